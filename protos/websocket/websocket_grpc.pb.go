@@ -20,13 +20,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	WebsocketService_GetOnline_FullMethodName      = "/websocket.WebsocketService/GetOnline"
-	WebsocketService_GetOnlineUsers_FullMethodName = "/websocket.WebsocketService/GetOnlineUsers"
-	WebsocketService_SendMessage_FullMethodName    = "/websocket.WebsocketService/SendMessage"
-	WebsocketService_Broadcast_FullMethodName      = "/websocket.WebsocketService/Broadcast"
+	WebsocketService_GetOnline_FullMethodName          = "/websocket.WebsocketService/GetOnline"
+	WebsocketService_GetOnlineUsers_FullMethodName     = "/websocket.WebsocketService/GetOnlineUsers"
+	WebsocketService_SendMessage_FullMethodName        = "/websocket.WebsocketService/SendMessage"
+	WebsocketService_Broadcast_FullMethodName          = "/websocket.WebsocketService/Broadcast"
+	WebsocketService_DisconnectSessions_FullMethodName = "/websocket.WebsocketService/DisconnectSessions"
 )
 
-// WebsocketServiceClient is the client API for WebsocketService domainservice.
+// WebsocketServiceClient is the client API for WebsocketService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type WebsocketServiceClient interface {
@@ -34,6 +35,7 @@ type WebsocketServiceClient interface {
 	GetOnlineUsers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetOnlineUsersResponse, error)
 	SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Broadcast(ctx context.Context, in *BroadcastRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DisconnectSessions(ctx context.Context, in *DisconnectSessionsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type websocketServiceClient struct {
@@ -84,7 +86,17 @@ func (c *websocketServiceClient) Broadcast(ctx context.Context, in *BroadcastReq
 	return out, nil
 }
 
-// WebsocketServiceServer is the server API for WebsocketService domainservice.
+func (c *websocketServiceClient) DisconnectSessions(ctx context.Context, in *DisconnectSessionsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WebsocketService_DisconnectSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WebsocketServiceServer is the server API for WebsocketService service.
 // All implementations must embed UnimplementedWebsocketServiceServer
 // for forward compatibility.
 type WebsocketServiceServer interface {
@@ -92,6 +104,7 @@ type WebsocketServiceServer interface {
 	GetOnlineUsers(context.Context, *emptypb.Empty) (*GetOnlineUsersResponse, error)
 	SendMessage(context.Context, *SendMessageRequest) (*emptypb.Empty, error)
 	Broadcast(context.Context, *BroadcastRequest) (*emptypb.Empty, error)
+	DisconnectSessions(context.Context, *DisconnectSessionsRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedWebsocketServiceServer()
 }
 
@@ -114,10 +127,13 @@ func (UnimplementedWebsocketServiceServer) SendMessage(context.Context, *SendMes
 func (UnimplementedWebsocketServiceServer) Broadcast(context.Context, *BroadcastRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Broadcast not implemented")
 }
+func (UnimplementedWebsocketServiceServer) DisconnectSessions(context.Context, *DisconnectSessionsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisconnectSessions not implemented")
+}
 func (UnimplementedWebsocketServiceServer) mustEmbedUnimplementedWebsocketServiceServer() {}
 func (UnimplementedWebsocketServiceServer) testEmbeddedByValue()                          {}
 
-// UnsafeWebsocketServiceServer may be embedded to opt out of forward compatibility for this domainservice.
+// UnsafeWebsocketServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to WebsocketServiceServer will
 // result in compilation errors.
 type UnsafeWebsocketServiceServer interface {
@@ -207,7 +223,25 @@ func _WebsocketService_Broadcast_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
-// WebsocketService_ServiceDesc is the grpc.ServiceDesc for WebsocketService domainservice.
+func _WebsocketService_DisconnectSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisconnectSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebsocketServiceServer).DisconnectSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebsocketService_DisconnectSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebsocketServiceServer).DisconnectSessions(ctx, req.(*DisconnectSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WebsocketService_ServiceDesc is the grpc.ServiceDesc for WebsocketService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var WebsocketService_ServiceDesc = grpc.ServiceDesc{
@@ -230,6 +264,10 @@ var WebsocketService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Broadcast",
 			Handler:    _WebsocketService_Broadcast_Handler,
 		},
+		{
+			MethodName: "DisconnectSessions",
+			Handler:    _WebsocketService_DisconnectSessions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "src/websocket.proto",
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"context"
+
 	"github.com/go-playground/validator/v10"
 	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/config"
 	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/grpc/clients"
@@ -11,6 +13,8 @@ import (
 	rediswrapper "github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/cache/redis"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/mail"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/oidc"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/oidc/fake"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/persistence"
 	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/auth"
 	"github.com/intezya/abyssleague/services/abysscore/pkg/errorz"
@@ -26,6 +30,10 @@ import (
 // @securityDefinitions.apikey	BearerAuth
 // @in							header
 // @name						Authorization
+// @securityDefinitions.apikey	APITokenAuth
+// @in							header
+// @name						Authorization
+// @description				Personal API token with "Token" scheme, accepted only by routes allowing its scopes
 // @schemes					http https.
 func main() {
 	appConfig := config.LoadConfig()
@@ -48,21 +56,36 @@ func main() {
 
 	logger.Log.Debug("grpcDependencies has been initialized")
 
+	if appConfig.OIDCFakeProviderConfig != nil {
+		fake.Run(appConfig.OIDCFakeProviderConfig, appConfig.OIDCFakeProviderPort)
+	}
+
 	repositoryDependencies := persistence.NewDependencyProvider(entClient, redisClient)
 
 	serviceDependencies := applicationservice.NewDependencyProvider(
 		repositoryDependencies,
 		gRPCDependencies,
-		auth.NewHashHelper(appConfig.HardwareIDEncryptionKey),
+		auth.NewHashHelper(appConfig.HardwareIDEncryptionKeys),
 		auth.NewJWTHelper(appConfig.JWTConfiguration),
+		auth.NewTOTPHelper(appConfig.TOTPIssuer),
 		smtpClient,
+		oidc.NewProviders(appConfig.OIDCConfigs),
 	)
 
+	backgroundCtx, cancelBackground := context.WithCancel(context.Background())
+	defer cancelBackground()
+
+	go serviceDependencies.SeasonService.RunRolloverLoop(backgroundCtx)
+	go serviceDependencies.PersonalDataService.RunDeletionLoop(backgroundCtx)
+	go serviceDependencies.KeyRotationService.RunReencryptionLoop(backgroundCtx)
+	go serviceDependencies.GrantJobService.RunStaleJobLoop(backgroundCtx)
+
 	handlerDependencies := handlers.NewDependencyProvider(serviceDependencies)
 
 	serverDependencies := routes.NewDependencyProvider(
 		handlerDependencies,
 		serviceDependencies.AuthenticationService,
+		serviceDependencies.APITokenService,
 		redisClient,
 		appConfig,
 	)
@@ -15,14 +15,34 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/api/account/email/enter_code": {
+        "/.well-known/jwks.json": {
+            "get": {
+                "description": "Returns JSON Web Key Set with public keys of the current and previous signing keys. Keys are picked by \"kid\" token header, the response is not wrapped and can be cached for a few minutes",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Get token verification keys",
+                "responses": {
+                    "200": {
+                        "description": "Public keys",
+                        "schema": {
+                            "$ref": "#/definitions/dto.JSONWebKeySetDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/account/2fa/confirm": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Verifies sent code and links email to account",
+                "description": "Enables two-factor authentication if TOTP code is right. Returns recovery codes, they are shown only once",
                 "consumes": [
                     "application/json"
                 ],
@@ -30,47 +50,47 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Account"
+                    "Two-factor authentication"
                 ],
-                "summary": "Verify sent code",
+                "summary": "Confirm two-factor enrollment",
                 "parameters": [
                     {
-                        "description": "Verification code from email",
+                        "description": "TOTP code",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.EnterCodeForEmailLinkRequest"
+                            "$ref": "#/definitions/request.TwoFactorCodeRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Email successfully linked",
+                        "description": "Recovery codes",
                         "schema": {
-                            "$ref": "#/definitions/dto.UserDTO"
+                            "$ref": "#/definitions/dto.RecoveryCodesDTO"
                         }
                     },
                     "400": {
-                        "description": "Bad request - wrong verification code",
+                        "description": "Bad request - missed request fields",
                         "schema": {
                             "$ref": "#/definitions/examples.BadRequestResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict - user already has linked email",
+                    "401": {
+                        "description": "Unauthorized - wrong code",
                         "schema": {
-                            "$ref": "#/definitions/examples.AccountAlreadyHasLinkedEmail"
+                            "$ref": "#/definitions/examples.WrongTwoFactorCodeResponse"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable entity - invalid request types",
+                    "409": {
+                        "description": "Conflict - enrollment is not started or expired",
                         "schema": {
-                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                            "$ref": "#/definitions/examples.TwoFactorEnrollmentNotStarted"
                         }
                     },
                     "429": {
-                        "description": "Too many requests - received too many requests",
+                        "description": "Too many requests - too many wrong codes",
                         "schema": {
                             "$ref": "#/definitions/examples.TooManyRequestsResponse"
                         }
@@ -78,14 +98,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/account/email/get_code": {
+        "/api/account/2fa/disable": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Sends verification code for email linking",
+                "description": "Disables two-factor authentication and deletes recovery codes. Requires TOTP or recovery code",
                 "consumes": [
                     "application/json"
                 ],
@@ -93,23 +113,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Account"
+                    "Two-factor authentication"
                 ],
-                "summary": "Send email verification code",
+                "summary": "Disable two-factor authentication",
                 "parameters": [
                     {
-                        "description": "Email for linking",
+                        "description": "TOTP or recovery code",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.LinkEmailRequest"
+                            "$ref": "#/definitions/request.TwoFactorCodeRequest"
                         }
                     }
                 ],
                 "responses": {
                     "204": {
-                        "description": "Code successfully sent"
+                        "description": "Two-factor authentication successfully disabled"
                     },
                     "400": {
                         "description": "Bad request - missed request fields",
@@ -117,20 +137,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/examples.BadRequestResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict - someone already has this email as linked",
+                    "401": {
+                        "description": "Unauthorized - wrong code",
                         "schema": {
-                            "$ref": "#/definitions/examples.EmailConflict"
+                            "$ref": "#/definitions/examples.WrongTwoFactorCodeResponse"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable entity - invalid request types",
+                    "409": {
+                        "description": "Conflict - two-factor authentication is not enabled",
                         "schema": {
-                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                            "$ref": "#/definitions/examples.TwoFactorNotEnabled"
                         }
                     },
                     "429": {
-                        "description": "Too many requests - received too many requests",
+                        "description": "Too many requests - too many wrong codes",
                         "schema": {
                             "$ref": "#/definitions/examples.TooManyRequestsResponse"
                         }
@@ -138,73 +158,45 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/auth/change_password": {
+        "/api/account/2fa/enroll": {
             "post": {
-                "description": "Changes the password for an existing user",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Generates TOTP secret and provisioning URI for QR code. Two-factor authentication is enabled after confirmation with a code",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
-                ],
-                "summary": "Change user password",
-                "parameters": [
-                    {
-                        "description": "Password change details",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.PasswordChangeRequest"
-                        }
-                    }
+                    "Two-factor authentication"
                 ],
+                "summary": "Start two-factor enrollment",
                 "responses": {
                     "200": {
-                        "description": "Password successfully changed",
-                        "schema": {
-                            "$ref": "#/definitions/examples.AuthenticationSuccessResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad request - missed request fields",
-                        "schema": {
-                            "$ref": "#/definitions/examples.BadRequestResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized - wrong password",
-                        "schema": {
-                            "$ref": "#/definitions/examples.UserWrongPasswordResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Not found - user with this username not found",
-                        "schema": {
-                            "$ref": "#/definitions/examples.UserNotFoundResponse"
-                        }
-                    },
-                    "422": {
-                        "description": "Unprocessable entity - invalid request types",
+                        "description": "TOTP secret and provisioning URI",
                         "schema": {
-                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                            "$ref": "#/definitions/dto.TOTPEnrollmentDTO"
                         }
                     },
-                    "429": {
-                        "description": "Too many requests - received too many auth requests",
+                    "409": {
+                        "description": "Conflict - two-factor authentication is already enabled",
                         "schema": {
-                            "$ref": "#/definitions/examples.TooManyRequestsResponse"
+                            "$ref": "#/definitions/examples.TwoFactorAlreadyEnabled"
                         }
                     }
                 }
             }
         },
-        "/api/auth/login": {
+        "/api/account/2fa/recovery_codes": {
             "post": {
-                "description": "Authenticates a user with username and password",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces all recovery codes with new ones. Requires TOTP or recovery code",
                 "consumes": [
                     "application/json"
                 ],
@@ -212,25 +204,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
+                    "Two-factor authentication"
                 ],
-                "summary": "Authenticate user",
+                "summary": "Regenerate recovery codes",
                 "parameters": [
                     {
-                        "description": "UserDTO login credentials",
+                        "description": "TOTP or recovery code",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.AuthenticationRequest"
+                            "$ref": "#/definitions/request.TwoFactorCodeRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully authenticated",
+                        "description": "New recovery codes",
                         "schema": {
-                            "$ref": "#/definitions/examples.AuthenticationSuccessResponse"
+                            "$ref": "#/definitions/dto.RecoveryCodesDTO"
                         }
                     },
                     "400": {
@@ -240,31 +232,19 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "Unauthorized - wrong hardware id",
-                        "schema": {
-                            "$ref": "#/definitions/examples.UserWrongHardwareIDResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Not found - user with this username not found",
+                        "description": "Unauthorized - wrong code",
                         "schema": {
-                            "$ref": "#/definitions/examples.UserNotFoundResponse"
+                            "$ref": "#/definitions/examples.WrongTwoFactorCodeResponse"
                         }
                     },
                     "409": {
-                        "description": "Conflict - only one account per device allowed",
-                        "schema": {
-                            "$ref": "#/definitions/examples.HardwareIDConflictResponse"
-                        }
-                    },
-                    "422": {
-                        "description": "Unprocessable entity - invalid request types",
+                        "description": "Conflict - two-factor authentication is not enabled",
                         "schema": {
-                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                            "$ref": "#/definitions/examples.TwoFactorNotEnabled"
                         }
                     },
                     "429": {
-                        "description": "Too many requests - received too many auth requests",
+                        "description": "Too many requests - too many wrong codes",
                         "schema": {
                             "$ref": "#/definitions/examples.TooManyRequestsResponse"
                         }
@@ -272,9 +252,37 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/auth/register": {
+        "/api/account/api_tokens": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns tokens including expired ones, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "API tokens"
+                ],
+                "summary": "List API tokens",
+                "responses": {
+                    "200": {
+                        "description": "User API tokens",
+                        "schema": {
+                            "$ref": "#/definitions/examples.APITokensSuccessResponse"
+                        }
+                    }
+                }
+            },
             "post": {
-                "description": "Creates a new user account with the provided credentials",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates scoped token for third-party tools, the token itself is returned only once. API tokens are sent with \"Token\" scheme in Authorization header",
                 "consumes": [
                     "application/json"
                 ],
@@ -282,37 +290,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
+                    "API tokens"
                 ],
-                "summary": "Register a new user",
+                "summary": "Issue API token",
                 "parameters": [
                     {
-                        "description": "UserDTO registration details",
+                        "description": "Token name, scopes and lifetime",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.AuthenticationRequest"
+                            "$ref": "#/definitions/request.IssueAPITokenRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "UserDTO successfully registered",
+                        "description": "Token successfully issued",
                         "schema": {
-                            "$ref": "#/definitions/examples.AuthenticationSuccessResponse"
+                            "$ref": "#/definitions/examples.IssuedAPITokenSuccessResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request - missed request fields",
+                        "description": "Bad request - lifetime is out of range",
                         "schema": {
-                            "$ref": "#/definitions/examples.BadRequestResponse"
+                            "$ref": "#/definitions/examples.InvalidAPITokenLifetimeResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - two-factor authentication code required",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TwoFactorRequiredResponse"
                         }
                     },
                     "409": {
-                        "description": "Conflict - only one account per device allowed",
+                        "description": "Conflict - too many active tokens",
                         "schema": {
-                            "$ref": "#/definitions/examples.HardwareIDConflictResponse"
+                            "$ref": "#/definitions/examples.APITokenLimitReachedResponse"
                         }
                     },
                     "422": {
@@ -322,7 +336,7 @@ const docTemplate = `{
                         }
                     },
                     "429": {
-                        "description": "Too many requests - received too many auth requests",
+                        "description": "Too many requests - received too many requests",
                         "schema": {
                             "$ref": "#/definitions/examples.TooManyRequestsResponse"
                         }
@@ -330,75 +344,51 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/items": {
-            "get": {
-                "description": "Returns a paginated list of game items with sorting",
+        "/api/account/api_tokens/{token_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Token stops working immediately",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Game Items"
+                    "API tokens"
                 ],
-                "summary": "List game items",
+                "summary": "Revoke API token",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Page number (default: 1)",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page size (default: 10)",
-                        "name": "size",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "created_at",
-                            "name",
-                            "collection",
-                            "type",
-                            "rarity"
-                        ],
-                        "type": "string",
-                        "description": "Field to sort by",
-                        "name": "order_by",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "asc",
-                            "desc"
-                        ],
-                        "type": "string",
-                        "description": "Sort order",
-                        "name": "order_type",
-                        "in": "query"
+                        "description": "API token ID",
+                        "name": "token_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Paginated list of game items",
-                        "schema": {
-                            "$ref": "#/definitions/examples.PaginatedGameItemsDTOResponse"
-                        }
+                    "204": {
+                        "description": "Token successfully revoked"
                     },
-                    "400": {
-                        "description": "Bad request - invalid query params",
+                    "404": {
+                        "description": "Not found - api token not found",
                         "schema": {
-                            "$ref": "#/definitions/examples.BadRequestResponse"
+                            "$ref": "#/definitions/examples.APITokenNotFoundResponse"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/account/deletion": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Admin creates a new game item",
+                "description": "Account is erased after the grace period: username and email are anonymized, hardware id is dropped, matches and sanctions are kept with a placeholder player. All other sessions are ended, deletion can be cancelled within the grace period",
                 "consumes": [
                     "application/json"
                 ],
@@ -406,25 +396,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Game Items"
+                    "Account"
                 ],
-                "summary": "Create game item",
+                "summary": "Request account deletion",
                 "parameters": [
                     {
-                        "description": "Game item data",
+                        "description": "Password and two-factor code",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.CreateUpdateGameItem"
+                            "$ref": "#/definitions/request.RequestAccountDeletionRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Created game item",
+                        "description": "Deletion scheduled",
                         "schema": {
-                            "$ref": "#/definitions/examples.CreateGameItemDTOSuccessResponse"
+                            "$ref": "#/definitions/examples.AccountDeletionSuccessResponse"
                         }
                     },
                     "400": {
@@ -433,10 +423,16 @@ const docTemplate = `{
                             "$ref": "#/definitions/examples.BadRequestResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden - not enough rights",
+                    "401": {
+                        "description": "Unauthorized - two-factor authentication code required",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TwoFactorRequiredResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - deletion is already scheduled",
                         "schema": {
-                            "$ref": "#/definitions/examples.ForbiddenByAccessLevelResponse"
+                            "$ref": "#/definitions/examples.AccountDeletionScheduled"
                         }
                     },
                     "422": {
@@ -446,55 +442,42 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/items/{id}": {
-            "get": {
-                "description": "Returns game item by its ID",
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Account stays as is, ended sessions are not restored",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Game Items"
-                ],
-                "summary": "Get game item by ID",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Game item ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Account"
                 ],
+                "summary": "Cancel account deletion",
                 "responses": {
-                    "200": {
-                        "description": "Game item",
-                        "schema": {
-                            "$ref": "#/definitions/examples.FindGameItemDTOSuccessResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad request - invalid ID",
-                        "schema": {
-                            "$ref": "#/definitions/examples.BadRequestResponse"
-                        }
+                    "204": {
+                        "description": "Deletion cancelled"
                     },
-                    "404": {
-                        "description": "Not found - no such game item",
+                    "409": {
+                        "description": "Conflict - deletion is not scheduled",
                         "schema": {
-                            "$ref": "#/definitions/examples.GameItemNotFound"
+                            "$ref": "#/definitions/examples.AccountDeletionNotScheduled"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/api/account/email/change/enter_code": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Admin updates a game item by ID",
+                "description": "Verifies codes sent to current and new addresses and changes account email",
                 "consumes": [
                     "application/json"
                 ],
@@ -502,41 +485,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Game Items"
+                    "Account"
                 ],
-                "summary": "Update game item",
+                "summary": "Verify email change codes",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Game item ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Updated game item data",
+                        "description": "Verification codes from both emails",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.CreateUpdateGameItem"
+                            "$ref": "#/definitions/request.EnterCodesForEmailChangeRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "Game item updated"
+                    "200": {
+                        "description": "Email successfully changed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserDTO"
+                        }
                     },
                     "400": {
-                        "description": "Bad request - missed request fields",
+                        "description": "Bad request - wrong verification code",
                         "schema": {
-                            "$ref": "#/definitions/examples.BadRequestResponse"
+                            "$ref": "#/definitions/examples.WrongVerificationCode"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden - not enough rights",
+                    "409": {
+                        "description": "Conflict - someone already has this email as linked",
                         "schema": {
-                            "$ref": "#/definitions/examples.ForbiddenByAccessLevelResponse"
+                            "$ref": "#/definitions/examples.EmailConflict"
                         }
                     },
                     "422": {
@@ -544,46 +523,65 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/examples.UnprocessableEntityResponse"
                         }
+                    },
+                    "429": {
+                        "description": "Too many requests - received too many requests",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TooManyRequestsResponse"
+                        }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/api/account/email/change/get_code": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Admin deletes a game item by ID",
+                "description": "Sends confirmation codes to both current and new email addresses",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Game Items"
+                    "Account"
                 ],
-                "summary": "Delete game item",
+                "summary": "Send email change codes",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Game item ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "New email",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ChangeEmailRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "204": {
-                        "description": "Game item deleted"
+                        "description": "Codes successfully sent"
                     },
                     "400": {
-                        "description": "Bad request - invalid ID",
+                        "description": "Bad request - missed request fields",
                         "schema": {
                             "$ref": "#/definitions/examples.BadRequestResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden - not enough rights",
+                    "401": {
+                        "description": "Unauthorized - two-factor authentication code required",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TwoFactorRequiredResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - someone already has this email as linked",
                         "schema": {
-                            "$ref": "#/definitions/examples.ForbiddenByAccessLevelResponse"
+                            "$ref": "#/definitions/examples.EmailConflict"
                         }
                     },
                     "422": {
@@ -591,46 +589,87 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/examples.UnprocessableEntityResponse"
                         }
+                    },
+                    "429": {
+                        "description": "Too many requests - received too many requests or email has been changed recently",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TooManyRequestsResponse"
+                        }
                     }
                 }
             }
         },
-        "/api/users/inventory": {
-            "get": {
+        "/api/account/email/enter_code": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns all inventory items for the currently authenticated user",
+                "description": "Verifies sent code and links email to account",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Inventory Items"
+                    "Account"
+                ],
+                "summary": "Verify sent code",
+                "parameters": [
+                    {
+                        "description": "Verification code from email",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.EnterCodeForEmailLinkRequest"
+                        }
+                    }
                 ],
-                "summary": "Get current user's inventory",
                 "responses": {
                     "200": {
-                        "description": "List of user's inventory items",
+                        "description": "Email successfully linked",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/examples.PaginatedInventoryItemsDTOResponse"
-                            }
+                            "$ref": "#/definitions/dto.UserDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - wrong verification code",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - user already has linked email",
+                        "schema": {
+                            "$ref": "#/definitions/examples.AccountAlreadyHasLinkedEmail"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests - received too many requests",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TooManyRequestsResponse"
                         }
                     }
                 }
             }
         },
-        "/api/users/me/inventory/set_item": {
+        "/api/account/email/get_code": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Sets a specific inventory item as current for the authenticated user",
+                "description": "Sends verification code for email linking",
                 "consumes": [
                     "application/json"
                 ],
@@ -638,34 +677,34 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Inventory Items"
+                    "Account"
                 ],
-                "summary": "Set inventory item as current",
+                "summary": "Send email verification code",
                 "parameters": [
                     {
-                        "description": "Inventory item details",
+                        "description": "Email for linking",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.SetItemAsCurrent"
+                            "$ref": "#/definitions/request.LinkEmailRequest"
                         }
                     }
                 ],
                 "responses": {
                     "204": {
-                        "description": "Item successfully set as current"
+                        "description": "Code successfully sent"
                     },
                     "400": {
-                        "description": "Bad request - invalid request body",
+                        "description": "Bad request - missed request fields",
                         "schema": {
                             "$ref": "#/definitions/examples.BadRequestResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not found - inventory item not found",
+                    "409": {
+                        "description": "Conflict - someone already has this email as linked",
                         "schema": {
-                            "$ref": "#/definitions/examples.InventoryItemNotFoundResponse"
+                            "$ref": "#/definitions/examples.EmailConflict"
                         }
                     },
                     "422": {
@@ -673,816 +712,8656 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/examples.UnprocessableEntityResponse"
                         }
+                    },
+                    "429": {
+                        "description": "Too many requests - received too many requests",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TooManyRequestsResponse"
+                        }
                     }
                 }
             }
         },
-        "/api/users/{user_id}/inventory": {
+        "/api/account/email/history": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Admin retrieves all inventory items for a specified user",
+                "description": "Returns links, changes and unlinks of account email, most recent first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Inventory Items"
-                ],
-                "summary": "Get user's inventory",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "UserDTO ID",
-                        "name": "user_id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Account"
                 ],
+                "summary": "Get own email history",
                 "responses": {
                     "200": {
-                        "description": "List of user's inventory items",
+                        "description": "Email history",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/examples.PaginatedInventoryItemsDTOResponse"
+                                "$ref": "#/definitions/dto.EmailHistoryDTO"
                             }
                         }
                     },
-                    "400": {
-                        "description": "Bad request - invalid ID",
-                        "schema": {
-                            "$ref": "#/definitions/examples.BadRequestResponse"
-                        }
-                    },
-                    "403": {
-                        "description": "Forbidden - not enough rights",
-                        "schema": {
-                            "$ref": "#/definitions/examples.ForbiddenByAccessLevelResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Not found - user not found",
+                    "429": {
+                        "description": "Too many requests - received too many requests",
                         "schema": {
-                            "$ref": "#/definitions/examples.UserNotFoundResponse"
+                            "$ref": "#/definitions/examples.TooManyRequestsResponse"
                         }
                     }
                 }
             }
         },
-        "/api/users/{user_id}/inventory/{item_id}": {
+        "/api/account/email/unlink": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Admin grants a game item to a specific user",
+                "description": "Removes email from account. Allowed only if the account has another recovery method, such as a linked external identity",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Inventory Items"
+                    "Account"
                 ],
-                "summary": "Grant item to user",
+                "summary": "Unlink email",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "UserDTO ID",
-                        "name": "user_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Item ID",
-                        "name": "item_id",
-                        "in": "path",
-                        "required": true
+                        "description": "Account password",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UnlinkEmailRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Granted inventory item",
+                        "description": "Email successfully unlinked",
                         "schema": {
-                            "$ref": "#/definitions/examples.InventoryItemDTOSuccessResponse"
+                            "$ref": "#/definitions/dto.UserDTO"
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid ID",
+                        "description": "Bad request - missed request fields",
                         "schema": {
                             "$ref": "#/definitions/examples.BadRequestResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden - not enough rights",
+                    "401": {
+                        "description": "Unauthorized - two-factor authentication code required",
                         "schema": {
-                            "$ref": "#/definitions/examples.ForbiddenByAccessLevelResponse"
+                            "$ref": "#/definitions/examples.TwoFactorRequiredResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not found - item not found",
+                    "409": {
+                        "description": "Conflict - account has no other recovery method",
                         "schema": {
-                            "$ref": "#/definitions/examples.GameItemNotFound"
+                            "$ref": "#/definitions/examples.NoOtherRecoveryMethod"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests - received too many requests",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TooManyRequestsResponse"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/api/account/external_identities": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Admin revokes a game item from a specific user",
+                "description": "Returns identity provider accounts linked to the account",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Inventory Items"
+                    "Account"
                 ],
-                "summary": "Revoke item from user",
+                "summary": "Get linked identities",
+                "responses": {
+                    "200": {
+                        "description": "Linked identities",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ExternalIdentitiesSuccessResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests - received too many requests",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TooManyRequestsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/account/external_identities/{identity_id}/unlink": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes identity provider account from the account. Account password is required, so the account keeps a way to sign in",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Account"
+                ],
+                "summary": "Unlink identity",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "UserDTO ID",
-                        "name": "user_id",
+                        "description": "External identity ID",
+                        "name": "identity_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Item ID",
-                        "name": "item_id",
-                        "in": "path",
-                        "required": true
+                        "description": "Account password",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UnlinkExternalIdentityRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "204": {
-                        "description": "Item successfully revoked"
+                        "description": "Identity successfully unlinked"
                     },
                     "400": {
-                        "description": "Bad request - invalid ID",
+                        "description": "Bad request - missed request fields",
                         "schema": {
                             "$ref": "#/definitions/examples.BadRequestResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden - not enough rights",
+                    "401": {
+                        "description": "Unauthorized - two-factor authentication code required",
                         "schema": {
-                            "$ref": "#/definitions/examples.ForbiddenByAccessLevelResponse"
+                            "$ref": "#/definitions/examples.TwoFactorRequiredResponse"
                         }
                     },
                     "404": {
-                        "description": "Not found - inventory item not found",
+                        "description": "Not found - identity is not linked to the account",
                         "schema": {
-                            "$ref": "#/definitions/examples.InventoryItemNotFoundResponse"
+                            "$ref": "#/definitions/examples.ExternalIdentityNotFoundResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests - received too many auth requests",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TooManyRequestsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/account/external_identities/{provider}/callback": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Exchanges authorization code and links the identity to the current account",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Account"
+                ],
+                "summary": "Finish identity linking",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Identity provider",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Authorization response",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.OIDCLinkCallbackRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Identity successfully linked",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ExternalIdentitySuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - authorization is expired or started by another user",
+                        "schema": {
+                            "$ref": "#/definitions/examples.InvalidOIDCStateResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - provider rejected authorization",
+                        "schema": {
+                            "$ref": "#/definitions/examples.OIDCAuthorizationFailedResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - account already has identity of this provider",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ProviderAlreadyLinkedResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests - received too many auth requests",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TooManyRequestsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/account/external_identities/{provider}/start": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns provider authorization url to link its account to the current account",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Account"
+                ],
+                "summary": "Start identity linking",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Identity provider",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Authorization started",
+                        "schema": {
+                            "$ref": "#/definitions/examples.OIDCAuthorizationSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - unknown identity provider",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnknownIdentityProviderResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests - received too many auth requests",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TooManyRequestsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/account/login_history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns successful and failed logins to the account, most recent first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Account"
+                ],
+                "summary": "Get own login history",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default: 10)",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Paginated login history",
+                        "schema": {
+                            "$ref": "#/definitions/examples.PaginatedLoginHistoryDTOResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests - received too many requests",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TooManyRequestsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/account/password/change": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sets new password, ends all other sessions of the account and notifies the linked email",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Account"
+                ],
+                "summary": "Change password",
+                "parameters": [
+                    {
+                        "description": "Current and new passwords",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ChangePasswordRequest"
                         }
                     }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Password successfully changed"
+                    },
+                    "400": {
+                        "description": "Bad request - password has been used recently",
+                        "schema": {
+                            "$ref": "#/definitions/examples.PasswordRecentlyUsed"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - two-factor authentication code required",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TwoFactorRequiredResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - password has been changed concurrently",
+                        "schema": {
+                            "$ref": "#/definitions/examples.PasswordChangedConcurrently"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests - received too many requests",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TooManyRequestsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/account/personal_data": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns profile, statistics, matches, inventory, friends, balance and linked identifiers. With \"zip\" format the data is sent as archive with a JSON file per section",
+                "produces": [
+                    "application/json",
+                    "application/zip"
+                ],
+                "tags": [
+                    "Account"
+                ],
+                "summary": "Export personal data",
+                "parameters": [
+                    {
+                        "enum": [
+                            "json",
+                            "zip"
+                        ],
+                        "type": "string",
+                        "default": "json",
+                        "description": "Export format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Personal data",
+                        "schema": {
+                            "$ref": "#/definitions/examples.PersonalDataSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - unknown export format",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnknownExportFormat"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/account/sanctions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns all sanctions of the current user, most recent first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sanctions"
+                ],
+                "summary": "Get own sanctions",
+                "responses": {
+                    "200": {
+                        "description": "Sanction history",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.SanctionDTO"
+                            }
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests - received too many requests",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TooManyRequestsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/account/sanctions/{sanction_id}/appeal": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Appeals own sanction, each sanction can be appealed once. Account blocked by the sanction can appeal it after the block ends",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sanctions"
+                ],
+                "summary": "Appeal sanction",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Sanction ID",
+                        "name": "sanction_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Appeal message",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.AppealSanctionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Sanction successfully appealed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SanctionDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - missed request fields",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - no such sanction",
+                        "schema": {
+                            "$ref": "#/definitions/examples.SanctionNotFoundResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - sanction is already appealed",
+                        "schema": {
+                            "$ref": "#/definitions/examples.SanctionAlreadyAppealedResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests - received too many requests",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TooManyRequestsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/account/username/change": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sets new username once per 30 days, every change except the first one costs 500 coins. The old username stays bound to the account for 14 days. Access tokens of other sessions stop working and have to be refreshed, the current session gets a new access token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Account"
+                ],
+                "summary": "Change username",
+                "parameters": [
+                    {
+                        "description": "New username",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ChangeUsernameRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Username successfully changed",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UsernameChangedSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - username does not satisfy the policy",
+                        "schema": {
+                            "$ref": "#/definitions/examples.InvalidUsername"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - two-factor authentication code required",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TwoFactorRequiredResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - not enough coins",
+                        "schema": {
+                            "$ref": "#/definitions/examples.InsufficientCoins"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests - username has been changed recently",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UsernameChangeCooldown"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/account/username/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns username changes with the time old usernames are released, most recent first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Account"
+                ],
+                "summary": "Get own username history",
+                "responses": {
+                    "200": {
+                        "description": "Username history",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.UsernameHistoryDTO"
+                            }
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests - received too many requests",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TooManyRequestsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/audit_log": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns privileged actions, most recent first. Before and after contain only changed fields",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Audit log"
+                ],
+                "summary": "List audit log",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Performer user ID",
+                        "name": "actor_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Action (e.g. game_item.update)",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Target type (e.g. game_item)",
+                        "name": "target_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Target ID",
+                        "name": "target_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "request_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RFC 3339 lower bound",
+                        "name": "created_after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RFC 3339 upper bound",
+                        "name": "created_before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default: 10)",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Paginated audit log",
+                        "schema": {
+                            "$ref": "#/definitions/examples.PaginatedAuditLogDTOResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid filter",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/audit_log/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns all matching records as JSON-lines file, oldest first. Accepts the same filters as the list",
+                "produces": [
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "Audit log"
+                ],
+                "summary": "Export audit log",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Performer user ID",
+                        "name": "actor_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Action (e.g. game_item.update)",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Target type (e.g. game_item)",
+                        "name": "target_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Target ID",
+                        "name": "target_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "request_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RFC 3339 lower bound",
+                        "name": "created_after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RFC 3339 upper bound",
+                        "name": "created_before",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "One audit log record per line",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid filter",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/hardware_id/reset_request": {
+            "post": {
+                "description": "Creates hardware id reset request for the user who can't log in from a new device. The request is resolved by the administration",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Hardware ID"
+                ],
+                "summary": "Request hardware id reset",
+                "parameters": [
+                    {
+                        "description": "Credentials and reason",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.HardwareIDResetRequestRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Request successfully created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.HardwareIDResetDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - missed request fields",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - two-factor authentication code required",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TwoFactorRequiredResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - user with this username not found",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UserNotFoundResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - request is already pending",
+                        "schema": {
+                            "$ref": "#/definitions/examples.HardwareIDResetAlreadyRequestedResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests - received too many auth requests",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TooManyRequestsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/login": {
+            "post": {
+                "description": "Authenticates a user with username and password",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Authenticate user",
+                "parameters": [
+                    {
+                        "description": "UserDTO login credentials",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.AuthenticationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/examples.AuthenticationSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - missed request fields",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - two-factor authentication code required",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TwoFactorRequiredResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - login is locked after too many failures",
+                        "schema": {
+                            "$ref": "#/definitions/examples.LoginLockedResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - user with this username not found",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UserNotFoundResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - only one account per device allowed",
+                        "schema": {
+                            "$ref": "#/definitions/examples.HardwareIDConflictResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests - locked out after failed logins",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TooManyLoginFailuresResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/logout": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Ends the session the request is authenticated with",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sessions"
+                ],
+                "summary": "Logout",
+                "responses": {
+                    "204": {
+                        "description": "Successfully logged out"
+                    },
+                    "404": {
+                        "description": "Not found - session not found",
+                        "schema": {
+                            "$ref": "#/definitions/examples.SessionNotFoundResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/logout_others": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Ends all sessions of the user except the one the request is authenticated with",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sessions"
+                ],
+                "summary": "Logout other sessions",
+                "responses": {
+                    "204": {
+                        "description": "Other sessions successfully ended"
+                    }
+                }
+            }
+        },
+        "/api/auth/oidc/providers": {
+            "get": {
+                "description": "Returns names of identity providers available for sign in",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Get identity providers",
+                "responses": {
+                    "200": {
+                        "description": "Identity providers",
+                        "schema": {
+                            "$ref": "#/definitions/examples.OIDCProvidersSuccessResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests - received too many auth requests",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TooManyRequestsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/oidc/register": {
+            "post": {
+                "description": "Creates account with the picked username and links the identity to it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Register with identity provider",
+                "parameters": [
+                    {
+                        "description": "Registration token and username",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.OIDCRegistrationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "UserDTO successfully registered",
+                        "schema": {
+                            "$ref": "#/definitions/examples.AuthenticationSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - registration token is invalid or expired",
+                        "schema": {
+                            "$ref": "#/definitions/examples.InvalidRegistrationTokenResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - identity is already linked to an account",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ExternalIdentityAlreadyLinkedResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests - received too many auth requests",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TooManyRequestsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/oidc/{provider}/callback": {
+            "post": {
+                "description": "Exchanges authorization code. Returns tokens if the identity is linked to an account, otherwise returns registration token to pick username for a new account",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Finish sign in with identity provider",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Identity provider",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Authorization response",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.OIDCCallbackRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully authenticated or registration required",
+                        "schema": {
+                            "$ref": "#/definitions/examples.OIDCLoginSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - authorization is expired or started from another device",
+                        "schema": {
+                            "$ref": "#/definitions/examples.InvalidOIDCStateResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - two-factor authentication code required",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TwoFactorRequiredResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests - locked out after failed logins",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TooManyLoginFailuresResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/oidc/{provider}/start": {
+            "post": {
+                "description": "Returns provider authorization url. Authorization is bound to the hardware id and must be finished from the same device",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Start sign in with identity provider",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Identity provider",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Hardware id",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.StartOIDCRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Authorization started",
+                        "schema": {
+                            "$ref": "#/definitions/examples.OIDCAuthorizationSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - unknown identity provider",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnknownIdentityProviderResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests - received too many auth requests",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TooManyRequestsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/password_reset/enter_code": {
+            "post": {
+                "description": "Verifies sent code, sets new password and ends all sessions of the account",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Account"
+                ],
+                "summary": "Reset password",
+                "parameters": [
+                    {
+                        "description": "Verification code from email and new password",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.EnterCodeForPasswordResetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Password successfully reset"
+                    },
+                    "400": {
+                        "description": "Bad request - password has been used recently",
+                        "schema": {
+                            "$ref": "#/definitions/examples.PasswordRecentlyUsed"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - two-factor authentication code required",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TwoFactorRequiredResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests - received too many requests",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TooManyRequestsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/password_reset/get_code": {
+            "post": {
+                "description": "Sends password reset code to the linked email. Responds the same way if no account has this email or the code has been sent recently",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Account"
+                ],
+                "summary": "Send password reset code",
+                "parameters": [
+                    {
+                        "description": "Linked email",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.PasswordResetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Code successfully sent"
+                    },
+                    "400": {
+                        "description": "Bad request - missed request fields",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests - received too many requests",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TooManyRequestsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/refresh": {
+            "post": {
+                "description": "Rotates the refresh token and issues a new access token. Reusing an already rotated refresh token revokes all tokens issued by it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Refresh tokens",
+                "parameters": [
+                    {
+                        "description": "Refresh token",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.RefreshTokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tokens successfully refreshed",
+                        "schema": {
+                            "$ref": "#/definitions/examples.AuthenticationSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - missed request fields",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - refresh token reuse detected",
+                        "schema": {
+                            "$ref": "#/definitions/examples.RefreshTokenReusedResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests - received too many auth requests",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TooManyRequestsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/register": {
+            "post": {
+                "description": "Creates a new user account with the provided credentials",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Register a new user",
+                "parameters": [
+                    {
+                        "description": "UserDTO registration details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.AuthenticationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "UserDTO successfully registered",
+                        "schema": {
+                            "$ref": "#/definitions/examples.AuthenticationSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - password does not satisfy the policy",
+                        "schema": {
+                            "$ref": "#/definitions/examples.WeakPassword"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - only one account per device allowed",
+                        "schema": {
+                            "$ref": "#/definitions/examples.HardwareIDConflictResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests - received too many auth requests",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TooManyRequestsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/revoke": {
+            "post": {
+                "description": "Revokes the refresh token with all access tokens issued by it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Revoke tokens",
+                "parameters": [
+                    {
+                        "description": "Refresh token",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.RefreshTokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Tokens successfully revoked"
+                    },
+                    "400": {
+                        "description": "Bad request - missed request fields",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - refresh token is invalid or expired",
+                        "schema": {
+                            "$ref": "#/definitions/examples.InvalidRefreshTokenResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests - received too many auth requests",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TooManyRequestsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/sessions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns devices the user is signed in on, most recently active first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sessions"
+                ],
+                "summary": "List sessions",
+                "responses": {
+                    "200": {
+                        "description": "User sessions",
+                        "schema": {
+                            "$ref": "#/definitions/examples.SessionsSuccessResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/sessions/{session_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revokes tokens of the session and disconnects its websocket connection",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sessions"
+                ],
+                "summary": "End session",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Session ID",
+                        "name": "session_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Session successfully ended"
+                    },
+                    "404": {
+                        "description": "Not found - session not found",
+                        "schema": {
+                            "$ref": "#/definitions/examples.SessionNotFoundResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/collections/rewards": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns configured collection completion rewards",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Collections"
+                ],
+                "summary": "List collection rewards",
+                "responses": {
+                    "200": {
+                        "description": "Collection rewards",
+                        "schema": {
+                            "$ref": "#/definitions/examples.CollectionRewardsSuccessResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Admin configures coins, title or badge item granted once on collection completion",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Collections"
+                ],
+                "summary": "Set collection reward",
+                "parameters": [
+                    {
+                        "description": "Collection reward",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.SetCollectionReward"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Collection reward",
+                        "schema": {
+                            "$ref": "#/definitions/examples.CollectionRewardSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - missed request fields",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - badge item not found",
+                        "schema": {
+                            "$ref": "#/definitions/examples.GameItemNotFound"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/hardware_id/bans": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns banned hardware ids, most recent first. Search matches hardware id or ban reason substring",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Hardware ID"
+                ],
+                "summary": "List banned hardware ids",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hardware id or reason substring",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default: 10)",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Paginated list of banned hardware ids",
+                        "schema": {
+                            "$ref": "#/definitions/examples.PaginatedBannedHardwareIDsDTOResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Bans raw hardware id. Sessions of all accounts bound to this hardware id are ended",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Hardware ID"
+                ],
+                "summary": "Ban hardware id",
+                "parameters": [
+                    {
+                        "description": "Hardware id and ban reason",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.BanHardwareIDRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Hardware id successfully banned",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BanHardwareIDResultDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - missed request fields",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - hardware id is already banned",
+                        "schema": {
+                            "$ref": "#/definitions/examples.HardwareIDAlreadyBannedResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/hardware_id/reset_requests": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns hardware id resets, most recent first. Filter by status to get requests awaiting resolution",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Hardware ID"
+                ],
+                "summary": "List hardware id reset requests",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Status filter (pending, approved, rejected)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default: 10)",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Paginated list of hardware id resets",
+                        "schema": {
+                            "$ref": "#/definitions/examples.PaginatedHardwareIDResetsDTOResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid status",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/hardware_id/reset_requests/{request_id}/approve": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Approves pending request and clears user hardware id. Ends all user sessions and notifies user by email",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Hardware ID"
+                ],
+                "summary": "Approve hardware id reset request",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Request ID",
+                        "name": "request_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Operator comment",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ResolveHardwareIDResetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Request successfully approved",
+                        "schema": {
+                            "$ref": "#/definitions/dto.HardwareIDResetDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - no such request",
+                        "schema": {
+                            "$ref": "#/definitions/examples.HardwareIDResetRequestNotFoundResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - request is already resolved",
+                        "schema": {
+                            "$ref": "#/definitions/examples.HardwareIDResetRequestResolvedResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/hardware_id/reset_requests/{request_id}/reject": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Rejects pending request, user hardware id is kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Hardware ID"
+                ],
+                "summary": "Reject hardware id reset request",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Request ID",
+                        "name": "request_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Operator comment",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ResolveHardwareIDResetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Request successfully rejected",
+                        "schema": {
+                            "$ref": "#/definitions/dto.HardwareIDResetDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - no such request",
+                        "schema": {
+                            "$ref": "#/definitions/examples.HardwareIDResetRequestNotFoundResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - request is already resolved",
+                        "schema": {
+                            "$ref": "#/definitions/examples.HardwareIDResetRequestResolvedResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/hardware_id/unban": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes hardware id ban, accounts bound to this hardware id are able to log in again",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Hardware ID"
+                ],
+                "summary": "Unban hardware id",
+                "parameters": [
+                    {
+                        "description": "Banned hardware id",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UnbanHardwareIDRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Hardware id successfully unbanned"
+                    },
+                    "400": {
+                        "description": "Bad request - missed request fields",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - hardware id is not banned",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BannedHardwareIDNotFoundResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/items": {
+            "get": {
+                "description": "Returns a paginated list of game items with sorting, filters and text search",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Game Items"
+                ],
+                "summary": "List game items",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default: 10)",
+                        "name": "size",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "created_at",
+                            "name",
+                            "collection",
+                            "type",
+                            "rarity"
+                        ],
+                        "type": "string",
+                        "description": "Field to sort by",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name contains (case-insensitive)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated list of collections",
+                        "name": "collection",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Item type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minimal rarity (inclusive)",
+                        "name": "rarity_min",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximal rarity (inclusive)",
+                        "name": "rarity_max",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or after (RFC 3339)",
+                        "name": "created_after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or before (RFC 3339)",
+                        "name": "created_before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Text search by name or collection",
+                        "name": "q",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Paginated list of game items",
+                        "schema": {
+                            "$ref": "#/definitions/examples.PaginatedGameItemsDTOResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid query params",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Admin creates a new game item",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Game Items"
+                ],
+                "summary": "Create game item",
+                "parameters": [
+                    {
+                        "description": "Game item data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreateUpdateGameItem"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Created game item",
+                        "schema": {
+                            "$ref": "#/definitions/examples.CreateGameItemDTOSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - missed request fields",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/items/archived": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Admin retrieves archived game items with count of users owning each item",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Game Items"
+                ],
+                "summary": "List archived game items",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default: 10)",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Paginated list of archived game items",
+                        "schema": {
+                            "$ref": "#/definitions/examples.PaginatedArchivedGameItemsDTOResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/items/grants/{job_id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Admin retrieves progress of a bulk grant job",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inventory Items"
+                ],
+                "summary": "Get grant job",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Grant job ID",
+                        "name": "job_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Grant job",
+                        "schema": {
+                            "$ref": "#/definitions/examples.GrantJobSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - grant job not found",
+                        "schema": {
+                            "$ref": "#/definitions/examples.GrantJobNotFoundResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/items/{id}": {
+            "get": {
+                "description": "Returns game item by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Game Items"
+                ],
+                "summary": "Get game item by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Game item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Game item",
+                        "schema": {
+                            "$ref": "#/definitions/examples.FindGameItemDTOSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - no such game item",
+                        "schema": {
+                            "$ref": "#/definitions/examples.GameItemNotFound"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Admin updates a game item by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Game Items"
+                ],
+                "summary": "Update game item",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Game item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated game item data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreateUpdateGameItem"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Game item updated"
+                    },
+                    "400": {
+                        "description": "Bad request - missed request fields",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Admin archives a game item by ID. Archived item is hidden from catalog but stays in inventories",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Game Items"
+                ],
+                "summary": "Archive game item",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Game item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Game item archived"
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - no such game item",
+                        "schema": {
+                            "$ref": "#/definitions/examples.GameItemNotFound"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/items/{id}/grants": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Admin grants a game item to a list of users, to users matching a filter or to every online user in background. Grants are idempotent per (user, item, campaign)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inventory Items"
+                ],
+                "summary": "Bulk grant item",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Game item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Grant recipients",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.BulkGrantInventoryItem"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Started grant job",
+                        "schema": {
+                            "$ref": "#/definitions/examples.GrantJobSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID or recipients",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - item not found",
+                        "schema": {
+                            "$ref": "#/definitions/examples.GameItemNotFound"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/items/{id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Admin restores an archived game item back to catalog",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Game Items"
+                ],
+                "summary": "Restore game item",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Game item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Game item restored"
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - no such game item",
+                        "schema": {
+                            "$ref": "#/definitions/examples.GameItemNotFound"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/permissions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns all permissions which can be included into roles",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roles"
+                ],
+                "summary": "List permissions",
+                "responses": {
+                    "200": {
+                        "description": "Permissions",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/roles": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns all roles with their permissions",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roles"
+                ],
+                "summary": "List roles",
+                "responses": {
+                    "200": {
+                        "description": "Roles",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.RoleDTO"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates role from permissions. Only permissions the performer has can be included",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roles"
+                ],
+                "summary": "Create role",
+                "parameters": [
+                    {
+                        "description": "Role",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.SaveRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Role successfully created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.RoleDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - missed request fields or unknown permission",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - role includes permissions the performer doesn't have",
+                        "schema": {
+                            "$ref": "#/definitions/examples.GrantingMissingPermissionsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - role name is reserved for legacy access level",
+                        "schema": {
+                            "$ref": "#/definitions/examples.RoleNameReservedResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/roles/{role_id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces role name, description and permissions. Changes apply to all users with the role",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roles"
+                ],
+                "summary": "Update role",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Role ID",
+                        "name": "role_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Role",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.SaveRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Role successfully updated",
+                        "schema": {
+                            "$ref": "#/definitions/dto.RoleDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - missed request fields or unknown permission",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - system role can't be changed",
+                        "schema": {
+                            "$ref": "#/definitions/examples.SystemRoleImmutableResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - role not found",
+                        "schema": {
+                            "$ref": "#/definitions/examples.RoleNotFoundResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - role name is reserved for legacy access level",
+                        "schema": {
+                            "$ref": "#/definitions/examples.RoleNameReservedResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes role, its users lose role permissions",
+                "tags": [
+                    "Roles"
+                ],
+                "summary": "Delete role",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Role ID",
+                        "name": "role_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Role successfully deleted"
+                    },
+                    "403": {
+                        "description": "Forbidden - system role can't be deleted",
+                        "schema": {
+                            "$ref": "#/definitions/examples.SystemRoleImmutableResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - role not found",
+                        "schema": {
+                            "$ref": "#/definitions/examples.RoleNotFoundResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/sanctions/appeals": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns sanctions with pending appeals, oldest appeal first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sanctions"
+                ],
+                "summary": "List pending appeals",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default: 10)",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Paginated list of appealed sanctions",
+                        "schema": {
+                            "$ref": "#/definitions/examples.PaginatedSanctionsDTOResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/sanctions/{sanction_id}/appeal/resolve": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Accepted appeal revokes the sanction, if it is the latest of its type the previous level is restored and the block is removed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sanctions"
+                ],
+                "summary": "Resolve sanction appeal",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Sanction ID",
+                        "name": "sanction_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Decision and comment",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ResolveSanctionAppealRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Appeal successfully resolved",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SanctionDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - no such sanction",
+                        "schema": {
+                            "$ref": "#/definitions/examples.SanctionNotFoundResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - sanction has no pending appeal",
+                        "schema": {
+                            "$ref": "#/definitions/examples.SanctionAppealNotPendingResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/sanctions/{sanction_id}/lift": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Ends the block of the latest active sanction early. The sanction is still counted for escalation",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sanctions"
+                ],
+                "summary": "Lift sanction",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Sanction ID",
+                        "name": "sanction_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Comment",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.LiftSanctionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Sanction successfully lifted",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SanctionDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - no such sanction",
+                        "schema": {
+                            "$ref": "#/definitions/examples.SanctionNotFoundResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - a newer sanction of this type exists",
+                        "schema": {
+                            "$ref": "#/definitions/examples.SanctionNotLatestResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/seasons": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Admin creates a battle pass season with reward tiers on free and premium tracks",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Seasons"
+                ],
+                "summary": "Create season",
+                "parameters": [
+                    {
+                        "description": "Season data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreateSeason"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Created season",
+                        "schema": {
+                            "$ref": "#/definitions/examples.SeasonSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid dates or tiers",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - reward item not found",
+                        "schema": {
+                            "$ref": "#/definitions/examples.GameItemNotFound"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - season already exists or overlaps",
+                        "schema": {
+                            "$ref": "#/definitions/examples.SeasonConflictResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/seasons/current": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APITokenAuth": []
+                    }
+                ],
+                "description": "Returns the active battle pass season with its reward tiers",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Seasons"
+                ],
+                "summary": "Get current season",
+                "responses": {
+                    "200": {
+                        "description": "Current season",
+                        "schema": {
+                            "$ref": "#/definitions/examples.SeasonSuccessResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - no active season",
+                        "schema": {
+                            "$ref": "#/definitions/examples.SeasonNotFoundResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/collections": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APITokenAuth": []
+                    }
+                ],
+                "description": "Returns every collection with owned/total counts and missing items for the currently authenticated user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Collections"
+                ],
+                "summary": "Get current user's collections progress",
+                "responses": {
+                    "200": {
+                        "description": "Collections progress",
+                        "schema": {
+                            "$ref": "#/definitions/examples.CollectionProgressSuccessResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/inventory": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APITokenAuth": []
+                    }
+                ],
+                "description": "Returns paginated inventory items with per rarity and collection summary for the currently authenticated user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inventory Items"
+                ],
+                "summary": "Get current user's inventory",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default: 10)",
+                        "name": "size",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "obtained_at",
+                            "rarity",
+                            "name"
+                        ],
+                        "type": "string",
+                        "description": "Field to sort by",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by collection",
+                        "name": "collection",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by item type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by item rarity",
+                        "name": "rarity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by obtain time (RFC 3339)",
+                        "name": "obtained_after",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Paginated list of user's inventory items",
+                        "schema": {
+                            "$ref": "#/definitions/examples.PaginatedInventoryItemsDTOResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid query params",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/me/inventory/dismantle": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Converts up to 100 inventory items of the authenticated user into coins by their rarity. Nothing is dismantled if any item is missing or showcased in profile",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inventory Items"
+                ],
+                "summary": "Dismantle inventory items",
+                "parameters": [
+                    {
+                        "description": "Inventory item IDs",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.DismantleInventoryItems"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Dismantled items and credited coins",
+                        "schema": {
+                            "$ref": "#/definitions/examples.DismantleSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - inventory item not found",
+                        "schema": {
+                            "$ref": "#/definitions/examples.InventoryItemNotFoundResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - item is showcased in profile",
+                        "schema": {
+                            "$ref": "#/definitions/examples.DismantleCurrentItemResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/me/inventory/set_item": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sets a specific inventory item as current for the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inventory Items"
+                ],
+                "summary": "Set inventory item as current",
+                "parameters": [
+                    {
+                        "description": "Inventory item details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.SetItemAsCurrent"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Item successfully set as current"
+                    },
+                    "400": {
+                        "description": "Bad request - invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - inventory item not found",
+                        "schema": {
+                            "$ref": "#/definitions/examples.InventoryItemNotFoundResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/me/inventory/{item_id}/dismantle": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Converts an inventory item of the authenticated user into coins by its rarity. Item showcased in profile can't be dismantled",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inventory Items"
+                ],
+                "summary": "Dismantle inventory item",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Inventory item ID",
+                        "name": "item_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Dismantled items and credited coins",
+                        "schema": {
+                            "$ref": "#/definitions/examples.DismantleSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - inventory item not found",
+                        "schema": {
+                            "$ref": "#/definitions/examples.InventoryItemNotFoundResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - item is showcased in profile",
+                        "schema": {
+                            "$ref": "#/definitions/examples.DismantleCurrentItemResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/season_pass": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APITokenAuth": []
+                    }
+                ],
+                "description": "Returns XP, reached level, premium status and claimed rewards in the active season",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Seasons"
+                ],
+                "summary": "Get current user's season pass",
+                "responses": {
+                    "200": {
+                        "description": "Season pass",
+                        "schema": {
+                            "$ref": "#/definitions/examples.SeasonPassSuccessResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - no active season",
+                        "schema": {
+                            "$ref": "#/definitions/examples.SeasonNotFoundResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/season_pass/claim": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Claims reward of a reached tier on free or premium track. Repeated claims return the existing claim",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Seasons"
+                ],
+                "summary": "Claim season reward",
+                "parameters": [
+                    {
+                        "description": "Tier level and track",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ClaimSeasonReward"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Claimed reward",
+                        "schema": {
+                            "$ref": "#/definitions/examples.SeasonRewardClaimSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - tier has no reward on this track",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - tier not reached or premium track locked",
+                        "schema": {
+                            "$ref": "#/definitions/examples.SeasonTierLockedResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - no active season or tier",
+                        "schema": {
+                            "$ref": "#/definitions/examples.SeasonNotFoundResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/season_pass/premium": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Unlocks premium track of the active season, season premium price is charged from user balance",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Seasons"
+                ],
+                "summary": "Purchase premium track",
+                "responses": {
+                    "200": {
+                        "description": "Season pass",
+                        "schema": {
+                            "$ref": "#/definitions/examples.SeasonPassSuccessResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - no active season",
+                        "schema": {
+                            "$ref": "#/definitions/examples.SeasonNotFoundResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - already premium or insufficient coins",
+                        "schema": {
+                            "$ref": "#/definitions/examples.SeasonConflictResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/{user_id}/collections": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Admin retrieves every collection with owned/total counts and missing items for a specified user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Collections"
+                ],
+                "summary": "Get user's collections progress",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "UserDTO ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Collections progress",
+                        "schema": {
+                            "$ref": "#/definitions/examples.CollectionProgressSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/{user_id}/email": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sets user email without confirmation codes and cooldown. The change is recorded in email history with performer and reason",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Account"
+                ],
+                "summary": "Change user email",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "UserDTO ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New email and reason",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ChangeEmailByAdminRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Email successfully changed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - missed request fields",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - user not found",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UserNotFoundResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - someone already has this email as linked",
+                        "schema": {
+                            "$ref": "#/definitions/examples.EmailConflict"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests - received too many requests",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TooManyRequestsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/{user_id}/email/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns links, changes and unlinks of user email, most recent first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Account"
+                ],
+                "summary": "Get user email history",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "UserDTO ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Email history",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.EmailHistoryDTO"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests - received too many requests",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TooManyRequestsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/{user_id}/hardware_id/ban": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Bans hardware id bound to the user. Sessions of all accounts bound to this hardware id are ended",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Hardware ID"
+                ],
+                "summary": "Ban user hardware id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "UserDTO ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Ban reason",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.BanUserHardwareIDRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Hardware id successfully banned",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BanHardwareIDResultDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - user not found",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UserNotFoundResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - hardware id is already banned",
+                        "schema": {
+                            "$ref": "#/definitions/examples.HardwareIDAlreadyBannedResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/{user_id}/hardware_id/reset": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Clears user hardware id, so the next login binds the account to a new device. Ends all user sessions and notifies user by email",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Hardware ID"
+                ],
+                "summary": "Reset user hardware id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "UserDTO ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reset reason",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ResetHardwareIDRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Hardware id successfully reset",
+                        "schema": {
+                            "$ref": "#/definitions/dto.HardwareIDResetDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - user not found",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UserNotFoundResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - user has no bound hardware id",
+                        "schema": {
+                            "$ref": "#/definitions/examples.HardwareIDNotBoundResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests - received too many requests",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TooManyRequestsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/{user_id}/inventory": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Admin retrieves paginated inventory items with per rarity and collection summary for a specified user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inventory Items"
+                ],
+                "summary": "Get user's inventory",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "UserDTO ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default: 10)",
+                        "name": "size",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "obtained_at",
+                            "rarity",
+                            "name"
+                        ],
+                        "type": "string",
+                        "description": "Field to sort by",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by collection",
+                        "name": "collection",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by item type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by item rarity",
+                        "name": "rarity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by obtain time (RFC 3339)",
+                        "name": "obtained_after",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Paginated list of user's inventory items",
+                        "schema": {
+                            "$ref": "#/definitions/examples.PaginatedInventoryItemsDTOResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID or query params",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - user not found",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UserNotFoundResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/{user_id}/inventory/{item_id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Admin grants a game item to a specific user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inventory Items"
+                ],
+                "summary": "Grant item to user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "UserDTO ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Item ID",
+                        "name": "item_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Granted inventory item",
+                        "schema": {
+                            "$ref": "#/definitions/examples.InventoryItemDTOSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - item not found",
+                        "schema": {
+                            "$ref": "#/definitions/examples.GameItemNotFound"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Admin revokes a game item from a specific user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inventory Items"
+                ],
+                "summary": "Revoke item from user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "UserDTO ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Item ID",
+                        "name": "item_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Item successfully revoked"
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - inventory item not found",
+                        "schema": {
+                            "$ref": "#/definitions/examples.InventoryItemNotFoundResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/{user_id}/login_history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns successful and failed logins to the user account, most recent first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Account"
+                ],
+                "summary": "Get user login history",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "UserDTO ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default: 10)",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Paginated login history",
+                        "schema": {
+                            "$ref": "#/definitions/examples.PaginatedLoginHistoryDTOResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests - received too many requests",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TooManyRequestsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/{user_id}/roles": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns roles assigned to the user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roles"
+                ],
+                "summary": "Get user roles",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "UserDTO ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User roles",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.RoleDTO"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - user not found",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UserNotFoundResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces roles assigned to the user. Performer must have all permissions of assigned and removed roles",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roles"
+                ],
+                "summary": "Set user roles",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "UserDTO ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Role IDs",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.SetUserRolesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Assigned roles",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.RoleDTO"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - missed request fields",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - roles include permissions the performer doesn't have",
+                        "schema": {
+                            "$ref": "#/definitions/examples.GrantingMissingPermissionsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - role not found",
+                        "schema": {
+                            "$ref": "#/definitions/examples.RoleNotFoundResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/{user_id}/sanctions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns all sanctions of the user, most recent first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sanctions"
+                ],
+                "summary": "Get user sanctions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "UserDTO ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Sanction history",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.SanctionDTO"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Issues sanction of the next ladder level. Level is escalated while the previous sanction of the same type is counted. User is notified by websocket and email, blocked account is disconnected",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sanctions"
+                ],
+                "summary": "Issue sanction",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "UserDTO ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Sanction type and reason",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.IssueSanctionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Sanction successfully issued",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SanctionDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid sanction type",
+                        "schema": {
+                            "$ref": "#/definitions/examples.BadRequestResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - user not found",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UserNotFoundResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - another sanction was issued concurrently",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UserSanctionsChangedConcurrentlyResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable entity - invalid request types",
+                        "schema": {
+                            "$ref": "#/definitions/examples.UnprocessableEntityResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/{user_id}/username/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns username changes of the user with spent coins, most recent first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Account"
+                ],
+                "summary": "Get user username history",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "UserDTO ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Username history",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.UsernameHistoryDTO"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - not enough rights",
+                        "schema": {
+                            "$ref": "#/definitions/examples.ForbiddenByPermissionsResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests - received too many requests",
+                        "schema": {
+                            "$ref": "#/definitions/examples.TooManyRequestsResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "apiscope.Scope": {
+            "type": "string",
+            "enum": [
+                "read:matches",
+                "read:profile"
+            ],
+            "x-enum-varnames": [
+                "ReadMatches",
+                "ReadProfile"
+            ]
+        },
+        "domainservice.AuthenticationResult": {
+            "type": "object",
+            "properties": {
+                "online_count": {
+                    "type": "integer"
+                },
+                "refresh_token": {
+                    "type": "string",
+                    "example": "q3Jx9vYQm0Zt8r7y0cHh1n2b3v4c5x6z7a8s9d0f1g2"
+                },
+                "token": {
+                    "type": "string",
+                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                },
+                "user": {
+                    "$ref": "#/definitions/dto.UserFullDTO"
+                }
+            }
+        },
+        "domainservice.OIDCLoginResult": {
+            "type": "object",
+            "properties": {
+                "authentication": {
+                    "$ref": "#/definitions/domainservice.AuthenticationResult"
+                },
+                "registration": {
+                    "$ref": "#/definitions/dto.OIDCRegistrationDTO"
+                }
+            }
+        },
+        "dto.APITokenDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "tournament bot"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/apiscope.Scope"
+                    },
+                    "example": [
+                        "read:matches"
+                    ]
+                },
+                "token_prefix": {
+                    "type": "string",
+                    "example": "abl_Xq3k9Z"
+                }
+            }
+        },
+        "dto.AccountDeletionDTO": {
+            "type": "object",
+            "properties": {
+                "scheduled_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ArchivedGameItemDTO": {
+            "type": "object",
+            "properties": {
+                "archived_at": {
+                    "type": "string"
+                },
+                "collection": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "owners_count": {
+                    "type": "integer"
+                },
+                "rarity": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.AuditAction": {
+            "type": "string",
+            "enum": [
+                "game_item.create",
+                "game_item.update",
+                "game_item.archive",
+                "game_item.restore",
+                "inventory_item.grant",
+                "inventory_item.revoke",
+                "grant_job.start",
+                "collection_reward.set",
+                "season.create",
+                "user.email_change",
+                "sanction.issue",
+                "sanction.lift",
+                "sanction.appeal_resolve",
+                "hardware_id.reset",
+                "hardware_id.reset_approve",
+                "hardware_id.reset_reject",
+                "hardware_id.ban",
+                "hardware_id.unban",
+                "role.create",
+                "role.update",
+                "role.delete",
+                "user.roles_set"
+            ],
+            "x-enum-varnames": [
+                "AuditActionGameItemCreate",
+                "AuditActionGameItemUpdate",
+                "AuditActionGameItemArchive",
+                "AuditActionGameItemRestore",
+                "AuditActionInventoryItemGrant",
+                "AuditActionInventoryItemRevoke",
+                "AuditActionGrantJobStart",
+                "AuditActionCollectionRewardSet",
+                "AuditActionSeasonCreate",
+                "AuditActionUserEmailChange",
+                "AuditActionSanctionIssue",
+                "AuditActionSanctionLift",
+                "AuditActionSanctionAppealResolve",
+                "AuditActionHardwareIDReset",
+                "AuditActionHardwareIDResetApprove",
+                "AuditActionHardwareIDResetReject",
+                "AuditActionHardwareIDBan",
+                "AuditActionHardwareIDUnban",
+                "AuditActionRoleCreate",
+                "AuditActionRoleUpdate",
+                "AuditActionRoleDelete",
+                "AuditActionUserRolesSet"
+            ]
+        },
+        "dto.AuditLogDTO": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "$ref": "#/definitions/dto.AuditAction"
+                },
+                "actor_id": {
+                    "type": "integer"
+                },
+                "actor_username": {
+                    "type": "string"
+                },
+                "after": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "before": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "ip": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "target_id": {
+                    "type": "string"
+                },
+                "target_type": {
+                    "$ref": "#/definitions/dto.AuditTargetType"
+                }
+            }
+        },
+        "dto.AuditTargetType": {
+            "type": "string",
+            "enum": [
+                "game_item",
+                "inventory_item",
+                "grant_job",
+                "collection_reward",
+                "season",
+                "user",
+                "sanction",
+                "hardware_id_reset",
+                "banned_hardware_id",
+                "role"
+            ],
+            "x-enum-varnames": [
+                "AuditTargetGameItem",
+                "AuditTargetInventoryItem",
+                "AuditTargetGrantJob",
+                "AuditTargetCollectionReward",
+                "AuditTargetSeason",
+                "AuditTargetUser",
+                "AuditTargetSanction",
+                "AuditTargetHardwareIDReset",
+                "AuditTargetBannedHardwareID",
+                "AuditTargetRole"
+            ]
+        },
+        "dto.BanHardwareIDResultDTO": {
+            "type": "object",
+            "properties": {
+                "ban": {
+                    "$ref": "#/definitions/dto.BannedHardwareID"
+                },
+                "kicked_user_ids": {
+                    "description": "KickedUserIDs are accounts bound to the banned hardware id, their sessions were ended",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "dto.BannedHardwareID": {
+            "type": "object",
+            "properties": {
+                "ban_reason": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "hardware_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "performer_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.CollectionProgressDTO": {
+            "type": "object",
+            "properties": {
+                "collection": {
+                    "type": "string"
+                },
+                "completed": {
+                    "type": "boolean"
+                },
+                "missing_items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.GameItemDTO"
+                    }
+                },
+                "owned": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.CollectionRewardDTO": {
+            "type": "object",
+            "properties": {
+                "badge_item_id": {
+                    "type": "integer"
+                },
+                "coins": {
+                    "type": "number"
+                },
+                "collection": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.DismantleResultDTO": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "number"
+                },
+                "coins": {
+                    "type": "number"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.InventoryItemDTO"
+                    }
+                }
+            }
+        },
+        "dto.EmailHistoryAction": {
+            "type": "string",
+            "enum": [
+                "linked",
+                "changed",
+                "unlinked"
+            ],
+            "x-enum-varnames": [
+                "EmailLinked",
+                "EmailChanged",
+                "EmailUnlinked"
+            ]
+        },
+        "dto.EmailHistoryDTO": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "$ref": "#/definitions/dto.EmailHistoryAction"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "new_email": {
+                    "type": "string"
+                },
+                "old_email": {
+                    "type": "string"
+                },
+                "performer_id": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ExternalIdentityDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "intezya@gmail.com"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_login_at": {
+                    "type": "string"
+                },
+                "provider": {
+                    "type": "string",
+                    "example": "google"
+                }
+            }
+        },
+        "dto.FriendDTO": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "intezya"
+                }
+            }
+        },
+        "dto.GameItemDTO": {
+            "type": "object",
+            "properties": {
+                "archived_at": {
+                    "type": "string"
+                },
+                "collection": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "rarity": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.GrantJobDTO": {
+            "type": "object",
+            "properties": {
+                "campaign": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by_id": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "finished_at": {
+                    "type": "string"
+                },
+                "granted": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "item_id": {
+                    "type": "integer"
+                },
+                "processed": {
+                    "type": "integer"
+                },
+                "skipped": {
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/dto.GrantJobStatus"
+                },
+                "target": {
+                    "$ref": "#/definitions/dto.GrantJobTarget"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.GrantJobStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "running",
+                "completed",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "GrantJobStatusPending",
+                "GrantJobStatusRunning",
+                "GrantJobStatusCompleted",
+                "GrantJobStatusFailed"
+            ]
+        },
+        "dto.GrantJobTarget": {
+            "type": "string",
+            "enum": [
+                "users",
+                "filter",
+                "online"
+            ],
+            "x-enum-varnames": [
+                "GrantJobTargetUsers",
+                "GrantJobTargetFilter",
+                "GrantJobTargetOnline"
+            ]
+        },
+        "dto.HardwareIDResetDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "operator_comment": {
+                    "type": "string"
+                },
+                "operator_id": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "resolved_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/dto.HardwareIDResetStatus"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.HardwareIDResetStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "approved",
+                "rejected"
+            ],
+            "x-enum-varnames": [
+                "HardwareIDResetPending",
+                "HardwareIDResetApproved",
+                "HardwareIDResetRejected"
+            ]
+        },
+        "dto.InventoryItemDTO": {
+            "type": "object",
+            "properties": {
+                "collection": {
+                    "type": "string"
+                },
+                "game_item_id": {
+                    "description": "Edges",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "obtained_at": {
+                    "type": "string"
+                },
+                "rarity": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.InventorySummaryDTO": {
+            "type": "object",
+            "properties": {
+                "by_collection": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "by_rarity": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "dto.IssuedAPITokenDTO": {
+            "type": "object",
+            "properties": {
+                "api_token": {
+                    "$ref": "#/definitions/dto.APITokenDTO"
+                },
+                "token": {
+                    "type": "string",
+                    "example": "abl_Xq3k9Zt0cW1o2m9Hs7Zp4AJb0p0tWm3qK8ZyQe2Xh1vA"
+                }
+            }
+        },
+        "dto.JSONWebKeyDTO": {
+            "type": "object",
+            "properties": {
+                "alg": {
+                    "type": "string",
+                    "example": "EdDSA"
+                },
+                "crv": {
+                    "type": "string",
+                    "example": "Ed25519"
+                },
+                "e": {
+                    "type": "string"
+                },
+                "kid": {
+                    "type": "string",
+                    "example": "1"
+                },
+                "kty": {
+                    "type": "string",
+                    "example": "OKP"
+                },
+                "n": {
+                    "type": "string"
+                },
+                "use": {
+                    "type": "string",
+                    "example": "sig"
+                },
+                "x": {
+                    "type": "string",
+                    "example": "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"
+                }
+            }
+        },
+        "dto.JSONWebKeySetDTO": {
+            "type": "object",
+            "properties": {
+                "keys": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.JSONWebKeyDTO"
+                    }
+                }
+            }
+        },
+        "dto.LinkedIdentifiersDTO": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "intezya@gmail.com"
+                },
+                "external_identities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ExternalIdentityDTO"
+                    }
+                },
+                "genshin_uid": {
+                    "type": "string",
+                    "example": "700000001"
+                },
+                "hardware_fingerprint": {
+                    "type": "string",
+                    "example": "9f86d081884c7d65"
+                },
+                "hoyolab_login": {
+                    "type": "string",
+                    "example": "intezya"
+                }
+            }
+        },
+        "dto.LoginHistoryDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "hardware_fingerprint": {
+                    "type": "string",
+                    "example": "9f86d081884c7d65"
+                },
+                "hardware_id_match": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "ip": {
+                    "type": "string",
+                    "example": "203.0.113.7"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "user_agent": {
+                    "type": "string",
+                    "example": "AbyssLeagueClient/1.4.2"
+                }
+            }
+        },
+        "dto.MatchDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "player1_penalty_time": {
+                    "type": "integer"
+                },
+                "player1_username": {
+                    "type": "string",
+                    "example": "intezya"
+                },
+                "player2_penalty_time": {
+                    "type": "integer"
+                },
+                "player2_username": {
+                    "type": "string",
+                    "example": "deleted_42"
+                },
+                "result": {
+                    "type": "string",
+                    "example": "player1_win"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PlayerMatchResultDTO"
+                    }
+                },
+                "status": {
+                    "type": "string",
+                    "example": "finished"
+                }
+            }
+        },
+        "dto.OIDCAuthorizationDTO": {
+            "type": "object",
+            "properties": {
+                "authorization_url": {
+                    "type": "string",
+                    "example": "https://accounts.google.com/o/oauth2/v2/auth?response_type=code\u0026..."
+                },
+                "state": {
+                    "type": "string",
+                    "example": "f3Yk1tq0cW1o2m9Hs7Zp4A"
+                }
+            }
+        },
+        "dto.OIDCRegistrationDTO": {
+            "type": "object",
+            "properties": {
+                "registration_token": {
+                    "type": "string",
+                    "example": "Jb0p0tWm3qK8ZyQe2Xh1vA"
+                },
+                "suggested_username": {
+                    "type": "string",
+                    "example": "intezya"
+                }
+            }
+        },
+        "dto.PersonalDataDTO": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "number",
+                    "example": 1200
+                },
+                "email_history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.EmailHistoryDTO"
+                    }
+                },
+                "exported_at": {
+                    "type": "string"
+                },
+                "friends": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.FriendDTO"
+                    }
+                },
+                "inventory": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.InventoryItemDTO"
+                    }
+                },
+                "linked_identifiers": {
+                    "$ref": "#/definitions/dto.LinkedIdentifiersDTO"
+                },
+                "login_history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.LoginHistoryDTO"
+                    }
+                },
+                "matches": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.MatchDTO"
+                    }
+                },
+                "profile": {
+                    "$ref": "#/definitions/dto.UserDTO"
+                },
+                "statistics": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.StatisticDTO"
+                    }
+                },
+                "username_history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.UsernameHistoryDTO"
+                    }
+                }
+            }
+        },
+        "dto.PlayerMatchResultDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "is_retried": {
+                    "type": "boolean"
+                },
+                "player_username": {
+                    "type": "string",
+                    "example": "intezya"
+                },
+                "score": {
+                    "type": "integer",
+                    "example": 420
+                }
+            }
+        },
+        "dto.RecoveryCodesDTO": {
+            "type": "object",
+            "properties": {
+                "codes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "7KD0PAQ2JV"
+                    ]
+                }
+            }
+        },
+        "dto.RoleDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/permission.Permission"
+                    }
+                },
+                "system": {
+                    "type": "boolean"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.SanctionAppealStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "accepted",
+                "rejected"
+            ],
+            "x-enum-varnames": [
+                "SanctionAppealPending",
+                "SanctionAppealAccepted",
+                "SanctionAppealRejected"
+            ]
+        },
+        "dto.SanctionDTO": {
+            "type": "object",
+            "properties": {
+                "appeal_message": {
+                    "type": "string"
+                },
+                "appeal_status": {
+                    "$ref": "#/definitions/dto.SanctionAppealStatus"
+                },
+                "appealed_at": {
+                    "type": "string"
+                },
+                "blocked_until": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "level_name": {
+                    "type": "string"
+                },
+                "performer_id": {
+                    "type": "integer"
+                },
+                "previous_level": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "resolution_comment": {
+                    "type": "string"
+                },
+                "resolved_at": {
+                    "type": "string"
+                },
+                "resolver_id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/dto.SanctionStatus"
+                },
+                "type": {
+                    "$ref": "#/definitions/dto.SanctionType"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.SanctionStatus": {
+            "type": "string",
+            "enum": [
+                "active",
+                "lifted",
+                "revoked"
+            ],
+            "x-enum-varnames": [
+                "SanctionActive",
+                "SanctionLifted",
+                "SanctionRevoked"
+            ]
+        },
+        "dto.SanctionType": {
+            "type": "string",
+            "enum": [
+                "account",
+                "search"
+            ],
+            "x-enum-varnames": [
+                "SanctionAccount",
+                "SanctionSearch"
+            ]
+        },
+        "dto.SeasonDTO": {
+            "type": "object",
+            "properties": {
+                "ends_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "integer"
+                },
+                "premium_price": {
+                    "type": "number"
+                },
+                "rolled_over_at": {
+                    "type": "string"
+                },
+                "starts_at": {
+                    "type": "string"
+                },
+                "tiers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SeasonTierDTO"
+                    }
+                }
+            }
+        },
+        "dto.SeasonPassDTO": {
+            "type": "object",
+            "properties": {
+                "claims": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SeasonRewardClaimDTO"
+                    }
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "premium": {
+                    "type": "boolean"
+                },
+                "premium_purchased_at": {
+                    "type": "string"
+                },
+                "season": {
+                    "$ref": "#/definitions/dto.SeasonDTO"
+                },
+                "xp": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.SeasonRewardClaimDTO": {
+            "type": "object",
+            "properties": {
+                "automatic": {
+                    "type": "boolean"
+                },
+                "claimed_at": {
+                    "type": "string"
+                },
+                "coins": {
+                    "type": "number"
+                },
+                "item": {
+                    "$ref": "#/definitions/dto.InventoryItemDTO"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "season_id": {
+                    "type": "integer"
+                },
+                "track": {
+                    "$ref": "#/definitions/seasonentity.Track"
+                }
+            }
+        },
+        "dto.SeasonTierDTO": {
+            "type": "object",
+            "properties": {
+                "free_coins": {
+                    "type": "number"
+                },
+                "free_item_id": {
+                    "type": "integer"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "premium_coins": {
+                    "type": "number"
+                },
+                "premium_item_id": {
+                    "type": "integer"
+                },
+                "xp_required": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.SessionDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "current": {
+                    "type": "boolean"
+                },
+                "hardware_fingerprint": {
+                    "type": "string",
+                    "example": "9f86d081884c7d65"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "2f1b8a4e-7c1d-4c55-9d0e-6a3b5f1c2d7e"
+                },
+                "ip": {
+                    "type": "string",
+                    "example": "203.0.113.7"
+                },
+                "last_activity_at": {
+                    "type": "string"
+                },
+                "user_agent": {
+                    "type": "string",
+                    "example": "AbyssLeagueClient/1.4.2"
+                }
+            }
+        },
+        "dto.StatisticDTO": {
+            "type": "object",
+            "properties": {
+                "best_match_time": {
+                    "type": "integer"
+                },
+                "best_result_time": {
+                    "type": "integer"
+                },
+                "best_retry_count": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "draws_count": {
+                    "type": "integer"
+                },
+                "loses_count": {
+                    "type": "integer"
+                },
+                "match_count": {
+                    "type": "integer"
+                },
+                "max_login_streak": {
+                    "type": "integer"
+                },
+                "max_lose_streak": {
+                    "type": "integer"
+                },
+                "max_win_streak": {
+                    "type": "integer"
+                },
+                "period": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "result_time": {
+                    "type": "integer"
+                },
+                "retry_count": {
+                    "type": "integer"
+                },
+                "retry_time": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "season"
+                },
+                "wins_count": {
+                    "type": "integer"
+                },
+                "worst_match_time": {
+                    "type": "integer"
+                },
+                "worst_result_time": {
+                    "type": "integer"
+                },
+                "worst_retry_count": {
+                    "type": "integer"
+                },
+                "xp": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.TOTPEnrollmentDTO": {
+            "type": "object",
+            "properties": {
+                "provisioning_uri": {
+                    "type": "string",
+                    "example": "otpauth://totp/AbyssLeague:intezya?algorithm=SHA1\u0026digits=6\u0026issuer=AbyssLeague\u0026period=30\u0026secret=JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"
+                },
+                "secret": {
+                    "type": "string",
+                    "example": "JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"
+                }
+            }
+        },
+        "dto.UserDTO": {
+            "type": "object",
+            "properties": {
+                "avatar_url": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deletion_scheduled_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "genshin_uid": {
+                    "type": "string"
+                },
+                "hoyolab_login": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "invites_enabled": {
+                    "type": "boolean"
+                },
+                "login_streak": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.UserFullDTO": {
+            "type": "object",
+            "properties": {
+                "avatar_url": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "current_item": {
+                    "$ref": "#/definitions/dto.InventoryItemDTO"
+                },
+                "deletion_scheduled_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "friends": {
+                    "description": "Statistics []*Statistic ` + "`" + `json:\"statistics\"` + "`" + `",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.UserDTO"
+                    }
+                },
+                "genshin_uid": {
+                    "type": "string"
+                },
+                "hoyolab_login": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "invites_enabled": {
+                    "type": "boolean"
+                },
+                "items": {
+                    "description": "SentFriendRequests []*FriendRequest ` + "`" + `json:\"sent_friend_requests\"` + "`" + `\nReceivedFriendRequests []*FriendRequest ` + "`" + `json:\"received_friend_requests\"` + "`" + `",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.InventoryItemDTO"
+                    }
+                },
+                "login_streak": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.UsernameChangedDTO": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "coins_spent": {
+                    "type": "number",
+                    "example": 500
+                },
+                "user": {
+                    "$ref": "#/definitions/dto.UserDTO"
+                }
+            }
+        },
+        "dto.UsernameHistoryDTO": {
+            "type": "object",
+            "properties": {
+                "coins_spent": {
+                    "type": "number",
+                    "example": 500
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "new_username": {
+                    "type": "string",
+                    "example": "intezya_the_legend"
+                },
+                "old_username": {
+                    "type": "string",
+                    "example": "intezya"
+                },
+                "released_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.APITokenLimitReachedResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 409
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "api token limit is reached, revoke unused tokens"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.APITokenNotFoundResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "api token not found"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.APITokensSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.APITokenDTO"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "success"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.AccountAlreadyHasLinkedEmail": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 409
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "account already has linked email"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.AccountDeletionNotScheduled": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 409
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "account deletion is not scheduled"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.AccountDeletionScheduled": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 409
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "account deletion is already scheduled"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.AccountDeletionSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "data": {
+                    "$ref": "#/definitions/dto.AccountDeletionDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "success"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.AccountHasNoLinkedEmail": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 409
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "account has no linked email"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.AuthenticationSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "data": {
+                    "$ref": "#/definitions/domainservice.AuthenticationResult"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "success"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.BadRequestResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "bad request"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.BannedHardwareIDNotFoundResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "banned hardware id not found"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.CollectionProgressSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CollectionProgressDTO"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "success"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.CollectionRewardSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "data": {
+                    "$ref": "#/definitions/dto.CollectionRewardDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "success"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.CollectionRewardsSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CollectionRewardDTO"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "success"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.CreateGameItemDTOSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "data": {
+                    "$ref": "#/definitions/dto.GameItemDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "success"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.DismantleCurrentItemResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 409
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "item showcased in profile can't be dismantled"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.DismantleSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "data": {
+                    "$ref": "#/definitions/dto.DismantleResultDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "success"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.EmailConflict": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 409
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "someone account already has this email"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.ExternalIdentitiesSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ExternalIdentityDTO"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "success"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.ExternalIdentityAlreadyLinkedResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 409
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "identity is already linked to an account"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.ExternalIdentityNotFoundResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "external identity not found"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.ExternalIdentitySuccessResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "data": {
+                    "$ref": "#/definitions/dto.ExternalIdentityDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "success"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.FindGameItemDTOSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "data": {
+                    "$ref": "#/definitions/dto.GameItemDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "success"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.ForbiddenByPermissionsResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 403
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "insufficient permissions"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.GameItemNotFound": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "game item not found"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.GrantJobNotFoundResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "grant job not found"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.GrantJobSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "data": {
+                    "$ref": "#/definitions/dto.GrantJobDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "success"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.GrantingMissingPermissionsResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 403
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "permissions you don't have can't be granted"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.HardwareIDAlreadyBannedResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 409
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "hardware id is already banned"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.HardwareIDConflictResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 409
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "user hardware id conflict"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.HardwareIDNotBoundResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 409
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "account has no bound hardware id"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.HardwareIDResetAlreadyRequestedResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 409
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "hardware id reset is already requested"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.HardwareIDResetRequestNotFoundResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "hardware id reset request not found"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.HardwareIDResetRequestResolvedResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 409
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "hardware id reset request is already resolved"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.InsufficientCoins": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 409
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "insufficient coins"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.InvalidAPITokenLifetimeResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "api token lifetime must be from 1 to 365 days"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "bad request"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.InvalidOIDCStateResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "authorization is expired or started from another device"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "bad request"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.InvalidRefreshTokenResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 401
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "invalid refresh token"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "unauthorized"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.InvalidRegistrationTokenResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "registration token is invalid or expired"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "bad request"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.InvalidUsername": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "username is reserved"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "bad request"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.InventoryItemDTOSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "data": {
+                    "$ref": "#/definitions/dto.InventoryItemDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "success"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.InventoryItemNotFoundResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "item not found"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.IssuedAPITokenSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "data": {
+                    "$ref": "#/definitions/dto.IssuedAPITokenDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "success"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.LoginLockedResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 403
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "try again after 2025-06-01T12:00:00Z"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "account is temporarily locked after too many failed logins"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.NoOtherRecoveryMethod": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 409
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "account has no recovery method except email"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.OIDCAuthorizationFailedResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 401
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "identity provider authorization failed"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "unauthorized"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.OIDCAuthorizationSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "data": {
+                    "$ref": "#/definitions/dto.OIDCAuthorizationDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "success"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.OIDCLoginSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "data": {
+                    "$ref": "#/definitions/domainservice.OIDCLoginResult"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "success"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.OIDCProvidersSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "google",
+                        "discord"
+                    ]
+                },
+                "message": {
+                    "type": "string",
+                    "example": "success"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.PaginatedArchivedGameItemsDTOResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ArchivedGameItemDTO"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "size": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "total_items": {
+                    "type": "integer",
+                    "example": 777
+                },
+                "total_pages": {
+                    "type": "integer",
+                    "example": 78
+                }
+            }
+        },
+        "examples.PaginatedAuditLogDTOResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.AuditLogDTO"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "size": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "total_items": {
+                    "type": "integer",
+                    "example": 777
+                },
+                "total_pages": {
+                    "type": "integer",
+                    "example": 78
+                }
+            }
+        },
+        "examples.PaginatedBannedHardwareIDsDTOResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.BannedHardwareID"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "size": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "total_items": {
+                    "type": "integer",
+                    "example": 777
+                },
+                "total_pages": {
+                    "type": "integer",
+                    "example": 78
+                }
+            }
+        },
+        "examples.PaginatedGameItemsDTOResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.GameItemDTO"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "size": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "total_items": {
+                    "type": "integer",
+                    "example": 777
+                },
+                "total_pages": {
+                    "type": "integer",
+                    "example": 78
+                }
+            }
+        },
+        "examples.PaginatedHardwareIDResetsDTOResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.HardwareIDResetDTO"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "size": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "total_items": {
+                    "type": "integer",
+                    "example": 777
+                },
+                "total_pages": {
+                    "type": "integer",
+                    "example": 78
+                }
+            }
+        },
+        "examples.PaginatedInventoryItemsDTOResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.InventoryItemDTO"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "size": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "summary": {
+                    "$ref": "#/definitions/dto.InventorySummaryDTO"
+                },
+                "total_items": {
+                    "type": "integer",
+                    "example": 777
+                },
+                "total_pages": {
+                    "type": "integer",
+                    "example": 78
+                }
+            }
+        },
+        "examples.PaginatedLoginHistoryDTOResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.LoginHistoryDTO"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "size": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "total_items": {
+                    "type": "integer",
+                    "example": 777
+                },
+                "total_pages": {
+                    "type": "integer",
+                    "example": 78
+                }
+            }
+        },
+        "examples.PaginatedSanctionsDTOResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SanctionDTO"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "size": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "total_items": {
+                    "type": "integer",
+                    "example": 777
+                },
+                "total_pages": {
+                    "type": "integer",
+                    "example": 78
+                }
+            }
+        },
+        "examples.PasswordChangedConcurrently": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 409
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "account password has been changed"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.PasswordRecentlyUsed": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "password has been used recently"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "bad request"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.PersonalDataSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "data": {
+                    "$ref": "#/definitions/dto.PersonalDataDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "success"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.ProviderAlreadyLinkedResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 409
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "account already has linked identity of this provider"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.RefreshTokenReusedResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 401
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "refresh token reuse detected, all sessions of the token are revoked"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "unauthorized"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.RoleAlreadyExistsResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 409
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "role with this name already exists"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.RoleNameReservedResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 409
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "role name is reserved"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.RoleNotFoundResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "role not found"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.SanctionAlreadyAppealedResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 409
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "sanction is already appealed"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.SanctionAppealNotPendingResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 409
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "sanction has no pending appeal"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.SanctionNotActiveResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 409
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "sanction is not active"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.SanctionNotFoundResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "sanction not found"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.SanctionNotLatestResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 409
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "sanction is not the latest of its type"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.SanctionRevokedResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 409
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "sanction is already revoked"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.SeasonConflictResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 409
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "season pass is already premium"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.SeasonNotFoundResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "season not found"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.SeasonPassSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "data": {
+                    "$ref": "#/definitions/dto.SeasonPassDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "success"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.SeasonRewardClaimSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "data": {
+                    "$ref": "#/definitions/dto.SeasonRewardClaimDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "success"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.SeasonSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "data": {
+                    "$ref": "#/definitions/dto.SeasonDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "success"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.SeasonTierLockedResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 403
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "season tier is not reached"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.SessionNotFoundResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "session not found"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.SessionsSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SessionDTO"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "success"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.SystemRoleImmutableResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 403
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "system role can't be changed"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.TooManyLoginFailuresResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 429
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "too many failed logins, try again in 16s"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "too many requests"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.TooManyRequestsResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 429
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "too many requests"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.TwoFactorAlreadyEnabled": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 409
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "two-factor authentication is already enabled"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.TwoFactorEnrollmentNotStarted": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 409
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "two-factor enrollment is not started or expired"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.TwoFactorNotEnabled": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 409
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "two-factor authentication is not enabled"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.TwoFactorRequiredResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 401
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "two-factor authentication code required"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "unauthorized"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.UnknownAPITokenScopeResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "unknown api token scope"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "bad request"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.UnknownExportFormat": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "export format must be json or zip"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "bad request"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.UnknownIdentityProviderResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "unknown identity provider"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "bad request"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.UnprocessableEntityResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 422
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "unprocessable entity"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.UserNotFoundResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "user not found"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.UserSanctionsChangedConcurrentlyResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 409
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "user sanctions have been changed"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.UserWrongHardwareIDResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 401
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "wrong hardware id"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "unauthorized"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.UserWrongPasswordResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 401
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "wrong password"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "unauthorized"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "examples.UsernameChangeCooldown": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 429
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "username has been changed recently, next change is available after 2025-07-01T12:00:00Z"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "too many requests"
+                },
+                "path": {
+                    "type": "string"
                 }
             }
-        }
-    },
-    "definitions": {
-        "domainservice.AuthenticationResult": {
+        },
+        "examples.UsernameChangedSuccessResponse": {
             "type": "object",
             "properties": {
-                "online_count": {
-                    "type": "integer"
+                "code": {
+                    "type": "integer",
+                    "example": 200
                 },
-                "token": {
+                "data": {
+                    "$ref": "#/definitions/dto.UsernameChangedDTO"
+                },
+                "message": {
                     "type": "string",
-                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                    "example": "success"
                 },
-                "user": {
-                    "$ref": "#/definitions/dto.UserFullDTO"
+                "path": {
+                    "type": "string"
                 }
             }
         },
-        "dto.GameItemDTO": {
+        "examples.UsernameConflictResponse": {
             "type": "object",
             "properties": {
-                "collection": {
-                    "type": "string"
+                "code": {
+                    "type": "integer",
+                    "example": 409
                 },
-                "created_at": {
+                "detail": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "integer"
+                "message": {
+                    "type": "string",
+                    "example": "user already exists"
                 },
-                "name": {
+                "path": {
                     "type": "string"
+                }
+            }
+        },
+        "examples.UsernameTaken": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 409
                 },
-                "rarity": {
-                    "type": "integer"
+                "detail": {
+                    "type": "string"
                 },
-                "type": {
-                    "type": "integer"
+                "message": {
+                    "type": "string",
+                    "example": "username is taken"
+                },
+                "path": {
+                    "type": "string"
                 }
             }
         },
-        "dto.InventoryItemDTO": {
+        "examples.WeakPassword": {
             "type": "object",
             "properties": {
-                "collection": {
+                "code": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "password must be at least 10 characters long"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "bad request"
+                },
+                "path": {
                     "type": "string"
+                }
+            }
+        },
+        "examples.WrongTwoFactorCodeResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 401
                 },
-                "game_item_id": {
-                    "description": "Edges",
-                    "type": "integer"
+                "detail": {
+                    "type": "string",
+                    "example": "wrong two-factor authentication code"
                 },
-                "id": {
-                    "type": "integer"
+                "message": {
+                    "type": "string",
+                    "example": "unauthorized"
                 },
-                "name": {
+                "path": {
                     "type": "string"
+                }
+            }
+        },
+        "examples.WrongVerificationCode": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 400
                 },
-                "obtained_at": {
+                "detail": {
+                    "type": "string",
+                    "example": "wrong verification code"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "bad request"
+                },
+                "path": {
                     "type": "string"
+                }
+            }
+        },
+        "permission.Permission": {
+            "type": "string",
+            "enum": [
+                "users:view",
+                "inventory:view",
+                "matches:view",
+                "users:change_email",
+                "sanctions:manage",
+                "seasons:manage",
+                "items:create",
+                "items:give",
+                "items:revoke",
+                "items:update",
+                "items:delete",
+                "hardware_id:reset",
+                "hardware_id:ban",
+                "roles:manage",
+                "audit_log:view"
+            ],
+            "x-enum-varnames": [
+                "ViewUsers",
+                "ViewInventory",
+                "ViewMatches",
+                "ChangeUserEmail",
+                "ManageSanctions",
+                "ManageSeasons",
+                "CreateItem",
+                "GiveItem",
+                "RevokeItem",
+                "UpdateItem",
+                "DeleteItem",
+                "ResetHardwareID",
+                "BanHardwareID",
+                "ManageRoles",
+                "ViewAuditLog"
+            ]
+        },
+        "request.AppealSanctionRequest": {
+            "type": "object",
+            "required": [
+                "message"
+            ],
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "it was my teammate on my PC"
+                }
+            }
+        },
+        "request.AuthenticationRequest": {
+            "type": "object",
+            "required": [
+                "hardware_id",
+                "password",
+                "username"
+            ],
+            "properties": {
+                "hardware_id": {
+                    "type": "string",
+                    "example": "QXV0aGVudGljQU1ENjA3NDA0"
                 },
-                "rarity": {
-                    "type": "integer"
+                "password": {
+                    "type": "string",
+                    "example": "STr0ngP@55w0rD!_"
                 },
-                "type": {
-                    "type": "integer"
+                "two_factor_code": {
+                    "type": "string",
+                    "example": "123456"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "my_legendary_username"
                 }
             }
         },
-        "dto.UserDTO": {
+        "request.BanHardwareIDRequest": {
+            "type": "object",
+            "required": [
+                "hardware_id"
+            ],
+            "properties": {
+                "hardware_id": {
+                    "type": "string",
+                    "example": "6a1f9e2c0b7d4e8f"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "cheating"
+                }
+            }
+        },
+        "request.BanUserHardwareIDRequest": {
             "type": "object",
             "properties": {
-                "avatar_url": {
-                    "type": "string"
+                "reason": {
+                    "type": "string",
+                    "example": "cheating"
+                }
+            }
+        },
+        "request.BulkGrantInventoryItem": {
+            "type": "object",
+            "required": [
+                "campaign",
+                "target"
+            ],
+            "properties": {
+                "campaign": {
+                    "type": "string",
+                    "example": "summer_event_2025"
                 },
-                "created_at": {
-                    "type": "string"
+                "min_search_score": {
+                    "type": "integer",
+                    "example": 1000
+                },
+                "registered_before": {
+                    "description": "Target \"filter\"",
+                    "type": "string",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "target": {
+                    "type": "string",
+                    "enum": [
+                        "users",
+                        "filter",
+                        "online"
+                    ],
+                    "example": "users"
                 },
+                "user_ids": {
+                    "description": "Target \"users\"",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        1,
+                        2,
+                        3
+                    ]
+                }
+            }
+        },
+        "request.ChangeEmailByAdminRequest": {
+            "type": "object",
+            "required": [
+                "email"
+            ],
+            "properties": {
                 "email": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "intezya@proton.me"
                 },
-                "genshin_uid": {
-                    "type": "string"
+                "reason": {
+                    "type": "string",
+                    "example": "user lost access to the old email"
+                }
+            }
+        },
+        "request.ChangeEmailRequest": {
+            "type": "object",
+            "required": [
+                "new_email"
+            ],
+            "properties": {
+                "new_email": {
+                    "type": "string",
+                    "example": "intezya@proton.me"
                 },
-                "hoyolab_login": {
-                    "type": "string"
+                "two_factor_code": {
+                    "type": "string",
+                    "example": "123456"
+                }
+            }
+        },
+        "request.ChangePasswordRequest": {
+            "type": "object",
+            "required": [
+                "new_password",
+                "old_password"
+            ],
+            "properties": {
+                "new_password": {
+                    "type": "string",
+                    "example": "N3wSTr0ngP@55w0rD!_"
                 },
-                "id": {
-                    "type": "integer"
+                "old_password": {
+                    "type": "string",
+                    "example": "STr0ngP@55w0rD!_"
                 },
-                "invites_enabled": {
-                    "type": "boolean"
+                "two_factor_code": {
+                    "type": "string",
+                    "example": "123456"
+                }
+            }
+        },
+        "request.ChangeUsernameRequest": {
+            "type": "object",
+            "required": [
+                "new_username"
+            ],
+            "properties": {
+                "new_username": {
+                    "type": "string",
+                    "example": "intezya_the_legend"
                 },
-                "login_streak": {
-                    "type": "integer"
+                "two_factor_code": {
+                    "type": "string",
+                    "example": "123456"
+                }
+            }
+        },
+        "request.ClaimSeasonReward": {
+            "type": "object",
+            "required": [
+                "level",
+                "track"
+            ],
+            "properties": {
+                "level": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "username": {
-                    "type": "string"
+                "track": {
+                    "type": "string",
+                    "enum": [
+                        "free",
+                        "premium"
+                    ],
+                    "example": "free"
                 }
             }
         },
-        "dto.UserFullDTO": {
+        "request.CreateSeason": {
             "type": "object",
+            "required": [
+                "ends_at",
+                "name",
+                "number",
+                "starts_at",
+                "tiers"
+            ],
             "properties": {
-                "avatar_url": {
-                    "type": "string"
+                "ends_at": {
+                    "type": "string",
+                    "example": "2025-08-01T00:00:00Z"
                 },
-                "created_at": {
-                    "type": "string"
+                "name": {
+                    "type": "string",
+                    "example": "Season of Shadows"
                 },
-                "current_item": {
-                    "$ref": "#/definitions/dto.InventoryItemDTO"
+                "number": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "email": {
-                    "type": "string"
+                "premium_price": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 1000
                 },
-                "friends": {
-                    "description": "Statistics []*Statistic ` + "`" + `json:\"statistics\"` + "`" + `",
+                "starts_at": {
+                    "type": "string",
+                    "example": "2025-06-01T00:00:00Z"
+                },
+                "tiers": {
                     "type": "array",
+                    "minItems": 1,
                     "items": {
-                        "$ref": "#/definitions/dto.UserDTO"
+                        "$ref": "#/definitions/request.SeasonTier"
                     }
+                }
+            }
+        },
+        "request.CreateUpdateGameItem": {
+            "type": "object",
+            "required": [
+                "collection",
+                "name",
+                "rarity",
+                "type"
+            ],
+            "properties": {
+                "collection": {
+                    "type": "string",
+                    "example": "Shadow Sigils"
                 },
-                "genshin_uid": {
-                    "type": "string"
-                },
-                "hoyolab_login": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "integer"
+                "name": {
+                    "type": "string",
+                    "example": "Whirling Mark"
                 },
-                "invites_enabled": {
-                    "type": "boolean"
+                "rarity": {
+                    "type": "integer",
+                    "example": 4
                 },
-                "items": {
-                    "description": "SentFriendRequests []*FriendRequest ` + "`" + `json:\"sent_friend_requests\"` + "`" + `\nReceivedFriendRequests []*FriendRequest ` + "`" + `json:\"received_friend_requests\"` + "`" + `",
+                "type": {
+                    "type": "integer",
+                    "example": 4
+                }
+            }
+        },
+        "request.DismantleInventoryItems": {
+            "type": "object",
+            "required": [
+                "inventory_item_ids"
+            ],
+            "properties": {
+                "inventory_item_ids": {
                     "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
                     "items": {
-                        "$ref": "#/definitions/dto.InventoryItemDTO"
-                    }
-                },
-                "login_streak": {
-                    "type": "integer"
-                },
-                "username": {
-                    "type": "string"
+                        "type": "integer"
+                    },
+                    "example": [
+                        1,
+                        2,
+                        3
+                    ]
                 }
             }
         },
-        "examples.AccountAlreadyHasLinkedEmail": {
+        "request.EnterCodeForEmailLinkRequest": {
             "type": "object",
+            "required": [
+                "verification_code"
+            ],
             "properties": {
-                "code": {
-                    "type": "integer",
-                    "example": 409
+                "verification_code": {
+                    "type": "string",
+                    "example": "Q2JV01"
+                }
+            }
+        },
+        "request.EnterCodeForPasswordResetRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "new_password",
+                "verification_code"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "intezya@gmail.com"
                 },
-                "detail": {
-                    "type": "string"
+                "new_password": {
+                    "type": "string",
+                    "example": "N3wSTr0ngP@55w0rD!_"
                 },
-                "message": {
+                "two_factor_code": {
                     "type": "string",
-                    "example": "account already has linked email"
+                    "example": "123456"
                 },
-                "path": {
-                    "type": "string"
+                "verification_code": {
+                    "type": "string",
+                    "example": "Q2JV01"
                 }
             }
         },
-        "examples.AuthenticationSuccessResponse": {
+        "request.EnterCodesForEmailChangeRequest": {
             "type": "object",
+            "required": [
+                "new_email_code",
+                "old_email_code"
+            ],
             "properties": {
-                "code": {
-                    "type": "integer",
-                    "example": 200
+                "new_email_code": {
+                    "type": "string",
+                    "example": "7KD0PA"
                 },
-                "data": {
-                    "$ref": "#/definitions/domainservice.AuthenticationResult"
+                "old_email_code": {
+                    "type": "string",
+                    "example": "Q2JV01"
+                }
+            }
+        },
+        "request.HardwareIDResetRequestRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "username"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string",
+                    "example": "STr0ngP@55w0rD!_"
                 },
-                "message": {
+                "reason": {
                     "type": "string",
-                    "example": "success"
+                    "example": "changed PC"
                 },
-                "path": {
-                    "type": "string"
+                "two_factor_code": {
+                    "type": "string",
+                    "example": "123456"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "intezya"
                 }
             }
         },
-        "examples.BadRequestResponse": {
+        "request.IssueAPITokenRequest": {
             "type": "object",
+            "required": [
+                "lifetime_days",
+                "name",
+                "password",
+                "scopes"
+            ],
             "properties": {
-                "code": {
+                "lifetime_days": {
                     "type": "integer",
-                    "example": 400
+                    "example": 90
                 },
-                "detail": {
-                    "type": "string"
+                "name": {
+                    "type": "string",
+                    "example": "tournament bot"
                 },
-                "errors": {
+                "password": {
+                    "type": "string",
+                    "example": "STr0ngP@55w0rD!_"
+                },
+                "scopes": {
                     "type": "array",
                     "items": {
-                        "type": "string"
-                    }
+                        "$ref": "#/definitions/apiscope.Scope"
+                    },
+                    "example": [
+                        "read:matches",
+                        "read:profile"
+                    ]
                 },
-                "message": {
+                "two_factor_code": {
                     "type": "string",
-                    "example": "bad request"
-                },
-                "path": {
-                    "type": "string"
+                    "example": "123456"
                 }
             }
         },
-        "examples.CreateGameItemDTOSuccessResponse": {
+        "request.IssueSanctionRequest": {
             "type": "object",
+            "required": [
+                "reason",
+                "type"
+            ],
             "properties": {
-                "code": {
-                    "type": "integer",
-                    "example": 200
-                },
-                "data": {
-                    "$ref": "#/definitions/dto.GameItemDTO"
-                },
-                "message": {
+                "reason": {
                     "type": "string",
-                    "example": "success"
+                    "example": "toxic behavior in match chat"
                 },
-                "path": {
-                    "type": "string"
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "account",
+                        "search"
+                    ],
+                    "example": "account"
                 }
             }
         },
-        "examples.EmailConflict": {
+        "request.LiftSanctionRequest": {
             "type": "object",
             "properties": {
-                "code": {
-                    "type": "integer",
-                    "example": 409
-                },
-                "detail": {
-                    "type": "string"
-                },
-                "message": {
+                "comment": {
                     "type": "string",
-                    "example": "someone account already has this email"
-                },
-                "path": {
-                    "type": "string"
+                    "example": "lifted after conversation with the user"
                 }
             }
         },
-        "examples.FindGameItemDTOSuccessResponse": {
+        "request.LinkEmailRequest": {
             "type": "object",
+            "required": [
+                "email"
+            ],
             "properties": {
-                "code": {
-                    "type": "integer",
-                    "example": 200
-                },
-                "data": {
-                    "$ref": "#/definitions/dto.GameItemDTO"
-                },
-                "message": {
+                "email": {
+                    "description": "TODO: validate: email",
                     "type": "string",
-                    "example": "success"
-                },
-                "path": {
-                    "type": "string"
+                    "example": "intezya@gmail.com"
                 }
             }
         },
-        "examples.ForbiddenByAccessLevelResponse": {
+        "request.OIDCCallbackRequest": {
             "type": "object",
+            "required": [
+                "code",
+                "hardware_id",
+                "state"
+            ],
             "properties": {
                 "code": {
-                    "type": "integer",
-                    "example": 403
+                    "type": "string",
+                    "example": "4/0AX4XfWh3k9a"
                 },
-                "detail": {
-                    "type": "string"
+                "hardware_id": {
+                    "type": "string",
+                    "example": "QXV0aGVudGljQU1ENjA3NDA0"
                 },
-                "message": {
+                "state": {
                     "type": "string",
-                    "example": "insufficient access level"
+                    "example": "f3Yk1tq0cW1o2m9Hs7Zp4A"
                 },
-                "path": {
-                    "type": "string"
+                "two_factor_code": {
+                    "type": "string",
+                    "example": "123456"
                 }
             }
         },
-        "examples.GameItemNotFound": {
+        "request.OIDCLinkCallbackRequest": {
             "type": "object",
+            "required": [
+                "code",
+                "state"
+            ],
             "properties": {
                 "code": {
-                    "type": "integer",
-                    "example": 404
-                },
-                "detail": {
-                    "type": "string"
-                },
-                "message": {
                     "type": "string",
-                    "example": "game item not found"
+                    "example": "4/0AX4XfWh3k9a"
                 },
-                "path": {
-                    "type": "string"
+                "state": {
+                    "type": "string",
+                    "example": "f3Yk1tq0cW1o2m9Hs7Zp4A"
                 }
             }
         },
-        "examples.HardwareIDConflictResponse": {
+        "request.OIDCRegistrationRequest": {
             "type": "object",
+            "required": [
+                "hardware_id",
+                "registration_token",
+                "username"
+            ],
             "properties": {
-                "code": {
-                    "type": "integer",
-                    "example": 409
-                },
-                "detail": {
-                    "type": "string"
-                },
-                "message": {
+                "hardware_id": {
                     "type": "string",
-                    "example": "user hardware id conflict"
+                    "example": "QXV0aGVudGljQU1ENjA3NDA0"
                 },
-                "path": {
-                    "type": "string"
+                "registration_token": {
+                    "type": "string",
+                    "example": "Jb0p0tWm3qK8ZyQe2Xh1vA"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "my_legendary_username"
                 }
             }
         },
-        "examples.InventoryItemDTOSuccessResponse": {
+        "request.PasswordResetRequest": {
             "type": "object",
+            "required": [
+                "email"
+            ],
             "properties": {
-                "code": {
-                    "type": "integer",
-                    "example": 200
-                },
-                "data": {
-                    "$ref": "#/definitions/dto.InventoryItemDTO"
-                },
-                "message": {
+                "email": {
                     "type": "string",
-                    "example": "success"
-                },
-                "path": {
-                    "type": "string"
+                    "example": "intezya@gmail.com"
                 }
             }
         },
-        "examples.InventoryItemNotFoundResponse": {
+        "request.RefreshTokenRequest": {
             "type": "object",
+            "required": [
+                "refresh_token"
+            ],
             "properties": {
-                "code": {
-                    "type": "integer",
-                    "example": 404
-                },
-                "detail": {
-                    "type": "string"
-                },
-                "message": {
+                "refresh_token": {
                     "type": "string",
-                    "example": "item not found"
-                },
-                "path": {
-                    "type": "string"
+                    "example": "q3Jx9vYQm0Zt8r7y0cHh1n2b3v4c5x6z7a8s9d0f1g2"
                 }
             }
         },
-        "examples.PaginatedGameItemsDTOResponse": {
+        "request.RequestAccountDeletionRequest": {
             "type": "object",
+            "required": [
+                "password"
+            ],
             "properties": {
-                "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.GameItemDTO"
-                    }
-                },
-                "page": {
-                    "type": "integer",
-                    "example": 1
-                },
-                "size": {
-                    "type": "integer",
-                    "example": 10
-                },
-                "total_items": {
-                    "type": "integer",
-                    "example": 777
+                "password": {
+                    "type": "string",
+                    "example": "STr0ngP@55w0rD!_"
                 },
-                "total_pages": {
-                    "type": "integer",
-                    "example": 78
+                "two_factor_code": {
+                    "type": "string",
+                    "example": "123456"
                 }
             }
         },
-        "examples.PaginatedInventoryItemsDTOResponse": {
+        "request.ResetHardwareIDRequest": {
             "type": "object",
             "properties": {
-                "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.InventoryItemDTO"
-                    }
-                },
-                "page": {
-                    "type": "integer",
-                    "example": 1
-                },
-                "size": {
-                    "type": "integer",
-                    "example": 10
-                },
-                "total_items": {
-                    "type": "integer",
-                    "example": 777
-                },
-                "total_pages": {
-                    "type": "integer",
-                    "example": 78
+                "reason": {
+                    "type": "string",
+                    "example": "user changed PC"
                 }
             }
         },
-        "examples.TooManyRequestsResponse": {
+        "request.ResolveHardwareIDResetRequest": {
             "type": "object",
             "properties": {
-                "code": {
-                    "type": "integer",
-                    "example": 429
-                },
-                "detail": {
-                    "type": "string"
-                },
-                "message": {
+                "comment": {
                     "type": "string",
-                    "example": "too many requests"
-                },
-                "path": {
-                    "type": "string"
+                    "example": "confirmed with the user in support chat"
                 }
             }
         },
-        "examples.UnprocessableEntityResponse": {
+        "request.ResolveSanctionAppealRequest": {
             "type": "object",
             "properties": {
-                "code": {
-                    "type": "integer",
-                    "example": 422
+                "accepted": {
+                    "type": "boolean",
+                    "example": true
                 },
-                "detail": {
-                    "type": "string"
-                },
-                "message": {
+                "comment": {
                     "type": "string",
-                    "example": "unprocessable entity"
-                },
-                "path": {
-                    "type": "string"
+                    "example": "replay confirms the report was wrong"
                 }
             }
         },
-        "examples.UserNotFoundResponse": {
+        "request.SaveRoleRequest": {
             "type": "object",
+            "required": [
+                "name",
+                "permissions"
+            ],
             "properties": {
-                "code": {
-                    "type": "integer",
-                    "example": 404
-                },
-                "detail": {
-                    "type": "string"
+                "description": {
+                    "type": "string",
+                    "example": "handles sanctions and appeals"
                 },
-                "message": {
+                "name": {
                     "type": "string",
-                    "example": "user not found"
+                    "maxLength": 64,
+                    "minLength": 1,
+                    "example": "moderator"
                 },
-                "path": {
-                    "type": "string"
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/permission.Permission"
+                    },
+                    "example": [
+                        "sanctions:manage",
+                        "users:view"
+                    ]
                 }
             }
         },
-        "examples.UserWrongHardwareIDResponse": {
+        "request.SeasonTier": {
             "type": "object",
+            "required": [
+                "level"
+            ],
             "properties": {
-                "code": {
+                "free_coins": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 50
+                },
+                "free_item_id": {
                     "type": "integer",
-                    "example": 401
+                    "example": 42
                 },
-                "detail": {
-                    "type": "string",
-                    "example": "wrong hardware id"
+                "level": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "message": {
-                    "type": "string",
-                    "example": "unauthorized"
+                "premium_coins": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 150
                 },
-                "path": {
-                    "type": "string"
+                "premium_item_id": {
+                    "type": "integer",
+                    "example": 43
+                },
+                "xp_required": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 500
                 }
             }
         },
-        "examples.UserWrongPasswordResponse": {
+        "request.SetCollectionReward": {
             "type": "object",
+            "required": [
+                "collection"
+            ],
             "properties": {
-                "code": {
+                "badge_item_id": {
                     "type": "integer",
-                    "example": 401
+                    "example": 42
                 },
-                "detail": {
-                    "type": "string",
-                    "example": "wrong password"
+                "coins": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 500
                 },
-                "message": {
+                "collection": {
                     "type": "string",
-                    "example": "unauthorized"
+                    "example": "Shadow Sigils"
                 },
-                "path": {
-                    "type": "string"
+                "title": {
+                    "type": "string",
+                    "example": "Sigil Keeper"
                 }
             }
         },
-        "examples.UsernameConflictResponse": {
+        "request.SetItemAsCurrent": {
             "type": "object",
+            "required": [
+                "inventory_item_id"
+            ],
             "properties": {
-                "code": {
+                "inventory_item_id": {
                     "type": "integer",
-                    "example": 409
-                },
-                "detail": {
-                    "type": "string"
-                },
-                "message": {
-                    "type": "string",
-                    "example": "user already exists"
-                },
-                "path": {
-                    "type": "string"
+                    "example": 1
                 }
             }
         },
-        "request.AuthenticationRequest": {
+        "request.SetUserRolesRequest": {
             "type": "object",
             "required": [
-                "hardware_id",
-                "password",
-                "username"
+                "role_ids"
             ],
             "properties": {
-                "hardware_id": {
-                    "type": "string",
-                    "example": "QXV0aGVudGljQU1ENjA3NDA0"
-                },
-                "password": {
-                    "type": "string",
-                    "example": "STr0ngP@55w0rD!_"
-                },
-                "username": {
-                    "type": "string",
-                    "example": "my_legendary_username"
+                "role_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        1,
+                        2
+                    ]
                 }
             }
         },
-        "request.CreateUpdateGameItem": {
+        "request.StartOIDCRequest": {
             "type": "object",
             "required": [
-                "collection",
-                "name",
-                "rarity",
-                "type"
+                "hardware_id"
             ],
             "properties": {
-                "collection": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "rarity": {
-                    "type": "integer"
-                },
-                "type": {
-                    "type": "integer"
+                "hardware_id": {
+                    "type": "string",
+                    "example": "QXV0aGVudGljQU1ENjA3NDA0"
                 }
             }
         },
-        "request.EnterCodeForEmailLinkRequest": {
+        "request.TwoFactorCodeRequest": {
             "type": "object",
             "required": [
-                "verification_code"
+                "code"
             ],
             "properties": {
-                "verification_code": {
+                "code": {
+                    "description": "Code is TOTP code, recovery codes are accepted everywhere except enrollment confirmation",
                     "type": "string",
-                    "example": "Q2JV01"
+                    "example": "123456"
                 }
             }
         },
-        "request.LinkEmailRequest": {
+        "request.UnbanHardwareIDRequest": {
             "type": "object",
             "required": [
-                "email"
+                "hardware_id"
             ],
             "properties": {
-                "email": {
-                    "description": "TODO: validate: email",
+                "hardware_id": {
                     "type": "string",
-                    "example": "intezya@gmail.com"
+                    "example": "6a1f9e2c0b7d4e8f"
                 }
             }
         },
-        "request.PasswordChangeRequest": {
+        "request.UnlinkEmailRequest": {
             "type": "object",
             "required": [
-                "new_password",
-                "old_password",
-                "username"
+                "password"
             ],
             "properties": {
-                "new_password": {
-                    "type": "string",
-                    "example": "QXV0aGVudGljQU1ENjA3NDA0"
-                },
-                "old_password": {
+                "password": {
                     "type": "string",
                     "example": "STr0ngP@55w0rD!_"
                 },
-                "username": {
+                "two_factor_code": {
                     "type": "string",
-                    "example": "my_legendary_username"
+                    "example": "123456"
                 }
             }
         },
-        "request.SetItemAsCurrent": {
+        "request.UnlinkExternalIdentityRequest": {
             "type": "object",
             "required": [
-                "inventory_item_id"
+                "password"
             ],
             "properties": {
-                "inventory_item_id": {
-                    "type": "integer"
+                "password": {
+                    "type": "string",
+                    "example": "STr0ngP@55w0rD!_"
+                },
+                "two_factor_code": {
+                    "type": "string",
+                    "example": "123456"
                 }
             }
+        },
+        "seasonentity.Track": {
+            "type": "string",
+            "enum": [
+                "free",
+                "premium"
+            ],
+            "x-enum-varnames": [
+                "TrackFree",
+                "TrackPremium"
+            ]
         }
     },
     "securityDefinitions": {
+        "APITokenAuth": {
+            "description": "Personal API token with \"Token\" scheme, accepted only by routes allowing its scopes",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
+        },
         "BearerAuth": {
             "type": "apiKey",
             "name": "Authorization",
@@ -1491,7 +9370,7 @@ const docTemplate = `{
     }
 }`
 
-// SwaggerInfo holds exported Swagger Info so clients can modify it.
+// SwaggerInfo holds exported Swagger Info so clients can modify it
 var SwaggerInfo = &swag.Spec{
 	Version:          "1.0",
 	Host:             "localhost:8080",
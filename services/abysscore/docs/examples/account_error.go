@@ -20,3 +20,87 @@ type EmailConflict struct {
 	Code    int    `json:"code"    example:"409"`
 	Path    string `json:"path"`
 }
+
+type AccountHasNoLinkedEmail struct {
+	Message string `json:"message" example:"account has no linked email"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"409"`
+	Path    string `json:"path"`
+}
+
+type NoOtherRecoveryMethod struct {
+	Message string `json:"message" example:"account has no recovery method except email"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"409"`
+	Path    string `json:"path"`
+}
+
+type WeakPassword struct {
+	Message string `json:"message" example:"bad request"`
+	Detail  string `json:"detail"  example:"password must be at least 10 characters long"`
+	Code    int    `json:"code"    example:"400"`
+	Path    string `json:"path"`
+}
+
+type PasswordRecentlyUsed struct {
+	Message string `json:"message" example:"bad request"`
+	Detail  string `json:"detail"  example:"password has been used recently"`
+	Code    int    `json:"code"    example:"400"`
+	Path    string `json:"path"`
+}
+
+type PasswordChangedConcurrently struct {
+	Message string `json:"message" example:"account password has been changed"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"409"`
+	Path    string `json:"path"`
+}
+
+type InvalidUsername struct {
+	Message string `json:"message" example:"bad request"`
+	Detail  string `json:"detail"  example:"username is reserved"`
+	Code    int    `json:"code"    example:"400"`
+	Path    string `json:"path"`
+}
+
+type UsernameTaken struct {
+	Message string `json:"message" example:"username is taken"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"409"`
+	Path    string `json:"path"`
+}
+
+type UsernameChangeCooldown struct {
+	Message string `json:"message" example:"too many requests"`
+	Detail  string `json:"detail"  example:"username has been changed recently, next change is available after 2025-07-01T12:00:00Z"`
+	Code    int    `json:"code"    example:"429"`
+	Path    string `json:"path"`
+}
+
+type InsufficientCoins struct {
+	Message string `json:"message" example:"insufficient coins"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"409"`
+	Path    string `json:"path"`
+}
+
+type UnknownExportFormat struct {
+	Message string `json:"message" example:"bad request"`
+	Detail  string `json:"detail"  example:"export format must be json or zip"`
+	Code    int    `json:"code"    example:"400"`
+	Path    string `json:"path"`
+}
+
+type AccountDeletionScheduled struct {
+	Message string `json:"message" example:"account deletion is already scheduled"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"409"`
+	Path    string `json:"path"`
+}
+
+type AccountDeletionNotScheduled struct {
+	Message string `json:"message" example:"account deletion is not scheduled"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"409"`
+	Path    string `json:"path"`
+}
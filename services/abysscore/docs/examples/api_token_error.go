@@ -0,0 +1,29 @@
+package examples
+
+type APITokenNotFoundResponse struct {
+	Message string `json:"message" example:"api token not found"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"404"`
+	Path    string `json:"path"`
+}
+
+type UnknownAPITokenScopeResponse struct {
+	Message string `json:"message" example:"bad request"`
+	Detail  string `json:"detail"  example:"unknown api token scope"`
+	Code    int    `json:"code"    example:"400"`
+	Path    string `json:"path"`
+}
+
+type InvalidAPITokenLifetimeResponse struct {
+	Message string `json:"message" example:"bad request"`
+	Detail  string `json:"detail"  example:"api token lifetime must be from 1 to 365 days"`
+	Code    int    `json:"code"    example:"400"`
+	Path    string `json:"path"`
+}
+
+type APITokenLimitReachedResponse struct {
+	Message string `json:"message" example:"api token limit is reached, revoke unused tokens"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"409"`
+	Path    string `json:"path"`
+}
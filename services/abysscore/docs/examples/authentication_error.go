@@ -34,3 +34,31 @@ type UserWrongHardwareIDResponse struct {
 	Code    int    `json:"code"    example:"401"`
 	Path    string `json:"path"`
 }
+
+type InvalidRefreshTokenResponse struct {
+	Message string `json:"message" example:"unauthorized"`
+	Detail  string `json:"detail"  example:"invalid refresh token"`
+	Code    int    `json:"code"    example:"401"`
+	Path    string `json:"path"`
+}
+
+type RefreshTokenReusedResponse struct {
+	Message string `json:"message" example:"unauthorized"`
+	Detail  string `json:"detail"  example:"refresh token reuse detected, all sessions of the token are revoked"`
+	Code    int    `json:"code"    example:"401"`
+	Path    string `json:"path"`
+}
+
+type LoginLockedResponse struct {
+	Message string `json:"message" example:"account is temporarily locked after too many failed logins"`
+	Detail  string `json:"detail"  example:"try again after 2025-06-01T12:00:00Z"`
+	Code    int    `json:"code"    example:"403"`
+	Path    string `json:"path"`
+}
+
+type TooManyLoginFailuresResponse struct {
+	Message string `json:"message" example:"too many requests"`
+	Detail  string `json:"detail"  example:"too many failed logins, try again in 16s"`
+	Code    int    `json:"code"    example:"429"`
+	Path    string `json:"path"`
+}
@@ -21,8 +21,8 @@ type TooManyRequestsResponse struct {
 	Path    string `json:"path"`
 }
 
-type ForbiddenByAccessLevelResponse struct {
-	Message string `json:"message" example:"insufficient access level"`
+type ForbiddenByPermissionsResponse struct {
+	Message string `json:"message" example:"insufficient permissions"`
 	Detail  string `json:"detail"`
 	Code    int    `json:"code"    example:"403"`
 	Path    string `json:"path"`
@@ -0,0 +1,43 @@
+package examples
+
+type HardwareIDNotBoundResponse struct {
+	Message string `json:"message" example:"account has no bound hardware id"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"409"`
+	Path    string `json:"path"`
+}
+
+type HardwareIDResetAlreadyRequestedResponse struct {
+	Message string `json:"message" example:"hardware id reset is already requested"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"409"`
+	Path    string `json:"path"`
+}
+
+type HardwareIDResetRequestResolvedResponse struct {
+	Message string `json:"message" example:"hardware id reset request is already resolved"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"409"`
+	Path    string `json:"path"`
+}
+
+type HardwareIDResetRequestNotFoundResponse struct {
+	Message string `json:"message" example:"hardware id reset request not found"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"404"`
+	Path    string `json:"path"`
+}
+
+type HardwareIDAlreadyBannedResponse struct {
+	Message string `json:"message" example:"hardware id is already banned"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"409"`
+	Path    string `json:"path"`
+}
+
+type BannedHardwareIDNotFoundResponse struct {
+	Message string `json:"message" example:"banned hardware id not found"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"404"`
+	Path    string `json:"path"`
+}
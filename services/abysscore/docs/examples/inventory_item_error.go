@@ -6,3 +6,17 @@ type InventoryItemNotFoundResponse struct {
 	Code    int    `json:"code"    example:"404"`
 	Path    string `json:"path"`
 }
+
+type GrantJobNotFoundResponse struct {
+	Message string `json:"message" example:"grant job not found"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"404"`
+	Path    string `json:"path"`
+}
+
+type DismantleCurrentItemResponse struct {
+	Message string `json:"message" example:"item showcased in profile can't be dismantled"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"409"`
+	Path    string `json:"path"`
+}
@@ -0,0 +1,50 @@
+package examples
+
+type UnknownIdentityProviderResponse struct {
+	Message string `json:"message" example:"bad request"`
+	Detail  string `json:"detail"  example:"unknown identity provider"`
+	Code    int    `json:"code"    example:"400"`
+	Path    string `json:"path"`
+}
+
+type InvalidOIDCStateResponse struct {
+	Message string `json:"message" example:"bad request"`
+	Detail  string `json:"detail"  example:"authorization is expired or started from another device"`
+	Code    int    `json:"code"    example:"400"`
+	Path    string `json:"path"`
+}
+
+type InvalidRegistrationTokenResponse struct {
+	Message string `json:"message" example:"bad request"`
+	Detail  string `json:"detail"  example:"registration token is invalid or expired"`
+	Code    int    `json:"code"    example:"400"`
+	Path    string `json:"path"`
+}
+
+type OIDCAuthorizationFailedResponse struct {
+	Message string `json:"message" example:"unauthorized"`
+	Detail  string `json:"detail"  example:"identity provider authorization failed"`
+	Code    int    `json:"code"    example:"401"`
+	Path    string `json:"path"`
+}
+
+type ExternalIdentityAlreadyLinkedResponse struct {
+	Message string `json:"message" example:"identity is already linked to an account"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"409"`
+	Path    string `json:"path"`
+}
+
+type ProviderAlreadyLinkedResponse struct {
+	Message string `json:"message" example:"account already has linked identity of this provider"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"409"`
+	Path    string `json:"path"`
+}
+
+type ExternalIdentityNotFoundResponse struct {
+	Message string `json:"message" example:"external identity not found"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"404"`
+	Path    string `json:"path"`
+}
@@ -9,7 +9,7 @@ import (
 type SuccessResponse struct {
 	Message string `json:"message" example:"success"`
 	Data    T      `json:"data"`
-	StatusCode    int    `json:"code" example:"200"`
+	StatusCode    int    `json:"code"    example:"200"`
 	Path    string `json:"path"`
 }
 */
@@ -41,3 +41,136 @@ type InventoryItemDTOSuccessResponse struct {
 	Code    int                  `json:"code"    example:"200"`
 	Path    string               `json:"path"`
 }
+
+type CollectionProgressSuccessResponse struct {
+	Message string                      `json:"message" example:"success"`
+	Data    []dto.CollectionProgressDTO `json:"data"`
+	Code    int                         `json:"code"    example:"200"`
+	Path    string                      `json:"path"`
+}
+
+type CollectionRewardsSuccessResponse struct {
+	Message string                    `json:"message" example:"success"`
+	Data    []dto.CollectionRewardDTO `json:"data"`
+	Code    int                       `json:"code"    example:"200"`
+	Path    string                    `json:"path"`
+}
+
+type CollectionRewardSuccessResponse struct {
+	Message string                  `json:"message" example:"success"`
+	Data    dto.CollectionRewardDTO `json:"data"`
+	Code    int                     `json:"code"    example:"200"`
+	Path    string                  `json:"path"`
+}
+
+type GrantJobSuccessResponse struct {
+	Message string          `json:"message" example:"success"`
+	Data    dto.GrantJobDTO `json:"data"`
+	Code    int             `json:"code"    example:"200"`
+	Path    string          `json:"path"`
+}
+
+type SeasonSuccessResponse struct {
+	Message string        `json:"message" example:"success"`
+	Data    dto.SeasonDTO `json:"data"`
+	Code    int           `json:"code"    example:"200"`
+	Path    string        `json:"path"`
+}
+
+type SeasonPassSuccessResponse struct {
+	Message string            `json:"message" example:"success"`
+	Data    dto.SeasonPassDTO `json:"data"`
+	Code    int               `json:"code"    example:"200"`
+	Path    string            `json:"path"`
+}
+
+type SeasonRewardClaimSuccessResponse struct {
+	Message string                   `json:"message" example:"success"`
+	Data    dto.SeasonRewardClaimDTO `json:"data"`
+	Code    int                      `json:"code"    example:"200"`
+	Path    string                   `json:"path"`
+}
+
+type DismantleSuccessResponse struct {
+	Message string                 `json:"message" example:"success"`
+	Data    dto.DismantleResultDTO `json:"data"`
+	Code    int                    `json:"code"    example:"200"`
+	Path    string                 `json:"path"`
+}
+
+type SessionsSuccessResponse struct {
+	Message string           `json:"message" example:"success"`
+	Data    []dto.SessionDTO `json:"data"`
+	Code    int              `json:"code"    example:"200"`
+	Path    string           `json:"path"`
+}
+
+type OIDCProvidersSuccessResponse struct {
+	Message string   `json:"message" example:"success"`
+	Data    []string `json:"data"    example:"google,discord"`
+	Code    int      `json:"code"    example:"200"`
+	Path    string   `json:"path"`
+}
+
+type OIDCAuthorizationSuccessResponse struct {
+	Message string                   `json:"message" example:"success"`
+	Data    dto.OIDCAuthorizationDTO `json:"data"`
+	Code    int                      `json:"code"    example:"200"`
+	Path    string                   `json:"path"`
+}
+
+type OIDCLoginSuccessResponse struct {
+	Message string                        `json:"message" example:"success"`
+	Data    domainservice.OIDCLoginResult `json:"data"`
+	Code    int                           `json:"code"    example:"200"`
+	Path    string                        `json:"path"`
+}
+
+type ExternalIdentitySuccessResponse struct {
+	Message string                  `json:"message" example:"success"`
+	Data    dto.ExternalIdentityDTO `json:"data"`
+	Code    int                     `json:"code"    example:"200"`
+	Path    string                  `json:"path"`
+}
+
+type ExternalIdentitiesSuccessResponse struct {
+	Message string                    `json:"message" example:"success"`
+	Data    []dto.ExternalIdentityDTO `json:"data"`
+	Code    int                       `json:"code"    example:"200"`
+	Path    string                    `json:"path"`
+}
+
+type APITokensSuccessResponse struct {
+	Message string            `json:"message" example:"success"`
+	Data    []dto.APITokenDTO `json:"data"`
+	Code    int               `json:"code"    example:"200"`
+	Path    string            `json:"path"`
+}
+
+type IssuedAPITokenSuccessResponse struct {
+	Message string                `json:"message" example:"success"`
+	Data    dto.IssuedAPITokenDTO `json:"data"`
+	Code    int                   `json:"code"    example:"200"`
+	Path    string                `json:"path"`
+}
+
+type UsernameChangedSuccessResponse struct {
+	Message string                 `json:"message" example:"success"`
+	Data    dto.UsernameChangedDTO `json:"data"`
+	Code    int                    `json:"code"    example:"200"`
+	Path    string                 `json:"path"`
+}
+
+type PersonalDataSuccessResponse struct {
+	Message string              `json:"message" example:"success"`
+	Data    dto.PersonalDataDTO `json:"data"`
+	Code    int                 `json:"code"    example:"200"`
+	Path    string              `json:"path"`
+}
+
+type AccountDeletionSuccessResponse struct {
+	Message string                 `json:"message" example:"success"`
+	Data    dto.AccountDeletionDTO `json:"data"`
+	Code    int                    `json:"code"    example:"200"`
+	Path    string                 `json:"path"`
+}
@@ -12,7 +12,62 @@ type PaginatedGameItemsDTOResponse struct {
 }
 
 type PaginatedInventoryItemsDTOResponse struct {
-	Data []dto.InventoryItemDTO `json:"data"`
+	Data    []dto.InventoryItemDTO  `json:"data"`
+	Summary dto.InventorySummaryDTO `json:"summary"`
+
+	Page       int `json:"page"        example:"1"`
+	Size       int `json:"size"        example:"10"`
+	TotalItems int `json:"total_items" example:"777"`
+	TotalPages int `json:"total_pages" example:"78"`
+}
+
+type PaginatedArchivedGameItemsDTOResponse struct {
+	Data []dto.ArchivedGameItemDTO `json:"data"`
+
+	Page       int `json:"page"        example:"1"`
+	Size       int `json:"size"        example:"10"`
+	TotalItems int `json:"total_items" example:"777"`
+	TotalPages int `json:"total_pages" example:"78"`
+}
+
+type PaginatedHardwareIDResetsDTOResponse struct {
+	Data []dto.HardwareIDResetDTO `json:"data"`
+
+	Page       int `json:"page"        example:"1"`
+	Size       int `json:"size"        example:"10"`
+	TotalItems int `json:"total_items" example:"777"`
+	TotalPages int `json:"total_pages" example:"78"`
+}
+
+type PaginatedBannedHardwareIDsDTOResponse struct {
+	Data []dto.BannedHardwareID `json:"data"`
+
+	Page       int `json:"page"        example:"1"`
+	Size       int `json:"size"        example:"10"`
+	TotalItems int `json:"total_items" example:"777"`
+	TotalPages int `json:"total_pages" example:"78"`
+}
+
+type PaginatedSanctionsDTOResponse struct {
+	Data []dto.SanctionDTO `json:"data"`
+
+	Page       int `json:"page"        example:"1"`
+	Size       int `json:"size"        example:"10"`
+	TotalItems int `json:"total_items" example:"777"`
+	TotalPages int `json:"total_pages" example:"78"`
+}
+
+type PaginatedAuditLogDTOResponse struct {
+	Data []dto.AuditLogDTO `json:"data"`
+
+	Page       int `json:"page"        example:"1"`
+	Size       int `json:"size"        example:"10"`
+	TotalItems int `json:"total_items" example:"777"`
+	TotalPages int `json:"total_pages" example:"78"`
+}
+
+type PaginatedLoginHistoryDTOResponse struct {
+	Data []dto.LoginHistoryDTO `json:"data"`
 
 	Page       int `json:"page"        example:"1"`
 	Size       int `json:"size"        example:"10"`
@@ -0,0 +1,36 @@
+package examples
+
+type RoleNotFoundResponse struct {
+	Message string `json:"message" example:"role not found"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"404"`
+	Path    string `json:"path"`
+}
+
+type RoleAlreadyExistsResponse struct {
+	Message string `json:"message" example:"role with this name already exists"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"409"`
+	Path    string `json:"path"`
+}
+
+type GrantingMissingPermissionsResponse struct {
+	Message string `json:"message" example:"permissions you don't have can't be granted"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"403"`
+	Path    string `json:"path"`
+}
+
+type RoleNameReservedResponse struct {
+	Message string `json:"message" example:"role name is reserved"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"409"`
+	Path    string `json:"path"`
+}
+
+type SystemRoleImmutableResponse struct {
+	Message string `json:"message" example:"system role can't be changed"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"403"`
+	Path    string `json:"path"`
+}
@@ -0,0 +1,50 @@
+package examples
+
+type SanctionNotFoundResponse struct {
+	Message string `json:"message" example:"sanction not found"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"404"`
+	Path    string `json:"path"`
+}
+
+type UserSanctionsChangedConcurrentlyResponse struct {
+	Message string `json:"message" example:"user sanctions have been changed"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"409"`
+	Path    string `json:"path"`
+}
+
+type SanctionNotActiveResponse struct {
+	Message string `json:"message" example:"sanction is not active"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"409"`
+	Path    string `json:"path"`
+}
+
+type SanctionNotLatestResponse struct {
+	Message string `json:"message" example:"sanction is not the latest of its type"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"409"`
+	Path    string `json:"path"`
+}
+
+type SanctionRevokedResponse struct {
+	Message string `json:"message" example:"sanction is already revoked"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"409"`
+	Path    string `json:"path"`
+}
+
+type SanctionAlreadyAppealedResponse struct {
+	Message string `json:"message" example:"sanction is already appealed"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"409"`
+	Path    string `json:"path"`
+}
+
+type SanctionAppealNotPendingResponse struct {
+	Message string `json:"message" example:"sanction has no pending appeal"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"409"`
+	Path    string `json:"path"`
+}
@@ -0,0 +1,22 @@
+package examples
+
+type SeasonNotFoundResponse struct {
+	Message string `json:"message" example:"season not found"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"404"`
+	Path    string `json:"path"`
+}
+
+type SeasonConflictResponse struct {
+	Message string `json:"message" example:"season pass is already premium"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"409"`
+	Path    string `json:"path"`
+}
+
+type SeasonTierLockedResponse struct {
+	Message string `json:"message" example:"season tier is not reached"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"403"`
+	Path    string `json:"path"`
+}
@@ -0,0 +1,8 @@
+package examples
+
+type SessionNotFoundResponse struct {
+	Message string `json:"message" example:"session not found"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"404"`
+	Path    string `json:"path"`
+}
@@ -0,0 +1,36 @@
+package examples
+
+type TwoFactorRequiredResponse struct {
+	Message string `json:"message" example:"unauthorized"`
+	Detail  string `json:"detail"  example:"two-factor authentication code required"`
+	Code    int    `json:"code"    example:"401"`
+	Path    string `json:"path"`
+}
+
+type WrongTwoFactorCodeResponse struct {
+	Message string `json:"message" example:"unauthorized"`
+	Detail  string `json:"detail"  example:"wrong two-factor authentication code"`
+	Code    int    `json:"code"    example:"401"`
+	Path    string `json:"path"`
+}
+
+type TwoFactorAlreadyEnabled struct {
+	Message string `json:"message" example:"two-factor authentication is already enabled"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"409"`
+	Path    string `json:"path"`
+}
+
+type TwoFactorNotEnabled struct {
+	Message string `json:"message" example:"two-factor authentication is not enabled"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"409"`
+	Path    string `json:"path"`
+}
+
+type TwoFactorEnrollmentNotStarted struct {
+	Message string `json:"message" example:"two-factor enrollment is not started or expired"`
+	Detail  string `json:"detail"`
+	Code    int    `json:"code"    example:"409"`
+	Path    string `json:"path"`
+}
@@ -14,6 +14,7 @@ import (
 	rediswrapper "github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/cache/redis"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/mail"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/oidc"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/persistence"
 	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/auth"
 	"github.com/intezya/pkglib/itertools"
@@ -38,7 +39,8 @@ var (
 // Default configuration values.
 const (
 	defaultServerPort             = 8080
-	defaultJWTExpiration          = 24 * time.Hour
+	defaultJWTExpiration          = 15 * time.Minute
+	defaultRefreshTokenExpiration = 30 * 24 * time.Hour
 	defaultRedisRetryDelay        = 2 * time.Second
 	defaultDBMaxRetries           = 5
 	defaultDBRetryDelay           = 2 * time.Second
@@ -51,6 +53,10 @@ const (
 	defaultRateLimitLoginRequests = 3
 	defaultRateLimitDefaultTime   = 1 * time.Second
 	defaultRateLimitDefaultReqs   = 4
+	defaultRateLimitEmailTime     = 10 * time.Minute
+	defaultRateLimitEmailReqs     = 10
+	defaultRateLimitAPITokenTime  = 1 * time.Minute
+	defaultRateLimitAPITokenReqs  = 60
 	defaultMetricsPort            = 2112
 	splitPairParts                = 2
 	defaultSlowRequestThresholdMs = 300
@@ -64,12 +70,15 @@ type ConfigValidator interface {
 // Config represents application configuration.
 type Config struct {
 	// Server configuration
-	ServerPort              int
-	SlowRequestThresholdMs  int
-	MetricsPort             int
-	FiberHealthCheckConfig  healthcheck.Config
-	FiberRequestIDConfig    requestid.Config
-	HardwareIDEncryptionKey string
+	ServerPort             int
+	SlowRequestThresholdMs int
+	MetricsPort            int
+	FiberHealthCheckConfig healthcheck.Config
+	FiberRequestIDConfig   requestid.Config
+	TOTPIssuer             string
+
+	// HardwareIDEncryptionKeys encrypt hardware ids and TOTP secrets
+	HardwareIDEncryptionKeys *auth.Keyring
 
 	// Environment configuration
 	IsDebug bool
@@ -84,6 +93,11 @@ type Config struct {
 	TracerConfig     *tracer.Config
 	GRPCConfig       *clients.Config
 	SMTPConfig       *mail.SMTPConfig
+	OIDCConfigs      []*oidc.Config
+
+	// In-process fake OIDC provider, it is started only in dev environment if the port is set
+	OIDCFakeProviderPort   int
+	OIDCFakeProviderConfig *oidc.Config
 }
 
 // Validate validates the rate limit configuration.
@@ -124,9 +138,14 @@ func LoadConfig() *Config {
 			"SLOW_REQUEST_THRESHOLD_MS",
 			defaultSlowRequestThresholdMs,
 		),
-		FiberHealthCheckConfig:  healthcheck.ConfigDefault,
-		FiberRequestIDConfig:    requestid.ConfigDefault,
-		HardwareIDEncryptionKey: getEnvString("HARDWARE_ID_ENCRYPTION_KEY", ""),
+		FiberHealthCheckConfig: healthcheck.ConfigDefault,
+		FiberRequestIDConfig:   requestid.ConfigDefault,
+		TOTPIssuer:             getEnvString("TOTP_ISSUER", "AbyssLeague"),
+		HardwareIDEncryptionKeys: initKeyring(
+			"HARDWARE_ID_ENCRYPTION_KEYS",
+			"HARDWARE_ID_ENCRYPTION_KEY",
+			"",
+		),
 
 		// Environment configuration
 		IsDebug: getEnvBool("DEBUG", false),
@@ -138,15 +157,23 @@ func LoadConfig() *Config {
 		RedisConfig:     initRedisConfig(),
 		EntConfig:       initEntConfig(),
 		JWTConfiguration: auth.NewJWTConfiguration(
-			getEnvString("JWT_SECRET", "default-secret-key"),
+			initSigningKeys(EnvType(envType)),
 			getEnvString("JWT_ISSUER", "com.intezya.abyssleague.auth"),
 			getEnvDuration("JWT_EXPIRATION_TIME", defaultJWTExpiration),
+			getEnvDuration("REFRESH_TOKEN_EXPIRATION_TIME", defaultRefreshTokenExpiration),
 		),
 		TracerConfig: initTracerConfig(envType),
 		GRPCConfig:   initGRPCConfig(envType == string(EnvTypeDev)),
 		SMTPConfig:   initSMTPConfig(),
 	}
 
+	if config.EnvType == EnvTypeDev {
+		config.OIDCFakeProviderPort = getEnvInt("OIDC_FAKE_PROVIDER_PORT", 0)
+		config.OIDCFakeProviderConfig = initFakeOIDCConfig(config.OIDCFakeProviderPort)
+	}
+
+	config.OIDCConfigs = initOIDCConfig(config.OIDCFakeProviderConfig)
+
 	// Set specific Fiber middleware configurations
 	config.FiberRequestIDConfig.ContextKey = getEnvString("REQUEST_ID_KEY", "requestid")
 	config.FiberHealthCheckConfig.LivenessEndpoint = getEnvString("LIVENESS_ENDPOINT", "/live")
@@ -200,6 +227,27 @@ func initRateLimitConfig() *RateLimitConfig {
 			"RATE_LIMIT_DEFAULT_MAX_REQUESTS",
 			defaultRateLimitDefaultReqs,
 		),
+
+		// API token rate limiting
+		EmailRateLimitKey: getEnvString("RATE_LIMIT_EMAIL_KEY", "email_rate_limit:"),
+		EmailRateLimitTime: getEnvDuration(
+			"RATE_LIMIT_EMAIL_TIME",
+			defaultRateLimitEmailTime,
+		),
+		EmailRateLimit: getEnvInt(
+			"RATE_LIMIT_EMAIL_MAX_REQUESTS",
+			defaultRateLimitEmailReqs,
+		),
+
+		APITokenRateLimitKey: getEnvString("RATE_LIMIT_API_TOKEN_KEY", "api_token_rate_limit:"),
+		APITokenRateLimitTime: getEnvDuration(
+			"RATE_LIMIT_API_TOKEN_TIME",
+			defaultRateLimitAPITokenTime,
+		),
+		APITokenRateLimit: getEnvInt(
+			"RATE_LIMIT_API_TOKEN_MAX_REQUESTS",
+			defaultRateLimitAPITokenReqs,
+		),
 	}
 }
 
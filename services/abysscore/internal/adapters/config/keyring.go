@@ -0,0 +1,59 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/auth"
+)
+
+// legacyKeyID is the id of the single key configured the old way, keep it in the list when rotating.
+const legacyKeyID = "1"
+
+// initKeyring reads comma-separated "id=secret" pairs from listKey, the first key is current.
+// If listKey is not set, the secret from legacyKey is used as the only key.
+func initKeyring(listKey, legacyKey, legacyFallback string) *auth.Keyring {
+	spec := getEnvString(listKey, "")
+	if spec == "" {
+		return auth.NewKeyring(legacyKeyID, getEnvString(legacyKey, legacyFallback))
+	}
+
+	keyring, err := auth.ParseKeyring(spec)
+	if err != nil {
+		panic(fmt.Sprintf("Error parsing %s: %v", listKey, err))
+	}
+
+	return keyring
+}
+
+// initSigningKeys reads JWT_SIGNING_KEYS as "id=key" pairs of base64 encoded PKCS #8 private keys.
+// In dev environment an ephemeral key is generated if it is not set.
+func initSigningKeys(envType EnvType) *auth.SigningKeys {
+	spec := getEnvString("JWT_SIGNING_KEYS", "")
+	if spec == "" {
+		if envType != EnvTypeDev {
+			panic("JWT_SIGNING_KEYS is required")
+		}
+
+		//nolint:forbidigo // logger not initialized yet
+		fmt.Println("JWT_SIGNING_KEYS is not set, generating ephemeral signing key")
+
+		signingKeys, err := auth.GenerateSigningKeys(legacyKeyID)
+		if err != nil {
+			panic(fmt.Sprintf("Error generating signing key: %v", err))
+		}
+
+		return signingKeys
+	}
+
+	keyring, err := auth.ParseKeyring(spec)
+	if err != nil {
+		panic(fmt.Sprintf("Error parsing JWT_SIGNING_KEYS: %v", err))
+	}
+
+	signingKeys, err := auth.NewSigningKeys(keyring)
+	if err != nil {
+		panic(fmt.Sprintf("Error parsing JWT_SIGNING_KEYS: %v", err))
+	}
+
+	return signingKeys
+}
@@ -12,4 +12,14 @@ type RateLimitConfig struct {
 	DefaultRateLimitKey  string
 	DefaultRateLimitTime time.Duration
 	DefaultRateLimit     int
+
+	// Email rate limiting, requests addressed to the same email share a bucket in addition to ip
+	EmailRateLimitKey  string
+	EmailRateLimitTime time.Duration
+	EmailRateLimit     int
+
+	// API token rate limiting, every authenticated token has its own bucket in addition to ip
+	APITokenRateLimitKey  string
+	APITokenRateLimitTime time.Duration
+	APITokenRateLimit     int
 }
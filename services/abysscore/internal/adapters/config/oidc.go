@@ -0,0 +1,54 @@
+package config
+
+import (
+	"strings"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/oidc"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/oidc/fake"
+)
+
+const (
+	defaultOIDCScopes          = "openid email profile"
+	defaultFakeOIDCRedirectURL = "http://localhost:3000/oidc/callback"
+)
+
+// initOIDCConfig reads providers listed in OIDC_PROVIDERS, e.g. OIDC_PROVIDERS=google,discord.
+// Each provider is configured with OIDC_<NAME>_* variables.
+func initOIDCConfig(fakeProviderConfig *oidc.Config) []*oidc.Config {
+	var configs []*oidc.Config
+
+	for _, name := range strings.Split(getEnvString("OIDC_PROVIDERS", ""), ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" || name == fake.ProviderName {
+			continue
+		}
+
+		prefix := "OIDC_" + strings.ToUpper(name) + "_"
+
+		configs = append(configs, &oidc.Config{
+			Name:         name,
+			Issuer:       getEnvString(prefix+"ISSUER", ""),
+			ClientID:     getEnvString(prefix+"CLIENT_ID", ""),
+			ClientSecret: getEnvString(prefix+"CLIENT_SECRET", ""),
+			AuthURL:      getEnvString(prefix+"AUTH_URL", ""),
+			TokenURL:     getEnvString(prefix+"TOKEN_URL", ""),
+			JWKSURL:      getEnvString(prefix+"JWKS_URL", ""),
+			RedirectURL:  getEnvString(prefix+"REDIRECT_URL", ""),
+			Scopes:       strings.Fields(getEnvString(prefix+"SCOPES", defaultOIDCScopes)),
+		})
+	}
+
+	if fakeProviderConfig != nil {
+		configs = append(configs, fakeProviderConfig)
+	}
+
+	return configs
+}
+
+func initFakeOIDCConfig(port int) *oidc.Config {
+	if port == 0 {
+		return nil
+	}
+
+	return fake.Config(port, getEnvString("OIDC_FAKE_REDIRECT_URL", defaultFakeOIDCRedirectURL))
+}
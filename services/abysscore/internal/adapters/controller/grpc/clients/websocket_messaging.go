@@ -24,6 +24,7 @@ type WebsocketMessagingClient interface {
 	GetOnlineUsers(ctx context.Context) ([]*OnlineUser, error)
 	SendMessage(ctx context.Context, userID int, jsonPayload []byte) error
 	Broadcast(ctx context.Context, jsonPayload []byte) error
+	DisconnectSessions(ctx context.Context, userID int, sessionIDs []string) error
 	WaitForConnection(ctx context.Context) error
 	Close() error
 }
@@ -185,6 +186,31 @@ func (c *websocketMessagingClientImpl) Broadcast(ctx context.Context, jsonPayloa
 	return err
 }
 
+func (c *websocketMessagingClientImpl) DisconnectSessions(
+	ctx context.Context,
+	userID int,
+	sessionIDs []string,
+) error {
+	client, err := c.grpcClient.GetClient()
+	if err != nil {
+		if c.grpcClient.DevMode {
+			return nil
+		}
+
+		return err
+	}
+
+	_, err = client.DisconnectSessions(ctx, &websocketpb.DisconnectSessionsRequest{
+		UserId:     int64(userID),
+		SessionIds: sessionIDs,
+	})
+	if err != nil && c.grpcClient.DevMode {
+		return nil
+	}
+
+	return err
+}
+
 func (c *websocketMessagingClientImpl) WaitForConnection(ctx context.Context) error {
 	return c.grpcClient.WaitForConnection(ctx)
 }
@@ -7,3 +7,55 @@ type LinkEmailRequest struct {
 type EnterCodeForEmailLinkRequest struct {
 	VerificationCode string `json:"verification_code" validate:"required" example:"Q2JV01"`
 }
+
+type PasswordResetRequest struct {
+	Email string `json:"email" validate:"required" example:"intezya@gmail.com"`
+}
+
+type EnterCodeForPasswordResetRequest struct {
+	Email            string `json:"email"                     validate:"required" example:"intezya@gmail.com"`
+	VerificationCode string `json:"verification_code"         validate:"required" example:"Q2JV01"`
+	NewPassword      string `json:"new_password"              validate:"required" example:"N3wSTr0ngP@55w0rD!_"`
+	TwoFactorCode    string `json:"two_factor_code,omitempty"                     example:"123456"`
+}
+
+type ChangePasswordRequest struct {
+	OldPassword   string `json:"old_password"              validate:"required" example:"STr0ngP@55w0rD!_"`
+	NewPassword   string `json:"new_password"              validate:"required" example:"N3wSTr0ngP@55w0rD!_"`
+	TwoFactorCode string `json:"two_factor_code,omitempty"                     example:"123456"`
+}
+
+type ChangeEmailRequest struct {
+	NewEmail      string `json:"new_email"                 validate:"required" example:"intezya@proton.me"`
+	TwoFactorCode string `json:"two_factor_code,omitempty"                     example:"123456"`
+}
+
+type EnterCodesForEmailChangeRequest struct {
+	OldEmailCode string `json:"old_email_code" validate:"required" example:"Q2JV01"`
+	NewEmailCode string `json:"new_email_code" validate:"required" example:"7KD0PA"`
+}
+
+type UnlinkEmailRequest struct {
+	Password      string `json:"password"                  validate:"required" example:"STr0ngP@55w0rD!_"`
+	TwoFactorCode string `json:"two_factor_code,omitempty"                     example:"123456"`
+}
+
+type RequestAccountDeletionRequest struct {
+	Password      string `json:"password"                  validate:"required" example:"STr0ngP@55w0rD!_"`
+	TwoFactorCode string `json:"two_factor_code,omitempty"                     example:"123456"`
+}
+
+type ChangeUsernameRequest struct {
+	NewUsername   string `json:"new_username"              validate:"required" example:"intezya_the_legend"`
+	TwoFactorCode string `json:"two_factor_code,omitempty"                     example:"123456"`
+}
+
+type ChangeEmailByAdminRequest struct {
+	Email  string  `json:"email"  validate:"required" example:"intezya@proton.me"`
+	Reason *string `json:"reason"                     example:"user lost access to the old email"`
+}
+
+type TwoFactorCodeRequest struct {
+	// Code is TOTP code, recovery codes are accepted everywhere except enrollment confirmation
+	Code string `json:"code" validate:"required" example:"123456"`
+}
@@ -0,0 +1,24 @@
+package request
+
+import (
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/apiscope"
+)
+
+type IssueAPITokenRequest struct {
+	Name          string           `json:"name"                      validate:"required" example:"tournament bot"`
+	Scopes        []apiscope.Scope `json:"scopes"                    validate:"required" example:"read:matches,read:profile"`
+	LifetimeDays  int              `json:"lifetime_days"             validate:"required" example:"90"`
+	Password      string           `json:"password"                  validate:"required" example:"STr0ngP@55w0rD!_"`
+	TwoFactorCode string           `json:"two_factor_code,omitempty"                     example:"123456"`
+}
+
+func (r *IssueAPITokenRequest) ToIssueAPITokenDTO() *dto.IssueAPITokenDTO {
+	return &dto.IssueAPITokenDTO{
+		Name:          r.Name,
+		Scopes:        r.Scopes,
+		LifetimeDays:  r.LifetimeDays,
+		Password:      r.Password,
+		TwoFactorCode: r.TwoFactorCode,
+	}
+}
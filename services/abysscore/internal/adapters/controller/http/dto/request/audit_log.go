@@ -0,0 +1,22 @@
+package request
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/auditlogentity"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/queryparser"
+)
+
+// NewAuditLogFilter builds audit log filter from query params.
+func NewAuditLogFilter(c *fiber.Ctx) (*auditlogentity.Filter, error) {
+	filter, err := queryparser.ParseAuditLogFilter(
+		func(key string) string {
+			return c.Query(key, "")
+		},
+	)
+	if err != nil {
+		return nil, apperrors.WrapBadRequest(err)
+	}
+
+	return filter, nil
+}
@@ -6,26 +6,20 @@ import (
 
 // AuthenticationRequest provide credentials for user registration/login.
 type AuthenticationRequest struct {
-	Username   string `json:"username"    validate:"required" example:"my_legendary_username"`
-	Password   string `json:"password"    validate:"required" example:"STr0ngP@55w0rD!_"`
-	HardwareID string `json:"hardware_id" validate:"required" example:"QXV0aGVudGljQU1ENjA3NDA0"`
+	Username      string `json:"username"                  validate:"required" example:"my_legendary_username"`
+	Password      string `json:"password"                  validate:"required" example:"STr0ngP@55w0rD!_"`
+	HardwareID    string `json:"hardware_id"               validate:"required" example:"QXV0aGVudGljQU1ENjA3NDA0"`
+	TwoFactorCode string `json:"two_factor_code,omitempty"                     example:"123456"`
 }
 
 func (a *AuthenticationRequest) ToCredentialsDTO() *dto.CredentialsDTO {
-	return dto.NewCredentialsDTO(a.Username, a.Password, a.HardwareID)
-}
+	credentials := dto.NewCredentialsDTO(a.Username, a.Password, a.HardwareID)
+	credentials.TwoFactorCode = a.TwoFactorCode
 
-// PasswordChangeRequest provide credentials for password changing.
-type PasswordChangeRequest struct {
-	Username    string `json:"username"     validate:"required" example:"my_legendary_username"`
-	OldPassword string `json:"old_password" validate:"required" example:"STr0ngP@55w0rD!_"`
-	NewPassword string `json:"new_password" validate:"required" example:"QXV0aGVudGljQU1ENjA3NDA0"`
+	return credentials
 }
 
-func (a *PasswordChangeRequest) ToDTO() *dto.ChangePasswordDTO {
-	return &dto.ChangePasswordDTO{
-		Username:    a.Username,
-		OldPassword: a.OldPassword,
-		NewPassword: a.NewPassword,
-	}
+// RefreshTokenRequest provide refresh token for its rotation or revocation.
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" validate:"required" example:"q3Jx9vYQm0Zt8r7y0cHh1n2b3v4c5x6z7a8s9d0f1g2"`
 }
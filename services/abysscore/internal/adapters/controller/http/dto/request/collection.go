@@ -0,0 +1,19 @@
+package request
+
+import "github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+
+type SetCollectionReward struct {
+	Collection  string  `json:"collection"    validate:"required" example:"Shadow Sigils"`
+	Coins       float64 `json:"coins"         validate:"gte=0"    example:"500"`
+	Title       *string `json:"title"                             example:"Sigil Keeper"`
+	BadgeItemID *int    `json:"badge_item_id"                     example:"42"`
+}
+
+func (s *SetCollectionReward) ToDTO() *dto.CollectionRewardDTO {
+	return &dto.CollectionRewardDTO{
+		Collection:  s.Collection,
+		Coins:       s.Coins,
+		Title:       s.Title,
+		BadgeItemID: s.BadgeItemID,
+	}
+}
@@ -3,7 +3,11 @@ package request
 import (
 	"time"
 
+	"github.com/gofiber/fiber/v2"
 	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/gameitementity"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/queryparser"
 )
 
 type CreateUpdateGameItem struct {
@@ -24,3 +28,17 @@ func (c *CreateUpdateGameItem) ToDTO() *dto.GameItemDTO {
 		CreatedAt:  time.Time{}, // blank
 	}
 }
+
+// NewGameItemFilter builds catalog filter from query params.
+func NewGameItemFilter(c *fiber.Ctx) (*gameitementity.Filter, error) {
+	filter, err := queryparser.ParseGameItemFilter(
+		func(key string) string {
+			return c.Query(key, "")
+		},
+	)
+	if err != nil {
+		return nil, apperrors.WrapBadRequest(err)
+	}
+
+	return filter, nil
+}
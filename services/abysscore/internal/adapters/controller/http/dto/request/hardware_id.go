@@ -0,0 +1,29 @@
+package request
+
+type ResetHardwareIDRequest struct {
+	Reason *string `json:"reason" example:"user changed PC"`
+}
+
+type HardwareIDResetRequestRequest struct {
+	Username      string  `json:"username"                  validate:"required" example:"intezya"`
+	Password      string  `json:"password"                  validate:"required" example:"STr0ngP@55w0rD!_"`
+	TwoFactorCode string  `json:"two_factor_code,omitempty"                     example:"123456"`
+	Reason        *string `json:"reason"                                        example:"changed PC"`
+}
+
+type ResolveHardwareIDResetRequest struct {
+	Comment *string `json:"comment" example:"confirmed with the user in support chat"`
+}
+
+type BanUserHardwareIDRequest struct {
+	Reason *string `json:"reason" example:"cheating"`
+}
+
+type BanHardwareIDRequest struct {
+	HardwareID string  `json:"hardware_id" validate:"required" example:"6a1f9e2c0b7d4e8f"`
+	Reason     *string `json:"reason"                          example:"cheating"`
+}
+
+type UnbanHardwareIDRequest struct {
+	HardwareID string `json:"hardware_id" validate:"required" example:"6a1f9e2c0b7d4e8f"`
+}
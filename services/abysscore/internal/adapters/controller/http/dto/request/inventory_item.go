@@ -1,6 +1,15 @@
 package request
 
-import "github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/inventoryitementity"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/queryparser"
+)
 
 type GrantInventoryItemToUser struct {
 	UserID int `json:"user_id" validate:"required" example:"1"`
@@ -18,3 +27,51 @@ func (g *GrantInventoryItemToUser) ToCreateDTO(performerID int) *dto.CreateInven
 type SetItemAsCurrent struct {
 	InventoryItemID int `json:"inventory_item_id" validate:"required" example:"1"`
 }
+
+type DismantleInventoryItems struct {
+	InventoryItemIDs []int `json:"inventory_item_ids" validate:"required,min=1,max=100" example:"1,2,3"`
+}
+
+// NewInventoryItemFilter builds inventory filter from query params.
+func NewInventoryItemFilter(c *fiber.Ctx) (*inventoryitementity.Filter, error) {
+	itemType, err := queryparser.ParseOptionalInt(c.Query("type", ""))
+	if err != nil {
+		return nil, apperrors.WrapBadRequest(fmt.Errorf("type: %w", err))
+	}
+
+	rarity, err := queryparser.ParseOptionalInt(c.Query("rarity", ""))
+	if err != nil {
+		return nil, apperrors.WrapBadRequest(fmt.Errorf("rarity: %w", err))
+	}
+
+	obtainedAfter, err := queryparser.ParseOptionalTime(c.Query("obtained_after", ""))
+	if err != nil {
+		return nil, apperrors.WrapBadRequest(fmt.Errorf("obtained_after: %w", err))
+	}
+
+	return &inventoryitementity.Filter{
+		Collection:    queryparser.ParseOptionalString(c.Query("collection", "")),
+		Type:          itemType,
+		Rarity:        rarity,
+		ObtainedAfter: obtainedAfter,
+	}, nil
+}
+
+type BulkGrantInventoryItem struct {
+	Campaign string `json:"campaign" validate:"required"                          example:"summer_event_2025"`
+	Target   string `json:"target"   validate:"required,oneof=users filter online" example:"users"`
+
+	// Target "users"
+	UserIDs []int `json:"user_ids" example:"1,2,3"`
+
+	// Target "filter"
+	RegisteredBefore *time.Time `json:"registered_before" example:"2025-01-01T00:00:00Z"`
+	MinSearchScore   *int       `json:"min_search_score"  example:"1000"`
+}
+
+func (b *BulkGrantInventoryItem) ToUserFilterDTO() *dto.UserFilterDTO {
+	return &dto.UserFilterDTO{
+		RegisteredBefore: b.RegisteredBefore,
+		MinSearchScore:   b.MinSearchScore,
+	}
+}
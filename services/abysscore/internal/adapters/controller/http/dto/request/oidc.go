@@ -0,0 +1,42 @@
+package request
+
+import (
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+)
+
+type StartOIDCRequest struct {
+	HardwareID string `json:"hardware_id" validate:"required" example:"QXV0aGVudGljQU1ENjA3NDA0"`
+}
+
+// OIDCCallbackRequest provide parameters the provider redirected user back with.
+type OIDCCallbackRequest struct {
+	Code          string `json:"code"                      validate:"required" example:"4/0AX4XfWh3k9a"`
+	State         string `json:"state"                     validate:"required" example:"f3Yk1tq0cW1o2m9Hs7Zp4A"`
+	HardwareID    string `json:"hardware_id"               validate:"required" example:"QXV0aGVudGljQU1ENjA3NDA0"`
+	TwoFactorCode string `json:"two_factor_code,omitempty"                     example:"123456"`
+}
+
+func (r *OIDCCallbackRequest) ToOIDCCallbackDTO() *dto.OIDCCallbackDTO {
+	return &dto.OIDCCallbackDTO{
+		Code:          r.Code,
+		State:         r.State,
+		HardwareID:    r.HardwareID,
+		TwoFactorCode: r.TwoFactorCode,
+	}
+}
+
+type OIDCRegistrationRequest struct {
+	RegistrationToken string `json:"registration_token" validate:"required" example:"Jb0p0tWm3qK8ZyQe2Xh1vA"`
+	Username          string `json:"username"           validate:"required" example:"my_legendary_username"`
+	HardwareID        string `json:"hardware_id"        validate:"required" example:"QXV0aGVudGljQU1ENjA3NDA0"`
+}
+
+type OIDCLinkCallbackRequest struct {
+	Code  string `json:"code"  validate:"required" example:"4/0AX4XfWh3k9a"`
+	State string `json:"state" validate:"required" example:"f3Yk1tq0cW1o2m9Hs7Zp4A"`
+}
+
+type UnlinkExternalIdentityRequest struct {
+	Password      string `json:"password"                  validate:"required" example:"STr0ngP@55w0rD!_"`
+	TwoFactorCode string `json:"two_factor_code,omitempty"                     example:"123456"`
+}
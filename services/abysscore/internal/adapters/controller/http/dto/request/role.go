@@ -0,0 +1,15 @@
+package request
+
+import (
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/permission"
+)
+
+type SaveRoleRequest struct {
+	Name        string                  `json:"name"        validate:"required,min=1,max=64" example:"moderator"`
+	Description *string                 `json:"description"                                  example:"handles sanctions and appeals"`
+	Permissions []permission.Permission `json:"permissions" validate:"required"              example:"sanctions:manage,users:view"`
+}
+
+type SetUserRolesRequest struct {
+	RoleIDs []int `json:"role_ids" validate:"required" example:"1,2"`
+}
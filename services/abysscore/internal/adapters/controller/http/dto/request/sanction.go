@@ -0,0 +1,19 @@
+package request
+
+type IssueSanctionRequest struct {
+	Type   string `json:"type"   validate:"required" example:"account"                      enums:"account,search"`
+	Reason string `json:"reason" validate:"required" example:"toxic behavior in match chat"`
+}
+
+type LiftSanctionRequest struct {
+	Comment *string `json:"comment" example:"lifted after conversation with the user"`
+}
+
+type AppealSanctionRequest struct {
+	Message string `json:"message" validate:"required" example:"it was my teammate on my PC"`
+}
+
+type ResolveSanctionAppealRequest struct {
+	Accepted bool    `json:"accepted" example:"true"`
+	Comment  *string `json:"comment"  example:"replay confirms the report was wrong"`
+}
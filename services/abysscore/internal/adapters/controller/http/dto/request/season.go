@@ -0,0 +1,55 @@
+package request
+
+import (
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/pkglib/itertools"
+)
+
+type SeasonTier struct {
+	Level      int `json:"level"       validate:"required,gt=0" example:"1"`
+	XPRequired int `json:"xp_required" validate:"gte=0"         example:"500"`
+
+	FreeItemID *int    `json:"free_item_id"                      example:"42"`
+	FreeCoins  float64 `json:"free_coins"    validate:"gte=0"    example:"50"`
+
+	PremiumItemID *int    `json:"premium_item_id"                 example:"43"`
+	PremiumCoins  float64 `json:"premium_coins"   validate:"gte=0" example:"150"`
+}
+
+func (t *SeasonTier) ToDTO() *dto.SeasonTierDTO {
+	return &dto.SeasonTierDTO{
+		Level:         t.Level,
+		XPRequired:    t.XPRequired,
+		FreeItemID:    t.FreeItemID,
+		FreeCoins:     t.FreeCoins,
+		PremiumItemID: t.PremiumItemID,
+		PremiumCoins:  t.PremiumCoins,
+	}
+}
+
+type CreateSeason struct {
+	Number       int           `json:"number"        validate:"required,gt=0"        example:"1"`
+	Name         string        `json:"name"          validate:"required"             example:"Season of Shadows"`
+	StartsAt     time.Time     `json:"starts_at"     validate:"required"             example:"2025-06-01T00:00:00Z"`
+	EndsAt       time.Time     `json:"ends_at"       validate:"required"             example:"2025-08-01T00:00:00Z"`
+	PremiumPrice float64       `json:"premium_price" validate:"gte=0"                example:"1000"`
+	Tiers        []*SeasonTier `json:"tiers"         validate:"required,min=1,dive"`
+}
+
+func (c *CreateSeason) ToDTO() *dto.CreateSeasonDTO {
+	return &dto.CreateSeasonDTO{
+		Number:       c.Number,
+		Name:         c.Name,
+		StartsAt:     c.StartsAt,
+		EndsAt:       c.EndsAt,
+		PremiumPrice: c.PremiumPrice,
+		Tiers:        itertools.Map(c.Tiers, (*SeasonTier).ToDTO),
+	}
+}
+
+type ClaimSeasonReward struct {
+	Level int    `json:"level" validate:"required,gt=0"              example:"1"`
+	Track string `json:"track" validate:"required,oneof=free premium" example:"free"`
+}
@@ -22,7 +22,8 @@ type PaginationResponse struct {
 	TotalItems int `json:"total_items"`
 	TotalPages int `json:"total_pages"`
 
-	Data interface{} `json:"data,omitempty"`
+	Data    interface{} `json:"data,omitempty"`
+	Summary interface{} `json:"summary,omitempty"`
 }
 
 const successMessage = "success"
@@ -64,3 +65,24 @@ func SuccessPagination[T any](data *dto.PaginatedResult[T], c *fiber.Ctx) error
 		},
 	)
 }
+
+func SuccessPaginationWithSummary[T any](
+	data *dto.PaginatedResult[T],
+	summary interface{},
+	c *fiber.Ctx,
+) error {
+	return c.Status(fiber.StatusOK).JSON(
+		PaginationResponse{
+			Message: successMessage,
+			Code:    fiber.StatusOK,
+			Path:    c.Path(),
+
+			Page:       data.Page,
+			Size:       data.Size,
+			TotalItems: data.TotalItems,
+			TotalPages: data.TotalPages,
+			Data:       data.Data,
+			Summary:    summary,
+		},
+	)
+}
@@ -88,3 +88,435 @@ func (h *AccountHandler) EnterCodeForEmailLink(c *fiber.Ctx) error {
 
 	return sendSuccess(result, c)
 }
+
+// SendCodesForEmailChange handles sending confirmation codes for email change
+//
+//	@Summary		Send email change codes
+//	@Description	Sends confirmation codes to both current and new email addresses
+//	@Tags			Account
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body	request.ChangeEmailRequest	true	"New email"
+//	@Success		204		"Codes successfully sent"
+//	@Failure		400		{object}	examples.BadRequestResponse				"Bad request - missed request fields"
+//	@Failure		401		{object}	examples.TwoFactorRequiredResponse		"Unauthorized - two-factor authentication code required"
+//	@Failure		409		{object}	examples.AccountHasNoLinkedEmail		"Conflict - user has no linked email"
+//	@Failure		409		{object}	examples.EmailConflict					"Conflict - someone already has this email as linked"
+//	@Failure		422		{object}	examples.UnprocessableEntityResponse	"Unprocessable entity - invalid request types"
+//	@Failure		429		{object}	examples.TooManyRequestsResponse		"Too many requests - received too many requests or email has been changed recently"
+//	@Router			/api/account/email/change/get_code [post].
+func (h *AccountHandler) SendCodesForEmailChange(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "AccountHandler.SendCodesForEmailChange")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+
+	req, err := getAndValidateRequest[request.ChangeEmailRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	err = h.accountService.SendCodesForEmailChange(ctx, user, req.NewEmail, req.TwoFactorCode)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendNoContent(c)
+}
+
+// EnterCodesForEmailChange verifies codes sent to both addresses and changes email
+//
+//	@Summary		Verify email change codes
+//	@Description	Verifies codes sent to current and new addresses and changes account email
+//	@Tags			Account
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		request.EnterCodesForEmailChangeRequest	true	"Verification codes from both emails"
+//	@Success		200		{object}	dto.UserDTO								"Email successfully changed"
+//	@Failure		400		{object}	examples.WrongVerificationCode			"Bad request - wrong verification code"
+//	@Failure		409		{object}	examples.EmailConflict					"Conflict - someone already has this email as linked"
+//	@Failure		422		{object}	examples.UnprocessableEntityResponse	"Unprocessable entity - invalid request types"
+//	@Failure		429		{object}	examples.TooManyRequestsResponse		"Too many requests - received too many requests"
+//	@Router			/api/account/email/change/enter_code [post].
+func (h *AccountHandler) EnterCodesForEmailChange(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "AccountHandler.EnterCodesForEmailChange")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+
+	req, err := getAndValidateRequest[request.EnterCodesForEmailChangeRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.accountService.EnterCodesForEmailChange(ctx, user, req.OldEmailCode, req.NewEmailCode)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// UnlinkEmail handles email unlinking
+//
+//	@Summary		Unlink email
+//	@Description	Removes email from account. Allowed only if the account has another recovery method, such as a linked external identity
+//	@Tags			Account
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		request.UnlinkEmailRequest				true	"Account password"
+//	@Success		200		{object}	dto.UserDTO								"Email successfully unlinked"
+//	@Failure		400		{object}	examples.BadRequestResponse				"Bad request - missed request fields"
+//	@Failure		401		{object}	examples.UserWrongPasswordResponse		"Unauthorized - wrong password"
+//	@Failure		401		{object}	examples.TwoFactorRequiredResponse		"Unauthorized - two-factor authentication code required"
+//	@Failure		409		{object}	examples.NoOtherRecoveryMethod			"Conflict - account has no other recovery method"
+//	@Failure		422		{object}	examples.UnprocessableEntityResponse	"Unprocessable entity - invalid request types"
+//	@Failure		429		{object}	examples.TooManyRequestsResponse		"Too many requests - received too many requests"
+//	@Router			/api/account/email/unlink [post].
+func (h *AccountHandler) UnlinkEmail(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "AccountHandler.UnlinkEmail")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+
+	req, err := getAndValidateRequest[request.UnlinkEmailRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.accountService.UnlinkEmail(ctx, user, req.Password, req.TwoFactorCode)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// GetEmailHistoryByAuthorization returns email history of the current user
+//
+//	@Summary		Get own email history
+//	@Description	Returns links, changes and unlinks of account email, most recent first
+//	@Tags			Account
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		dto.EmailHistoryDTO					"Email history"
+//	@Failure		429	{object}	examples.TooManyRequestsResponse	"Too many requests - received too many requests"
+//	@Router			/api/account/email/history [get].
+func (h *AccountHandler) GetEmailHistoryByAuthorization(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "AccountHandler.GetEmailHistoryByAuthorization")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+
+	result, err := h.accountService.GetEmailHistory(ctx, user.ID)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// GetEmailHistoryByUserID returns email history of the user
+//
+//	@Summary		Get user email history
+//	@Description	Returns links, changes and unlinks of user email, most recent first
+//	@Tags			Account
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			user_id	path		int										true	"UserDTO ID"
+//	@Success		200		{array}		dto.EmailHistoryDTO						"Email history"
+//	@Failure		403		{object}	examples.ForbiddenByPermissionsResponse	"Forbidden - not enough rights"
+//	@Failure		429		{object}	examples.TooManyRequestsResponse		"Too many requests - received too many requests"
+//	@Router			/api/users/{user_id}/email/history [get].
+func (h *AccountHandler) GetEmailHistoryByUserID(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "AccountHandler.GetEmailHistoryByUserID")
+	defer span.End()
+
+	userID, err := extractIntParam("user_id", c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.accountService.GetEmailHistory(ctx, userID)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// ChangeUsername handles username change of the current user
+//
+//	@Summary		Change username
+//	@Description	Sets new username once per 30 days, every change except the first one costs 500 coins. The old username stays bound to the account for 14 days. Access tokens of other sessions stop working and have to be refreshed, the current session gets a new access token
+//	@Tags			Account
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		request.ChangeUsernameRequest			true	"New username"
+//	@Success		200		{object}	examples.UsernameChangedSuccessResponse	"Username successfully changed"
+//	@Failure		400		{object}	examples.BadRequestResponse				"Bad request - missed request fields"
+//	@Failure		400		{object}	examples.InvalidUsername				"Bad request - username does not satisfy the policy"
+//	@Failure		401		{object}	examples.TwoFactorRequiredResponse		"Unauthorized - two-factor authentication code required"
+//	@Failure		409		{object}	examples.UsernameTaken					"Conflict - username is taken"
+//	@Failure		409		{object}	examples.InsufficientCoins				"Conflict - not enough coins"
+//	@Failure		422		{object}	examples.UnprocessableEntityResponse	"Unprocessable entity - invalid request types"
+//	@Failure		429		{object}	examples.UsernameChangeCooldown			"Too many requests - username has been changed recently"
+//	@Router			/api/account/username/change [post].
+func (h *AccountHandler) ChangeUsername(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "AccountHandler.ChangeUsername")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+	sessionID := mustExtractSessionID(ctx)
+
+	req, err := getAndValidateRequest[request.ChangeUsernameRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.accountService.ChangeUsername(ctx, user, sessionID, req.NewUsername, req.TwoFactorCode)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// GetUsernameHistoryByAuthorization returns username history of the current user
+//
+//	@Summary		Get own username history
+//	@Description	Returns username changes with the time old usernames are released, most recent first
+//	@Tags			Account
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		dto.UsernameHistoryDTO				"Username history"
+//	@Failure		429	{object}	examples.TooManyRequestsResponse	"Too many requests - received too many requests"
+//	@Router			/api/account/username/history [get].
+func (h *AccountHandler) GetUsernameHistoryByAuthorization(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "AccountHandler.GetUsernameHistoryByAuthorization")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+
+	result, err := h.accountService.GetUsernameHistory(ctx, user.ID)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// GetUsernameHistoryByUserID returns username history of the user
+//
+//	@Summary		Get user username history
+//	@Description	Returns username changes of the user with spent coins, most recent first
+//	@Tags			Account
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			user_id	path		int										true	"UserDTO ID"
+//	@Success		200		{array}		dto.UsernameHistoryDTO					"Username history"
+//	@Failure		403		{object}	examples.ForbiddenByPermissionsResponse	"Forbidden - not enough rights"
+//	@Failure		429		{object}	examples.TooManyRequestsResponse		"Too many requests - received too many requests"
+//	@Router			/api/users/{user_id}/username/history [get].
+func (h *AccountHandler) GetUsernameHistoryByUserID(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "AccountHandler.GetUsernameHistoryByUserID")
+	defer span.End()
+
+	userID, err := extractIntParam("user_id", c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.accountService.GetUsernameHistory(ctx, userID)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// ChangeEmailByAdmin sets user email without confirmation
+//
+//	@Summary		Change user email
+//	@Description	Sets user email without confirmation codes and cooldown. The change is recorded in email history with performer and reason
+//	@Tags			Account
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			user_id	path		int										true	"UserDTO ID"
+//	@Param			request	body		request.ChangeEmailByAdminRequest		true	"New email and reason"
+//	@Success		200		{object}	dto.UserDTO								"Email successfully changed"
+//	@Failure		400		{object}	examples.BadRequestResponse				"Bad request - missed request fields"
+//	@Failure		403		{object}	examples.ForbiddenByPermissionsResponse	"Forbidden - not enough rights"
+//	@Failure		404		{object}	examples.UserNotFoundResponse			"Not found - user not found"
+//	@Failure		409		{object}	examples.EmailConflict					"Conflict - someone already has this email as linked"
+//	@Failure		422		{object}	examples.UnprocessableEntityResponse	"Unprocessable entity - invalid request types"
+//	@Failure		429		{object}	examples.TooManyRequestsResponse		"Too many requests - received too many requests"
+//	@Router			/api/users/{user_id}/email [put].
+func (h *AccountHandler) ChangeEmailByAdmin(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "AccountHandler.ChangeEmailByAdmin")
+	defer span.End()
+
+	admin := mustExtractUser(ctx)
+
+	userID, err := extractIntParam("user_id", c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	req, err := getAndValidateRequest[request.ChangeEmailByAdminRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.accountService.ChangeEmailByAdmin(ctx, admin, userID, req.Email, req.Reason)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// SendCodeForPasswordReset handles sending verification code for password reset
+//
+//	@Summary		Send password reset code
+//	@Description	Sends password reset code to the linked email. Responds the same way if no account has this email or the code has been sent recently
+//	@Tags			Account
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body	request.PasswordResetRequest	true	"Linked email"
+//	@Success		204		"Code successfully sent"
+//	@Failure		400		{object}	examples.BadRequestResponse				"Bad request - missed request fields"
+//	@Failure		422		{object}	examples.UnprocessableEntityResponse	"Unprocessable entity - invalid request types"
+//	@Failure		429		{object}	examples.TooManyRequestsResponse		"Too many requests - received too many requests"
+//	@Router			/api/auth/password_reset/get_code [post].
+func (h *AccountHandler) SendCodeForPasswordReset(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "AccountHandler.SendCodeForPasswordReset")
+	defer span.End()
+
+	req, err := getAndValidateRequest[request.PasswordResetRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	err = h.accountService.SendCodeForPasswordReset(ctx, req.Email)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendNoContent(c)
+}
+
+// ResetPassword verifies password reset code and sets new password
+//
+//	@Summary		Reset password
+//	@Description	Verifies sent code, sets new password and ends all sessions of the account
+//	@Tags			Account
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body	request.EnterCodeForPasswordResetRequest	true	"Verification code from email and new password"
+//	@Success		204		"Password successfully reset"
+//	@Failure		400		{object}	examples.BadRequestResponse				"Bad request - missed request fields"
+//	@Failure		400		{object}	examples.WrongVerificationCode			"Bad request - wrong verification code"
+//	@Failure		400		{object}	examples.WeakPassword					"Bad request - password does not satisfy the policy"
+//	@Failure		400		{object}	examples.PasswordRecentlyUsed			"Bad request - password has been used recently"
+//	@Failure		401		{object}	examples.TwoFactorRequiredResponse		"Unauthorized - two-factor authentication code required"
+//	@Failure		422		{object}	examples.UnprocessableEntityResponse	"Unprocessable entity - invalid request types"
+//	@Failure		429		{object}	examples.TooManyRequestsResponse		"Too many requests - received too many requests"
+//	@Router			/api/auth/password_reset/enter_code [post].
+func (h *AccountHandler) ResetPassword(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "AccountHandler.ResetPassword")
+	defer span.End()
+
+	req, err := getAndValidateRequest[request.EnterCodeForPasswordResetRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	err = h.accountService.ResetPassword(
+		ctx,
+		req.Email,
+		req.VerificationCode,
+		req.NewPassword,
+		req.TwoFactorCode,
+	)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendNoContent(c)
+}
+
+// ChangePassword handles password change of the current user
+//
+//	@Summary		Change password
+//	@Description	Sets new password, ends all other sessions of the account and notifies the linked email
+//	@Tags			Account
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body	request.ChangePasswordRequest	true	"Current and new passwords"
+//	@Success		204		"Password successfully changed"
+//	@Failure		400		{object}	examples.BadRequestResponse				"Bad request - missed request fields"
+//	@Failure		400		{object}	examples.WeakPassword					"Bad request - password does not satisfy the policy"
+//	@Failure		400		{object}	examples.PasswordRecentlyUsed			"Bad request - password has been used recently"
+//	@Failure		401		{object}	examples.UserWrongPasswordResponse		"Unauthorized - wrong password"
+//	@Failure		401		{object}	examples.TwoFactorRequiredResponse		"Unauthorized - two-factor authentication code required"
+//	@Failure		409		{object}	examples.PasswordChangedConcurrently	"Conflict - password has been changed concurrently"
+//	@Failure		422		{object}	examples.UnprocessableEntityResponse	"Unprocessable entity - invalid request types"
+//	@Failure		429		{object}	examples.TooManyRequestsResponse		"Too many requests - received too many requests"
+//	@Router			/api/account/password/change [post].
+func (h *AccountHandler) ChangePassword(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "AccountHandler.ChangePassword")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+	sessionID := mustExtractSessionID(ctx)
+
+	req, err := getAndValidateRequest[request.ChangePasswordRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	err = h.accountService.ChangePassword(
+		ctx,
+		user,
+		sessionID,
+		req.OldPassword,
+		req.NewPassword,
+		req.TwoFactorCode,
+	)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendNoContent(c)
+}
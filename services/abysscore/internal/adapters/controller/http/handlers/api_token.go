@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/dto/request"
+	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+)
+
+type APITokenHandler struct {
+	apiTokenService domainservice.APITokenService
+}
+
+func NewAPITokenHandler(apiTokenService domainservice.APITokenService) *APITokenHandler {
+	return &APITokenHandler{apiTokenService: apiTokenService}
+}
+
+// Issue creates personal API token of the authenticated user
+//
+//	@Summary		Issue API token
+//	@Description	Creates scoped token for third-party tools, the token itself is returned only once. API tokens are sent with "Token" scheme in Authorization header
+//	@Tags			API tokens
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		request.IssueAPITokenRequest				true	"Token name, scopes and lifetime"
+//	@Success		200		{object}	examples.IssuedAPITokenSuccessResponse		"Token successfully issued"
+//	@Failure		400		{object}	examples.BadRequestResponse					"Bad request - missed request fields"
+//	@Failure		400		{object}	examples.UnknownAPITokenScopeResponse		"Bad request - unknown scope"
+//	@Failure		400		{object}	examples.InvalidAPITokenLifetimeResponse	"Bad request - lifetime is out of range"
+//	@Failure		401		{object}	examples.UserWrongPasswordResponse			"Unauthorized - wrong password"
+//	@Failure		401		{object}	examples.TwoFactorRequiredResponse			"Unauthorized - two-factor authentication code required"
+//	@Failure		409		{object}	examples.APITokenLimitReachedResponse		"Conflict - too many active tokens"
+//	@Failure		422		{object}	examples.UnprocessableEntityResponse		"Unprocessable entity - invalid request types"
+//	@Failure		429		{object}	examples.TooManyRequestsResponse			"Too many requests - received too many requests"
+//	@Router			/api/account/api_tokens [post].
+func (h *APITokenHandler) Issue(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "APITokenHandler.Issue")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+
+	req, err := getAndValidateRequest[request.IssueAPITokenRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.apiTokenService.Issue(ctx, user, req.ToIssueAPITokenDTO())
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// FindByAuthorization lists API tokens of the authenticated user
+//
+//	@Summary		List API tokens
+//	@Description	Returns tokens including expired ones, newest first
+//	@Tags			API tokens
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	examples.APITokensSuccessResponse	"User API tokens"
+//	@Router			/api/account/api_tokens [get].
+func (h *APITokenHandler) FindByAuthorization(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "APITokenHandler.FindByAuthorization")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+
+	result, err := h.apiTokenService.FindByUserID(ctx, user.ID)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// Revoke deletes API token of the authenticated user
+//
+//	@Summary		Revoke API token
+//	@Description	Token stops working immediately
+//	@Tags			API tokens
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			token_id	path	int	true	"API token ID"
+//	@Success		204			"Token successfully revoked"
+//	@Failure		404			{object}	examples.APITokenNotFoundResponse	"Not found - api token not found"
+//	@Router			/api/account/api_tokens/{token_id} [delete].
+func (h *APITokenHandler) Revoke(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "APITokenHandler.Revoke")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+
+	tokenID, err := extractIntParam("token_id", c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	err = h.apiTokenService.Revoke(ctx, user.ID, tokenID)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendNoContent(c)
+}
@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/dto/request"
+	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+)
+
+const (
+	jsonLinesContentType = "application/x-ndjson"
+	auditLogExportHeader = `attachment; filename="audit_log.jsonl"`
+)
+
+type AuditLogHandler struct {
+	auditLogService domainservice.AuditLogService
+}
+
+func NewAuditLogHandler(auditLogService domainservice.AuditLogService) *AuditLogHandler {
+	return &AuditLogHandler{auditLogService: auditLogService}
+}
+
+// FindPaged returns paginated audit log
+//
+//	@Summary		List audit log
+//	@Description	Returns privileged actions, most recent first. Before and after contain only changed fields
+//	@Tags			Audit log
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			actor_id		query		int										false	"Performer user ID"
+//	@Param			action			query		string									false	"Action (e.g. game_item.update)"
+//	@Param			target_type		query		string									false	"Target type (e.g. game_item)"
+//	@Param			target_id		query		string									false	"Target ID"
+//	@Param			request_id		query		string									false	"Request ID"
+//	@Param			created_after	query		string									false	"RFC 3339 lower bound"
+//	@Param			created_before	query		string									false	"RFC 3339 upper bound"
+//	@Param			page			query		int										false	"Page number (default: 1)"
+//	@Param			size			query		int										false	"Page size (default: 10)"
+//	@Success		200				{object}	examples.PaginatedAuditLogDTOResponse	"Paginated audit log"
+//	@Failure		400				{object}	examples.BadRequestResponse				"Bad request - invalid filter"
+//	@Failure		403				{object}	examples.ForbiddenByPermissionsResponse	"Forbidden - not enough rights"
+//	@Router			/api/audit_log [get].
+func (h *AuditLogHandler) FindPaged(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "AuditLogHandler.FindPaged")
+	defer span.End()
+
+	filter, err := request.NewAuditLogFilter(c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.auditLogService.FindPaged(
+		ctx,
+		filter,
+		c.QueryInt("page", 0),
+		c.QueryInt("size", 0),
+	)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccessPagination(result, c)
+}
+
+// Export returns matching audit log as JSON-lines
+//
+//	@Summary		Export audit log
+//	@Description	Returns all matching records as JSON-lines file, oldest first. Accepts the same filters as the list
+//	@Tags			Audit log
+//	@Produce		application/x-ndjson
+//	@Security		BearerAuth
+//	@Param			actor_id		query		int										false	"Performer user ID"
+//	@Param			action			query		string									false	"Action (e.g. game_item.update)"
+//	@Param			target_type		query		string									false	"Target type (e.g. game_item)"
+//	@Param			target_id		query		string									false	"Target ID"
+//	@Param			request_id		query		string									false	"Request ID"
+//	@Param			created_after	query		string									false	"RFC 3339 lower bound"
+//	@Param			created_before	query		string									false	"RFC 3339 upper bound"
+//	@Success		200				{string}	string									"One audit log record per line"
+//	@Failure		400				{object}	examples.BadRequestResponse				"Bad request - invalid filter"
+//	@Failure		403				{object}	examples.ForbiddenByPermissionsResponse	"Forbidden - not enough rights"
+//	@Router			/api/audit_log/export [get].
+func (h *AuditLogHandler) Export(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "AuditLogHandler.Export")
+	defer span.End()
+
+	filter, err := request.NewAuditLogFilter(c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	err = h.auditLogService.Export(ctx, filter, c.Response().BodyWriter())
+	if err != nil {
+		c.Response().ResetBody()
+
+		return handleError(err, c)
+	}
+
+	c.Set(fiber.HeaderContentType, jsonLinesContentType)
+	c.Set(fiber.HeaderContentDisposition, auditLogExportHeader)
+
+	return nil
+}
@@ -7,6 +7,9 @@ import (
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
 )
 
+// jwksCacheControl lets verifiers cache keys for a while, a new key is published before it signs tokens.
+const jwksCacheControl = "public, max-age=300"
+
 type AuthenticationHandler struct {
 	authenticationService domainservice.AuthenticationService
 }
@@ -29,6 +32,7 @@ func NewAuthenticationHandler(
 //	@Param			request	body		request.AuthenticationRequest			true	"UserDTO registration details"
 //	@Success		200		{object}	examples.AuthenticationSuccessResponse	"UserDTO successfully registered"
 //	@Failure		400		{object}	examples.BadRequestResponse				"Bad request - missed request fields"
+//	@Failure		400		{object}	examples.WeakPassword					"Bad request - password does not satisfy the policy"
 //	@Failure		409		{object}	examples.UsernameConflictResponse		"Conflict - user with this username already exists"
 //	@Failure		409		{object}	examples.HardwareIDConflictResponse		"Conflict - only one account per device allowed"
 //	@Failure		422		{object}	examples.UnprocessableEntityResponse	"Unprocessable entity - invalid request types"
@@ -45,7 +49,7 @@ func (h *AuthenticationHandler) Register(c *fiber.Ctx) error {
 		return handleError(err, c)
 	}
 
-	result, err := h.authenticationService.Register(ctx, req.ToCredentialsDTO())
+	result, err := h.authenticationService.Register(ctx, req.ToCredentialsDTO(), extractClientInfo(c))
 	if err != nil {
 		return handleError(err, c)
 	}
@@ -65,11 +69,14 @@ func (h *AuthenticationHandler) Register(c *fiber.Ctx) error {
 //	@Failure		400		{object}	examples.BadRequestResponse				"Bad request - missed request fields"
 //	@Failure		401		{object}	examples.UserWrongPasswordResponse		"Unauthorized - wrong password"
 //	@Failure		401		{object}	examples.UserWrongHardwareIDResponse	"Unauthorized - wrong hardware id"
+//	@Failure		401		{object}	examples.TwoFactorRequiredResponse		"Unauthorized - two-factor authentication code required"
+//	@Failure		403		{object}	examples.LoginLockedResponse			"Forbidden - login is locked after too many failures"
 //	@Failure		404		{object}	examples.UserNotFoundResponse			"Not found - user with this username not found"
 //	@Failure		409		{object}	examples.UsernameConflictResponse		"Conflict - user with this username already exists"
 //	@Failure		409		{object}	examples.HardwareIDConflictResponse		"Conflict - only one account per device allowed"
 //	@Failure		422		{object}	examples.UnprocessableEntityResponse	"Unprocessable entity - invalid request types"
 //	@Failure		429		{object}	examples.TooManyRequestsResponse		"Too many requests - received too many auth requests"
+//	@Failure		429		{object}	examples.TooManyLoginFailuresResponse	"Too many requests - locked out after failed logins"
 //	@Router			/api/auth/login [post].
 func (h *AuthenticationHandler) Login(c *fiber.Ctx) error {
 	ctx := c.UserContext()
@@ -82,7 +89,7 @@ func (h *AuthenticationHandler) Login(c *fiber.Ctx) error {
 		return handleError(err, c)
 	}
 
-	result, err := h.authenticationService.Authenticate(ctx, req.ToCredentialsDTO())
+	result, err := h.authenticationService.Authenticate(ctx, req.ToCredentialsDTO(), extractClientInfo(c))
 	if err != nil {
 		return handleError(err, c)
 	}
@@ -90,36 +97,88 @@ func (h *AuthenticationHandler) Login(c *fiber.Ctx) error {
 	return sendSuccess(result, c)
 }
 
-// ChangePassword handles changing user password
+// Refresh handles refresh token rotation
 //
-//	@Summary		Change user password
-//	@Description	Changes the password for an existing user
+//	@Summary		Refresh tokens
+//	@Description	Rotates the refresh token and issues a new access token. Reusing an already rotated refresh token revokes all tokens issued by it
 //	@Tags			Authentication
 //	@Accept			json
 //	@Produce		json
-//	@Param			request	body		request.PasswordChangeRequest			true	"Password change details"
-//	@Success		200		{object}	examples.AuthenticationSuccessResponse	"Password successfully changed"
+//	@Param			request	body		request.RefreshTokenRequest				true	"Refresh token"
+//	@Success		200		{object}	examples.AuthenticationSuccessResponse	"Tokens successfully refreshed"
 //	@Failure		400		{object}	examples.BadRequestResponse				"Bad request - missed request fields"
-//	@Failure		401		{object}	examples.UserWrongPasswordResponse		"Unauthorized - wrong password"
-//	@Failure		404		{object}	examples.UserNotFoundResponse			"Not found - user with this username not found"
+//	@Failure		401		{object}	examples.InvalidRefreshTokenResponse	"Unauthorized - refresh token is invalid, expired or revoked"
+//	@Failure		401		{object}	examples.RefreshTokenReusedResponse		"Unauthorized - refresh token reuse detected"
 //	@Failure		422		{object}	examples.UnprocessableEntityResponse	"Unprocessable entity - invalid request types"
 //	@Failure		429		{object}	examples.TooManyRequestsResponse		"Too many requests - received too many auth requests"
-//	@Router			/api/auth/change_password [post].
-func (h *AuthenticationHandler) ChangePassword(c *fiber.Ctx) error {
+//	@Router			/api/auth/refresh [post].
+func (h *AuthenticationHandler) Refresh(c *fiber.Ctx) error {
 	ctx := c.UserContext()
 
-	ctx, span := tracer.StartSpan(ctx, "AuthenticationHandler.ChangePassword")
+	ctx, span := tracer.StartSpan(ctx, "AuthenticationHandler.Refresh")
 	defer span.End()
 
-	req, err := getAndValidateRequest[request.PasswordChangeRequest](c)
+	req, err := getAndValidateRequest[request.RefreshTokenRequest](c)
 	if err != nil {
 		return handleError(err, c)
 	}
 
-	result, err := h.authenticationService.ChangePassword(ctx, req.ToDTO())
+	result, err := h.authenticationService.Refresh(ctx, req.RefreshToken, extractClientInfo(c))
 	if err != nil {
 		return handleError(err, c)
 	}
 
 	return sendSuccess(result, c)
 }
+
+// Revoke handles refresh token revocation
+//
+//	@Summary		Revoke tokens
+//	@Description	Revokes the refresh token with all access tokens issued by it
+//	@Tags			Authentication
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body	request.RefreshTokenRequest	true	"Refresh token"
+//	@Success		204		"Tokens successfully revoked"
+//	@Failure		400		{object}	examples.BadRequestResponse				"Bad request - missed request fields"
+//	@Failure		401		{object}	examples.InvalidRefreshTokenResponse	"Unauthorized - refresh token is invalid or expired"
+//	@Failure		422		{object}	examples.UnprocessableEntityResponse	"Unprocessable entity - invalid request types"
+//	@Failure		429		{object}	examples.TooManyRequestsResponse		"Too many requests - received too many auth requests"
+//	@Router			/api/auth/revoke [post].
+func (h *AuthenticationHandler) Revoke(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "AuthenticationHandler.Revoke")
+	defer span.End()
+
+	req, err := getAndValidateRequest[request.RefreshTokenRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	err = h.authenticationService.RevokeRefreshToken(ctx, req.RefreshToken)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendNoContent(c)
+}
+
+// JWKS returns public keys access tokens are signed with
+//
+//	@Summary		Get token verification keys
+//	@Description	Returns JSON Web Key Set with public keys of the current and previous signing keys. Keys are picked by "kid" token header, the response is not wrapped and can be cached for a few minutes
+//	@Tags			Authentication
+//	@Produce		json
+//	@Success		200	{object}	dto.JSONWebKeySetDTO	"Public keys"
+//	@Router			/.well-known/jwks.json [get].
+func (h *AuthenticationHandler) JWKS(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "AuthenticationHandler.JWKS")
+	defer span.End()
+
+	c.Set(fiber.HeaderCacheControl, jwksCacheControl)
+
+	return c.Status(fiber.StatusOK).JSON(h.authenticationService.PublicKeys(ctx))
+}
@@ -21,6 +21,10 @@ var errUserNotFoundInContext = apperrors.WrapInternalServerError(
 	errors.New("user not found in context"), //nolint:err113
 )
 
+var errSessionNotFoundInContext = apperrors.WrapInternalServerError(
+	errors.New("session not found in context"), //nolint:err113
+)
+
 // getAndValidateRequest parses and validates the request body into the given generic struct T.
 // returns the parsed struct or a validation/parsing error.
 func getAndValidateRequest[T interface{}](c *fiber.Ctx) (*T, error) {
@@ -68,6 +72,25 @@ func mustExtractUser(ctx context.Context) *dto.UserDTO {
 	return user
 }
 
+// mustExtractSessionID retrieves session id of the authenticated user from the context.
+// can be used if handler has authentication middleware.
+func mustExtractSessionID(ctx context.Context) string {
+	sessionID, ok := ctx.Value(middleware.SessionIDCtxKey).(string)
+	if !ok {
+		panic(errSessionNotFoundInContext)
+	}
+
+	return sessionID
+}
+
+// extractClientInfo retrieves info about the client a session is created from.
+func extractClientInfo(c *fiber.Ctx) *dto.ClientInfoDTO {
+	return &dto.ClientInfoDTO{
+		IP:        c.IP(),
+		UserAgent: c.Get(fiber.HeaderUserAgent),
+	}
+}
+
 // extractIntParam extracts an integer route parameter by key.
 // returns a BadRequest error if the parameter is missing or invalid.
 func extractIntParam(key string, c *fiber.Ctx) (int, error) {
@@ -98,3 +121,12 @@ func sendNoContent(c *fiber.Ctx) error {
 func sendSuccessPagination[T any](data *dto.PaginatedResult[T], c *fiber.Ctx) error {
 	return response.SuccessPagination(data, c)
 }
+
+// sendSuccessPaginationWithSummary sends a paginated JSON response with an additional summary.
+func sendSuccessPaginationWithSummary[T any](
+	data *dto.PaginatedResult[T],
+	summary interface{},
+	c *fiber.Ctx,
+) error {
+	return response.SuccessPaginationWithSummary(data, summary, c)
+}
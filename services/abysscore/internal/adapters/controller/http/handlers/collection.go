@@ -0,0 +1,132 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/dto/request"
+	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+)
+
+type CollectionHandler struct {
+	collectionService domainservice.CollectionService
+}
+
+func NewCollectionHandler(collectionService domainservice.CollectionService) *CollectionHandler {
+	return &CollectionHandler{collectionService: collectionService}
+}
+
+// GetProgressByAuthorization gets collections progress for the authenticated user
+//
+//	@Summary		Get current user's collections progress
+//	@Description	Returns every collection with owned/total counts and missing items for the currently authenticated user
+//	@Tags			Collections
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		APITokenAuth
+//	@Success		200	{object}	examples.CollectionProgressSuccessResponse	"Collections progress"
+//	@Router			/api/users/collections [get].
+func (h *CollectionHandler) GetProgressByAuthorization(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "CollectionHandler.GetProgressByAuthorization")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+
+	result, err := h.collectionService.FindProgressByUserID(ctx, user.ID)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// GetProgressByUserID gets collections progress for a specific user
+//
+//	@Summary		Get user's collections progress
+//	@Description	Admin retrieves every collection with owned/total counts and missing items for a specified user
+//	@Tags			Collections
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			user_id	path		int											true	"UserDTO ID"
+//	@Success		200		{object}	examples.CollectionProgressSuccessResponse	"Collections progress"
+//	@Failure		400		{object}	examples.BadRequestResponse					"Bad request - invalid ID"
+//	@Failure		403		{object}	examples.ForbiddenByPermissionsResponse		"Forbidden - not enough rights"
+//	@Router			/api/users/{user_id}/collections [get].
+func (h *CollectionHandler) GetProgressByUserID(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "CollectionHandler.GetProgressByUserID")
+	defer span.End()
+
+	userID, err := extractIntParam("user_id", c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.collectionService.FindProgressByUserID(ctx, userID)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// GetRewards gets configured collection completion rewards
+//
+//	@Summary		List collection rewards
+//	@Description	Returns configured collection completion rewards
+//	@Tags			Collections
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	examples.CollectionRewardsSuccessResponse	"Collection rewards"
+//	@Router			/api/collections/rewards [get].
+func (h *CollectionHandler) GetRewards(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "CollectionHandler.GetRewards")
+	defer span.End()
+
+	result, err := h.collectionService.FindAllRewards(ctx)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// SetReward sets a collection completion reward
+//
+//	@Summary		Set collection reward
+//	@Description	Admin configures coins, title or badge item granted once on collection completion
+//	@Tags			Collections
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		request.SetCollectionReward					true	"Collection reward"
+//	@Success		200		{object}	examples.CollectionRewardSuccessResponse	"Collection reward"
+//	@Failure		400		{object}	examples.BadRequestResponse					"Bad request - missed request fields"
+//	@Failure		403		{object}	examples.ForbiddenByPermissionsResponse		"Forbidden - not enough rights"
+//	@Failure		404		{object}	examples.GameItemNotFound					"Not found - badge item not found"
+//	@Failure		422		{object}	examples.UnprocessableEntityResponse		"Unprocessable entity - invalid request types"
+//	@Router			/api/collections/rewards [put].
+func (h *CollectionHandler) SetReward(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "CollectionHandler.SetReward")
+	defer span.End()
+
+	admin := mustExtractUser(ctx)
+
+	req, err := getAndValidateRequest[request.SetCollectionReward](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.collectionService.SetReward(ctx, req, admin)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
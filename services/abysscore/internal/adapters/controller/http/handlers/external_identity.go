@@ -0,0 +1,303 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/dto/request"
+	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+)
+
+type ExternalIdentityHandler struct {
+	authenticationService domainservice.AuthenticationService
+}
+
+func NewExternalIdentityHandler(
+	authenticationService domainservice.AuthenticationService,
+) *ExternalIdentityHandler {
+	return &ExternalIdentityHandler{authenticationService: authenticationService}
+}
+
+// FindProviders returns configured identity providers
+//
+//	@Summary		Get identity providers
+//	@Description	Returns names of identity providers available for sign in
+//	@Tags			Authentication
+//	@Produce		json
+//	@Success		200	{object}	examples.OIDCProvidersSuccessResponse	"Identity providers"
+//	@Failure		429	{object}	examples.TooManyRequestsResponse		"Too many requests - received too many auth requests"
+//	@Router			/api/auth/oidc/providers [get].
+func (h *ExternalIdentityHandler) FindProviders(c *fiber.Ctx) error {
+	_, span := tracer.StartSpan(c.UserContext(), "ExternalIdentityHandler.FindProviders")
+	defer span.End()
+
+	return sendSuccess(h.authenticationService.OIDCProviders(), c)
+}
+
+// Start handles start of sign in with identity provider
+//
+//	@Summary		Start sign in with identity provider
+//	@Description	Returns provider authorization url. Authorization is bound to the hardware id and must be finished from the same device
+//	@Tags			Authentication
+//	@Accept			json
+//	@Produce		json
+//	@Param			provider	path		string										true	"Identity provider"
+//	@Param			request		body		request.StartOIDCRequest					true	"Hardware id"
+//	@Success		200			{object}	examples.OIDCAuthorizationSuccessResponse	"Authorization started"
+//	@Failure		400			{object}	examples.BadRequestResponse					"Bad request - missed request fields"
+//	@Failure		400			{object}	examples.UnknownIdentityProviderResponse	"Bad request - unknown identity provider"
+//	@Failure		422			{object}	examples.UnprocessableEntityResponse		"Unprocessable entity - invalid request types"
+//	@Failure		429			{object}	examples.TooManyRequestsResponse			"Too many requests - received too many auth requests"
+//	@Router			/api/auth/oidc/{provider}/start [post].
+func (h *ExternalIdentityHandler) Start(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "ExternalIdentityHandler.Start")
+	defer span.End()
+
+	req, err := getAndValidateRequest[request.StartOIDCRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.authenticationService.StartOIDC(ctx, c.Params("provider"), req.HardwareID)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// Callback handles finish of sign in with identity provider
+//
+//	@Summary		Finish sign in with identity provider
+//	@Description	Exchanges authorization code. Returns tokens if the identity is linked to an account, otherwise returns registration token to pick username for a new account
+//	@Tags			Authentication
+//	@Accept			json
+//	@Produce		json
+//	@Param			provider	path		string										true	"Identity provider"
+//	@Param			request		body		request.OIDCCallbackRequest					true	"Authorization response"
+//	@Success		200			{object}	examples.OIDCLoginSuccessResponse			"Successfully authenticated or registration required"
+//	@Failure		400			{object}	examples.BadRequestResponse					"Bad request - missed request fields"
+//	@Failure		400			{object}	examples.InvalidOIDCStateResponse			"Bad request - authorization is expired or started from another device"
+//	@Failure		401			{object}	examples.OIDCAuthorizationFailedResponse	"Unauthorized - provider rejected authorization"
+//	@Failure		401			{object}	examples.UserWrongHardwareIDResponse		"Unauthorized - wrong hardware id"
+//	@Failure		401			{object}	examples.TwoFactorRequiredResponse			"Unauthorized - two-factor authentication code required"
+//	@Failure		422			{object}	examples.UnprocessableEntityResponse		"Unprocessable entity - invalid request types"
+//	@Failure		429			{object}	examples.TooManyRequestsResponse			"Too many requests - received too many auth requests"
+//	@Failure		429			{object}	examples.TooManyLoginFailuresResponse		"Too many requests - locked out after failed logins"
+//	@Router			/api/auth/oidc/{provider}/callback [post].
+func (h *ExternalIdentityHandler) Callback(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "ExternalIdentityHandler.Callback")
+	defer span.End()
+
+	req, err := getAndValidateRequest[request.OIDCCallbackRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.authenticationService.FinishOIDC(
+		ctx,
+		c.Params("provider"),
+		req.ToOIDCCallbackDTO(),
+		extractClientInfo(c),
+	)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// Register handles registration with identity provider
+//
+//	@Summary		Register with identity provider
+//	@Description	Creates account with the picked username and links the identity to it
+//	@Tags			Authentication
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		request.OIDCRegistrationRequest					true	"Registration token and username"
+//	@Success		200		{object}	examples.AuthenticationSuccessResponse			"UserDTO successfully registered"
+//	@Failure		400		{object}	examples.BadRequestResponse						"Bad request - missed request fields"
+//	@Failure		400		{object}	examples.InvalidRegistrationTokenResponse		"Bad request - registration token is invalid or expired"
+//	@Failure		409		{object}	examples.UsernameConflictResponse				"Conflict - user with this username already exists"
+//	@Failure		409		{object}	examples.HardwareIDConflictResponse				"Conflict - only one account per device allowed"
+//	@Failure		409		{object}	examples.ExternalIdentityAlreadyLinkedResponse	"Conflict - identity is already linked to an account"
+//	@Failure		422		{object}	examples.UnprocessableEntityResponse			"Unprocessable entity - invalid request types"
+//	@Failure		429		{object}	examples.TooManyRequestsResponse				"Too many requests - received too many auth requests"
+//	@Router			/api/auth/oidc/register [post].
+func (h *ExternalIdentityHandler) Register(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "ExternalIdentityHandler.Register")
+	defer span.End()
+
+	req, err := getAndValidateRequest[request.OIDCRegistrationRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.authenticationService.RegisterOIDC(
+		ctx,
+		req.RegistrationToken,
+		req.Username,
+		req.HardwareID,
+		extractClientInfo(c),
+	)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// FindByAuthorization returns identities linked to the current user
+//
+//	@Summary		Get linked identities
+//	@Description	Returns identity provider accounts linked to the account
+//	@Tags			Account
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	examples.ExternalIdentitiesSuccessResponse	"Linked identities"
+//	@Failure		429	{object}	examples.TooManyRequestsResponse			"Too many requests - received too many requests"
+//	@Router			/api/account/external_identities [get].
+func (h *ExternalIdentityHandler) FindByAuthorization(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "ExternalIdentityHandler.FindByAuthorization")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+
+	result, err := h.authenticationService.FindExternalIdentities(ctx, user.ID)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// StartLink handles start of identity linking
+//
+//	@Summary		Start identity linking
+//	@Description	Returns provider authorization url to link its account to the current account
+//	@Tags			Account
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			provider	path		string										true	"Identity provider"
+//	@Success		200			{object}	examples.OIDCAuthorizationSuccessResponse	"Authorization started"
+//	@Failure		400			{object}	examples.UnknownIdentityProviderResponse	"Bad request - unknown identity provider"
+//	@Failure		429			{object}	examples.TooManyRequestsResponse			"Too many requests - received too many auth requests"
+//	@Router			/api/account/external_identities/{provider}/start [post].
+func (h *ExternalIdentityHandler) StartLink(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "ExternalIdentityHandler.StartLink")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+
+	result, err := h.authenticationService.StartOIDCLink(ctx, user, c.Params("provider"))
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// FinishLink handles finish of identity linking
+//
+//	@Summary		Finish identity linking
+//	@Description	Exchanges authorization code and links the identity to the current account
+//	@Tags			Account
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			provider	path		string											true	"Identity provider"
+//	@Param			request		body		request.OIDCLinkCallbackRequest					true	"Authorization response"
+//	@Success		200			{object}	examples.ExternalIdentitySuccessResponse		"Identity successfully linked"
+//	@Failure		400			{object}	examples.BadRequestResponse						"Bad request - missed request fields"
+//	@Failure		400			{object}	examples.InvalidOIDCStateResponse				"Bad request - authorization is expired or started by another user"
+//	@Failure		401			{object}	examples.OIDCAuthorizationFailedResponse		"Unauthorized - provider rejected authorization"
+//	@Failure		409			{object}	examples.ExternalIdentityAlreadyLinkedResponse	"Conflict - identity is already linked to an account"
+//	@Failure		409			{object}	examples.ProviderAlreadyLinkedResponse			"Conflict - account already has identity of this provider"
+//	@Failure		422			{object}	examples.UnprocessableEntityResponse			"Unprocessable entity - invalid request types"
+//	@Failure		429			{object}	examples.TooManyRequestsResponse				"Too many requests - received too many auth requests"
+//	@Router			/api/account/external_identities/{provider}/callback [post].
+func (h *ExternalIdentityHandler) FinishLink(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "ExternalIdentityHandler.FinishLink")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+
+	req, err := getAndValidateRequest[request.OIDCLinkCallbackRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.authenticationService.FinishOIDCLink(
+		ctx,
+		user,
+		c.Params("provider"),
+		req.Code,
+		req.State,
+	)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// Unlink handles identity unlinking
+//
+//	@Summary		Unlink identity
+//	@Description	Removes identity provider account from the account. Account password is required, so the account keeps a way to sign in
+//	@Tags			Account
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			identity_id	path	int										true	"External identity ID"
+//	@Param			request		body	request.UnlinkExternalIdentityRequest	true	"Account password"
+//	@Success		204			"Identity successfully unlinked"
+//	@Failure		400			{object}	examples.BadRequestResponse					"Bad request - missed request fields"
+//	@Failure		401			{object}	examples.UserWrongPasswordResponse			"Unauthorized - wrong password"
+//	@Failure		401			{object}	examples.TwoFactorRequiredResponse			"Unauthorized - two-factor authentication code required"
+//	@Failure		404			{object}	examples.ExternalIdentityNotFoundResponse	"Not found - identity is not linked to the account"
+//	@Failure		422			{object}	examples.UnprocessableEntityResponse		"Unprocessable entity - invalid request types"
+//	@Failure		429			{object}	examples.TooManyRequestsResponse			"Too many requests - received too many auth requests"
+//	@Router			/api/account/external_identities/{identity_id}/unlink [post].
+func (h *ExternalIdentityHandler) Unlink(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "ExternalIdentityHandler.Unlink")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+
+	identityID, err := extractIntParam("identity_id", c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	req, err := getAndValidateRequest[request.UnlinkExternalIdentityRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	err = h.authenticationService.UnlinkExternalIdentity(
+		ctx,
+		user,
+		identityID,
+		req.Password,
+		req.TwoFactorCode,
+	)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendNoContent(c)
+}
@@ -45,7 +45,7 @@ func NewGameItemHandler(gameItemService domainservice.GameItemService) *GameItem
 //	@Param			request	body		request.CreateUpdateGameItem				true	"Game item data"
 //	@Success		200		{object}	examples.CreateGameItemDTOSuccessResponse	"Created game item"
 //	@Failure		400		{object}	examples.BadRequestResponse					"Bad request - missed request fields"
-//	@Failure		403		{object}	examples.ForbiddenByAccessLevelResponse		"Forbidden - not enough rights"
+//	@Failure		403		{object}	examples.ForbiddenByPermissionsResponse		"Forbidden - not enough rights"
 //	@Failure		422		{object}	examples.UnprocessableEntityResponse		"Unprocessable entity - invalid request types"
 //	@Router			/api/items [post].
 func (h *GameItemHandler) Create(c *fiber.Ctx) error {
@@ -102,15 +102,23 @@ func (h *GameItemHandler) FindByID(c *fiber.Ctx) error {
 // FindAllPaged returns paginated game items
 //
 //	@Summary		List game items
-//	@Description	Returns a paginated list of game items with sorting
+//	@Description	Returns a paginated list of game items with sorting, filters and text search
 //	@Tags			Game Items
 //	@Produce		json
-//	@Param			page		query		int										false	"Page number (default: 1)"
-//	@Param			size		query		int										false	"Page size (default: 10)"
-//	@Param			order_by	query		string									false	"Field to sort by"	Enums(created_at, name, collection, type, rarity)
-//	@Param			order_type	query		string									false	"Sort order"		Enums(asc, desc)
-//	@Success		200			{object}	examples.PaginatedGameItemsDTOResponse	"Paginated list of game items"
-//	@Failure		400			{object}	examples.BadRequestResponse				"Bad request - invalid query params"
+//	@Param			page			query		int										false	"Page number (default: 1)"
+//	@Param			size			query		int										false	"Page size (default: 10)"
+//	@Param			order_by		query		string									false	"Field to sort by"	Enums(created_at, name, collection, type, rarity)
+//	@Param			order_type		query		string									false	"Sort order"		Enums(asc, desc)
+//	@Param			name			query		string									false	"Name contains (case-insensitive)"
+//	@Param			collection		query		string									false	"Comma separated list of collections"
+//	@Param			type			query		int										false	"Item type"
+//	@Param			rarity_min		query		int										false	"Minimal rarity (inclusive)"
+//	@Param			rarity_max		query		int										false	"Maximal rarity (inclusive)"
+//	@Param			created_after	query		string									false	"Created at or after (RFC 3339)"
+//	@Param			created_before	query		string									false	"Created at or before (RFC 3339)"
+//	@Param			q				query		string									false	"Text search by name or collection"
+//	@Success		200				{object}	examples.PaginatedGameItemsDTOResponse	"Paginated list of game items"
+//	@Failure		400				{object}	examples.BadRequestResponse				"Bad request - invalid query params"
 //	@Router			/api/items [get].
 func (h *GameItemHandler) FindAllPaged(c *fiber.Ctx) error {
 	ctx := c.UserContext()
@@ -123,7 +131,12 @@ func (h *GameItemHandler) FindAllPaged(c *fiber.Ctx) error {
 		return handleError(err, c)
 	}
 
-	result, err := h.gameItemService.FindAllPaged(ctx, paginationQuery)
+	filter, err := request.NewGameItemFilter(c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.gameItemService.FindAllPaged(ctx, paginationQuery, filter)
 	if err != nil {
 		return handleError(err, c)
 	}
@@ -143,7 +156,7 @@ func (h *GameItemHandler) FindAllPaged(c *fiber.Ctx) error {
 //	@Param			request	body	request.CreateUpdateGameItem	true	"Updated game item data"
 //	@Success		204		"Game item updated"
 //	@Failure		400		{object}	examples.BadRequestResponse				"Bad request - missed request fields"
-//	@Failure		403		{object}	examples.ForbiddenByAccessLevelResponse	"Forbidden - not enough rights"
+//	@Failure		403		{object}	examples.ForbiddenByPermissionsResponse	"Forbidden - not enough rights"
 //	@Failure		422		{object}	examples.UnprocessableEntityResponse	"Unprocessable entity - invalid request types"
 //	@Router			/api/items/{id} [put].
 func (h *GameItemHandler) Update(c *fiber.Ctx) error {
@@ -172,19 +185,18 @@ func (h *GameItemHandler) Update(c *fiber.Ctx) error {
 	return sendNoContent(c)
 }
 
-// Delete handles deleting a game item
-// Delete deletes a game item
+// Delete archives a game item
 //
-//	@Summary		Delete game item
-//	@Description	Admin deletes a game item by ID
+//	@Summary		Archive game item
+//	@Description	Admin archives a game item by ID. Archived item is hidden from catalog but stays in inventories
 //	@Tags			Game Items
 //	@Produce		json
 //	@Security		BearerAuth
 //	@Param			id	path	int	true	"Game item ID"
-//	@Success		204	"Game item deleted"
+//	@Success		204	"Game item archived"
 //	@Failure		400	{object}	examples.BadRequestResponse				"Bad request - invalid ID"
-//	@Failure		403	{object}	examples.ForbiddenByAccessLevelResponse	"Forbidden - not enough rights"
-//	@Failure		422	{object}	examples.UnprocessableEntityResponse	"Unprocessable entity - invalid request types"
+//	@Failure		403	{object}	examples.ForbiddenByPermissionsResponse	"Forbidden - not enough rights"
+//	@Failure		404	{object}	examples.GameItemNotFound				"Not found - no such game item"
 //	@Router			/api/items/{id} [delete].
 func (h *GameItemHandler) Delete(c *fiber.Ctx) error {
 	ctx := c.UserContext()
@@ -199,7 +211,41 @@ func (h *GameItemHandler) Delete(c *fiber.Ctx) error {
 		return handleError(err, c)
 	}
 
-	err = h.gameItemService.Delete(ctx, itemID, admin)
+	err = h.gameItemService.Archive(ctx, itemID, admin)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendNoContent(c)
+}
+
+// Restore restores an archived game item
+//
+//	@Summary		Restore game item
+//	@Description	Admin restores an archived game item back to catalog
+//	@Tags			Game Items
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path	int	true	"Game item ID"
+//	@Success		204	"Game item restored"
+//	@Failure		400	{object}	examples.BadRequestResponse				"Bad request - invalid ID"
+//	@Failure		403	{object}	examples.ForbiddenByPermissionsResponse	"Forbidden - not enough rights"
+//	@Failure		404	{object}	examples.GameItemNotFound				"Not found - no such game item"
+//	@Router			/api/items/{id}/restore [post].
+func (h *GameItemHandler) Restore(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "GameItemHandler.Restore")
+	defer span.End()
+
+	admin := mustExtractUser(ctx)
+
+	itemID, err := extractIntParam("id", c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	err = h.gameItemService.Restore(ctx, itemID, admin)
 	if err != nil {
 		return handleError(err, c)
 	}
@@ -207,6 +253,36 @@ func (h *GameItemHandler) Delete(c *fiber.Ctx) error {
 	return sendNoContent(c)
 }
 
+// FindArchivedPaged returns paginated archived game items
+//
+//	@Summary		List archived game items
+//	@Description	Admin retrieves archived game items with count of users owning each item
+//	@Tags			Game Items
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			page	query		int												false	"Page number (default: 1)"
+//	@Param			size	query		int												false	"Page size (default: 10)"
+//	@Success		200		{object}	examples.PaginatedArchivedGameItemsDTOResponse	"Paginated list of archived game items"
+//	@Failure		403		{object}	examples.ForbiddenByPermissionsResponse			"Forbidden - not enough rights"
+//	@Router			/api/items/archived [get].
+func (h *GameItemHandler) FindArchivedPaged(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "GameItemHandler.FindArchivedPaged")
+	defer span.End()
+
+	result, err := h.gameItemService.FindArchivedPaged(
+		ctx,
+		c.QueryInt("page", 0),
+		c.QueryInt("size", 0),
+	)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccessPagination(result, c)
+}
+
 // getPaginationQuery gets pagination query parameters from the request.
 func (h *GameItemHandler) getPaginationQuery(
 	c *fiber.Ctx,
@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/dto/request"
+	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+)
+
+type GrantJobHandler struct {
+	grantJobService domainservice.GrantJobService
+}
+
+func NewGrantJobHandler(grantJobService domainservice.GrantJobService) *GrantJobHandler {
+	return &GrantJobHandler{grantJobService: grantJobService}
+}
+
+// Start starts a bulk grant job
+//
+//	@Summary		Bulk grant item
+//	@Description	Admin grants a game item to a list of users, to users matching a filter or to every online user in background. Grants are idempotent per (user, item, campaign)
+//	@Tags			Inventory Items
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int										true	"Game item ID"
+//	@Param			request	body		request.BulkGrantInventoryItem			true	"Grant recipients"
+//	@Success		200		{object}	examples.GrantJobSuccessResponse		"Started grant job"
+//	@Failure		400		{object}	examples.BadRequestResponse				"Bad request - invalid ID or recipients"
+//	@Failure		403		{object}	examples.ForbiddenByPermissionsResponse	"Forbidden - not enough rights"
+//	@Failure		404		{object}	examples.GameItemNotFound				"Not found - item not found"
+//	@Failure		422		{object}	examples.UnprocessableEntityResponse	"Unprocessable entity - invalid request types"
+//	@Router			/api/items/{id}/grants [post].
+func (h *GrantJobHandler) Start(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "GrantJobHandler.Start")
+	defer span.End()
+
+	admin := mustExtractUser(ctx)
+
+	itemID, err := extractIntParam("id", c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	req, err := getAndValidateRequest[request.BulkGrantInventoryItem](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.grantJobService.Start(ctx, itemID, req, admin)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// FindByID gets a bulk grant job progress
+//
+//	@Summary		Get grant job
+//	@Description	Admin retrieves progress of a bulk grant job
+//	@Tags			Inventory Items
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			job_id	path		int										true	"Grant job ID"
+//	@Success		200		{object}	examples.GrantJobSuccessResponse		"Grant job"
+//	@Failure		400		{object}	examples.BadRequestResponse				"Bad request - invalid ID"
+//	@Failure		403		{object}	examples.ForbiddenByPermissionsResponse	"Forbidden - not enough rights"
+//	@Failure		404		{object}	examples.GrantJobNotFoundResponse		"Not found - grant job not found"
+//	@Router			/api/items/grants/{job_id} [get].
+func (h *GrantJobHandler) FindByID(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "GrantJobHandler.FindByID")
+	defer span.End()
+
+	jobID, err := extractIntParam("job_id", c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.grantJobService.FindByID(ctx, jobID)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
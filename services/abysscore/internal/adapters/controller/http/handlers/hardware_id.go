@@ -0,0 +1,384 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/dto/request"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+)
+
+type HardwareIDHandler struct {
+	hardwareIDResetService domainservice.HardwareIDResetService
+	hardwareIDBanService   domainservice.HardwareIDBanService
+}
+
+func NewHardwareIDHandler(
+	hardwareIDResetService domainservice.HardwareIDResetService,
+	hardwareIDBanService domainservice.HardwareIDBanService,
+) *HardwareIDHandler {
+	return &HardwareIDHandler{
+		hardwareIDResetService: hardwareIDResetService,
+		hardwareIDBanService:   hardwareIDBanService,
+	}
+}
+
+// ResetByAdmin clears user hardware id
+//
+//	@Summary		Reset user hardware id
+//	@Description	Clears user hardware id, so the next login binds the account to a new device. Ends all user sessions and notifies user by email
+//	@Tags			Hardware ID
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			user_id	path		int										true	"UserDTO ID"
+//	@Param			request	body		request.ResetHardwareIDRequest			true	"Reset reason"
+//	@Success		200		{object}	dto.HardwareIDResetDTO					"Hardware id successfully reset"
+//	@Failure		403		{object}	examples.ForbiddenByPermissionsResponse	"Forbidden - not enough rights"
+//	@Failure		404		{object}	examples.UserNotFoundResponse			"Not found - user not found"
+//	@Failure		409		{object}	examples.HardwareIDNotBoundResponse		"Conflict - user has no bound hardware id"
+//	@Failure		422		{object}	examples.UnprocessableEntityResponse	"Unprocessable entity - invalid request types"
+//	@Failure		429		{object}	examples.TooManyRequestsResponse		"Too many requests - received too many requests"
+//	@Router			/api/users/{user_id}/hardware_id/reset [post].
+func (h *HardwareIDHandler) ResetByAdmin(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "HardwareIDHandler.ResetByAdmin")
+	defer span.End()
+
+	admin := mustExtractUser(ctx)
+
+	userID, err := extractIntParam("user_id", c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	req, err := getAndValidateRequest[request.ResetHardwareIDRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.hardwareIDResetService.ResetByAdmin(ctx, admin, userID, req.Reason)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// RequestReset creates hardware id reset request
+//
+//	@Summary		Request hardware id reset
+//	@Description	Creates hardware id reset request for the user who can't log in from a new device. The request is resolved by the administration
+//	@Tags			Hardware ID
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		request.HardwareIDResetRequestRequest				true	"Credentials and reason"
+//	@Success		200		{object}	dto.HardwareIDResetDTO								"Request successfully created"
+//	@Failure		400		{object}	examples.BadRequestResponse							"Bad request - missed request fields"
+//	@Failure		401		{object}	examples.UserWrongPasswordResponse					"Unauthorized - wrong password"
+//	@Failure		401		{object}	examples.TwoFactorRequiredResponse					"Unauthorized - two-factor authentication code required"
+//	@Failure		404		{object}	examples.UserNotFoundResponse						"Not found - user with this username not found"
+//	@Failure		409		{object}	examples.HardwareIDNotBoundResponse					"Conflict - account has no bound hardware id"
+//	@Failure		409		{object}	examples.HardwareIDResetAlreadyRequestedResponse	"Conflict - request is already pending"
+//	@Failure		422		{object}	examples.UnprocessableEntityResponse				"Unprocessable entity - invalid request types"
+//	@Failure		429		{object}	examples.TooManyRequestsResponse					"Too many requests - received too many auth requests"
+//	@Router			/api/auth/hardware_id/reset_request [post].
+func (h *HardwareIDHandler) RequestReset(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "HardwareIDHandler.RequestReset")
+	defer span.End()
+
+	req, err := getAndValidateRequest[request.HardwareIDResetRequestRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.hardwareIDResetService.RequestReset(ctx, &dto.HardwareIDResetRequestDTO{
+		Username:      req.Username,
+		Password:      req.Password,
+		TwoFactorCode: req.TwoFactorCode,
+		Reason:        req.Reason,
+	})
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// FindRequestsPaged returns paginated hardware id reset requests
+//
+//	@Summary		List hardware id reset requests
+//	@Description	Returns hardware id resets, most recent first. Filter by status to get requests awaiting resolution
+//	@Tags			Hardware ID
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			status	query		string											false	"Status filter (pending, approved, rejected)"
+//	@Param			page	query		int												false	"Page number (default: 1)"
+//	@Param			size	query		int												false	"Page size (default: 10)"
+//	@Success		200		{object}	examples.PaginatedHardwareIDResetsDTOResponse	"Paginated list of hardware id resets"
+//	@Failure		400		{object}	examples.BadRequestResponse						"Bad request - invalid status"
+//	@Failure		403		{object}	examples.ForbiddenByPermissionsResponse			"Forbidden - not enough rights"
+//	@Router			/api/hardware_id/reset_requests [get].
+func (h *HardwareIDHandler) FindRequestsPaged(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "HardwareIDHandler.FindRequestsPaged")
+	defer span.End()
+
+	var status *dto.HardwareIDResetStatus
+
+	if rawStatus := c.Query("status"); rawStatus != "" {
+		parsed := dto.HardwareIDResetStatus(rawStatus)
+		if !parsed.IsValid() {
+			return handleError(apperrors.ErrInvalidHardwareIDResetStatus, c)
+		}
+
+		status = &parsed
+	}
+
+	result, err := h.hardwareIDResetService.FindRequestsPaged(
+		ctx,
+		status,
+		c.QueryInt("page", 0),
+		c.QueryInt("size", 0),
+	)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccessPagination(result, c)
+}
+
+// Approve approves hardware id reset request
+//
+//	@Summary		Approve hardware id reset request
+//	@Description	Approves pending request and clears user hardware id. Ends all user sessions and notifies user by email
+//	@Tags			Hardware ID
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request_id	path		int												true	"Request ID"
+//	@Param			request		body		request.ResolveHardwareIDResetRequest			true	"Operator comment"
+//	@Success		200			{object}	dto.HardwareIDResetDTO							"Request successfully approved"
+//	@Failure		403			{object}	examples.ForbiddenByPermissionsResponse			"Forbidden - not enough rights"
+//	@Failure		404			{object}	examples.HardwareIDResetRequestNotFoundResponse	"Not found - no such request"
+//	@Failure		409			{object}	examples.HardwareIDResetRequestResolvedResponse	"Conflict - request is already resolved"
+//	@Failure		422			{object}	examples.UnprocessableEntityResponse			"Unprocessable entity - invalid request types"
+//	@Router			/api/hardware_id/reset_requests/{request_id}/approve [post].
+func (h *HardwareIDHandler) Approve(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "HardwareIDHandler.Approve")
+	defer span.End()
+
+	admin := mustExtractUser(ctx)
+
+	requestID, err := extractIntParam("request_id", c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	req, err := getAndValidateRequest[request.ResolveHardwareIDResetRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.hardwareIDResetService.Approve(ctx, admin, requestID, req.Comment)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// Reject rejects hardware id reset request
+//
+//	@Summary		Reject hardware id reset request
+//	@Description	Rejects pending request, user hardware id is kept
+//	@Tags			Hardware ID
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request_id	path		int												true	"Request ID"
+//	@Param			request		body		request.ResolveHardwareIDResetRequest			true	"Operator comment"
+//	@Success		200			{object}	dto.HardwareIDResetDTO							"Request successfully rejected"
+//	@Failure		403			{object}	examples.ForbiddenByPermissionsResponse			"Forbidden - not enough rights"
+//	@Failure		404			{object}	examples.HardwareIDResetRequestNotFoundResponse	"Not found - no such request"
+//	@Failure		409			{object}	examples.HardwareIDResetRequestResolvedResponse	"Conflict - request is already resolved"
+//	@Failure		422			{object}	examples.UnprocessableEntityResponse			"Unprocessable entity - invalid request types"
+//	@Router			/api/hardware_id/reset_requests/{request_id}/reject [post].
+func (h *HardwareIDHandler) Reject(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "HardwareIDHandler.Reject")
+	defer span.End()
+
+	admin := mustExtractUser(ctx)
+
+	requestID, err := extractIntParam("request_id", c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	req, err := getAndValidateRequest[request.ResolveHardwareIDResetRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.hardwareIDResetService.Reject(ctx, admin, requestID, req.Comment)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// BanByUser bans hardware id bound to the user
+//
+//	@Summary		Ban user hardware id
+//	@Description	Bans hardware id bound to the user. Sessions of all accounts bound to this hardware id are ended
+//	@Tags			Hardware ID
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			user_id	path		int											true	"UserDTO ID"
+//	@Param			request	body		request.BanUserHardwareIDRequest			true	"Ban reason"
+//	@Success		200		{object}	dto.BanHardwareIDResultDTO					"Hardware id successfully banned"
+//	@Failure		403		{object}	examples.ForbiddenByPermissionsResponse		"Forbidden - not enough rights"
+//	@Failure		404		{object}	examples.UserNotFoundResponse				"Not found - user not found"
+//	@Failure		409		{object}	examples.HardwareIDNotBoundResponse			"Conflict - user has no bound hardware id"
+//	@Failure		409		{object}	examples.HardwareIDAlreadyBannedResponse	"Conflict - hardware id is already banned"
+//	@Failure		422		{object}	examples.UnprocessableEntityResponse		"Unprocessable entity - invalid request types"
+//	@Router			/api/users/{user_id}/hardware_id/ban [post].
+func (h *HardwareIDHandler) BanByUser(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "HardwareIDHandler.BanByUser")
+	defer span.End()
+
+	admin := mustExtractUser(ctx)
+
+	userID, err := extractIntParam("user_id", c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	req, err := getAndValidateRequest[request.BanUserHardwareIDRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.hardwareIDBanService.BanByUser(ctx, admin, userID, req.Reason)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// Ban bans raw hardware id
+//
+//	@Summary		Ban hardware id
+//	@Description	Bans raw hardware id. Sessions of all accounts bound to this hardware id are ended
+//	@Tags			Hardware ID
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		request.BanHardwareIDRequest				true	"Hardware id and ban reason"
+//	@Success		200		{object}	dto.BanHardwareIDResultDTO					"Hardware id successfully banned"
+//	@Failure		400		{object}	examples.BadRequestResponse					"Bad request - missed request fields"
+//	@Failure		403		{object}	examples.ForbiddenByPermissionsResponse		"Forbidden - not enough rights"
+//	@Failure		409		{object}	examples.HardwareIDAlreadyBannedResponse	"Conflict - hardware id is already banned"
+//	@Failure		422		{object}	examples.UnprocessableEntityResponse		"Unprocessable entity - invalid request types"
+//	@Router			/api/hardware_id/bans [post].
+func (h *HardwareIDHandler) Ban(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "HardwareIDHandler.Ban")
+	defer span.End()
+
+	admin := mustExtractUser(ctx)
+
+	req, err := getAndValidateRequest[request.BanHardwareIDRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.hardwareIDBanService.Ban(ctx, admin, req.HardwareID, req.Reason)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// FindBansPaged returns paginated banned hardware ids
+//
+//	@Summary		List banned hardware ids
+//	@Description	Returns banned hardware ids, most recent first. Search matches hardware id or ban reason substring
+//	@Tags			Hardware ID
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			search	query		string											false	"Hardware id or reason substring"
+//	@Param			page	query		int												false	"Page number (default: 1)"
+//	@Param			size	query		int												false	"Page size (default: 10)"
+//	@Success		200		{object}	examples.PaginatedBannedHardwareIDsDTOResponse	"Paginated list of banned hardware ids"
+//	@Failure		403		{object}	examples.ForbiddenByPermissionsResponse			"Forbidden - not enough rights"
+//	@Router			/api/hardware_id/bans [get].
+func (h *HardwareIDHandler) FindBansPaged(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "HardwareIDHandler.FindBansPaged")
+	defer span.End()
+
+	result, err := h.hardwareIDBanService.FindPaged(
+		ctx,
+		c.Query("search"),
+		c.QueryInt("page", 0),
+		c.QueryInt("size", 0),
+	)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccessPagination(result, c)
+}
+
+// Unban removes hardware id ban
+//
+//	@Summary		Unban hardware id
+//	@Description	Removes hardware id ban, accounts bound to this hardware id are able to log in again
+//	@Tags			Hardware ID
+//	@Accept			json
+//	@Security		BearerAuth
+//	@Param			request	body	request.UnbanHardwareIDRequest	true	"Banned hardware id"
+//	@Success		204		"Hardware id successfully unbanned"
+//	@Failure		400		{object}	examples.BadRequestResponse					"Bad request - missed request fields"
+//	@Failure		403		{object}	examples.ForbiddenByPermissionsResponse		"Forbidden - not enough rights"
+//	@Failure		404		{object}	examples.BannedHardwareIDNotFoundResponse	"Not found - hardware id is not banned"
+//	@Failure		422		{object}	examples.UnprocessableEntityResponse		"Unprocessable entity - invalid request types"
+//	@Router			/api/hardware_id/unban [post].
+func (h *HardwareIDHandler) Unban(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "HardwareIDHandler.Unban")
+	defer span.End()
+
+	admin := mustExtractUser(ctx)
+
+	req, err := getAndValidateRequest[request.UnbanHardwareIDRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	err = h.hardwareIDBanService.Unban(ctx, admin, req.HardwareID)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendNoContent(c)
+}
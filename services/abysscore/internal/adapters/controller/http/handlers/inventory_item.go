@@ -1,10 +1,14 @@
 package handlers
 
 import (
+	"context"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/dto/request"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/inventoryitementity"
 	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/queryparser"
 )
 
 type InventoryItemHandler struct {
@@ -28,7 +32,7 @@ func NewInventoryItemHandler(
 //	@Param			item_id	path		int											true	"Item ID"
 //	@Success		200		{object}	examples.InventoryItemDTOSuccessResponse	"Granted inventory item"
 //	@Failure		400		{object}	examples.BadRequestResponse					"Bad request - invalid ID"
-//	@Failure		403		{object}	examples.ForbiddenByAccessLevelResponse		"Forbidden - not enough rights"
+//	@Failure		403		{object}	examples.ForbiddenByPermissionsResponse		"Forbidden - not enough rights"
 //	@Failure		404		{object}	examples.UserNotFoundResponse				"Not found - user not found"
 //	@Failure		404		{object}	examples.GameItemNotFound					"Not found - item not found"
 //	@Router			/api/users/{user_id}/inventory/{item_id} [post].
@@ -58,14 +62,24 @@ func (h *InventoryItemHandler) GrantInventoryItemToUser(c *fiber.Ctx) error {
 	return sendSuccess(result, c)
 }
 
-// GetAllByAuthorization gets all inventory items for the authenticated user
+// GetAllByAuthorization gets inventory items for the authenticated user
 //
 //	@Summary		Get current user's inventory
-//	@Description	Returns all inventory items for the currently authenticated user
+//	@Description	Returns paginated inventory items with per rarity and collection summary for the currently authenticated user
 //	@Tags			Inventory Items
 //	@Produce		json
 //	@Security		BearerAuth
-//	@Success		200	{array}	examples.PaginatedInventoryItemsDTOResponse	"List of user's inventory items"
+//	@Security		APITokenAuth
+//	@Param			page			query		int											false	"Page number (default: 1)"
+//	@Param			size			query		int											false	"Page size (default: 10)"
+//	@Param			order_by		query		string										false	"Field to sort by"	Enums(obtained_at, rarity, name)
+//	@Param			order_type		query		string										false	"Sort order"		Enums(asc, desc)
+//	@Param			collection		query		string										false	"Filter by collection"
+//	@Param			type			query		int											false	"Filter by item type"
+//	@Param			rarity			query		int											false	"Filter by item rarity"
+//	@Param			obtained_after	query		string										false	"Filter by obtain time (RFC 3339)"
+//	@Success		200				{object}	examples.PaginatedInventoryItemsDTOResponse	"Paginated list of user's inventory items"
+//	@Failure		400				{object}	examples.BadRequestResponse					"Bad request - invalid query params"
 //	@Router			/api/users/inventory [get].
 func (h *InventoryItemHandler) GetAllByAuthorization(c *fiber.Ctx) error {
 	ctx := c.UserContext()
@@ -75,26 +89,29 @@ func (h *InventoryItemHandler) GetAllByAuthorization(c *fiber.Ctx) error {
 
 	user := mustExtractUser(ctx)
 
-	result, err := h.inventoryItemService.FindAllByUserID(ctx, user.ID)
-	if err != nil {
-		return handleError(err, c)
-	}
-
-	return sendSuccess(result, c)
+	return h.sendInventory(ctx, user.ID, c)
 }
 
-// GetAllByUserID gets all inventory items for a specific user
+// GetAllByUserID gets inventory items for a specific user
 //
 //	@Summary		Get user's inventory
-//	@Description	Admin retrieves all inventory items for a specified user
+//	@Description	Admin retrieves paginated inventory items with per rarity and collection summary for a specified user
 //	@Tags			Inventory Items
 //	@Produce		json
 //	@Security		BearerAuth
-//	@Param			user_id	path		int											true	"UserDTO ID"
-//	@Success		200		{array}		examples.PaginatedInventoryItemsDTOResponse	"List of user's inventory items"
-//	@Failure		400		{object}	examples.BadRequestResponse					"Bad request - invalid ID"
-//	@Failure		403		{object}	examples.ForbiddenByAccessLevelResponse		"Forbidden - not enough rights"
-//	@Failure		404		{object}	examples.UserNotFoundResponse				"Not found - user not found"
+//	@Param			user_id			path		int											true	"UserDTO ID"
+//	@Param			page			query		int											false	"Page number (default: 1)"
+//	@Param			size			query		int											false	"Page size (default: 10)"
+//	@Param			order_by		query		string										false	"Field to sort by"	Enums(obtained_at, rarity, name)
+//	@Param			order_type		query		string										false	"Sort order"		Enums(asc, desc)
+//	@Param			collection		query		string										false	"Filter by collection"
+//	@Param			type			query		int											false	"Filter by item type"
+//	@Param			rarity			query		int											false	"Filter by item rarity"
+//	@Param			obtained_after	query		string										false	"Filter by obtain time (RFC 3339)"
+//	@Success		200				{object}	examples.PaginatedInventoryItemsDTOResponse	"Paginated list of user's inventory items"
+//	@Failure		400				{object}	examples.BadRequestResponse					"Bad request - invalid ID or query params"
+//	@Failure		403				{object}	examples.ForbiddenByPermissionsResponse		"Forbidden - not enough rights"
+//	@Failure		404				{object}	examples.UserNotFoundResponse				"Not found - user not found"
 //	@Router			/api/users/{user_id}/inventory [get].
 func (h *InventoryItemHandler) GetAllByUserID(c *fiber.Ctx) error {
 	ctx := c.UserContext()
@@ -107,12 +124,7 @@ func (h *InventoryItemHandler) GetAllByUserID(c *fiber.Ctx) error {
 		return handleError(err, c)
 	}
 
-	result, err := h.inventoryItemService.FindAllByUserID(ctx, userID)
-	if err != nil {
-		return handleError(err, c)
-	}
-
-	return sendSuccess(result, c)
+	return h.sendInventory(ctx, userID, c)
 }
 
 // RevokeByAdmin revokes an item from a user
@@ -126,7 +138,7 @@ func (h *InventoryItemHandler) GetAllByUserID(c *fiber.Ctx) error {
 //	@Param			item_id	path	int	true	"Item ID"
 //	@Success		204		"Item successfully revoked"
 //	@Failure		400		{object}	examples.BadRequestResponse				"Bad request - invalid ID"
-//	@Failure		403		{object}	examples.ForbiddenByAccessLevelResponse	"Forbidden - not enough rights"
+//	@Failure		403		{object}	examples.ForbiddenByPermissionsResponse	"Forbidden - not enough rights"
 //	@Failure		404		{object}	examples.UserNotFoundResponse			"Not found - user not found"
 //	@Failure		404		{object}	examples.InventoryItemNotFoundResponse	"Not found - inventory item not found"
 //	@Router			/api/users/{user_id}/inventory/{item_id} [delete].
@@ -190,3 +202,96 @@ func (h *InventoryItemHandler) SetInventoryItem(c *fiber.Ctx) error {
 
 	return sendNoContent(c)
 }
+
+// Dismantle dismantles a single inventory item
+//
+//	@Summary		Dismantle inventory item
+//	@Description	Converts an inventory item of the authenticated user into coins by its rarity. Item showcased in profile can't be dismantled
+//	@Tags			Inventory Items
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			item_id	path		int										true	"Inventory item ID"
+//	@Success		200		{object}	examples.DismantleSuccessResponse		"Dismantled items and credited coins"
+//	@Failure		400		{object}	examples.BadRequestResponse				"Bad request - invalid ID"
+//	@Failure		404		{object}	examples.InventoryItemNotFoundResponse	"Not found - inventory item not found"
+//	@Failure		409		{object}	examples.DismantleCurrentItemResponse	"Conflict - item is showcased in profile"
+//	@Router			/api/users/me/inventory/{item_id}/dismantle [post].
+func (h *InventoryItemHandler) Dismantle(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "InventoryItemHandler.Dismantle")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+
+	itemID, err := extractIntParam("item_id", c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.inventoryItemService.Dismantle(ctx, user, []int{itemID})
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// DismantleBulk dismantles several inventory items at once
+//
+//	@Summary		Dismantle inventory items
+//	@Description	Converts up to 100 inventory items of the authenticated user into coins by their rarity. Nothing is dismantled if any item is missing or showcased in profile
+//	@Tags			Inventory Items
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		request.DismantleInventoryItems			true	"Inventory item IDs"
+//	@Success		200		{object}	examples.DismantleSuccessResponse		"Dismantled items and credited coins"
+//	@Failure		400		{object}	examples.BadRequestResponse				"Bad request - invalid request body"
+//	@Failure		404		{object}	examples.InventoryItemNotFoundResponse	"Not found - inventory item not found"
+//	@Failure		409		{object}	examples.DismantleCurrentItemResponse	"Conflict - item is showcased in profile"
+//	@Failure		422		{object}	examples.UnprocessableEntityResponse	"Unprocessable entity - invalid request types"
+//	@Router			/api/users/me/inventory/dismantle [post].
+func (h *InventoryItemHandler) DismantleBulk(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "InventoryItemHandler.DismantleBulk")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+
+	req, err := getAndValidateRequest[request.DismantleInventoryItems](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.inventoryItemService.Dismantle(ctx, user, req.InventoryItemIDs)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// sendInventory parses pagination and filter query params and sends user's inventory page.
+func (h *InventoryItemHandler) sendInventory(ctx context.Context, userID int, c *fiber.Ctx) error {
+	paginationQuery, err := request.NewPaginationQuery[inventoryitementity.OrderBy](
+		c,
+		queryparser.ParseInventoryItemOrderBy,
+	)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	filter, err := request.NewInventoryItemFilter(c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.inventoryItemService.FindAllPagedByUserID(ctx, userID, paginationQuery, filter)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccessPaginationWithSummary(result.PaginatedResult, result.Summary, c)
+}
@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+)
+
+type LoginHistoryHandler struct {
+	loginDefenseService domainservice.LoginDefenseService
+}
+
+func NewLoginHistoryHandler(loginDefenseService domainservice.LoginDefenseService) *LoginHistoryHandler {
+	return &LoginHistoryHandler{loginDefenseService: loginDefenseService}
+}
+
+// FindPagedByAuthorization returns login history of the current user
+//
+//	@Summary		Get own login history
+//	@Description	Returns successful and failed logins to the account, most recent first
+//	@Tags			Account
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			page	query		int											false	"Page number (default: 1)"
+//	@Param			size	query		int											false	"Page size (default: 10)"
+//	@Success		200		{object}	examples.PaginatedLoginHistoryDTOResponse	"Paginated login history"
+//	@Failure		429		{object}	examples.TooManyRequestsResponse			"Too many requests - received too many requests"
+//	@Router			/api/account/login_history [get].
+func (h *LoginHistoryHandler) FindPagedByAuthorization(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "LoginHistoryHandler.FindPagedByAuthorization")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+
+	result, err := h.loginDefenseService.FindHistoryPaged(
+		ctx,
+		user.ID,
+		c.QueryInt("page", 0),
+		c.QueryInt("size", 0),
+	)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccessPagination(result, c)
+}
+
+// FindPagedByUserID returns login history of the user
+//
+//	@Summary		Get user login history
+//	@Description	Returns successful and failed logins to the user account, most recent first
+//	@Tags			Account
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			user_id	path		int											true	"UserDTO ID"
+//	@Param			page	query		int											false	"Page number (default: 1)"
+//	@Param			size	query		int											false	"Page size (default: 10)"
+//	@Success		200		{object}	examples.PaginatedLoginHistoryDTOResponse	"Paginated login history"
+//	@Failure		403		{object}	examples.ForbiddenByPermissionsResponse		"Forbidden - not enough rights"
+//	@Failure		429		{object}	examples.TooManyRequestsResponse			"Too many requests - received too many requests"
+//	@Router			/api/users/{user_id}/login_history [get].
+func (h *LoginHistoryHandler) FindPagedByUserID(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "LoginHistoryHandler.FindPagedByUserID")
+	defer span.End()
+
+	userID, err := extractIntParam("user_id", c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.loginDefenseService.FindHistoryPaged(
+		ctx,
+		userID,
+		c.QueryInt("page", 0),
+		c.QueryInt("size", 0),
+	)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccessPagination(result, c)
+}
@@ -0,0 +1,182 @@
+package handlers
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/dto/request"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+)
+
+const (
+	exportFormatJSON = "json"
+	exportFormatZIP  = "zip"
+
+	personalDataArchiveName = "personal_data.zip"
+)
+
+type PersonalDataHandler struct {
+	personalDataService domainservice.PersonalDataService
+}
+
+func NewPersonalDataHandler(personalDataService domainservice.PersonalDataService) *PersonalDataHandler {
+	return &PersonalDataHandler{personalDataService: personalDataService}
+}
+
+// Export returns personal data of the authenticated user
+//
+//	@Summary		Export personal data
+//	@Description	Returns profile, statistics, matches, inventory, friends, balance and linked identifiers. With "zip" format the data is sent as archive with a JSON file per section
+//	@Tags			Account
+//	@Produce		json
+//	@Produce		application/zip
+//	@Security		BearerAuth
+//	@Param			format	query		string									false	"Export format"	Enums(json, zip)	default(json)
+//	@Success		200		{object}	examples.PersonalDataSuccessResponse	"Personal data"
+//	@Failure		400		{object}	examples.UnknownExportFormat			"Bad request - unknown export format"
+//	@Router			/api/account/personal_data [get].
+func (h *PersonalDataHandler) Export(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "PersonalDataHandler.Export")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+
+	format := c.Query("format", exportFormatJSON)
+	if format != exportFormatJSON && format != exportFormatZIP {
+		return handleError(apperrors.ErrUnknownExportFormat, c)
+	}
+
+	result, err := h.personalDataService.Export(ctx, user)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	if format == exportFormatJSON {
+		return sendSuccess(result, c)
+	}
+
+	var archive bytes.Buffer
+
+	err = writePersonalDataArchive(&archive, result)
+	if err != nil {
+		return handleError(apperrors.WrapUnexpectedError(err), c)
+	}
+
+	c.Attachment(personalDataArchiveName)
+
+	return c.Status(fiber.StatusOK).Send(archive.Bytes())
+}
+
+// RequestDeletion schedules deletion of the authenticated user account
+//
+//	@Summary		Request account deletion
+//	@Description	Account is erased after the grace period: username and email are anonymized, hardware id is dropped, matches and sanctions are kept with a placeholder player. All other sessions are ended, deletion can be cancelled within the grace period
+//	@Tags			Account
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		request.RequestAccountDeletionRequest	true	"Password and two-factor code"
+//	@Success		200		{object}	examples.AccountDeletionSuccessResponse	"Deletion scheduled"
+//	@Failure		400		{object}	examples.BadRequestResponse				"Bad request - missed request fields"
+//	@Failure		401		{object}	examples.UserWrongPasswordResponse		"Unauthorized - wrong password"
+//	@Failure		401		{object}	examples.TwoFactorRequiredResponse		"Unauthorized - two-factor authentication code required"
+//	@Failure		409		{object}	examples.AccountDeletionScheduled		"Conflict - deletion is already scheduled"
+//	@Failure		422		{object}	examples.UnprocessableEntityResponse	"Unprocessable entity - invalid request types"
+//	@Router			/api/account/deletion [post].
+func (h *PersonalDataHandler) RequestDeletion(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "PersonalDataHandler.RequestDeletion")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+	sessionID := mustExtractSessionID(ctx)
+
+	req, err := getAndValidateRequest[request.RequestAccountDeletionRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.personalDataService.RequestDeletion(ctx, user, sessionID, req.Password, req.TwoFactorCode)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// CancelDeletion cancels scheduled deletion of the authenticated user account
+//
+//	@Summary		Cancel account deletion
+//	@Description	Account stays as is, ended sessions are not restored
+//	@Tags			Account
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		204	"Deletion cancelled"
+//	@Failure		409	{object}	examples.AccountDeletionNotScheduled	"Conflict - deletion is not scheduled"
+//	@Router			/api/account/deletion [delete].
+func (h *PersonalDataHandler) CancelDeletion(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "PersonalDataHandler.CancelDeletion")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+
+	err := h.personalDataService.CancelDeletion(ctx, user)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendNoContent(c)
+}
+
+// writePersonalDataArchive writes every section of the export as a separate JSON file.
+func writePersonalDataArchive(w io.Writer, data *dto.PersonalDataDTO) error {
+	sections := []struct {
+		name    string
+		content any
+	}{
+		{"profile.json", data.Profile},
+		{"linked_identifiers.json", data.LinkedIdentifiers},
+		{"statistics.json", data.Statistics},
+		{"matches.json", data.Matches},
+		{"inventory.json", data.Inventory},
+		{"friends.json", data.Friends},
+		{"balance.json", map[string]float64{"coins": data.Balance}},
+		{"username_history.json", data.UsernameHistory},
+		{"email_history.json", data.EmailHistory},
+		{"login_history.json", data.LoginHistory},
+	}
+
+	archive := zip.NewWriter(w)
+
+	for _, section := range sections {
+		file, err := archive.CreateHeader(&zip.FileHeader{
+			Name:     section.name,
+			Method:   zip.Deflate,
+			Modified: data.ExportedAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		encoder := json.NewEncoder(file)
+		encoder.SetIndent("", "  ")
+
+		err = encoder.Encode(section.content)
+		if err != nil {
+			return err
+		}
+	}
+
+	return archive.Close()
+}
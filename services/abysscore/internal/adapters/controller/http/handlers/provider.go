@@ -11,6 +11,20 @@ type DependencyProvider struct {
 	GameItemHandler       *GameItemHandler
 	InventoryItemHandler  *InventoryItemHandler
 	AccountHandler        *AccountHandler
+	CollectionHandler     *CollectionHandler
+	GrantJobHandler       *GrantJobHandler
+	SeasonHandler         *SeasonHandler
+	SessionHandler        *SessionHandler
+	TwoFactorHandler      *TwoFactorHandler
+	HardwareIDHandler     *HardwareIDHandler
+	SanctionHandler       *SanctionHandler
+	RoleHandler           *RoleHandler
+	AuditLogHandler       *AuditLogHandler
+	LoginHistoryHandler   *LoginHistoryHandler
+
+	ExternalIdentityHandler *ExternalIdentityHandler
+	APITokenHandler         *APITokenHandler
+	PersonalDataHandler     *PersonalDataHandler
 }
 
 func NewDependencyProvider(
@@ -23,5 +37,25 @@ func NewDependencyProvider(
 		GameItemHandler:       NewGameItemHandler(dependencyProvider.GameItemService),
 		InventoryItemHandler:  NewInventoryItemHandler(dependencyProvider.InventoryItemService),
 		AccountHandler:        NewAccountHandler(dependencyProvider.AccountService),
+		CollectionHandler:     NewCollectionHandler(dependencyProvider.CollectionService),
+		GrantJobHandler:       NewGrantJobHandler(dependencyProvider.GrantJobService),
+		SeasonHandler:         NewSeasonHandler(dependencyProvider.SeasonService),
+		SessionHandler:        NewSessionHandler(dependencyProvider.SessionService),
+		TwoFactorHandler:      NewTwoFactorHandler(dependencyProvider.TwoFactorService),
+		HardwareIDHandler: NewHardwareIDHandler(
+			dependencyProvider.HardwareIDResetService,
+			dependencyProvider.HardwareIDBanService,
+		),
+		SanctionHandler: NewSanctionHandler(dependencyProvider.SanctionService),
+		RoleHandler:     NewRoleHandler(dependencyProvider.RoleService),
+		AuditLogHandler: NewAuditLogHandler(dependencyProvider.AuditLogService),
+		LoginHistoryHandler: NewLoginHistoryHandler(
+			dependencyProvider.LoginDefenseService,
+		),
+		ExternalIdentityHandler: NewExternalIdentityHandler(
+			dependencyProvider.AuthenticationService,
+		),
+		APITokenHandler:     NewAPITokenHandler(dependencyProvider.APITokenService),
+		PersonalDataHandler: NewPersonalDataHandler(dependencyProvider.PersonalDataService),
 	}
 }
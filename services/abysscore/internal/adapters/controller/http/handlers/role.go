@@ -0,0 +1,258 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/dto/request"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+)
+
+type RoleHandler struct {
+	roleService domainservice.RoleService
+}
+
+func NewRoleHandler(roleService domainservice.RoleService) *RoleHandler {
+	return &RoleHandler{roleService: roleService}
+}
+
+// GetPermissions returns all known permissions
+//
+//	@Summary		List permissions
+//	@Description	Returns all permissions which can be included into roles
+//	@Tags			Roles
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		string									"Permissions"
+//	@Failure		403	{object}	examples.ForbiddenByPermissionsResponse	"Forbidden - not enough rights"
+//	@Router			/api/permissions [get].
+func (h *RoleHandler) GetPermissions(c *fiber.Ctx) error {
+	return sendSuccess(h.roleService.Permissions(), c)
+}
+
+// FindAll returns all roles
+//
+//	@Summary		List roles
+//	@Description	Returns all roles with their permissions
+//	@Tags			Roles
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		dto.RoleDTO								"Roles"
+//	@Failure		403	{object}	examples.ForbiddenByPermissionsResponse	"Forbidden - not enough rights"
+//	@Router			/api/roles [get].
+func (h *RoleHandler) FindAll(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "RoleHandler.FindAll")
+	defer span.End()
+
+	result, err := h.roleService.FindAll(ctx)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// Create creates role
+//
+//	@Summary		Create role
+//	@Description	Creates role from permissions. Only permissions the performer has can be included
+//	@Tags			Roles
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		request.SaveRoleRequest						true	"Role"
+//	@Success		200		{object}	dto.RoleDTO									"Role successfully created"
+//	@Failure		400		{object}	examples.BadRequestResponse					"Bad request - missed request fields or unknown permission"
+//	@Failure		403		{object}	examples.ForbiddenByPermissionsResponse		"Forbidden - not enough rights"
+//	@Failure		403		{object}	examples.GrantingMissingPermissionsResponse	"Forbidden - role includes permissions the performer doesn't have"
+//	@Failure		409		{object}	examples.RoleAlreadyExistsResponse			"Conflict - role name is taken"
+//	@Failure		409		{object}	examples.RoleNameReservedResponse			"Conflict - role name is reserved for legacy access level"
+//	@Failure		422		{object}	examples.UnprocessableEntityResponse		"Unprocessable entity - invalid request types"
+//	@Router			/api/roles [post].
+func (h *RoleHandler) Create(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "RoleHandler.Create")
+	defer span.End()
+
+	admin := mustExtractUser(ctx)
+
+	req, err := getAndValidateRequest[request.SaveRoleRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.roleService.Create(ctx, admin, &dto.SaveRoleDTO{
+		Name:        req.Name,
+		Description: req.Description,
+		Permissions: req.Permissions,
+	})
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// Update replaces role name, description and permissions
+//
+//	@Summary		Update role
+//	@Description	Replaces role name, description and permissions. Changes apply to all users with the role
+//	@Tags			Roles
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			role_id	path		int											true	"Role ID"
+//	@Param			request	body		request.SaveRoleRequest						true	"Role"
+//	@Success		200		{object}	dto.RoleDTO									"Role successfully updated"
+//	@Failure		400		{object}	examples.BadRequestResponse					"Bad request - missed request fields or unknown permission"
+//	@Failure		403		{object}	examples.ForbiddenByPermissionsResponse		"Forbidden - not enough rights"
+//	@Failure		403		{object}	examples.GrantingMissingPermissionsResponse	"Forbidden - role includes permissions the performer doesn't have"
+//	@Failure		403		{object}	examples.SystemRoleImmutableResponse		"Forbidden - system role can't be changed"
+//	@Failure		404		{object}	examples.RoleNotFoundResponse				"Not found - role not found"
+//	@Failure		409		{object}	examples.RoleAlreadyExistsResponse			"Conflict - role name is taken"
+//	@Failure		409		{object}	examples.RoleNameReservedResponse			"Conflict - role name is reserved for legacy access level"
+//	@Failure		422		{object}	examples.UnprocessableEntityResponse		"Unprocessable entity - invalid request types"
+//	@Router			/api/roles/{role_id} [put].
+func (h *RoleHandler) Update(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "RoleHandler.Update")
+	defer span.End()
+
+	admin := mustExtractUser(ctx)
+
+	roleID, err := extractIntParam("role_id", c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	req, err := getAndValidateRequest[request.SaveRoleRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.roleService.Update(ctx, admin, roleID, &dto.SaveRoleDTO{
+		Name:        req.Name,
+		Description: req.Description,
+		Permissions: req.Permissions,
+	})
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// Delete deletes role
+//
+//	@Summary		Delete role
+//	@Description	Deletes role, its users lose role permissions
+//	@Tags			Roles
+//	@Security		BearerAuth
+//	@Param			role_id	path	int	true	"Role ID"
+//	@Success		204		"Role successfully deleted"
+//	@Failure		403		{object}	examples.ForbiddenByPermissionsResponse		"Forbidden - not enough rights"
+//	@Failure		403		{object}	examples.GrantingMissingPermissionsResponse	"Forbidden - role includes permissions the performer doesn't have"
+//	@Failure		403		{object}	examples.SystemRoleImmutableResponse		"Forbidden - system role can't be deleted"
+//	@Failure		404		{object}	examples.RoleNotFoundResponse				"Not found - role not found"
+//	@Failure		422		{object}	examples.UnprocessableEntityResponse		"Unprocessable entity - invalid request types"
+//	@Router			/api/roles/{role_id} [delete].
+func (h *RoleHandler) Delete(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "RoleHandler.Delete")
+	defer span.End()
+
+	admin := mustExtractUser(ctx)
+
+	roleID, err := extractIntParam("role_id", c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	err = h.roleService.Delete(ctx, admin, roleID)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendNoContent(c)
+}
+
+// GetByUserID returns user roles
+//
+//	@Summary		Get user roles
+//	@Description	Returns roles assigned to the user
+//	@Tags			Roles
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			user_id	path		int										true	"UserDTO ID"
+//	@Success		200		{array}		dto.RoleDTO								"User roles"
+//	@Failure		403		{object}	examples.ForbiddenByPermissionsResponse	"Forbidden - not enough rights"
+//	@Failure		404		{object}	examples.UserNotFoundResponse			"Not found - user not found"
+//	@Failure		422		{object}	examples.UnprocessableEntityResponse	"Unprocessable entity - invalid request types"
+//	@Router			/api/users/{user_id}/roles [get].
+func (h *RoleHandler) GetByUserID(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "RoleHandler.GetByUserID")
+	defer span.End()
+
+	userID, err := extractIntParam("user_id", c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.roleService.FindByUserID(ctx, userID)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// SetUserRoles replaces user roles
+//
+//	@Summary		Set user roles
+//	@Description	Replaces roles assigned to the user. Performer must have all permissions of assigned and removed roles
+//	@Tags			Roles
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			user_id	path		int											true	"UserDTO ID"
+//	@Param			request	body		request.SetUserRolesRequest					true	"Role IDs"
+//	@Success		200		{array}		dto.RoleDTO									"Assigned roles"
+//	@Failure		400		{object}	examples.BadRequestResponse					"Bad request - missed request fields"
+//	@Failure		403		{object}	examples.ForbiddenByPermissionsResponse		"Forbidden - not enough rights"
+//	@Failure		403		{object}	examples.GrantingMissingPermissionsResponse	"Forbidden - roles include permissions the performer doesn't have"
+//	@Failure		404		{object}	examples.UserNotFoundResponse				"Not found - user not found"
+//	@Failure		404		{object}	examples.RoleNotFoundResponse				"Not found - role not found"
+//	@Failure		422		{object}	examples.UnprocessableEntityResponse		"Unprocessable entity - invalid request types"
+//	@Router			/api/users/{user_id}/roles [put].
+func (h *RoleHandler) SetUserRoles(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "RoleHandler.SetUserRoles")
+	defer span.End()
+
+	admin := mustExtractUser(ctx)
+
+	userID, err := extractIntParam("user_id", c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	req, err := getAndValidateRequest[request.SetUserRolesRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.roleService.SetUserRoles(ctx, admin, userID, req.RoleIDs)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
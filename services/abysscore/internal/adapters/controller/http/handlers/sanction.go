@@ -0,0 +1,275 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/dto/request"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+)
+
+type SanctionHandler struct {
+	sanctionService domainservice.SanctionService
+}
+
+func NewSanctionHandler(sanctionService domainservice.SanctionService) *SanctionHandler {
+	return &SanctionHandler{sanctionService: sanctionService}
+}
+
+// Issue issues account or search sanction
+//
+//	@Summary		Issue sanction
+//	@Description	Issues sanction of the next ladder level. Level is escalated while the previous sanction of the same type is counted. User is notified by websocket and email, blocked account is disconnected
+//	@Tags			Sanctions
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			user_id	path		int													true	"UserDTO ID"
+//	@Param			request	body		request.IssueSanctionRequest						true	"Sanction type and reason"
+//	@Success		200		{object}	dto.SanctionDTO										"Sanction successfully issued"
+//	@Failure		400		{object}	examples.BadRequestResponse							"Bad request - invalid sanction type"
+//	@Failure		403		{object}	examples.ForbiddenByPermissionsResponse				"Forbidden - not enough rights"
+//	@Failure		404		{object}	examples.UserNotFoundResponse						"Not found - user not found"
+//	@Failure		409		{object}	examples.UserSanctionsChangedConcurrentlyResponse	"Conflict - another sanction was issued concurrently"
+//	@Failure		422		{object}	examples.UnprocessableEntityResponse				"Unprocessable entity - invalid request types"
+//	@Router			/api/users/{user_id}/sanctions [post].
+func (h *SanctionHandler) Issue(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "SanctionHandler.Issue")
+	defer span.End()
+
+	admin := mustExtractUser(ctx)
+
+	userID, err := extractIntParam("user_id", c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	req, err := getAndValidateRequest[request.IssueSanctionRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.sanctionService.Issue(ctx, admin, userID, dto.SanctionType(req.Type), req.Reason)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// GetByUserID returns sanction history of the user
+//
+//	@Summary		Get user sanctions
+//	@Description	Returns all sanctions of the user, most recent first
+//	@Tags			Sanctions
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			user_id	path		int										true	"UserDTO ID"
+//	@Success		200		{array}		dto.SanctionDTO							"Sanction history"
+//	@Failure		403		{object}	examples.ForbiddenByPermissionsResponse	"Forbidden - not enough rights"
+//	@Router			/api/users/{user_id}/sanctions [get].
+func (h *SanctionHandler) GetByUserID(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "SanctionHandler.GetByUserID")
+	defer span.End()
+
+	userID, err := extractIntParam("user_id", c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.sanctionService.FindByUserID(ctx, userID)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// GetByAuthorization returns sanction history of the current user
+//
+//	@Summary		Get own sanctions
+//	@Description	Returns all sanctions of the current user, most recent first
+//	@Tags			Sanctions
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		dto.SanctionDTO						"Sanction history"
+//	@Failure		429	{object}	examples.TooManyRequestsResponse	"Too many requests - received too many requests"
+//	@Router			/api/account/sanctions [get].
+func (h *SanctionHandler) GetByAuthorization(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "SanctionHandler.GetByAuthorization")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+
+	result, err := h.sanctionService.FindByUserID(ctx, user.ID)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// Lift ends the block of the sanction early
+//
+//	@Summary		Lift sanction
+//	@Description	Ends the block of the latest active sanction early. The sanction is still counted for escalation
+//	@Tags			Sanctions
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			sanction_id	path		int										true	"Sanction ID"
+//	@Param			request		body		request.LiftSanctionRequest				true	"Comment"
+//	@Success		200			{object}	dto.SanctionDTO							"Sanction successfully lifted"
+//	@Failure		403			{object}	examples.ForbiddenByPermissionsResponse	"Forbidden - not enough rights"
+//	@Failure		404			{object}	examples.SanctionNotFoundResponse		"Not found - no such sanction"
+//	@Failure		409			{object}	examples.SanctionNotActiveResponse		"Conflict - sanction is not active"
+//	@Failure		409			{object}	examples.SanctionNotLatestResponse		"Conflict - a newer sanction of this type exists"
+//	@Failure		422			{object}	examples.UnprocessableEntityResponse	"Unprocessable entity - invalid request types"
+//	@Router			/api/sanctions/{sanction_id}/lift [post].
+func (h *SanctionHandler) Lift(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "SanctionHandler.Lift")
+	defer span.End()
+
+	admin := mustExtractUser(ctx)
+
+	sanctionID, err := extractIntParam("sanction_id", c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	req, err := getAndValidateRequest[request.LiftSanctionRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.sanctionService.Lift(ctx, admin, sanctionID, req.Comment)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// Appeal appeals own sanction
+//
+//	@Summary		Appeal sanction
+//	@Description	Appeals own sanction, each sanction can be appealed once. Account blocked by the sanction can appeal it after the block ends
+//	@Tags			Sanctions
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			sanction_id	path		int											true	"Sanction ID"
+//	@Param			request		body		request.AppealSanctionRequest				true	"Appeal message"
+//	@Success		200			{object}	dto.SanctionDTO								"Sanction successfully appealed"
+//	@Failure		400			{object}	examples.BadRequestResponse					"Bad request - missed request fields"
+//	@Failure		404			{object}	examples.SanctionNotFoundResponse			"Not found - no such sanction"
+//	@Failure		409			{object}	examples.SanctionRevokedResponse			"Conflict - sanction is already revoked"
+//	@Failure		409			{object}	examples.SanctionAlreadyAppealedResponse	"Conflict - sanction is already appealed"
+//	@Failure		422			{object}	examples.UnprocessableEntityResponse		"Unprocessable entity - invalid request types"
+//	@Failure		429			{object}	examples.TooManyRequestsResponse			"Too many requests - received too many requests"
+//	@Router			/api/account/sanctions/{sanction_id}/appeal [post].
+func (h *SanctionHandler) Appeal(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "SanctionHandler.Appeal")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+
+	sanctionID, err := extractIntParam("sanction_id", c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	req, err := getAndValidateRequest[request.AppealSanctionRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.sanctionService.Appeal(ctx, user, sanctionID, req.Message)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// FindPendingAppealsPaged returns paginated sanctions with pending appeals
+//
+//	@Summary		List pending appeals
+//	@Description	Returns sanctions with pending appeals, oldest appeal first
+//	@Tags			Sanctions
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			page	query		int										false	"Page number (default: 1)"
+//	@Param			size	query		int										false	"Page size (default: 10)"
+//	@Success		200		{object}	examples.PaginatedSanctionsDTOResponse	"Paginated list of appealed sanctions"
+//	@Failure		403		{object}	examples.ForbiddenByPermissionsResponse	"Forbidden - not enough rights"
+//	@Router			/api/sanctions/appeals [get].
+func (h *SanctionHandler) FindPendingAppealsPaged(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "SanctionHandler.FindPendingAppealsPaged")
+	defer span.End()
+
+	result, err := h.sanctionService.FindPendingAppealsPaged(
+		ctx,
+		c.QueryInt("page", 0),
+		c.QueryInt("size", 0),
+	)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccessPagination(result, c)
+}
+
+// ResolveAppeal accepts or rejects sanction appeal
+//
+//	@Summary		Resolve sanction appeal
+//	@Description	Accepted appeal revokes the sanction, if it is the latest of its type the previous level is restored and the block is removed
+//	@Tags			Sanctions
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			sanction_id	path		int											true	"Sanction ID"
+//	@Param			request		body		request.ResolveSanctionAppealRequest		true	"Decision and comment"
+//	@Success		200			{object}	dto.SanctionDTO								"Appeal successfully resolved"
+//	@Failure		403			{object}	examples.ForbiddenByPermissionsResponse		"Forbidden - not enough rights"
+//	@Failure		404			{object}	examples.SanctionNotFoundResponse			"Not found - no such sanction"
+//	@Failure		409			{object}	examples.SanctionAppealNotPendingResponse	"Conflict - sanction has no pending appeal"
+//	@Failure		422			{object}	examples.UnprocessableEntityResponse		"Unprocessable entity - invalid request types"
+//	@Router			/api/sanctions/{sanction_id}/appeal/resolve [post].
+func (h *SanctionHandler) ResolveAppeal(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "SanctionHandler.ResolveAppeal")
+	defer span.End()
+
+	admin := mustExtractUser(ctx)
+
+	sanctionID, err := extractIntParam("sanction_id", c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	req, err := getAndValidateRequest[request.ResolveSanctionAppealRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.sanctionService.ResolveAppeal(ctx, admin, sanctionID, req.Accepted, req.Comment)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/dto/request"
+	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+)
+
+type SeasonHandler struct {
+	seasonService domainservice.SeasonService
+}
+
+func NewSeasonHandler(seasonService domainservice.SeasonService) *SeasonHandler {
+	return &SeasonHandler{seasonService: seasonService}
+}
+
+// Create creates a new battle pass season
+//
+//	@Summary		Create season
+//	@Description	Admin creates a battle pass season with reward tiers on free and premium tracks
+//	@Tags			Seasons
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		request.CreateSeason					true	"Season data"
+//	@Success		200		{object}	examples.SeasonSuccessResponse			"Created season"
+//	@Failure		400		{object}	examples.BadRequestResponse				"Bad request - invalid dates or tiers"
+//	@Failure		403		{object}	examples.ForbiddenByPermissionsResponse	"Forbidden - not enough rights"
+//	@Failure		404		{object}	examples.GameItemNotFound				"Not found - reward item not found"
+//	@Failure		409		{object}	examples.SeasonConflictResponse			"Conflict - season already exists or overlaps"
+//	@Failure		422		{object}	examples.UnprocessableEntityResponse	"Unprocessable entity - invalid request types"
+//	@Router			/api/seasons [post].
+func (h *SeasonHandler) Create(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "SeasonHandler.Create")
+	defer span.End()
+
+	admin := mustExtractUser(ctx)
+
+	req, err := getAndValidateRequest[request.CreateSeason](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.seasonService.Create(ctx, req, admin)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// GetCurrent gets the active season
+//
+//	@Summary		Get current season
+//	@Description	Returns the active battle pass season with its reward tiers
+//	@Tags			Seasons
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		APITokenAuth
+//	@Success		200	{object}	examples.SeasonSuccessResponse	"Current season"
+//	@Failure		404	{object}	examples.SeasonNotFoundResponse	"Not found - no active season"
+//	@Router			/api/seasons/current [get].
+func (h *SeasonHandler) GetCurrent(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "SeasonHandler.GetCurrent")
+	defer span.End()
+
+	result, err := h.seasonService.FindCurrent(ctx)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// GetPassByAuthorization gets season pass of the authenticated user
+//
+//	@Summary		Get current user's season pass
+//	@Description	Returns XP, reached level, premium status and claimed rewards in the active season
+//	@Tags			Seasons
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		APITokenAuth
+//	@Success		200	{object}	examples.SeasonPassSuccessResponse	"Season pass"
+//	@Failure		404	{object}	examples.SeasonNotFoundResponse		"Not found - no active season"
+//	@Router			/api/users/season_pass [get].
+func (h *SeasonHandler) GetPassByAuthorization(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "SeasonHandler.GetPassByAuthorization")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+
+	result, err := h.seasonService.FindPassByUserID(ctx, user.ID)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// Claim claims a season tier reward
+//
+//	@Summary		Claim season reward
+//	@Description	Claims reward of a reached tier on free or premium track. Repeated claims return the existing claim
+//	@Tags			Seasons
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		request.ClaimSeasonReward					true	"Tier level and track"
+//	@Success		200		{object}	examples.SeasonRewardClaimSuccessResponse	"Claimed reward"
+//	@Failure		400		{object}	examples.BadRequestResponse					"Bad request - tier has no reward on this track"
+//	@Failure		403		{object}	examples.SeasonTierLockedResponse			"Forbidden - tier not reached or premium track locked"
+//	@Failure		404		{object}	examples.SeasonNotFoundResponse				"Not found - no active season or tier"
+//	@Failure		422		{object}	examples.UnprocessableEntityResponse		"Unprocessable entity - invalid request types"
+//	@Router			/api/users/season_pass/claim [post].
+func (h *SeasonHandler) Claim(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "SeasonHandler.Claim")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+
+	req, err := getAndValidateRequest[request.ClaimSeasonReward](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.seasonService.Claim(ctx, user, req)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// PurchasePremium unlocks premium track for coins
+//
+//	@Summary		Purchase premium track
+//	@Description	Unlocks premium track of the active season, season premium price is charged from user balance
+//	@Tags			Seasons
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	examples.SeasonPassSuccessResponse	"Season pass"
+//	@Failure		404	{object}	examples.SeasonNotFoundResponse		"Not found - no active season"
+//	@Failure		409	{object}	examples.SeasonConflictResponse		"Conflict - already premium or insufficient coins"
+//	@Router			/api/users/season_pass/premium [post].
+func (h *SeasonHandler) PurchasePremium(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "SeasonHandler.PurchasePremium")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+
+	result, err := h.seasonService.PurchasePremium(ctx, user)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
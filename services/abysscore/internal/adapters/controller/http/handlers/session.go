@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+)
+
+type SessionHandler struct {
+	sessionService domainservice.SessionService
+}
+
+func NewSessionHandler(sessionService domainservice.SessionService) *SessionHandler {
+	return &SessionHandler{sessionService: sessionService}
+}
+
+// GetByAuthorization lists sessions of the authenticated user
+//
+//	@Summary		List sessions
+//	@Description	Returns devices the user is signed in on, most recently active first
+//	@Tags			Sessions
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	examples.SessionsSuccessResponse	"User sessions"
+//	@Router			/api/auth/sessions [get].
+func (h *SessionHandler) GetByAuthorization(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "SessionHandler.GetByAuthorization")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+	sessionID := mustExtractSessionID(ctx)
+
+	result, err := h.sessionService.FindByUserID(ctx, user.ID, sessionID)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// End ends a session of the authenticated user
+//
+//	@Summary		End session
+//	@Description	Revokes tokens of the session and disconnects its websocket connection
+//	@Tags			Sessions
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			session_id	path	string	true	"Session ID"
+//	@Success		204			"Session successfully ended"
+//	@Failure		404			{object}	examples.SessionNotFoundResponse	"Not found - session not found"
+//	@Router			/api/auth/sessions/{session_id} [delete].
+func (h *SessionHandler) End(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "SessionHandler.End")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+
+	err := h.sessionService.End(ctx, user.ID, c.Params("session_id"))
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendNoContent(c)
+}
+
+// Logout ends the current session
+//
+//	@Summary		Logout
+//	@Description	Ends the session the request is authenticated with
+//	@Tags			Sessions
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		204	"Successfully logged out"
+//	@Failure		404	{object}	examples.SessionNotFoundResponse	"Not found - session not found"
+//	@Router			/api/auth/logout [post].
+func (h *SessionHandler) Logout(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "SessionHandler.Logout")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+	sessionID := mustExtractSessionID(ctx)
+
+	err := h.sessionService.End(ctx, user.ID, sessionID)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendNoContent(c)
+}
+
+// LogoutOthers ends all sessions except the current one
+//
+//	@Summary		Logout other sessions
+//	@Description	Ends all sessions of the user except the one the request is authenticated with
+//	@Tags			Sessions
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		204	"Other sessions successfully ended"
+//	@Router			/api/auth/logout_others [post].
+func (h *SessionHandler) LogoutOthers(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "SessionHandler.LogoutOthers")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+	sessionID := mustExtractSessionID(ctx)
+
+	err := h.sessionService.EndOthers(ctx, user.ID, sessionID)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendNoContent(c)
+}
@@ -0,0 +1,151 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/dto/request"
+	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+)
+
+type TwoFactorHandler struct {
+	twoFactorService domainservice.TwoFactorService
+}
+
+func NewTwoFactorHandler(twoFactorService domainservice.TwoFactorService) *TwoFactorHandler {
+	return &TwoFactorHandler{twoFactorService: twoFactorService}
+}
+
+// Enroll starts two-factor authentication enrollment
+//
+//	@Summary		Start two-factor enrollment
+//	@Description	Generates TOTP secret and provisioning URI for QR code. Two-factor authentication is enabled after confirmation with a code
+//	@Tags			Two-factor authentication
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	dto.TOTPEnrollmentDTO				"TOTP secret and provisioning URI"
+//	@Failure		409	{object}	examples.TwoFactorAlreadyEnabled	"Conflict - two-factor authentication is already enabled"
+//	@Router			/api/account/2fa/enroll [post].
+func (h *TwoFactorHandler) Enroll(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "TwoFactorHandler.Enroll")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+
+	result, err := h.twoFactorService.Enroll(ctx, user)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// ConfirmEnrollment enables two-factor authentication
+//
+//	@Summary		Confirm two-factor enrollment
+//	@Description	Enables two-factor authentication if TOTP code is right. Returns recovery codes, they are shown only once
+//	@Tags			Two-factor authentication
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		request.TwoFactorCodeRequest			true	"TOTP code"
+//	@Success		200		{object}	dto.RecoveryCodesDTO					"Recovery codes"
+//	@Failure		400		{object}	examples.BadRequestResponse				"Bad request - missed request fields"
+//	@Failure		401		{object}	examples.WrongTwoFactorCodeResponse		"Unauthorized - wrong code"
+//	@Failure		409		{object}	examples.TwoFactorAlreadyEnabled		"Conflict - two-factor authentication is already enabled"
+//	@Failure		409		{object}	examples.TwoFactorEnrollmentNotStarted	"Conflict - enrollment is not started or expired"
+//	@Failure		429		{object}	examples.TooManyRequestsResponse		"Too many requests - too many wrong codes"
+//	@Router			/api/account/2fa/confirm [post].
+func (h *TwoFactorHandler) ConfirmEnrollment(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "TwoFactorHandler.ConfirmEnrollment")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+
+	req, err := getAndValidateRequest[request.TwoFactorCodeRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.twoFactorService.ConfirmEnrollment(ctx, user, req.Code)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
+
+// Disable disables two-factor authentication
+//
+//	@Summary		Disable two-factor authentication
+//	@Description	Disables two-factor authentication and deletes recovery codes. Requires TOTP or recovery code
+//	@Tags			Two-factor authentication
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body	request.TwoFactorCodeRequest	true	"TOTP or recovery code"
+//	@Success		204		"Two-factor authentication successfully disabled"
+//	@Failure		400		{object}	examples.BadRequestResponse			"Bad request - missed request fields"
+//	@Failure		401		{object}	examples.WrongTwoFactorCodeResponse	"Unauthorized - wrong code"
+//	@Failure		409		{object}	examples.TwoFactorNotEnabled		"Conflict - two-factor authentication is not enabled"
+//	@Failure		429		{object}	examples.TooManyRequestsResponse	"Too many requests - too many wrong codes"
+//	@Router			/api/account/2fa/disable [post].
+func (h *TwoFactorHandler) Disable(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "TwoFactorHandler.Disable")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+
+	req, err := getAndValidateRequest[request.TwoFactorCodeRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	err = h.twoFactorService.Disable(ctx, user, req.Code)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendNoContent(c)
+}
+
+// RegenerateRecoveryCodes replaces recovery codes
+//
+//	@Summary		Regenerate recovery codes
+//	@Description	Replaces all recovery codes with new ones. Requires TOTP or recovery code
+//	@Tags			Two-factor authentication
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		request.TwoFactorCodeRequest		true	"TOTP or recovery code"
+//	@Success		200		{object}	dto.RecoveryCodesDTO				"New recovery codes"
+//	@Failure		400		{object}	examples.BadRequestResponse			"Bad request - missed request fields"
+//	@Failure		401		{object}	examples.WrongTwoFactorCodeResponse	"Unauthorized - wrong code"
+//	@Failure		409		{object}	examples.TwoFactorNotEnabled		"Conflict - two-factor authentication is not enabled"
+//	@Failure		429		{object}	examples.TooManyRequestsResponse	"Too many requests - too many wrong codes"
+//	@Router			/api/account/2fa/recovery_codes [post].
+func (h *TwoFactorHandler) RegenerateRecoveryCodes(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	ctx, span := tracer.StartSpan(ctx, "TwoFactorHandler.RegenerateRecoveryCodes")
+	defer span.End()
+
+	user := mustExtractUser(ctx)
+
+	req, err := getAndValidateRequest[request.TwoFactorCodeRequest](c)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	result, err := h.twoFactorService.RegenerateRecoveryCodes(ctx, user, req.Code)
+	if err != nil {
+		return handleError(err, c)
+	}
+
+	return sendSuccess(result, c)
+}
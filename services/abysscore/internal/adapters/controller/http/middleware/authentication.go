@@ -3,6 +3,7 @@ package middleware
 import (
 	"context"
 	"errors"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
 	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
 	rediswrapper "github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/cache/redis"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/apiscope"
 	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
 	"github.com/intezya/pkglib/logger"
 )
@@ -25,19 +27,34 @@ import (
 
 type CtxKey string
 
-const authorizationHeaderKey = "Authorization"
+const (
+	authorizationHeaderKey = "Authorization"
+	// apiTokenScheme separates personal API tokens from JWT sent with Bearer scheme
+	apiTokenScheme = "Token "
+)
 
-const UserCtxKey CtxKey = "user"
+const (
+	UserCtxKey      CtxKey = "user"
+	SessionIDCtxKey CtxKey = "session_id"
+	APITokenCtxKey  CtxKey = "api_token"
+)
 
 const TokenCacheTime = 10 * time.Second
 
 var (
 	errTokenNotFoundInCache = errors.New("token not found in cache")
 	errInvalidCacheType     = errors.New("invalid cache type")
+	errTokenRevokedInCache  = errors.New("cached token is revoked")
 )
 
+type authenticatedSession struct {
+	user      *dto.UserDTO
+	sessionID string
+}
+
 type AuthenticationMiddleware struct {
 	authenticationService domainservice.AuthenticationService
+	apiTokenService       domainservice.APITokenService
 	redisClient           *rediswrapper.ClientWrapper
 
 	localCache sync.Map
@@ -45,30 +62,37 @@ type AuthenticationMiddleware struct {
 
 func NewAuthenticationMiddleware(
 	authenticationService domainservice.AuthenticationService,
+	apiTokenService domainservice.APITokenService,
 	redisClient *rediswrapper.ClientWrapper,
 ) *AuthenticationMiddleware {
 	return &AuthenticationMiddleware{
 		authenticationService: authenticationService,
+		apiTokenService:       apiTokenService,
 		redisClient:           redisClient,
 		localCache:            sync.Map{},
 	}
 }
 
-func (a *AuthenticationMiddleware) Handle() fiber.Handler {
+// Handle authenticates user by JWT, API tokens are accepted only if the route allows any of their scopes.
+func (a *AuthenticationMiddleware) Handle(apiTokenScopes ...apiscope.Scope) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		logger.Log.Debug("Starting authentication middleware")
 
+		if apiToken, ok := parseAPIToken(c.Get(authorizationHeaderKey)); ok {
+			return a.handleAPIToken(c, apiToken, apiTokenScopes)
+		}
+
 		authorizationHeaderValue := c.Get(authorizationHeaderKey)
 		authorizationHeaderValue = parseAuthorizationValue(authorizationHeaderValue)
 
 		logger.Log.Debug("Authorization header value: ", authorizationHeaderValue)
 		logger.Log.Debug("Redis client is: ", a.redisClient.Client)
 
-		user, err := a.checkTokenCache(authorizationHeaderValue)
+		session, err := a.checkTokenCache(c.UserContext(), authorizationHeaderValue)
 		if err != nil {
 			logger.Log.Debug("Error checking token in cache: ", err)
 
-			user, err = a.authenticationService.ValidateToken(
+			user, sessionID, err := a.authenticationService.ValidateToken(
 				c.UserContext(),
 				authorizationHeaderValue,
 			)
@@ -78,36 +102,81 @@ func (a *AuthenticationMiddleware) Handle() fiber.Handler {
 				return apperrors.HandleError(apperrors.WrapUnauthorized(err), c)
 			}
 
-			a.cacheToken(authorizationHeaderValue, user)
+			session = &authenticatedSession{user: user, sessionID: sessionID}
+
+			a.cacheToken(authorizationHeaderValue, session)
 		}
 
-		logger.Log.Debugw("user successfully fetched", "user", user)
+		logger.Log.Debugw("user successfully fetched", "user", session.user)
 
-		userContext := context.WithValue(c.UserContext(), UserCtxKey, user)
+		userContext := context.WithValue(c.UserContext(), UserCtxKey, session.user)
+		userContext = context.WithValue(userContext, SessionIDCtxKey, session.sessionID)
 		c.SetUserContext(userContext)
 
 		return c.Next()
 	}
 }
 
-func (a *AuthenticationMiddleware) checkTokenCache(token string) (*dto.UserDTO, error) {
+// handleAPIToken is not cached, so revoked tokens stop working immediately.
+func (a *AuthenticationMiddleware) handleAPIToken(
+	c *fiber.Ctx,
+	token string,
+	allowedScopes []apiscope.Scope,
+) error {
+	if len(allowedScopes) == 0 {
+		return apperrors.HandleError(apperrors.ErrAPITokenNotAllowed, c)
+	}
+
+	user, apiToken, err := a.apiTokenService.Authenticate(c.UserContext(), token)
+	if err != nil {
+		logger.Log.Debug("Error validating api token: ", err)
+
+		return apperrors.HandleError(apperrors.WrapUnauthorized(err), c)
+	}
+
+	if !slices.ContainsFunc(apiToken.Scopes, func(scope apiscope.Scope) bool {
+		return slices.Contains(allowedScopes, scope)
+	}) {
+		return apperrors.HandleError(apperrors.ErrAPITokenScopeMissing, c)
+	}
+
+	userContext := context.WithValue(c.UserContext(), UserCtxKey, user)
+	userContext = context.WithValue(userContext, APITokenCtxKey, apiToken)
+	c.SetUserContext(userContext)
+
+	return c.Next()
+}
+
+// checkTokenCache returns cached user, token is still checked for revocation
+// so revoked sessions don't stay valid until the cache entry expires.
+func (a *AuthenticationMiddleware) checkTokenCache(
+	ctx context.Context,
+	token string,
+) (*authenticatedSession, error) {
 	val, ok := a.localCache.Load(token)
 
 	if !ok {
 		return nil, errTokenNotFoundInCache
 	}
 
-	user, ok := val.(*dto.UserDTO)
+	session, ok := val.(*authenticatedSession)
 
 	if !ok {
 		return nil, errInvalidCacheType
 	}
 
-	return user, nil
+	revoked, err := a.authenticationService.IsTokenRevoked(ctx, token)
+	if err != nil || revoked {
+		a.localCache.Delete(token)
+
+		return nil, errTokenRevokedInCache
+	}
+
+	return session, nil
 }
 
-func (a *AuthenticationMiddleware) cacheToken(token string, user *dto.UserDTO) {
-	a.localCache.Store(token, user)
+func (a *AuthenticationMiddleware) cacheToken(token string, session *authenticatedSession) {
+	a.localCache.Store(token, session)
 
 	go func() {
 		time.Sleep(TokenCacheTime)
@@ -115,6 +184,10 @@ func (a *AuthenticationMiddleware) cacheToken(token string, user *dto.UserDTO) {
 	}()
 }
 
+func parseAPIToken(authorizationHeaderValue string) (string, bool) {
+	return strings.CutPrefix(authorizationHeaderValue, apiTokenScheme)
+}
+
 func parseAuthorizationValue(authorizationHeaderValue string) string {
 	switch {
 	case strings.HasPrefix(authorizationHeaderValue, "Bearer "):
@@ -3,10 +3,13 @@ package middleware
 import (
 	"context"
 	"errors"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/config"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
 	rediswrapper "github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/cache/redis"
 	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
 	"github.com/intezya/pkglib/logger"
@@ -16,6 +19,13 @@ import (
 
 const rateLimitStateReadTimeout = 2 * time.Second
 
+// rateLimitBucket is a counter shared by requests with the same key.
+type rateLimitBucket struct {
+	key    string
+	limit  int
+	window time.Duration
+}
+
 type RateLimitMiddleware struct {
 	redisClient          *rediswrapper.ClientWrapper
 	config               *config.Config
@@ -71,18 +81,63 @@ func (r *RateLimitMiddleware) HandleForAuth() fiber.Handler {
 	}
 }
 
+// HandleDefault limits requests by IP. Requests with API token are charged too, the token is not
+// authenticated yet at this point.
 func (r *RateLimitMiddleware) HandleDefault() fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		if !r.isRedisAvailable() {
 			return c.Next()
 		}
 
+		cfg := r.config.RateLimitConfig
 		ipAddr := r.getClientIP(c)
-		path := c.Path()
-		requestID := r.getRequestID(c)
-		key := r.config.RateLimitConfig.DefaultRateLimitKey + ipAddr
+		bucket := rateLimitBucket{
+			key:    cfg.DefaultRateLimitKey + ipAddr,
+			limit:  cfg.DefaultRateLimit,
+			window: cfg.DefaultRateLimitTime,
+		}
 
-		return r.processDefaultRateLimit(c, ipAddr, path, key, requestID)
+		return r.processDefaultRateLimit(c, ipAddr, c.Path(), bucket, r.getRequestID(c))
+	}
+}
+
+// HandleForEmail limits requests by email from the body, it goes after HandleDefault, so requests
+// are charged both by ip and by email. Requests without email are passed as is.
+func (r *RateLimitMiddleware) HandleForEmail() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		email := r.extractEmail(c)
+		if email == "" || !r.isRedisAvailable() {
+			return c.Next() // will be handled as bad request
+		}
+
+		cfg := r.config.RateLimitConfig
+		bucket := rateLimitBucket{
+			key:    cfg.EmailRateLimitKey + email,
+			limit:  cfg.EmailRateLimit,
+			window: cfg.EmailRateLimitTime,
+		}
+
+		return r.processDefaultRateLimit(c, r.getClientIP(c), c.Path(), bucket, r.getRequestID(c))
+	}
+}
+
+// HandleAPIToken limits requests authenticated with API token by the token id, it must go after
+// the authentication middleware. Requests authenticated otherwise are passed as is.
+func (r *RateLimitMiddleware) HandleAPIToken() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		apiToken, ok := c.UserContext().Value(APITokenCtxKey).(*dto.APITokenDTO)
+		if !ok || !r.isRedisAvailable() {
+			return c.Next()
+		}
+
+		cfg := r.config.RateLimitConfig
+		bucket := rateLimitBucket{
+			key:    cfg.APITokenRateLimitKey + strconv.Itoa(apiToken.ID),
+			limit:  cfg.APITokenRateLimit,
+			window: cfg.APITokenRateLimitTime,
+		}
+
+		return r.processDefaultRateLimit(c, r.getClientIP(c), c.Path(), bucket, r.getRequestID(c))
 	}
 }
 
@@ -117,6 +172,19 @@ func (r *RateLimitMiddleware) extractUsername(c *fiber.Ctx) string {
 	return req.Username
 }
 
+func (r *RateLimitMiddleware) extractEmail(c *fiber.Ctx) string {
+	type emailRequest struct {
+		Email string `json:"email"`
+	}
+
+	var req emailRequest
+	if err := c.BodyParser(&req); err != nil {
+		return ""
+	}
+
+	return strings.ToLower(strings.TrimSpace(req.Email))
+}
+
 func (r *RateLimitMiddleware) getRequestID(c *fiber.Ctx) interface{} {
 	return c.Locals(r.config.FiberRequestIDConfig.ContextKey)
 }
@@ -242,13 +310,14 @@ func (r *RateLimitMiddleware) logLimitExceeded(
 
 func (r *RateLimitMiddleware) processDefaultRateLimit(
 	c *fiber.Ctx,
-	ipAddr, path, key string,
+	ipAddr, path string,
+	bucket rateLimitBucket,
 	requestID interface{},
 ) error {
 	ctx, cancel := context.WithTimeout(context.Background(), rateLimitStateReadTimeout)
 	defer cancel()
 
-	count, err := r.redisClient.Client.Get(ctx, key).Int()
+	count, err := r.redisClient.Client.Get(ctx, bucket.key).Int()
 
 	// Redis error
 	if err != nil && !errors.Is(err, redis.Nil) {
@@ -258,15 +327,15 @@ func (r *RateLimitMiddleware) processDefaultRateLimit(
 	}
 
 	// Check if limit exceeded
-	if count >= r.config.RateLimitConfig.DefaultRateLimit {
-		r.logDefaultLimitExceeded(ipAddr, path, count, requestID)
+	if count >= bucket.limit {
+		r.logDefaultLimitExceeded(ipAddr, path, count, bucket.limit, requestID)
 		r.rateLimitCounter.WithLabelValues(ipAddr, path, "blocked").Inc()
 
 		return apperrors.HandleError(apperrors.TooManyRequests, c)
 	}
 
 	// Increment request count
-	if err := r.incrementDefaultRequestCount(ctx, key, ipAddr, path, count, requestID); err != nil {
+	if err := r.incrementDefaultRequestCount(ctx, bucket, ipAddr, path, count, requestID); err != nil {
 		return c.Next()
 	}
 
@@ -275,29 +344,30 @@ func (r *RateLimitMiddleware) processDefaultRateLimit(
 
 func (r *RateLimitMiddleware) logDefaultLimitExceeded(
 	ipAddr, path string,
-	count int,
+	count, limit int,
 	requestID interface{},
 ) {
 	logger.Log.With(
 		"ip", ipAddr,
 		"path", path,
 		"count", count,
-		"limit", r.config.RateLimitConfig.DefaultRateLimit,
+		"limit", limit,
 		"request_id", requestID,
 	).Debug("General rate limit exceeded")
 }
 
 func (r *RateLimitMiddleware) incrementDefaultRequestCount(
 	ctx context.Context,
-	key, ipAddr, path string,
+	bucket rateLimitBucket,
+	ipAddr, path string,
 	count int,
 	requestID interface{},
 ) error {
 	_, err := r.redisClient.Client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
-		pipe.Incr(ctx, key)
+		pipe.Incr(ctx, bucket.key)
 
 		if count == 0 {
-			pipe.Expire(ctx, key, r.config.RateLimitConfig.DefaultRateLimitTime)
+			pipe.Expire(ctx, bucket.key, bucket.window)
 		}
 
 		return nil
@@ -314,7 +384,7 @@ func (r *RateLimitMiddleware) incrementDefaultRequestCount(
 		"ip_address", ipAddr,
 		"path", path,
 		"count", count+1,
-		"limit", r.config.RateLimitConfig.DefaultRateLimit,
+		"limit", bucket.limit,
 		"request_id", requestID,
 	).Debug("Request counted in rate limit")
 
@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/requestid"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/requestmeta"
+)
+
+// RequestMetadataMiddleware passes request id and client ip to services, e.g. for the audit log.
+type RequestMetadataMiddleware struct {
+	requestIDConfig requestid.Config
+}
+
+func NewRequestMetadataMiddleware(requestIDConfig requestid.Config) *RequestMetadataMiddleware {
+	return &RequestMetadataMiddleware{requestIDConfig: requestIDConfig}
+}
+
+func (r *RequestMetadataMiddleware) Handle() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var requestID string
+
+		if value := c.Locals(r.requestIDConfig.ContextKey); value != nil {
+			requestID = fmt.Sprintf("%v", value)
+		}
+
+		c.SetUserContext(requestmeta.WithMetadata(c.UserContext(), &requestmeta.Metadata{
+			RequestID: requestID,
+			IP:        c.IP(),
+		}))
+
+		return c.Next()
+	}
+}
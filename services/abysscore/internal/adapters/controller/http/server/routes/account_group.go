@@ -4,6 +4,7 @@ import (
 	"path"
 
 	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/handlers"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/permission"
 )
 
 func GetAccountGroup(
@@ -27,5 +28,185 @@ func GetAccountGroup(
 		),
 	)
 
+	accountGroup.Add(
+		"/account/email/change/get_code",
+		NewRoute(
+			handlers.AccountHandler.SendCodesForEmailChange,
+			MethodPost,
+		),
+	)
+
+	accountGroup.Add(
+		"/account/email/change/enter_code",
+		NewRoute(
+			handlers.AccountHandler.EnterCodesForEmailChange,
+			MethodPost,
+		),
+	)
+
+	accountGroup.Add(
+		"/account/email/unlink",
+		NewRoute(
+			handlers.AccountHandler.UnlinkEmail,
+			MethodPost,
+		),
+	)
+
+	accountGroup.Add(
+		"/account/email/history",
+		NewRoute(
+			handlers.AccountHandler.GetEmailHistoryByAuthorization,
+			MethodGet,
+		),
+	)
+
+	accountGroup.Add(
+		"/account/password/change",
+		NewRoute(
+			handlers.AccountHandler.ChangePassword,
+			MethodPost,
+		),
+	)
+
+	accountGroup.Add(
+		"/account/username/change",
+		NewRoute(
+			handlers.AccountHandler.ChangeUsername,
+			MethodPost,
+		),
+	)
+
+	accountGroup.Add(
+		"/account/username/history",
+		NewRoute(
+			handlers.AccountHandler.GetUsernameHistoryByAuthorization,
+			MethodGet,
+		),
+	)
+
+	accountGroup.Add(
+		"/account/login_history",
+		NewRoute(
+			handlers.LoginHistoryHandler.FindPagedByAuthorization,
+			MethodGet,
+		),
+	)
+
+	accountGroup.Add(
+		"/account/external_identities",
+		NewRoute(
+			handlers.ExternalIdentityHandler.FindByAuthorization,
+			MethodGet,
+		),
+	)
+
+	accountGroup.Add(
+		"/account/external_identities/:provider/start",
+		NewRoute(
+			handlers.ExternalIdentityHandler.StartLink,
+			MethodPost,
+		),
+	)
+
+	accountGroup.Add(
+		"/account/external_identities/:provider/callback",
+		NewRoute(
+			handlers.ExternalIdentityHandler.FinishLink,
+			MethodPost,
+		),
+	)
+
+	accountGroup.Add(
+		"/account/external_identities/:identity_id/unlink",
+		NewRoute(
+			handlers.ExternalIdentityHandler.Unlink,
+			MethodPost,
+		),
+	)
+
+	accountGroup.Add(
+		"/account/api_tokens",
+		NewRoute(
+			handlers.APITokenHandler.FindByAuthorization,
+			MethodGet,
+		),
+	)
+
+	accountGroup.Add(
+		"/account/api_tokens",
+		NewRoute(
+			handlers.APITokenHandler.Issue,
+			MethodPost,
+		),
+	)
+
+	accountGroup.Add(
+		"/account/api_tokens/:token_id",
+		NewRoute(
+			handlers.APITokenHandler.Revoke,
+			MethodDelete,
+		),
+	)
+
+	accountGroup.Add(
+		"/account/personal_data",
+		NewRoute(
+			handlers.PersonalDataHandler.Export,
+			MethodGet,
+		),
+	)
+
+	accountGroup.Add(
+		"/account/deletion",
+		NewRoute(
+			handlers.PersonalDataHandler.RequestDeletion,
+			MethodPost,
+		),
+	)
+
+	accountGroup.Add(
+		"/account/deletion",
+		NewRoute(
+			handlers.PersonalDataHandler.CancelDeletion,
+			MethodDelete,
+		),
+	)
+
+	accountGroup.Add(
+		"/users/:user_id/email",
+		NewRoute(
+			handlers.AccountHandler.ChangeEmailByAdmin,
+			MethodPut,
+			WithAnyPermission(permission.ChangeUserEmail),
+		),
+	)
+
+	accountGroup.Add(
+		"/users/:user_id/email/history",
+		NewRoute(
+			handlers.AccountHandler.GetEmailHistoryByUserID,
+			MethodGet,
+			WithAnyPermission(permission.ViewUsers),
+		),
+	)
+
+	accountGroup.Add(
+		"/users/:user_id/username/history",
+		NewRoute(
+			handlers.AccountHandler.GetUsernameHistoryByUserID,
+			MethodGet,
+			WithAnyPermission(permission.ViewUsers),
+		),
+	)
+
+	accountGroup.Add(
+		"/users/:user_id/login_history",
+		NewRoute(
+			handlers.LoginHistoryHandler.FindPagedByUserID,
+			MethodGet,
+			WithAnyPermission(permission.ViewUsers),
+		),
+	)
+
 	return accountGroup
 }
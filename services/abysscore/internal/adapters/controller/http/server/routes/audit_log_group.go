@@ -0,0 +1,32 @@
+package routes
+
+import (
+	"path"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/handlers"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/permission"
+)
+
+func GetAuditLogGroup(handlers *handlers.DependencyProvider, provider *DependencyProvider) *RouteGroup {
+	auditLogGroup := NewRouteGroup(path.Join(provider.apiPrefix, "audit_log"))
+
+	auditLogGroup.Add(
+		"",
+		NewRoute(
+			handlers.AuditLogHandler.FindPaged,
+			MethodGet,
+			WithAnyPermission(permission.ViewAuditLog),
+		),
+	)
+
+	auditLogGroup.Add(
+		"/export",
+		NewRoute(
+			handlers.AuditLogHandler.Export,
+			MethodGet,
+			WithAnyPermission(permission.ViewAuditLog),
+		),
+	)
+
+	return auditLogGroup
+}
@@ -29,14 +29,79 @@ func GetAuthGroup(handlers *handlers.DependencyProvider, provider *DependencyPro
 	)
 
 	authGroup.Add(
-		"/change_password",
+		"/password_reset/get_code",
 		NewRoute(
-			handlers.AuthenticationHandler.ChangePassword,
+			handlers.AccountHandler.SendCodeForPasswordReset,
+			MethodPost,
+			WithoutAuthenticationRequirement(),
+			WithRateLimit(EmailRateLimit),
+		),
+	)
+
+	authGroup.Add(
+		"/password_reset/enter_code",
+		NewRoute(
+			handlers.AccountHandler.ResetPassword,
+			MethodPost,
+			WithoutAuthenticationRequirement(),
+			WithRateLimit(EmailRateLimit),
+		),
+	)
+
+	authGroup.Add(
+		"/refresh",
+		NewRoute(
+			handlers.AuthenticationHandler.Refresh,
+			MethodPost,
+			WithoutAuthenticationRequirement(),
+		),
+	)
+
+	authGroup.Add(
+		"/revoke",
+		NewRoute(
+			handlers.AuthenticationHandler.Revoke,
+			MethodPost,
+			WithoutAuthenticationRequirement(),
+		),
+	)
+
+	authGroup.Add(
+		"/oidc/providers",
+		NewRoute(
+			handlers.ExternalIdentityHandler.FindProviders,
+			MethodGet,
+			WithoutAuthenticationRequirement(),
+		),
+	)
+
+	authGroup.Add(
+		"/oidc/register",
+		NewRoute(
+			handlers.ExternalIdentityHandler.Register,
 			MethodPost,
 			WithoutAuthenticationRequirement(),
 			WithRateLimit(AuthRateLimit),
 		),
 	)
 
+	authGroup.Add(
+		"/oidc/:provider/start",
+		NewRoute(
+			handlers.ExternalIdentityHandler.Start,
+			MethodPost,
+			WithoutAuthenticationRequirement(),
+		),
+	)
+
+	authGroup.Add(
+		"/oidc/:provider/callback",
+		NewRoute(
+			handlers.ExternalIdentityHandler.Callback,
+			MethodPost,
+			WithoutAuthenticationRequirement(),
+		),
+	)
+
 	return authGroup
 }
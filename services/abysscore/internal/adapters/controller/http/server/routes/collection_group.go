@@ -0,0 +1,51 @@
+package routes
+
+import (
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/handlers"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/apiscope"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/permission"
+)
+
+func GetCollectionGroup(
+	handlers *handlers.DependencyProvider,
+	provider *DependencyProvider,
+) *RouteGroup {
+	collectionGroup := NewRouteGroup(provider.apiPrefix)
+
+	collectionGroup.Add(
+		"/users/collections",
+		NewRoute(
+			handlers.CollectionHandler.GetProgressByAuthorization,
+			MethodGet,
+			WithAPITokenScopes(apiscope.ReadProfile),
+		),
+	)
+
+	collectionGroup.Add(
+		"/users/:user_id/collections",
+		NewRoute(
+			handlers.CollectionHandler.GetProgressByUserID,
+			MethodGet,
+			WithAnyPermission(permission.ViewInventory),
+		),
+	)
+
+	collectionGroup.Add(
+		"/collections/rewards",
+		NewRoute(
+			handlers.CollectionHandler.GetRewards,
+			MethodGet,
+		),
+	)
+
+	collectionGroup.Add(
+		"/collections/rewards",
+		NewRoute(
+			handlers.CollectionHandler.SetReward,
+			MethodPut,
+			WithAnyPermission(permission.UpdateItem),
+		),
+	)
+
+	return collectionGroup
+}
@@ -4,7 +4,7 @@ import (
 	"path"
 
 	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/handlers"
-	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/access_level"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/permission"
 )
 
 func GetGameItemGroup(
@@ -17,7 +17,17 @@ func GetGameItemGroup(
 		"", NewRoute(
 			handlers.GameItemHandler.Create,
 			MethodPost,
-			WithAccessLevel(access_level.CreateItem),
+			WithAnyPermission(permission.CreateItem),
+		),
+	)
+
+	// Must be registered before "/:id"
+	gameItemGroup.Add(
+		"/archived",
+		NewRoute(
+			handlers.GameItemHandler.FindArchivedPaged,
+			MethodGet,
+			WithAnyPermission(permission.DeleteItem),
 		),
 	)
 
@@ -42,7 +52,7 @@ func GetGameItemGroup(
 		NewRoute(
 			handlers.GameItemHandler.Update,
 			MethodPut,
-			WithAccessLevel(access_level.UpdateItem),
+			WithAnyPermission(permission.UpdateItem),
 		),
 	)
 
@@ -51,7 +61,16 @@ func GetGameItemGroup(
 		NewRoute(
 			handlers.GameItemHandler.Delete,
 			MethodDelete,
-			WithAccessLevel(access_level.DeleteItem),
+			WithAnyPermission(permission.DeleteItem),
+		),
+	)
+
+	gameItemGroup.Add(
+		"/:id/restore",
+		NewRoute(
+			handlers.GameItemHandler.Restore,
+			MethodPost,
+			WithAnyPermission(permission.DeleteItem),
 		),
 	)
 
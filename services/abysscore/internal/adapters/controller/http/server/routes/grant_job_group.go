@@ -0,0 +1,35 @@
+package routes
+
+import (
+	"path"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/handlers"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/permission"
+)
+
+func GetGrantJobGroup(
+	handlers *handlers.DependencyProvider,
+	provider *DependencyProvider,
+) *RouteGroup {
+	grantJobGroup := NewRouteGroup(path.Join(provider.apiPrefix, "items"))
+
+	grantJobGroup.Add(
+		"/:id/grants",
+		NewRoute(
+			handlers.GrantJobHandler.Start,
+			MethodPost,
+			WithAnyPermission(permission.GiveItem),
+		),
+	)
+
+	grantJobGroup.Add(
+		"/grants/:job_id",
+		NewRoute(
+			handlers.GrantJobHandler.FindByID,
+			MethodGet,
+			WithAnyPermission(permission.GiveItem),
+		),
+	)
+
+	return grantJobGroup
+}
@@ -13,6 +13,7 @@ type DependencyProvider struct {
 	Config                *config.Config
 	RedisClient           *rediswrapper.ClientWrapper
 	AuthenticationService domainservice.AuthenticationService
+	APITokenService       domainservice.APITokenService
 	apiPrefix             string
 
 	// Routes map is replaced with RouteGroups
@@ -25,6 +26,7 @@ type DependencyProvider struct {
 func NewDependencyProvider(
 	dependencyProvider *handlers.DependencyProvider,
 	authenticationService domainservice.AuthenticationService,
+	apiTokenService domainservice.APITokenService,
 	redisClient *rediswrapper.ClientWrapper,
 	config *config.Config,
 ) *DependencyProvider {
@@ -33,6 +35,7 @@ func NewDependencyProvider(
 		Config:                config,
 		RedisClient:           redisClient,
 		AuthenticationService: authenticationService,
+		APITokenService:       apiTokenService,
 		apiPrefix:             apiPrefix,
 		Routes:                make(map[string]*Route),
 		routeGroups:           nil,
@@ -65,8 +68,33 @@ func (dp *DependencyProvider) setupRouteGroups(handlers *handlers.DependencyProv
 	gameItemGroup := GetGameItemGroup(handlers, dp)
 	inventoryItemGroup := GetInventoryItemGroup(handlers, dp)
 	accountGroup := GetAccountGroup(handlers, dp)
+	collectionGroup := GetCollectionGroup(handlers, dp)
+	grantJobGroup := GetGrantJobGroup(handlers, dp)
+	seasonGroup := GetSeasonGroup(handlers, dp)
+	sessionGroup := GetSessionGroup(handlers, dp)
+	twoFactorGroup := GetTwoFactorGroup(handlers, dp)
+	hardwareIDGroup := GetHardwareIDGroup(handlers, dp)
+	sanctionGroup := GetSanctionGroup(handlers, dp)
+	roleGroup := GetRoleGroup(handlers, dp)
+	auditLogGroup := GetAuditLogGroup(handlers, dp)
+	wellKnownGroup := GetWellKnownGroup(handlers, dp)
 
-	dp.routeGroups = []*RouteGroup{authGroup, gameItemGroup, inventoryItemGroup, accountGroup}
+	dp.routeGroups = []*RouteGroup{
+		authGroup,
+		gameItemGroup,
+		inventoryItemGroup,
+		accountGroup,
+		collectionGroup,
+		grantJobGroup,
+		seasonGroup,
+		sessionGroup,
+		twoFactorGroup,
+		hardwareIDGroup,
+		sanctionGroup,
+		roleGroup,
+		auditLogGroup,
+		wellKnownGroup,
+	}
 }
 
 // populateRoutesMap converts route groups to the flat map for backward compatibility.
@@ -0,0 +1,94 @@
+package routes
+
+import (
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/handlers"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/permission"
+)
+
+func GetHardwareIDGroup(handlers *handlers.DependencyProvider, provider *DependencyProvider) *RouteGroup {
+	hardwareIDGroup := NewRouteGroup(provider.apiPrefix)
+
+	hardwareIDGroup.Add(
+		"/users/:user_id/hardware_id/reset",
+		NewRoute(
+			handlers.HardwareIDHandler.ResetByAdmin,
+			MethodPost,
+			WithAnyPermission(permission.ResetHardwareID),
+		),
+	)
+
+	hardwareIDGroup.Add(
+		"/auth/hardware_id/reset_request",
+		NewRoute(
+			handlers.HardwareIDHandler.RequestReset,
+			MethodPost,
+			WithoutAuthenticationRequirement(),
+			WithRateLimit(AuthRateLimit),
+		),
+	)
+
+	hardwareIDGroup.Add(
+		"/hardware_id/reset_requests",
+		NewRoute(
+			handlers.HardwareIDHandler.FindRequestsPaged,
+			MethodGet,
+			WithAnyPermission(permission.ResetHardwareID),
+		),
+	)
+
+	hardwareIDGroup.Add(
+		"/hardware_id/reset_requests/:request_id/approve",
+		NewRoute(
+			handlers.HardwareIDHandler.Approve,
+			MethodPost,
+			WithAnyPermission(permission.ResetHardwareID),
+		),
+	)
+
+	hardwareIDGroup.Add(
+		"/hardware_id/reset_requests/:request_id/reject",
+		NewRoute(
+			handlers.HardwareIDHandler.Reject,
+			MethodPost,
+			WithAnyPermission(permission.ResetHardwareID),
+		),
+	)
+
+	hardwareIDGroup.Add(
+		"/users/:user_id/hardware_id/ban",
+		NewRoute(
+			handlers.HardwareIDHandler.BanByUser,
+			MethodPost,
+			WithAnyPermission(permission.BanHardwareID),
+		),
+	)
+
+	hardwareIDGroup.Add(
+		"/hardware_id/bans",
+		NewRoute(
+			handlers.HardwareIDHandler.Ban,
+			MethodPost,
+			WithAnyPermission(permission.BanHardwareID),
+		),
+	)
+
+	hardwareIDGroup.Add(
+		"/hardware_id/bans",
+		NewRoute(
+			handlers.HardwareIDHandler.FindBansPaged,
+			MethodGet,
+			WithAnyPermission(permission.BanHardwareID),
+		),
+	)
+
+	hardwareIDGroup.Add(
+		"/hardware_id/unban",
+		NewRoute(
+			handlers.HardwareIDHandler.Unban,
+			MethodPost,
+			WithAnyPermission(permission.BanHardwareID),
+		),
+	)
+
+	return hardwareIDGroup
+}
@@ -4,7 +4,8 @@ import (
 	"path"
 
 	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/handlers"
-	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/access_level"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/apiscope"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/permission"
 )
 
 func GetInventoryItemGroup(
@@ -17,7 +18,7 @@ func GetInventoryItemGroup(
 		"/:user_id/inventory/:item_id", NewRoute(
 			handlers.InventoryItemHandler.GrantInventoryItemToUser,
 			MethodPost,
-			WithAccessLevel(access_level.GiveItem),
+			WithAnyPermission(permission.GiveItem),
 		),
 	)
 
@@ -26,6 +27,7 @@ func GetInventoryItemGroup(
 		NewRoute(
 			handlers.InventoryItemHandler.GetAllByAuthorization,
 			MethodGet,
+			WithAPITokenScopes(apiscope.ReadProfile),
 		),
 	)
 
@@ -34,7 +36,7 @@ func GetInventoryItemGroup(
 		NewRoute(
 			handlers.InventoryItemHandler.GetAllByUserID,
 			MethodGet,
-			WithAccessLevel(access_level.ViewInventory),
+			WithAnyPermission(permission.ViewInventory),
 		),
 	)
 
@@ -43,7 +45,7 @@ func GetInventoryItemGroup(
 		NewRoute(
 			handlers.InventoryItemHandler.RevokeByAdmin,
 			MethodDelete,
-			WithAccessLevel(access_level.RevokeItem),
+			WithAnyPermission(permission.RevokeItem),
 		),
 	)
 
@@ -55,5 +57,21 @@ func GetInventoryItemGroup(
 		),
 	)
 
+	inventoryItemGroup.Add(
+		"/me/inventory/dismantle",
+		NewRoute(
+			handlers.InventoryItemHandler.DismantleBulk,
+			MethodPost,
+		),
+	)
+
+	inventoryItemGroup.Add(
+		"/me/inventory/:item_id/dismantle",
+		NewRoute(
+			handlers.InventoryItemHandler.Dismantle,
+			MethodPost,
+		),
+	)
+
 	return inventoryItemGroup
 }
@@ -6,7 +6,7 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/middleware"
 	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
-	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/access_level"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/permission"
 	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
 	"github.com/intezya/pkglib/logger"
 )
@@ -17,10 +17,11 @@ var (
 )
 
 type MiddlewareLinker struct {
-	loggingMiddleware        *middleware.LoggingMiddleware
-	recoverMiddleware        *middleware.RecoverMiddleware
-	rateLimitMiddleware      *middleware.RateLimitMiddleware
-	authenticationMiddleware *middleware.AuthenticationMiddleware
+	loggingMiddleware         *middleware.LoggingMiddleware
+	recoverMiddleware         *middleware.RecoverMiddleware
+	rateLimitMiddleware       *middleware.RateLimitMiddleware
+	authenticationMiddleware  *middleware.AuthenticationMiddleware
+	requestMetadataMiddleware *middleware.RequestMetadataMiddleware
 }
 
 func NewMiddlewareLinker(
@@ -28,17 +29,19 @@ func NewMiddlewareLinker(
 	recoverMiddleware *middleware.RecoverMiddleware,
 	rateLimitMiddleware *middleware.RateLimitMiddleware,
 	authenticationMiddleware *middleware.AuthenticationMiddleware,
+	requestMetadataMiddleware *middleware.RequestMetadataMiddleware,
 ) *MiddlewareLinker {
 	return &MiddlewareLinker{
-		loggingMiddleware:        loggingMiddleware,
-		recoverMiddleware:        recoverMiddleware,
-		rateLimitMiddleware:      rateLimitMiddleware,
-		authenticationMiddleware: authenticationMiddleware,
+		loggingMiddleware:         loggingMiddleware,
+		recoverMiddleware:         recoverMiddleware,
+		rateLimitMiddleware:       rateLimitMiddleware,
+		authenticationMiddleware:  authenticationMiddleware,
+		requestMetadataMiddleware: requestMetadataMiddleware,
 	}
 }
 
-// createAccessLevelChecker creates a middleware to check user access level.
-func createAccessLevelChecker(requiredLevel *access_level.AccessLevel) fiber.Handler {
+// createPermissionChecker creates a middleware to check user permissions.
+func createPermissionChecker(required []permission.Permission, match PermissionMatch) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		user, ok := c.UserContext().Value(middleware.UserCtxKey).(*dto.UserDTO)
 
@@ -48,8 +51,13 @@ func createAccessLevelChecker(requiredLevel *access_level.AccessLevel) fiber.Han
 			return apperrors.HandleError(apperrors.InternalServerError, c)
 		}
 
-		if user.AccessLevel < *requiredLevel {
-			return apperrors.HandleError(apperrors.ForbiddenByInsufficientAccessLevel, c)
+		allowed := user.Permissions.HasAny(required...)
+		if match == AllPermissions {
+			allowed = user.Permissions.HasAll(required...)
+		}
+
+		if !allowed {
+			return apperrors.HandleError(apperrors.ForbiddenByInsufficientPermissions, c)
 		}
 
 		return c.Next()
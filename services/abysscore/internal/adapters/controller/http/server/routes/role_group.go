@@ -0,0 +1,75 @@
+package routes
+
+import (
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/handlers"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/permission"
+)
+
+func GetRoleGroup(handlers *handlers.DependencyProvider, provider *DependencyProvider) *RouteGroup {
+	roleGroup := NewRouteGroup(provider.apiPrefix)
+
+	roleGroup.Add(
+		"/permissions",
+		NewRoute(
+			handlers.RoleHandler.GetPermissions,
+			MethodGet,
+			WithAnyPermission(permission.ManageRoles),
+		),
+	)
+
+	roleGroup.Add(
+		"/roles",
+		NewRoute(
+			handlers.RoleHandler.FindAll,
+			MethodGet,
+			WithAnyPermission(permission.ManageRoles),
+		),
+	)
+
+	roleGroup.Add(
+		"/roles",
+		NewRoute(
+			handlers.RoleHandler.Create,
+			MethodPost,
+			WithAnyPermission(permission.ManageRoles),
+		),
+	)
+
+	roleGroup.Add(
+		"/roles/:role_id",
+		NewRoute(
+			handlers.RoleHandler.Update,
+			MethodPut,
+			WithAnyPermission(permission.ManageRoles),
+		),
+	)
+
+	roleGroup.Add(
+		"/roles/:role_id",
+		NewRoute(
+			handlers.RoleHandler.Delete,
+			MethodDelete,
+			WithAnyPermission(permission.ManageRoles),
+		),
+	)
+
+	roleGroup.Add(
+		"/users/:user_id/roles",
+		NewRoute(
+			handlers.RoleHandler.GetByUserID,
+			MethodGet,
+			WithAnyPermission(permission.ManageRoles, permission.ViewUsers),
+		),
+	)
+
+	roleGroup.Add(
+		"/users/:user_id/roles",
+		NewRoute(
+			handlers.RoleHandler.SetUserRoles,
+			MethodPut,
+			WithAnyPermission(permission.ManageRoles),
+		),
+	)
+
+	return roleGroup
+}
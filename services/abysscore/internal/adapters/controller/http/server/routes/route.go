@@ -2,13 +2,15 @@ package routes
 
 import (
 	"github.com/gofiber/fiber/v2"
-	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/access_level"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/apiscope"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/permission"
 )
 
 type (
 	MatchRequirement int
 	RateLimit        int
 	Method           string
+	PermissionMatch  int
 )
 
 const (
@@ -17,10 +19,17 @@ const (
 	MustNotBeInMatch
 )
 
+const (
+	AnyPermission PermissionMatch = iota
+	AllPermissions
+)
+
 const (
 	DisableRateLimit RateLimit = iota
 	AuthRateLimit
 	DefaultRateLimit
+	// EmailRateLimit charges both ip and email from the request body.
+	EmailRateLimit
 )
 
 const (
@@ -35,9 +44,11 @@ type Route struct {
 	Handler fiber.Handler
 	Method
 	RequireAuthentication bool
-	AccessLevel           *access_level.AccessLevel
+	Permissions           []permission.Permission
+	PermissionMatch       PermissionMatch
 	MatchRequirement      MatchRequirement
 	RateLimit             RateLimit
+	APITokenScopes        []apiscope.Scope
 }
 
 type RouteOption func(*Route)
@@ -47,9 +58,11 @@ func NewRoute(handler fiber.Handler, method Method, opts ...RouteOption) *Route
 		Handler:               handler,
 		Method:                method,
 		RequireAuthentication: true,
-		AccessLevel:           nil,
+		Permissions:           nil,
+		PermissionMatch:       AnyPermission,
 		MatchRequirement:      MatchIrrelevant,
 		RateLimit:             DefaultRateLimit,
+		APITokenScopes:        nil,
 	}
 
 	for _, opt := range opts {
@@ -59,9 +72,19 @@ func NewRoute(handler fiber.Handler, method Method, opts ...RouteOption) *Route
 	return route
 }
 
-func WithAccessLevel(level access_level.AccessLevel) RouteOption {
+// WithAnyPermission requires user to have at least one of the permissions.
+func WithAnyPermission(permissions ...permission.Permission) RouteOption {
 	return func(r *Route) {
-		r.AccessLevel = &level
+		r.Permissions = permissions
+		r.PermissionMatch = AnyPermission
+	}
+}
+
+// WithAllPermissions requires user to have every of the permissions.
+func WithAllPermissions(permissions ...permission.Permission) RouteOption {
+	return func(r *Route) {
+		r.Permissions = permissions
+		r.PermissionMatch = AllPermissions
 	}
 }
 
@@ -76,3 +99,10 @@ func WithRateLimit(rate RateLimit) RouteOption {
 		r.RateLimit = rate
 	}
 }
+
+// WithAPITokenScopes allows personal API tokens having any of the scopes, only JWT is accepted otherwise.
+func WithAPITokenScopes(scopes ...apiscope.Scope) RouteOption {
+	return func(r *Route) {
+		r.APITokenScopes = scopes
+	}
+}
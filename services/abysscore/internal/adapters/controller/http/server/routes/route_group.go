@@ -59,6 +59,7 @@ func (rg *RouteGroup) Register(app *fiber.App, middlewareLinker *MiddlewareLinke
 		handlers := []fiber.Handler{
 			middlewareLinker.loggingMiddleware.Handle(),
 			middlewareLinker.recoverMiddleware.Handle(),
+			middlewareLinker.requestMetadataMiddleware.Handle(),
 		}
 
 		// Rate limiting middleware
@@ -66,16 +67,30 @@ func (rg *RouteGroup) Register(app *fiber.App, middlewareLinker *MiddlewareLinke
 		case DisableRateLimit:
 		case DefaultRateLimit:
 			handlers = append(handlers, middlewareLinker.rateLimitMiddleware.HandleDefault())
+		case EmailRateLimit:
+			handlers = append(
+				handlers,
+				middlewareLinker.rateLimitMiddleware.HandleDefault(),
+				middlewareLinker.rateLimitMiddleware.HandleForEmail(),
+			)
 		case AuthRateLimit:
 			handlers = append(handlers, middlewareLinker.rateLimitMiddleware.HandleForAuth())
 		}
 
 		// Authentication middleware
 		if entry.Route.RequireAuthentication {
-			handlers = append(handlers, middlewareLinker.authenticationMiddleware.Handle())
+			handlers = append(handlers, middlewareLinker.authenticationMiddleware.Handle(entry.Route.APITokenScopes...))
 
-			if entry.Route.AccessLevel != nil {
-				handlers = append(handlers, createAccessLevelChecker(entry.Route.AccessLevel))
+			// API tokens get a bucket of their own only after they are authenticated
+			if entry.Route.RateLimit == DefaultRateLimit && len(entry.Route.APITokenScopes) > 0 {
+				handlers = append(handlers, middlewareLinker.rateLimitMiddleware.HandleAPIToken())
+			}
+
+			if len(entry.Route.Permissions) > 0 {
+				handlers = append(
+					handlers,
+					createPermissionChecker(entry.Route.Permissions, entry.Route.PermissionMatch),
+				)
 			}
 
 			if entry.Route.MatchRequirement != MatchIrrelevant {
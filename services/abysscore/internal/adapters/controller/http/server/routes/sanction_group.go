@@ -0,0 +1,73 @@
+package routes
+
+import (
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/handlers"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/permission"
+)
+
+func GetSanctionGroup(handlers *handlers.DependencyProvider, provider *DependencyProvider) *RouteGroup {
+	sanctionGroup := NewRouteGroup(provider.apiPrefix)
+
+	sanctionGroup.Add(
+		"/users/:user_id/sanctions",
+		NewRoute(
+			handlers.SanctionHandler.Issue,
+			MethodPost,
+			WithAnyPermission(permission.ManageSanctions),
+		),
+	)
+
+	sanctionGroup.Add(
+		"/users/:user_id/sanctions",
+		NewRoute(
+			handlers.SanctionHandler.GetByUserID,
+			MethodGet,
+			WithAnyPermission(permission.ViewUsers),
+		),
+	)
+
+	sanctionGroup.Add(
+		"/account/sanctions",
+		NewRoute(
+			handlers.SanctionHandler.GetByAuthorization,
+			MethodGet,
+		),
+	)
+
+	sanctionGroup.Add(
+		"/account/sanctions/:sanction_id/appeal",
+		NewRoute(
+			handlers.SanctionHandler.Appeal,
+			MethodPost,
+		),
+	)
+
+	sanctionGroup.Add(
+		"/sanctions/appeals",
+		NewRoute(
+			handlers.SanctionHandler.FindPendingAppealsPaged,
+			MethodGet,
+			WithAnyPermission(permission.ManageSanctions),
+		),
+	)
+
+	sanctionGroup.Add(
+		"/sanctions/:sanction_id/lift",
+		NewRoute(
+			handlers.SanctionHandler.Lift,
+			MethodPost,
+			WithAnyPermission(permission.ManageSanctions),
+		),
+	)
+
+	sanctionGroup.Add(
+		"/sanctions/:sanction_id/appeal/resolve",
+		NewRoute(
+			handlers.SanctionHandler.ResolveAppeal,
+			MethodPost,
+			WithAnyPermission(permission.ManageSanctions),
+		),
+	)
+
+	return sanctionGroup
+}
@@ -0,0 +1,59 @@
+package routes
+
+import (
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/handlers"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/apiscope"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/permission"
+)
+
+func GetSeasonGroup(
+	handlers *handlers.DependencyProvider,
+	provider *DependencyProvider,
+) *RouteGroup {
+	seasonGroup := NewRouteGroup(provider.apiPrefix)
+
+	seasonGroup.Add(
+		"/seasons",
+		NewRoute(
+			handlers.SeasonHandler.Create,
+			MethodPost,
+			WithAnyPermission(permission.ManageSeasons),
+		),
+	)
+
+	seasonGroup.Add(
+		"/seasons/current",
+		NewRoute(
+			handlers.SeasonHandler.GetCurrent,
+			MethodGet,
+			WithAPITokenScopes(apiscope.ReadProfile),
+		),
+	)
+
+	seasonGroup.Add(
+		"/users/season_pass",
+		NewRoute(
+			handlers.SeasonHandler.GetPassByAuthorization,
+			MethodGet,
+			WithAPITokenScopes(apiscope.ReadProfile),
+		),
+	)
+
+	seasonGroup.Add(
+		"/users/season_pass/claim",
+		NewRoute(
+			handlers.SeasonHandler.Claim,
+			MethodPost,
+		),
+	)
+
+	seasonGroup.Add(
+		"/users/season_pass/premium",
+		NewRoute(
+			handlers.SeasonHandler.PurchasePremium,
+			MethodPost,
+		),
+	)
+
+	return seasonGroup
+}
@@ -0,0 +1,45 @@
+package routes
+
+import (
+	"path"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/handlers"
+)
+
+func GetSessionGroup(handlers *handlers.DependencyProvider, provider *DependencyProvider) *RouteGroup {
+	sessionGroup := NewRouteGroup(path.Join(provider.apiPrefix, "auth"))
+
+	sessionGroup.Add(
+		"/sessions",
+		NewRoute(
+			handlers.SessionHandler.GetByAuthorization,
+			MethodGet,
+		),
+	)
+
+	sessionGroup.Add(
+		"/sessions/:session_id",
+		NewRoute(
+			handlers.SessionHandler.End,
+			MethodDelete,
+		),
+	)
+
+	sessionGroup.Add(
+		"/logout",
+		NewRoute(
+			handlers.SessionHandler.Logout,
+			MethodPost,
+		),
+	)
+
+	sessionGroup.Add(
+		"/logout_others",
+		NewRoute(
+			handlers.SessionHandler.LogoutOthers,
+			MethodPost,
+		),
+	)
+
+	return sessionGroup
+}
@@ -0,0 +1,45 @@
+package routes
+
+import (
+	"path"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/handlers"
+)
+
+func GetTwoFactorGroup(handlers *handlers.DependencyProvider, provider *DependencyProvider) *RouteGroup {
+	twoFactorGroup := NewRouteGroup(path.Join(provider.apiPrefix, "account/2fa"))
+
+	twoFactorGroup.Add(
+		"/enroll",
+		NewRoute(
+			handlers.TwoFactorHandler.Enroll,
+			MethodPost,
+		),
+	)
+
+	twoFactorGroup.Add(
+		"/confirm",
+		NewRoute(
+			handlers.TwoFactorHandler.ConfirmEnrollment,
+			MethodPost,
+		),
+	)
+
+	twoFactorGroup.Add(
+		"/disable",
+		NewRoute(
+			handlers.TwoFactorHandler.Disable,
+			MethodPost,
+		),
+	)
+
+	twoFactorGroup.Add(
+		"/recovery_codes",
+		NewRoute(
+			handlers.TwoFactorHandler.RegenerateRecoveryCodes,
+			MethodPost,
+		),
+	)
+
+	return twoFactorGroup
+}
@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/handlers"
+)
+
+// GetWellKnownGroup serves discovery documents, they live outside of the API prefix.
+func GetWellKnownGroup(handlers *handlers.DependencyProvider, _ *DependencyProvider) *RouteGroup {
+	wellKnownGroup := NewRouteGroup("/.well-known")
+
+	wellKnownGroup.Add(
+		"/jwks.json",
+		NewRoute(
+			handlers.AuthenticationHandler.JWKS,
+			MethodGet,
+			WithoutAuthenticationRequirement(),
+		),
+	)
+
+	return wellKnownGroup
+}
@@ -105,14 +105,17 @@ func createMiddlewareLinker(
 	)
 	authenticationMiddleware := middleware.NewAuthenticationMiddleware(
 		dependencies.AuthenticationService,
+		dependencies.APITokenService,
 		dependencies.RedisClient,
 	)
+	requestMetadataMiddleware := middleware.NewRequestMetadataMiddleware(config.FiberRequestIDConfig)
 
 	return routes.NewMiddlewareLinker(
 		loggingMiddleware,
 		recoverMiddleware,
 		rateLimitMiddleware,
 		authenticationMiddleware,
+		requestMetadataMiddleware,
 	)
 }
 
@@ -0,0 +1,23 @@
+package mapper
+
+import (
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+)
+
+func ToAPITokenDTOFromEnt(token *ent.APIToken) *dto.APITokenDTO {
+	if token == nil {
+		return nil
+	}
+
+	return &dto.APITokenDTO{
+		ID:          token.ID,
+		UserID:      token.UserID,
+		Name:        token.Name,
+		TokenPrefix: token.TokenPrefix,
+		Scopes:      token.Scopes,
+		ExpiresAt:   token.ExpiresAt,
+		LastUsedAt:  token.LastUsedAt,
+		CreatedAt:   token.CreatedAt,
+	}
+}
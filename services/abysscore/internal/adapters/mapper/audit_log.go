@@ -0,0 +1,22 @@
+package mapper
+
+import (
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+)
+
+func ToAuditLogDTOFromEnt(record *ent.AuditLog) *dto.AuditLogDTO {
+	return &dto.AuditLogDTO{
+		ID:            record.ID,
+		ActorID:       record.ActorID,
+		ActorUsername: record.ActorUsername,
+		Action:        dto.AuditAction(record.Action),
+		TargetType:    dto.AuditTargetType(record.TargetType),
+		TargetID:      record.TargetID,
+		Before:        record.Before,
+		After:         record.After,
+		RequestID:     record.RequestID,
+		IP:            record.IP,
+		CreatedAt:     record.CreatedAt,
+	}
+}
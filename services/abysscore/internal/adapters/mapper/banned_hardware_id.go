@@ -7,8 +7,10 @@ import (
 
 func ToBannedHardwareIDFromEnt(banned *ent.BannedHardwareID) *dto.BannedHardwareID {
 	return &dto.BannedHardwareID{
-		ID:        banned.ID,
-		CreatedAt: banned.CreatedAt,
-		BanReason: banned.BanReason,
+		ID:          banned.ID,
+		HardwareID:  banned.HardwareID,
+		CreatedAt:   banned.CreatedAt,
+		BanReason:   banned.BanReason,
+		PerformerID: banned.PerformerID,
 	}
 }
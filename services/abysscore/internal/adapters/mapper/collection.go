@@ -0,0 +1,20 @@
+package mapper
+
+import (
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+)
+
+func ToCollectionRewardDTOFromEnt(reward *ent.CollectionReward) *dto.CollectionRewardDTO {
+	if reward == nil {
+		return nil
+	}
+
+	return &dto.CollectionRewardDTO{
+		Collection:  reward.Collection,
+		Coins:       reward.Coins,
+		Title:       reward.Title,
+		BadgeItemID: reward.BadgeItemID,
+		UpdatedAt:   reward.UpdatedAt,
+	}
+}
@@ -0,0 +1,22 @@
+package mapper
+
+import (
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+)
+
+func ToEmailHistoryDTOFromEnt(record *ent.EmailHistory) *dto.EmailHistoryDTO {
+	if record == nil {
+		return nil
+	}
+
+	return &dto.EmailHistoryDTO{
+		ID:          record.ID,
+		Action:      dto.EmailHistoryAction(record.Action),
+		OldEmail:    record.OldEmail,
+		NewEmail:    record.NewEmail,
+		PerformerID: record.PerformerID,
+		Reason:      record.Reason,
+		CreatedAt:   record.CreatedAt,
+	}
+}
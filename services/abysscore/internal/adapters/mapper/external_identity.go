@@ -0,0 +1,20 @@
+package mapper
+
+import (
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+)
+
+func ToExternalIdentityDTOFromEnt(identity *ent.ExternalIdentity) *dto.ExternalIdentityDTO {
+	if identity == nil {
+		return nil
+	}
+
+	return &dto.ExternalIdentityDTO{
+		ID:          identity.ID,
+		Provider:    identity.Provider,
+		Email:       identity.Email,
+		CreatedAt:   identity.CreatedAt,
+		LastLoginAt: identity.LastLoginAt,
+	}
+}
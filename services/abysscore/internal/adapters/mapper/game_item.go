@@ -17,5 +17,6 @@ func ToGameItemDTOFromEnt(gameItem *ent.GameItem) *dto.GameItemDTO {
 		Type:       gameItem.Type,
 		Rarity:     gameItem.Rarity,
 		CreatedAt:  gameItem.CreatedAt,
+		ArchivedAt: gameItem.ArchivedAt,
 	}
 }
@@ -0,0 +1,29 @@
+package mapper
+
+import (
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+)
+
+func ToGrantJobDTOFromEnt(job *ent.GrantJob) *dto.GrantJobDTO {
+	if job == nil {
+		return nil
+	}
+
+	return &dto.GrantJobDTO{
+		ID:          job.ID,
+		Campaign:    job.Campaign,
+		ItemID:      job.ItemID,
+		CreatedByID: job.CreatedByID,
+		Target:      dto.GrantJobTarget(job.Target),
+		Status:      dto.GrantJobStatus(job.Status),
+		Total:       job.Total,
+		Processed:   job.Processed,
+		Granted:     job.Granted,
+		Skipped:     job.Skipped,
+		Failed:      job.Failed,
+		Error:       job.Error,
+		CreatedAt:   job.CreatedAt,
+		FinishedAt:  job.FinishedAt,
+	}
+}
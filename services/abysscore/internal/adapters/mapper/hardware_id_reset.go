@@ -0,0 +1,23 @@
+package mapper
+
+import (
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+)
+
+func ToHardwareIDResetDTOFromEnt(reset *ent.HardwareIDReset) *dto.HardwareIDResetDTO {
+	if reset == nil {
+		return nil
+	}
+
+	return &dto.HardwareIDResetDTO{
+		ID:              reset.ID,
+		UserID:          reset.UserID,
+		Status:          dto.HardwareIDResetStatus(reset.Status),
+		Reason:          reset.Reason,
+		OperatorID:      reset.OperatorID,
+		OperatorComment: reset.OperatorComment,
+		CreatedAt:       reset.CreatedAt,
+		ResolvedAt:      reset.ResolvedAt,
+	}
+}
@@ -0,0 +1,22 @@
+package mapper
+
+import (
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+)
+
+func ToLoginHistoryDTOFromEnt(record *ent.LoginHistory) *dto.LoginHistoryDTO {
+	if record == nil {
+		return nil
+	}
+
+	return &dto.LoginHistoryDTO{
+		ID:                  record.ID,
+		IP:                  record.IP,
+		UserAgent:           record.UserAgent,
+		HardwareFingerprint: record.HardwareFingerprint,
+		HardwareIDMatch:     record.HardwareIDMatch,
+		Success:             record.Success,
+		CreatedAt:           record.CreatedAt,
+	}
+}
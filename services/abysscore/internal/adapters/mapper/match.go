@@ -0,0 +1,54 @@
+package mapper
+
+import (
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+	"github.com/intezya/pkglib/itertools"
+)
+
+// ToMatchDTOFromEnt expects players and results with their users to be loaded.
+func ToMatchDTOFromEnt(match *ent.Match) *dto.MatchDTO {
+	if match == nil {
+		return nil
+	}
+
+	var result *string
+
+	if match.Result != nil {
+		value := match.Result.String()
+		result = &value
+	}
+
+	return &dto.MatchDTO{
+		ID:                 match.ID,
+		Player1Username:    usernameOf(match.Edges.Player1),
+		Player2Username:    usernameOf(match.Edges.Player2),
+		Player1PenaltyTime: match.Player1PenaltyTime,
+		Player2PenaltyTime: match.Player2PenaltyTime,
+		Status:             match.Status.String(),
+		Result:             result,
+		Results:            itertools.Map(match.Edges.Results, ToPlayerMatchResultDTOFromEnt),
+		CreatedAt:          match.CreatedAt,
+	}
+}
+
+func ToPlayerMatchResultDTOFromEnt(result *ent.PlayerMatchResult) *dto.PlayerMatchResultDTO {
+	if result == nil {
+		return nil
+	}
+
+	return &dto.PlayerMatchResultDTO{
+		PlayerUsername: usernameOf(result.Edges.User),
+		Score:          result.Score,
+		IsRetried:      result.IsRetried,
+		CreatedAt:      result.CreatedAt,
+	}
+}
+
+func usernameOf(user *ent.User) string {
+	if user == nil {
+		return ""
+	}
+
+	return user.Username
+}
@@ -0,0 +1,49 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+	"github.com/intezya/pkglib/itertools"
+)
+
+// ToPersonalDataDTOFromEnt expects all exported edges of the user to be loaded.
+// Hardware fingerprint is left empty, it can't be computed from the stored hardware id.
+func ToPersonalDataDTOFromEnt(user *ent.User, matches []*ent.Match) *dto.PersonalDataDTO {
+	var balance float64
+
+	if user.Edges.Balance != nil {
+		balance = user.Edges.Balance.Coins
+	}
+
+	return &dto.PersonalDataDTO{
+		ExportedAt: time.Now(),
+		Profile:    ToUserDTOFromEnt(user),
+		LinkedIdentifiers: &dto.LinkedIdentifiersDTO{
+			Email:              user.Email,
+			GenshinUID:         user.GenshinUID,
+			HoyolabLogin:       user.HoyolabLogin,
+			ExternalIdentities: itertools.Map(user.Edges.ExternalIdentities, ToExternalIdentityDTOFromEnt),
+		},
+		Statistics:      itertools.Map(user.Edges.Statistics, ToStatisticDTOFromEnt),
+		Matches:         itertools.Map(matches, ToMatchDTOFromEnt),
+		Inventory:       itertools.Map(user.Edges.Items, ToInventoryItemDTOFromEnt),
+		Friends:         itertools.Map(user.Edges.Friends, ToFriendDTOFromEnt),
+		Balance:         balance,
+		UsernameHistory: itertools.Map(user.Edges.UsernameHistory, ToUsernameHistoryDTOFromEnt),
+		EmailHistory:    itertools.Map(user.Edges.EmailHistory, ToEmailHistoryDTOFromEnt),
+		LoginHistory:    itertools.Map(user.Edges.LoginHistory, ToLoginHistoryDTOFromEnt),
+	}
+}
+
+func ToFriendDTOFromEnt(user *ent.User) *dto.FriendDTO {
+	if user == nil {
+		return nil
+	}
+
+	return &dto.FriendDTO{
+		ID:       user.ID,
+		Username: user.Username,
+	}
+}
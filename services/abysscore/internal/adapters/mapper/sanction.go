@@ -0,0 +1,48 @@
+package mapper
+
+import (
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/userentity"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+)
+
+func ToSanctionDTOFromEnt(sanction *ent.Sanction) *dto.SanctionDTO {
+	if sanction == nil {
+		return nil
+	}
+
+	var appealStatus *dto.SanctionAppealStatus
+
+	if sanction.AppealStatus != nil {
+		status := dto.SanctionAppealStatus(*sanction.AppealStatus)
+		appealStatus = &status
+	}
+
+	return &dto.SanctionDTO{
+		ID:                sanction.ID,
+		UserID:            sanction.UserID,
+		Type:              dto.SanctionType(sanction.Type),
+		Level:             sanction.Level,
+		LevelName:         toSanctionLevelName(dto.SanctionType(sanction.Type), sanction.Level),
+		PreviousLevel:     sanction.PreviousLevel,
+		Reason:            sanction.Reason,
+		BlockedUntil:      sanction.BlockedUntil,
+		PerformerID:       sanction.PerformerID,
+		Status:            dto.SanctionStatus(sanction.Status),
+		ResolverID:        sanction.ResolverID,
+		ResolutionComment: sanction.ResolutionComment,
+		ResolvedAt:        sanction.ResolvedAt,
+		AppealStatus:      appealStatus,
+		AppealMessage:     sanction.AppealMessage,
+		AppealedAt:        sanction.AppealedAt,
+		CreatedAt:         sanction.CreatedAt,
+	}
+}
+
+func toSanctionLevelName(sanctionType dto.SanctionType, level int) string {
+	if sanctionType == dto.SanctionSearch {
+		return userentity.SearchBlockLevel(level).String()
+	}
+
+	return userentity.AccountBlockLevel(level).String()
+}
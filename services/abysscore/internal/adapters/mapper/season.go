@@ -0,0 +1,65 @@
+package mapper
+
+import (
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/seasonentity"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+	"github.com/intezya/pkglib/itertools"
+)
+
+func ToSeasonDTOFromEnt(season *ent.Season) *dto.SeasonDTO {
+	if season == nil {
+		return nil
+	}
+
+	tiers := make([]*dto.SeasonTierDTO, 0, len(season.Edges.Tiers))
+	if season.Edges.Tiers != nil {
+		tiers = itertools.Map(season.Edges.Tiers, ToSeasonTierDTOFromEnt)
+	}
+
+	return &dto.SeasonDTO{
+		ID:           season.ID,
+		Number:       season.Number,
+		Name:         season.Name,
+		StartsAt:     season.StartsAt,
+		EndsAt:       season.EndsAt,
+		PremiumPrice: season.PremiumPrice,
+		RolledOverAt: season.RolledOverAt,
+		Tiers:        tiers,
+	}
+}
+
+func ToSeasonTierDTOFromEnt(tier *ent.SeasonTier) *dto.SeasonTierDTO {
+	if tier == nil {
+		return nil
+	}
+
+	return &dto.SeasonTierDTO{
+		Level:         tier.Level,
+		XPRequired:    tier.XpRequired,
+		FreeItemID:    tier.FreeItemID,
+		FreeCoins:     tier.FreeCoins,
+		PremiumItemID: tier.PremiumItemID,
+		PremiumCoins:  tier.PremiumCoins,
+	}
+}
+
+// ToSeasonRewardClaimDTOFromEnt maps claim, item must be loaded separately because claim has no item edge.
+func ToSeasonRewardClaimDTOFromEnt(
+	claim *ent.SeasonRewardClaim,
+	item *ent.InventoryItem,
+) *dto.SeasonRewardClaimDTO {
+	if claim == nil {
+		return nil
+	}
+
+	return &dto.SeasonRewardClaimDTO{
+		SeasonID:  claim.SeasonID,
+		Level:     claim.Level,
+		Track:     seasonentity.Track(claim.Track),
+		Coins:     claim.Coins,
+		Item:      ToInventoryItemDTOFromEnt(item),
+		Automatic: claim.Automatic,
+		ClaimedAt: claim.ClaimedAt,
+	}
+}
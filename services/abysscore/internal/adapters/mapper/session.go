@@ -0,0 +1,22 @@
+package mapper
+
+import (
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity"
+)
+
+func ToSessionDTOFromEntity(session *entity.SessionData, currentSessionID string) *dto.SessionDTO {
+	if session == nil {
+		return nil
+	}
+
+	return &dto.SessionDTO{
+		ID:                  session.ID,
+		HardwareFingerprint: session.HardwareFingerprint,
+		IP:                  session.IP,
+		UserAgent:           session.UserAgent,
+		CreatedAt:           session.CreatedAt,
+		LastActivityAt:      session.LastActivityAt,
+		Current:             session.ID == currentSessionID,
+	}
+}
@@ -0,0 +1,35 @@
+package mapper
+
+import (
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+)
+
+func ToStatisticDTOFromEnt(statistic *ent.Statistic) *dto.StatisticDTO {
+	if statistic == nil {
+		return nil
+	}
+
+	return &dto.StatisticDTO{
+		Type:            statistic.Type.String(),
+		Period:          statistic.Period,
+		XP:              statistic.Xp,
+		MatchCount:      statistic.MatchCount,
+		WinsCount:       statistic.WinsCount,
+		LosesCount:      statistic.LosesCount,
+		DrawsCount:      statistic.DrawsCount,
+		ResultTime:      statistic.ResultTime,
+		RetryTime:       statistic.RetryTime,
+		RetryCount:      statistic.RetryCount,
+		BestResultTime:  statistic.BestResultTime,
+		BestRetryCount:  statistic.BestRetryCount,
+		BestMatchTime:   statistic.BestMatchTime,
+		WorstResultTime: statistic.WorstResultTime,
+		WorstRetryCount: statistic.WorstRetryCount,
+		WorstMatchTime:  statistic.WorstMatchTime,
+		MaxWinStreak:    statistic.MaxWinStreak,
+		MaxLoseStreak:   statistic.MaxLoseStreak,
+		MaxLoginStreak:  statistic.MaxLoginStreak,
+		CreatedAt:       statistic.CreatedAt,
+	}
+}
@@ -3,6 +3,7 @@ package mapper
 import (
 	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/permission"
 	"github.com/intezya/pkglib/itertools"
 )
 
@@ -13,12 +14,15 @@ func ToUserDTOFromEnt(user *ent.User) *dto.UserDTO {
 		Email:                  user.Email,
 		Password:               user.Password,
 		HardwareID:             user.HardwareID,
-		AccessLevel:            user.AccessLevel,
+		TOTPSecret:             user.TotpSecret,
+		TOTPEnabledAt:          user.TotpEnabledAt,
+		Permissions:            toPermissionSetFromRoles(user.Edges.Roles),
 		GenshinUID:             user.GenshinUID,
 		HoyolabLogin:           user.HoyolabLogin,
 		CurrentMatchID:         user.CurrentMatchID,
 		CurrentItemInProfileID: user.CurrentItemInProfileID,
 		AvatarURL:              user.AvatarURL,
+		Title:                  user.Title,
 		InvitesEnabled:         user.InvitesEnabled,
 		LoginAt:                user.LoginAt,
 		LoginStreak:            user.LoginStreak,
@@ -29,9 +33,24 @@ func ToUserDTOFromEnt(user *ent.User) *dto.UserDTO {
 		AccountBlockedUntil:    user.AccountBlockedUntil,
 		AccountBlockReason:     user.AccountBlockReason,
 		AccountBlockedLevel:    user.AccountBlockedLevel,
+		LoginLockedUntil:       user.LoginLockedUntil,
+		DeletionScheduledAt:    user.DeletionScheduledAt,
+		DeletedAt:              user.DeletedAt,
 	}
 }
 
+func toPermissionSetFromRoles(roles []*ent.Role) permission.Set {
+	set := permission.NewSet()
+
+	for _, role := range roles {
+		for _, p := range role.Permissions {
+			set[p] = struct{}{}
+		}
+	}
+
+	return set
+}
+
 func ToUserFullDTOFromEnt(user *ent.User) *dto.UserFullDTO {
 	mappedFriends := itertools.Map(user.Edges.Friends, ToUserDTOFromEnt)
 	mappedItems := itertools.Map(user.Edges.Items, ToInventoryItemDTOFromEnt)
@@ -0,0 +1,21 @@
+package mapper
+
+import (
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+)
+
+func ToUsernameHistoryDTOFromEnt(record *ent.UsernameHistory) *dto.UsernameHistoryDTO {
+	if record == nil {
+		return nil
+	}
+
+	return &dto.UsernameHistoryDTO{
+		ID:          record.ID,
+		OldUsername: record.OldUsername,
+		NewUsername: record.NewUsername,
+		CoinsSpent:  record.CoinsSpent,
+		ReleasedAt:  record.ReleasedAt,
+		CreatedAt:   record.CreatedAt,
+	}
+}
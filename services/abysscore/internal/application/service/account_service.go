@@ -2,31 +2,60 @@ package applicationservice
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
 	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/mailmessage"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/userentity"
 	drivenports "github.com/intezya/abyssleague/services/abysscore/internal/domain/ports/driven"
 	repositoryports "github.com/intezya/abyssleague/services/abysscore/internal/domain/repository"
+	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
 	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/intezya/pkglib/logger"
+)
+
+const (
+	changeEmailCodeExpireMinutes = 10
+	changeEmailResendTimeout     = time.Minute
+	emailChangeCooldown          = 7 * 24 * time.Hour
+
+	passwordResetCodeExpireMinutes = 10
+	passwordResetResendTimeout     = time.Minute
+	passwordResetMaxAttempts       = 5
 )
 
 type AccountService struct {
-	userRepository        repositoryports.UserRepository
-	mailSender            drivenports.MailSender
-	mailMessageRepository repositoryports.MailMessageRepository
+	userRepository             repositoryports.UserRepository
+	externalIdentityRepository repositoryports.ExternalIdentityRepository
+	mailSender                 drivenports.MailSender
+	mailMessageRepository      repositoryports.MailMessageRepository
+	credentialsHelper          domainservice.CredentialsHelper
+	sessionService             domainservice.SessionService
+	twoFactorService           domainservice.TwoFactorService
+	auditLogger                domainservice.AuditLogger
 }
 
 func NewAccountService(
 	userRepository repositoryports.UserRepository,
+	externalIdentityRepository repositoryports.ExternalIdentityRepository,
 	mailSender drivenports.MailSender,
 	mailMessageRepository repositoryports.MailMessageRepository,
+	credentialsHelper domainservice.CredentialsHelper,
+	sessionService domainservice.SessionService,
+	twoFactorService domainservice.TwoFactorService,
+	auditLogger domainservice.AuditLogger,
 ) *AccountService {
 	return &AccountService{
-		userRepository:        userRepository,
-		mailSender:            mailSender,
-		mailMessageRepository: mailMessageRepository,
+		userRepository:             userRepository,
+		externalIdentityRepository: externalIdentityRepository,
+		mailSender:                 mailSender,
+		mailMessageRepository:      mailMessageRepository,
+		credentialsHelper:          credentialsHelper,
+		sessionService:             sessionService,
+		twoFactorService:           twoFactorService,
+		auditLogger:                auditLogger,
 	}
 }
 
@@ -55,6 +84,11 @@ func (s *AccountService) SendCodeForEmailLink(
 		return apperrors.ErrEmailConflict
 	}
 
+	err = s.checkEmailChangeCooldown(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
 	sentMailMessage, err := s.mailMessageRepository.GetLinkMailCodeData(ctx, user.ID)
 
 	if err == nil && sentMailMessage != nil &&
@@ -103,3 +137,529 @@ func (s *AccountService) EnterCodeForEmailLink(
 
 	return result, nil
 }
+
+// SendCodesForEmailChange sends confirmation codes to both current and new email addresses.
+func (s *AccountService) SendCodesForEmailChange(
+	ctx context.Context,
+	user *dto.UserDTO,
+	newEmail string,
+	twoFactorCode string,
+) error {
+	ctx, span := tracer.StartSpan(ctx, "AccountService.SendCodesForEmailChange")
+	defer span.End()
+
+	if user.Email == nil {
+		return apperrors.ErrAccountHasNoEmail
+	}
+
+	err := s.twoFactorService.ChallengeByUserID(ctx, user.ID, twoFactorCode)
+	if err != nil {
+		return err
+	}
+
+	typedEmail, err := drivenports.NewEmail(newEmail)
+	if err != nil {
+		return apperrors.WrapBadRequest(err)
+	}
+
+	if strings.EqualFold(*user.Email, typedEmail.String()) {
+		return apperrors.ErrSameEmail
+	}
+
+	if s.userRepository.ExistsByEmail(ctx, typedEmail.String()) {
+		return apperrors.ErrEmailConflict
+	}
+
+	err = s.checkEmailChangeCooldown(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	sentMailMessage, err := s.mailMessageRepository.GetChangeEmailCodeData(ctx, user.ID)
+
+	if err == nil && sentMailMessage.CreatedAt.After(time.Now().Add(-changeEmailResendTimeout)) {
+		return apperrors.TooManyEmailChangeRequests
+	}
+
+	mailMessage := mailmessage.NewChangeEmailCodeMail(
+		user.ID,
+		*user.Email,
+		typedEmail.String(),
+		changeEmailCodeExpireMinutes,
+	)
+
+	err = s.mailSender.Send(ctx, mailMessage.OldEmailMessage, mailMessage.OldEmail)
+	if err != nil {
+		return apperrors.WrapServiceUnavailable(err)
+	}
+
+	err = s.mailSender.Send(ctx, mailMessage.NewEmailMessage, mailMessage.NewEmail)
+	if err != nil {
+		return apperrors.WrapServiceUnavailable(err)
+	}
+
+	go s.mailMessageRepository.SaveChangeEmailCodeData(ctx, mailMessage, changeEmailCodeExpireMinutes)
+
+	return nil
+}
+
+// EnterCodesForEmailChange changes email if codes sent to both addresses are right.
+func (s *AccountService) EnterCodesForEmailChange(
+	ctx context.Context,
+	user *dto.UserDTO,
+	oldEmailCode, newEmailCode string,
+) (*dto.UserDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "AccountService.EnterCodesForEmailChange")
+	defer span.End()
+
+	mailMessageData, err := s.mailMessageRepository.GetChangeEmailCodeData(ctx, user.ID)
+	if apperrors.IsNotFound(err) {
+		return nil, apperrors.ErrWrongVerificationCodeForEmailChange
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if mailMessageData.OldEmailCode != strings.ToUpper(oldEmailCode) ||
+		mailMessageData.NewEmailCode != strings.ToUpper(newEmailCode) {
+		return nil, apperrors.ErrWrongVerificationCodeForEmailChange
+	}
+
+	// Consuming fails if the codes have been used concurrently
+	mailMessageData, err = s.mailMessageRepository.ConsumeChangeEmailCodeData(ctx, user.ID)
+	if apperrors.IsNotFound(err) {
+		return nil, apperrors.ErrWrongVerificationCodeForEmailChange
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.userRepository.ReplaceEmail(ctx, &dto.EmailChangeDTO{
+		UserID:   user.ID,
+		Action:   dto.EmailChanged,
+		OldEmail: &mailMessageData.OldEmail,
+		NewEmail: &mailMessageData.NewEmail,
+	})
+}
+
+// ChangeEmailByAdmin sets user email without confirmation and cooldown.
+func (s *AccountService) ChangeEmailByAdmin(
+	ctx context.Context,
+	performer *dto.UserDTO,
+	userID int,
+	newEmail string,
+	reason *string,
+) (*dto.UserDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "AccountService.ChangeEmailByAdmin")
+	defer span.End()
+
+	typedEmail, err := drivenports.NewEmail(newEmail)
+	if err != nil {
+		return nil, apperrors.WrapBadRequest(err)
+	}
+
+	user, err := s.userRepository.FindDTOById(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Email != nil && strings.EqualFold(*user.Email, typedEmail.String()) {
+		return nil, apperrors.ErrSameEmail
+	}
+
+	action := dto.EmailChanged
+	if user.Email == nil {
+		action = dto.EmailLinked
+	}
+
+	email := typedEmail.String()
+
+	updated, err := s.userRepository.ReplaceEmail(ctx, &dto.EmailChangeDTO{
+		UserID:      user.ID,
+		Action:      action,
+		OldEmail:    user.Email,
+		NewEmail:    &email,
+		PerformerID: &performer.ID,
+		Reason:      reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditLogger.Record(
+		ctx,
+		performer,
+		dto.AuditActionUserEmailChange,
+		dto.NewAuditTarget(dto.AuditTargetUser, user.ID),
+		map[string]any{"email": user.Email},
+		map[string]any{"email": email, "reason": reason},
+	)
+
+	return updated, nil
+}
+
+// UnlinkEmail removes email from account if the account can be recovered in another way.
+func (s *AccountService) UnlinkEmail(
+	ctx context.Context,
+	user *dto.UserDTO,
+	password string,
+	twoFactorCode string,
+) (*dto.UserDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "AccountService.UnlinkEmail")
+	defer span.End()
+
+	if user.Email == nil {
+		return nil, apperrors.ErrAccountHasNoEmail
+	}
+
+	if !s.credentialsHelper.VerifyPassword(password, user.Password) {
+		return nil, apperrors.ErrWrongPassword
+	}
+
+	err := s.twoFactorService.ChallengeByUserID(ctx, user.ID, twoFactorCode)
+	if err != nil {
+		return nil, err
+	}
+
+	hasRecoveryMethod, err := s.hasRecoveryMethodExceptEmail(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasRecoveryMethod {
+		return nil, apperrors.ErrNoOtherRecoveryMethod
+	}
+
+	return s.userRepository.ReplaceEmail(ctx, &dto.EmailChangeDTO{
+		UserID:   user.ID,
+		Action:   dto.EmailUnlinked,
+		OldEmail: user.Email,
+	})
+}
+
+func (s *AccountService) GetEmailHistory(ctx context.Context, userID int) ([]*dto.EmailHistoryDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "AccountService.GetEmailHistory")
+	defer span.End()
+
+	return s.userRepository.FindEmailHistoryByUserID(ctx, userID)
+}
+
+// SendCodeForPasswordReset sends password reset code to the linked email.
+// Unknown email and resend cooldown are not reported to the caller, so linked emails can not be enumerated.
+func (s *AccountService) SendCodeForPasswordReset(ctx context.Context, email string) error {
+	ctx, span := tracer.StartSpan(ctx, "AccountService.SendCodeForPasswordReset")
+	defer span.End()
+
+	typedEmail, err := drivenports.NewEmail(email)
+	if err != nil {
+		return apperrors.WrapBadRequest(err)
+	}
+
+	user, err := s.userRepository.FindDTOByEmail(ctx, typedEmail.String())
+	if apperrors.IsNotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	sentMailMessage, err := s.mailMessageRepository.GetPasswordResetCodeData(ctx, user.ID)
+
+	if err == nil && sentMailMessage.CreatedAt.After(time.Now().Add(-passwordResetResendTimeout)) {
+		return nil
+	}
+
+	// Previous code and its wrong attempts are dropped, only the last sent code is valid
+	err = s.mailMessageRepository.DeletePasswordResetCodeData(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	mailMessage := mailmessage.NewPasswordResetCodeMail(
+		user.ID,
+		typedEmail.String(),
+		passwordResetCodeExpireMinutes,
+	)
+
+	err = s.mailSender.Send(ctx, mailMessage.Message, typedEmail.String())
+	if err != nil {
+		return apperrors.WrapServiceUnavailable(err)
+	}
+
+	go s.mailMessageRepository.SavePasswordResetCodeData(ctx, mailMessage, passwordResetCodeExpireMinutes)
+
+	return nil
+}
+
+// ResetPassword sets new password if verification code is right and ends all user sessions.
+// Users with enabled two-factor authentication also have to enter TOTP or recovery code.
+// Code is single-use and is invalidated after too many wrong attempts.
+func (s *AccountService) ResetPassword(
+	ctx context.Context,
+	email, verificationCode, newPassword, twoFactorCode string,
+) error {
+	ctx, span := tracer.StartSpan(ctx, "AccountService.ResetPassword")
+	defer span.End()
+
+	user, err := s.userRepository.FindDTOByEmail(ctx, email)
+	if apperrors.IsNotFound(err) {
+		return apperrors.ErrWrongVerificationCodeForPasswordReset
+	}
+
+	if err != nil {
+		return err
+	}
+
+	mailMessageData, err := s.mailMessageRepository.GetPasswordResetCodeData(ctx, user.ID)
+	if apperrors.IsNotFound(err) {
+		return apperrors.ErrWrongVerificationCodeForPasswordReset
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if mailMessageData.VerificationCode != strings.ToUpper(verificationCode) {
+		return s.registerWrongPasswordResetAttempt(ctx, user.ID)
+	}
+
+	// Email alone is not enough to take over account protected by two-factor authentication
+	err = s.twoFactorService.Challenge(ctx, user, twoFactorCode)
+	if err != nil {
+		return err
+	}
+
+	// Checked before consuming the code, so the user can retry with another password
+	err = s.checkNewPassword(ctx, user, newPassword)
+	if err != nil {
+		return err
+	}
+
+	// Consuming fails if the code has been used concurrently
+	_, err = s.mailMessageRepository.ConsumePasswordResetCodeData(ctx, user.ID)
+	if apperrors.IsNotFound(err) {
+		return apperrors.ErrWrongVerificationCodeForPasswordReset
+	}
+
+	if err != nil {
+		return err
+	}
+
+	err = s.replacePassword(ctx, user, newPassword)
+	if err != nil {
+		return err
+	}
+
+	return s.sessionService.EndAll(ctx, user.ID)
+}
+
+func (s *AccountService) registerWrongPasswordResetAttempt(ctx context.Context, userID int) error {
+	ctx, span := tracer.StartSpan(ctx, "AccountService.registerWrongPasswordResetAttempt")
+	defer span.End()
+
+	attempts, err := s.mailMessageRepository.IncrementPasswordResetAttempts(
+		ctx,
+		userID,
+		passwordResetCodeExpireMinutes,
+	)
+	if err != nil {
+		return err
+	}
+
+	if attempts >= passwordResetMaxAttempts {
+		err = s.mailMessageRepository.DeletePasswordResetCodeData(ctx, userID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return apperrors.ErrWrongVerificationCodeForPasswordReset
+}
+
+// checkEmailChangeCooldown limits how often the user can link or change email.
+func (s *AccountService) checkEmailChangeCooldown(ctx context.Context, userID int) error {
+	ctx, span := tracer.StartSpan(ctx, "AccountService.checkEmailChangeCooldown")
+	defer span.End()
+
+	lastChangeAt, err := s.userRepository.FindLastEmailChangeAt(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if lastChangeAt != nil && lastChangeAt.After(time.Now().Add(-emailChangeCooldown)) {
+		return apperrors.ErrEmailChangeCooldown
+	}
+
+	return nil
+}
+
+// hasRecoveryMethodExceptEmail reports whether account access can be restored without email,
+// linked external identity signs in without password.
+func (s *AccountService) hasRecoveryMethodExceptEmail(ctx context.Context, userID int) (bool, error) {
+	identities, err := s.externalIdentityRepository.FindByUserID(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	return len(identities) > 0, nil
+}
+
+// ChangePassword sets new password of the authenticated user and ends all other sessions.
+// Confirmation is sent to the linked email, if any.
+func (s *AccountService) ChangePassword(
+	ctx context.Context,
+	user *dto.UserDTO,
+	sessionID string,
+	oldPassword, newPassword, twoFactorCode string,
+) error {
+	ctx, span := tracer.StartSpan(ctx, "AccountService.ChangePassword")
+	defer span.End()
+
+	if !s.credentialsHelper.VerifyPassword(oldPassword, user.Password) {
+		return apperrors.ErrWrongPassword
+	}
+
+	err := s.twoFactorService.Challenge(ctx, user, twoFactorCode)
+	if err != nil {
+		return err
+	}
+
+	err = s.checkNewPassword(ctx, user, newPassword)
+	if err != nil {
+		return err
+	}
+
+	err = s.replacePassword(ctx, user, newPassword)
+	if err != nil {
+		return err
+	}
+
+	err = s.sessionService.EndOthers(ctx, user.ID, sessionID)
+	if err != nil {
+		return err
+	}
+
+	if user.Email == nil {
+		return nil
+	}
+
+	err = s.mailSender.Send(ctx, mailmessage.NewPasswordChangedMessage(user.Username), *user.Email)
+	if err != nil {
+		logger.Log.Warnw("failed to send password changed mail", "error", err, "userID", user.ID)
+	}
+
+	return nil
+}
+
+// checkNewPassword validates password strength and rejects the current and recently used passwords.
+func (s *AccountService) checkNewPassword(ctx context.Context, user *dto.UserDTO, newPassword string) error {
+	err := userentity.ValidatePassword(newPassword, user.Username)
+	if err != nil {
+		return apperrors.WrapBadRequest(err)
+	}
+
+	if s.credentialsHelper.VerifyPassword(newPassword, user.Password) {
+		return apperrors.ErrPasswordSameAsCurrent
+	}
+
+	recentPasswords, err := s.userRepository.FindRecentPasswords(ctx, user.ID, userentity.PasswordHistorySize-1)
+	if err != nil {
+		return err
+	}
+
+	for _, recentPassword := range recentPasswords {
+		if s.credentialsHelper.VerifyPassword(newPassword, recentPassword) {
+			return apperrors.ErrPasswordRecentlyUsed
+		}
+	}
+
+	return nil
+}
+
+func (s *AccountService) replacePassword(ctx context.Context, user *dto.UserDTO, newPassword string) error {
+	return s.userRepository.ReplacePassword(
+		ctx,
+		user.ID,
+		user.Password,
+		s.credentialsHelper.EncodePassword(newPassword),
+		userentity.PasswordHistorySize,
+	)
+}
+
+// ChangeUsername changes username once per cooldown, every change except the first one costs coins.
+// Tokens carry the username, so access tokens of all sessions are reissued.
+func (s *AccountService) ChangeUsername(
+	ctx context.Context,
+	user *dto.UserDTO,
+	sessionID string,
+	newUsername string,
+	twoFactorCode string,
+) (*dto.UsernameChangedDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "AccountService.ChangeUsername")
+	defer span.End()
+
+	if newUsername == user.Username {
+		return nil, apperrors.ErrUsernameSameAsCurrent
+	}
+
+	err := userentity.ValidateUsername(newUsername)
+	if err != nil {
+		return nil, apperrors.WrapBadRequest(err)
+	}
+
+	err = s.twoFactorService.ChallengeByUserID(ctx, user.ID, twoFactorCode)
+	if err != nil {
+		return nil, err
+	}
+
+	lastChangeAt, err := s.userRepository.FindLastUsernameChangeAt(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var cost float64
+
+	if lastChangeAt != nil {
+		availableAt := lastChangeAt.Add(userentity.UsernameChangeCooldown)
+		if availableAt.After(time.Now()) {
+			return nil, apperrors.ErrUsernameChangeCooldown(availableAt)
+		}
+
+		cost = userentity.UsernameChangeCost
+	}
+
+	updatedUser, err := s.userRepository.ReplaceUsername(ctx, &dto.UsernameChangeDTO{
+		UserID:      user.ID,
+		OldUsername: user.Username,
+		NewUsername: newUsername,
+		CoinsSpent:  cost,
+		ReleasedAt:  time.Now().Add(userentity.UsernameReleaseGracePeriod),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, err := s.sessionService.ReissueAccessTokens(ctx, updatedUser, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.UsernameChangedDTO{
+		User:        updatedUser,
+		AccessToken: accessToken,
+		CoinsSpent:  cost,
+	}, nil
+}
+
+func (s *AccountService) GetUsernameHistory(
+	ctx context.Context,
+	userID int,
+) ([]*dto.UsernameHistoryDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "AccountService.GetUsernameHistory")
+	defer span.End()
+
+	return s.userRepository.FindUsernameHistoryByUserID(ctx, userID)
+}
@@ -0,0 +1,163 @@
+package applicationservice
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity"
+	repositoryports "github.com/intezya/abyssleague/services/abysscore/internal/domain/repository"
+	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/apiscope"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/intezya/pkglib/logger"
+)
+
+const day = 24 * time.Hour
+
+type APITokenService struct {
+	apiTokenRepository repositoryports.APITokenRepository
+	userRepository     repositoryports.UserRepository
+	credentialsHelper  domainservice.CredentialsHelper
+	twoFactorService   domainservice.TwoFactorService
+}
+
+func NewAPITokenService(
+	apiTokenRepository repositoryports.APITokenRepository,
+	userRepository repositoryports.UserRepository,
+	credentialsHelper domainservice.CredentialsHelper,
+	twoFactorService domainservice.TwoFactorService,
+) *APITokenService {
+	return &APITokenService{
+		apiTokenRepository: apiTokenRepository,
+		userRepository:     userRepository,
+		credentialsHelper:  credentialsHelper,
+		twoFactorService:   twoFactorService,
+	}
+}
+
+// Issue creates a token, it isn't bound to hardware id, so the password is required.
+func (s *APITokenService) Issue(
+	ctx context.Context,
+	user *dto.UserDTO,
+	request *dto.IssueAPITokenDTO,
+) (*dto.IssuedAPITokenDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "APITokenService.Issue")
+	defer span.End()
+
+	scopes, err := s.validateScopes(request.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.LifetimeDays < 1 || request.LifetimeDays > entity.APITokenMaxLifetimeDays {
+		return nil, apperrors.ErrInvalidAPITokenLifetime(entity.APITokenMaxLifetimeDays)
+	}
+
+	if !s.credentialsHelper.VerifyPassword(request.Password, user.Password) {
+		return nil, apperrors.ErrWrongPassword
+	}
+
+	err = s.twoFactorService.ChallengeByUserID(ctx, user.ID, request.TwoFactorCode)
+	if err != nil {
+		return nil, err
+	}
+
+	token := entity.NewAPIToken()
+
+	created, err := s.apiTokenRepository.Create(ctx, &dto.CreateAPITokenDTO{
+		UserID:      user.ID,
+		Name:        request.Name,
+		TokenHash:   s.credentialsHelper.HashToken(token),
+		TokenPrefix: entity.APITokenVisiblePart(token),
+		Scopes:      scopes,
+		ExpiresAt:   time.Now().Add(time.Duration(request.LifetimeDays) * day),
+	}, entity.APITokenMaxPerUser)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.IssuedAPITokenDTO{Token: token, APIToken: created}, nil
+}
+
+func (s *APITokenService) FindByUserID(ctx context.Context, userID int) ([]*dto.APITokenDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "APITokenService.FindByUserID")
+	defer span.End()
+
+	return s.apiTokenRepository.FindByUserID(ctx, userID)
+}
+
+func (s *APITokenService) Revoke(ctx context.Context, userID int, tokenID int) error {
+	ctx, span := tracer.StartSpan(ctx, "APITokenService.Revoke")
+	defer span.End()
+
+	return s.apiTokenRepository.DeleteByIDAndUserID(ctx, tokenID, userID)
+}
+
+func (s *APITokenService) Authenticate(
+	ctx context.Context,
+	token string,
+) (*dto.UserDTO, *dto.APITokenDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "APITokenService.Authenticate")
+	defer span.End()
+
+	if !strings.HasPrefix(token, entity.APITokenPrefix) {
+		return nil, nil, apperrors.ErrInvalidAPIToken
+	}
+
+	apiToken, err := s.apiTokenRepository.FindValidByHash(ctx, s.credentialsHelper.HashToken(token))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user, err := s.userRepository.FindDTOById(ctx, apiToken.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if user.AccountBlockedUntil != nil && user.AccountBlockedUntil.After(time.Now()) {
+		return nil, nil, apperrors.ErrAccountIsLocked(user.AccountBlockReason)
+	}
+
+	s.touchLastUsed(ctx, apiToken)
+
+	return user, apiToken, nil
+}
+
+func (s *APITokenService) touchLastUsed(ctx context.Context, token *dto.APITokenDTO) {
+	now := time.Now()
+
+	if token.LastUsedAt != nil && now.Sub(*token.LastUsedAt) < entity.APITokenLastUsedPrecision {
+		return
+	}
+
+	err := s.apiTokenRepository.TouchLastUsed(ctx, token.ID, now)
+	if err != nil {
+		logger.Log.Warnw("failed to update api token usage", "error", err, "tokenID", token.ID)
+
+		return
+	}
+
+	token.LastUsedAt = &now
+}
+
+// validateScopes rejects unknown scopes and drops duplicates.
+func (s *APITokenService) validateScopes(scopes []apiscope.Scope) ([]apiscope.Scope, error) {
+	if len(scopes) == 0 {
+		return nil, apperrors.ErrAPITokenScopesEmpty
+	}
+
+	for _, scope := range scopes {
+		if !scope.IsValid() {
+			return nil, apperrors.ErrUnknownAPITokenScope
+		}
+	}
+
+	unique := slices.Clone(scopes)
+	slices.Sort(unique)
+
+	return slices.Compact(unique), nil
+}
@@ -0,0 +1,188 @@
+package applicationservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/auditlogentity"
+	repositoryports "github.com/intezya/abyssleague/services/abysscore/internal/domain/repository"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/requestmeta"
+	"github.com/intezya/pkglib/logger"
+)
+
+const auditLogExportBatchSize = 500
+
+// auditScalarKey wraps states which are not JSON objects, e.g. lists of roles.
+const auditScalarKey = "value"
+
+type AuditLogService struct {
+	auditLogRepository repositoryports.AuditLogRepository
+}
+
+func NewAuditLogService(auditLogRepository repositoryports.AuditLogRepository) *AuditLogService {
+	return &AuditLogService{auditLogRepository: auditLogRepository}
+}
+
+func (s *AuditLogService) Record(
+	ctx context.Context,
+	performer *dto.UserDTO,
+	action dto.AuditAction,
+	target dto.AuditTarget,
+	before, after any,
+) {
+	ctx, span := tracer.StartSpan(ctx, "AuditLogService.Record")
+	defer span.End()
+
+	err := s.record(ctx, performer, action, target, before, after)
+	if err != nil {
+		logger.Log.Errorw(
+			"failed to record audit log",
+			"error", err,
+			"action", action,
+			"performerID", performer.ID,
+			"targetType", target.Type,
+		)
+	}
+}
+
+func (s *AuditLogService) record(
+	ctx context.Context,
+	performer *dto.UserDTO,
+	action dto.AuditAction,
+	target dto.AuditTarget,
+	before, after any,
+) error {
+	beforeState, err := toAuditState(before)
+	if err != nil {
+		return err
+	}
+
+	afterState, err := toAuditState(after)
+	if err != nil {
+		return err
+	}
+
+	beforeDiff, afterDiff := diffAuditStates(beforeState, afterState)
+	metadata := requestmeta.FromContext(ctx)
+
+	return s.auditLogRepository.Create(ctx, &dto.CreateAuditLogDTO{
+		ActorID:       performer.ID,
+		ActorUsername: performer.Username,
+		Action:        action,
+		Target:        target,
+		Before:        beforeDiff,
+		After:         afterDiff,
+		RequestID:     nonEmpty(metadata.RequestID),
+		IP:            nonEmpty(metadata.IP),
+	})
+}
+
+func (s *AuditLogService) FindPaged(
+	ctx context.Context,
+	filter *auditlogentity.Filter,
+	page, size int,
+) (*dto.PaginatedResult[*dto.AuditLogDTO], error) {
+	ctx, span := tracer.StartSpan(ctx, "AuditLogService.FindPaged")
+	defer span.End()
+
+	return s.auditLogRepository.FindPaged(ctx, filter, page, size)
+}
+
+func (s *AuditLogService) Export(
+	ctx context.Context,
+	filter *auditlogentity.Filter,
+	writer io.Writer,
+) error {
+	ctx, span := tracer.StartSpan(ctx, "AuditLogService.Export")
+	defer span.End()
+
+	encoder := json.NewEncoder(writer)
+	afterID := 0
+
+	for {
+		batch, err := s.auditLogRepository.FindBatch(ctx, filter, afterID, auditLogExportBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, record := range batch {
+			if err := encoder.Encode(record); err != nil {
+				return apperrors.WrapUnexpectedError(fmt.Errorf("write audit log record: %w", err))
+			}
+		}
+
+		if len(batch) < auditLogExportBatchSize {
+			return nil
+		}
+
+		afterID = batch[len(batch)-1].ID
+	}
+}
+
+// toAuditState converts target state to generic JSON object, so fields hidden from responses stay hidden.
+func toAuditState(state any) (map[string]any, error) {
+	if state == nil || (reflect.ValueOf(state).Kind() == reflect.Ptr && reflect.ValueOf(state).IsNil()) {
+		return nil, nil //nolint:nilnil // absent state is not an error
+	}
+
+	raw, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("marshal audit state: %w", err)
+	}
+
+	var object map[string]any
+	if err := json.Unmarshal(raw, &object); err == nil {
+		return object, nil
+	}
+
+	var value any
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, fmt.Errorf("unmarshal audit state: %w", err)
+	}
+
+	return map[string]any{auditScalarKey: value}, nil
+}
+
+// diffAuditStates keeps only fields which differ between states.
+// Created and deleted targets are stored whole.
+func diffAuditStates(before, after map[string]any) (map[string]any, map[string]any) {
+	if before == nil || after == nil {
+		return before, after
+	}
+
+	beforeDiff := make(map[string]any)
+	afterDiff := make(map[string]any)
+
+	for key, beforeValue := range before {
+		afterValue, ok := after[key]
+		if !ok || !reflect.DeepEqual(beforeValue, afterValue) {
+			beforeDiff[key] = beforeValue
+
+			if ok {
+				afterDiff[key] = afterValue
+			}
+		}
+	}
+
+	for key, afterValue := range after {
+		if _, ok := before[key]; !ok {
+			afterDiff[key] = afterValue
+		}
+	}
+
+	return beforeDiff, afterDiff
+}
+
+func nonEmpty(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
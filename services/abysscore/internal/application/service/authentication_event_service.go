@@ -5,6 +5,7 @@ import (
 	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
 	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/userentity"
 	repositoryports "github.com/intezya/abyssleague/services/abysscore/internal/domain/repository"
+	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/persistence"
@@ -16,10 +17,17 @@ import (
 
 type AuthenticationEventService struct {
 	userRepository repositoryports.UserRepository
+	seasonService  domainservice.SeasonService
 }
 
-func NewAuthenticationEventService(userRepository repositoryports.UserRepository) *AuthenticationEventService {
-	return &AuthenticationEventService{userRepository: userRepository}
+func NewAuthenticationEventService(
+	userRepository repositoryports.UserRepository,
+	seasonService domainservice.SeasonService,
+) *AuthenticationEventService {
+	return &AuthenticationEventService{
+		userRepository: userRepository,
+		seasonService:  seasonService,
+	}
 }
 
 func (s *AuthenticationEventService) HandleRegistration(ctx context.Context, user *dto.UserDTO) {
@@ -32,6 +40,9 @@ func (s *AuthenticationEventService) HandleLogin(ctx context.Context, user *dto.
 	ctx, span := tracer.StartSpan(ctx, "AuthenticationEventService.HandleLogin")
 	defer span.End()
 
+	// must be checked before login streak update changes user.LoginAt
+	isStreakContinued := timeutils.IsDayBeforeToday(user.LoginAt)
+
 	tx, err := s.userRepository.WithTx(ctx)
 	if err != nil {
 		logger.Log.Warnln("failed to start transaction:", err)
@@ -52,14 +63,18 @@ func (s *AuthenticationEventService) HandleLogin(ctx context.Context, user *dto.
 					return s.processBanDecrementAfterLogin(ctx, tx, user)
 				},
 			)
-			// TODO: Implement logic to add bonuses for user based on login streak
-
 			return group.Wait()
 		},
 	)
 
 	if err != nil {
 		logger.Log.Errorln("error in authentication handler:", err)
+
+		return
+	}
+
+	if isStreakContinued {
+		s.seasonService.HandleLoginStreak(ctx, user.ID, user.LoginStreak)
 	}
 }
 
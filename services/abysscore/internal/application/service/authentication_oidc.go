@@ -0,0 +1,408 @@
+package applicationservice
+
+import (
+	"context"
+	"slices"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/userentity"
+	drivenports "github.com/intezya/abyssleague/services/abysscore/internal/domain/ports/driven"
+	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/persistence"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/intezya/pkglib/logger"
+)
+
+// OIDCProviders returns names of configured identity providers.
+func (s *AuthenticationService) OIDCProviders() []string {
+	names := make([]string, 0, len(s.oidcProviders))
+
+	for name := range s.oidcProviders {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// StartOIDC starts authorization code flow with PKCE, authorization is bound to the device it was started from.
+func (s *AuthenticationService) StartOIDC(
+	ctx context.Context,
+	providerName string,
+	hardwareID string,
+) (*dto.OIDCAuthorizationDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "AuthenticationService.StartOIDC")
+	defer span.End()
+
+	return s.startOIDC(ctx, providerName, &entity.OIDCAuthorizationData{
+		Provider:            providerName,
+		HardwareFingerprint: s.rawHardwareFingerprint(hardwareID),
+	})
+}
+
+// FinishOIDC exchanges the authorization code and signs in the user the identity is linked to.
+// If the identity is not linked yet, registration token is returned to pick username for a new account.
+func (s *AuthenticationService) FinishOIDC(
+	ctx context.Context,
+	providerName string,
+	callback *dto.OIDCCallbackDTO,
+	client *dto.ClientInfoDTO,
+) (*domainservice.OIDCLoginResult, error) {
+	ctx, span := tracer.StartSpan(ctx, "AuthenticationService.FinishOIDC")
+	defer span.End()
+
+	authorization, err := s.oidcStateRepo.ConsumeAuthorization(ctx, callback.State)
+	if err != nil {
+		return nil, err
+	}
+
+	hardwareFingerprint := s.rawHardwareFingerprint(callback.HardwareID)
+
+	// State started from another device may be injected to sign in the victim to attacker's account
+	if authorization.Provider != providerName ||
+		authorization.LinkUserID != 0 ||
+		authorization.HardwareFingerprint != hardwareFingerprint {
+		return nil, apperrors.ErrInvalidOIDCState
+	}
+
+	identity, err := s.exchangeOIDCCode(ctx, authorization, callback.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	attempt := &dto.LoginAttemptDTO{
+		IP:                  client.IP,
+		UserAgent:           client.UserAgent,
+		HardwareFingerprint: hardwareFingerprint,
+	}
+
+	tx, err := s.authRepo.WithTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *dto.UserDTO
+
+	user, err := persistence.WithTxResultTx(
+		ctx, tx, func(tx *ent.Tx) (*dto.UserFullDTO, error) {
+			userID, err := s.externalIdentityRepo.TxFindUserIDByProviderAndSubject(
+				ctx,
+				tx,
+				providerName,
+				identity.Subject,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			user, err := s.userRepo.TxFindFullDTOById(ctx, tx, userID)
+			if err != nil {
+				return nil, err
+			}
+
+			found = user.UserDTO
+			attempt.Username = user.Username
+
+			if err := s.loginDefenseService.CheckAllowed(ctx, attempt); err != nil {
+				return nil, err
+			}
+
+			// Login lock is not checked, it protects against password guessing and the provider
+			// has already authenticated the user
+			if err := s.verifyAndUpdateHardwareID(ctx, tx, user, callback.HardwareID); err != nil {
+				return nil, err
+			}
+
+			if s.isAccountLocked(user.UserDTO) {
+				return nil, apperrors.ErrAccountIsLocked(user.AccountBlockReason)
+			}
+
+			err = s.twoFactorService.Challenge(ctx, user.UserDTO, callback.TwoFactorCode)
+			if err != nil {
+				return nil, err
+			}
+
+			err = s.externalIdentityRepo.TxTouch(ctx, tx, providerName, identity.Subject, identity.Email)
+			if err != nil {
+				return nil, err
+			}
+
+			return user, nil
+		},
+	)
+	if apperrors.IsNotFound(err) && found == nil {
+		return s.startOIDCRegistration(ctx, providerName, identity, hardwareFingerprint)
+	}
+
+	if err != nil {
+		s.handleLoginFailure(ctx, attempt, found, err)
+
+		return nil, err
+	}
+
+	s.loginDefenseService.HandleSuccess(ctx, attempt, user.UserDTO)
+	s.eventService.HandleLogin(ctx, user.UserDTO)
+
+	result, err := s.createAuthResult(ctx, user, client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domainservice.OIDCLoginResult{Authentication: result}, nil
+}
+
+// RegisterOIDC creates account with the picked username and links the identity to it.
+// Account has random password, so it can be signed in only with the provider until the password is reset.
+func (s *AuthenticationService) RegisterOIDC(
+	ctx context.Context,
+	registrationToken string,
+	username string,
+	hardwareID string,
+	client *dto.ClientInfoDTO,
+) (*domainservice.AuthenticationResult, error) {
+	ctx, span := tracer.StartSpan(ctx, "AuthenticationService.RegisterOIDC")
+	defer span.End()
+
+	err := userentity.ValidateUsername(username)
+	if err != nil {
+		return nil, apperrors.WrapBadRequest(err)
+	}
+
+	tokenHash := s.credentialsHelper.HashToken(registrationToken)
+
+	registration, err := s.oidcStateRepo.FindRegistration(ctx, tokenHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if registration.HardwareFingerprint != s.rawHardwareFingerprint(hardwareID) {
+		return nil, apperrors.ErrInvalidRegistrationToken
+	}
+
+	credentials := dto.NewCredentialsDTO(username, entity.NewOIDCSecret(), hardwareID)
+	attempt := s.newLoginAttempt(credentials, client)
+
+	s.encryptCredentials(ctx, credentials)
+
+	tx, err := s.authRepo.WithTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := persistence.WithTxResultTx(
+		ctx, tx, func(tx *ent.Tx) (*dto.UserDTO, error) {
+			if err := s.checkHardwareIDBanned(ctx, tx, credentials.HardwareID); err != nil {
+				return nil, err
+			}
+
+			user, err := s.userRepo.TxCreate(ctx, tx, credentials)
+			if err != nil {
+				return nil, err
+			}
+
+			_, err = s.externalIdentityRepo.TxCreate(ctx, tx, &dto.CreateExternalIdentityDTO{
+				UserID:   user.ID,
+				Provider: registration.Provider,
+				Subject:  registration.Subject,
+				Email:    registration.Email,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return user, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.oidcStateRepo.DeleteRegistration(ctx, tokenHash)
+	if err != nil {
+		logger.Log.Warnw("failed to delete oidc registration", "error", err, "userID", user.ID)
+	}
+
+	s.eventService.HandleRegistration(ctx, user)
+	s.loginDefenseService.HandleSuccess(ctx, attempt, user)
+
+	return s.createAuthResult(ctx, &dto.UserFullDTO{UserDTO: user}, client)
+}
+
+// StartOIDCLink starts authorization to link identity of the provider to the user account.
+func (s *AuthenticationService) StartOIDCLink(
+	ctx context.Context,
+	user *dto.UserDTO,
+	providerName string,
+) (*dto.OIDCAuthorizationDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "AuthenticationService.StartOIDCLink")
+	defer span.End()
+
+	return s.startOIDC(ctx, providerName, &entity.OIDCAuthorizationData{
+		Provider:   providerName,
+		LinkUserID: user.ID,
+	})
+}
+
+// FinishOIDCLink exchanges the authorization code and links the identity to the user account.
+func (s *AuthenticationService) FinishOIDCLink(
+	ctx context.Context,
+	user *dto.UserDTO,
+	providerName string,
+	code, state string,
+) (*dto.ExternalIdentityDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "AuthenticationService.FinishOIDCLink")
+	defer span.End()
+
+	authorization, err := s.oidcStateRepo.ConsumeAuthorization(ctx, state)
+	if err != nil {
+		return nil, err
+	}
+
+	if authorization.Provider != providerName || authorization.LinkUserID != user.ID {
+		return nil, apperrors.ErrInvalidOIDCState
+	}
+
+	identity, err := s.exchangeOIDCCode(ctx, authorization, code)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.externalIdentityRepo.Create(ctx, &dto.CreateExternalIdentityDTO{
+		UserID:   user.ID,
+		Provider: providerName,
+		Subject:  identity.Subject,
+		Email:    identity.Email,
+	})
+}
+
+func (s *AuthenticationService) FindExternalIdentities(
+	ctx context.Context,
+	userID int,
+) ([]*dto.ExternalIdentityDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "AuthenticationService.FindExternalIdentities")
+	defer span.End()
+
+	return s.externalIdentityRepo.FindByUserID(ctx, userID)
+}
+
+// UnlinkExternalIdentity requires the password, so account registered with the provider
+// can't be left without a way to sign in.
+func (s *AuthenticationService) UnlinkExternalIdentity(
+	ctx context.Context,
+	user *dto.UserDTO,
+	identityID int,
+	password string,
+	twoFactorCode string,
+) error {
+	ctx, span := tracer.StartSpan(ctx, "AuthenticationService.UnlinkExternalIdentity")
+	defer span.End()
+
+	if !s.verifyPassword(ctx, user.Password, password) {
+		return apperrors.ErrWrongPassword
+	}
+
+	err := s.twoFactorService.ChallengeByUserID(ctx, user.ID, twoFactorCode)
+	if err != nil {
+		return err
+	}
+
+	return s.externalIdentityRepo.DeleteByIDAndUserID(ctx, identityID, user.ID)
+}
+
+func (s *AuthenticationService) startOIDC(
+	ctx context.Context,
+	providerName string,
+	authorization *entity.OIDCAuthorizationData,
+) (*dto.OIDCAuthorizationDTO, error) {
+	provider, err := s.findOIDCProvider(providerName)
+	if err != nil {
+		return nil, err
+	}
+
+	state := entity.NewOIDCSecret()
+	authorization.Nonce = entity.NewOIDCSecret()
+	authorization.CodeVerifier = entity.NewOIDCSecret()
+
+	err = s.oidcStateRepo.SaveAuthorization(ctx, state, authorization, entity.OIDCAuthorizationTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.OIDCAuthorizationDTO{
+		AuthorizationURL: provider.AuthorizationURL(
+			state,
+			authorization.Nonce,
+			entity.OIDCCodeChallenge(authorization.CodeVerifier),
+		),
+		State: state,
+	}, nil
+}
+
+func (s *AuthenticationService) startOIDCRegistration(
+	ctx context.Context,
+	providerName string,
+	identity *drivenports.OIDCIdentity,
+	hardwareFingerprint string,
+) (*domainservice.OIDCLoginResult, error) {
+	registrationToken := entity.NewOIDCSecret()
+
+	err := s.oidcStateRepo.SaveRegistration(
+		ctx,
+		s.credentialsHelper.HashToken(registrationToken),
+		&entity.OIDCRegistrationData{
+			Provider:            providerName,
+			Subject:             identity.Subject,
+			Email:               identity.Email,
+			HardwareFingerprint: hardwareFingerprint,
+		},
+		entity.OIDCRegistrationTTL,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domainservice.OIDCLoginResult{
+		Registration: &dto.OIDCRegistrationDTO{
+			RegistrationToken: registrationToken,
+			SuggestedUsername: entity.SuggestUsername(identity.PreferredUsername),
+		},
+	}, nil
+}
+
+// exchangeOIDCCode exchanges the code and checks the id token was issued for this authorization.
+func (s *AuthenticationService) exchangeOIDCCode(
+	ctx context.Context,
+	authorization *entity.OIDCAuthorizationData,
+	code string,
+) (*drivenports.OIDCIdentity, error) {
+	provider, err := s.findOIDCProvider(authorization.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	identity, err := provider.Exchange(ctx, code, authorization.CodeVerifier)
+	if err != nil {
+		logger.Log.Debugw("oidc code exchange failed", "error", err, "provider", authorization.Provider)
+
+		return nil, apperrors.ErrOIDCAuthorizationFailed
+	}
+
+	if identity.Subject == "" || identity.Nonce != authorization.Nonce {
+		return nil, apperrors.ErrOIDCAuthorizationFailed
+	}
+
+	return identity, nil
+}
+
+func (s *AuthenticationService) findOIDCProvider(name string) (drivenports.OIDCProvider, error) {
+	provider, ok := s.oidcProviders[name]
+	if !ok {
+		return nil, apperrors.ErrUnknownIdentityProvider
+	}
+
+	return provider, nil
+}
@@ -2,12 +2,16 @@ package applicationservice
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/grpc/clients"
 	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
 	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/userentity"
+	drivenports "github.com/intezya/abyssleague/services/abysscore/internal/domain/ports/driven"
 	repositoryports "github.com/intezya/abyssleague/services/abysscore/internal/domain/repository"
 	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
@@ -25,7 +29,14 @@ type AuthenticationService struct {
 	credentialsHelper    domainservice.CredentialsHelper
 	tokenHelper          domainservice.TokenHelper
 	bannedHardwareIDRepo repositoryports.BannedHardwareIDRepository
+	refreshTokenRepo     repositoryports.RefreshTokenRepository
+	sessionRepo          repositoryports.SessionRepository
 	eventService         domainservice.AuthenticationEventService
+	twoFactorService     domainservice.TwoFactorService
+	loginDefenseService  domainservice.LoginDefenseService
+	externalIdentityRepo repositoryports.ExternalIdentityRepository
+	oidcStateRepo        repositoryports.OIDCStateRepository
+	oidcProviders        map[string]drivenports.OIDCProvider
 }
 
 // NewAuthenticationService creates a new authentication service with dependency injection.
@@ -36,7 +47,14 @@ func NewAuthenticationService(
 	credentialsHelper domainservice.CredentialsHelper,
 	tokenHelper domainservice.TokenHelper,
 	bannedHardwareIDRepo repositoryports.BannedHardwareIDRepository,
+	refreshTokenRepo repositoryports.RefreshTokenRepository,
+	sessionRepo repositoryports.SessionRepository,
 	eventService domainservice.AuthenticationEventService,
+	twoFactorService domainservice.TwoFactorService,
+	loginDefenseService domainservice.LoginDefenseService,
+	externalIdentityRepo repositoryports.ExternalIdentityRepository,
+	oidcStateRepo repositoryports.OIDCStateRepository,
+	oidcProviders map[string]drivenports.OIDCProvider,
 ) *AuthenticationService {
 	return &AuthenticationService{
 		authRepo:             authRepo,
@@ -45,7 +63,14 @@ func NewAuthenticationService(
 		credentialsHelper:    credentialsHelper,
 		tokenHelper:          tokenHelper,
 		bannedHardwareIDRepo: bannedHardwareIDRepo,
+		refreshTokenRepo:     refreshTokenRepo,
+		sessionRepo:          sessionRepo,
 		eventService:         eventService,
+		twoFactorService:     twoFactorService,
+		loginDefenseService:  loginDefenseService,
+		externalIdentityRepo: externalIdentityRepo,
+		oidcStateRepo:        oidcStateRepo,
+		oidcProviders:        oidcProviders,
 	}
 }
 
@@ -53,15 +78,28 @@ func NewAuthenticationService(
 func (s *AuthenticationService) Register(
 	ctx context.Context,
 	credentials *dto.CredentialsDTO,
+	client *dto.ClientInfoDTO,
 ) (*domainservice.AuthenticationResult, error) {
 	ctx, span := tracer.StartSpan(ctx, "AuthenticationService.Register")
 	defer span.End()
 
+	err := userentity.ValidateUsername(credentials.Username)
+	if err != nil {
+		return nil, apperrors.WrapBadRequest(err)
+	}
+
+	err = userentity.ValidatePassword(credentials.Password, credentials.Username)
+	if err != nil {
+		return nil, apperrors.WrapBadRequest(err)
+	}
+
 	tx, err := s.authRepo.WithTx(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	attempt := s.newLoginAttempt(credentials, client)
+
 	s.encryptCredentials(ctx, credentials)
 
 	user, err := persistence.WithTxResultTx(
@@ -83,23 +121,34 @@ func (s *AuthenticationService) Register(
 	}
 
 	s.eventService.HandleRegistration(ctx, user)
+	s.loginDefenseService.HandleSuccess(ctx, attempt, user)
 
-	return s.createAuthResult(ctx, &dto.UserFullDTO{UserDTO: user}), nil
+	return s.createAuthResult(ctx, &dto.UserFullDTO{UserDTO: user}, client)
 }
 
 // Authenticate validates user credentials and returns authentication result.
 func (s *AuthenticationService) Authenticate(
 	ctx context.Context,
 	credentials *dto.CredentialsDTO,
+	client *dto.ClientInfoDTO,
 ) (*domainservice.AuthenticationResult, error) {
 	ctx, span := tracer.StartSpan(ctx, "AuthenticationService.Authenticate")
 	defer span.End()
 
+	attempt := s.newLoginAttempt(credentials, client)
+
+	err := s.loginDefenseService.CheckAllowed(ctx, attempt)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := s.authRepo.WithTx(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	var found *dto.UserDTO
+
 	user, err := persistence.WithTxResultTx(
 		ctx, tx, func(tx *ent.Tx) (*dto.UserFullDTO, error) {
 			user, err := s.findUserFullDTOByUsername(ctx, tx, credentials.Username)
@@ -107,6 +156,12 @@ func (s *AuthenticationService) Authenticate(
 				return nil, err
 			}
 
+			found = user.UserDTO
+
+			if s.isLoginLocked(user.UserDTO) {
+				return nil, apperrors.ErrLoginLocked(*user.LoginLockedUntil)
+			}
+
 			if err := s.verifyAndUpdateHardwareID(ctx, tx, user, credentials.HardwareID); err != nil {
 				return nil, err
 			}
@@ -119,36 +174,54 @@ func (s *AuthenticationService) Authenticate(
 				return nil, apperrors.ErrAccountIsLocked(user.AccountBlockReason)
 			}
 
+			// Step-up after password check, so the code is asked only from who knows the password
+			err = s.twoFactorService.Challenge(ctx, user.UserDTO, credentials.TwoFactorCode)
+			if err != nil {
+				return nil, err
+			}
+
 			return user, nil
 		},
 	)
 	if err != nil {
+		s.handleLoginFailure(ctx, attempt, found, err)
+
 		return nil, err
 	}
 
+	s.loginDefenseService.HandleSuccess(ctx, attempt, user.UserDTO)
 	s.eventService.HandleLogin(ctx, user.UserDTO)
 
-	return s.createAuthResult(ctx, user), nil
+	return s.createAuthResult(ctx, user, client)
 }
 
-// ValidateToken validates the authentication token and returns user data.
+// ValidateToken validates the authentication token and returns user data with session id.
 func (s *AuthenticationService) ValidateToken(
 	ctx context.Context,
 	token string,
-) (*dto.UserDTO, error) {
+) (*dto.UserDTO, string, error) {
 	ctx, span := tracer.StartSpan(ctx, "AuthenticationService.ValidateToken")
 	defer span.End()
 
 	tokenData, err := s.tokenHelper.ValidateToken(token)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	logger.Log.Debugw("authentication data received from token", "data", tokenData)
 
+	revoked, err := s.isTokenRevoked(ctx, tokenData)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if revoked {
+		return nil, "", apperrors.ErrTokenRevoked
+	}
+
 	tx, err := s.authRepo.WithTx(ctx)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	user, err := persistence.WithTxResultTx(
@@ -174,60 +247,135 @@ func (s *AuthenticationService) ValidateToken(
 		},
 	)
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+
+	err = s.sessionRepo.Touch(ctx, tokenData.FamilyID, time.Now())
+	if err != nil {
+		logger.Log.Debugw("failed to update session activity", "error", err, "sessionID", tokenData.FamilyID)
+	}
+
+	return user, tokenData.FamilyID, nil
+}
+
+// IsTokenRevoked checks the access token against the refresh token family revocation list.
+func (s *AuthenticationService) IsTokenRevoked(ctx context.Context, token string) (bool, error) {
+	ctx, span := tracer.StartSpan(ctx, "AuthenticationService.IsTokenRevoked")
+	defer span.End()
+
+	tokenData, err := s.tokenHelper.ValidateToken(token)
+	if err != nil {
+		return false, err
 	}
 
-	return user, nil
+	return s.isTokenRevoked(ctx, tokenData)
+}
+
+func (s *AuthenticationService) PublicKeys(ctx context.Context) *dto.JSONWebKeySetDTO {
+	_, span := tracer.StartSpan(ctx, "AuthenticationService.PublicKeys")
+	defer span.End()
+
+	return s.tokenHelper.PublicKeys()
 }
 
-// ChangePassword updates user password.
-func (s *AuthenticationService) ChangePassword(
+// Refresh rotates the refresh token. Presenting an already rotated token revokes its whole family.
+func (s *AuthenticationService) Refresh(
 	ctx context.Context,
-	credentials *dto.ChangePasswordDTO,
+	refreshToken string,
+	client *dto.ClientInfoDTO,
 ) (*domainservice.AuthenticationResult, error) {
-	ctx, span := tracer.StartSpan(ctx, "AuthenticationService.ChangePassword")
+	ctx, span := tracer.StartSpan(ctx, "AuthenticationService.Refresh")
 	defer span.End()
 
+	tokenHash := s.credentialsHelper.HashToken(refreshToken)
+
+	stored, err := s.refreshTokenRepo.FindByHash(ctx, tokenHash)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := s.authRepo.WithTx(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	userAuth, err := persistence.WithTxResultTx(
-		ctx,
-		tx,
-		func(tx *ent.Tx) (*dto.UserFullDTO, error) {
-			// Find user auth by username
-			userAuth, err := s.findUserFullDTOByUsername(ctx, tx, credentials.Username)
+	user, err := persistence.WithTxResultTx(
+		ctx, tx, func(tx *ent.Tx) (*dto.UserDTO, error) {
+			user, err := s.userRepo.TxFindDTOById(ctx, tx, stored.UserID)
 			if err != nil {
 				return nil, err
 			}
 
-			// Verify old password
-			if !s.verifyPassword(ctx, userAuth.Password, credentials.OldPassword) {
-				return nil, apperrors.ErrWrongPassword
-			}
-
-			// Encode new password
-			encodedPassword := s.encodePassword(ctx, credentials.NewPassword)
-
-			// Update password
-			err = s.authRepo.TxUpdatePasswordByID(ctx, tx, userAuth.ID, encodedPassword)
+			err = s.verifyTokenHardwareID(ctx, tx, user.HardwareID, stored.HardwareID)
 			if err != nil {
 				return nil, err
 			}
 
-			// Update user auth object with new password
-			userAuth.Password = encodedPassword
+			if s.isAccountLocked(user) {
+				return nil, apperrors.ErrAccountIsLocked(user.AccountBlockReason)
+			}
 
-			return userAuth, nil
+			return user, nil
 		},
 	)
 	if err != nil {
+		if !apperrors.IsUnexpected(err) { // user is deleted, locked or has another hardware id
+			s.revokeFamily(ctx, stored.FamilyID)
+		}
+
 		return nil, err
 	}
 
-	return s.createAuthResult(ctx, userAuth), nil
+	newRefreshToken := s.tokenHelper.RefreshTokenGenerator()
+
+	err = s.refreshTokenRepo.Rotate(
+		ctx,
+		tokenHash,
+		s.credentialsHelper.HashToken(newRefreshToken),
+		s.newRefreshTokenData(user.ID, stored.FamilyID, *user.HardwareID),
+	)
+	if errors.Is(err, apperrors.ErrRefreshTokenReused) {
+		logger.Log.Warnw("refresh token reuse detected", "userID", user.ID, "familyID", stored.FamilyID)
+
+		s.revokeFamily(ctx, stored.FamilyID)
+
+		return nil, err
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.refreshSession(ctx, stored, client)
+
+	token := s.generateToken(ctx, &entity.TokenData{
+		ID:         user.ID,
+		Username:   user.Username,
+		HardwareID: *user.HardwareID,
+		FamilyID:   stored.FamilyID,
+	})
+
+	online := s.getOnlineCount(ctx)
+
+	return domainservice.NewAuthenticationResult(
+		token,
+		newRefreshToken,
+		&dto.UserFullDTO{UserDTO: user},
+		online,
+	), nil
+}
+
+// RevokeRefreshToken revokes the refresh token family with all access tokens issued by it.
+func (s *AuthenticationService) RevokeRefreshToken(ctx context.Context, refreshToken string) error {
+	ctx, span := tracer.StartSpan(ctx, "AuthenticationService.RevokeRefreshToken")
+	defer span.End()
+
+	stored, err := s.refreshTokenRepo.FindByHash(ctx, s.credentialsHelper.HashToken(refreshToken))
+	if err != nil {
+		return err
+	}
+
+	return s.refreshTokenRepo.RevokeFamily(ctx, stored.FamilyID, s.tokenHelper.AccessTokenLifetime())
 }
 
 // Helper methods
@@ -269,6 +417,7 @@ func (s *AuthenticationService) encryptCredentials(
 	defer span.End()
 
 	credentials.Password = s.encodePassword(ctx, credentials.Password)
+	credentials.HardwareFingerprint = s.rawHardwareFingerprint(credentials.HardwareID)
 	credentials.HardwareID = s.encodeHardwareID(ctx, credentials.HardwareID)
 }
 
@@ -284,7 +433,8 @@ func (s *AuthenticationService) verifyPassword(
 	return s.credentialsHelper.VerifyPassword(rawPassword, hashedPassword)
 }
 
-// verifyTokenHardwareID checks if the token's HWID matches the stored one.
+// verifyTokenHardwareID checks if the token's HWID matches the stored one. Decoded values are compared,
+// ciphertexts differ after re-encryption with another key.
 func (s *AuthenticationService) verifyTokenHardwareID(
 	ctx context.Context,
 	tx *ent.Tx,
@@ -302,7 +452,12 @@ func (s *AuthenticationService) verifyTokenHardwareID(
 		return apperrors.ErrHardwareIDIsInvalid
 	}
 
-	if *storedHardwareID != tokenHardwareID {
+	rawTokenHardwareID, err := s.credentialsHelper.DecodeHardwareID(tokenHardwareID)
+	if err != nil {
+		return apperrors.ErrHardwareIDIsInvalid
+	}
+
+	if !s.credentialsHelper.VerifyHardwareID(rawTokenHardwareID, *storedHardwareID) {
 		return apperrors.ErrTokenHardwareIDIsInvalid
 	}
 
@@ -329,7 +484,13 @@ func (s *AuthenticationService) verifyAndUpdateHardwareID(
 		encodedHardwareID := s.encodeHardwareID(ctx, hardwareID)
 		user.HardwareID = &encodedHardwareID
 
-		return s.authRepo.TxUpdateHardwareIDByID(ctx, tx, user.ID, encodedHardwareID)
+		return s.authRepo.TxUpdateHardwareIDByID(
+			ctx,
+			tx,
+			user.ID,
+			encodedHardwareID,
+			s.rawHardwareFingerprint(hardwareID),
+		)
 	}
 
 	// Verify hardware ID
@@ -359,6 +520,40 @@ func (s *AuthenticationService) checkHardwareIDBanned(
 	return nil
 }
 
+// handleLoginFailure counts only guesses of credentials, two-factor codes are throttled separately.
+func (s *AuthenticationService) handleLoginFailure(
+	ctx context.Context,
+	attempt *dto.LoginAttemptDTO,
+	user *dto.UserDTO,
+	err error,
+) {
+	switch {
+	case apperrors.IsNotFound(err):
+		s.loginDefenseService.HandleFailure(ctx, attempt, nil, true)
+	case errors.Is(err, apperrors.ErrUserWrongHardwareID):
+		s.loginDefenseService.HandleFailure(ctx, attempt, user, false)
+	case errors.Is(err, apperrors.ErrWrongPassword):
+		s.loginDefenseService.HandleFailure(ctx, attempt, user, true)
+	}
+}
+
+func (s *AuthenticationService) newLoginAttempt(
+	credentials *dto.CredentialsDTO,
+	client *dto.ClientInfoDTO,
+) *dto.LoginAttemptDTO {
+	return &dto.LoginAttemptDTO{
+		Username:            credentials.Username,
+		IP:                  client.IP,
+		UserAgent:           client.UserAgent,
+		HardwareFingerprint: s.rawHardwareFingerprint(credentials.HardwareID),
+	}
+}
+
+// isLoginLocked checks if login is locked after too many failures.
+func (s *AuthenticationService) isLoginLocked(user *dto.UserDTO) bool {
+	return user.LoginLockedUntil != nil && user.LoginLockedUntil.After(time.Now())
+}
+
 // isAccountLocked checks if user account is locked.
 func (s *AuthenticationService) isAccountLocked(user *dto.UserDTO) bool {
 	return user.AccountBlockedUntil != nil && user.AccountBlockedUntil.After(time.Now())
@@ -380,21 +575,44 @@ func (s *AuthenticationService) encodeHardwareID(ctx context.Context, rawHwid st
 	return s.credentialsHelper.EncodeHardwareID(rawHwid)
 }
 
-// createAuthResult creates authentication result with token and online count.
+// createAuthResult creates authentication result with tokens of a new session and online count.
 func (s *AuthenticationService) createAuthResult(
 	ctx context.Context,
 	user *dto.UserFullDTO,
-) *domainservice.AuthenticationResult {
+	client *dto.ClientInfoDTO,
+) (*domainservice.AuthenticationResult, error) {
 	ctx, span := tracer.StartSpan(ctx, "AuthenticationService.createAuthResult")
 	defer span.End()
 
-	var token string
+	var token, refreshToken string
 
 	if user.HardwareID != nil { // HardwareID must be updated if nil
+		familyID := uuid.NewString()
+		refreshToken = s.tokenHelper.RefreshTokenGenerator()
+
+		err := s.refreshTokenRepo.Save(
+			ctx,
+			s.credentialsHelper.HashToken(refreshToken),
+			s.newRefreshTokenData(user.ID, familyID, *user.HardwareID),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.sessionRepo.Save(
+			ctx,
+			s.newSessionData(user.ID, familyID, *user.HardwareID, client),
+			s.tokenHelper.RefreshTokenLifetime(),
+		)
+		if err != nil {
+			return nil, err
+		}
+
 		tokenData := &entity.TokenData{
 			ID:         user.ID,
 			Username:   user.Username,
 			HardwareID: *user.HardwareID,
+			FamilyID:   familyID,
 		}
 
 		token = s.generateToken(ctx, tokenData)
@@ -402,7 +620,103 @@ func (s *AuthenticationService) createAuthResult(
 
 	online := s.getOnlineCount(ctx)
 
-	return domainservice.NewAuthenticationResult(token, user, online)
+	return domainservice.NewAuthenticationResult(token, refreshToken, user, online), nil
+}
+
+func (s *AuthenticationService) newRefreshTokenData(
+	userID int,
+	familyID string,
+	hardwareID string,
+) *entity.RefreshTokenData {
+	now := time.Now()
+
+	return &entity.RefreshTokenData{
+		UserID:     userID,
+		FamilyID:   familyID,
+		HardwareID: hardwareID,
+		IssuedAt:   now,
+		ExpiresAt:  now.Add(s.tokenHelper.RefreshTokenLifetime()),
+	}
+}
+
+func (s *AuthenticationService) newSessionData(
+	userID int,
+	sessionID string,
+	encodedHardwareID string,
+	client *dto.ClientInfoDTO,
+) *entity.SessionData {
+	now := time.Now()
+
+	return &entity.SessionData{
+		ID:                  sessionID,
+		UserID:              userID,
+		HardwareFingerprint: s.hardwareFingerprint(encodedHardwareID),
+		IP:                  client.IP,
+		UserAgent:           client.UserAgent,
+		CreatedAt:           now,
+		LastActivityAt:      now,
+	}
+}
+
+// refreshSession updates session client info and extends it to the new refresh token lifetime.
+func (s *AuthenticationService) refreshSession(
+	ctx context.Context,
+	token *entity.RefreshTokenData,
+	client *dto.ClientInfoDTO,
+) {
+	session, err := s.sessionRepo.FindByID(ctx, token.FamilyID)
+	if err != nil {
+		session = s.newSessionData(token.UserID, token.FamilyID, token.HardwareID, client)
+	}
+
+	session.IP = client.IP
+	session.UserAgent = client.UserAgent
+	session.LastActivityAt = time.Now()
+
+	err = s.sessionRepo.Save(ctx, session, s.tokenHelper.RefreshTokenLifetime())
+	if err != nil {
+		logger.Log.Warnw("failed to refresh session", "error", err, "sessionID", token.FamilyID)
+	}
+}
+
+// hardwareFingerprint is a short hash of raw hardware id, stored hardware id can't be used
+// because its encryption is randomized.
+func (s *AuthenticationService) hardwareFingerprint(encodedHardwareID string) string {
+	rawHardwareID, err := s.credentialsHelper.DecodeHardwareID(encodedHardwareID)
+	if err != nil {
+		return ""
+	}
+
+	return s.rawHardwareFingerprint(rawHardwareID)
+}
+
+func (s *AuthenticationService) rawHardwareFingerprint(rawHardwareID string) string {
+	return s.credentialsHelper.HashToken(rawHardwareID)[:entity.HardwareFingerprintLength]
+}
+
+// isTokenRevoked treats tokens issued without refresh token family as revoked.
+// Tokens issued before user data in them has changed are revoked too.
+func (s *AuthenticationService) isTokenRevoked(
+	ctx context.Context,
+	tokenData *entity.TokenData,
+) (bool, error) {
+	if tokenData.FamilyID == "" {
+		return true, nil
+	}
+
+	revoked, err := s.refreshTokenRepo.IsFamilyRevoked(ctx, tokenData.FamilyID)
+	if err != nil || revoked {
+		return revoked, err
+	}
+
+	return s.refreshTokenRepo.IsUserTokenStale(ctx, tokenData.ID, tokenData.IssuedAt)
+}
+
+func (s *AuthenticationService) revokeFamily(ctx context.Context, familyID string) {
+	err := s.refreshTokenRepo.RevokeFamily(ctx, familyID, s.tokenHelper.AccessTokenLifetime())
+	if err != nil {
+		logger.Log.Warnw("failed to revoke refresh token family", "error", err, "familyID", familyID)
+	}
 }
 
 // generateToken creates an authentication token.
@@ -0,0 +1,145 @@
+package applicationservice
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/dto/request"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	repositoryports "github.com/intezya/abyssleague/services/abysscore/internal/domain/repository"
+	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/websocketmessage"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/pkglib/logger"
+)
+
+type CollectionService struct {
+	collectionRepository repositoryports.CollectionRepository
+	gameItemRepository   repositoryports.GameItemRepository
+	notificationService  domainservice.NotificationService
+	auditLogger          domainservice.AuditLogger
+}
+
+func NewCollectionService(
+	collectionRepository repositoryports.CollectionRepository,
+	gameItemRepository repositoryports.GameItemRepository,
+	notificationService domainservice.NotificationService,
+	auditLogger domainservice.AuditLogger,
+) *CollectionService {
+	return &CollectionService{
+		collectionRepository: collectionRepository,
+		gameItemRepository:   gameItemRepository,
+		notificationService:  notificationService,
+		auditLogger:          auditLogger,
+	}
+}
+
+func (s *CollectionService) FindProgressByUserID(
+	ctx context.Context,
+	userID int,
+) ([]*dto.CollectionProgressDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "CollectionService.FindProgressByUserID")
+	defer span.End()
+
+	return s.collectionRepository.FindProgressByUserID(ctx, userID)
+}
+
+func (s *CollectionService) FindAllRewards(ctx context.Context) ([]*dto.CollectionRewardDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "CollectionService.FindAllRewards")
+	defer span.End()
+
+	return s.collectionRepository.FindAllRewards(ctx)
+}
+
+func (s *CollectionService) SetReward(
+	ctx context.Context,
+	request *request.SetCollectionReward,
+	performer *dto.UserDTO,
+) (*dto.CollectionRewardDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "CollectionService.SetReward")
+	defer span.End()
+
+	if request.BadgeItemID != nil {
+		_, err := s.gameItemRepository.FindByID(ctx, *request.BadgeItemID)
+		if err != nil {
+			return nil, err // badge item not found
+		}
+	}
+
+	rewards, err := s.collectionRepository.FindAllRewards(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reward := request.ToDTO()
+
+	var before *dto.CollectionRewardDTO
+
+	if index := slices.IndexFunc(rewards, func(r *dto.CollectionRewardDTO) bool {
+		return r.Collection == reward.Collection
+	}); index != -1 {
+		before = rewards[index]
+	}
+
+	after, err := s.collectionRepository.UpsertReward(ctx, reward)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditLogger.Record(
+		ctx,
+		performer,
+		dto.AuditActionCollectionRewardSet,
+		dto.NewAuditTargetByName(dto.AuditTargetCollectionReward, after.Collection),
+		before,
+		after,
+	)
+
+	return after, nil
+}
+
+func (s *CollectionService) HandleItemGranted(
+	ctx context.Context,
+	userID int,
+	item *dto.InventoryItemDTO,
+) {
+	ctx, span := tracer.StartSpan(ctx, "CollectionService.HandleItemGranted")
+	defer span.End()
+
+	progress, err := s.collectionRepository.FindProgressByUserIDAndCollection(
+		ctx,
+		userID,
+		item.Collection,
+	)
+	if err != nil {
+		logger.Log.Warnln("failed to check collection progress:", err)
+
+		return
+	}
+
+	if !progress.Completed {
+		return
+	}
+
+	completion, err := s.collectionRepository.CompleteAndReward(ctx, userID, item.Collection)
+	if err != nil {
+		logger.Log.Warnln("failed to reward collection completion:", err)
+
+		return
+	}
+
+	if completion == nil {
+		return // already rewarded
+	}
+
+	eventID := uuid.NewString()
+	tracer.AddAttribute(ctx, "event_id", eventID)
+
+	message := websocketmessage.NewCollectionCompletedMessage(eventID, completion)
+
+	err = s.notificationService.SendToUser(ctx, userID, message)
+	if err != nil {
+		logger.Log.Warnln("failed to send message to user:", err)
+	}
+}
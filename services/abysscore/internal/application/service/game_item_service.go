@@ -7,15 +7,23 @@ import (
 	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
 	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/gameitementity"
 	repositoryports "github.com/intezya/abyssleague/services/abysscore/internal/domain/repository"
+	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
 )
 
 type GameItemService struct {
 	gameItemRepository repositoryports.GameItemRepository
+	auditLogger        domainservice.AuditLogger
 }
 
-func NewGameItemService(repository repositoryports.GameItemRepository) *GameItemService {
-	return &GameItemService{gameItemRepository: repository}
+func NewGameItemService(
+	repository repositoryports.GameItemRepository,
+	auditLogger domainservice.AuditLogger,
+) *GameItemService {
+	return &GameItemService{
+		gameItemRepository: repository,
+		auditLogger:        auditLogger,
+	}
 }
 
 func (g *GameItemService) Create(
@@ -26,13 +34,20 @@ func (g *GameItemService) Create(
 	ctx, span := tracer.StartSpan(ctx, "GameItemService.Create")
 	defer span.End()
 
-	// TODO: log performer action
-
 	result, err := g.gameItemRepository.Create(ctx, request.ToDTO())
 	if err != nil {
 		return nil, err // ???
 	}
 
+	g.auditLogger.Record(
+		ctx,
+		performer,
+		dto.AuditActionGameItemCreate,
+		dto.NewAuditTarget(dto.AuditTargetGameItem, result.ID),
+		nil,
+		result,
+	)
+
 	return result, nil
 }
 
@@ -51,6 +66,7 @@ func (g *GameItemService) FindByID(ctx context.Context, id int) (*dto.GameItemDT
 func (g *GameItemService) FindAllPaged(
 	ctx context.Context,
 	query *request.PaginationQuery[gameitementity.OrderBy],
+	filter *gameitementity.Filter,
 ) (*dto.PaginatedResult[*dto.GameItemDTO], error) {
 	ctx, span := tracer.StartSpan(ctx, "GameItemService.FindAllPaged")
 	defer span.End()
@@ -61,6 +77,7 @@ func (g *GameItemService) FindAllPaged(
 		query.Size,
 		query.OrderBy,
 		query.OrderType,
+		filter,
 	)
 	if err != nil {
 		return nil, err // ???
@@ -78,30 +95,76 @@ func (g *GameItemService) Update(
 	ctx, span := tracer.StartSpan(ctx, "GameItemService.Update")
 	defer span.End()
 
-	// TODO: log performer action
+	return g.changeWithAudit(ctx, id, performer, dto.AuditActionGameItemUpdate, func() error {
+		return g.gameItemRepository.UpdateByID(ctx, id, request.ToDTO())
+	})
+}
+
+func (g *GameItemService) FindArchivedPaged(
+	ctx context.Context,
+	page, size int,
+) (*dto.PaginatedResult[*dto.ArchivedGameItemDTO], error) {
+	ctx, span := tracer.StartSpan(ctx, "GameItemService.FindArchivedPaged")
+	defer span.End()
 
-	err := g.gameItemRepository.UpdateByID(ctx, id, request.ToDTO())
+	result, err := g.gameItemRepository.FindArchivedPaged(ctx, page, size)
 	if err != nil {
-		return err // not found
+		return nil, err // ???
 	}
 
-	return nil
+	return result, nil
+}
+
+func (g *GameItemService) Archive(
+	ctx context.Context,
+	id int,
+	performer *dto.UserDTO,
+) error {
+	ctx, span := tracer.StartSpan(ctx, "GameItemService.Archive")
+	defer span.End()
+
+	return g.changeWithAudit(ctx, id, performer, dto.AuditActionGameItemArchive, func() error {
+		return g.gameItemRepository.ArchiveByID(ctx, id)
+	})
 }
 
-func (g *GameItemService) Delete(
+func (g *GameItemService) Restore(
 	ctx context.Context,
 	id int,
 	performer *dto.UserDTO,
 ) error {
-	ctx, span := tracer.StartSpan(ctx, "GameItemService.Delete")
+	ctx, span := tracer.StartSpan(ctx, "GameItemService.Restore")
 	defer span.End()
 
-	// TODO: log performer action
+	return g.changeWithAudit(ctx, id, performer, dto.AuditActionGameItemRestore, func() error {
+		return g.gameItemRepository.RestoreByID(ctx, id)
+	})
+}
 
-	err := g.gameItemRepository.DeleteByID(ctx, id)
+// changeWithAudit applies change to the item and records item states around it.
+func (g *GameItemService) changeWithAudit(
+	ctx context.Context,
+	id int,
+	performer *dto.UserDTO,
+	action dto.AuditAction,
+	change func() error,
+) error {
+	before, err := g.gameItemRepository.FindByID(ctx, id)
 	if err != nil {
 		return err // not found
 	}
 
+	err = change()
+	if err != nil {
+		return err // not found
+	}
+
+	after, err := g.gameItemRepository.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	g.auditLogger.Record(ctx, performer, action, dto.NewAuditTarget(dto.AuditTargetGameItem, id), before, after)
+
 	return nil
 }
@@ -0,0 +1,308 @@
+package applicationservice
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/grpc/clients"
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/dto/request"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity"
+	repositoryports "github.com/intezya/abyssleague/services/abysscore/internal/domain/repository"
+	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/intezya/abyssleague/services/abysscore/pkg/optional"
+	"github.com/intezya/pkglib/logger"
+)
+
+const (
+	grantJobBatchSize = 100
+
+	allBatchesFailedReason  = "all batches failed"
+	someBatchesFailedReason = "some batches failed"
+	staleReason             = "interrupted, instance running the job stopped"
+)
+
+var (
+	errEmptyUserIDs    = errors.New("user_ids must not be empty for target users")
+	errEmptyUserFilter = errors.New("at least one filter must be set for target filter")
+	errUnknownTarget   = errors.New("unknown grant target")
+)
+
+type GrantJobService struct {
+	grantJobRepository      repositoryports.GrantJobRepository
+	inventoryItemRepository repositoryports.InventoryItemRepository
+	gameItemRepository      repositoryports.GameItemRepository
+	userRepository          repositoryports.UserRepository
+	websocketService        clients.WebsocketMessagingClient
+	eventService            domainservice.InventoryItemEventService
+	collectionService       domainservice.CollectionService
+	auditLogger             domainservice.AuditLogger
+
+	// instanceID owns jobs started by this process
+	instanceID string
+}
+
+func NewGrantJobService(
+	grantJobRepository repositoryports.GrantJobRepository,
+	inventoryItemRepository repositoryports.InventoryItemRepository,
+	gameItemRepository repositoryports.GameItemRepository,
+	userRepository repositoryports.UserRepository,
+	websocketService clients.WebsocketMessagingClient,
+	eventService domainservice.InventoryItemEventService,
+	collectionService domainservice.CollectionService,
+	auditLogger domainservice.AuditLogger,
+) *GrantJobService {
+	return &GrantJobService{
+		grantJobRepository:      grantJobRepository,
+		inventoryItemRepository: inventoryItemRepository,
+		gameItemRepository:      gameItemRepository,
+		userRepository:          userRepository,
+		websocketService:        websocketService,
+		eventService:            eventService,
+		collectionService:       collectionService,
+		auditLogger:             auditLogger,
+		instanceID:              uuid.NewString(),
+	}
+}
+
+func (s *GrantJobService) Start(
+	ctx context.Context,
+	itemID int,
+	request *request.BulkGrantInventoryItem,
+	performer *dto.UserDTO,
+) (*dto.GrantJobDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "GrantJobService.Start")
+	defer span.End()
+
+	gameItem, err := s.gameItemRepository.FindByID(ctx, itemID)
+	if err != nil {
+		return nil, err // not found
+	}
+
+	if gameItem.ArchivedAt != nil {
+		return nil, apperrors.ErrGameItemArchived
+	}
+
+	target := dto.GrantJobTarget(request.Target)
+
+	userIDs, err := s.resolveRecipients(ctx, target, request)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := s.grantJobRepository.Create(ctx, &dto.CreateGrantJobDTO{
+		Campaign:    request.Campaign,
+		ItemID:      itemID,
+		CreatedByID: performer.ID,
+		Target:      target,
+		Total:       len(userIDs),
+		Owner:       s.instanceID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditLogger.Record(
+		ctx,
+		performer,
+		dto.AuditActionGrantJobStart,
+		dto.NewAuditTarget(dto.AuditTargetGrantJob, job.ID),
+		nil,
+		job,
+	)
+
+	// Job must outlive the request, so it is detached from request cancellation.
+	go s.run(context.WithoutCancel(ctx), job, userIDs, performer)
+
+	return job, nil
+}
+
+func (s *GrantJobService) FindByID(ctx context.Context, id int) (*dto.GrantJobDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "GrantJobService.FindByID")
+	defer span.End()
+
+	return s.grantJobRepository.FindByID(ctx, id)
+}
+
+func (s *GrantJobService) RunStaleJobLoop(ctx context.Context) {
+	ticker := time.NewTicker(entity.GrantJobStaleCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		s.failStale(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// failStale fails jobs of stopped instances, recipients are kept only in memory of the owner,
+// so such jobs can't be resumed.
+func (s *GrantJobService) failStale(ctx context.Context) {
+	ctx, span := tracer.StartSpan(ctx, "GrantJobService.failStale")
+	defer span.End()
+
+	failed, err := s.grantJobRepository.FailStale(
+		ctx,
+		s.instanceID,
+		time.Now().Add(-entity.GrantJobStaleAfter),
+		staleReason,
+	)
+	if err != nil {
+		logger.Log.Warnln("failed to fail stale grant jobs:", err)
+
+		return
+	}
+
+	if failed > 0 {
+		logger.Log.Infoln("grant jobs of stopped instances marked as failed:", failed)
+	}
+}
+
+// heartbeat marks the job alive until ctx is done.
+func (s *GrantJobService) heartbeat(ctx context.Context, jobID int) {
+	ticker := time.NewTicker(entity.GrantJobHeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		err := s.grantJobRepository.Heartbeat(ctx, jobID)
+		if err != nil {
+			logger.Log.Warnln("failed to save heartbeat of grant job:", jobID, err)
+		}
+	}
+}
+
+func (s *GrantJobService) resolveRecipients(
+	ctx context.Context,
+	target dto.GrantJobTarget,
+	request *request.BulkGrantInventoryItem,
+) ([]int, error) {
+	switch target {
+	case dto.GrantJobTargetUsers:
+		if len(request.UserIDs) == 0 {
+			return nil, apperrors.WrapBadRequest(errEmptyUserIDs)
+		}
+
+		userIDs := slices.Clone(request.UserIDs)
+		slices.Sort(userIDs)
+
+		return s.userRepository.FindExistingIDs(ctx, slices.Compact(userIDs))
+	case dto.GrantJobTargetFilter:
+		filter := request.ToUserFilterDTO()
+
+		if filter.RegisteredBefore == nil && filter.MinSearchScore == nil {
+			return nil, apperrors.WrapBadRequest(errEmptyUserFilter)
+		}
+
+		return s.userRepository.FindIDsByFilter(ctx, filter)
+	case dto.GrantJobTargetOnline:
+		onlineUsers, err := s.websocketService.GetOnlineUsers(ctx)
+		if err != nil {
+			return nil, apperrors.WrapUnexpectedError(err)
+		}
+
+		userIDs := make([]int, 0, len(onlineUsers))
+		for _, onlineUser := range onlineUsers {
+			userIDs = append(userIDs, onlineUser.ID)
+		}
+
+		slices.Sort(userIDs)
+
+		return slices.Compact(userIDs), nil
+	default:
+		return nil, apperrors.WrapBadRequest(errUnknownTarget)
+	}
+}
+
+func (s *GrantJobService) run(
+	ctx context.Context,
+	job *dto.GrantJobDTO,
+	userIDs []int,
+	performer *dto.UserDTO,
+) {
+	ctx, span := tracer.StartSpan(ctx, "GrantJobService.run")
+	defer span.End()
+
+	tracer.AddAttribute(ctx, "grant_job_id", job.ID)
+
+	heartbeatCtx, stopHeartbeat := context.WithCancel(ctx)
+	defer stopHeartbeat()
+
+	go s.heartbeat(heartbeatCtx, job.ID)
+
+	err := s.grantJobRepository.SetRunning(ctx, job.ID)
+	if err != nil {
+		logger.Log.Warnln("failed to start grant job:", job.ID, err)
+	}
+
+	batches, failedBatches := 0, 0
+
+	for batch := range slices.Chunk(userIDs, grantJobBatchSize) {
+		batches++
+
+		progress := &dto.GrantJobProgressDTO{Processed: len(batch)}
+
+		result, err := s.inventoryItemRepository.CreateForCampaign(
+			ctx,
+			batch,
+			job.ItemID,
+			performer.ID,
+			job.Campaign,
+		)
+		if err != nil {
+			logger.Log.Warnln("failed to grant batch of grant job:", job.ID, err)
+
+			failedBatches++
+			progress.Failed = len(batch)
+		} else {
+			progress.Granted = len(result.Granted)
+			progress.Skipped = result.Skipped
+		}
+
+		err = s.grantJobRepository.AddProgress(ctx, job.ID, progress)
+		if err != nil {
+			logger.Log.Warnln("failed to save progress of grant job:", job.ID, err)
+		}
+
+		if result == nil || len(result.Granted) == 0 {
+			continue
+		}
+
+		s.eventService.HandleItemsObtained(ctx, optional.New(performer), result.Granted)
+
+		for _, item := range result.Granted {
+			s.collectionService.HandleItemGranted(ctx, item.UserID, item)
+		}
+	}
+
+	status := dto.GrantJobStatusCompleted
+
+	reason := optional.EmptyOptional[string]()
+
+	switch {
+	case failedBatches > 0 && failedBatches == batches:
+		status = dto.GrantJobStatusFailed
+		reason = optional.New(allBatchesFailedReason)
+	case failedBatches > 0:
+		reason = optional.New(someBatchesFailedReason)
+	}
+
+	err = s.grantJobRepository.Finish(ctx, job.ID, status, reason)
+	if err != nil {
+		logger.Log.Warnln("failed to finish grant job:", job.ID, err)
+	}
+}
@@ -0,0 +1,177 @@
+package applicationservice
+
+import (
+	"context"
+	"slices"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity"
+	repositoryports "github.com/intezya/abyssleague/services/abysscore/internal/domain/repository"
+	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/intezya/abyssleague/services/abysscore/pkg/optional"
+	"github.com/intezya/pkglib/logger"
+)
+
+type HardwareIDBanService struct {
+	bannedHardwareIDRepository repositoryports.BannedHardwareIDRepository
+	userRepository             repositoryports.UserRepository
+	credentialsHelper          domainservice.CredentialsHelper
+	sessionService             domainservice.SessionService
+	auditLogger                domainservice.AuditLogger
+}
+
+func NewHardwareIDBanService(
+	bannedHardwareIDRepository repositoryports.BannedHardwareIDRepository,
+	userRepository repositoryports.UserRepository,
+	credentialsHelper domainservice.CredentialsHelper,
+	sessionService domainservice.SessionService,
+	auditLogger domainservice.AuditLogger,
+) *HardwareIDBanService {
+	return &HardwareIDBanService{
+		bannedHardwareIDRepository: bannedHardwareIDRepository,
+		userRepository:             userRepository,
+		credentialsHelper:          credentialsHelper,
+		sessionService:             sessionService,
+		auditLogger:                auditLogger,
+	}
+}
+
+func (s *HardwareIDBanService) BanByUser(
+	ctx context.Context,
+	performer *dto.UserDTO,
+	userID int,
+	reason *string,
+) (*dto.BanHardwareIDResultDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "HardwareIDBanService.BanByUser")
+	defer span.End()
+
+	user, err := s.userRepository.FindDTOById(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.HardwareID == nil {
+		return nil, apperrors.ErrHardwareIDNotBound
+	}
+
+	hardwareID, err := s.credentialsHelper.DecodeHardwareID(*user.HardwareID)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return s.Ban(ctx, performer, hardwareID, reason)
+}
+
+func (s *HardwareIDBanService) Ban(
+	ctx context.Context,
+	performer *dto.UserDTO,
+	hardwareID string,
+	reason *string,
+) (*dto.BanHardwareIDResultDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "HardwareIDBanService.Ban")
+	defer span.End()
+
+	ban, err := s.bannedHardwareIDRepository.Create(ctx, hardwareID, optional.FromPtr(reason), performer.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditLogger.Record(
+		ctx,
+		performer,
+		dto.AuditActionHardwareIDBan,
+		dto.NewAuditTarget(dto.AuditTargetBannedHardwareID, ban.ID),
+		nil,
+		ban,
+	)
+
+	// Ban is already created, so the accounts are not able to log in again even if kick fails
+	kickedUserIDs, err := s.kickBoundAccounts(ctx, hardwareID)
+	if err != nil {
+		logger.Log.Warnw("failed to kick accounts of banned hardware id", "error", err, "banID", ban.ID)
+	}
+
+	return &dto.BanHardwareIDResultDTO{
+		Ban:           ban,
+		KickedUserIDs: kickedUserIDs,
+	}, nil
+}
+
+func (s *HardwareIDBanService) FindPaged(
+	ctx context.Context,
+	search string,
+	page, size int,
+) (*dto.PaginatedResult[*dto.BannedHardwareID], error) {
+	ctx, span := tracer.StartSpan(ctx, "HardwareIDBanService.FindPaged")
+	defer span.End()
+
+	return s.bannedHardwareIDRepository.FindPaged(ctx, search, page, size)
+}
+
+func (s *HardwareIDBanService) Unban(
+	ctx context.Context,
+	performer *dto.UserDTO,
+	hardwareID string,
+) error {
+	ctx, span := tracer.StartSpan(ctx, "HardwareIDBanService.Unban")
+	defer span.End()
+
+	ban, err := s.bannedHardwareIDRepository.FindByHardwareID(ctx, hardwareID)
+	if err != nil {
+		return err
+	}
+
+	err = s.bannedHardwareIDRepository.DeleteByHardwareID(ctx, hardwareID)
+	if err != nil {
+		return err
+	}
+
+	s.auditLogger.Record(
+		ctx,
+		performer,
+		dto.AuditActionHardwareIDUnban,
+		dto.NewAuditTarget(dto.AuditTargetBannedHardwareID, ban.ID),
+		ban,
+		nil,
+	)
+
+	return nil
+}
+
+// kickBoundAccounts ends sessions of all accounts bound to the hardware id. Accounts are found by
+// fingerprint, hardware ids stored before fingerprints were are found once re-encryption fills them.
+func (s *HardwareIDBanService) kickBoundAccounts(ctx context.Context, hardwareID string) ([]int, error) {
+	ctx, span := tracer.StartSpan(ctx, "HardwareIDBanService.kickBoundAccounts")
+	defer span.End()
+
+	encodedHardwareIDs, err := s.userRepository.FindEncodedHardwareIDsByFingerprint(
+		ctx,
+		s.credentialsHelper.HashToken(hardwareID)[:entity.HardwareFingerprintLength],
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	kickedUserIDs := make([]int, 0)
+
+	for userID, encoded := range encodedHardwareIDs {
+		if !s.credentialsHelper.VerifyHardwareID(hardwareID, encoded) {
+			continue
+		}
+
+		err = s.sessionService.EndAll(ctx, userID)
+		if err != nil {
+			logger.Log.Warnw("failed to end sessions of banned account", "error", err, "userID", userID)
+
+			continue
+		}
+
+		kickedUserIDs = append(kickedUserIDs, userID)
+	}
+
+	slices.Sort(kickedUserIDs)
+
+	return kickedUserIDs, nil
+}
@@ -0,0 +1,199 @@
+package applicationservice
+
+import (
+	"context"
+	"strings"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/mailmessage"
+	drivenports "github.com/intezya/abyssleague/services/abysscore/internal/domain/ports/driven"
+	repositoryports "github.com/intezya/abyssleague/services/abysscore/internal/domain/repository"
+	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/intezya/pkglib/logger"
+)
+
+type HardwareIDResetService struct {
+	hardwareIDResetRepository repositoryports.HardwareIDResetRepository
+	userRepository            repositoryports.UserRepository
+	mailSender                drivenports.MailSender
+	credentialsHelper         domainservice.CredentialsHelper
+	sessionService            domainservice.SessionService
+	twoFactorService          domainservice.TwoFactorService
+	auditLogger               domainservice.AuditLogger
+}
+
+func NewHardwareIDResetService(
+	hardwareIDResetRepository repositoryports.HardwareIDResetRepository,
+	userRepository repositoryports.UserRepository,
+	mailSender drivenports.MailSender,
+	credentialsHelper domainservice.CredentialsHelper,
+	sessionService domainservice.SessionService,
+	twoFactorService domainservice.TwoFactorService,
+	auditLogger domainservice.AuditLogger,
+) *HardwareIDResetService {
+	return &HardwareIDResetService{
+		hardwareIDResetRepository: hardwareIDResetRepository,
+		userRepository:            userRepository,
+		mailSender:                mailSender,
+		credentialsHelper:         credentialsHelper,
+		sessionService:            sessionService,
+		twoFactorService:          twoFactorService,
+		auditLogger:               auditLogger,
+	}
+}
+
+func (s *HardwareIDResetService) ResetByAdmin(
+	ctx context.Context,
+	performer *dto.UserDTO,
+	userID int,
+	reason *string,
+) (*dto.HardwareIDResetDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "HardwareIDResetService.ResetByAdmin")
+	defer span.End()
+
+	result, err := s.hardwareIDResetRepository.Reset(ctx, userID, performer.ID, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditLogger.Record(
+		ctx,
+		performer,
+		dto.AuditActionHardwareIDReset,
+		dto.NewAuditTarget(dto.AuditTargetUser, userID),
+		nil,
+		result.HardwareIDResetDTO,
+	)
+
+	s.afterReset(ctx, result.User, reason)
+
+	return result.HardwareIDResetDTO, nil
+}
+
+func (s *HardwareIDResetService) RequestReset(
+	ctx context.Context,
+	request *dto.HardwareIDResetRequestDTO,
+) (*dto.HardwareIDResetDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "HardwareIDResetService.RequestReset")
+	defer span.End()
+
+	user, err := s.userRepository.FindDTOByLowerUsername(ctx, strings.ToLower(request.Username))
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.credentialsHelper.VerifyPassword(request.Password, user.Password) {
+		return nil, apperrors.ErrWrongPassword
+	}
+
+	err = s.twoFactorService.Challenge(ctx, user, request.TwoFactorCode)
+	if err != nil {
+		return nil, err
+	}
+
+	// Not bound hardware id is bound on the next login, there is nothing to reset
+	if user.HardwareID == nil {
+		return nil, apperrors.ErrHardwareIDNotBound
+	}
+
+	return s.hardwareIDResetRepository.CreateRequest(ctx, user.ID, request.Reason)
+}
+
+func (s *HardwareIDResetService) FindRequestsPaged(
+	ctx context.Context,
+	status *dto.HardwareIDResetStatus,
+	page, size int,
+) (*dto.PaginatedResult[*dto.HardwareIDResetDTO], error) {
+	ctx, span := tracer.StartSpan(ctx, "HardwareIDResetService.FindRequestsPaged")
+	defer span.End()
+
+	return s.hardwareIDResetRepository.FindPaged(ctx, status, page, size)
+}
+
+func (s *HardwareIDResetService) Approve(
+	ctx context.Context,
+	performer *dto.UserDTO,
+	requestID int,
+	comment *string,
+) (*dto.HardwareIDResetDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "HardwareIDResetService.Approve")
+	defer span.End()
+
+	result, err := s.hardwareIDResetRepository.Approve(ctx, requestID, performer.ID, comment)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditLogger.Record(
+		ctx,
+		performer,
+		dto.AuditActionHardwareIDResetApprove,
+		dto.NewAuditTarget(dto.AuditTargetHardwareIDReset, requestID),
+		pendingStateOf(result.HardwareIDResetDTO),
+		result.HardwareIDResetDTO,
+	)
+
+	s.afterReset(ctx, result.User, comment)
+
+	return result.HardwareIDResetDTO, nil
+}
+
+func (s *HardwareIDResetService) Reject(
+	ctx context.Context,
+	performer *dto.UserDTO,
+	requestID int,
+	comment *string,
+) (*dto.HardwareIDResetDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "HardwareIDResetService.Reject")
+	defer span.End()
+
+	result, err := s.hardwareIDResetRepository.Reject(ctx, requestID, performer.ID, comment)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditLogger.Record(
+		ctx,
+		performer,
+		dto.AuditActionHardwareIDResetReject,
+		dto.NewAuditTarget(dto.AuditTargetHardwareIDReset, requestID),
+		pendingStateOf(result),
+		result,
+	)
+
+	return result, nil
+}
+
+// pendingStateOf returns request state before resolution, only pending requests can be resolved.
+func pendingStateOf(resolved *dto.HardwareIDResetDTO) *dto.HardwareIDResetDTO {
+	pending := *resolved
+	pending.Status = dto.HardwareIDResetPending
+	pending.OperatorID = nil
+	pending.OperatorComment = nil
+	pending.ResolvedAt = nil
+
+	return &pending
+}
+
+// afterReset ends user sessions and notifies user by email.
+// Hardware id is already reset, so failures are only logged.
+func (s *HardwareIDResetService) afterReset(ctx context.Context, user *dto.UserDTO, reason *string) {
+	ctx, span := tracer.StartSpan(ctx, "HardwareIDResetService.afterReset")
+	defer span.End()
+
+	err := s.sessionService.EndAll(ctx, user.ID)
+	if err != nil {
+		logger.Log.Warnw("failed to end sessions after hardware id reset", "error", err, "userID", user.ID)
+	}
+
+	if user.Email == nil {
+		return
+	}
+
+	err = s.mailSender.Send(ctx, mailmessage.NewHardwareIDResetMessage(user.Username, reason), *user.Email)
+	if err != nil {
+		logger.Log.Warnw("failed to send hardware id reset mail", "error", err, "userID", user.ID)
+	}
+}
@@ -59,6 +59,49 @@ func (s *InventoryItemEventService) HandleItemObtained(
 	}
 }
 
+func (s *InventoryItemEventService) HandleItemsObtained(
+	ctx context.Context,
+	performer optional.Optional[*dto.UserDTO],
+	items []*dto.InventoryItemDTO,
+) {
+	ctx, span := tracer.StartSpan(ctx, "InventoryItemEventService.HandleItemsObtained")
+	defer span.End()
+
+	const maxConcurrentMessages = 16
+
+	eventID := uuid.NewString()
+	tracer.AddAttribute(ctx, "event_id", eventID)
+
+	performerName := websocketmessage.SystemIsSenderName
+
+	if performer.IsSet() {
+		performerName = performer.MustValue().Username
+	}
+
+	group, _ := errgroup.WithContext(ctx)
+	group.SetLimit(maxConcurrentMessages)
+
+	for _, item := range items {
+		group.Go(
+			func() error {
+				message := websocketmessage.NewInventoryItemObtainedMessage(
+					eventID,
+					performerName,
+					item,
+				)
+
+				return s.notificationService.SendToUser(ctx, item.UserID, message)
+			},
+		)
+	}
+
+	err := group.Wait()
+
+	if err != nil {
+		logger.Log.Warnln("failed to send message to users:", err)
+	}
+}
+
 func (s *InventoryItemEventService) HandleItemRevoked(
 	ctx context.Context,
 	receiverID int,
@@ -97,3 +140,22 @@ func (s *InventoryItemEventService) HandleItemRevoked(
 		logger.Log.Warnln("failed to send message to user:", err)
 	}
 }
+
+func (s *InventoryItemEventService) HandleItemsDismantled(
+	ctx context.Context,
+	receiverID int,
+	result *dto.DismantleResultDTO,
+) {
+	ctx, span := tracer.StartSpan(ctx, "InventoryItemEventService.HandleItemsDismantled")
+	defer span.End()
+
+	eventID := uuid.NewString()
+	tracer.AddAttribute(ctx, "event_id", eventID)
+
+	message := websocketmessage.NewInventoryItemDismantledMessage(eventID, result)
+
+	err := s.notificationService.SendToUser(ctx, receiverID, message)
+	if err != nil {
+		logger.Log.Warnln("failed to send message to user:", err)
+	}
+}
@@ -2,29 +2,45 @@ package applicationservice
 
 import (
 	"context"
-	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
+	"errors"
+	"slices"
 
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/dto/request"
 	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/inventoryitementity"
 	repositoryports "github.com/intezya/abyssleague/services/abysscore/internal/domain/repository"
+	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
 	"github.com/intezya/abyssleague/services/abysscore/pkg/optional"
 )
 
+var errInvalidDismantleBatchSize = errors.New("invalid number of items to dismantle")
+
 type InventoryItemService struct {
 	inventoryItemRepository repositoryports.InventoryItemRepository
 	inventoryRepository     repositoryports.InventoryRepository
+	gameItemRepository      repositoryports.GameItemRepository
 	eventService            domainservice.InventoryItemEventService
+	collectionService       domainservice.CollectionService
+	auditLogger             domainservice.AuditLogger
 }
 
 func NewInventoryItemService(
 	repository repositoryports.InventoryItemRepository,
 	inventoryRepository repositoryports.InventoryRepository,
+	gameItemRepository repositoryports.GameItemRepository,
 	eventService domainservice.InventoryItemEventService,
+	collectionService domainservice.CollectionService,
+	auditLogger domainservice.AuditLogger,
 ) *InventoryItemService {
 	return &InventoryItemService{
 		inventoryItemRepository: repository,
 		inventoryRepository:     inventoryRepository,
+		gameItemRepository:      gameItemRepository,
 		eventService:            eventService,
+		collectionService:       collectionService,
+		auditLogger:             auditLogger,
 	}
 }
 
@@ -37,6 +53,15 @@ func (i *InventoryItemService) GrantToUserByAdmin(
 	ctx, span := tracer.StartSpan(ctx, "InventoryItemService.GrantToUserByAdmin")
 	defer span.End()
 
+	gameItem, err := i.gameItemRepository.FindByID(ctx, itemID)
+	if err != nil {
+		return nil, err // not found
+	}
+
+	if gameItem.ArchivedAt != nil {
+		return nil, apperrors.ErrGameItemArchived
+	}
+
 	createRequest := &dto.CreateInventoryItemDTO{
 		UserID:         userID,
 		ItemID:         itemID,
@@ -48,24 +73,52 @@ func (i *InventoryItemService) GrantToUserByAdmin(
 		return nil, err
 	}
 
+	i.auditLogger.Record(
+		ctx,
+		performer,
+		dto.AuditActionInventoryItemGrant,
+		dto.NewAuditTarget(dto.AuditTargetInventoryItem, item.ID),
+		nil,
+		item,
+	)
+
 	i.eventService.HandleItemObtained(ctx, userID, optional.New(performer), item)
+	i.collectionService.HandleItemGranted(ctx, userID, item)
 
 	return item, nil
 }
 
-func (i *InventoryItemService) FindAllByUserID(
+func (i *InventoryItemService) FindAllPagedByUserID(
 	ctx context.Context,
 	userID int,
-) ([]*dto.InventoryItemDTO, error) {
-	ctx, span := tracer.StartSpan(ctx, "InventoryItemService.FindAllByUserID")
+	query *request.PaginationQuery[inventoryitementity.OrderBy],
+	filter *inventoryitementity.Filter,
+) (*dto.PaginatedInventoryDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "InventoryItemService.FindAllPagedByUserID")
 	defer span.End()
 
-	items, err := i.inventoryItemRepository.FindByUserID(ctx, userID)
+	items, err := i.inventoryItemRepository.FindPagedByUserID(
+		ctx,
+		userID,
+		query.Page,
+		query.Size,
+		query.OrderBy,
+		query.OrderType,
+		filter,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	return items, nil
+	summary, err := i.inventoryItemRepository.SummaryByUserID(ctx, userID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.PaginatedInventoryDTO{
+		PaginatedResult: items,
+		Summary:         summary,
+	}, nil
 }
 
 func (i *InventoryItemService) RevokeByAdmin(
@@ -82,6 +135,15 @@ func (i *InventoryItemService) RevokeByAdmin(
 		return err
 	}
 
+	i.auditLogger.Record(
+		ctx,
+		performer,
+		dto.AuditActionInventoryItemRevoke,
+		dto.NewAuditTarget(dto.AuditTargetInventoryItem, item.ID),
+		item,
+		nil,
+	)
+
 	i.eventService.HandleItemRevoked(ctx, userID, optional.New(performer), item)
 
 	return nil
@@ -107,3 +169,29 @@ func (i *InventoryItemService) SetInventoryItemAsCurrent(
 
 	return nil
 }
+
+func (i *InventoryItemService) Dismantle(
+	ctx context.Context,
+	user *dto.UserDTO,
+	inventoryItemIDs []int,
+) (*dto.DismantleResultDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "InventoryItemService.Dismantle")
+	defer span.End()
+
+	ids := slices.Clone(inventoryItemIDs)
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+
+	if len(ids) == 0 || len(ids) > inventoryitementity.MaxDismantleBatchSize {
+		return nil, apperrors.WrapBadRequest(errInvalidDismantleBatchSize)
+	}
+
+	result, err := i.inventoryItemRepository.DismantleByUserIDAndIDs(ctx, user.ID, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	i.eventService.HandleItemsDismantled(ctx, user.ID, result)
+
+	return result, nil
+}
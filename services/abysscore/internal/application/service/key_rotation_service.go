@@ -0,0 +1,170 @@
+package applicationservice
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity"
+	repositoryports "github.com/intezya/abyssleague/services/abysscore/internal/domain/repository"
+	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/pkglib/logger"
+)
+
+type KeyRotationService struct {
+	userRepository    repositoryports.UserRepository
+	credentialsHelper domainservice.CredentialsHelper
+}
+
+func NewKeyRotationService(
+	userRepository repositoryports.UserRepository,
+	credentialsHelper domainservice.CredentialsHelper,
+) *KeyRotationService {
+	return &KeyRotationService{
+		userRepository:    userRepository,
+		credentialsHelper: credentialsHelper,
+	}
+}
+
+func (s *KeyRotationService) RunReencryptionLoop(ctx context.Context) {
+	ticker := time.NewTicker(entity.ReencryptionInterval)
+	defer ticker.Stop()
+
+	for {
+		s.reencryptAll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// reencryptAll walks users by id, so values failed to re-encrypt are not picked again in the same run.
+// Missing hardware fingerprints are filled on the way.
+func (s *KeyRotationService) reencryptAll(ctx context.Context) {
+	ctx, span := tracer.StartSpan(ctx, "KeyRotationService.reencryptAll")
+	defer span.End()
+
+	keyID := s.credentialsHelper.CurrentEncryptionKeyID()
+	afterID := 0
+	migrated := 0
+
+	for ctx.Err() == nil {
+		batch, err := s.userRepository.FindSecretsNotEncodedWithKey(
+			ctx,
+			keyID,
+			afterID,
+			entity.ReencryptionBatchSize,
+		)
+		if err != nil {
+			logger.Log.Warnln("failed to find secrets encoded with old keys:", err)
+
+			return
+		}
+
+		if len(batch) == 0 {
+			break
+		}
+
+		for _, secrets := range batch {
+			afterID = secrets.UserID
+
+			if s.reencrypt(ctx, keyID, secrets) {
+				migrated++
+			}
+		}
+	}
+
+	if migrated > 0 {
+		logger.Log.Infow("secrets re-encrypted with the current key", "keyID", keyID, "users", migrated)
+	}
+}
+
+func (s *KeyRotationService) reencrypt(ctx context.Context, keyID string, current *dto.EncodedSecretsDTO) bool {
+	hardwareID, err := s.reencode(
+		keyID,
+		current.HardwareID,
+		s.credentialsHelper.DecodeHardwareID,
+		s.credentialsHelper.EncodeHardwareID,
+	)
+	if err != nil {
+		logger.Log.Warnw("failed to decode hardware id for re-encryption", "error", err, "userID", current.UserID)
+
+		return false
+	}
+
+	hardwareFingerprint, err := s.hardwareFingerprint(current)
+	if err != nil {
+		logger.Log.Warnw("failed to decode hardware id for fingerprint", "error", err, "userID", current.UserID)
+
+		return false
+	}
+
+	totpSecret, err := s.reencode(
+		keyID,
+		current.TOTPSecret,
+		s.credentialsHelper.DecodeTOTPSecret,
+		s.credentialsHelper.EncodeTOTPSecret,
+	)
+	if err != nil {
+		logger.Log.Warnw("failed to decode TOTP secret for re-encryption", "error", err, "userID", current.UserID)
+
+		return false
+	}
+
+	replaced, err := s.userRepository.ReplaceEncodedSecrets(ctx, current, &dto.EncodedSecretsDTO{
+		UserID:              current.UserID,
+		HardwareID:          hardwareID,
+		HardwareFingerprint: hardwareFingerprint,
+		TOTPSecret:          totpSecret,
+	})
+	if err != nil {
+		logger.Log.Warnw("failed to replace re-encrypted secrets", "error", err, "userID", current.UserID)
+
+		return false
+	}
+
+	// secrets changed concurrently are encoded with the current key already or are picked in the next run
+	return replaced
+}
+
+// reencode returns value as is if it is not set or is encoded with the current key.
+func (s *KeyRotationService) reencode(
+	keyID string,
+	encoded *string,
+	decode func(string) (string, error),
+	encode func(string) string,
+) (*string, error) {
+	if encoded == nil || strings.HasPrefix(*encoded, keyID+":") {
+		return encoded, nil
+	}
+
+	raw, err := decode(*encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	reencoded := encode(raw)
+
+	return &reencoded, nil
+}
+
+// hardwareFingerprint returns stored fingerprint or computes it for hardware id stored without one.
+func (s *KeyRotationService) hardwareFingerprint(current *dto.EncodedSecretsDTO) (*string, error) {
+	if current.HardwareID == nil || current.HardwareFingerprint != nil {
+		return current.HardwareFingerprint, nil
+	}
+
+	raw, err := s.credentialsHelper.DecodeHardwareID(*current.HardwareID)
+	if err != nil {
+		return nil, err
+	}
+
+	fingerprint := s.credentialsHelper.HashToken(raw)[:entity.HardwareFingerprintLength]
+
+	return &fingerprint, nil
+}
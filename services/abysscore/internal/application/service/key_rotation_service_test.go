@@ -0,0 +1,153 @@
+package applicationservice
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity"
+	repositoryports "github.com/intezya/abyssleague/services/abysscore/internal/domain/repository"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/auth"
+	"github.com/intezya/pkglib/logger"
+)
+
+// secretsUserRepository keeps encoded secrets of users in memory.
+type secretsUserRepository struct {
+	repositoryports.UserRepository
+
+	secrets map[int]*dto.EncodedSecretsDTO
+}
+
+func (r *secretsUserRepository) FindSecretsNotEncodedWithKey(
+	_ context.Context,
+	keyID string,
+	afterID int,
+	limit int,
+) ([]*dto.EncodedSecretsDTO, error) {
+	result := make([]*dto.EncodedSecretsDTO, 0)
+
+	for id := afterID + 1; id <= len(r.secrets) && len(result) < limit; id++ {
+		secrets := r.secrets[id]
+		if secrets.HardwareID != nil && !strings.HasPrefix(*secrets.HardwareID, keyID+":") {
+			result = append(result, secrets)
+		}
+	}
+
+	return result, nil
+}
+
+func (r *secretsUserRepository) ReplaceEncodedSecrets(
+	_ context.Context,
+	current, replacement *dto.EncodedSecretsDTO,
+) (bool, error) {
+	if r.secrets[current.UserID] != current {
+		return false, nil
+	}
+
+	r.secrets[current.UserID] = replacement
+
+	return true, nil
+}
+
+type noBannedHardwareIDRepository struct {
+	repositoryports.BannedHardwareIDRepository
+}
+
+func (noBannedHardwareIDRepository) TxFindByHardwareID(
+	_ context.Context,
+	_ *ent.Tx,
+	_ string,
+) (*dto.BannedHardwareID, error) {
+	return nil, nil
+}
+
+func mustParseKeyring(t *testing.T, spec string) *auth.Keyring {
+	t.Helper()
+
+	keyring, err := auth.ParseKeyring(spec)
+	if err != nil {
+		t.Fatalf("failed to parse keyring: %v", err)
+	}
+
+	return keyring
+}
+
+// TestTokensSurviveReencryption checks that tokens issued before rotation carry hardware id that still
+// matches the re-encrypted one, both for access token validation and refresh.
+func TestTokensSurviveReencryption(t *testing.T) {
+	_, err := logger.New()
+	if err != nil {
+		t.Fatalf("failed to initialize logger: %v", err)
+	}
+
+	ctx := t.Context()
+
+	signingKeys, err := auth.GenerateSigningKeys("1")
+	if err != nil {
+		t.Fatalf("failed to generate signing keys: %v", err)
+	}
+
+	tokenHelper := auth.NewJWTHelper(auth.NewJWTConfiguration(signingKeys, "issuer", time.Hour, time.Hour))
+
+	// legacy unversioned value is encoded before key ids were introduced
+	oldHelper := auth.NewHashHelper(auth.NewKeyring("1", "old-secret"))
+	encodedHardwareID := oldHelper.EncodeHardwareID("hwid")
+
+	token := tokenHelper.TokenGenerator(&entity.TokenData{
+		ID:         1,
+		Username:   "user",
+		HardwareID: encodedHardwareID,
+		FamilyID:   "family",
+	})
+	refreshTokenHardwareID := encodedHardwareID
+
+	rotatedHelper := auth.NewHashHelper(mustParseKeyring(t, "2=new-secret,1=old-secret"))
+	userRepository := &secretsUserRepository{
+		secrets: map[int]*dto.EncodedSecretsDTO{1: {UserID: 1, HardwareID: &encodedHardwareID}},
+	}
+
+	NewKeyRotationService(userRepository, rotatedHelper).reencryptAll(ctx)
+
+	storedHardwareID := userRepository.secrets[1].HardwareID
+	if *storedHardwareID == encodedHardwareID {
+		t.Fatal("expected hardware id to be re-encrypted")
+	}
+
+	fingerprint := userRepository.secrets[1].HardwareFingerprint
+	if fingerprint == nil || *fingerprint != rotatedHelper.HashToken("hwid")[:entity.HardwareFingerprintLength] {
+		t.Error("expected hardware fingerprint to be filled on re-encryption")
+	}
+
+	authenticationService := &AuthenticationService{ //nolint:exhaustruct
+		credentialsHelper:    rotatedHelper,
+		tokenHelper:          tokenHelper,
+		bannedHardwareIDRepo: noBannedHardwareIDRepository{},
+	}
+
+	tokenData, err := tokenHelper.ValidateToken(token)
+	if err != nil {
+		t.Fatalf("failed to validate token: %v", err)
+	}
+
+	err = authenticationService.verifyTokenHardwareID(ctx, nil, storedHardwareID, tokenData.HardwareID)
+	if err != nil {
+		t.Errorf("expected access token to stay valid after re-encryption, got %v", err)
+	}
+
+	err = authenticationService.verifyTokenHardwareID(ctx, nil, storedHardwareID, refreshTokenHardwareID)
+	if err != nil {
+		t.Errorf("expected refresh token to stay valid after re-encryption, got %v", err)
+	}
+
+	otherHardwareID := rotatedHelper.EncodeHardwareID("other-hwid")
+
+	err = authenticationService.verifyTokenHardwareID(ctx, nil, storedHardwareID, otherHardwareID)
+	if !errors.Is(err, apperrors.ErrTokenHardwareIDIsInvalid) {
+		t.Errorf("expected ErrTokenHardwareIDIsInvalid for another hardware id, got %v", err)
+	}
+}
@@ -0,0 +1,245 @@
+package applicationservice
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/mailmessage"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/userentity"
+	drivenports "github.com/intezya/abyssleague/services/abysscore/internal/domain/ports/driven"
+	repositoryports "github.com/intezya/abyssleague/services/abysscore/internal/domain/repository"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/intezya/pkglib/logger"
+)
+
+const (
+	loginSubjectUsername = "username:"
+	loginSubjectIP       = "ip:"
+	loginSubjectHardware = "hardware:"
+)
+
+type LoginDefenseService struct {
+	loginAttemptRepository repositoryports.LoginAttemptRepository
+	loginHistoryRepository repositoryports.LoginHistoryRepository
+	userRepository         repositoryports.UserRepository
+	mailSender             drivenports.MailSender
+}
+
+func NewLoginDefenseService(
+	loginAttemptRepository repositoryports.LoginAttemptRepository,
+	loginHistoryRepository repositoryports.LoginHistoryRepository,
+	userRepository repositoryports.UserRepository,
+	mailSender drivenports.MailSender,
+) *LoginDefenseService {
+	return &LoginDefenseService{
+		loginAttemptRepository: loginAttemptRepository,
+		loginHistoryRepository: loginHistoryRepository,
+		userRepository:         userRepository,
+		mailSender:             mailSender,
+	}
+}
+
+// CheckAllowed lets attempts through if lockouts can't be read, like the auth rate limit does.
+func (s *LoginDefenseService) CheckAllowed(ctx context.Context, attempt *dto.LoginAttemptDTO) error {
+	ctx, span := tracer.StartSpan(ctx, "LoginDefenseService.CheckAllowed")
+	defer span.End()
+
+	lockout, err := s.loginAttemptRepository.FindLockout(ctx, s.subjects(attempt)...)
+	if err != nil {
+		logger.Log.Warnw("failed to check login lockout", "error", err)
+
+		return nil
+	}
+
+	if lockout > 0 {
+		return apperrors.TooManyLoginFailures(lockout)
+	}
+
+	return nil
+}
+
+// HandleFailure locks out every subject of the attempt for the time growing with its failures.
+// Too many failures by username lock login to the account itself.
+func (s *LoginDefenseService) HandleFailure(
+	ctx context.Context,
+	attempt *dto.LoginAttemptDTO,
+	user *dto.UserDTO,
+	hardwareIDMatch bool,
+) {
+	ctx, span := tracer.StartSpan(ctx, "LoginDefenseService.HandleFailure")
+	defer span.End()
+
+	usernameFailures := 0
+
+	for _, subject := range s.subjects(attempt) {
+		failures, err := s.loginAttemptRepository.IncrementFailures(ctx, subject, userentity.LoginFailuresWindow)
+		if err != nil {
+			logger.Log.Warnw("failed to count login failure", "error", err, "subject", subject)
+
+			continue
+		}
+
+		if strings.HasPrefix(subject, loginSubjectUsername) {
+			usernameFailures = failures
+		}
+
+		lockout := userentity.LoginLockout(failures)
+		if lockout == 0 {
+			continue
+		}
+
+		err = s.loginAttemptRepository.Lockout(ctx, subject, lockout)
+		if err != nil {
+			logger.Log.Warnw("failed to lock out login", "error", err, "subject", subject)
+		}
+	}
+
+	if user == nil {
+		return
+	}
+
+	s.record(ctx, attempt, user, hardwareIDMatch, false)
+
+	if usernameFailures >= userentity.LoginLockThreshold {
+		s.lockLogin(ctx, attempt, user)
+	}
+}
+
+// HandleSuccess records the login and notifies the user if it is made from a new device or ip address.
+// Failures by ip and hardware id are not reset, otherwise they could be reset by logging in to own account.
+func (s *LoginDefenseService) HandleSuccess(
+	ctx context.Context,
+	attempt *dto.LoginAttemptDTO,
+	user *dto.UserDTO,
+) {
+	ctx, span := tracer.StartSpan(ctx, "LoginDefenseService.HandleSuccess")
+	defer span.End()
+
+	err := s.loginAttemptRepository.ResetFailures(ctx, s.usernameSubject(attempt.Username))
+	if err != nil {
+		logger.Log.Warnw("failed to reset login failures", "error", err, "userID", user.ID)
+	}
+
+	newHardware, newIP := s.isUnrecognized(ctx, attempt, user)
+
+	s.record(ctx, attempt, user, true, true)
+
+	if (newHardware || newIP) && user.Email != nil {
+		message := mailmessage.NewUnrecognizedLoginMessage(
+			user.Username,
+			attempt.IP,
+			attempt.UserAgent,
+			newHardware,
+			time.Now(),
+		)
+		s.sendMail(ctx, user, message)
+	}
+}
+
+func (s *LoginDefenseService) FindHistoryPaged(
+	ctx context.Context,
+	userID int,
+	page, size int,
+) (*dto.PaginatedResult[*dto.LoginHistoryDTO], error) {
+	ctx, span := tracer.StartSpan(ctx, "LoginDefenseService.FindHistoryPaged")
+	defer span.End()
+
+	return s.loginHistoryRepository.FindPagedByUserID(ctx, userID, page, size)
+}
+
+// isUnrecognized compares attempt with previous successful logins, the first login is never unrecognized.
+func (s *LoginDefenseService) isUnrecognized(
+	ctx context.Context,
+	attempt *dto.LoginAttemptDTO,
+	user *dto.UserDTO,
+) (newHardware, newIP bool) {
+	hasLogins, err := s.loginHistoryRepository.ExistsSuccessfulByUserID(ctx, user.ID)
+	if err != nil || !hasLogins {
+		return false, false
+	}
+
+	knownHardware, err := s.loginHistoryRepository.ExistsSuccessfulByUserIDAndHardwareFingerprint(
+		ctx,
+		user.ID,
+		attempt.HardwareFingerprint,
+	)
+	if err != nil {
+		return false, false
+	}
+
+	knownIP, err := s.loginHistoryRepository.ExistsSuccessfulByUserIDAndIP(ctx, user.ID, attempt.IP)
+	if err != nil {
+		return false, false
+	}
+
+	return !knownHardware, !knownIP
+}
+
+func (s *LoginDefenseService) lockLogin(ctx context.Context, attempt *dto.LoginAttemptDTO, user *dto.UserDTO) {
+	until := time.Now().Add(userentity.LoginLockDuration)
+
+	err := s.userRepository.LockLoginUntil(ctx, user.ID, until)
+	if err != nil {
+		logger.Log.Warnw("failed to lock login", "error", err, "userID", user.ID)
+
+		return
+	}
+
+	// Counting starts over, so the lock is not extended by every next failure
+	err = s.loginAttemptRepository.ResetFailures(ctx, s.usernameSubject(attempt.Username))
+	if err != nil {
+		logger.Log.Warnw("failed to reset login failures", "error", err, "userID", user.ID)
+	}
+
+	if user.Email != nil {
+		s.sendMail(ctx, user, mailmessage.NewLoginLockedMessage(user.Username, attempt.IP, until))
+	}
+}
+
+func (s *LoginDefenseService) record(
+	ctx context.Context,
+	attempt *dto.LoginAttemptDTO,
+	user *dto.UserDTO,
+	hardwareIDMatch bool,
+	success bool,
+) {
+	err := s.loginHistoryRepository.Create(ctx, &dto.CreateLoginHistoryDTO{
+		UserID:              user.ID,
+		IP:                  attempt.IP,
+		UserAgent:           attempt.UserAgent,
+		HardwareFingerprint: attempt.HardwareFingerprint,
+		HardwareIDMatch:     hardwareIDMatch,
+		Success:             success,
+	})
+	if err != nil {
+		logger.Log.Warnw("failed to record login history", "error", err, "userID", user.ID)
+	}
+}
+
+// sendMail does not block login on mail delivery.
+func (s *LoginDefenseService) sendMail(ctx context.Context, user *dto.UserDTO, message *mailmessage.Message) {
+	ctx = context.WithoutCancel(ctx)
+	email := *user.Email
+
+	go func() {
+		err := s.mailSender.Send(ctx, message, email)
+		if err != nil {
+			logger.Log.Warnw("failed to send login alert mail", "error", err, "userID", user.ID)
+		}
+	}()
+}
+
+func (s *LoginDefenseService) subjects(attempt *dto.LoginAttemptDTO) []string {
+	return []string{
+		s.usernameSubject(attempt.Username),
+		loginSubjectIP + attempt.IP,
+		loginSubjectHardware + attempt.HardwareFingerprint,
+	}
+}
+
+func (s *LoginDefenseService) usernameSubject(username string) string {
+	return loginSubjectUsername + strings.ToLower(username)
+}
@@ -0,0 +1,177 @@
+package applicationservice
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/mailmessage"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/userentity"
+	drivenports "github.com/intezya/abyssleague/services/abysscore/internal/domain/ports/driven"
+	repositoryports "github.com/intezya/abyssleague/services/abysscore/internal/domain/repository"
+	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/intezya/pkglib/logger"
+)
+
+type PersonalDataService struct {
+	personalDataRepository repositoryports.PersonalDataRepository
+	credentialsHelper      domainservice.CredentialsHelper
+	sessionService         domainservice.SessionService
+	twoFactorService       domainservice.TwoFactorService
+	mailSender             drivenports.MailSender
+}
+
+func NewPersonalDataService(
+	personalDataRepository repositoryports.PersonalDataRepository,
+	credentialsHelper domainservice.CredentialsHelper,
+	sessionService domainservice.SessionService,
+	twoFactorService domainservice.TwoFactorService,
+	mailSender drivenports.MailSender,
+) *PersonalDataService {
+	return &PersonalDataService{
+		personalDataRepository: personalDataRepository,
+		credentialsHelper:      credentialsHelper,
+		sessionService:         sessionService,
+		twoFactorService:       twoFactorService,
+		mailSender:             mailSender,
+	}
+}
+
+func (s *PersonalDataService) Export(ctx context.Context, user *dto.UserDTO) (*dto.PersonalDataDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "PersonalDataService.Export")
+	defer span.End()
+
+	result, err := s.personalDataRepository.FindByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Profile.HardwareID != nil {
+		rawHardwareID, err := s.credentialsHelper.DecodeHardwareID(*result.Profile.HardwareID)
+		if err != nil {
+			logger.Log.Warnw("failed to decode hardware id for export", "error", err, "userID", user.ID)
+		} else {
+			fingerprint := s.credentialsHelper.HashToken(rawHardwareID)[:entity.HardwareFingerprintLength]
+			result.LinkedIdentifiers.HardwareFingerprint = &fingerprint
+		}
+	}
+
+	return result, nil
+}
+
+// RequestDeletion keeps the current session, so the user can cancel the deletion within the grace period.
+func (s *PersonalDataService) RequestDeletion(
+	ctx context.Context,
+	user *dto.UserDTO,
+	sessionID string,
+	password string,
+	twoFactorCode string,
+) (*dto.AccountDeletionDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "PersonalDataService.RequestDeletion")
+	defer span.End()
+
+	if !s.credentialsHelper.VerifyPassword(password, user.Password) {
+		return nil, apperrors.ErrWrongPassword
+	}
+
+	err := s.twoFactorService.Challenge(ctx, user, twoFactorCode)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduledAt := time.Now().Add(userentity.AccountDeletionGracePeriod)
+
+	err = s.personalDataRepository.ScheduleDeletion(ctx, user.ID, scheduledAt)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.sessionService.EndOthers(ctx, user.ID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Email != nil {
+		message := mailmessage.NewAccountDeletionScheduledMessage(user.Username, scheduledAt)
+
+		err = s.mailSender.Send(ctx, message, *user.Email)
+		if err != nil {
+			logger.Log.Warnw("failed to send account deletion mail", "error", err, "userID", user.ID)
+		}
+	}
+
+	return &dto.AccountDeletionDTO{ScheduledAt: scheduledAt}, nil
+}
+
+func (s *PersonalDataService) CancelDeletion(ctx context.Context, user *dto.UserDTO) error {
+	ctx, span := tracer.StartSpan(ctx, "PersonalDataService.CancelDeletion")
+	defer span.End()
+
+	return s.personalDataRepository.CancelDeletion(ctx, user.ID)
+}
+
+func (s *PersonalDataService) RunDeletionLoop(ctx context.Context) {
+	ticker := time.NewTicker(userentity.AccountDeletionCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		s.eraseDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *PersonalDataService) eraseDue(ctx context.Context) {
+	ctx, span := tracer.StartSpan(ctx, "PersonalDataService.eraseDue")
+	defer span.End()
+
+	now := time.Now()
+
+	userIDs, err := s.personalDataRepository.FindIDsDueForDeletion(ctx, now, userentity.AccountDeletionBatchSize)
+	if err != nil {
+		logger.Log.Warnln("failed to find accounts due for deletion:", err)
+
+		return
+	}
+
+	for _, userID := range userIDs {
+		s.erase(ctx, userID, now)
+	}
+}
+
+// erase ends sessions after the data is erased, so a session can't be refreshed with the old data.
+func (s *PersonalDataService) erase(ctx context.Context, userID int, now time.Time) {
+	err := s.personalDataRepository.Erase(ctx, &dto.AccountErasureDTO{
+		UserID:   userID,
+		Username: userentity.DeletedUsername(userID),
+		Password: s.credentialsHelper.EncodePassword(uuid.NewString()),
+		ErasedAt: now,
+	})
+	if errors.Is(err, apperrors.ErrAccountDeletionNotScheduled) {
+		logger.Log.Debugw("account deletion was cancelled before erasure", "userID", userID)
+
+		return
+	}
+
+	if err != nil {
+		logger.Log.Warnw("failed to erase account", "error", err, "userID", userID)
+
+		return
+	}
+
+	err = s.sessionService.EndAll(ctx, userID)
+	if err != nil {
+		logger.Log.Warnw("failed to end sessions of erased account", "error", err, "userID", userID)
+	}
+
+	logger.Log.Infow("account erased", "userID", userID)
+}
@@ -16,10 +16,24 @@ type DependencyProvider struct {
 
 	EventPublisher eventlib.ApplicationEventPublisher
 
-	AuthenticationService domainservice.AuthenticationService
-	GameItemService       domainservice.GameItemService
-	InventoryItemService  domainservice.InventoryItemService
-	AccountService        domainservice.AccountService
+	AuthenticationService  domainservice.AuthenticationService
+	GameItemService        domainservice.GameItemService
+	InventoryItemService   domainservice.InventoryItemService
+	AccountService         domainservice.AccountService
+	CollectionService      domainservice.CollectionService
+	GrantJobService        domainservice.GrantJobService
+	SeasonService          domainservice.SeasonService
+	SessionService         domainservice.SessionService
+	TwoFactorService       domainservice.TwoFactorService
+	HardwareIDResetService domainservice.HardwareIDResetService
+	HardwareIDBanService   domainservice.HardwareIDBanService
+	SanctionService        domainservice.SanctionService
+	RoleService            domainservice.RoleService
+	AuditLogService        domainservice.AuditLogService
+	LoginDefenseService    domainservice.LoginDefenseService
+	APITokenService        domainservice.APITokenService
+	PersonalDataService    domainservice.PersonalDataService
+	KeyRotationService     domainservice.KeyRotationService
 }
 
 func NewDependencyProvider(
@@ -27,12 +41,49 @@ func NewDependencyProvider(
 	gRPCDependencyProvider *clients.DependencyProvider,
 	passwordHelper domainservice.CredentialsHelper,
 	tokenHelper domainservice.TokenHelper,
+	totpHelper domainservice.TOTPHelper,
 	mailSender drivenports.MailSender,
+	oidcProviders map[string]drivenports.OIDCProvider,
 ) *DependencyProvider {
+	auditLogService := NewAuditLogService(repositoryDependencyProvider.AuditLogRepository)
 	mainClientNotificationService := NewNotificationService(
 		gRPCDependencyProvider.MainWebsocketService,
 	)
 	// draftClientNotificationService := NewNotificationService(gRPCDependencyProvider.DraftWebsocketService)
+	collectionService := NewCollectionService(
+		repositoryDependencyProvider.CollectionRepository,
+		repositoryDependencyProvider.GameItemRepository,
+		mainClientNotificationService,
+		auditLogService,
+	)
+	inventoryItemEventService := NewInventoryItemEventService(mainClientNotificationService)
+	sessionService := NewSessionService(
+		repositoryDependencyProvider.SessionRepository,
+		repositoryDependencyProvider.RefreshTokenRepository,
+		tokenHelper,
+		gRPCDependencyProvider.MainWebsocketService,
+	)
+	twoFactorService := NewTwoFactorService(
+		repositoryDependencyProvider.UserRepository,
+		repositoryDependencyProvider.TwoFactorRepository,
+		repositoryDependencyProvider.TwoFactorCacheRepository,
+		passwordHelper,
+		totpHelper,
+	)
+	loginDefenseService := NewLoginDefenseService(
+		repositoryDependencyProvider.LoginAttemptRepository,
+		repositoryDependencyProvider.LoginHistoryRepository,
+		repositoryDependencyProvider.UserRepository,
+		mailSender,
+	)
+	seasonService := NewSeasonService(
+		repositoryDependencyProvider.SeasonRepository,
+		repositoryDependencyProvider.GameItemRepository,
+		mainClientNotificationService,
+		collectionService,
+		auditLogService,
+	)
+
 	return &DependencyProvider{
 		repositoryDependencyProvider: repositoryDependencyProvider,
 		gRPCDependencyProvider:       gRPCDependencyProvider,
@@ -46,22 +97,100 @@ func NewDependencyProvider(
 			passwordHelper,
 			tokenHelper,
 			repositoryDependencyProvider.BannedHardwareIDRepository,
+			repositoryDependencyProvider.RefreshTokenRepository,
+			repositoryDependencyProvider.SessionRepository,
 			NewAuthenticationEventService(
 				repositoryDependencyProvider.UserRepository,
+				seasonService,
 			),
+			twoFactorService,
+			loginDefenseService,
+			repositoryDependencyProvider.ExternalIdentityRepository,
+			repositoryDependencyProvider.OIDCStateRepository,
+			oidcProviders,
+		),
+		GameItemService: NewGameItemService(
+			repositoryDependencyProvider.GameItemRepository,
+			auditLogService,
 		),
-		GameItemService: NewGameItemService(repositoryDependencyProvider.GameItemRepository),
 		InventoryItemService: NewInventoryItemService(
 			repositoryDependencyProvider.InventoryItemRepository,
 			repositoryDependencyProvider.InventoryRepository,
-			NewInventoryItemEventService(
-				mainClientNotificationService,
-			),
+			repositoryDependencyProvider.GameItemRepository,
+			inventoryItemEventService,
+			collectionService,
+			auditLogService,
 		),
 		AccountService: NewAccountService(
 			repositoryDependencyProvider.UserRepository,
+			repositoryDependencyProvider.ExternalIdentityRepository,
 			mailSender,
 			repositoryDependencyProvider.MailMessageRepository,
+			passwordHelper,
+			sessionService,
+			twoFactorService,
+			auditLogService,
+		),
+		CollectionService: collectionService,
+		GrantJobService: NewGrantJobService(
+			repositoryDependencyProvider.GrantJobRepository,
+			repositoryDependencyProvider.InventoryItemRepository,
+			repositoryDependencyProvider.GameItemRepository,
+			repositoryDependencyProvider.UserRepository,
+			gRPCDependencyProvider.MainWebsocketService,
+			inventoryItemEventService,
+			collectionService,
+			auditLogService,
+		),
+		SeasonService:    seasonService,
+		SessionService:   sessionService,
+		TwoFactorService: twoFactorService,
+		HardwareIDResetService: NewHardwareIDResetService(
+			repositoryDependencyProvider.HardwareIDResetRepository,
+			repositoryDependencyProvider.UserRepository,
+			mailSender,
+			passwordHelper,
+			sessionService,
+			twoFactorService,
+			auditLogService,
+		),
+		HardwareIDBanService: NewHardwareIDBanService(
+			repositoryDependencyProvider.BannedHardwareIDRepository,
+			repositoryDependencyProvider.UserRepository,
+			passwordHelper,
+			sessionService,
+			auditLogService,
+		),
+		SanctionService: NewSanctionService(
+			repositoryDependencyProvider.SanctionRepository,
+			repositoryDependencyProvider.UserRepository,
+			mainClientNotificationService,
+			mailSender,
+			sessionService,
+			auditLogService,
+		),
+		RoleService: NewRoleService(
+			repositoryDependencyProvider.RoleRepository,
+			auditLogService,
+		),
+		AuditLogService:     auditLogService,
+		LoginDefenseService: loginDefenseService,
+		APITokenService: NewAPITokenService(
+			repositoryDependencyProvider.APITokenRepository,
+			repositoryDependencyProvider.UserRepository,
+			passwordHelper,
+			twoFactorService,
+		),
+		PersonalDataService: NewPersonalDataService(
+			repositoryDependencyProvider.PersonalDataRepository,
+			passwordHelper,
+			sessionService,
+			twoFactorService,
+			mailSender,
+		),
+		KeyRotationService: NewKeyRotationService(
+			repositoryDependencyProvider.UserRepository,
+			passwordHelper,
 		),
 	}
 }
@@ -0,0 +1,275 @@
+package applicationservice
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	repositoryports "github.com/intezya/abyssleague/services/abysscore/internal/domain/repository"
+	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/access_level"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/permission"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+)
+
+type RoleService struct {
+	roleRepository repositoryports.RoleRepository
+	auditLogger    domainservice.AuditLogger
+}
+
+func NewRoleService(
+	roleRepository repositoryports.RoleRepository,
+	auditLogger domainservice.AuditLogger,
+) *RoleService {
+	return &RoleService{
+		roleRepository: roleRepository,
+		auditLogger:    auditLogger,
+	}
+}
+
+func (s *RoleService) Permissions() []permission.Permission {
+	return permission.All()
+}
+
+func (s *RoleService) FindAll(ctx context.Context) ([]*dto.RoleDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "RoleService.FindAll")
+	defer span.End()
+
+	return s.roleRepository.FindAll(ctx)
+}
+
+func (s *RoleService) Create(
+	ctx context.Context,
+	performer *dto.UserDTO,
+	role *dto.SaveRoleDTO,
+) (*dto.RoleDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "RoleService.Create")
+	defer span.End()
+
+	if isReservedRoleName(role.Name) {
+		return nil, apperrors.ErrRoleNameReserved
+	}
+
+	err := s.validatePermissions(performer, role.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	role.Permissions = normalizePermissions(role.Permissions)
+
+	created, err := s.roleRepository.Create(ctx, role)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditLogger.Record(
+		ctx,
+		performer,
+		dto.AuditActionRoleCreate,
+		dto.NewAuditTarget(dto.AuditTargetRole, created.ID),
+		nil,
+		created,
+	)
+
+	return created, nil
+}
+
+func (s *RoleService) Update(
+	ctx context.Context,
+	performer *dto.UserDTO,
+	roleID int,
+	role *dto.SaveRoleDTO,
+) (*dto.RoleDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "RoleService.Update")
+	defer span.End()
+
+	existing, err := s.roleRepository.FindByID(ctx, roleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing.System {
+		return nil, apperrors.ErrSystemRoleImmutable
+	}
+
+	// roles migrated from access levels keep their names, other roles can't take them
+	if !strings.EqualFold(existing.Name, role.Name) && isReservedRoleName(role.Name) {
+		return nil, apperrors.ErrRoleNameReserved
+	}
+
+	// Removing permission from the role revokes it from its users, so it's also a grant-level action
+	err = s.validatePermissions(performer, slices.Concat(existing.Permissions, role.Permissions))
+	if err != nil {
+		return nil, err
+	}
+
+	role.Permissions = normalizePermissions(role.Permissions)
+
+	updated, err := s.roleRepository.Update(ctx, roleID, role)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditLogger.Record(
+		ctx,
+		performer,
+		dto.AuditActionRoleUpdate,
+		dto.NewAuditTarget(dto.AuditTargetRole, roleID),
+		existing,
+		updated,
+	)
+
+	return updated, nil
+}
+
+func (s *RoleService) Delete(ctx context.Context, performer *dto.UserDTO, roleID int) error {
+	ctx, span := tracer.StartSpan(ctx, "RoleService.Delete")
+	defer span.End()
+
+	existing, err := s.roleRepository.FindByID(ctx, roleID)
+	if err != nil {
+		return err
+	}
+
+	if existing.System {
+		return apperrors.ErrSystemRoleImmutable
+	}
+
+	err = s.validatePermissions(performer, existing.Permissions)
+	if err != nil {
+		return err
+	}
+
+	err = s.roleRepository.Delete(ctx, roleID)
+	if err != nil {
+		return err
+	}
+
+	s.auditLogger.Record(
+		ctx,
+		performer,
+		dto.AuditActionRoleDelete,
+		dto.NewAuditTarget(dto.AuditTargetRole, roleID),
+		existing,
+		nil,
+	)
+
+	return nil
+}
+
+func (s *RoleService) FindByUserID(ctx context.Context, userID int) ([]*dto.RoleDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "RoleService.FindByUserID")
+	defer span.End()
+
+	return s.roleRepository.FindByUserID(ctx, userID)
+}
+
+func (s *RoleService) SetUserRoles(
+	ctx context.Context,
+	performer *dto.UserDTO,
+	userID int,
+	roleIDs []int,
+) ([]*dto.RoleDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "RoleService.SetUserRoles")
+	defer span.End()
+
+	current, err := s.roleRepository.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	roleIDs = slices.Clone(roleIDs)
+	slices.Sort(roleIDs)
+	roleIDs = slices.Compact(roleIDs)
+
+	changed := make([]permission.Permission, 0)
+
+	for _, role := range current {
+		if _, found := slices.BinarySearch(roleIDs, role.ID); !found {
+			changed = append(changed, role.Permissions...)
+		}
+	}
+
+	for _, roleID := range roleIDs {
+		alreadyAssigned := slices.ContainsFunc(current, func(role *dto.RoleDTO) bool {
+			return role.ID == roleID
+		})
+		if alreadyAssigned {
+			continue
+		}
+
+		role, err := s.roleRepository.FindByID(ctx, roleID)
+		if err != nil {
+			return nil, err
+		}
+
+		changed = append(changed, role.Permissions...)
+	}
+
+	err = s.validatePermissions(performer, changed)
+	if err != nil {
+		return nil, err
+	}
+
+	assigned, err := s.roleRepository.SetUserRoles(ctx, userID, roleIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditLogger.Record(
+		ctx,
+		performer,
+		dto.AuditActionUserRolesSet,
+		dto.NewAuditTarget(dto.AuditTargetUser, userID),
+		map[string]any{"roles": roleNames(current)},
+		map[string]any{"roles": roleNames(assigned)},
+	)
+
+	return assigned, nil
+}
+
+// isReservedRoleName reports whether the name belongs to a legacy access level, migration
+// looks up roles by these names.
+func isReservedRoleName(name string) bool {
+	for level := access_level.User; level <= access_level.Dev; level++ {
+		if strings.EqualFold(name, level.String()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validatePermissions rejects unknown permissions and permissions performer doesn't have,
+// so nobody is able to escalate own privileges with roles.
+func (s *RoleService) validatePermissions(
+	performer *dto.UserDTO,
+	permissions []permission.Permission,
+) error {
+	for _, p := range permissions {
+		if !p.IsValid() {
+			return apperrors.ErrUnknownPermission
+		}
+	}
+
+	if !performer.Permissions.HasAll(permissions...) {
+		return apperrors.ErrGrantingMissingPermissions
+	}
+
+	return nil
+}
+
+func roleNames(roles []*dto.RoleDTO) []string {
+	names := make([]string, 0, len(roles))
+	for _, role := range roles {
+		names = append(names, role.Name)
+	}
+
+	return names
+}
+
+func normalizePermissions(permissions []permission.Permission) []permission.Permission {
+	return permission.NewSet(permissions...).Slice()
+}
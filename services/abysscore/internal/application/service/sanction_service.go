@@ -0,0 +1,271 @@
+package applicationservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/mailmessage"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/userentity"
+	drivenports "github.com/intezya/abyssleague/services/abysscore/internal/domain/ports/driven"
+	repositoryports "github.com/intezya/abyssleague/services/abysscore/internal/domain/repository"
+	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/websocketmessage"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/intezya/pkglib/logger"
+)
+
+type SanctionService struct {
+	sanctionRepository  repositoryports.SanctionRepository
+	userRepository      repositoryports.UserRepository
+	notificationService domainservice.NotificationService
+	mailSender          drivenports.MailSender
+	sessionService      domainservice.SessionService
+	auditLogger         domainservice.AuditLogger
+}
+
+func NewSanctionService(
+	sanctionRepository repositoryports.SanctionRepository,
+	userRepository repositoryports.UserRepository,
+	notificationService domainservice.NotificationService,
+	mailSender drivenports.MailSender,
+	sessionService domainservice.SessionService,
+	auditLogger domainservice.AuditLogger,
+) *SanctionService {
+	return &SanctionService{
+		sanctionRepository:  sanctionRepository,
+		userRepository:      userRepository,
+		notificationService: notificationService,
+		mailSender:          mailSender,
+		sessionService:      sessionService,
+		auditLogger:         auditLogger,
+	}
+}
+
+func (s *SanctionService) Issue(
+	ctx context.Context,
+	performer *dto.UserDTO,
+	userID int,
+	sanctionType dto.SanctionType,
+	reason string,
+) (*dto.SanctionDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "SanctionService.Issue")
+	defer span.End()
+
+	if !sanctionType.IsValid() {
+		return nil, apperrors.ErrInvalidSanctionType
+	}
+
+	user, err := s.userRepository.FindDTOById(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	issue := newIssueSanction(user, sanctionType, time.Now())
+	issue.Reason = reason
+	issue.PerformerID = performer.ID
+
+	result, err := s.sanctionRepository.Issue(ctx, issue)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditLogger.Record(
+		ctx,
+		performer,
+		dto.AuditActionSanctionIssue,
+		dto.NewAuditTarget(dto.AuditTargetSanction, result.ID),
+		nil,
+		result.SanctionDTO,
+	)
+
+	s.notify(
+		ctx,
+		result,
+		websocketmessage.NewSanctionIssuedMessage(uuid.NewString(), performer.Username, result.SanctionDTO),
+		mailmessage.NewSanctionIssuedMessage(result.User.Username, result.SanctionDTO),
+	)
+
+	// Blocked account is disconnected after the notice is delivered
+	if sanctionType == dto.SanctionAccount && result.BlockedUntil.After(result.CreatedAt) {
+		err = s.sessionService.EndAll(ctx, user.ID)
+		if err != nil {
+			logger.Log.Warnw("failed to end sessions of blocked account", "error", err, "userID", user.ID)
+		}
+	}
+
+	return result.SanctionDTO, nil
+}
+
+func (s *SanctionService) Lift(
+	ctx context.Context,
+	performer *dto.UserDTO,
+	sanctionID int,
+	comment *string,
+) (*dto.SanctionDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "SanctionService.Lift")
+	defer span.End()
+
+	before, err := s.sanctionRepository.FindByID(ctx, sanctionID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.sanctionRepository.Lift(ctx, sanctionID, performer.ID, comment)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditLogger.Record(
+		ctx,
+		performer,
+		dto.AuditActionSanctionLift,
+		dto.NewAuditTarget(dto.AuditTargetSanction, sanctionID),
+		before,
+		result.SanctionDTO,
+	)
+
+	s.notify(
+		ctx,
+		result,
+		websocketmessage.NewSanctionLiftedMessage(uuid.NewString(), performer.Username, result.SanctionDTO),
+		mailmessage.NewSanctionLiftedMessage(result.User.Username, result.SanctionDTO),
+	)
+
+	return result.SanctionDTO, nil
+}
+
+func (s *SanctionService) Appeal(
+	ctx context.Context,
+	user *dto.UserDTO,
+	sanctionID int,
+	message string,
+) (*dto.SanctionDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "SanctionService.Appeal")
+	defer span.End()
+
+	return s.sanctionRepository.Appeal(ctx, user.ID, sanctionID, message)
+}
+
+func (s *SanctionService) ResolveAppeal(
+	ctx context.Context,
+	performer *dto.UserDTO,
+	sanctionID int,
+	accepted bool,
+	comment *string,
+) (*dto.SanctionDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "SanctionService.ResolveAppeal")
+	defer span.End()
+
+	before, err := s.sanctionRepository.FindByID(ctx, sanctionID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.sanctionRepository.ResolveAppeal(ctx, sanctionID, performer.ID, accepted, comment)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditLogger.Record(
+		ctx,
+		performer,
+		dto.AuditActionSanctionAppealResolve,
+		dto.NewAuditTarget(dto.AuditTargetSanction, sanctionID),
+		before,
+		result.SanctionDTO,
+	)
+
+	s.notify(
+		ctx,
+		result,
+		websocketmessage.NewSanctionAppealResolvedMessage(uuid.NewString(), performer.Username, result.SanctionDTO),
+		mailmessage.NewSanctionAppealResolvedMessage(result.User.Username, result.SanctionDTO),
+	)
+
+	return result.SanctionDTO, nil
+}
+
+func (s *SanctionService) FindByUserID(ctx context.Context, userID int) ([]*dto.SanctionDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "SanctionService.FindByUserID")
+	defer span.End()
+
+	return s.sanctionRepository.FindByUserID(ctx, userID)
+}
+
+func (s *SanctionService) FindPendingAppealsPaged(
+	ctx context.Context,
+	page, size int,
+) (*dto.PaginatedResult[*dto.SanctionDTO], error) {
+	ctx, span := tracer.StartSpan(ctx, "SanctionService.FindPendingAppealsPaged")
+	defer span.End()
+
+	return s.sanctionRepository.FindPendingAppealsPaged(ctx, page, size)
+}
+
+// notify sends websocket and email notices, the sanction is already applied so failures are only logged.
+func (s *SanctionService) notify(
+	ctx context.Context,
+	result *dto.SanctionResultDTO,
+	websocketMessage *websocketmessage.SanctionMessage,
+	mailMessage *mailmessage.Message,
+) {
+	ctx, span := tracer.StartSpan(ctx, "SanctionService.notify")
+	defer span.End()
+
+	err := s.notificationService.SendToUser(ctx, result.UserID, websocketMessage)
+	if err != nil {
+		logger.Log.Debugw("failed to send sanction notice", "error", err, "userID", result.UserID)
+	}
+
+	if result.User.Email == nil {
+		return
+	}
+
+	err = s.mailSender.Send(ctx, mailMessage, *result.User.Email)
+	if err != nil {
+		logger.Log.Warnw("failed to send sanction mail", "error", err, "userID", result.UserID)
+	}
+}
+
+// newIssueSanction computes the next ladder level from the user block state.
+// Block state is kept after the block ends and is cleared by decrement on login,
+// so while it is set the previous sanction is counted and the level is escalated.
+func newIssueSanction(user *dto.UserDTO, sanctionType dto.SanctionType, now time.Time) *dto.IssueSanctionDTO {
+	issue := &dto.IssueSanctionDTO{
+		UserID: user.ID,
+		Type:   sanctionType,
+	}
+
+	var duration time.Duration
+
+	switch sanctionType {
+	case dto.SanctionSearch:
+		level := userentity.SearchBlockLevel(user.SearchBlockedLevel)
+		if user.SearchBlockedUntil != nil {
+			level = level.Next()
+		}
+
+		issue.Level = int(level)
+		issue.PreviousLevel = user.SearchBlockedLevel
+		issue.PreviousBlockedUntil = user.SearchBlockedUntil
+		duration = level.Duration()
+	default:
+		level := userentity.AccountBlockLevel(user.AccountBlockedLevel)
+		if user.AccountBlockedUntil != nil {
+			level = level.Next()
+		}
+
+		issue.Level = int(level)
+		issue.PreviousLevel = user.AccountBlockedLevel
+		issue.PreviousBlockedUntil = user.AccountBlockedUntil
+		duration = level.Duration()
+	}
+
+	issue.IssuedAt = now
+	issue.BlockedUntil = now.Add(duration)
+
+	return issue
+}
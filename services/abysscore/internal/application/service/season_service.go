@@ -0,0 +1,359 @@
+package applicationservice
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/dto/request"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/seasonentity"
+	repositoryports "github.com/intezya/abyssleague/services/abysscore/internal/domain/repository"
+	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/websocketmessage"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/intezya/pkglib/logger"
+)
+
+var (
+	errSeasonEndsBeforeStart = errors.New("ends_at must be after starts_at")
+	errDuplicateTierLevel    = errors.New("tier levels must be unique")
+	errTierXPNotIncreasing   = errors.New("xp_required must increase with tier level")
+	errTierHasNoReward       = errors.New("tier has no reward on this track")
+	errTierItemArchived      = errors.New("tier reward item is archived")
+)
+
+type SeasonService struct {
+	seasonRepository    repositoryports.SeasonRepository
+	gameItemRepository  repositoryports.GameItemRepository
+	notificationService domainservice.NotificationService
+	collectionService   domainservice.CollectionService
+	auditLogger         domainservice.AuditLogger
+}
+
+func NewSeasonService(
+	seasonRepository repositoryports.SeasonRepository,
+	gameItemRepository repositoryports.GameItemRepository,
+	notificationService domainservice.NotificationService,
+	collectionService domainservice.CollectionService,
+	auditLogger domainservice.AuditLogger,
+) *SeasonService {
+	return &SeasonService{
+		seasonRepository:    seasonRepository,
+		gameItemRepository:  gameItemRepository,
+		notificationService: notificationService,
+		collectionService:   collectionService,
+		auditLogger:         auditLogger,
+	}
+}
+
+func (s *SeasonService) Create(
+	ctx context.Context,
+	request *request.CreateSeason,
+	performer *dto.UserDTO,
+) (*dto.SeasonDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "SeasonService.Create")
+	defer span.End()
+
+	season := request.ToDTO()
+
+	if !season.EndsAt.After(season.StartsAt) {
+		return nil, apperrors.WrapBadRequest(errSeasonEndsBeforeStart)
+	}
+
+	err := s.validateTiers(ctx, season.Tiers)
+	if err != nil {
+		return nil, err
+	}
+
+	overlaps, err := s.seasonRepository.ExistsOverlapping(ctx, season.StartsAt, season.EndsAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if overlaps {
+		return nil, apperrors.ErrSeasonOverlaps
+	}
+
+	created, err := s.seasonRepository.Create(ctx, season)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditLogger.Record(
+		ctx,
+		performer,
+		dto.AuditActionSeasonCreate,
+		dto.NewAuditTarget(dto.AuditTargetSeason, created.ID),
+		nil,
+		created,
+	)
+
+	return created, nil
+}
+
+func (s *SeasonService) FindCurrent(ctx context.Context) (*dto.SeasonDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "SeasonService.FindCurrent")
+	defer span.End()
+
+	return s.seasonRepository.FindActive(ctx, time.Now())
+}
+
+func (s *SeasonService) FindPassByUserID(ctx context.Context, userID int) (*dto.SeasonPassDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "SeasonService.FindPassByUserID")
+	defer span.End()
+
+	season, err := s.seasonRepository.FindActive(ctx, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.seasonRepository.FindPass(ctx, userID, season)
+}
+
+func (s *SeasonService) Claim(
+	ctx context.Context,
+	user *dto.UserDTO,
+	request *request.ClaimSeasonReward,
+) (*dto.SeasonRewardClaimDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "SeasonService.Claim")
+	defer span.End()
+
+	track := seasonentity.Track(request.Track)
+
+	// Rewards of ended seasons are granted automatically on rollover.
+	season, err := s.seasonRepository.FindActive(ctx, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	tier := season.Tier(request.Level)
+	if tier == nil {
+		return nil, apperrors.WrapSeasonTierNotFound(nil)
+	}
+
+	if !tier.HasReward(track) {
+		return nil, apperrors.WrapBadRequest(errTierHasNoReward)
+	}
+
+	pass, err := s.seasonRepository.FindPass(ctx, user.ID, season)
+	if err != nil {
+		return nil, err
+	}
+
+	if pass.XP < tier.XPRequired {
+		return nil, apperrors.ErrSeasonTierNotReached
+	}
+
+	if track == seasonentity.TrackPremium && !pass.Premium {
+		return nil, apperrors.ErrSeasonPremiumTrackLocked
+	}
+
+	claim, granted, err := s.seasonRepository.Claim(ctx, user.ID, season.ID, tier, track, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if granted && claim.Item != nil {
+		s.collectionService.HandleItemGranted(ctx, user.ID, claim.Item)
+	}
+
+	return claim, nil
+}
+
+func (s *SeasonService) PurchasePremium(
+	ctx context.Context,
+	user *dto.UserDTO,
+) (*dto.SeasonPassDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "SeasonService.PurchasePremium")
+	defer span.End()
+
+	season, err := s.seasonRepository.FindActive(ctx, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.seasonRepository.PurchasePremium(ctx, user.ID, season)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.seasonRepository.FindPass(ctx, user.ID, season)
+}
+
+func (s *SeasonService) HandleLoginStreak(ctx context.Context, userID int, streak int) {
+	ctx, span := tracer.StartSpan(ctx, "SeasonService.HandleLoginStreak")
+	defer span.End()
+
+	s.addXP(ctx, userID, seasonentity.LoginStreakXP(streak))
+}
+
+func (s *SeasonService) RunRolloverLoop(ctx context.Context) {
+	ticker := time.NewTicker(seasonentity.RolloverCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		s.rolloverEnded(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *SeasonService) addXP(ctx context.Context, userID int, xp int) {
+	season, err := s.seasonRepository.FindActive(ctx, time.Now())
+	if err != nil {
+		logger.Log.Debugln("no active season to add xp:", err)
+
+		return
+	}
+
+	_, err = s.seasonRepository.AddXP(ctx, userID, season.Number, xp)
+	if err != nil {
+		logger.Log.Warnw("failed to add season xp", "error", err, "userID", userID)
+	}
+}
+
+func (s *SeasonService) rolloverEnded(ctx context.Context) {
+	ctx, span := tracer.StartSpan(ctx, "SeasonService.rolloverEnded")
+	defer span.End()
+
+	seasons, err := s.seasonRepository.FindEndedNotRolledOver(ctx, time.Now())
+	if err != nil {
+		logger.Log.Warnln("failed to find ended seasons:", err)
+
+		return
+	}
+
+	for _, season := range seasons {
+		s.rollover(ctx, season)
+	}
+}
+
+// rollover grants unclaimed rewards of ended season. Season is marked as rolled over only
+// if every user has been processed, otherwise it is retried on the next tick.
+func (s *SeasonService) rollover(ctx context.Context, season *dto.SeasonDTO) {
+	tracer.AddAttribute(ctx, "season_id", season.ID)
+
+	userIDs, err := s.seasonRepository.FindUserIDsWithXP(ctx, season.Number)
+	if err != nil {
+		logger.Log.Warnln("failed to find season participants:", season.ID, err)
+
+		return
+	}
+
+	failed := 0
+
+	for _, userID := range userIDs {
+		err = s.grantUnclaimed(ctx, season, userID)
+		if err != nil {
+			logger.Log.Warnw("failed to grant unclaimed season rewards", "error", err, "userID", userID)
+
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return
+	}
+
+	err = s.seasonRepository.MarkRolledOver(ctx, season.ID)
+	if err != nil {
+		logger.Log.Warnln("failed to mark season as rolled over:", season.ID, err)
+	}
+}
+
+func (s *SeasonService) grantUnclaimed(ctx context.Context, season *dto.SeasonDTO, userID int) error {
+	pass, err := s.seasonRepository.FindPass(ctx, userID, season)
+	if err != nil {
+		return err
+	}
+
+	tracks := []seasonentity.Track{seasonentity.TrackFree}
+	if pass.Premium {
+		tracks = append(tracks, seasonentity.TrackPremium)
+	}
+
+	granted := make([]*dto.SeasonRewardClaimDTO, 0)
+
+	for _, tier := range season.Tiers {
+		if tier.Level > pass.Level {
+			break
+		}
+
+		for _, track := range tracks {
+			if !tier.HasReward(track) || pass.IsClaimed(tier.Level, track) {
+				continue
+			}
+
+			claim, isGranted, err := s.seasonRepository.Claim(ctx, userID, season.ID, tier, track, true)
+			if err != nil {
+				return err
+			}
+
+			if !isGranted {
+				continue
+			}
+
+			granted = append(granted, claim)
+
+			if claim.Item != nil {
+				s.collectionService.HandleItemGranted(ctx, userID, claim.Item)
+			}
+		}
+	}
+
+	if len(granted) == 0 {
+		return nil
+	}
+
+	eventID := uuid.NewString()
+
+	message := websocketmessage.NewSeasonRewardsGrantedMessage(eventID, season, granted)
+
+	err = s.notificationService.SendToUser(ctx, userID, message)
+	if err != nil {
+		logger.Log.Warnln("failed to send message to user:", err)
+	}
+
+	return nil
+}
+
+func (s *SeasonService) validateTiers(ctx context.Context, tiers []*dto.SeasonTierDTO) error {
+	slices.SortFunc(tiers, func(a, b *dto.SeasonTierDTO) int {
+		return a.Level - b.Level
+	})
+
+	for i, tier := range tiers {
+		if i > 0 && tiers[i-1].Level == tier.Level {
+			return apperrors.WrapBadRequest(errDuplicateTierLevel)
+		}
+
+		if i > 0 && tiers[i-1].XPRequired >= tier.XPRequired {
+			return apperrors.WrapBadRequest(errTierXPNotIncreasing)
+		}
+
+		for _, itemID := range []*int{tier.FreeItemID, tier.PremiumItemID} {
+			if itemID == nil {
+				continue
+			}
+
+			item, err := s.gameItemRepository.FindByID(ctx, *itemID)
+			if err != nil {
+				return err // not found
+			}
+
+			if item.ArchivedAt != nil {
+				return apperrors.WrapBadRequest(errTierItemArchived)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package applicationservice
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/grpc/clients"
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/mapper"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity"
+	repositoryports "github.com/intezya/abyssleague/services/abysscore/internal/domain/repository"
+	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/intezya/pkglib/itertools"
+	"github.com/intezya/pkglib/logger"
+)
+
+type SessionService struct {
+	sessionRepo      repositoryports.SessionRepository
+	refreshTokenRepo repositoryports.RefreshTokenRepository
+	tokenHelper      domainservice.TokenHelper
+	websocketClient  clients.WebsocketMessagingClient
+}
+
+func NewSessionService(
+	sessionRepo repositoryports.SessionRepository,
+	refreshTokenRepo repositoryports.RefreshTokenRepository,
+	tokenHelper domainservice.TokenHelper,
+	websocketClient clients.WebsocketMessagingClient,
+) *SessionService {
+	return &SessionService{
+		sessionRepo:      sessionRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		tokenHelper:      tokenHelper,
+		websocketClient:  websocketClient,
+	}
+}
+
+// FindByUserID returns sessions ordered by last activity, most recent first.
+func (s *SessionService) FindByUserID(
+	ctx context.Context,
+	userID int,
+	currentSessionID string,
+) ([]*dto.SessionDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "SessionService.FindByUserID")
+	defer span.End()
+
+	sessions, err := s.sessionRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(sessions, func(a, b *entity.SessionData) int {
+		return b.LastActivityAt.Compare(a.LastActivityAt)
+	})
+
+	return itertools.Map(sessions, func(session *entity.SessionData) *dto.SessionDTO {
+		return mapper.ToSessionDTOFromEntity(session, currentSessionID)
+	}), nil
+}
+
+func (s *SessionService) End(ctx context.Context, userID int, sessionID string) error {
+	ctx, span := tracer.StartSpan(ctx, "SessionService.End")
+	defer span.End()
+
+	session, err := s.sessionRepo.FindByID(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+
+	if session.UserID != userID { // don't reveal sessions of other users
+		return apperrors.WrapSessionNotFound(nil)
+	}
+
+	return s.end(ctx, userID, []string{sessionID})
+}
+
+func (s *SessionService) EndOthers(ctx context.Context, userID int, currentSessionID string) error {
+	ctx, span := tracer.StartSpan(ctx, "SessionService.EndOthers")
+	defer span.End()
+
+	sessions, err := s.sessionRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	sessionIDs := make([]string, 0, len(sessions))
+
+	for _, session := range sessions {
+		if session.ID != currentSessionID {
+			sessionIDs = append(sessionIDs, session.ID)
+		}
+	}
+
+	return s.end(ctx, userID, sessionIDs)
+}
+
+func (s *SessionService) EndAll(ctx context.Context, userID int) error {
+	ctx, span := tracer.StartSpan(ctx, "SessionService.EndAll")
+	defer span.End()
+
+	return s.EndOthers(ctx, userID, "")
+}
+
+func (s *SessionService) ReissueAccessTokens(
+	ctx context.Context,
+	user *dto.UserDTO,
+	currentSessionID string,
+) (string, error) {
+	ctx, span := tracer.StartSpan(ctx, "SessionService.ReissueAccessTokens")
+	defer span.End()
+
+	// Token issued at time is stored in seconds, so the new token issued within the same second stays valid
+	issuedBefore := time.Now().Truncate(time.Second)
+
+	err := s.refreshTokenRepo.MarkUserTokensStale(ctx, user.ID, issuedBefore, s.tokenHelper.AccessTokenLifetime())
+	if err != nil {
+		return "", err
+	}
+
+	sessions, err := s.sessionRepo.FindByUserID(ctx, user.ID)
+	if err != nil {
+		return "", err
+	}
+
+	// Websocket connections keep user data of the token they are opened with
+	sessionIDs := itertools.Map(sessions, func(session *entity.SessionData) string { return session.ID })
+
+	err = s.websocketClient.DisconnectSessions(ctx, user.ID, sessionIDs)
+	if err != nil {
+		logger.Log.Debugw("sessions with stale tokens are not disconnected", "error", err, "userID", user.ID)
+	}
+
+	var hardwareID string
+	if user.HardwareID != nil {
+		hardwareID = *user.HardwareID
+	}
+
+	return s.tokenHelper.TokenGenerator(&entity.TokenData{
+		ID:         user.ID,
+		Username:   user.Username,
+		HardwareID: hardwareID,
+		FamilyID:   currentSessionID,
+	}), nil
+}
+
+// end revokes refresh token families first, so ended sessions can't be refreshed
+// even if websocket disconnect fails.
+func (s *SessionService) end(ctx context.Context, userID int, sessionIDs []string) error {
+	if len(sessionIDs) == 0 {
+		return nil
+	}
+
+	for _, sessionID := range sessionIDs {
+		err := s.refreshTokenRepo.RevokeFamily(ctx, sessionID, s.tokenHelper.AccessTokenLifetime())
+		if err != nil {
+			return err
+		}
+	}
+
+	err := s.sessionRepo.Delete(ctx, userID, sessionIDs...)
+	if err != nil {
+		return err
+	}
+
+	err = s.websocketClient.DisconnectSessions(ctx, userID, sessionIDs)
+	if err != nil {
+		logger.Log.Debugw("ended sessions are not disconnected", "error", err, "userID", userID)
+	}
+
+	return nil
+}
@@ -0,0 +1,281 @@
+package applicationservice
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	repositoryports "github.com/intezya/abyssleague/services/abysscore/internal/domain/repository"
+	domainservice "github.com/intezya/abyssleague/services/abysscore/internal/domain/service"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/intezya/pkglib/logger"
+)
+
+const (
+	twoFactorEnrollmentTTL = 10 * time.Minute
+	recoveryCodesCount     = 10
+
+	twoFactorMaxFailures    = 5
+	twoFactorFailuresWindow = 15 * time.Minute
+)
+
+type TwoFactorService struct {
+	userRepo          repositoryports.UserRepository
+	twoFactorRepo     repositoryports.TwoFactorRepository
+	twoFactorCache    repositoryports.TwoFactorCacheRepository
+	credentialsHelper domainservice.CredentialsHelper
+	totpHelper        domainservice.TOTPHelper
+}
+
+func NewTwoFactorService(
+	userRepo repositoryports.UserRepository,
+	twoFactorRepo repositoryports.TwoFactorRepository,
+	twoFactorCache repositoryports.TwoFactorCacheRepository,
+	credentialsHelper domainservice.CredentialsHelper,
+	totpHelper domainservice.TOTPHelper,
+) *TwoFactorService {
+	return &TwoFactorService{
+		userRepo:          userRepo,
+		twoFactorRepo:     twoFactorRepo,
+		twoFactorCache:    twoFactorCache,
+		credentialsHelper: credentialsHelper,
+		totpHelper:        totpHelper,
+	}
+}
+
+// Enroll generates a new secret, it is kept in cache until enrollment is confirmed with a code.
+func (s *TwoFactorService) Enroll(ctx context.Context, user *dto.UserDTO) (*dto.TOTPEnrollmentDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "TwoFactorService.Enroll")
+	defer span.End()
+
+	if user.TOTPSecret != nil {
+		return nil, apperrors.ErrTwoFactorAlreadyEnabled
+	}
+
+	secret := s.totpHelper.GenerateTOTPSecret()
+
+	err := s.twoFactorCache.SavePendingSecret(
+		ctx,
+		user.ID,
+		s.credentialsHelper.EncodeTOTPSecret(secret),
+		twoFactorEnrollmentTTL,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.TOTPEnrollmentDTO{
+		Secret:          secret,
+		ProvisioningURI: s.totpHelper.TOTPProvisioningURI(secret, user.Username),
+	}, nil
+}
+
+// ConfirmEnrollment enables two-factor authentication if code matches the pending secret.
+func (s *TwoFactorService) ConfirmEnrollment(
+	ctx context.Context,
+	user *dto.UserDTO,
+	code string,
+) (*dto.RecoveryCodesDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "TwoFactorService.ConfirmEnrollment")
+	defer span.End()
+
+	encryptedSecret, err := s.twoFactorCache.GetPendingSecret(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.verify(ctx, user.ID, encryptedSecret, code, false)
+	if err != nil {
+		return nil, err
+	}
+
+	recoveryCodes, recoveryCodeHashes := s.generateRecoveryCodes()
+
+	err = s.twoFactorRepo.Enable(ctx, user.ID, encryptedSecret, recoveryCodeHashes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.twoFactorCache.DeletePendingSecret(ctx, user.ID); err != nil {
+		logger.Log.Debugw("failed to delete pending totp secret", "error", err, "userID", user.ID)
+	}
+
+	return recoveryCodes, nil
+}
+
+func (s *TwoFactorService) Disable(ctx context.Context, user *dto.UserDTO, code string) error {
+	ctx, span := tracer.StartSpan(ctx, "TwoFactorService.Disable")
+	defer span.End()
+
+	err := s.challengeEnrolled(ctx, user.ID, code)
+	if err != nil {
+		return err
+	}
+
+	return s.twoFactorRepo.Disable(ctx, user.ID)
+}
+
+// RegenerateRecoveryCodes replaces all recovery codes, including unused ones.
+func (s *TwoFactorService) RegenerateRecoveryCodes(
+	ctx context.Context,
+	user *dto.UserDTO,
+	code string,
+) (*dto.RecoveryCodesDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "TwoFactorService.RegenerateRecoveryCodes")
+	defer span.End()
+
+	err := s.challengeEnrolled(ctx, user.ID, code)
+	if err != nil {
+		return nil, err
+	}
+
+	recoveryCodes, recoveryCodeHashes := s.generateRecoveryCodes()
+
+	err = s.twoFactorRepo.ReplaceRecoveryCodes(ctx, user.ID, recoveryCodeHashes)
+	if err != nil {
+		return nil, err
+	}
+
+	return recoveryCodes, nil
+}
+
+func (s *TwoFactorService) Challenge(ctx context.Context, user *dto.UserDTO, code string) error {
+	ctx, span := tracer.StartSpan(ctx, "TwoFactorService.Challenge")
+	defer span.End()
+
+	if user.TOTPSecret == nil {
+		return nil
+	}
+
+	if code == "" {
+		return apperrors.ErrTwoFactorRequired
+	}
+
+	return s.verify(ctx, user.ID, *user.TOTPSecret, code, true)
+}
+
+func (s *TwoFactorService) ChallengeByUserID(ctx context.Context, userID int, code string) error {
+	ctx, span := tracer.StartSpan(ctx, "TwoFactorService.ChallengeByUserID")
+	defer span.End()
+
+	user, err := s.userRepo.FindDTOById(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	return s.Challenge(ctx, user, code)
+}
+
+// challengeEnrolled is Challenge for actions that make sense only with enabled two-factor authentication.
+func (s *TwoFactorService) challengeEnrolled(ctx context.Context, userID int, code string) error {
+	ctx, span := tracer.StartSpan(ctx, "TwoFactorService.challengeEnrolled")
+	defer span.End()
+
+	user, err := s.userRepo.FindDTOById(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if user.TOTPSecret == nil {
+		return apperrors.ErrTwoFactorNotEnabled
+	}
+
+	return s.Challenge(ctx, user, code)
+}
+
+// verify checks TOTP code (or recovery code if allowed) and counts wrong attempts.
+func (s *TwoFactorService) verify(
+	ctx context.Context,
+	userID int,
+	encryptedSecret string,
+	code string,
+	allowRecoveryCode bool,
+) error {
+	ctx, span := tracer.StartSpan(ctx, "TwoFactorService.verify")
+	defer span.End()
+
+	failures, err := s.twoFactorCache.GetFailures(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if failures >= twoFactorMaxFailures {
+		return apperrors.TooManyTwoFactorAttempts
+	}
+
+	ok, err := s.verifyTOTP(ctx, userID, encryptedSecret, code)
+	if err != nil {
+		return err
+	}
+
+	if !ok && allowRecoveryCode {
+		ok, err = s.twoFactorRepo.UseRecoveryCode(
+			ctx,
+			userID,
+			s.credentialsHelper.HashToken(strings.ToUpper(code)),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ok {
+		if err := s.twoFactorCache.ResetFailures(ctx, userID); err != nil {
+			logger.Log.Debugw("failed to reset two-factor failures", "error", err, "userID", userID)
+		}
+
+		return nil
+	}
+
+	failures, err = s.twoFactorCache.IncrementFailures(ctx, userID, twoFactorFailuresWindow)
+	if err != nil {
+		return err
+	}
+
+	if failures >= twoFactorMaxFailures {
+		return apperrors.TooManyTwoFactorAttempts
+	}
+
+	return apperrors.ErrWrongTwoFactorCode
+}
+
+// verifyTOTP accepts each code only once, so intercepted code can't be replayed.
+func (s *TwoFactorService) verifyTOTP(
+	ctx context.Context,
+	userID int,
+	encryptedSecret string,
+	code string,
+) (bool, error) {
+	ctx, span := tracer.StartSpan(ctx, "TwoFactorService.verifyTOTP")
+	defer span.End()
+
+	secret, err := s.credentialsHelper.DecodeTOTPSecret(encryptedSecret)
+	if err != nil {
+		return false, apperrors.WrapUnexpectedError(err)
+	}
+
+	step, ok := s.totpHelper.ValidateTOTP(secret, code, time.Now())
+	if !ok {
+		return false, nil
+	}
+
+	const usedStepTTLPeriods = 3 // the step can be accepted in the previous, current and next periods
+
+	return s.twoFactorCache.MarkStepUsed(ctx, userID, step, usedStepTTLPeriods*s.totpHelper.TOTPPeriod())
+}
+
+func (s *TwoFactorService) generateRecoveryCodes() (*dto.RecoveryCodesDTO, []string) {
+	codes := make([]string, 0, recoveryCodesCount)
+	hashes := make([]string, 0, recoveryCodesCount)
+
+	for range recoveryCodesCount {
+		code := s.totpHelper.GenerateRecoveryCode()
+
+		codes = append(codes, code)
+		hashes = append(hashes, s.credentialsHelper.HashToken(code))
+	}
+
+	return &dto.RecoveryCodesDTO{Codes: codes}, hashes
+}
@@ -0,0 +1,41 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/apiscope"
+)
+
+type APITokenDTO struct {
+	ID          int              `json:"id"`
+	UserID      int              `json:"-"`
+	Name        string           `json:"name"         example:"tournament bot"`
+	TokenPrefix string           `json:"token_prefix" example:"abl_Xq3k9Z"`
+	Scopes      []apiscope.Scope `json:"scopes"       example:"read:matches"`
+	ExpiresAt   time.Time        `json:"expires_at"`
+	LastUsedAt  *time.Time       `json:"last_used_at"`
+	CreatedAt   time.Time        `json:"created_at"`
+}
+
+// IssuedAPITokenDTO contains the token itself, it is shown only once after creation.
+type IssuedAPITokenDTO struct {
+	Token    string       `json:"token"     example:"abl_Xq3k9Zt0cW1o2m9Hs7Zp4AJb0p0tWm3qK8ZyQe2Xh1vA"`
+	APIToken *APITokenDTO `json:"api_token"`
+}
+
+type IssueAPITokenDTO struct {
+	Name          string
+	Scopes        []apiscope.Scope
+	LifetimeDays  int
+	Password      string
+	TwoFactorCode string
+}
+
+type CreateAPITokenDTO struct {
+	UserID      int
+	Name        string
+	TokenHash   string
+	TokenPrefix string
+	Scopes      []apiscope.Scope
+	ExpiresAt   time.Time
+}
@@ -0,0 +1,95 @@
+package dto
+
+import (
+	"strconv"
+	"time"
+)
+
+type AuditAction string
+
+const (
+	AuditActionGameItemCreate  AuditAction = "game_item.create"
+	AuditActionGameItemUpdate  AuditAction = "game_item.update"
+	AuditActionGameItemArchive AuditAction = "game_item.archive"
+	AuditActionGameItemRestore AuditAction = "game_item.restore"
+
+	AuditActionInventoryItemGrant  AuditAction = "inventory_item.grant"
+	AuditActionInventoryItemRevoke AuditAction = "inventory_item.revoke"
+	AuditActionGrantJobStart       AuditAction = "grant_job.start"
+
+	AuditActionCollectionRewardSet AuditAction = "collection_reward.set"
+	AuditActionSeasonCreate        AuditAction = "season.create"
+
+	AuditActionUserEmailChange AuditAction = "user.email_change"
+
+	AuditActionSanctionIssue         AuditAction = "sanction.issue"
+	AuditActionSanctionLift          AuditAction = "sanction.lift"
+	AuditActionSanctionAppealResolve AuditAction = "sanction.appeal_resolve"
+
+	AuditActionHardwareIDReset        AuditAction = "hardware_id.reset"
+	AuditActionHardwareIDResetApprove AuditAction = "hardware_id.reset_approve"
+	AuditActionHardwareIDResetReject  AuditAction = "hardware_id.reset_reject"
+	AuditActionHardwareIDBan          AuditAction = "hardware_id.ban"
+	AuditActionHardwareIDUnban        AuditAction = "hardware_id.unban"
+
+	AuditActionRoleCreate   AuditAction = "role.create"
+	AuditActionRoleUpdate   AuditAction = "role.update"
+	AuditActionRoleDelete   AuditAction = "role.delete"
+	AuditActionUserRolesSet AuditAction = "user.roles_set"
+)
+
+type AuditTargetType string
+
+const (
+	AuditTargetGameItem         AuditTargetType = "game_item"
+	AuditTargetInventoryItem    AuditTargetType = "inventory_item"
+	AuditTargetGrantJob         AuditTargetType = "grant_job"
+	AuditTargetCollectionReward AuditTargetType = "collection_reward"
+	AuditTargetSeason           AuditTargetType = "season"
+	AuditTargetUser             AuditTargetType = "user"
+	AuditTargetSanction         AuditTargetType = "sanction"
+	AuditTargetHardwareIDReset  AuditTargetType = "hardware_id_reset"
+	AuditTargetBannedHardwareID AuditTargetType = "banned_hardware_id"
+	AuditTargetRole             AuditTargetType = "role"
+)
+
+type AuditTarget struct {
+	Type AuditTargetType
+	// ID is string, because some targets (e.g. collections) are identified by name
+	ID *string
+}
+
+func NewAuditTarget(targetType AuditTargetType, id int) AuditTarget {
+	formatted := strconv.Itoa(id)
+
+	return AuditTarget{Type: targetType, ID: &formatted}
+}
+
+func NewAuditTargetByName(targetType AuditTargetType, name string) AuditTarget {
+	return AuditTarget{Type: targetType, ID: &name}
+}
+
+type AuditLogDTO struct {
+	ID            int             `json:"id"`
+	ActorID       int             `json:"actor_id"`
+	ActorUsername string          `json:"actor_username"`
+	Action        AuditAction     `json:"action"`
+	TargetType    AuditTargetType `json:"target_type"`
+	TargetID      *string         `json:"target_id"`
+	Before        map[string]any  `json:"before"`
+	After         map[string]any  `json:"after"`
+	RequestID     *string         `json:"request_id"`
+	IP            *string         `json:"ip"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+type CreateAuditLogDTO struct {
+	ActorID       int
+	ActorUsername string
+	Action        AuditAction
+	Target        AuditTarget
+	Before        map[string]any
+	After         map[string]any
+	RequestID     *string
+	IP            *string
+}
@@ -1,17 +1,14 @@
 package dto
 
-// ChangePasswordDTO holds data for password change requests.
-type ChangePasswordDTO struct {
-	Username    string
-	OldPassword string
-	NewPassword string
-}
-
 // CredentialsDTO holds user authentication input data.
 type CredentialsDTO struct {
 	Username   string
 	Password   string
 	HardwareID string
+	// HardwareFingerprint is set together with encoding of HardwareID
+	HardwareFingerprint string
+	// TwoFactorCode is TOTP or recovery code, required on login if user has enabled two-factor authentication
+	TwoFactorCode string
 }
 
 func NewCredentialsDTO(username string, password string, hardwareID string) *CredentialsDTO {
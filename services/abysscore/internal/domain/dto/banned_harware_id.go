@@ -5,7 +5,15 @@ import (
 )
 
 type BannedHardwareID struct {
-	ID        int       `json:"id"`
-	CreatedAt time.Time `json:"created_at"`
-	BanReason *string   `json:"ban_reason"`
+	ID          int       `json:"id"`
+	HardwareID  string    `json:"hardware_id"`
+	CreatedAt   time.Time `json:"created_at"`
+	BanReason   *string   `json:"ban_reason"`
+	PerformerID *int      `json:"performer_id"`
+}
+
+type BanHardwareIDResultDTO struct {
+	Ban *BannedHardwareID `json:"ban"`
+	// KickedUserIDs are accounts bound to the banned hardware id, their sessions were ended
+	KickedUserIDs []int `json:"kicked_user_ids"`
 }
@@ -0,0 +1,30 @@
+package dto
+
+import (
+	"time"
+)
+
+type CollectionProgressDTO struct {
+	Collection   string         `json:"collection"`
+	Owned        int            `json:"owned"`
+	Total        int            `json:"total"`
+	Completed    bool           `json:"completed"`
+	MissingItems []*GameItemDTO `json:"missing_items"`
+}
+
+type CollectionRewardDTO struct {
+	Collection  string    `json:"collection"`
+	Coins       float64   `json:"coins"`
+	Title       *string   `json:"title"`
+	BadgeItemID *int      `json:"badge_item_id"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// CollectionCompletionDTO is a result of collection completion, Reward is nil if the collection has no reward.
+type CollectionCompletionDTO struct {
+	UserID      int                  `json:"-"`
+	Collection  string               `json:"collection"`
+	CompletedAt time.Time            `json:"completed_at"`
+	Reward      *CollectionRewardDTO `json:"reward"`
+	BadgeItem   *InventoryItemDTO    `json:"badge_item"`
+}
@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"time"
+)
+
+type EmailHistoryAction string
+
+const (
+	EmailLinked   EmailHistoryAction = "linked"
+	EmailChanged  EmailHistoryAction = "changed"
+	EmailUnlinked EmailHistoryAction = "unlinked"
+)
+
+// EmailChangeDTO describes replacement of OldEmail with NewEmail, nil NewEmail means unlinking.
+type EmailChangeDTO struct {
+	UserID      int
+	Action      EmailHistoryAction
+	OldEmail    *string
+	NewEmail    *string
+	PerformerID *int
+	Reason      *string
+}
+
+type EmailHistoryDTO struct {
+	ID          int                `json:"id"`
+	Action      EmailHistoryAction `json:"action"`
+	OldEmail    *string            `json:"old_email"`
+	NewEmail    *string            `json:"new_email"`
+	PerformerID *int               `json:"performer_id"`
+	Reason      *string            `json:"reason"`
+	CreatedAt   time.Time          `json:"created_at"`
+}
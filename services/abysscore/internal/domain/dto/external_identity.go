@@ -0,0 +1,41 @@
+package dto
+
+import (
+	"time"
+)
+
+type ExternalIdentityDTO struct {
+	ID          int        `json:"id"`
+	Provider    string     `json:"provider"      example:"google"`
+	Email       *string    `json:"email"         example:"intezya@gmail.com"`
+	CreatedAt   time.Time  `json:"created_at"`
+	LastLoginAt *time.Time `json:"last_login_at"`
+}
+
+type CreateExternalIdentityDTO struct {
+	UserID   int
+	Provider string
+	Subject  string
+	Email    *string
+}
+
+type OIDCAuthorizationDTO struct {
+	AuthorizationURL string `json:"authorization_url" example:"https://accounts.google.com/o/oauth2/v2/auth?response_type=code&..."`
+	State            string `json:"state"             example:"f3Yk1tq0cW1o2m9Hs7Zp4A"`
+}
+
+// OIDCCallbackDTO holds data the provider redirected user back with.
+type OIDCCallbackDTO struct {
+	Code       string
+	State      string
+	HardwareID string
+	// TwoFactorCode is TOTP or recovery code, required on login if user has enabled two-factor authentication
+	TwoFactorCode string
+}
+
+// OIDCRegistrationDTO is returned instead of tokens if the identity is not linked to any account,
+// the token is exchanged for a new account with the picked username.
+type OIDCRegistrationDTO struct {
+	RegistrationToken string `json:"registration_token" example:"Jb0p0tWm3qK8ZyQe2Xh1vA"`
+	SuggestedUsername string `json:"suggested_username" example:"intezya"`
+}
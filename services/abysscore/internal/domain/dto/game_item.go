@@ -5,10 +5,17 @@ import (
 )
 
 type GameItemDTO struct {
-	ID         int       `json:"id"`
-	Name       string    `json:"name"`
-	Collection string    `json:"collection"`
-	Type       int       `json:"type"`
-	Rarity     int       `json:"rarity"`
-	CreatedAt  time.Time `json:"created_at"`
+	ID         int        `json:"id"`
+	Name       string     `json:"name"`
+	Collection string     `json:"collection"`
+	Type       int        `json:"type"`
+	Rarity     int        `json:"rarity"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+}
+
+type ArchivedGameItemDTO struct {
+	*GameItemDTO
+
+	OwnersCount int `json:"owners_count"`
 }
@@ -0,0 +1,70 @@
+package dto
+
+import (
+	"time"
+)
+
+type GrantJobTarget string
+
+const (
+	GrantJobTargetUsers  GrantJobTarget = "users"
+	GrantJobTargetFilter GrantJobTarget = "filter"
+	GrantJobTargetOnline GrantJobTarget = "online"
+)
+
+type GrantJobStatus string
+
+const (
+	GrantJobStatusPending   GrantJobStatus = "pending"
+	GrantJobStatusRunning   GrantJobStatus = "running"
+	GrantJobStatusCompleted GrantJobStatus = "completed"
+	GrantJobStatusFailed    GrantJobStatus = "failed"
+)
+
+type GrantJobDTO struct {
+	ID          int            `json:"id"`
+	Campaign    string         `json:"campaign"`
+	ItemID      int            `json:"item_id"`
+	CreatedByID int            `json:"created_by_id"`
+	Target      GrantJobTarget `json:"target"`
+	Status      GrantJobStatus `json:"status"`
+
+	Total     int `json:"total"`
+	Processed int `json:"processed"`
+	Granted   int `json:"granted"`
+	Skipped   int `json:"skipped"`
+	Failed    int `json:"failed"`
+
+	Error      *string    `json:"error"`
+	CreatedAt  time.Time  `json:"created_at"`
+	FinishedAt *time.Time `json:"finished_at"`
+}
+
+type CreateGrantJobDTO struct {
+	Campaign    string
+	ItemID      int
+	CreatedByID int
+	Target      GrantJobTarget
+	Total       int
+	// Owner is the id of the instance running the job
+	Owner string
+}
+
+type GrantJobProgressDTO struct {
+	Processed int
+	Granted   int
+	Skipped   int
+	Failed    int
+}
+
+// UserFilterDTO selects users for bulk operations. Nil fields are not applied.
+type UserFilterDTO struct {
+	RegisteredBefore *time.Time
+	MinSearchScore   *int
+}
+
+// BulkGrantResultDTO is a result of granting an item to a batch of users.
+type BulkGrantResultDTO struct {
+	Granted []*InventoryItemDTO
+	Skipped int
+}
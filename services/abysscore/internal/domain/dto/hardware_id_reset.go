@@ -0,0 +1,48 @@
+package dto
+
+import (
+	"time"
+)
+
+type HardwareIDResetStatus string
+
+const (
+	HardwareIDResetPending  HardwareIDResetStatus = "pending"
+	HardwareIDResetApproved HardwareIDResetStatus = "approved"
+	HardwareIDResetRejected HardwareIDResetStatus = "rejected"
+)
+
+func (s HardwareIDResetStatus) IsValid() bool {
+	switch s {
+	case HardwareIDResetPending, HardwareIDResetApproved, HardwareIDResetRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+type HardwareIDResetDTO struct {
+	ID              int                   `json:"id"`
+	UserID          int                   `json:"user_id"`
+	Status          HardwareIDResetStatus `json:"status"`
+	Reason          *string               `json:"reason"`
+	OperatorID      *int                  `json:"operator_id"`
+	OperatorComment *string               `json:"operator_comment"`
+	CreatedAt       time.Time             `json:"created_at"`
+	ResolvedAt      *time.Time            `json:"resolved_at"`
+}
+
+// HardwareIDResetResultDTO is a resolved reset with the data required to notify the user.
+type HardwareIDResetResultDTO struct {
+	*HardwareIDResetDTO
+
+	User *UserDTO `json:"-"`
+}
+
+// HardwareIDResetRequestDTO is a self-service request, credentials are required because user can't log in.
+type HardwareIDResetRequestDTO struct {
+	Username      string
+	Password      string
+	TwoFactorCode string
+	Reason        *string
+}
@@ -24,3 +24,22 @@ type CreateInventoryItemDTO struct {
 	ItemID         int
 	ReceivedFromID int
 }
+
+// InventorySummaryDTO holds item counts of the (filtered) inventory.
+type InventorySummaryDTO struct {
+	ByRarity     map[int]int    `json:"by_rarity"`
+	ByCollection map[string]int `json:"by_collection"`
+}
+
+type PaginatedInventoryDTO struct {
+	*PaginatedResult[*InventoryItemDTO]
+
+	Summary *InventorySummaryDTO `json:"summary"`
+}
+
+// DismantleResultDTO is a result of converting inventory items into coins.
+type DismantleResultDTO struct {
+	Items   []*InventoryItemDTO `json:"items"`
+	Coins   float64             `json:"coins"`
+	Balance float64             `json:"balance"`
+}
@@ -0,0 +1,17 @@
+package dto
+
+// JSONWebKeySetDTO is a public JWT verification key set as defined in RFC 7517.
+type JSONWebKeySetDTO struct {
+	Keys []*JSONWebKeyDTO `json:"keys"`
+}
+
+type JSONWebKeyDTO struct {
+	KeyType   string `json:"kty"           example:"OKP"`
+	KeyID     string `json:"kid"           example:"1"`
+	Algorithm string `json:"alg"           example:"EdDSA"`
+	Use       string `json:"use"           example:"sig"`
+	Curve     string `json:"crv,omitempty" example:"Ed25519"`
+	X         string `json:"x,omitempty"   example:"11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"`
+	Modulus   string `json:"n,omitempty"`
+	Exponent  string `json:"e,omitempty"`
+}
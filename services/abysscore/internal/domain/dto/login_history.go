@@ -0,0 +1,32 @@
+package dto
+
+import (
+	"time"
+)
+
+// LoginAttemptDTO describes login request, HardwareFingerprint is a short hash of raw hardware id.
+type LoginAttemptDTO struct {
+	Username            string
+	IP                  string
+	UserAgent           string
+	HardwareFingerprint string
+}
+
+type CreateLoginHistoryDTO struct {
+	UserID              int
+	IP                  string
+	UserAgent           string
+	HardwareFingerprint string
+	HardwareIDMatch     bool
+	Success             bool
+}
+
+type LoginHistoryDTO struct {
+	ID                  int       `json:"id"`
+	IP                  string    `json:"ip"                   example:"203.0.113.7"`
+	UserAgent           string    `json:"user_agent"           example:"AbyssLeagueClient/1.4.2"`
+	HardwareFingerprint string    `json:"hardware_fingerprint" example:"9f86d081884c7d65"`
+	HardwareIDMatch     bool      `json:"hardware_id_match"`
+	Success             bool      `json:"success"`
+	CreatedAt           time.Time `json:"created_at"`
+}
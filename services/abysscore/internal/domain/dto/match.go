@@ -0,0 +1,25 @@
+package dto
+
+import (
+	"time"
+)
+
+// MatchDTO shows players by username, deleted accounts are shown with a placeholder username.
+type MatchDTO struct {
+	ID                 int                     `json:"id"`
+	Player1Username    string                  `json:"player1_username"     example:"intezya"`
+	Player2Username    string                  `json:"player2_username"     example:"deleted_42"`
+	Player1PenaltyTime int                     `json:"player1_penalty_time"`
+	Player2PenaltyTime int                     `json:"player2_penalty_time"`
+	Status             string                  `json:"status"               example:"finished"`
+	Result             *string                 `json:"result"               example:"player1_win"`
+	Results            []*PlayerMatchResultDTO `json:"results"`
+	CreatedAt          time.Time               `json:"created_at"`
+}
+
+type PlayerMatchResultDTO struct {
+	PlayerUsername string    `json:"player_username" example:"intezya"`
+	Score          int       `json:"score"           example:"420"`
+	IsRetried      bool      `json:"is_retried"`
+	CreatedAt      time.Time `json:"created_at"`
+}
@@ -0,0 +1,48 @@
+package dto
+
+import (
+	"time"
+)
+
+// PersonalDataDTO is a copy of all personal data of the user, each field is a separate file in ZIP export.
+type PersonalDataDTO struct {
+	ExportedAt        time.Time             `json:"exported_at"`
+	Profile           *UserDTO              `json:"profile"`
+	LinkedIdentifiers *LinkedIdentifiersDTO `json:"linked_identifiers"`
+	Statistics        []*StatisticDTO       `json:"statistics"`
+	Matches           []*MatchDTO           `json:"matches"`
+	Inventory         []*InventoryItemDTO   `json:"inventory"`
+	Friends           []*FriendDTO          `json:"friends"`
+	Balance           float64               `json:"balance"            example:"1200"`
+	UsernameHistory   []*UsernameHistoryDTO `json:"username_history"`
+	EmailHistory      []*EmailHistoryDTO    `json:"email_history"`
+	LoginHistory      []*LoginHistoryDTO    `json:"login_history"`
+}
+
+// LinkedIdentifiersDTO lists identifiers the account is bound to.
+// Raw hardware id is not exported, its fingerprint matches the one in login history.
+type LinkedIdentifiersDTO struct {
+	Email               *string                `json:"email"                example:"intezya@gmail.com"`
+	GenshinUID          *string                `json:"genshin_uid"          example:"700000001"`
+	HoyolabLogin        *string                `json:"hoyolab_login"        example:"intezya"`
+	HardwareFingerprint *string                `json:"hardware_fingerprint" example:"9f86d081884c7d65"`
+	ExternalIdentities  []*ExternalIdentityDTO `json:"external_identities"`
+}
+
+type FriendDTO struct {
+	ID       int    `json:"id"`
+	Username string `json:"username" example:"intezya"`
+}
+
+// AccountDeletionDTO describes scheduled account deletion.
+type AccountDeletionDTO struct {
+	ScheduledAt time.Time `json:"scheduled_at"`
+}
+
+// AccountErasureDTO replaces credentials of the erased account, so nobody can log in to it.
+type AccountErasureDTO struct {
+	UserID   int
+	Username string
+	Password string
+	ErasedAt time.Time
+}
@@ -0,0 +1,23 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/permission"
+)
+
+type RoleDTO struct {
+	ID          int                     `json:"id"`
+	Name        string                  `json:"name"`
+	Description *string                 `json:"description"`
+	Permissions []permission.Permission `json:"permissions"`
+	System      bool                    `json:"system"`
+	CreatedAt   time.Time               `json:"created_at"`
+	UpdatedAt   time.Time               `json:"updated_at"`
+}
+
+type SaveRoleDTO struct {
+	Name        string
+	Description *string
+	Permissions []permission.Permission
+}
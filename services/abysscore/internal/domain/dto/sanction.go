@@ -0,0 +1,75 @@
+package dto
+
+import (
+	"time"
+)
+
+type SanctionType string
+
+const (
+	SanctionAccount SanctionType = "account"
+	SanctionSearch  SanctionType = "search"
+)
+
+func (t SanctionType) IsValid() bool {
+	return t == SanctionAccount || t == SanctionSearch
+}
+
+type SanctionStatus string
+
+const (
+	SanctionActive SanctionStatus = "active"
+	// SanctionLifted means the block was ended early, the level is kept.
+	SanctionLifted SanctionStatus = "lifted"
+	// SanctionRevoked means the sanction was issued by mistake, the previous level is restored.
+	SanctionRevoked SanctionStatus = "revoked"
+)
+
+type SanctionAppealStatus string
+
+const (
+	SanctionAppealPending  SanctionAppealStatus = "pending"
+	SanctionAppealAccepted SanctionAppealStatus = "accepted"
+	SanctionAppealRejected SanctionAppealStatus = "rejected"
+)
+
+type SanctionDTO struct {
+	ID                int                   `json:"id"`
+	UserID            int                   `json:"user_id"`
+	Type              SanctionType          `json:"type"`
+	Level             int                   `json:"level"`
+	LevelName         string                `json:"level_name"`
+	PreviousLevel     int                   `json:"previous_level"`
+	Reason            string                `json:"reason"`
+	BlockedUntil      time.Time             `json:"blocked_until"`
+	PerformerID       int                   `json:"performer_id"`
+	Status            SanctionStatus        `json:"status"`
+	ResolverID        *int                  `json:"resolver_id"`
+	ResolutionComment *string               `json:"resolution_comment"`
+	ResolvedAt        *time.Time            `json:"resolved_at"`
+	AppealStatus      *SanctionAppealStatus `json:"appeal_status"`
+	AppealMessage     *string               `json:"appeal_message"`
+	AppealedAt        *time.Time            `json:"appealed_at"`
+	CreatedAt         time.Time             `json:"created_at"`
+}
+
+// IssueSanctionDTO is a sanction with precomputed level,
+// it is applied only if user block state still equals Previous* fields.
+type IssueSanctionDTO struct {
+	UserID               int
+	Type                 SanctionType
+	Level                int
+	PreviousLevel        int
+	PreviousBlockedUntil *time.Time
+	Reason               string
+	BlockedUntil         time.Time
+	PerformerID          int
+	IssuedAt             time.Time
+}
+
+// SanctionResultDTO is a sanction with the data required to notify the user.
+type SanctionResultDTO struct {
+	*SanctionDTO
+
+	User *UserDTO `json:"-"`
+}
@@ -0,0 +1,112 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/seasonentity"
+)
+
+type SeasonTierDTO struct {
+	Level      int `json:"level"`
+	XPRequired int `json:"xp_required"`
+
+	FreeItemID *int    `json:"free_item_id"`
+	FreeCoins  float64 `json:"free_coins"`
+
+	PremiumItemID *int    `json:"premium_item_id"`
+	PremiumCoins  float64 `json:"premium_coins"`
+}
+
+// HasReward reports whether tier grants anything on given track.
+func (t *SeasonTierDTO) HasReward(track seasonentity.Track) bool {
+	if track == seasonentity.TrackPremium {
+		return t.PremiumItemID != nil || t.PremiumCoins > 0
+	}
+
+	return t.FreeItemID != nil || t.FreeCoins > 0
+}
+
+// Reward returns item and coins granted by tier on given track.
+func (t *SeasonTierDTO) Reward(track seasonentity.Track) (*int, float64) {
+	if track == seasonentity.TrackPremium {
+		return t.PremiumItemID, t.PremiumCoins
+	}
+
+	return t.FreeItemID, t.FreeCoins
+}
+
+type SeasonDTO struct {
+	ID           int              `json:"id"`
+	Number       int              `json:"number"`
+	Name         string           `json:"name"`
+	StartsAt     time.Time        `json:"starts_at"`
+	EndsAt       time.Time        `json:"ends_at"`
+	PremiumPrice float64          `json:"premium_price"`
+	RolledOverAt *time.Time       `json:"rolled_over_at"`
+	Tiers        []*SeasonTierDTO `json:"tiers"`
+}
+
+// Tier returns tier by level or nil. Tiers are ordered by level.
+func (s *SeasonDTO) Tier(level int) *SeasonTierDTO {
+	for _, tier := range s.Tiers {
+		if tier.Level == level {
+			return tier
+		}
+	}
+
+	return nil
+}
+
+// LevelForXP returns the highest level reached with given XP, 0 if none.
+func (s *SeasonDTO) LevelForXP(xp int) int {
+	level := 0
+
+	for _, tier := range s.Tiers {
+		if tier.XPRequired > xp {
+			break
+		}
+
+		level = tier.Level
+	}
+
+	return level
+}
+
+type CreateSeasonDTO struct {
+	Number       int
+	Name         string
+	StartsAt     time.Time
+	EndsAt       time.Time
+	PremiumPrice float64
+	Tiers        []*SeasonTierDTO
+}
+
+type SeasonRewardClaimDTO struct {
+	SeasonID  int                `json:"season_id"`
+	Level     int                `json:"level"`
+	Track     seasonentity.Track `json:"track"`
+	Coins     float64            `json:"coins"`
+	Item      *InventoryItemDTO  `json:"item"`
+	Automatic bool               `json:"automatic"`
+	ClaimedAt time.Time          `json:"claimed_at"`
+}
+
+type SeasonPassDTO struct {
+	Season             *SeasonDTO              `json:"season"`
+	XP                 int                     `json:"xp"`
+	Level              int                     `json:"level"`
+	Premium            bool                    `json:"premium"`
+	PremiumPurchasedAt *time.Time              `json:"premium_purchased_at"`
+	Claims             []*SeasonRewardClaimDTO `json:"claims"`
+}
+
+// IsClaimed reports whether reward of given level and track has been claimed.
+func (p *SeasonPassDTO) IsClaimed(level int, track seasonentity.Track) bool {
+	for _, claim := range p.Claims {
+		if claim.Level == level && claim.Track == track {
+			return true
+		}
+	}
+
+	return false
+}
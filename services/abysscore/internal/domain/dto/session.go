@@ -0,0 +1,19 @@
+package dto
+
+import "time"
+
+// ClientInfoDTO describes the client a session is created or refreshed from.
+type ClientInfoDTO struct {
+	IP        string
+	UserAgent string
+}
+
+type SessionDTO struct {
+	ID                  string    `json:"id"                   example:"2f1b8a4e-7c1d-4c55-9d0e-6a3b5f1c2d7e"`
+	HardwareFingerprint string    `json:"hardware_fingerprint" example:"9f86d081884c7d65"`
+	IP                  string    `json:"ip"                   example:"203.0.113.7"`
+	UserAgent           string    `json:"user_agent"           example:"AbyssLeagueClient/1.4.2"`
+	CreatedAt           time.Time `json:"created_at"`
+	LastActivityAt      time.Time `json:"last_activity_at"`
+	Current             bool      `json:"current"`
+}
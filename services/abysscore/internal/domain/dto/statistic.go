@@ -0,0 +1,28 @@
+package dto
+
+import (
+	"time"
+)
+
+type StatisticDTO struct {
+	Type            string    `json:"type"              example:"season"`
+	Period          int       `json:"period"            example:"3"`
+	XP              int       `json:"xp"`
+	MatchCount      int       `json:"match_count"`
+	WinsCount       int       `json:"wins_count"`
+	LosesCount      int       `json:"loses_count"`
+	DrawsCount      int       `json:"draws_count"`
+	ResultTime      int       `json:"result_time"`
+	RetryTime       int       `json:"retry_time"`
+	RetryCount      int       `json:"retry_count"`
+	BestResultTime  int       `json:"best_result_time"`
+	BestRetryCount  int       `json:"best_retry_count"`
+	BestMatchTime   int       `json:"best_match_time"`
+	WorstResultTime int       `json:"worst_result_time"`
+	WorstRetryCount int       `json:"worst_retry_count"`
+	WorstMatchTime  int       `json:"worst_match_time"`
+	MaxWinStreak    int       `json:"max_win_streak"`
+	MaxLoseStreak   int       `json:"max_lose_streak"`
+	MaxLoginStreak  int       `json:"max_login_streak"`
+	CreatedAt       time.Time `json:"created_at"`
+}
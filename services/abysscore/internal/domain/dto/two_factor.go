@@ -0,0 +1,11 @@
+package dto
+
+type TOTPEnrollmentDTO struct {
+	Secret          string `json:"secret"           example:"JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"`
+	ProvisioningURI string `json:"provisioning_uri" example:"otpauth://totp/AbyssLeague:intezya?algorithm=SHA1&digits=6&issuer=AbyssLeague&period=30&secret=JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"`
+}
+
+// RecoveryCodesDTO holds plain recovery codes, they are shown to user only once.
+type RecoveryCodesDTO struct {
+	Codes []string `json:"codes" example:"7KD0PAQ2JV"`
+}
@@ -3,25 +3,28 @@ package dto
 import (
 	"time"
 
-	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/access_level"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/permission"
 )
 
 type UserDTO struct {
-	ID                     int                      `json:"id"`
-	Username               string                   `json:"username"`
-	Email                  *string                  `json:"email"`
-	Password               string                   `json:"-"`
-	HardwareID             *string                  `json:"-"`
-	AccessLevel            access_level.AccessLevel `json:"-"`
-	GenshinUID             *string                  `json:"genshin_uid"`
-	HoyolabLogin           *string                  `json:"hoyolab_login"`
-	CurrentMatchID         *int                     `json:"-"`
-	CurrentItemInProfileID *int                     `json:"-"`
-	AvatarURL              *string                  `json:"avatar_url"`
-	InvitesEnabled         bool                     `json:"invites_enabled"`
-	LoginAt                time.Time                `json:"-"`
-	LoginStreak            int                      `json:"login_streak"`
-	CreatedAt              time.Time                `json:"created_at"`
+	ID                     int            `json:"id"`
+	Username               string         `json:"username"`
+	Email                  *string        `json:"email"`
+	Password               string         `json:"-"`
+	HardwareID             *string        `json:"-"`
+	TOTPSecret             *string        `json:"-"`
+	TOTPEnabledAt          *time.Time     `json:"-"`
+	Permissions            permission.Set `json:"-"`
+	GenshinUID             *string        `json:"genshin_uid"`
+	HoyolabLogin           *string        `json:"hoyolab_login"`
+	CurrentMatchID         *int           `json:"-"`
+	CurrentItemInProfileID *int           `json:"-"`
+	AvatarURL              *string        `json:"avatar_url"`
+	Title                  *string        `json:"title"`
+	InvitesEnabled         bool           `json:"invites_enabled"`
+	LoginAt                time.Time      `json:"-"`
+	LoginStreak            int            `json:"login_streak"`
+	CreatedAt              time.Time      `json:"created_at"`
 
 	SearchBlockedUntil *time.Time `json:"-"`
 	SearchBlockReason  *string    `json:"-"`
@@ -30,6 +33,11 @@ type UserDTO struct {
 	AccountBlockedUntil *time.Time `json:"-"`
 	AccountBlockReason  *string    `json:"-"`
 	AccountBlockedLevel int        `json:"-"`
+
+	LoginLockedUntil *time.Time `json:"-"`
+
+	DeletionScheduledAt *time.Time `json:"deletion_scheduled_at"`
+	DeletedAt           *time.Time `json:"-"`
 }
 
 type UserFullDTO struct {
@@ -43,3 +51,12 @@ type UserFullDTO struct {
 	CurrentItem *InventoryItemDTO   `json:"current_item"`
 	// CurrentMatch *Match      `json:"current_match"`
 }
+
+// EncodedSecretsDTO holds encrypted values of the user, nil means the value is not set.
+type EncodedSecretsDTO struct {
+	UserID     int
+	HardwareID *string
+	// HardwareFingerprint is nil for hardware ids stored before fingerprints were, it's set on re-encryption
+	HardwareFingerprint *string
+	TOTPSecret          *string
+}
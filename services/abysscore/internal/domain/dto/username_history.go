@@ -0,0 +1,30 @@
+package dto
+
+import (
+	"time"
+)
+
+type UsernameChangeDTO struct {
+	UserID      int
+	OldUsername string
+	NewUsername string
+	CoinsSpent  float64
+	ReleasedAt  time.Time
+}
+
+type UsernameHistoryDTO struct {
+	ID          int       `json:"id"`
+	OldUsername string    `json:"old_username" example:"intezya"`
+	NewUsername string    `json:"new_username" example:"intezya_the_legend"`
+	CoinsSpent  float64   `json:"coins_spent"  example:"500"`
+	ReleasedAt  time.Time `json:"released_at"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// UsernameChangedDTO contains access token of the current session with the new username,
+// access tokens of other sessions stop working and have to be refreshed.
+type UsernameChangedDTO struct {
+	User        *UserDTO `json:"user"`
+	AccessToken string   `json:"access_token"`
+	CoinsSpent  float64  `json:"coins_spent"  example:"500"`
+}
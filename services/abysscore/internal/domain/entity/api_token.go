@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"encoding/base64"
+	"time"
+
+	"github.com/intezya/pkglib/generate"
+)
+
+const (
+	// APITokenPrefix marks personal API tokens, so leaked tokens can be found by secret scanners.
+	APITokenPrefix = "abl_"
+
+	APITokenMaxPerUser      = 10
+	APITokenMaxLifetimeDays = 365
+	// APITokenLastUsedPrecision limits writes of last usage time to one per interval.
+	APITokenLastUsedPrecision = time.Minute
+
+	apiTokenLength        = 32
+	apiTokenVisibleLength = len(APITokenPrefix) + 6
+)
+
+func NewAPIToken() string {
+	return APITokenPrefix + base64.RawURLEncoding.EncodeToString(generate.RandomBytes(apiTokenLength))
+}
+
+// APITokenVisiblePart returns the beginning of the token stored in plain text to tell tokens apart.
+func APITokenVisiblePart(token string) string {
+	return token[:apiTokenVisibleLength]
+}
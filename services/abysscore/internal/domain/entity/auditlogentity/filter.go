@@ -0,0 +1,57 @@
+package auditlogentity
+
+import (
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/auditlog"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+)
+
+// Filter selects audit log records. Nil fields are not applied.
+type Filter struct {
+	ActorID       *int
+	Action        *string
+	TargetType    *string
+	TargetID      *string
+	RequestID     *string
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+}
+
+func (f *Filter) ToPredicates() []predicate.AuditLog {
+	if f == nil {
+		return nil
+	}
+
+	var predicates []predicate.AuditLog
+
+	if f.ActorID != nil {
+		predicates = append(predicates, auditlog.ActorIDEQ(*f.ActorID))
+	}
+
+	if f.Action != nil {
+		predicates = append(predicates, auditlog.ActionEQ(*f.Action))
+	}
+
+	if f.TargetType != nil {
+		predicates = append(predicates, auditlog.TargetTypeEQ(*f.TargetType))
+	}
+
+	if f.TargetID != nil {
+		predicates = append(predicates, auditlog.TargetIDEQ(*f.TargetID))
+	}
+
+	if f.RequestID != nil {
+		predicates = append(predicates, auditlog.RequestIDEQ(*f.RequestID))
+	}
+
+	if f.CreatedAfter != nil {
+		predicates = append(predicates, auditlog.CreatedAtGTE(*f.CreatedAfter))
+	}
+
+	if f.CreatedBefore != nil {
+		predicates = append(predicates, auditlog.CreatedAtLTE(*f.CreatedBefore))
+	}
+
+	return predicates
+}
@@ -0,0 +1,77 @@
+package gameitementity
+
+import (
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/gameitem"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+)
+
+// Filter selects catalog items. Nil and empty fields are not applied.
+type Filter struct {
+	NameContains  *string
+	Collections   []string
+	Type          *int
+	RarityMin     *int
+	RarityMax     *int
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+
+	// Search matches name or collection case-insensitively.
+	Search *string
+
+	// IncludeArchived disables hiding of archived items.
+	IncludeArchived bool
+}
+
+func (f *Filter) ToPredicates() []predicate.GameItem {
+	if f == nil {
+		return []predicate.GameItem{gameitem.ArchivedAtIsNil()}
+	}
+
+	var predicates []predicate.GameItem
+
+	if !f.IncludeArchived {
+		predicates = append(predicates, gameitem.ArchivedAtIsNil())
+	}
+
+	if f.NameContains != nil {
+		predicates = append(predicates, gameitem.NameContainsFold(*f.NameContains))
+	}
+
+	if len(f.Collections) > 0 {
+		predicates = append(predicates, gameitem.CollectionIn(f.Collections...))
+	}
+
+	if f.Type != nil {
+		predicates = append(predicates, gameitem.TypeEQ(*f.Type))
+	}
+
+	if f.RarityMin != nil {
+		predicates = append(predicates, gameitem.RarityGTE(*f.RarityMin))
+	}
+
+	if f.RarityMax != nil {
+		predicates = append(predicates, gameitem.RarityLTE(*f.RarityMax))
+	}
+
+	if f.CreatedAfter != nil {
+		predicates = append(predicates, gameitem.CreatedAtGTE(*f.CreatedAfter))
+	}
+
+	if f.CreatedBefore != nil {
+		predicates = append(predicates, gameitem.CreatedAtLTE(*f.CreatedBefore))
+	}
+
+	if f.Search != nil {
+		predicates = append(
+			predicates,
+			gameitem.Or(
+				gameitem.NameContainsFold(*f.Search),
+				gameitem.CollectionContainsFold(*f.Search),
+			),
+		)
+	}
+
+	return predicates
+}
@@ -0,0 +1,14 @@
+package entity
+
+import (
+	"time"
+)
+
+const (
+	// GrantJobHeartbeatInterval is how often the instance running a grant job marks it alive.
+	GrantJobHeartbeatInterval = 30 * time.Second
+	// GrantJobStaleAfter is how long a job without heartbeat is considered running before it is failed.
+	GrantJobStaleAfter = 3 * GrantJobHeartbeatInterval
+	// GrantJobStaleCheckInterval is how often jobs of stopped instances are looked for.
+	GrantJobStaleCheckInterval = time.Minute
+)
@@ -0,0 +1,24 @@
+package inventoryitementity
+
+// MaxDismantleBatchSize limits inventory items dismantled at once.
+const MaxDismantleBatchSize = 100
+
+// dismantleCoinsByRarity is an exchange table indexed by item rarity.
+var dismantleCoinsByRarity = []float64{
+	0,   // rarity starts from 1
+	5,   // common
+	15,  // uncommon
+	40,  // rare
+	100, // epic
+	250, // legendary
+}
+
+// DismantleCoins returns coins for dismantling an item of given rarity,
+// rarities above the table are exchanged by the highest rate.
+func DismantleCoins(rarity int) float64 {
+	if rarity <= 0 {
+		return 0
+	}
+
+	return dismantleCoinsByRarity[min(rarity, len(dismantleCoinsByRarity)-1)]
+}
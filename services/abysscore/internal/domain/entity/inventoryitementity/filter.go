@@ -0,0 +1,50 @@
+package inventoryitementity
+
+import (
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/gameitem"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/inventoryitem"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+)
+
+// Filter narrows down inventory listings. Nil fields are not applied.
+type Filter struct {
+	Collection    *string
+	Type          *int
+	Rarity        *int
+	ObtainedAfter *time.Time
+}
+
+func (f *Filter) ToPredicates() []predicate.InventoryItem {
+	if f == nil {
+		return nil
+	}
+
+	var (
+		predicates     []predicate.InventoryItem
+		itemPredicates []predicate.GameItem
+	)
+
+	if f.Collection != nil {
+		itemPredicates = append(itemPredicates, gameitem.CollectionEQ(*f.Collection))
+	}
+
+	if f.Type != nil {
+		itemPredicates = append(itemPredicates, gameitem.TypeEQ(*f.Type))
+	}
+
+	if f.Rarity != nil {
+		itemPredicates = append(itemPredicates, gameitem.RarityEQ(*f.Rarity))
+	}
+
+	if len(itemPredicates) > 0 {
+		predicates = append(predicates, inventoryitem.HasItemWith(itemPredicates...))
+	}
+
+	if f.ObtainedAfter != nil {
+		predicates = append(predicates, inventoryitem.ObtainedAtGT(*f.ObtainedAfter))
+	}
+
+	return predicates
+}
@@ -0,0 +1,34 @@
+package inventoryitementity
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/types"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/gameitem"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/inventoryitem"
+)
+
+type OrderBy string
+
+const (
+	OrderByObtainedAt OrderBy = "obtained_at"
+	OrderByRarity     OrderBy = "rarity"
+	OrderByName       OrderBy = "name"
+)
+
+func (o OrderBy) ToOrderOption(orderType types.OrderType) inventoryitem.OrderOption {
+	direction := sql.OrderDesc()
+	if orderType == types.OrderAsc {
+		direction = sql.OrderAsc()
+	}
+
+	switch o {
+	case OrderByRarity:
+		return inventoryitem.ByItemField(gameitem.FieldRarity, direction)
+	case OrderByName:
+		return inventoryitem.ByItemField(gameitem.FieldName, direction)
+	case OrderByObtainedAt:
+		return inventoryitem.ByObtainedAt(direction)
+	default:
+		return inventoryitem.ByObtainedAt(direction)
+	}
+}
@@ -0,0 +1,11 @@
+package entity
+
+import (
+	"time"
+)
+
+const (
+	// ReencryptionInterval is how often values encoded with old keys are looked for after key rotation.
+	ReencryptionInterval  = time.Hour
+	ReencryptionBatchSize = 100
+)
@@ -0,0 +1,21 @@
+package mailmessage
+
+import (
+	"time"
+)
+
+// NewAccountDeletionScheduledMessage notifies that the account will be erased unless the deletion is cancelled.
+func NewAccountDeletionScheduledMessage(username string, scheduledAt time.Time) *Message {
+	const subject = "Your account is scheduled for deletion"
+
+	const summary = "Deletion of your account was requested. All your other sessions were ended."
+
+	details := []string{
+		"Personal data is erased at: " + scheduledAt.UTC().Format(time.RFC1123),
+	}
+
+	const advice = "Log in and cancel the deletion before this time to keep your account. " +
+		"If you have not requested it, cancel the deletion and change your password."
+
+	return newAccountSecurityMessage(subject, username, summary, details, advice)
+}
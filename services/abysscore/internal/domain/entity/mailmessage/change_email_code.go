@@ -0,0 +1,69 @@
+package mailmessage
+
+import (
+	"fmt"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// ChangeEmailCodeData holds codes sent to both current and new addresses,
+// email is changed only if both of them are entered.
+type ChangeEmailCodeData struct {
+	OldEmailMessage *Message `json:"-"`
+	NewEmailMessage *Message `json:"-"`
+
+	// Stored in cache
+	UserID       int       `json:"user_id"`
+	OldEmail     string    `json:"old_email"`
+	NewEmail     string    `json:"new_email"`
+	OldEmailCode string    `json:"old_email_code"`
+	NewEmailCode string    `json:"new_email_code"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func NewChangeEmailCodeMail(
+	userID int,
+	oldEmail string,
+	newEmail string,
+	validMinutes int,
+) *ChangeEmailCodeData {
+	const subject = "Email address change confirmation"
+
+	const mime = "text/html; charset=UTF-8"
+
+	oldEmailCode := newVerificationCode()
+	newEmailCode := newVerificationCode()
+
+	newBody := func(code string) string {
+		return fmt.Sprintf(
+			changeEmailCodeMessageBodyTemplate,
+			oldEmail,
+			newEmail,
+			code,
+			validMinutes,
+			time.Now().Year(),
+		)
+	}
+
+	return &ChangeEmailCodeData{
+		OldEmailMessage: NewMessage(subject, mime, newBody(oldEmailCode)),
+		NewEmailMessage: NewMessage(subject, mime, newBody(newEmailCode)),
+		UserID:          userID,
+		OldEmail:        oldEmail,
+		NewEmail:        newEmail,
+		OldEmailCode:    oldEmailCode,
+		NewEmailCode:    newEmailCode,
+		CreatedAt:       time.Now(),
+	}
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (d *ChangeEmailCodeData) MarshalBinary() ([]byte, error) {
+	return jsoniter.Marshal(d)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (d *ChangeEmailCodeData) UnmarshalBinary(data []byte) error {
+	return jsoniter.Unmarshal(data, d)
+}
@@ -0,0 +1,29 @@
+package mailmessage
+
+import (
+	"fmt"
+	"html"
+	"time"
+)
+
+const hardwareIDResetDefaultReason = "No reason specified"
+
+func NewHardwareIDResetMessage(username string, reason *string) *Message {
+	const subject = "Hardware ID reset"
+
+	const mime = "text/html; charset=UTF-8"
+
+	resetReason := hardwareIDResetDefaultReason
+	if reason != nil && *reason != "" {
+		resetReason = *reason
+	}
+
+	body := fmt.Sprintf(
+		hardwareIDResetMessageBodyTemplate,
+		html.EscapeString(username),
+		html.EscapeString(resetReason),
+		time.Now().Year(),
+	)
+
+	return NewMessage(subject, mime, body)
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/intezya/pkglib/generate"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -23,11 +22,7 @@ func NewLinkEmailCodeMail(
 	emailForLink string,
 	validMinutes int,
 ) *LinkEmailCodeData {
-	const verificationCodeLength = 6
-
-	const verificationCodeCharset = "ABCDEFGHIJKLMNOPQRSTUVWXYZ" + "0123456789"
-
-	verificationCode := generate.RandomString(verificationCodeLength, verificationCodeCharset)
+	verificationCode := newVerificationCode()
 
 	const subject = "Email address confirmation"
 
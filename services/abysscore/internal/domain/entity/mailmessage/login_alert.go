@@ -0,0 +1,66 @@
+package mailmessage
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"time"
+)
+
+const loginAlertAdvice = "If it was not you, please change your password and enable two-factor authentication."
+
+// NewUnrecognizedLoginMessage notifies about successful login from a device or ip address not used before.
+func NewUnrecognizedLoginMessage(
+	username, ip, userAgent string,
+	newHardware bool,
+	at time.Time,
+) *Message {
+	const subject = "New login to your account"
+
+	summary := "Your account was logged in from a new ip address."
+	if newHardware {
+		summary = "Your account was logged in from a new device."
+	}
+
+	details := []string{
+		"Time: " + at.UTC().Format(time.RFC1123),
+		"IP address: " + ip,
+		"Client: " + userAgent,
+	}
+
+	return newAccountSecurityMessage(subject, username, summary, details, loginAlertAdvice)
+}
+
+// NewLoginLockedMessage notifies that login to the account was locked after too many failures.
+func NewLoginLockedMessage(username, ip string, until time.Time) *Message {
+	const subject = "Login to your account is locked"
+
+	const summary = "There were too many failed attempts to log in to your account, so login is temporarily locked."
+
+	details := []string{
+		"Locked until: " + until.UTC().Format(time.RFC1123),
+		"Last attempt from: " + ip,
+	}
+
+	return newAccountSecurityMessage(subject, username, summary, details, loginAlertAdvice)
+}
+
+func newAccountSecurityMessage(subject, username, summary string, details []string, advice string) *Message {
+	const mime = "text/html; charset=UTF-8"
+
+	escapedDetails := make([]string, 0, len(details))
+	for _, detail := range details {
+		escapedDetails = append(escapedDetails, html.EscapeString(detail))
+	}
+
+	body := fmt.Sprintf(
+		accountSecurityMessageBodyTemplate,
+		html.EscapeString(username),
+		html.EscapeString(summary),
+		strings.Join(escapedDetails, "<br>"),
+		html.EscapeString(advice),
+		time.Now().Year(),
+	)
+
+	return NewMessage(subject, mime, body)
+}
@@ -0,0 +1,21 @@
+package mailmessage
+
+import (
+	"fmt"
+	"html"
+	"time"
+)
+
+func NewPasswordChangedMessage(username string) *Message {
+	const subject = "Password changed"
+
+	const mime = "text/html; charset=UTF-8"
+
+	body := fmt.Sprintf(
+		passwordChangedMessageBodyTemplate,
+		html.EscapeString(username),
+		time.Now().Year(),
+	)
+
+	return NewMessage(subject, mime, body)
+}
@@ -0,0 +1,56 @@
+package mailmessage
+
+import (
+	"fmt"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+type PasswordResetCodeData struct {
+	Message *Message `json:"-"`
+
+	// Stored in cache
+	UserID           int       `json:"user_id"`
+	VerificationCode string    `json:"verification_code"`
+	Email            string    `json:"email"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+func NewPasswordResetCodeMail(
+	userID int,
+	email string,
+	validMinutes int,
+) *PasswordResetCodeData {
+	verificationCode := newVerificationCode()
+
+	const subject = "Password reset"
+
+	const mime = "text/html; charset=UTF-8"
+
+	body := fmt.Sprintf(
+		passwordResetCodeMessageBodyTemplate,
+		email,
+		verificationCode,
+		validMinutes,
+		time.Now().Year(),
+	)
+
+	return &PasswordResetCodeData{
+		UserID:           userID,
+		VerificationCode: verificationCode,
+		Email:            email,
+		Message:          NewMessage(subject, mime, body),
+		CreatedAt:        time.Now(),
+	}
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (d *PasswordResetCodeData) MarshalBinary() ([]byte, error) {
+	return jsoniter.Marshal(d)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (d *PasswordResetCodeData) UnmarshalBinary(data []byte) error {
+	return jsoniter.Unmarshal(data, d)
+}
@@ -0,0 +1,89 @@
+package mailmessage
+
+import (
+	"fmt"
+	"html"
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+)
+
+const sanctionNoCommentText = "No comment specified"
+
+func NewSanctionIssuedMessage(username string, sanction *dto.SanctionDTO) *Message {
+	summary := fmt.Sprintf("You have received a warning, your %s is not blocked yet.", sanctionScope(sanction.Type))
+	if sanction.BlockedUntil.After(sanction.CreatedAt) {
+		summary = fmt.Sprintf(
+			"Your %s is blocked until %s.",
+			sanctionScope(sanction.Type),
+			sanction.BlockedUntil.UTC().Format(time.RFC1123),
+		)
+	}
+
+	return newSanctionMessage(
+		"Account sanction",
+		username,
+		summary,
+		sanction.Reason,
+		"Repeated violations lead to longer blocks. If you think the sanction is a mistake, you can appeal it.",
+	)
+}
+
+func NewSanctionLiftedMessage(username string, sanction *dto.SanctionDTO) *Message {
+	return newSanctionMessage(
+		"Account sanction lifted",
+		username,
+		fmt.Sprintf("The block of your %s was lifted by the administration.", sanctionScope(sanction.Type)),
+		commentOrDefault(sanction.ResolutionComment),
+		"The sanction is still counted, so the next one will be longer.",
+	)
+}
+
+func NewSanctionAppealResolvedMessage(username string, sanction *dto.SanctionDTO) *Message {
+	summary := "Your appeal was rejected, the sanction stays in force."
+	note := "The decision is final."
+
+	if sanction.Status == dto.SanctionRevoked {
+		summary = "Your appeal was accepted, the sanction was revoked."
+		note = "The sanction is not counted anymore."
+	}
+
+	return newSanctionMessage(
+		"Sanction appeal resolved",
+		username,
+		summary,
+		commentOrDefault(sanction.ResolutionComment),
+		note,
+	)
+}
+
+func newSanctionMessage(subject, username, summary, reason, note string) *Message {
+	const mime = "text/html; charset=UTF-8"
+
+	body := fmt.Sprintf(
+		sanctionMessageBodyTemplate,
+		html.EscapeString(username),
+		html.EscapeString(summary),
+		html.EscapeString(reason),
+		html.EscapeString(note),
+		time.Now().Year(),
+	)
+
+	return NewMessage(subject, mime, body)
+}
+
+func sanctionScope(sanctionType dto.SanctionType) string {
+	if sanctionType == dto.SanctionSearch {
+		return "match search"
+	}
+
+	return "account"
+}
+
+func commentOrDefault(comment *string) string {
+	if comment == nil || *comment == "" {
+		return sanctionNoCommentText
+	}
+
+	return *comment
+}
@@ -1,3 +1,15 @@
 package mailmessage
 
 const linkEmailCodeMessageBodyTemplate = "\n<!DOCTYPE html>\n<html>\n<head>\n    <meta charset=\"UTF-8\">\n    <title>Email Confirmation</title>\n    <style>\n        body {\n            font-family: Arial, sans-serif;\n            line-height: 1.6;\n            color: #333;\n            max-width: 600px;\n            margin: 0 auto;\n            padding: 20px;\n        }\n        .container {\n            border: 1px solid #ddd;\n            border-radius: 5px;\n            padding: 20px;\n            background-color: #f9f9f9;\n        }\n        .code {\n            font-size: 24px;\n            font-weight: bold;\n            text-align: center;\n            padding: 10px;\n            margin: 20px 0;\n            background-color: #eee;\n            border-radius: 4px;\n            letter-spacing: 5px;\n        }\n        .footer {\n            font-size: 12px;\n            color: #777;\n            margin-top: 30px;\n            text-align: center;\n        }\n    </style>\n</head>\n<body>\n    <div class=\"container\">\n        <p>Yo!</p>\n        \n        <p>Thank you for registering for our service. To confirm your e-mail address <strong>%s</strong>, please, use next code:</p>\n        \n        <div class=\"code\">%s</div>\n        \n        <p>This code is valid for %d minutes. If you have not requested this code, please ignore this email.</p>\n    </div>\n    \n    <div class=\"footer\">\n        <p>This is an automated noreply message.</p>\n        <p>&copy; %d All rights reserved.</p>\n    </div>\n</body>"
+
+const passwordResetCodeMessageBodyTemplate = "\n<!DOCTYPE html>\n<html>\n<head>\n    <meta charset=\"UTF-8\">\n    <title>Password Reset</title>\n    <style>\n        body {\n            font-family: Arial, sans-serif;\n            line-height: 1.6;\n            color: #333;\n            max-width: 600px;\n            margin: 0 auto;\n            padding: 20px;\n        }\n        .container {\n            border: 1px solid #ddd;\n            border-radius: 5px;\n            padding: 20px;\n            background-color: #f9f9f9;\n        }\n        .code {\n            font-size: 24px;\n            font-weight: bold;\n            text-align: center;\n            padding: 10px;\n            margin: 20px 0;\n            background-color: #eee;\n            border-radius: 4px;\n            letter-spacing: 5px;\n        }\n        .footer {\n            font-size: 12px;\n            color: #777;\n            margin-top: 30px;\n            text-align: center;\n        }\n    </style>\n</head>\n<body>\n    <div class=\"container\">\n        <p>Yo!</p>\n        \n        <p>Password reset was requested for the account linked to <strong>%s</strong>. To set a new password, please, use next code:</p>\n        \n        <div class=\"code\">%s</div>\n        \n        <p>This code is valid for %d minutes. If you have not requested a password reset, please ignore this email, your password will not be changed.</p>\n    </div>\n    \n    <div class=\"footer\">\n        <p>This is an automated noreply message.</p>\n        <p>&copy; %d All rights reserved.</p>\n    </div>\n</body>"
+
+const changeEmailCodeMessageBodyTemplate = "\n<!DOCTYPE html>\n<html>\n<head>\n    <meta charset=\"UTF-8\">\n    <title>Email Change</title>\n    <style>\n        body {\n            font-family: Arial, sans-serif;\n            line-height: 1.6;\n            color: #333;\n            max-width: 600px;\n            margin: 0 auto;\n            padding: 20px;\n        }\n        .container {\n            border: 1px solid #ddd;\n            border-radius: 5px;\n            padding: 20px;\n            background-color: #f9f9f9;\n        }\n        .code {\n            font-size: 24px;\n            font-weight: bold;\n            text-align: center;\n            padding: 10px;\n            margin: 20px 0;\n            background-color: #eee;\n            border-radius: 4px;\n            letter-spacing: 5px;\n        }\n        .footer {\n            font-size: 12px;\n            color: #777;\n            margin-top: 30px;\n            text-align: center;\n        }\n    </style>\n</head>\n<body>\n    <div class=\"container\">\n        <p>Yo!</p>\n        \n        <p>Change of your account e-mail address from <strong>%s</strong> to <strong>%s</strong> was requested. To confirm it from this address, please, use next code:</p>\n        \n        <div class=\"code\">%s</div>\n        \n        <p>This code is valid for %d minutes. Both addresses have to be confirmed. If you have not requested this change, please ignore this email and change your password.</p>\n    </div>\n    \n    <div class=\"footer\">\n        <p>This is an automated noreply message.</p>\n        <p>&copy; %d All rights reserved.</p>\n    </div>\n</body>"
+
+const hardwareIDResetMessageBodyTemplate = "\n<!DOCTYPE html>\n<html>\n<head>\n    <meta charset=\"UTF-8\">\n    <title>Hardware ID Reset</title>\n    <style>\n        body {\n            font-family: Arial, sans-serif;\n            line-height: 1.6;\n            color: #333;\n            max-width: 600px;\n            margin: 0 auto;\n            padding: 20px;\n        }\n        .container {\n            border: 1px solid #ddd;\n            border-radius: 5px;\n            padding: 20px;\n            background-color: #f9f9f9;\n        }\n        .reason {\n            padding: 10px;\n            margin: 20px 0;\n            background-color: #eee;\n            border-radius: 4px;\n        }\n        .footer {\n            font-size: 12px;\n            color: #777;\n            margin-top: 30px;\n            text-align: center;\n        }\n    </style>\n</head>\n<body>\n    <div class=\"container\">\n        <p>Yo, <strong>%s</strong>!</p>\n        \n        <p>Hardware ID of your account was reset by the administration. Your next login will bind the account to the device you log in from. All your active sessions were ended.</p>\n        \n        <div class=\"reason\">%s</div>\n        \n        <p>If you have not requested this reset, please change your password and contact support.</p>\n    </div>\n    \n    <div class=\"footer\">\n        <p>This is an automated noreply message.</p>\n        <p>&copy; %d All rights reserved.</p>\n    </div>\n</body>"
+
+const passwordChangedMessageBodyTemplate = "\n<!DOCTYPE html>\n<html>\n<head>\n    <meta charset=\"UTF-8\">\n    <title>Password Changed</title>\n    <style>\n        body {\n            font-family: Arial, sans-serif;\n            line-height: 1.6;\n            color: #333;\n            max-width: 600px;\n            margin: 0 auto;\n            padding: 20px;\n        }\n        .container {\n            border: 1px solid #ddd;\n            border-radius: 5px;\n            padding: 20px;\n            background-color: #f9f9f9;\n        }\n        .footer {\n            font-size: 12px;\n            color: #777;\n            margin-top: 30px;\n            text-align: center;\n        }\n    </style>\n</head>\n<body>\n    <div class=\"container\">\n        <p>Yo, <strong>%s</strong>!</p>\n        \n        <p>Password of your account was changed. All your sessions except the one used for the change were ended.</p>\n        \n        <p>If you have not changed your password, please reset it using this e-mail address and contact support.</p>\n    </div>\n    \n    <div class=\"footer\">\n        <p>This is an automated noreply message.</p>\n        <p>&copy; %d All rights reserved.</p>\n    </div>\n</body>"
+
+const accountSecurityMessageBodyTemplate = "\n<!DOCTYPE html>\n<html>\n<head>\n    <meta charset=\"UTF-8\">\n    <title>Account Security</title>\n    <style>\n        body {\n            font-family: Arial, sans-serif;\n            line-height: 1.6;\n            color: #333;\n            max-width: 600px;\n            margin: 0 auto;\n            padding: 20px;\n        }\n        .container {\n            border: 1px solid #ddd;\n            border-radius: 5px;\n            padding: 20px;\n            background-color: #f9f9f9;\n        }\n        .details {\n            padding: 10px;\n            margin: 20px 0;\n            background-color: #eee;\n            border-radius: 4px;\n        }\n        .footer {\n            font-size: 12px;\n            color: #777;\n            margin-top: 30px;\n            text-align: center;\n        }\n    </style>\n</head>\n<body>\n    <div class=\"container\">\n        <p>Yo, <strong>%s</strong>!</p>\n        \n        <p>%s</p>\n        \n        <div class=\"details\">%s</div>\n        \n        <p>%s</p>\n    </div>\n    \n    <div class=\"footer\">\n        <p>This is an automated noreply message.</p>\n        <p>&copy; %d All rights reserved.</p>\n    </div>\n</body>"
+
+const sanctionMessageBodyTemplate = "\n<!DOCTYPE html>\n<html>\n<head>\n    <meta charset=\"UTF-8\">\n    <title>Account Sanction</title>\n    <style>\n        body {\n            font-family: Arial, sans-serif;\n            line-height: 1.6;\n            color: #333;\n            max-width: 600px;\n            margin: 0 auto;\n            padding: 20px;\n        }\n        .container {\n            border: 1px solid #ddd;\n            border-radius: 5px;\n            padding: 20px;\n            background-color: #f9f9f9;\n        }\n        .reason {\n            padding: 10px;\n            margin: 20px 0;\n            background-color: #eee;\n            border-radius: 4px;\n        }\n        .footer {\n            font-size: 12px;\n            color: #777;\n            margin-top: 30px;\n            text-align: center;\n        }\n    </style>\n</head>\n<body>\n    <div class=\"container\">\n        <p>Yo, <strong>%s</strong>!</p>\n        \n        <p>%s</p>\n        \n        <div class=\"reason\">%s</div>\n        \n        <p>%s</p>\n    </div>\n    \n    <div class=\"footer\">\n        <p>This is an automated noreply message.</p>\n        <p>&copy; %d All rights reserved.</p>\n    </div>\n</body>"
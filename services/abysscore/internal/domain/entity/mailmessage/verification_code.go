@@ -0,0 +1,11 @@
+package mailmessage
+
+import "github.com/intezya/pkglib/generate"
+
+func newVerificationCode() string {
+	const verificationCodeLength = 6
+
+	const verificationCodeCharset = "ABCDEFGHIJKLMNOPQRSTUVWXYZ" + "0123456789"
+
+	return generate.RandomString(verificationCodeLength, verificationCodeCharset)
+}
@@ -0,0 +1,90 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/intezya/pkglib/generate"
+	jsoniter "github.com/json-iterator/go"
+)
+
+const (
+	// OIDCAuthorizationTTL is the time user has to sign in at the provider.
+	OIDCAuthorizationTTL = 10 * time.Minute
+	// OIDCRegistrationTTL is the time user has to pick username for a new account.
+	OIDCRegistrationTTL = 15 * time.Minute
+
+	oidcSecretLength           = 32
+	suggestedUsernameMaxLength = 24
+)
+
+// NewOIDCSecret generates url-safe random value used as state, nonce, PKCE code verifier and registration token.
+func NewOIDCSecret() string {
+	return base64.RawURLEncoding.EncodeToString(generate.RandomBytes(oidcSecretLength))
+}
+
+// OIDCCodeChallenge derives S256 PKCE code challenge from the code verifier.
+func OIDCCodeChallenge(codeVerifier string) string {
+	hash := sha256.Sum256([]byte(codeVerifier))
+
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// SuggestUsername keeps only letters, digits and underscores of the name reported by the provider.
+func SuggestUsername(preferredUsername string) string {
+	var builder strings.Builder
+
+	for _, r := range preferredUsername {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_') {
+			builder.WriteRune(r)
+		}
+
+		if builder.Len() == suggestedUsernameMaxLength {
+			break
+		}
+	}
+
+	return builder.String()
+}
+
+// OIDCAuthorizationData is stored by state of started authorization until the provider redirects back.
+type OIDCAuthorizationData struct {
+	Provider     string `json:"provider"`
+	CodeVerifier string `json:"code_verifier"`
+	Nonce        string `json:"nonce"`
+	// HardwareFingerprint binds authorization to the device it was started from, empty when linking
+	HardwareFingerprint string `json:"hardware_fingerprint"`
+	// LinkUserID is set if the identity is linked to the existing account instead of login
+	LinkUserID int `json:"link_user_id"`
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (d *OIDCAuthorizationData) MarshalBinary() ([]byte, error) {
+	return jsoniter.Marshal(d)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (d *OIDCAuthorizationData) UnmarshalBinary(data []byte) error {
+	return jsoniter.Unmarshal(data, d)
+}
+
+// OIDCRegistrationData is stored by registration token hash when the identity is not linked to any account.
+type OIDCRegistrationData struct {
+	Provider            string  `json:"provider"`
+	Subject             string  `json:"subject"`
+	Email               *string `json:"email"`
+	HardwareFingerprint string  `json:"hardware_fingerprint"`
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (d *OIDCRegistrationData) MarshalBinary() ([]byte, error) {
+	return jsoniter.Marshal(d)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (d *OIDCRegistrationData) UnmarshalBinary(data []byte) error {
+	return jsoniter.Unmarshal(data, d)
+}
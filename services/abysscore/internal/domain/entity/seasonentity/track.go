@@ -0,0 +1,12 @@
+package seasonentity
+
+type Track string
+
+const (
+	TrackFree    Track = "free"
+	TrackPremium Track = "premium"
+)
+
+func (t Track) IsValid() bool {
+	return t == TrackFree || t == TrackPremium
+}
@@ -0,0 +1,17 @@
+package seasonentity
+
+import "time"
+
+// XP sources of the battle pass.
+const (
+	LoginStreakXPPerDay  = 20
+	LoginStreakMaxXPDays = 7
+)
+
+// RolloverCheckInterval is how often ended seasons are checked for rollover.
+const RolloverCheckInterval = time.Minute
+
+// LoginStreakXP returns XP for daily login, it grows with streak up to LoginStreakMaxXPDays.
+func LoginStreakXP(streak int) int {
+	return min(max(streak, 1), LoginStreakMaxXPDays) * LoginStreakXPPerDay
+}
@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// HardwareFingerprintLength is the number of hash characters kept to tell devices apart,
+// so raw hardware id is never shown.
+const HardwareFingerprintLength = 16
+
+// SessionData describes a device signed in with a refresh token family, its ID is the family ID.
+type SessionData struct {
+	ID                  string    `json:"id"`
+	UserID              int       `json:"user_id"`
+	HardwareFingerprint string    `json:"hardware_fingerprint"`
+	IP                  string    `json:"ip"`
+	UserAgent           string    `json:"user_agent"`
+	CreatedAt           time.Time `json:"created_at"`
+	LastActivityAt      time.Time `json:"last_activity_at"`
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (d *SessionData) MarshalBinary() ([]byte, error) {
+	return jsoniter.Marshal(d)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (d *SessionData) UnmarshalBinary(data []byte) error {
+	return jsoniter.Unmarshal(data, d)
+}
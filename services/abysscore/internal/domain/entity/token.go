@@ -1,8 +1,38 @@
 package entity
 
+import (
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
 // TokenData contains information to be encoded in auth tokens.
 type TokenData struct {
 	ID         int    `json:"id"`
 	Username   string `json:"username"`
 	HardwareID string `json:"hardware_id"`
+	// FamilyID links access token to the refresh token family it was issued by,
+	// revoking the family revokes its access tokens too. It is also the session id and token jti.
+	FamilyID string `json:"family_id"`
+	// IssuedAt is taken from the registered claims on validation.
+	IssuedAt time.Time `json:"-"`
+}
+
+// RefreshTokenData is stored by refresh token hash, raw token is never stored.
+type RefreshTokenData struct {
+	UserID     int       `json:"user_id"`
+	FamilyID   string    `json:"family_id"`
+	HardwareID string    `json:"hardware_id"`
+	IssuedAt   time.Time `json:"issued_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (d *RefreshTokenData) MarshalBinary() ([]byte, error) {
+	return jsoniter.Marshal(d)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (d *RefreshTokenData) UnmarshalBinary(data []byte) error {
+	return jsoniter.Unmarshal(data, d)
 }
@@ -0,0 +1,22 @@
+package userentity
+
+import (
+	"strconv"
+	"time"
+)
+
+const (
+	// AccountDeletionGracePeriod is the time user can cancel requested deletion, the account stays usable.
+	AccountDeletionGracePeriod   = 30 * 24 * time.Hour
+	AccountDeletionCheckInterval = time.Hour
+	// AccountDeletionBatchSize limits accounts erased per check, the rest are erased on the next one.
+	AccountDeletionBatchSize = 100
+
+	deletedUsernamePrefix = "deleted_"
+)
+
+// DeletedUsername is shown instead of the username of a deleted account in its past matches.
+// Usernames with its prefix are reserved, so it never collides with a registered one.
+func DeletedUsername(userID int) string {
+	return deletedUsernamePrefix + strconv.Itoa(userID)
+}
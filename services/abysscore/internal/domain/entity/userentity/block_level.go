@@ -28,3 +28,59 @@ const (
 
 	AccountBlockDecrementTime = time.Hour * 24 * 3 // 3 days
 )
+
+const (
+	MaxSearchBlockLevel  = SearchBlockLevelBan72h
+	MaxAccountBlockLevel = AccountBlockLevelBan72h
+)
+
+var searchBlockLevels = [...]struct {
+	name     string
+	duration time.Duration
+}{
+	SearchBlockLevelWarning1: {"warning_1", 0},
+	SearchBlockLevelWarning2: {"warning_2", 0},
+	SearchBlockLevelBan1h:    {"ban_1h", time.Hour},
+	SearchBlockLevelBan6h:    {"ban_6h", time.Hour * 6},
+	SearchBlockLevelBan12h:   {"ban_12h", time.Hour * 12},
+	SearchBlockLevelBan24h:   {"ban_24h", time.Hour * 24},
+	SearchBlockLevelBan72h:   {"ban_72h", time.Hour * 72},
+}
+
+var accountBlockLevels = [...]struct {
+	name     string
+	duration time.Duration
+}{
+	AccountBlockLevelWarning: {"warning", 0},
+	AccountBlockLevelBan6h:   {"ban_6h", time.Hour * 6},
+	AccountBlockLevelBan24h:  {"ban_24h", time.Hour * 24},
+	AccountBlockLevelBan72h:  {"ban_72h", time.Hour * 72},
+}
+
+// Next returns the level of the next sanction, the last level is repeated.
+func (l SearchBlockLevel) Next() SearchBlockLevel {
+	return min(l+1, MaxSearchBlockLevel)
+}
+
+// Duration returns block duration, warnings don't block.
+func (l SearchBlockLevel) Duration() time.Duration {
+	return searchBlockLevels[min(max(l, 0), MaxSearchBlockLevel)].duration
+}
+
+func (l SearchBlockLevel) String() string {
+	return searchBlockLevels[min(max(l, 0), MaxSearchBlockLevel)].name
+}
+
+// Next returns the level of the next sanction, the last level is repeated.
+func (l AccountBlockLevel) Next() AccountBlockLevel {
+	return min(l+1, MaxAccountBlockLevel)
+}
+
+// Duration returns block duration, warnings don't block.
+func (l AccountBlockLevel) Duration() time.Duration {
+	return accountBlockLevels[min(max(l, 0), MaxAccountBlockLevel)].duration
+}
+
+func (l AccountBlockLevel) String() string {
+	return accountBlockLevels[min(max(l, 0), MaxAccountBlockLevel)].name
+}
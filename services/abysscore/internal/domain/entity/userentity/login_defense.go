@@ -0,0 +1,38 @@
+package userentity
+
+import (
+	"time"
+)
+
+const (
+	// LoginFailuresWindow is the time failures are remembered for since the first of them.
+	LoginFailuresWindow = 24 * time.Hour
+
+	// LoginLockThreshold is the number of failures by username after which the account is locked.
+	LoginLockThreshold = 10
+	LoginLockDuration  = time.Hour
+
+	loginFreeFailures = 3
+	loginLockoutBase  = 2 * time.Second
+	loginLockoutMax   = 15 * time.Minute
+)
+
+// LoginLockout returns how long the next login is refused after the given number of failures.
+// The first failures are free, then lockout doubles with every failure up to loginLockoutMax.
+func LoginLockout(failures int) time.Duration {
+	exceeded := failures - loginFreeFailures
+	if exceeded <= 0 {
+		return 0
+	}
+
+	lockout := loginLockoutBase
+	for range exceeded - 1 {
+		lockout *= 2
+
+		if lockout >= loginLockoutMax {
+			return loginLockoutMax
+		}
+	}
+
+	return lockout
+}
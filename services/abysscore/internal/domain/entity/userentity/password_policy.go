@@ -0,0 +1,88 @@
+package userentity
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+)
+
+const (
+	PasswordMinLength = 10
+	// PasswordMaxLength limits hashing cost of a single request.
+	PasswordMaxLength = 128
+	// PasswordHistorySize is the number of recent passwords, including the current one, that can't be reused.
+	PasswordHistorySize = 5
+
+	passwordMinCharClasses = 3
+)
+
+var (
+	ErrPasswordTooShort      = errors.New("password must be at least 10 characters long")
+	ErrPasswordTooLong       = errors.New("password must be at most 128 characters long")
+	ErrPasswordTooSimple     = errors.New("password must contain at least 3 of: lowercase, uppercase, digits, symbols")
+	ErrPasswordContainsLogin = errors.New("password must not contain username")
+	ErrPasswordTooCommon     = errors.New("password is too common")
+)
+
+// commonPasswords pass other rules, but are first to be tried by attackers. Compared case-insensitively.
+var commonPasswords = map[string]struct{}{
+	"password123!":  {},
+	"password1234!": {},
+	"qwerty12345!":  {},
+	"qwertyuiop1!":  {},
+	"p@ssw0rd1234":  {},
+	"p@ssword1234":  {},
+	"welcome1234!":  {},
+	"letmein1234!":  {},
+	"iloveyou1234!": {},
+	"abcdef12345!":  {},
+}
+
+// ValidatePassword checks password against the strength policy.
+func ValidatePassword(password, username string) error {
+	length := len([]rune(password))
+
+	switch {
+	case length < PasswordMinLength:
+		return ErrPasswordTooShort
+	case length > PasswordMaxLength:
+		return ErrPasswordTooLong
+	case countCharClasses(password) < passwordMinCharClasses:
+		return ErrPasswordTooSimple
+	case username != "" && strings.Contains(strings.ToLower(password), strings.ToLower(username)):
+		return ErrPasswordContainsLogin
+	}
+
+	if _, ok := commonPasswords[strings.ToLower(password)]; ok {
+		return ErrPasswordTooCommon
+	}
+
+	return nil
+}
+
+func countCharClasses(password string) int {
+	var lower, upper, digit, symbol bool
+
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+
+	count := 0
+
+	for _, present := range []bool{lower, upper, digit, symbol} {
+		if present {
+			count++
+		}
+	}
+
+	return count
+}
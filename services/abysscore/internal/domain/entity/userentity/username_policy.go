@@ -0,0 +1,112 @@
+package userentity
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+const (
+	UsernameMinLength = 3
+	UsernameMaxLength = 24
+
+	UsernameChangeCooldown = 30 * 24 * time.Hour
+	// UsernameChangeCost is charged in coins for every change except the first one.
+	UsernameChangeCost = 500
+	// UsernameReleaseGracePeriod keeps the old username bound to the user after a change,
+	// so it can't be impersonated right away and the user can take it back.
+	UsernameReleaseGracePeriod = 14 * 24 * time.Hour
+)
+
+var (
+	ErrUsernameTooShort      = errors.New("username must be at least 3 characters long")
+	ErrUsernameTooLong       = errors.New("username must be at most 24 characters long")
+	ErrUsernameInvalidChars  = errors.New("username may contain only latin letters, digits and underscores")
+	ErrUsernameReserved      = errors.New("username is reserved")
+	ErrUsernameInappropriate = errors.New("username contains inappropriate words")
+)
+
+// reservedUsernames could be mistaken for staff or system accounts. Compared after normalization.
+var reservedUsernames = map[string]struct{}{
+	"admin":         {},
+	"administrator": {},
+	"moderator":     {},
+	"mod":           {},
+	"support":       {},
+	"staff":         {},
+	"system":        {},
+	"root":          {},
+	"official":      {},
+	"abyss":         {},
+	"abyssleague":   {},
+	"security":      {},
+	"deleted":       {},
+	"anonymous":     {},
+	"null":          {},
+	"undefined":     {},
+}
+
+// inappropriateWords are rejected anywhere in the username. Compared after normalization.
+var inappropriateWords = []string{
+	"fuck",
+	"shit",
+	"bitch",
+	"cunt",
+	"whore",
+	"slut",
+	"nigger",
+	"nigga",
+	"faggot",
+	"retard",
+	"nazi",
+	"hitler",
+}
+
+// usernameNormalizer reverts common letter substitutions and drops separators.
+var usernameNormalizer = strings.NewReplacer(
+	"_", "",
+	"0", "o",
+	"1", "i",
+	"3", "e",
+	"4", "a",
+	"5", "s",
+	"7", "t",
+)
+
+// ValidateUsername checks username format and rejects reserved and inappropriate names.
+func ValidateUsername(username string) error {
+	switch {
+	case len(username) < UsernameMinLength:
+		return ErrUsernameTooShort
+	case len(username) > UsernameMaxLength:
+		return ErrUsernameTooLong
+	}
+
+	for _, r := range username {
+		if !isUsernameRune(r) {
+			return ErrUsernameInvalidChars
+		}
+	}
+
+	if strings.HasPrefix(strings.ToLower(username), deletedUsernamePrefix) {
+		return ErrUsernameReserved
+	}
+
+	normalized := usernameNormalizer.Replace(strings.ToLower(username))
+
+	if _, ok := reservedUsernames[normalized]; ok {
+		return ErrUsernameReserved
+	}
+
+	for _, word := range inappropriateWords {
+		if strings.Contains(normalized, word) {
+			return ErrUsernameInappropriate
+		}
+	}
+
+	return nil
+}
+
+func isUsernameRune(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_'
+}
@@ -0,0 +1,20 @@
+package drivenports
+
+import (
+	"context"
+)
+
+// OIDCIdentity holds verified claims of ID token.
+type OIDCIdentity struct {
+	Subject           string
+	Email             *string
+	PreferredUsername string
+	Nonce             string
+}
+
+// OIDCProvider performs authorization code flow with PKCE against OpenID Connect provider.
+type OIDCProvider interface {
+	AuthorizationURL(state, nonce, codeChallenge string) string
+	// Exchange redeems authorization code and verifies returned ID token, nonce is checked by the caller.
+	Exchange(ctx context.Context, code, codeVerifier string) (*OIDCIdentity, error)
+}
@@ -0,0 +1,18 @@
+package repositoryports
+
+import (
+	"context"
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+)
+
+type APITokenRepository interface {
+	// Create fails with conflict if the user already has maxPerUser tokens.
+	Create(ctx context.Context, token *dto.CreateAPITokenDTO, maxPerUser int) (*dto.APITokenDTO, error)
+	FindByUserID(ctx context.Context, userID int) ([]*dto.APITokenDTO, error)
+	// FindValidByHash returns not expired token.
+	FindValidByHash(ctx context.Context, tokenHash string) (*dto.APITokenDTO, error)
+	TouchLastUsed(ctx context.Context, id int, usedAt time.Time) error
+	DeleteByIDAndUserID(ctx context.Context, id, userID int) error
+}
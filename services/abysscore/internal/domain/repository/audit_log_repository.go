@@ -0,0 +1,25 @@
+package repositoryports
+
+import (
+	"context"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/auditlogentity"
+)
+
+// AuditLogRepository is append-only, records are never updated or deleted.
+type AuditLogRepository interface {
+	Create(ctx context.Context, record *dto.CreateAuditLogDTO) error
+	FindPaged(
+		ctx context.Context,
+		filter *auditlogentity.Filter,
+		page, size int,
+	) (*dto.PaginatedResult[*dto.AuditLogDTO], error)
+	// FindBatch returns up to limit records with id greater than afterID in ascending id order.
+	FindBatch(
+		ctx context.Context,
+		filter *auditlogentity.Filter,
+		afterID int,
+		limit int,
+	) ([]*dto.AuditLogDTO, error)
+}
@@ -0,0 +1,27 @@
+package repositoryports
+
+import (
+	"context"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+)
+
+type CollectionRepository interface {
+	FindProgressByUserID(ctx context.Context, userID int) ([]*dto.CollectionProgressDTO, error)
+	FindProgressByUserIDAndCollection(
+		ctx context.Context,
+		userID int,
+		collection string,
+	) (*dto.CollectionProgressDTO, error)
+
+	FindAllRewards(ctx context.Context) ([]*dto.CollectionRewardDTO, error)
+	UpsertReward(ctx context.Context, reward *dto.CollectionRewardDTO) (*dto.CollectionRewardDTO, error)
+
+	// CompleteAndReward records collection completion and grants configured reward in one transaction.
+	// Returns nil if the user has already completed the collection.
+	CompleteAndReward(
+		ctx context.Context,
+		userID int,
+		collection string,
+	) (*dto.CollectionCompletionDTO, error)
+}
@@ -0,0 +1,46 @@
+package repositoryports
+
+import (
+	"context"
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+)
+
+type ExternalIdentityRepository interface {
+	Create(ctx context.Context, identity *dto.CreateExternalIdentityDTO) (*dto.ExternalIdentityDTO, error)
+	FindByUserID(ctx context.Context, userID int) ([]*dto.ExternalIdentityDTO, error)
+	DeleteByIDAndUserID(ctx context.Context, id, userID int) error
+
+	TxCreate(
+		ctx context.Context,
+		tx *ent.Tx,
+		identity *dto.CreateExternalIdentityDTO,
+	) (*dto.ExternalIdentityDTO, error)
+	// TxFindUserIDByProviderAndSubject returns id of the user the identity is linked to.
+	TxFindUserIDByProviderAndSubject(ctx context.Context, tx *ent.Tx, provider, subject string) (int, error)
+	// TxTouch updates last login time and email the provider reported for the identity.
+	TxTouch(ctx context.Context, tx *ent.Tx, provider, subject string, email *string) error
+}
+
+// OIDCStateRepository keeps state of OIDC flows between redirects.
+type OIDCStateRepository interface {
+	SaveAuthorization(
+		ctx context.Context,
+		state string,
+		data *entity.OIDCAuthorizationData,
+		ttl time.Duration,
+	) error
+	// ConsumeAuthorization returns and deletes authorization data, so state can't be used twice.
+	ConsumeAuthorization(ctx context.Context, state string) (*entity.OIDCAuthorizationData, error)
+	SaveRegistration(
+		ctx context.Context,
+		tokenHash string,
+		data *entity.OIDCRegistrationData,
+		ttl time.Duration,
+	) error
+	FindRegistration(ctx context.Context, tokenHash string) (*entity.OIDCRegistrationData, error)
+	DeleteRegistration(ctx context.Context, tokenHash string) error
+}
@@ -18,8 +18,15 @@ type GameItemRepository interface {
 		page, size int,
 		orderBy gameitementity.OrderBy,
 		orderType types.OrderType,
+		filter *gameitementity.Filter,
 	) (*dto.PaginatedResult[*dto.GameItemDTO], error)
 
+	FindArchivedPaged(
+		ctx context.Context,
+		page, size int,
+	) (*dto.PaginatedResult[*dto.ArchivedGameItemDTO], error)
+
 	UpdateByID(ctx context.Context, id int, gameItem *dto.GameItemDTO) error
-	DeleteByID(ctx context.Context, id int) error
+	ArchiveByID(ctx context.Context, id int) error
+	RestoreByID(ctx context.Context, id int) error
 }
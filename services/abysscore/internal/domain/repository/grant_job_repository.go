@@ -0,0 +1,22 @@
+package repositoryports
+
+import (
+	"context"
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/pkg/optional"
+)
+
+type GrantJobRepository interface {
+	Create(ctx context.Context, job *dto.CreateGrantJobDTO) (*dto.GrantJobDTO, error)
+	FindByID(ctx context.Context, id int) (*dto.GrantJobDTO, error)
+	SetRunning(ctx context.Context, id int) error
+	AddProgress(ctx context.Context, id int, progress *dto.GrantJobProgressDTO) error
+	Finish(ctx context.Context, id int, status dto.GrantJobStatus, reason optional.String) error
+	// Heartbeat marks the job as alive, so it is not failed as stale.
+	Heartbeat(ctx context.Context, id int) error
+	// FailStale fails pending and running jobs of other owners without heartbeat since heartbeatBefore
+	// and returns their count.
+	FailStale(ctx context.Context, owner string, heartbeatBefore time.Time, reason string) (int, error)
+}
@@ -0,0 +1,36 @@
+package repositoryports
+
+import (
+	"context"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+)
+
+type HardwareIDResetRepository interface {
+	// CreateRequest creates pending request, only one pending request per user is allowed.
+	CreateRequest(ctx context.Context, userID int, reason *string) (*dto.HardwareIDResetDTO, error)
+	// Reset clears user hardware id and resolves its pending requests as approved by the operator.
+	Reset(
+		ctx context.Context,
+		userID int,
+		operatorID int,
+		reason *string,
+	) (*dto.HardwareIDResetResultDTO, error)
+	Approve(
+		ctx context.Context,
+		requestID int,
+		operatorID int,
+		comment *string,
+	) (*dto.HardwareIDResetResultDTO, error)
+	Reject(
+		ctx context.Context,
+		requestID int,
+		operatorID int,
+		comment *string,
+	) (*dto.HardwareIDResetDTO, error)
+	FindPaged(
+		ctx context.Context,
+		status *dto.HardwareIDResetStatus,
+		page, size int,
+	) (*dto.PaginatedResult[*dto.HardwareIDResetDTO], error)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/inventoryitementity"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/types"
 )
 
 type InventoryItemRepository interface {
@@ -11,10 +13,30 @@ type InventoryItemRepository interface {
 		ctx context.Context,
 		inventoryItem *dto.CreateInventoryItemDTO,
 	) (*dto.InventoryItemDTO, error)
+	// CreateForCampaign grants the item to every user once per campaign,
+	// users who have already received it within the campaign are skipped.
+	CreateForCampaign(
+		ctx context.Context,
+		userIDs []int,
+		itemID int,
+		receivedFromID int,
+		campaign string,
+	) (*dto.BulkGrantResultDTO, error)
 	FindByUserIDAndID(ctx context.Context, userID, id int) (*dto.InventoryItemDTO, error)
-	FindByUserID(
+	FindPagedByUserID(
+		ctx context.Context,
+		userID int,
+		page, size int,
+		orderBy inventoryitementity.OrderBy,
+		orderType types.OrderType,
+		filter *inventoryitementity.Filter,
+	) (*dto.PaginatedResult[*dto.InventoryItemDTO], error)
+	SummaryByUserID(
 		ctx context.Context,
 		userID int,
-	) ([]*dto.InventoryItemDTO, error) // TODO: maybe add pagination
+		filter *inventoryitementity.Filter,
+	) (*dto.InventorySummaryDTO, error)
 	DeleteByUserIDAndID(ctx context.Context, userID, id int) (*dto.InventoryItemDTO, error)
+	// DismantleByUserIDAndIDs deletes items and credits their exchange value to user balance.
+	DismantleByUserIDAndIDs(ctx context.Context, userID int, ids []int) (*dto.DismantleResultDTO, error)
 }
@@ -0,0 +1,35 @@
+package repositoryports
+
+import (
+	"context"
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+)
+
+type LoginHistoryRepository interface {
+	Create(ctx context.Context, record *dto.CreateLoginHistoryDTO) error
+	FindPagedByUserID(
+		ctx context.Context,
+		userID int,
+		page, size int,
+	) (*dto.PaginatedResult[*dto.LoginHistoryDTO], error)
+	ExistsSuccessfulByUserID(ctx context.Context, userID int) (bool, error)
+	ExistsSuccessfulByUserIDAndIP(ctx context.Context, userID int, ip string) (bool, error)
+	ExistsSuccessfulByUserIDAndHardwareFingerprint(
+		ctx context.Context,
+		userID int,
+		hardwareFingerprint string,
+	) (bool, error)
+}
+
+// LoginAttemptRepository counts failed logins by subject, subject is a username, ip or hardware fingerprint
+// prefixed with its kind.
+type LoginAttemptRepository interface {
+	// IncrementFailures increments failures of the subject, counter expires in window since the first failure.
+	IncrementFailures(ctx context.Context, subject string, window time.Duration) (int, error)
+	ResetFailures(ctx context.Context, subject string) error
+	Lockout(ctx context.Context, subject string, duration time.Duration) error
+	// FindLockout returns the longest remaining lockout of the subjects, zero if none of them is locked out.
+	FindLockout(ctx context.Context, subjects ...string) (time.Duration, error)
+}
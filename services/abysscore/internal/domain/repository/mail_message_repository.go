@@ -17,4 +17,40 @@ type MailMessageRepository interface {
 		ctx context.Context,
 		receiverID int,
 	) (*mailmessage.LinkEmailCodeData, error)
+
+	SavePasswordResetCodeData(
+		ctx context.Context,
+		message *mailmessage.PasswordResetCodeData,
+		expireMinutes int,
+	)
+
+	GetPasswordResetCodeData(
+		ctx context.Context,
+		receiverID int,
+	) (*mailmessage.PasswordResetCodeData, error)
+
+	ConsumePasswordResetCodeData(
+		ctx context.Context,
+		receiverID int,
+	) (*mailmessage.PasswordResetCodeData, error)
+
+	IncrementPasswordResetAttempts(ctx context.Context, receiverID int, expireMinutes int) (int, error)
+
+	DeletePasswordResetCodeData(ctx context.Context, receiverID int) error
+
+	SaveChangeEmailCodeData(
+		ctx context.Context,
+		message *mailmessage.ChangeEmailCodeData,
+		expireMinutes int,
+	)
+
+	GetChangeEmailCodeData(
+		ctx context.Context,
+		receiverID int,
+	) (*mailmessage.ChangeEmailCodeData, error)
+
+	ConsumeChangeEmailCodeData(
+		ctx context.Context,
+		receiverID int,
+	) (*mailmessage.ChangeEmailCodeData, error)
 }
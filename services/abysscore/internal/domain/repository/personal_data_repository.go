@@ -0,0 +1,20 @@
+package repositoryports
+
+import (
+	"context"
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+)
+
+type PersonalDataRepository interface {
+	FindByUserID(ctx context.Context, userID int) (*dto.PersonalDataDTO, error)
+	// ScheduleDeletion fails with conflict if deletion of the account is already scheduled.
+	ScheduleDeletion(ctx context.Context, userID int, at time.Time) error
+	// CancelDeletion fails with conflict if deletion is not scheduled or the account is already erased.
+	CancelDeletion(ctx context.Context, userID int) error
+	FindIDsDueForDeletion(ctx context.Context, now time.Time, limit int) ([]int, error)
+	// Erase anonymizes the account and deletes everything bound to it except matches, the user row is kept
+	// as a placeholder player of past matches. Fails with conflict if deletion is not due anymore.
+	Erase(ctx context.Context, erasure *dto.AccountErasureDTO) error
+}
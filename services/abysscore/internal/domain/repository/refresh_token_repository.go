@@ -0,0 +1,30 @@
+package repositoryports
+
+import (
+	"context"
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity"
+)
+
+type RefreshTokenRepository interface {
+	// Save stores the first token of a new family.
+	Save(ctx context.Context, tokenHash string, token *entity.RefreshTokenData) error
+	FindByHash(ctx context.Context, tokenHash string) (*entity.RefreshTokenData, error)
+	// Rotate replaces the current token of the family, it fails with apperrors.ErrRefreshTokenReused
+	// if the old token is not the current one anymore.
+	Rotate(ctx context.Context, oldTokenHash, newTokenHash string, token *entity.RefreshTokenData) error
+	// RevokeFamily deletes the family and adds it to the revocation list for accessTokenLifetime,
+	// so access tokens issued within the family are rejected until they expire.
+	RevokeFamily(ctx context.Context, familyID string, accessTokenLifetime time.Duration) error
+	IsFamilyRevoked(ctx context.Context, familyID string) (bool, error)
+	// MarkUserTokensStale rejects access tokens of the user issued before issuedBefore until they expire,
+	// refresh tokens stay valid, so sessions get tokens with actual user data on the next refresh.
+	MarkUserTokensStale(
+		ctx context.Context,
+		userID int,
+		issuedBefore time.Time,
+		accessTokenLifetime time.Duration,
+	) error
+	IsUserTokenStale(ctx context.Context, userID int, issuedAt time.Time) (bool, error)
+}
@@ -0,0 +1,19 @@
+package repositoryports
+
+import (
+	"context"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+)
+
+type RoleRepository interface {
+	FindAll(ctx context.Context) ([]*dto.RoleDTO, error)
+	FindByID(ctx context.Context, roleID int) (*dto.RoleDTO, error)
+	FindByUserID(ctx context.Context, userID int) ([]*dto.RoleDTO, error)
+	Create(ctx context.Context, role *dto.SaveRoleDTO) (*dto.RoleDTO, error)
+	Update(ctx context.Context, roleID int, role *dto.SaveRoleDTO) (*dto.RoleDTO, error)
+	// Delete removes role, users lose its permissions with the next request.
+	Delete(ctx context.Context, roleID int) error
+	// SetUserRoles replaces user roles and returns the assigned ones.
+	SetUserRoles(ctx context.Context, userID int, roleIDs []int) ([]*dto.RoleDTO, error)
+}
@@ -0,0 +1,26 @@
+package repositoryports
+
+import (
+	"context"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+)
+
+type SanctionRepository interface {
+	// Issue records sanction and applies it to the user block state.
+	Issue(ctx context.Context, sanction *dto.IssueSanctionDTO) (*dto.SanctionResultDTO, error)
+	// Lift ends the block of the latest active sanction early, the level is kept.
+	Lift(ctx context.Context, sanctionID int, resolverID int, comment *string) (*dto.SanctionResultDTO, error)
+	Appeal(ctx context.Context, userID int, sanctionID int, message string) (*dto.SanctionDTO, error)
+	// ResolveAppeal revokes accepted sanction, if it is the latest the previous level is restored.
+	ResolveAppeal(
+		ctx context.Context,
+		sanctionID int,
+		resolverID int,
+		accepted bool,
+		comment *string,
+	) (*dto.SanctionResultDTO, error)
+	FindByID(ctx context.Context, sanctionID int) (*dto.SanctionDTO, error)
+	FindByUserID(ctx context.Context, userID int) ([]*dto.SanctionDTO, error)
+	FindPendingAppealsPaged(ctx context.Context, page, size int) (*dto.PaginatedResult[*dto.SanctionDTO], error)
+}
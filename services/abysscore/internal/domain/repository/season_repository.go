@@ -0,0 +1,34 @@
+package repositoryports
+
+import (
+	"context"
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/seasonentity"
+)
+
+type SeasonRepository interface {
+	Create(ctx context.Context, season *dto.CreateSeasonDTO) (*dto.SeasonDTO, error)
+	ExistsOverlapping(ctx context.Context, startsAt, endsAt time.Time) (bool, error)
+	FindActive(ctx context.Context, now time.Time) (*dto.SeasonDTO, error)
+	FindEndedNotRolledOver(ctx context.Context, now time.Time) ([]*dto.SeasonDTO, error)
+	MarkRolledOver(ctx context.Context, seasonID int) error
+
+	// AddXP adds XP to user's season statistic and returns the new total.
+	AddXP(ctx context.Context, userID int, seasonNumber int, xp int) (int, error)
+	FindUserIDsWithXP(ctx context.Context, seasonNumber int) ([]int, error)
+
+	FindPass(ctx context.Context, userID int, season *dto.SeasonDTO) (*dto.SeasonPassDTO, error)
+	PurchasePremium(ctx context.Context, userID int, season *dto.SeasonDTO) error
+
+	// Claim grants tier reward once, returns existing claim and false if it has already been claimed.
+	Claim(
+		ctx context.Context,
+		userID int,
+		seasonID int,
+		tier *dto.SeasonTierDTO,
+		track seasonentity.Track,
+		automatic bool,
+	) (*dto.SeasonRewardClaimDTO, bool, error)
+}
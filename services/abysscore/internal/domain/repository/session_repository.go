@@ -0,0 +1,18 @@
+package repositoryports
+
+import (
+	"context"
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity"
+)
+
+type SessionRepository interface {
+	// Save creates or replaces session, it expires after ttl.
+	Save(ctx context.Context, session *entity.SessionData, ttl time.Duration) error
+	FindByID(ctx context.Context, sessionID string) (*entity.SessionData, error)
+	FindByUserID(ctx context.Context, userID int) ([]*entity.SessionData, error)
+	// Touch updates last activity of existing session, missing session is ignored.
+	Touch(ctx context.Context, sessionID string, lastActivityAt time.Time) error
+	Delete(ctx context.Context, userID int, sessionIDs ...string) error
+}
@@ -0,0 +1,26 @@
+package repositoryports
+
+import (
+	"context"
+	"time"
+)
+
+type TwoFactorRepository interface {
+	// Enable stores encrypted secret and recovery code hashes if two-factor authentication is not enabled yet.
+	Enable(ctx context.Context, userID int, encryptedSecret string, recoveryCodeHashes []string) error
+	Disable(ctx context.Context, userID int) error
+	ReplaceRecoveryCodes(ctx context.Context, userID int, recoveryCodeHashes []string) error
+	// UseRecoveryCode marks the code as used and reports whether it was valid and unused.
+	UseRecoveryCode(ctx context.Context, userID int, codeHash string) (bool, error)
+}
+
+type TwoFactorCacheRepository interface {
+	SavePendingSecret(ctx context.Context, userID int, encryptedSecret string, ttl time.Duration) error
+	GetPendingSecret(ctx context.Context, userID int) (string, error)
+	DeletePendingSecret(ctx context.Context, userID int) error
+	// MarkStepUsed reports whether TOTP time step has not been used by the user before.
+	MarkStepUsed(ctx context.Context, userID int, step int64, ttl time.Duration) (bool, error)
+	GetFailures(ctx context.Context, userID int) (int, error)
+	IncrementFailures(ctx context.Context, userID int, ttl time.Duration) (int, error)
+	ResetFailures(ctx context.Context, userID int) error
+}
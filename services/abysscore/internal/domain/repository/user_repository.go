@@ -12,11 +12,41 @@ type UserRepository interface {
 	WithTx(ctx context.Context) (*ent.Tx, error)
 	FindDTOById(ctx context.Context, id int) (*dto.UserDTO, error)
 	FindFullDTOById(ctx context.Context, id int) (*dto.UserFullDTO, error)
+	FindDTOByEmail(ctx context.Context, email string) (*dto.UserDTO, error)
+	FindDTOByLowerUsername(ctx context.Context, username string) (*dto.UserDTO, error)
 	ExistsByEmail(ctx context.Context, email string) bool
+	// ReplacePassword fails with conflict if the password has been changed since oldPassword was read.
+	ReplacePassword(ctx context.Context, userID int, oldPassword, newPassword string, historySize int) error
+	FindRecentPasswords(ctx context.Context, userID int, limit int) ([]string, error)
+	LockLoginUntil(ctx context.Context, userID int, until time.Time) error
 	SetEmailIfNil(ctx context.Context, userID int, email string) (*dto.UserDTO, error)
+	ReplaceEmail(ctx context.Context, change *dto.EmailChangeDTO) (*dto.UserDTO, error)
+	FindEmailHistoryByUserID(ctx context.Context, userID int) ([]*dto.EmailHistoryDTO, error)
+	FindLastEmailChangeAt(ctx context.Context, userID int) (*time.Time, error)
+	// ReplaceUsername fails with conflict if the username has been changed since OldUsername was read
+	// or the new username is taken, including old usernames of other users within the grace period.
+	ReplaceUsername(ctx context.Context, change *dto.UsernameChangeDTO) (*dto.UserDTO, error)
+	FindUsernameHistoryByUserID(ctx context.Context, userID int) ([]*dto.UsernameHistoryDTO, error)
+	FindLastUsernameChangeAt(ctx context.Context, userID int) (*time.Time, error)
+	FindIDsByFilter(ctx context.Context, filter *dto.UserFilterDTO) ([]int, error)
+	FindExistingIDs(ctx context.Context, ids []int) ([]int, error)
+	// FindEncodedHardwareIDsByFingerprint returns encoded hardware ids by user id of accounts bound to
+	// hardware with the fingerprint. Fingerprint is truncated, so callers verify the hardware id itself.
+	FindEncodedHardwareIDsByFingerprint(ctx context.Context, fingerprint string) (map[int]string, error)
+	// FindSecretsNotEncodedWithKey returns users with hardware id or TOTP secret encoded with a key other
+	// than keyID or with hardware id without fingerprint, ordered by id starting after afterID.
+	FindSecretsNotEncodedWithKey(
+		ctx context.Context,
+		keyID string,
+		afterID int,
+		limit int,
+	) ([]*dto.EncodedSecretsDTO, error)
+	// ReplaceEncodedSecrets returns false if the secrets have been changed since current was read.
+	ReplaceEncodedSecrets(ctx context.Context, current, replacement *dto.EncodedSecretsDTO) (bool, error)
 
 	TxCreate(ctx context.Context, tx *ent.Tx, credentials *dto.CredentialsDTO) (*dto.UserDTO, error)
 	TxFindDTOById(ctx context.Context, tx *ent.Tx, id int) (*dto.UserDTO, error)
+	TxFindFullDTOById(ctx context.Context, tx *ent.Tx, id int) (*dto.UserFullDTO, error)
 	TxFindFullDTOByLowerUsername(
 		ctx context.Context,
 		tx *ent.Tx,
@@ -39,8 +69,7 @@ type UserRepository interface {
 
 type AuthenticationRepository interface {
 	WithTx(ctx context.Context) (*ent.Tx, error)
-	TxUpdateHardwareIDByID(ctx context.Context, tx *ent.Tx, id int, hardwareID string) error
-	TxUpdatePasswordByID(ctx context.Context, tx *ent.Tx, id int, password string) error
+	TxUpdateHardwareIDByID(ctx context.Context, tx *ent.Tx, id int, hardwareID, hardwareFingerprint string) error
 }
 
 type InventoryRepository interface {
@@ -56,8 +85,15 @@ type BannedHardwareIDRepository interface {
 		ctx context.Context,
 		hardwareID string,
 		reason optional.String,
+		performerID int,
 	) (*dto.BannedHardwareID, error)
 	FindByHardwareID(ctx context.Context, hardwareID string) (*dto.BannedHardwareID, error)
+	// FindPaged searches bans by hardware id or reason substring, empty search matches all bans.
+	FindPaged(
+		ctx context.Context,
+		search string,
+		page, size int,
+	) (*dto.PaginatedResult[*dto.BannedHardwareID], error)
 	DeleteByHardwareID(ctx context.Context, hardwareID string) error
 
 	TxFindByHardwareID(
@@ -14,4 +14,55 @@ type AccountService interface {
 		user *dto.UserDTO,
 		verificationCode string,
 	) (*dto.UserDTO, error)
+
+	SendCodesForEmailChange(
+		ctx context.Context,
+		user *dto.UserDTO,
+		newEmail string,
+		twoFactorCode string,
+	) error
+
+	EnterCodesForEmailChange(
+		ctx context.Context,
+		user *dto.UserDTO,
+		oldEmailCode, newEmailCode string,
+	) (*dto.UserDTO, error)
+
+	ChangeEmailByAdmin(
+		ctx context.Context,
+		performer *dto.UserDTO,
+		userID int,
+		newEmail string,
+		reason *string,
+	) (*dto.UserDTO, error)
+
+	UnlinkEmail(
+		ctx context.Context,
+		user *dto.UserDTO,
+		password string,
+		twoFactorCode string,
+	) (*dto.UserDTO, error)
+
+	GetEmailHistory(ctx context.Context, userID int) ([]*dto.EmailHistoryDTO, error)
+
+	ChangeUsername(
+		ctx context.Context,
+		user *dto.UserDTO,
+		sessionID string,
+		newUsername string,
+		twoFactorCode string,
+	) (*dto.UsernameChangedDTO, error)
+
+	GetUsernameHistory(ctx context.Context, userID int) ([]*dto.UsernameHistoryDTO, error)
+
+	SendCodeForPasswordReset(ctx context.Context, email string) error
+
+	ResetPassword(ctx context.Context, email, verificationCode, newPassword, twoFactorCode string) error
+
+	ChangePassword(
+		ctx context.Context,
+		user *dto.UserDTO,
+		sessionID string,
+		oldPassword, newPassword, twoFactorCode string,
+	) error
 }
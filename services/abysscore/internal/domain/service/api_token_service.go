@@ -0,0 +1,16 @@
+package domainservice
+
+import (
+	"context"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+)
+
+type APITokenService interface {
+	// Issue creates token after password and two-factor check, the token is returned only once.
+	Issue(ctx context.Context, user *dto.UserDTO, request *dto.IssueAPITokenDTO) (*dto.IssuedAPITokenDTO, error)
+	FindByUserID(ctx context.Context, userID int) ([]*dto.APITokenDTO, error)
+	Revoke(ctx context.Context, userID int, tokenID int) error
+	// Authenticate returns owner of the token and the token with its scopes.
+	Authenticate(ctx context.Context, token string) (*dto.UserDTO, *dto.APITokenDTO, error)
+}
@@ -0,0 +1,33 @@
+package domainservice
+
+import (
+	"context"
+	"io"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/auditlogentity"
+)
+
+// AuditLogger is the only way privileged actions are recorded into the audit log.
+type AuditLogger interface {
+	// Record stores the action with the diff of target states. Nil before means target was created,
+	// nil after means it was deleted. Failures are logged, because the action is already done.
+	Record(
+		ctx context.Context,
+		performer *dto.UserDTO,
+		action dto.AuditAction,
+		target dto.AuditTarget,
+		before, after any,
+	)
+}
+
+type AuditLogService interface {
+	AuditLogger
+	FindPaged(
+		ctx context.Context,
+		filter *auditlogentity.Filter,
+		page, size int,
+	) (*dto.PaginatedResult[*dto.AuditLogDTO], error)
+	// Export writes all matching records in JSON-lines, oldest first.
+	Export(ctx context.Context, filter *auditlogentity.Filter, writer io.Writer) error
+}
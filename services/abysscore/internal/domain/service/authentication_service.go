@@ -2,41 +2,106 @@ package domainservice
 
 import (
 	"context"
+	"time"
 
 	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
 	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity"
 )
 
 type AuthenticationResult struct {
-	Token       string           `json:"token,omitempty" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
-	User        *dto.UserFullDTO `json:"user"`
-	OnlineCount int              `json:"online_count"`
+	Token        string           `json:"token,omitempty"         example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+	RefreshToken string           `json:"refresh_token,omitempty" example:"q3Jx9vYQm0Zt8r7y0cHh1n2b3v4c5x6z7a8s9d0f1g2"`
+	User         *dto.UserFullDTO `json:"user"`
+	OnlineCount  int              `json:"online_count"`
 }
 
 func NewAuthenticationResult(
 	token string,
+	refreshToken string,
 	user *dto.UserFullDTO,
 	onlineCount int,
 ) *AuthenticationResult {
-	return &AuthenticationResult{Token: token, User: user, OnlineCount: onlineCount}
+	return &AuthenticationResult{
+		Token:        token,
+		RefreshToken: refreshToken,
+		User:         user,
+		OnlineCount:  onlineCount,
+	}
+}
+
+// OIDCLoginResult holds either tokens of the signed in user or registration of a new account
+// if the identity is not linked to any account.
+type OIDCLoginResult struct {
+	Authentication *AuthenticationResult    `json:"authentication,omitempty"`
+	Registration   *dto.OIDCRegistrationDTO `json:"registration,omitempty"`
 }
 
 type AuthenticationService interface {
-	Register(ctx context.Context, credentials *dto.CredentialsDTO) (*AuthenticationResult, error)
+	Register(
+		ctx context.Context,
+		credentials *dto.CredentialsDTO,
+		client *dto.ClientInfoDTO,
+	) (*AuthenticationResult, error)
 	Authenticate(
 		ctx context.Context,
 		credentials *dto.CredentialsDTO,
+		client *dto.ClientInfoDTO,
 	) (*AuthenticationResult, error)
-	ValidateToken(ctx context.Context, token string) (*dto.UserDTO, error)
-	ChangePassword(
+	ValidateToken(ctx context.Context, token string) (user *dto.UserDTO, sessionID string, err error)
+	// IsTokenRevoked reports whether the refresh token family of the access token is revoked
+	// or the token carries outdated user data.
+	IsTokenRevoked(ctx context.Context, token string) (bool, error)
+	// PublicKeys returns keys for access token verification by other services.
+	PublicKeys(ctx context.Context) *dto.JSONWebKeySetDTO
+	// Refresh rotates the refresh token and issues a new access token.
+	Refresh(
 		ctx context.Context,
-		credentials *dto.ChangePasswordDTO,
+		refreshToken string,
+		client *dto.ClientInfoDTO,
 	) (*AuthenticationResult, error)
+	// RevokeRefreshToken revokes the whole family of the refresh token.
+	RevokeRefreshToken(ctx context.Context, refreshToken string) error
+
+	OIDCProviders() []string
+	StartOIDC(ctx context.Context, provider string, hardwareID string) (*dto.OIDCAuthorizationDTO, error)
+	FinishOIDC(
+		ctx context.Context,
+		provider string,
+		callback *dto.OIDCCallbackDTO,
+		client *dto.ClientInfoDTO,
+	) (*OIDCLoginResult, error)
+	// RegisterOIDC creates account for the identity with the username picked by user.
+	RegisterOIDC(
+		ctx context.Context,
+		registrationToken string,
+		username string,
+		hardwareID string,
+		client *dto.ClientInfoDTO,
+	) (*AuthenticationResult, error)
+	StartOIDCLink(ctx context.Context, user *dto.UserDTO, provider string) (*dto.OIDCAuthorizationDTO, error)
+	FinishOIDCLink(
+		ctx context.Context,
+		user *dto.UserDTO,
+		provider string,
+		code, state string,
+	) (*dto.ExternalIdentityDTO, error)
+	FindExternalIdentities(ctx context.Context, userID int) ([]*dto.ExternalIdentityDTO, error)
+	UnlinkExternalIdentity(
+		ctx context.Context,
+		user *dto.UserDTO,
+		identityID int,
+		password string,
+		twoFactorCode string,
+	) error
 }
 
 type TokenHelper interface {
 	TokenGenerator(tokenData *entity.TokenData) string
 	ValidateToken(token string) (*entity.TokenData, error)
+	RefreshTokenGenerator() string
+	PublicKeys() *dto.JSONWebKeySetDTO
+	AccessTokenLifetime() time.Duration
+	RefreshTokenLifetime() time.Duration
 }
 
 type CredentialsHelper interface {
@@ -45,4 +110,9 @@ type CredentialsHelper interface {
 	EncodeHardwareID(raw string) string
 	DecodeHardwareID(encoded string) (string, error)
 	VerifyHardwareID(raw, encoded string) bool
+	EncodeTOTPSecret(raw string) string
+	DecodeTOTPSecret(encoded string) (string, error)
+	// CurrentEncryptionKeyID is the id encoded values start with when encoded with the newest key.
+	CurrentEncryptionKeyID() string
+	HashToken(raw string) string
 }
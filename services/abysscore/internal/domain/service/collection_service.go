@@ -0,0 +1,22 @@
+package domainservice
+
+import (
+	"context"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/dto/request"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+)
+
+type CollectionService interface {
+	FindProgressByUserID(ctx context.Context, userID int) ([]*dto.CollectionProgressDTO, error)
+
+	FindAllRewards(ctx context.Context) ([]*dto.CollectionRewardDTO, error)
+	SetReward(
+		ctx context.Context,
+		request *request.SetCollectionReward,
+		performer *dto.UserDTO,
+	) (*dto.CollectionRewardDTO, error)
+
+	// HandleItemGranted checks whether granted item completes its collection and rewards the user.
+	HandleItemGranted(ctx context.Context, userID int, item *dto.InventoryItemDTO)
+}
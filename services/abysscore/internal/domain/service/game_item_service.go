@@ -20,6 +20,7 @@ type GameItemService interface {
 	FindAllPaged(
 		ctx context.Context,
 		query *request.PaginationQuery[gameitementity.OrderBy],
+		filter *gameitementity.Filter,
 	) (*dto.PaginatedResult[*dto.GameItemDTO], error)
 
 	Update(
@@ -29,7 +30,19 @@ type GameItemService interface {
 		performer *dto.UserDTO,
 	) error
 
-	Delete(
+	FindArchivedPaged(
+		ctx context.Context,
+		page, size int,
+	) (*dto.PaginatedResult[*dto.ArchivedGameItemDTO], error)
+
+	// Archive hides the item from catalog, owned inventory items are kept.
+	Archive(
+		ctx context.Context,
+		id int,
+		performer *dto.UserDTO,
+	) error
+
+	Restore(
 		ctx context.Context,
 		id int,
 		performer *dto.UserDTO,
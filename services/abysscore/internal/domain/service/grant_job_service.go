@@ -0,0 +1,24 @@
+package domainservice
+
+import (
+	"context"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/dto/request"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+)
+
+type GrantJobService interface {
+	// Start resolves recipients and grants the item to them in background.
+	Start(
+		ctx context.Context,
+		itemID int,
+		request *request.BulkGrantInventoryItem,
+		performer *dto.UserDTO,
+	) (*dto.GrantJobDTO, error)
+
+	FindByID(ctx context.Context, id int) (*dto.GrantJobDTO, error)
+
+	// RunStaleJobLoop fails jobs of stopped instances until ctx is done. Grants are idempotent
+	// per campaign, so failed jobs are safe to re-run.
+	RunStaleJobLoop(ctx context.Context)
+}
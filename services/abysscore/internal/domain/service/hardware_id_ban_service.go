@@ -0,0 +1,30 @@
+package domainservice
+
+import (
+	"context"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+)
+
+type HardwareIDBanService interface {
+	// BanByUser bans hardware id bound to the user.
+	BanByUser(
+		ctx context.Context,
+		performer *dto.UserDTO,
+		userID int,
+		reason *string,
+	) (*dto.BanHardwareIDResultDTO, error)
+	// Ban bans raw hardware id, it may be not bound to any account yet.
+	Ban(
+		ctx context.Context,
+		performer *dto.UserDTO,
+		hardwareID string,
+		reason *string,
+	) (*dto.BanHardwareIDResultDTO, error)
+	FindPaged(
+		ctx context.Context,
+		search string,
+		page, size int,
+	) (*dto.PaginatedResult[*dto.BannedHardwareID], error)
+	Unban(ctx context.Context, performer *dto.UserDTO, hardwareID string) error
+}
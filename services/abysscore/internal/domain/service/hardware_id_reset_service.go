@@ -0,0 +1,36 @@
+package domainservice
+
+import (
+	"context"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+)
+
+type HardwareIDResetService interface {
+	// ResetByAdmin clears user hardware id, so the next login binds it again.
+	ResetByAdmin(
+		ctx context.Context,
+		performer *dto.UserDTO,
+		userID int,
+		reason *string,
+	) (*dto.HardwareIDResetDTO, error)
+	// RequestReset creates request for users who can't log in from their new device.
+	RequestReset(ctx context.Context, request *dto.HardwareIDResetRequestDTO) (*dto.HardwareIDResetDTO, error)
+	FindRequestsPaged(
+		ctx context.Context,
+		status *dto.HardwareIDResetStatus,
+		page, size int,
+	) (*dto.PaginatedResult[*dto.HardwareIDResetDTO], error)
+	Approve(
+		ctx context.Context,
+		performer *dto.UserDTO,
+		requestID int,
+		comment *string,
+	) (*dto.HardwareIDResetDTO, error)
+	Reject(
+		ctx context.Context,
+		performer *dto.UserDTO,
+		requestID int,
+		comment *string,
+	) (*dto.HardwareIDResetDTO, error)
+}
@@ -13,10 +13,21 @@ type InventoryItemEventService interface {
 		performer optional.Optional[*dto.UserDTO],
 		item *dto.InventoryItemDTO,
 	)
+	// HandleItemsObtained notifies every receiver of the batch about obtained item.
+	HandleItemsObtained(
+		ctx context.Context,
+		performer optional.Optional[*dto.UserDTO],
+		items []*dto.InventoryItemDTO,
+	)
 	HandleItemRevoked(
 		ctx context.Context,
 		receiverID int,
 		performer optional.Optional[*dto.UserDTO],
 		item *dto.InventoryItemDTO,
 	)
+	HandleItemsDismantled(
+		ctx context.Context,
+		receiverID int,
+		result *dto.DismantleResultDTO,
+	)
 }
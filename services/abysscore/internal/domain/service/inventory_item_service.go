@@ -3,7 +3,9 @@ package domainservice
 import (
 	"context"
 
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/dto/request"
 	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/inventoryitementity"
 )
 
 type InventoryItemService interface {
@@ -15,7 +17,12 @@ type InventoryItemService interface {
 	) (*dto.InventoryItemDTO, error)
 	// GrantToUserBySystem(ctx context.Context, request *dto.GrantInventoryItemDTO) error
 
-	FindAllByUserID(ctx context.Context, userID int) ([]*dto.InventoryItemDTO, error)
+	FindAllPagedByUserID(
+		ctx context.Context,
+		userID int,
+		query *request.PaginationQuery[inventoryitementity.OrderBy],
+		filter *inventoryitementity.Filter,
+	) (*dto.PaginatedInventoryDTO, error)
 
 	RevokeByAdmin(
 		ctx context.Context,
@@ -26,4 +33,11 @@ type InventoryItemService interface {
 	// RevokeBySystem(ctx context.Context, inventoryItemID int, performer *dto.UserDTO) error
 
 	SetInventoryItemAsCurrent(ctx context.Context, user *dto.UserDTO, inventoryItemID int) error
+
+	// Dismantle converts user's inventory items into coins, showcased item is protected.
+	Dismantle(
+		ctx context.Context,
+		user *dto.UserDTO,
+		inventoryItemIDs []int,
+	) (*dto.DismantleResultDTO, error)
 }
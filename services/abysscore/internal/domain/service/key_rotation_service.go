@@ -0,0 +1,11 @@
+package domainservice
+
+import (
+	"context"
+)
+
+type KeyRotationService interface {
+	// RunReencryptionLoop re-encrypts hardware ids and TOTP secrets with the newest key until ctx is done,
+	// an old key can be removed once nothing is encoded with it.
+	RunReencryptionLoop(ctx context.Context)
+}
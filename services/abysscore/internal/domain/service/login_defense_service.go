@@ -0,0 +1,21 @@
+package domainservice
+
+import (
+	"context"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+)
+
+// LoginDefenseService throttles failed logins and keeps login history.
+type LoginDefenseService interface {
+	// CheckAllowed fails if username, ip or hardware id of the attempt is locked out.
+	CheckAllowed(ctx context.Context, attempt *dto.LoginAttemptDTO) error
+	// HandleFailure counts failed attempt, user is nil if there is no user with the username.
+	HandleFailure(ctx context.Context, attempt *dto.LoginAttemptDTO, user *dto.UserDTO, hardwareIDMatch bool)
+	HandleSuccess(ctx context.Context, attempt *dto.LoginAttemptDTO, user *dto.UserDTO)
+	FindHistoryPaged(
+		ctx context.Context,
+		userID int,
+		page, size int,
+	) (*dto.PaginatedResult[*dto.LoginHistoryDTO], error)
+}
@@ -0,0 +1,22 @@
+package domainservice
+
+import (
+	"context"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+)
+
+type PersonalDataService interface {
+	Export(ctx context.Context, user *dto.UserDTO) (*dto.PersonalDataDTO, error)
+	// RequestDeletion schedules erasure of the account after the grace period and ends other sessions.
+	RequestDeletion(
+		ctx context.Context,
+		user *dto.UserDTO,
+		sessionID string,
+		password string,
+		twoFactorCode string,
+	) (*dto.AccountDeletionDTO, error)
+	CancelDeletion(ctx context.Context, user *dto.UserDTO) error
+	// RunDeletionLoop erases accounts with passed deletion grace period until ctx is done.
+	RunDeletionLoop(ctx context.Context)
+}
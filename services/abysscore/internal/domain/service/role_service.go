@@ -0,0 +1,30 @@
+package domainservice
+
+import (
+	"context"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/permission"
+)
+
+type RoleService interface {
+	Permissions() []permission.Permission
+	FindAll(ctx context.Context) ([]*dto.RoleDTO, error)
+	// Create, Update and Delete are allowed only if performer has every permission of the role.
+	Create(ctx context.Context, performer *dto.UserDTO, role *dto.SaveRoleDTO) (*dto.RoleDTO, error)
+	Update(
+		ctx context.Context,
+		performer *dto.UserDTO,
+		roleID int,
+		role *dto.SaveRoleDTO,
+	) (*dto.RoleDTO, error)
+	Delete(ctx context.Context, performer *dto.UserDTO, roleID int) error
+	FindByUserID(ctx context.Context, userID int) ([]*dto.RoleDTO, error)
+	// SetUserRoles is allowed only if performer has every permission of assigned and removed roles.
+	SetUserRoles(
+		ctx context.Context,
+		performer *dto.UserDTO,
+		userID int,
+		roleIDs []int,
+	) ([]*dto.RoleDTO, error)
+}
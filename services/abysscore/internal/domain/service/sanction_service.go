@@ -0,0 +1,29 @@
+package domainservice
+
+import (
+	"context"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+)
+
+type SanctionService interface {
+	// Issue issues sanction of the next ladder level, the level is escalated while the previous sanction is counted.
+	Issue(
+		ctx context.Context,
+		performer *dto.UserDTO,
+		userID int,
+		sanctionType dto.SanctionType,
+		reason string,
+	) (*dto.SanctionDTO, error)
+	Lift(ctx context.Context, performer *dto.UserDTO, sanctionID int, comment *string) (*dto.SanctionDTO, error)
+	Appeal(ctx context.Context, user *dto.UserDTO, sanctionID int, message string) (*dto.SanctionDTO, error)
+	ResolveAppeal(
+		ctx context.Context,
+		performer *dto.UserDTO,
+		sanctionID int,
+		accepted bool,
+		comment *string,
+	) (*dto.SanctionDTO, error)
+	FindByUserID(ctx context.Context, userID int) ([]*dto.SanctionDTO, error)
+	FindPendingAppealsPaged(ctx context.Context, page, size int) (*dto.PaginatedResult[*dto.SanctionDTO], error)
+}
@@ -0,0 +1,32 @@
+package domainservice
+
+import (
+	"context"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/controller/http/dto/request"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+)
+
+type SeasonService interface {
+	Create(
+		ctx context.Context,
+		request *request.CreateSeason,
+		performer *dto.UserDTO,
+	) (*dto.SeasonDTO, error)
+
+	FindCurrent(ctx context.Context) (*dto.SeasonDTO, error)
+
+	FindPassByUserID(ctx context.Context, userID int) (*dto.SeasonPassDTO, error)
+	Claim(
+		ctx context.Context,
+		user *dto.UserDTO,
+		request *request.ClaimSeasonReward,
+	) (*dto.SeasonRewardClaimDTO, error)
+	PurchasePremium(ctx context.Context, user *dto.UserDTO) (*dto.SeasonPassDTO, error)
+
+	// HandleLoginStreak adds daily login XP to the current season.
+	HandleLoginStreak(ctx context.Context, userID int, streak int)
+
+	// RunRolloverLoop grants unclaimed rewards of ended seasons until ctx is done.
+	RunRolloverLoop(ctx context.Context)
+}
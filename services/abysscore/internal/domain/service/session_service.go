@@ -0,0 +1,18 @@
+package domainservice
+
+import (
+	"context"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+)
+
+type SessionService interface {
+	FindByUserID(ctx context.Context, userID int, currentSessionID string) ([]*dto.SessionDTO, error)
+	// End revokes tokens of the session and disconnects its websocket connection.
+	End(ctx context.Context, userID int, sessionID string) error
+	EndOthers(ctx context.Context, userID int, currentSessionID string) error
+	EndAll(ctx context.Context, userID int) error
+	// ReissueAccessTokens rejects access tokens of all user sessions and returns a new one for the current
+	// session. Other sessions get tokens with actual user data after refresh.
+	ReissueAccessTokens(ctx context.Context, user *dto.UserDTO, currentSessionID string) (string, error)
+}
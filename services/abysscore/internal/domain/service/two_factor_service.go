@@ -0,0 +1,29 @@
+package domainservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+)
+
+type TwoFactorService interface {
+	// Enroll starts enrollment, two-factor authentication is enabled only after ConfirmEnrollment.
+	Enroll(ctx context.Context, user *dto.UserDTO) (*dto.TOTPEnrollmentDTO, error)
+	ConfirmEnrollment(ctx context.Context, user *dto.UserDTO, code string) (*dto.RecoveryCodesDTO, error)
+	Disable(ctx context.Context, user *dto.UserDTO, code string) error
+	RegenerateRecoveryCodes(ctx context.Context, user *dto.UserDTO, code string) (*dto.RecoveryCodesDTO, error)
+	// Challenge verifies TOTP or recovery code if user has enabled two-factor authentication.
+	// User must be loaded from the database, not from authentication cache.
+	Challenge(ctx context.Context, user *dto.UserDTO, code string) error
+	// ChallengeByUserID loads user and calls Challenge.
+	ChallengeByUserID(ctx context.Context, userID int, code string) error
+}
+
+type TOTPHelper interface {
+	GenerateTOTPSecret() string
+	TOTPProvisioningURI(secret, accountName string) string
+	ValidateTOTP(secret, code string, at time.Time) (step int64, ok bool)
+	GenerateRecoveryCode() string
+	TOTPPeriod() time.Duration
+}
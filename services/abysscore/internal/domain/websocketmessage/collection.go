@@ -0,0 +1,35 @@
+package websocketmessage
+
+import "github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+
+const collectionCompletedMessageSubtype = "collection_completed"
+
+type CollectionCompletedMessage struct {
+	*BaseMessage
+
+	Data struct {
+		Completion *dto.CollectionCompletionDTO `json:"completion"`
+	} `json:"data"`
+}
+
+func NewCollectionCompletedMessage(
+	eventID string,
+	completion *dto.CollectionCompletionDTO,
+) *CollectionCompletedMessage {
+	const message = "collection completed"
+
+	return &CollectionCompletedMessage{
+		BaseMessage: NewBaseMessage(
+			eventID,
+			inventoryMessageType,
+			collectionCompletedMessageSubtype,
+			message,
+			SystemIsSenderName,
+		),
+		Data: struct {
+			Completion *dto.CollectionCompletionDTO `json:"completion"`
+		}{
+			Completion: completion,
+		},
+	}
+}
@@ -3,8 +3,9 @@ package websocketmessage
 import "github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
 
 const (
-	inventoryMessageType = "inventory"
-	itemMessageSubtype   = "item"
+	inventoryMessageType         = "inventory"
+	itemMessageSubtype           = "item"
+	itemDismantledMessageSubtype = "dismantled"
 )
 
 type InventoryItemObtainedMessage struct {
@@ -68,3 +69,36 @@ func NewInventoryItemRevokedMessage(
 		},
 	}
 }
+
+type InventoryItemDismantledMessage struct {
+	*BaseMessage
+
+	Data struct {
+		Items []*dto.InventoryItemDTO `json:"items"`
+		Coins float64                 `json:"coins"`
+	} `json:"data"`
+}
+
+func NewInventoryItemDismantledMessage(
+	eventID string,
+	result *dto.DismantleResultDTO,
+) *InventoryItemDismantledMessage {
+	const message = "items dismantled"
+
+	return &InventoryItemDismantledMessage{
+		BaseMessage: NewBaseMessage(
+			eventID,
+			inventoryMessageType,
+			itemDismantledMessageSubtype,
+			message,
+			SystemIsSenderName,
+		),
+		Data: struct {
+			Items []*dto.InventoryItemDTO `json:"items"`
+			Coins float64                 `json:"coins"`
+		}{
+			Items: result.Items,
+			Coins: result.Coins,
+		},
+	}
+}
@@ -0,0 +1,64 @@
+package websocketmessage
+
+import "github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+
+const (
+	sanctionMessageType = "sanction"
+
+	sanctionIssuedMessageSubtype         = "issued"
+	sanctionLiftedMessageSubtype         = "lifted"
+	sanctionAppealResolvedMessageSubtype = "appeal_resolved"
+)
+
+type SanctionMessage struct {
+	*BaseMessage
+
+	Data struct {
+		Sanction *dto.SanctionDTO `json:"sanction"`
+	} `json:"data"`
+}
+
+func NewSanctionIssuedMessage(eventID, performerName string, sanction *dto.SanctionDTO) *SanctionMessage {
+	return newSanctionMessage(eventID, sanctionIssuedMessageSubtype, "sanction issued", performerName, sanction)
+}
+
+func NewSanctionLiftedMessage(eventID, performerName string, sanction *dto.SanctionDTO) *SanctionMessage {
+	return newSanctionMessage(eventID, sanctionLiftedMessageSubtype, "sanction lifted", performerName, sanction)
+}
+
+func NewSanctionAppealResolvedMessage(
+	eventID string,
+	performerName string,
+	sanction *dto.SanctionDTO,
+) *SanctionMessage {
+	return newSanctionMessage(
+		eventID,
+		sanctionAppealResolvedMessageSubtype,
+		"sanction appeal resolved",
+		performerName,
+		sanction,
+	)
+}
+
+func newSanctionMessage(
+	eventID string,
+	subtype messageSubtype,
+	message string,
+	performerName string,
+	sanction *dto.SanctionDTO,
+) *SanctionMessage {
+	return &SanctionMessage{
+		BaseMessage: NewBaseMessage(
+			eventID,
+			sanctionMessageType,
+			subtype,
+			message,
+			performerName,
+		),
+		Data: struct {
+			Sanction *dto.SanctionDTO `json:"sanction"`
+		}{
+			Sanction: sanction,
+		},
+	}
+}
@@ -0,0 +1,43 @@
+package websocketmessage
+
+import "github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+
+const (
+	seasonMessageType                  = "season"
+	seasonRewardsGrantedMessageSubtype = "rewards_granted"
+)
+
+type SeasonRewardsGrantedMessage struct {
+	*BaseMessage
+
+	Data struct {
+		Season *dto.SeasonDTO              `json:"season"`
+		Claims []*dto.SeasonRewardClaimDTO `json:"claims"`
+	} `json:"data"`
+}
+
+// NewSeasonRewardsGrantedMessage notifies about unclaimed rewards granted on season rollover.
+func NewSeasonRewardsGrantedMessage(
+	eventID string,
+	season *dto.SeasonDTO,
+	claims []*dto.SeasonRewardClaimDTO,
+) *SeasonRewardsGrantedMessage {
+	const message = "unclaimed season rewards granted"
+
+	return &SeasonRewardsGrantedMessage{
+		BaseMessage: NewBaseMessage(
+			eventID,
+			seasonMessageType,
+			seasonRewardsGrantedMessageSubtype,
+			message,
+			SystemIsSenderName,
+		),
+		Data: struct {
+			Season *dto.SeasonDTO              `json:"season"`
+			Claims []*dto.SeasonRewardClaimDTO `json:"claims"`
+		}{
+			Season: season,
+			Claims: claims,
+		},
+	}
+}
@@ -18,11 +18,13 @@ type BannedHardwareID struct {
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
 	// HardwareID holds the value of the "hardware_id" field.
-	HardwareID string `json:"hardware_id,omitempty"`
+	HardwareID string `json:"-"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// BanReason holds the value of the "ban_reason" field.
-	BanReason    *string `json:"ban_reason,omitempty"`
+	BanReason *string `json:"ban_reason,omitempty"`
+	// PerformerID holds the value of the "performer_id" field.
+	PerformerID  *int `json:"performer_id,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -31,7 +33,7 @@ func (*BannedHardwareID) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case bannedhardwareid.FieldID:
+		case bannedhardwareid.FieldID, bannedhardwareid.FieldPerformerID:
 			values[i] = new(sql.NullInt64)
 		case bannedhardwareid.FieldHardwareID, bannedhardwareid.FieldBanReason:
 			values[i] = new(sql.NullString)
@@ -77,6 +79,13 @@ func (bhi *BannedHardwareID) assignValues(columns []string, values []any) error
 				bhi.BanReason = new(string)
 				*bhi.BanReason = value.String
 			}
+		case bannedhardwareid.FieldPerformerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field performer_id", values[i])
+			} else if value.Valid {
+				bhi.PerformerID = new(int)
+				*bhi.PerformerID = int(value.Int64)
+			}
 		default:
 			bhi.selectValues.Set(columns[i], values[i])
 		}
@@ -113,8 +122,7 @@ func (bhi *BannedHardwareID) String() string {
 	var builder strings.Builder
 	builder.WriteString("BannedHardwareID(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", bhi.ID))
-	builder.WriteString("hardware_id=")
-	builder.WriteString(bhi.HardwareID)
+	builder.WriteString("hardware_id=<sensitive>")
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(bhi.CreatedAt.Format(time.ANSIC))
@@ -123,6 +131,11 @@ func (bhi *BannedHardwareID) String() string {
 		builder.WriteString("ban_reason=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	if v := bhi.PerformerID; v != nil {
+		builder.WriteString("performer_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
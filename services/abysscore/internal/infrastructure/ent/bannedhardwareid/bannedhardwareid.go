@@ -3,6 +3,8 @@
 package bannedhardwareid
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 )
 
@@ -17,6 +19,8 @@ const (
 	FieldCreatedAt = "created_at"
 	// FieldBanReason holds the string denoting the ban_reason field in the database.
 	FieldBanReason = "ban_reason"
+	// FieldPerformerID holds the string denoting the performer_id field in the database.
+	FieldPerformerID = "performer_id"
 	// Table holds the table name of the bannedhardwareid in the database.
 	Table = "banned_hardware_ids"
 )
@@ -27,6 +31,7 @@ var Columns = []string{
 	FieldHardwareID,
 	FieldCreatedAt,
 	FieldBanReason,
+	FieldPerformerID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -39,6 +44,11 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
 // OrderOption defines the ordering options for the BannedHardwareID queries.
 type OrderOption func(*sql.Selector)
 
@@ -61,3 +71,8 @@ func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 func ByBanReason(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBanReason, opts...).ToFunc()
 }
+
+// ByPerformerID orders the results by the performer_id field.
+func ByPerformerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPerformerID, opts...).ToFunc()
+}
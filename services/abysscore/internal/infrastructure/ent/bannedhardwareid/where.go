@@ -69,6 +69,11 @@ func BanReason(v string) predicate.BannedHardwareID {
 	return predicate.BannedHardwareID(sql.FieldEQ(FieldBanReason, v))
 }
 
+// PerformerID applies equality check predicate on the "performer_id" field. It's identical to PerformerIDEQ.
+func PerformerID(v int) predicate.BannedHardwareID {
+	return predicate.BannedHardwareID(sql.FieldEQ(FieldPerformerID, v))
+}
+
 // HardwareIDEQ applies the EQ predicate on the "hardware_id" field.
 func HardwareIDEQ(v string) predicate.BannedHardwareID {
 	return predicate.BannedHardwareID(sql.FieldEQ(FieldHardwareID, v))
@@ -249,6 +254,56 @@ func BanReasonContainsFold(v string) predicate.BannedHardwareID {
 	return predicate.BannedHardwareID(sql.FieldContainsFold(FieldBanReason, v))
 }
 
+// PerformerIDEQ applies the EQ predicate on the "performer_id" field.
+func PerformerIDEQ(v int) predicate.BannedHardwareID {
+	return predicate.BannedHardwareID(sql.FieldEQ(FieldPerformerID, v))
+}
+
+// PerformerIDNEQ applies the NEQ predicate on the "performer_id" field.
+func PerformerIDNEQ(v int) predicate.BannedHardwareID {
+	return predicate.BannedHardwareID(sql.FieldNEQ(FieldPerformerID, v))
+}
+
+// PerformerIDIn applies the In predicate on the "performer_id" field.
+func PerformerIDIn(vs ...int) predicate.BannedHardwareID {
+	return predicate.BannedHardwareID(sql.FieldIn(FieldPerformerID, vs...))
+}
+
+// PerformerIDNotIn applies the NotIn predicate on the "performer_id" field.
+func PerformerIDNotIn(vs ...int) predicate.BannedHardwareID {
+	return predicate.BannedHardwareID(sql.FieldNotIn(FieldPerformerID, vs...))
+}
+
+// PerformerIDGT applies the GT predicate on the "performer_id" field.
+func PerformerIDGT(v int) predicate.BannedHardwareID {
+	return predicate.BannedHardwareID(sql.FieldGT(FieldPerformerID, v))
+}
+
+// PerformerIDGTE applies the GTE predicate on the "performer_id" field.
+func PerformerIDGTE(v int) predicate.BannedHardwareID {
+	return predicate.BannedHardwareID(sql.FieldGTE(FieldPerformerID, v))
+}
+
+// PerformerIDLT applies the LT predicate on the "performer_id" field.
+func PerformerIDLT(v int) predicate.BannedHardwareID {
+	return predicate.BannedHardwareID(sql.FieldLT(FieldPerformerID, v))
+}
+
+// PerformerIDLTE applies the LTE predicate on the "performer_id" field.
+func PerformerIDLTE(v int) predicate.BannedHardwareID {
+	return predicate.BannedHardwareID(sql.FieldLTE(FieldPerformerID, v))
+}
+
+// PerformerIDIsNil applies the IsNil predicate on the "performer_id" field.
+func PerformerIDIsNil() predicate.BannedHardwareID {
+	return predicate.BannedHardwareID(sql.FieldIsNull(FieldPerformerID))
+}
+
+// PerformerIDNotNil applies the NotNil predicate on the "performer_id" field.
+func PerformerIDNotNil() predicate.BannedHardwareID {
+	return predicate.BannedHardwareID(sql.FieldNotNull(FieldPerformerID))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.BannedHardwareID) predicate.BannedHardwareID {
 	return predicate.BannedHardwareID(sql.AndPredicates(predicates...))
@@ -32,6 +32,14 @@ func (bhic *BannedHardwareIDCreate) SetCreatedAt(t time.Time) *BannedHardwareIDC
 	return bhic
 }
 
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (bhic *BannedHardwareIDCreate) SetNillableCreatedAt(t *time.Time) *BannedHardwareIDCreate {
+	if t != nil {
+		bhic.SetCreatedAt(*t)
+	}
+	return bhic
+}
+
 // SetBanReason sets the "ban_reason" field.
 func (bhic *BannedHardwareIDCreate) SetBanReason(s string) *BannedHardwareIDCreate {
 	bhic.mutation.SetBanReason(s)
@@ -46,6 +54,20 @@ func (bhic *BannedHardwareIDCreate) SetNillableBanReason(s *string) *BannedHardw
 	return bhic
 }
 
+// SetPerformerID sets the "performer_id" field.
+func (bhic *BannedHardwareIDCreate) SetPerformerID(i int) *BannedHardwareIDCreate {
+	bhic.mutation.SetPerformerID(i)
+	return bhic
+}
+
+// SetNillablePerformerID sets the "performer_id" field if the given value is not nil.
+func (bhic *BannedHardwareIDCreate) SetNillablePerformerID(i *int) *BannedHardwareIDCreate {
+	if i != nil {
+		bhic.SetPerformerID(*i)
+	}
+	return bhic
+}
+
 // SetID sets the "id" field.
 func (bhic *BannedHardwareIDCreate) SetID(i int) *BannedHardwareIDCreate {
 	bhic.mutation.SetID(i)
@@ -59,6 +81,7 @@ func (bhic *BannedHardwareIDCreate) Mutation() *BannedHardwareIDMutation {
 
 // Save creates the BannedHardwareID in the database.
 func (bhic *BannedHardwareIDCreate) Save(ctx context.Context) (*BannedHardwareID, error) {
+	bhic.defaults()
 	return withHooks(ctx, bhic.sqlSave, bhic.mutation, bhic.hooks)
 }
 
@@ -84,6 +107,14 @@ func (bhic *BannedHardwareIDCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (bhic *BannedHardwareIDCreate) defaults() {
+	if _, ok := bhic.mutation.CreatedAt(); !ok {
+		v := bannedhardwareid.DefaultCreatedAt()
+		bhic.mutation.SetCreatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (bhic *BannedHardwareIDCreate) check() error {
 	if _, ok := bhic.mutation.HardwareID(); !ok {
@@ -136,6 +167,10 @@ func (bhic *BannedHardwareIDCreate) createSpec() (*BannedHardwareID, *sqlgraph.C
 		_spec.SetField(bannedhardwareid.FieldBanReason, field.TypeString, value)
 		_node.BanReason = &value
 	}
+	if value, ok := bhic.mutation.PerformerID(); ok {
+		_spec.SetField(bannedhardwareid.FieldPerformerID, field.TypeInt, value)
+		_node.PerformerID = &value
+	}
 	return _node, _spec
 }
 
@@ -157,6 +192,7 @@ func (bhicb *BannedHardwareIDCreateBulk) Save(ctx context.Context) ([]*BannedHar
 	for i := range bhicb.builders {
 		func(i int, root context.Context) {
 			builder := bhicb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*BannedHardwareIDMutation)
 				if !ok {
@@ -94,6 +94,9 @@ func (bhiu *BannedHardwareIDUpdate) sqlSave(ctx context.Context) (n int, err err
 	if bhiu.mutation.BanReasonCleared() {
 		_spec.ClearField(bannedhardwareid.FieldBanReason, field.TypeString)
 	}
+	if bhiu.mutation.PerformerIDCleared() {
+		_spec.ClearField(bannedhardwareid.FieldPerformerID, field.TypeInt)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, bhiu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{bannedhardwareid.Label}
@@ -211,6 +214,9 @@ func (bhiuo *BannedHardwareIDUpdateOne) sqlSave(ctx context.Context) (_node *Ban
 	if bhiuo.mutation.BanReasonCleared() {
 		_spec.ClearField(bannedhardwareid.FieldBanReason, field.TypeString)
 	}
+	if bhiuo.mutation.PerformerIDCleared() {
+		_spec.ClearField(bannedhardwareid.FieldPerformerID, field.TypeInt)
+	}
 	_node = &BannedHardwareID{config: bhiuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
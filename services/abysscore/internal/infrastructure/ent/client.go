@@ -15,15 +15,33 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/apitoken"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/auditlog"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/bannedhardwareid"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/collectioncompletion"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/collectionreward"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/emailhistory"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/externalidentity"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/friendrequest"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/gameitem"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/grantjob"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/hardwareidreset"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/inventoryitem"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/loginhistory"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/match"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/passwordhistory"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/playermatchresult"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/recoverycode"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/role"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/sanction"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/season"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasonpass"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasonrewardclaim"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasontier"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/statistic"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/userbalance"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/usernamehistory"
 )
 
 // Client is the client that holds all ent builders.
@@ -31,24 +49,60 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// APIToken is the client for interacting with the APIToken builders.
+	APIToken *APITokenClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
 	// BannedHardwareID is the client for interacting with the BannedHardwareID builders.
 	BannedHardwareID *BannedHardwareIDClient
+	// CollectionCompletion is the client for interacting with the CollectionCompletion builders.
+	CollectionCompletion *CollectionCompletionClient
+	// CollectionReward is the client for interacting with the CollectionReward builders.
+	CollectionReward *CollectionRewardClient
+	// EmailHistory is the client for interacting with the EmailHistory builders.
+	EmailHistory *EmailHistoryClient
+	// ExternalIdentity is the client for interacting with the ExternalIdentity builders.
+	ExternalIdentity *ExternalIdentityClient
 	// FriendRequest is the client for interacting with the FriendRequest builders.
 	FriendRequest *FriendRequestClient
 	// GameItem is the client for interacting with the GameItem builders.
 	GameItem *GameItemClient
+	// GrantJob is the client for interacting with the GrantJob builders.
+	GrantJob *GrantJobClient
+	// HardwareIDReset is the client for interacting with the HardwareIDReset builders.
+	HardwareIDReset *HardwareIDResetClient
 	// InventoryItem is the client for interacting with the InventoryItem builders.
 	InventoryItem *InventoryItemClient
+	// LoginHistory is the client for interacting with the LoginHistory builders.
+	LoginHistory *LoginHistoryClient
 	// Match is the client for interacting with the Match builders.
 	Match *MatchClient
+	// PasswordHistory is the client for interacting with the PasswordHistory builders.
+	PasswordHistory *PasswordHistoryClient
 	// PlayerMatchResult is the client for interacting with the PlayerMatchResult builders.
 	PlayerMatchResult *PlayerMatchResultClient
+	// RecoveryCode is the client for interacting with the RecoveryCode builders.
+	RecoveryCode *RecoveryCodeClient
+	// Role is the client for interacting with the Role builders.
+	Role *RoleClient
+	// Sanction is the client for interacting with the Sanction builders.
+	Sanction *SanctionClient
+	// Season is the client for interacting with the Season builders.
+	Season *SeasonClient
+	// SeasonPass is the client for interacting with the SeasonPass builders.
+	SeasonPass *SeasonPassClient
+	// SeasonRewardClaim is the client for interacting with the SeasonRewardClaim builders.
+	SeasonRewardClaim *SeasonRewardClaimClient
+	// SeasonTier is the client for interacting with the SeasonTier builders.
+	SeasonTier *SeasonTierClient
 	// Statistic is the client for interacting with the Statistic builders.
 	Statistic *StatisticClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UserBalance is the client for interacting with the UserBalance builders.
 	UserBalance *UserBalanceClient
+	// UsernameHistory is the client for interacting with the UsernameHistory builders.
+	UsernameHistory *UsernameHistoryClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -60,15 +114,33 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.APIToken = NewAPITokenClient(c.config)
+	c.AuditLog = NewAuditLogClient(c.config)
 	c.BannedHardwareID = NewBannedHardwareIDClient(c.config)
+	c.CollectionCompletion = NewCollectionCompletionClient(c.config)
+	c.CollectionReward = NewCollectionRewardClient(c.config)
+	c.EmailHistory = NewEmailHistoryClient(c.config)
+	c.ExternalIdentity = NewExternalIdentityClient(c.config)
 	c.FriendRequest = NewFriendRequestClient(c.config)
 	c.GameItem = NewGameItemClient(c.config)
+	c.GrantJob = NewGrantJobClient(c.config)
+	c.HardwareIDReset = NewHardwareIDResetClient(c.config)
 	c.InventoryItem = NewInventoryItemClient(c.config)
+	c.LoginHistory = NewLoginHistoryClient(c.config)
 	c.Match = NewMatchClient(c.config)
+	c.PasswordHistory = NewPasswordHistoryClient(c.config)
 	c.PlayerMatchResult = NewPlayerMatchResultClient(c.config)
+	c.RecoveryCode = NewRecoveryCodeClient(c.config)
+	c.Role = NewRoleClient(c.config)
+	c.Sanction = NewSanctionClient(c.config)
+	c.Season = NewSeasonClient(c.config)
+	c.SeasonPass = NewSeasonPassClient(c.config)
+	c.SeasonRewardClaim = NewSeasonRewardClaimClient(c.config)
+	c.SeasonTier = NewSeasonTierClient(c.config)
 	c.Statistic = NewStatisticClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.UserBalance = NewUserBalanceClient(c.config)
+	c.UsernameHistory = NewUsernameHistoryClient(c.config)
 }
 
 type (
@@ -159,17 +231,35 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:               ctx,
-		config:            cfg,
-		BannedHardwareID:  NewBannedHardwareIDClient(cfg),
-		FriendRequest:     NewFriendRequestClient(cfg),
-		GameItem:          NewGameItemClient(cfg),
-		InventoryItem:     NewInventoryItemClient(cfg),
-		Match:             NewMatchClient(cfg),
-		PlayerMatchResult: NewPlayerMatchResultClient(cfg),
-		Statistic:         NewStatisticClient(cfg),
-		User:              NewUserClient(cfg),
-		UserBalance:       NewUserBalanceClient(cfg),
+		ctx:                  ctx,
+		config:               cfg,
+		APIToken:             NewAPITokenClient(cfg),
+		AuditLog:             NewAuditLogClient(cfg),
+		BannedHardwareID:     NewBannedHardwareIDClient(cfg),
+		CollectionCompletion: NewCollectionCompletionClient(cfg),
+		CollectionReward:     NewCollectionRewardClient(cfg),
+		EmailHistory:         NewEmailHistoryClient(cfg),
+		ExternalIdentity:     NewExternalIdentityClient(cfg),
+		FriendRequest:        NewFriendRequestClient(cfg),
+		GameItem:             NewGameItemClient(cfg),
+		GrantJob:             NewGrantJobClient(cfg),
+		HardwareIDReset:      NewHardwareIDResetClient(cfg),
+		InventoryItem:        NewInventoryItemClient(cfg),
+		LoginHistory:         NewLoginHistoryClient(cfg),
+		Match:                NewMatchClient(cfg),
+		PasswordHistory:      NewPasswordHistoryClient(cfg),
+		PlayerMatchResult:    NewPlayerMatchResultClient(cfg),
+		RecoveryCode:         NewRecoveryCodeClient(cfg),
+		Role:                 NewRoleClient(cfg),
+		Sanction:             NewSanctionClient(cfg),
+		Season:               NewSeasonClient(cfg),
+		SeasonPass:           NewSeasonPassClient(cfg),
+		SeasonRewardClaim:    NewSeasonRewardClaimClient(cfg),
+		SeasonTier:           NewSeasonTierClient(cfg),
+		Statistic:            NewStatisticClient(cfg),
+		User:                 NewUserClient(cfg),
+		UserBalance:          NewUserBalanceClient(cfg),
+		UsernameHistory:      NewUsernameHistoryClient(cfg),
 	}, nil
 }
 
@@ -187,24 +277,42 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:               ctx,
-		config:            cfg,
-		BannedHardwareID:  NewBannedHardwareIDClient(cfg),
-		FriendRequest:     NewFriendRequestClient(cfg),
-		GameItem:          NewGameItemClient(cfg),
-		InventoryItem:     NewInventoryItemClient(cfg),
-		Match:             NewMatchClient(cfg),
-		PlayerMatchResult: NewPlayerMatchResultClient(cfg),
-		Statistic:         NewStatisticClient(cfg),
-		User:              NewUserClient(cfg),
-		UserBalance:       NewUserBalanceClient(cfg),
+		ctx:                  ctx,
+		config:               cfg,
+		APIToken:             NewAPITokenClient(cfg),
+		AuditLog:             NewAuditLogClient(cfg),
+		BannedHardwareID:     NewBannedHardwareIDClient(cfg),
+		CollectionCompletion: NewCollectionCompletionClient(cfg),
+		CollectionReward:     NewCollectionRewardClient(cfg),
+		EmailHistory:         NewEmailHistoryClient(cfg),
+		ExternalIdentity:     NewExternalIdentityClient(cfg),
+		FriendRequest:        NewFriendRequestClient(cfg),
+		GameItem:             NewGameItemClient(cfg),
+		GrantJob:             NewGrantJobClient(cfg),
+		HardwareIDReset:      NewHardwareIDResetClient(cfg),
+		InventoryItem:        NewInventoryItemClient(cfg),
+		LoginHistory:         NewLoginHistoryClient(cfg),
+		Match:                NewMatchClient(cfg),
+		PasswordHistory:      NewPasswordHistoryClient(cfg),
+		PlayerMatchResult:    NewPlayerMatchResultClient(cfg),
+		RecoveryCode:         NewRecoveryCodeClient(cfg),
+		Role:                 NewRoleClient(cfg),
+		Sanction:             NewSanctionClient(cfg),
+		Season:               NewSeasonClient(cfg),
+		SeasonPass:           NewSeasonPassClient(cfg),
+		SeasonRewardClaim:    NewSeasonRewardClaimClient(cfg),
+		SeasonTier:           NewSeasonTierClient(cfg),
+		Statistic:            NewStatisticClient(cfg),
+		User:                 NewUserClient(cfg),
+		UserBalance:          NewUserBalanceClient(cfg),
+		UsernameHistory:      NewUsernameHistoryClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		BannedHardwareID.
+//		APIToken.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -227,8 +335,12 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.BannedHardwareID, c.FriendRequest, c.GameItem, c.InventoryItem, c.Match,
-		c.PlayerMatchResult, c.Statistic, c.User, c.UserBalance,
+		c.APIToken, c.AuditLog, c.BannedHardwareID, c.CollectionCompletion,
+		c.CollectionReward, c.EmailHistory, c.ExternalIdentity, c.FriendRequest,
+		c.GameItem, c.GrantJob, c.HardwareIDReset, c.InventoryItem, c.LoginHistory,
+		c.Match, c.PasswordHistory, c.PlayerMatchResult, c.RecoveryCode, c.Role,
+		c.Sanction, c.Season, c.SeasonPass, c.SeasonRewardClaim, c.SeasonTier,
+		c.Statistic, c.User, c.UserBalance, c.UsernameHistory,
 	} {
 		n.Use(hooks...)
 	}
@@ -238,8 +350,12 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.BannedHardwareID, c.FriendRequest, c.GameItem, c.InventoryItem, c.Match,
-		c.PlayerMatchResult, c.Statistic, c.User, c.UserBalance,
+		c.APIToken, c.AuditLog, c.BannedHardwareID, c.CollectionCompletion,
+		c.CollectionReward, c.EmailHistory, c.ExternalIdentity, c.FriendRequest,
+		c.GameItem, c.GrantJob, c.HardwareIDReset, c.InventoryItem, c.LoginHistory,
+		c.Match, c.PasswordHistory, c.PlayerMatchResult, c.RecoveryCode, c.Role,
+		c.Sanction, c.Season, c.SeasonPass, c.SeasonRewardClaim, c.SeasonTier,
+		c.Statistic, c.User, c.UserBalance, c.UsernameHistory,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -248,29 +364,347 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *APITokenMutation:
+		return c.APIToken.mutate(ctx, m)
+	case *AuditLogMutation:
+		return c.AuditLog.mutate(ctx, m)
 	case *BannedHardwareIDMutation:
 		return c.BannedHardwareID.mutate(ctx, m)
+	case *CollectionCompletionMutation:
+		return c.CollectionCompletion.mutate(ctx, m)
+	case *CollectionRewardMutation:
+		return c.CollectionReward.mutate(ctx, m)
+	case *EmailHistoryMutation:
+		return c.EmailHistory.mutate(ctx, m)
+	case *ExternalIdentityMutation:
+		return c.ExternalIdentity.mutate(ctx, m)
 	case *FriendRequestMutation:
 		return c.FriendRequest.mutate(ctx, m)
 	case *GameItemMutation:
 		return c.GameItem.mutate(ctx, m)
+	case *GrantJobMutation:
+		return c.GrantJob.mutate(ctx, m)
+	case *HardwareIDResetMutation:
+		return c.HardwareIDReset.mutate(ctx, m)
 	case *InventoryItemMutation:
 		return c.InventoryItem.mutate(ctx, m)
+	case *LoginHistoryMutation:
+		return c.LoginHistory.mutate(ctx, m)
 	case *MatchMutation:
 		return c.Match.mutate(ctx, m)
+	case *PasswordHistoryMutation:
+		return c.PasswordHistory.mutate(ctx, m)
 	case *PlayerMatchResultMutation:
 		return c.PlayerMatchResult.mutate(ctx, m)
+	case *RecoveryCodeMutation:
+		return c.RecoveryCode.mutate(ctx, m)
+	case *RoleMutation:
+		return c.Role.mutate(ctx, m)
+	case *SanctionMutation:
+		return c.Sanction.mutate(ctx, m)
+	case *SeasonMutation:
+		return c.Season.mutate(ctx, m)
+	case *SeasonPassMutation:
+		return c.SeasonPass.mutate(ctx, m)
+	case *SeasonRewardClaimMutation:
+		return c.SeasonRewardClaim.mutate(ctx, m)
+	case *SeasonTierMutation:
+		return c.SeasonTier.mutate(ctx, m)
 	case *StatisticMutation:
 		return c.Statistic.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	case *UserBalanceMutation:
 		return c.UserBalance.mutate(ctx, m)
+	case *UsernameHistoryMutation:
+		return c.UsernameHistory.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// APITokenClient is a client for the APIToken schema.
+type APITokenClient struct {
+	config
+}
+
+// NewAPITokenClient returns a client for the APIToken from the given config.
+func NewAPITokenClient(c config) *APITokenClient {
+	return &APITokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `apitoken.Hooks(f(g(h())))`.
+func (c *APITokenClient) Use(hooks ...Hook) {
+	c.hooks.APIToken = append(c.hooks.APIToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `apitoken.Intercept(f(g(h())))`.
+func (c *APITokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.APIToken = append(c.inters.APIToken, interceptors...)
+}
+
+// Create returns a builder for creating a APIToken entity.
+func (c *APITokenClient) Create() *APITokenCreate {
+	mutation := newAPITokenMutation(c.config, OpCreate)
+	return &APITokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of APIToken entities.
+func (c *APITokenClient) CreateBulk(builders ...*APITokenCreate) *APITokenCreateBulk {
+	return &APITokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *APITokenClient) MapCreateBulk(slice any, setFunc func(*APITokenCreate, int)) *APITokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &APITokenCreateBulk{err: fmt.Errorf("calling to APITokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*APITokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &APITokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for APIToken.
+func (c *APITokenClient) Update() *APITokenUpdate {
+	mutation := newAPITokenMutation(c.config, OpUpdate)
+	return &APITokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *APITokenClient) UpdateOne(at *APIToken) *APITokenUpdateOne {
+	mutation := newAPITokenMutation(c.config, OpUpdateOne, withAPIToken(at))
+	return &APITokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *APITokenClient) UpdateOneID(id int) *APITokenUpdateOne {
+	mutation := newAPITokenMutation(c.config, OpUpdateOne, withAPITokenID(id))
+	return &APITokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for APIToken.
+func (c *APITokenClient) Delete() *APITokenDelete {
+	mutation := newAPITokenMutation(c.config, OpDelete)
+	return &APITokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *APITokenClient) DeleteOne(at *APIToken) *APITokenDeleteOne {
+	return c.DeleteOneID(at.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *APITokenClient) DeleteOneID(id int) *APITokenDeleteOne {
+	builder := c.Delete().Where(apitoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &APITokenDeleteOne{builder}
+}
+
+// Query returns a query builder for APIToken.
+func (c *APITokenClient) Query() *APITokenQuery {
+	return &APITokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAPIToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a APIToken entity by its id.
+func (c *APITokenClient) Get(ctx context.Context, id int) (*APIToken, error) {
+	return c.Query().Where(apitoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *APITokenClient) GetX(ctx context.Context, id int) *APIToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a APIToken.
+func (c *APITokenClient) QueryUser(at *APIToken) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := at.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(apitoken.Table, apitoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, apitoken.UserTable, apitoken.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(at.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *APITokenClient) Hooks() []Hook {
+	return c.hooks.APIToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *APITokenClient) Interceptors() []Interceptor {
+	return c.inters.APIToken
+}
+
+func (c *APITokenClient) mutate(ctx context.Context, m *APITokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&APITokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&APITokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&APITokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&APITokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown APIToken mutation op: %q", m.Op())
+	}
+}
+
+// AuditLogClient is a client for the AuditLog schema.
+type AuditLogClient struct {
+	config
+}
+
+// NewAuditLogClient returns a client for the AuditLog from the given config.
+func NewAuditLogClient(c config) *AuditLogClient {
+	return &AuditLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditlog.Hooks(f(g(h())))`.
+func (c *AuditLogClient) Use(hooks ...Hook) {
+	c.hooks.AuditLog = append(c.hooks.AuditLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditlog.Intercept(f(g(h())))`.
+func (c *AuditLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditLog = append(c.inters.AuditLog, interceptors...)
+}
+
+// Create returns a builder for creating a AuditLog entity.
+func (c *AuditLogClient) Create() *AuditLogCreate {
+	mutation := newAuditLogMutation(c.config, OpCreate)
+	return &AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditLog entities.
+func (c *AuditLogClient) CreateBulk(builders ...*AuditLogCreate) *AuditLogCreateBulk {
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditLogClient) MapCreateBulk(slice any, setFunc func(*AuditLogCreate, int)) *AuditLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditLogCreateBulk{err: fmt.Errorf("calling to AuditLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditLog.
+func (c *AuditLogClient) Update() *AuditLogUpdate {
+	mutation := newAuditLogMutation(c.config, OpUpdate)
+	return &AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditLogClient) UpdateOne(al *AuditLog) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLog(al))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditLogClient) UpdateOneID(id int) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLogID(id))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditLog.
+func (c *AuditLogClient) Delete() *AuditLogDelete {
+	mutation := newAuditLogMutation(c.config, OpDelete)
+	return &AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditLogClient) DeleteOne(al *AuditLog) *AuditLogDeleteOne {
+	return c.DeleteOneID(al.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditLogClient) DeleteOneID(id int) *AuditLogDeleteOne {
+	builder := c.Delete().Where(auditlog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditLogDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditLog.
+func (c *AuditLogClient) Query() *AuditLogQuery {
+	return &AuditLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditLog entity by its id.
+func (c *AuditLogClient) Get(ctx context.Context, id int) (*AuditLog, error) {
+	return c.Query().Where(auditlog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditLogClient) GetX(ctx context.Context, id int) *AuditLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditLogClient) Hooks() []Hook {
+	return c.hooks.AuditLog
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditLogClient) Interceptors() []Interceptor {
+	return c.inters.AuditLog
+}
+
+func (c *AuditLogClient) mutate(ctx context.Context, m *AuditLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuditLog mutation op: %q", m.Op())
+	}
+}
+
 // BannedHardwareIDClient is a client for the BannedHardwareID schema.
 type BannedHardwareIDClient struct {
 	config
@@ -404,107 +838,107 @@ func (c *BannedHardwareIDClient) mutate(ctx context.Context, m *BannedHardwareID
 	}
 }
 
-// FriendRequestClient is a client for the FriendRequest schema.
-type FriendRequestClient struct {
+// CollectionCompletionClient is a client for the CollectionCompletion schema.
+type CollectionCompletionClient struct {
 	config
 }
 
-// NewFriendRequestClient returns a client for the FriendRequest from the given config.
-func NewFriendRequestClient(c config) *FriendRequestClient {
-	return &FriendRequestClient{config: c}
+// NewCollectionCompletionClient returns a client for the CollectionCompletion from the given config.
+func NewCollectionCompletionClient(c config) *CollectionCompletionClient {
+	return &CollectionCompletionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `friendrequest.Hooks(f(g(h())))`.
-func (c *FriendRequestClient) Use(hooks ...Hook) {
-	c.hooks.FriendRequest = append(c.hooks.FriendRequest, hooks...)
+// A call to `Use(f, g, h)` equals to `collectioncompletion.Hooks(f(g(h())))`.
+func (c *CollectionCompletionClient) Use(hooks ...Hook) {
+	c.hooks.CollectionCompletion = append(c.hooks.CollectionCompletion, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `friendrequest.Intercept(f(g(h())))`.
-func (c *FriendRequestClient) Intercept(interceptors ...Interceptor) {
-	c.inters.FriendRequest = append(c.inters.FriendRequest, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `collectioncompletion.Intercept(f(g(h())))`.
+func (c *CollectionCompletionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CollectionCompletion = append(c.inters.CollectionCompletion, interceptors...)
 }
 
-// Create returns a builder for creating a FriendRequest entity.
-func (c *FriendRequestClient) Create() *FriendRequestCreate {
-	mutation := newFriendRequestMutation(c.config, OpCreate)
-	return &FriendRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CollectionCompletion entity.
+func (c *CollectionCompletionClient) Create() *CollectionCompletionCreate {
+	mutation := newCollectionCompletionMutation(c.config, OpCreate)
+	return &CollectionCompletionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of FriendRequest entities.
-func (c *FriendRequestClient) CreateBulk(builders ...*FriendRequestCreate) *FriendRequestCreateBulk {
-	return &FriendRequestCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CollectionCompletion entities.
+func (c *CollectionCompletionClient) CreateBulk(builders ...*CollectionCompletionCreate) *CollectionCompletionCreateBulk {
+	return &CollectionCompletionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *FriendRequestClient) MapCreateBulk(slice any, setFunc func(*FriendRequestCreate, int)) *FriendRequestCreateBulk {
+func (c *CollectionCompletionClient) MapCreateBulk(slice any, setFunc func(*CollectionCompletionCreate, int)) *CollectionCompletionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &FriendRequestCreateBulk{err: fmt.Errorf("calling to FriendRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CollectionCompletionCreateBulk{err: fmt.Errorf("calling to CollectionCompletionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*FriendRequestCreate, rv.Len())
+	builders := make([]*CollectionCompletionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &FriendRequestCreateBulk{config: c.config, builders: builders}
+	return &CollectionCompletionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for FriendRequest.
-func (c *FriendRequestClient) Update() *FriendRequestUpdate {
-	mutation := newFriendRequestMutation(c.config, OpUpdate)
-	return &FriendRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CollectionCompletion.
+func (c *CollectionCompletionClient) Update() *CollectionCompletionUpdate {
+	mutation := newCollectionCompletionMutation(c.config, OpUpdate)
+	return &CollectionCompletionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *FriendRequestClient) UpdateOne(fr *FriendRequest) *FriendRequestUpdateOne {
-	mutation := newFriendRequestMutation(c.config, OpUpdateOne, withFriendRequest(fr))
-	return &FriendRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CollectionCompletionClient) UpdateOne(cc *CollectionCompletion) *CollectionCompletionUpdateOne {
+	mutation := newCollectionCompletionMutation(c.config, OpUpdateOne, withCollectionCompletion(cc))
+	return &CollectionCompletionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *FriendRequestClient) UpdateOneID(id int) *FriendRequestUpdateOne {
-	mutation := newFriendRequestMutation(c.config, OpUpdateOne, withFriendRequestID(id))
-	return &FriendRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CollectionCompletionClient) UpdateOneID(id int) *CollectionCompletionUpdateOne {
+	mutation := newCollectionCompletionMutation(c.config, OpUpdateOne, withCollectionCompletionID(id))
+	return &CollectionCompletionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for FriendRequest.
-func (c *FriendRequestClient) Delete() *FriendRequestDelete {
-	mutation := newFriendRequestMutation(c.config, OpDelete)
-	return &FriendRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CollectionCompletion.
+func (c *CollectionCompletionClient) Delete() *CollectionCompletionDelete {
+	mutation := newCollectionCompletionMutation(c.config, OpDelete)
+	return &CollectionCompletionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *FriendRequestClient) DeleteOne(fr *FriendRequest) *FriendRequestDeleteOne {
-	return c.DeleteOneID(fr.ID)
+func (c *CollectionCompletionClient) DeleteOne(cc *CollectionCompletion) *CollectionCompletionDeleteOne {
+	return c.DeleteOneID(cc.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *FriendRequestClient) DeleteOneID(id int) *FriendRequestDeleteOne {
-	builder := c.Delete().Where(friendrequest.ID(id))
+func (c *CollectionCompletionClient) DeleteOneID(id int) *CollectionCompletionDeleteOne {
+	builder := c.Delete().Where(collectioncompletion.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &FriendRequestDeleteOne{builder}
+	return &CollectionCompletionDeleteOne{builder}
 }
 
-// Query returns a query builder for FriendRequest.
-func (c *FriendRequestClient) Query() *FriendRequestQuery {
-	return &FriendRequestQuery{
+// Query returns a query builder for CollectionCompletion.
+func (c *CollectionCompletionClient) Query() *CollectionCompletionQuery {
+	return &CollectionCompletionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeFriendRequest},
+		ctx:    &QueryContext{Type: TypeCollectionCompletion},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a FriendRequest entity by its id.
-func (c *FriendRequestClient) Get(ctx context.Context, id int) (*FriendRequest, error) {
-	return c.Query().Where(friendrequest.ID(id)).Only(ctx)
+// Get returns a CollectionCompletion entity by its id.
+func (c *CollectionCompletionClient) Get(ctx context.Context, id int) (*CollectionCompletion, error) {
+	return c.Query().Where(collectioncompletion.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *FriendRequestClient) GetX(ctx context.Context, id int) *FriendRequest {
+func (c *CollectionCompletionClient) GetX(ctx context.Context, id int) *CollectionCompletion {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -512,164 +946,2431 @@ func (c *FriendRequestClient) GetX(ctx context.Context, id int) *FriendRequest {
 	return obj
 }
 
-// QueryFromUser queries the from_user edge of a FriendRequest.
-func (c *FriendRequestClient) QueryFromUser(fr *FriendRequest) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := fr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(friendrequest.Table, friendrequest.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, friendrequest.FromUserTable, friendrequest.FromUserColumn),
-		)
-		fromV = sqlgraph.Neighbors(fr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryToUser queries the to_user edge of a FriendRequest.
-func (c *FriendRequestClient) QueryToUser(fr *FriendRequest) *UserQuery {
+// QueryUser queries the user edge of a CollectionCompletion.
+func (c *CollectionCompletionClient) QueryUser(cc *CollectionCompletion) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := fr.ID
+		id := cc.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(friendrequest.Table, friendrequest.FieldID, id),
+			sqlgraph.From(collectioncompletion.Table, collectioncompletion.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, friendrequest.ToUserTable, friendrequest.ToUserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, collectioncompletion.UserTable, collectioncompletion.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(fr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(cc.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *FriendRequestClient) Hooks() []Hook {
-	return c.hooks.FriendRequest
+func (c *CollectionCompletionClient) Hooks() []Hook {
+	return c.hooks.CollectionCompletion
 }
 
 // Interceptors returns the client interceptors.
-func (c *FriendRequestClient) Interceptors() []Interceptor {
-	return c.inters.FriendRequest
+func (c *CollectionCompletionClient) Interceptors() []Interceptor {
+	return c.inters.CollectionCompletion
 }
 
-func (c *FriendRequestClient) mutate(ctx context.Context, m *FriendRequestMutation) (Value, error) {
+func (c *CollectionCompletionClient) mutate(ctx context.Context, m *CollectionCompletionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&FriendRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CollectionCompletionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&FriendRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CollectionCompletionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&FriendRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CollectionCompletionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&FriendRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CollectionCompletionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown FriendRequest mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown CollectionCompletion mutation op: %q", m.Op())
 	}
 }
 
-// GameItemClient is a client for the GameItem schema.
-type GameItemClient struct {
+// CollectionRewardClient is a client for the CollectionReward schema.
+type CollectionRewardClient struct {
 	config
 }
 
-// NewGameItemClient returns a client for the GameItem from the given config.
-func NewGameItemClient(c config) *GameItemClient {
-	return &GameItemClient{config: c}
+// NewCollectionRewardClient returns a client for the CollectionReward from the given config.
+func NewCollectionRewardClient(c config) *CollectionRewardClient {
+	return &CollectionRewardClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `gameitem.Hooks(f(g(h())))`.
-func (c *GameItemClient) Use(hooks ...Hook) {
-	c.hooks.GameItem = append(c.hooks.GameItem, hooks...)
+// A call to `Use(f, g, h)` equals to `collectionreward.Hooks(f(g(h())))`.
+func (c *CollectionRewardClient) Use(hooks ...Hook) {
+	c.hooks.CollectionReward = append(c.hooks.CollectionReward, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `gameitem.Intercept(f(g(h())))`.
-func (c *GameItemClient) Intercept(interceptors ...Interceptor) {
-	c.inters.GameItem = append(c.inters.GameItem, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `collectionreward.Intercept(f(g(h())))`.
+func (c *CollectionRewardClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CollectionReward = append(c.inters.CollectionReward, interceptors...)
 }
 
-// Create returns a builder for creating a GameItem entity.
-func (c *GameItemClient) Create() *GameItemCreate {
-	mutation := newGameItemMutation(c.config, OpCreate)
-	return &GameItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CollectionReward entity.
+func (c *CollectionRewardClient) Create() *CollectionRewardCreate {
+	mutation := newCollectionRewardMutation(c.config, OpCreate)
+	return &CollectionRewardCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of GameItem entities.
-func (c *GameItemClient) CreateBulk(builders ...*GameItemCreate) *GameItemCreateBulk {
+// CreateBulk returns a builder for creating a bulk of CollectionReward entities.
+func (c *CollectionRewardClient) CreateBulk(builders ...*CollectionRewardCreate) *CollectionRewardCreateBulk {
+	return &CollectionRewardCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CollectionRewardClient) MapCreateBulk(slice any, setFunc func(*CollectionRewardCreate, int)) *CollectionRewardCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CollectionRewardCreateBulk{err: fmt.Errorf("calling to CollectionRewardClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CollectionRewardCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CollectionRewardCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CollectionReward.
+func (c *CollectionRewardClient) Update() *CollectionRewardUpdate {
+	mutation := newCollectionRewardMutation(c.config, OpUpdate)
+	return &CollectionRewardUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CollectionRewardClient) UpdateOne(cr *CollectionReward) *CollectionRewardUpdateOne {
+	mutation := newCollectionRewardMutation(c.config, OpUpdateOne, withCollectionReward(cr))
+	return &CollectionRewardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CollectionRewardClient) UpdateOneID(id int) *CollectionRewardUpdateOne {
+	mutation := newCollectionRewardMutation(c.config, OpUpdateOne, withCollectionRewardID(id))
+	return &CollectionRewardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CollectionReward.
+func (c *CollectionRewardClient) Delete() *CollectionRewardDelete {
+	mutation := newCollectionRewardMutation(c.config, OpDelete)
+	return &CollectionRewardDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CollectionRewardClient) DeleteOne(cr *CollectionReward) *CollectionRewardDeleteOne {
+	return c.DeleteOneID(cr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CollectionRewardClient) DeleteOneID(id int) *CollectionRewardDeleteOne {
+	builder := c.Delete().Where(collectionreward.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CollectionRewardDeleteOne{builder}
+}
+
+// Query returns a query builder for CollectionReward.
+func (c *CollectionRewardClient) Query() *CollectionRewardQuery {
+	return &CollectionRewardQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCollectionReward},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CollectionReward entity by its id.
+func (c *CollectionRewardClient) Get(ctx context.Context, id int) (*CollectionReward, error) {
+	return c.Query().Where(collectionreward.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CollectionRewardClient) GetX(ctx context.Context, id int) *CollectionReward {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CollectionRewardClient) Hooks() []Hook {
+	return c.hooks.CollectionReward
+}
+
+// Interceptors returns the client interceptors.
+func (c *CollectionRewardClient) Interceptors() []Interceptor {
+	return c.inters.CollectionReward
+}
+
+func (c *CollectionRewardClient) mutate(ctx context.Context, m *CollectionRewardMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CollectionRewardCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CollectionRewardUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CollectionRewardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CollectionRewardDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CollectionReward mutation op: %q", m.Op())
+	}
+}
+
+// EmailHistoryClient is a client for the EmailHistory schema.
+type EmailHistoryClient struct {
+	config
+}
+
+// NewEmailHistoryClient returns a client for the EmailHistory from the given config.
+func NewEmailHistoryClient(c config) *EmailHistoryClient {
+	return &EmailHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `emailhistory.Hooks(f(g(h())))`.
+func (c *EmailHistoryClient) Use(hooks ...Hook) {
+	c.hooks.EmailHistory = append(c.hooks.EmailHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `emailhistory.Intercept(f(g(h())))`.
+func (c *EmailHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmailHistory = append(c.inters.EmailHistory, interceptors...)
+}
+
+// Create returns a builder for creating a EmailHistory entity.
+func (c *EmailHistoryClient) Create() *EmailHistoryCreate {
+	mutation := newEmailHistoryMutation(c.config, OpCreate)
+	return &EmailHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EmailHistory entities.
+func (c *EmailHistoryClient) CreateBulk(builders ...*EmailHistoryCreate) *EmailHistoryCreateBulk {
+	return &EmailHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EmailHistoryClient) MapCreateBulk(slice any, setFunc func(*EmailHistoryCreate, int)) *EmailHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EmailHistoryCreateBulk{err: fmt.Errorf("calling to EmailHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EmailHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EmailHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EmailHistory.
+func (c *EmailHistoryClient) Update() *EmailHistoryUpdate {
+	mutation := newEmailHistoryMutation(c.config, OpUpdate)
+	return &EmailHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmailHistoryClient) UpdateOne(eh *EmailHistory) *EmailHistoryUpdateOne {
+	mutation := newEmailHistoryMutation(c.config, OpUpdateOne, withEmailHistory(eh))
+	return &EmailHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmailHistoryClient) UpdateOneID(id int) *EmailHistoryUpdateOne {
+	mutation := newEmailHistoryMutation(c.config, OpUpdateOne, withEmailHistoryID(id))
+	return &EmailHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EmailHistory.
+func (c *EmailHistoryClient) Delete() *EmailHistoryDelete {
+	mutation := newEmailHistoryMutation(c.config, OpDelete)
+	return &EmailHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmailHistoryClient) DeleteOne(eh *EmailHistory) *EmailHistoryDeleteOne {
+	return c.DeleteOneID(eh.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmailHistoryClient) DeleteOneID(id int) *EmailHistoryDeleteOne {
+	builder := c.Delete().Where(emailhistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmailHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for EmailHistory.
+func (c *EmailHistoryClient) Query() *EmailHistoryQuery {
+	return &EmailHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmailHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EmailHistory entity by its id.
+func (c *EmailHistoryClient) Get(ctx context.Context, id int) (*EmailHistory, error) {
+	return c.Query().Where(emailhistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmailHistoryClient) GetX(ctx context.Context, id int) *EmailHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a EmailHistory.
+func (c *EmailHistoryClient) QueryUser(eh *EmailHistory) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := eh.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(emailhistory.Table, emailhistory.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, emailhistory.UserTable, emailhistory.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(eh.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EmailHistoryClient) Hooks() []Hook {
+	return c.hooks.EmailHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmailHistoryClient) Interceptors() []Interceptor {
+	return c.inters.EmailHistory
+}
+
+func (c *EmailHistoryClient) mutate(ctx context.Context, m *EmailHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmailHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmailHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmailHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmailHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EmailHistory mutation op: %q", m.Op())
+	}
+}
+
+// ExternalIdentityClient is a client for the ExternalIdentity schema.
+type ExternalIdentityClient struct {
+	config
+}
+
+// NewExternalIdentityClient returns a client for the ExternalIdentity from the given config.
+func NewExternalIdentityClient(c config) *ExternalIdentityClient {
+	return &ExternalIdentityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `externalidentity.Hooks(f(g(h())))`.
+func (c *ExternalIdentityClient) Use(hooks ...Hook) {
+	c.hooks.ExternalIdentity = append(c.hooks.ExternalIdentity, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `externalidentity.Intercept(f(g(h())))`.
+func (c *ExternalIdentityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ExternalIdentity = append(c.inters.ExternalIdentity, interceptors...)
+}
+
+// Create returns a builder for creating a ExternalIdentity entity.
+func (c *ExternalIdentityClient) Create() *ExternalIdentityCreate {
+	mutation := newExternalIdentityMutation(c.config, OpCreate)
+	return &ExternalIdentityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ExternalIdentity entities.
+func (c *ExternalIdentityClient) CreateBulk(builders ...*ExternalIdentityCreate) *ExternalIdentityCreateBulk {
+	return &ExternalIdentityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ExternalIdentityClient) MapCreateBulk(slice any, setFunc func(*ExternalIdentityCreate, int)) *ExternalIdentityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ExternalIdentityCreateBulk{err: fmt.Errorf("calling to ExternalIdentityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ExternalIdentityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ExternalIdentityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ExternalIdentity.
+func (c *ExternalIdentityClient) Update() *ExternalIdentityUpdate {
+	mutation := newExternalIdentityMutation(c.config, OpUpdate)
+	return &ExternalIdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ExternalIdentityClient) UpdateOne(ei *ExternalIdentity) *ExternalIdentityUpdateOne {
+	mutation := newExternalIdentityMutation(c.config, OpUpdateOne, withExternalIdentity(ei))
+	return &ExternalIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ExternalIdentityClient) UpdateOneID(id int) *ExternalIdentityUpdateOne {
+	mutation := newExternalIdentityMutation(c.config, OpUpdateOne, withExternalIdentityID(id))
+	return &ExternalIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ExternalIdentity.
+func (c *ExternalIdentityClient) Delete() *ExternalIdentityDelete {
+	mutation := newExternalIdentityMutation(c.config, OpDelete)
+	return &ExternalIdentityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExternalIdentityClient) DeleteOne(ei *ExternalIdentity) *ExternalIdentityDeleteOne {
+	return c.DeleteOneID(ei.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ExternalIdentityClient) DeleteOneID(id int) *ExternalIdentityDeleteOne {
+	builder := c.Delete().Where(externalidentity.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExternalIdentityDeleteOne{builder}
+}
+
+// Query returns a query builder for ExternalIdentity.
+func (c *ExternalIdentityClient) Query() *ExternalIdentityQuery {
+	return &ExternalIdentityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeExternalIdentity},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ExternalIdentity entity by its id.
+func (c *ExternalIdentityClient) Get(ctx context.Context, id int) (*ExternalIdentity, error) {
+	return c.Query().Where(externalidentity.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExternalIdentityClient) GetX(ctx context.Context, id int) *ExternalIdentity {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a ExternalIdentity.
+func (c *ExternalIdentityClient) QueryUser(ei *ExternalIdentity) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ei.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(externalidentity.Table, externalidentity.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, externalidentity.UserTable, externalidentity.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(ei.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ExternalIdentityClient) Hooks() []Hook {
+	return c.hooks.ExternalIdentity
+}
+
+// Interceptors returns the client interceptors.
+func (c *ExternalIdentityClient) Interceptors() []Interceptor {
+	return c.inters.ExternalIdentity
+}
+
+func (c *ExternalIdentityClient) mutate(ctx context.Context, m *ExternalIdentityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ExternalIdentityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ExternalIdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ExternalIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ExternalIdentityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ExternalIdentity mutation op: %q", m.Op())
+	}
+}
+
+// FriendRequestClient is a client for the FriendRequest schema.
+type FriendRequestClient struct {
+	config
+}
+
+// NewFriendRequestClient returns a client for the FriendRequest from the given config.
+func NewFriendRequestClient(c config) *FriendRequestClient {
+	return &FriendRequestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `friendrequest.Hooks(f(g(h())))`.
+func (c *FriendRequestClient) Use(hooks ...Hook) {
+	c.hooks.FriendRequest = append(c.hooks.FriendRequest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `friendrequest.Intercept(f(g(h())))`.
+func (c *FriendRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FriendRequest = append(c.inters.FriendRequest, interceptors...)
+}
+
+// Create returns a builder for creating a FriendRequest entity.
+func (c *FriendRequestClient) Create() *FriendRequestCreate {
+	mutation := newFriendRequestMutation(c.config, OpCreate)
+	return &FriendRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FriendRequest entities.
+func (c *FriendRequestClient) CreateBulk(builders ...*FriendRequestCreate) *FriendRequestCreateBulk {
+	return &FriendRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FriendRequestClient) MapCreateBulk(slice any, setFunc func(*FriendRequestCreate, int)) *FriendRequestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FriendRequestCreateBulk{err: fmt.Errorf("calling to FriendRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FriendRequestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FriendRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FriendRequest.
+func (c *FriendRequestClient) Update() *FriendRequestUpdate {
+	mutation := newFriendRequestMutation(c.config, OpUpdate)
+	return &FriendRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FriendRequestClient) UpdateOne(fr *FriendRequest) *FriendRequestUpdateOne {
+	mutation := newFriendRequestMutation(c.config, OpUpdateOne, withFriendRequest(fr))
+	return &FriendRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FriendRequestClient) UpdateOneID(id int) *FriendRequestUpdateOne {
+	mutation := newFriendRequestMutation(c.config, OpUpdateOne, withFriendRequestID(id))
+	return &FriendRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FriendRequest.
+func (c *FriendRequestClient) Delete() *FriendRequestDelete {
+	mutation := newFriendRequestMutation(c.config, OpDelete)
+	return &FriendRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FriendRequestClient) DeleteOne(fr *FriendRequest) *FriendRequestDeleteOne {
+	return c.DeleteOneID(fr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FriendRequestClient) DeleteOneID(id int) *FriendRequestDeleteOne {
+	builder := c.Delete().Where(friendrequest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FriendRequestDeleteOne{builder}
+}
+
+// Query returns a query builder for FriendRequest.
+func (c *FriendRequestClient) Query() *FriendRequestQuery {
+	return &FriendRequestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFriendRequest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FriendRequest entity by its id.
+func (c *FriendRequestClient) Get(ctx context.Context, id int) (*FriendRequest, error) {
+	return c.Query().Where(friendrequest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FriendRequestClient) GetX(ctx context.Context, id int) *FriendRequest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFromUser queries the from_user edge of a FriendRequest.
+func (c *FriendRequestClient) QueryFromUser(fr *FriendRequest) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := fr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(friendrequest.Table, friendrequest.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, friendrequest.FromUserTable, friendrequest.FromUserColumn),
+		)
+		fromV = sqlgraph.Neighbors(fr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryToUser queries the to_user edge of a FriendRequest.
+func (c *FriendRequestClient) QueryToUser(fr *FriendRequest) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := fr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(friendrequest.Table, friendrequest.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, friendrequest.ToUserTable, friendrequest.ToUserColumn),
+		)
+		fromV = sqlgraph.Neighbors(fr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FriendRequestClient) Hooks() []Hook {
+	return c.hooks.FriendRequest
+}
+
+// Interceptors returns the client interceptors.
+func (c *FriendRequestClient) Interceptors() []Interceptor {
+	return c.inters.FriendRequest
+}
+
+func (c *FriendRequestClient) mutate(ctx context.Context, m *FriendRequestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FriendRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FriendRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FriendRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FriendRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FriendRequest mutation op: %q", m.Op())
+	}
+}
+
+// GameItemClient is a client for the GameItem schema.
+type GameItemClient struct {
+	config
+}
+
+// NewGameItemClient returns a client for the GameItem from the given config.
+func NewGameItemClient(c config) *GameItemClient {
+	return &GameItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `gameitem.Hooks(f(g(h())))`.
+func (c *GameItemClient) Use(hooks ...Hook) {
+	c.hooks.GameItem = append(c.hooks.GameItem, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `gameitem.Intercept(f(g(h())))`.
+func (c *GameItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GameItem = append(c.inters.GameItem, interceptors...)
+}
+
+// Create returns a builder for creating a GameItem entity.
+func (c *GameItemClient) Create() *GameItemCreate {
+	mutation := newGameItemMutation(c.config, OpCreate)
+	return &GameItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GameItem entities.
+func (c *GameItemClient) CreateBulk(builders ...*GameItemCreate) *GameItemCreateBulk {
+	return &GameItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GameItemClient) MapCreateBulk(slice any, setFunc func(*GameItemCreate, int)) *GameItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GameItemCreateBulk{err: fmt.Errorf("calling to GameItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GameItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
 	return &GameItemCreateBulk{config: c.config, builders: builders}
 }
 
+// Update returns an update builder for GameItem.
+func (c *GameItemClient) Update() *GameItemUpdate {
+	mutation := newGameItemMutation(c.config, OpUpdate)
+	return &GameItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GameItemClient) UpdateOne(gi *GameItem) *GameItemUpdateOne {
+	mutation := newGameItemMutation(c.config, OpUpdateOne, withGameItem(gi))
+	return &GameItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GameItemClient) UpdateOneID(id int) *GameItemUpdateOne {
+	mutation := newGameItemMutation(c.config, OpUpdateOne, withGameItemID(id))
+	return &GameItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GameItem.
+func (c *GameItemClient) Delete() *GameItemDelete {
+	mutation := newGameItemMutation(c.config, OpDelete)
+	return &GameItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GameItemClient) DeleteOne(gi *GameItem) *GameItemDeleteOne {
+	return c.DeleteOneID(gi.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GameItemClient) DeleteOneID(id int) *GameItemDeleteOne {
+	builder := c.Delete().Where(gameitem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GameItemDeleteOne{builder}
+}
+
+// Query returns a query builder for GameItem.
+func (c *GameItemClient) Query() *GameItemQuery {
+	return &GameItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGameItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GameItem entity by its id.
+func (c *GameItemClient) Get(ctx context.Context, id int) (*GameItem, error) {
+	return c.Query().Where(gameitem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GameItemClient) GetX(ctx context.Context, id int) *GameItem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryInventoryItems queries the inventory_items edge of a GameItem.
+func (c *GameItemClient) QueryInventoryItems(gi *GameItem) *InventoryItemQuery {
+	query := (&InventoryItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gi.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(gameitem.Table, gameitem.FieldID, id),
+			sqlgraph.To(inventoryitem.Table, inventoryitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, gameitem.InventoryItemsTable, gameitem.InventoryItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(gi.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GameItemClient) Hooks() []Hook {
+	return c.hooks.GameItem
+}
+
+// Interceptors returns the client interceptors.
+func (c *GameItemClient) Interceptors() []Interceptor {
+	return c.inters.GameItem
+}
+
+func (c *GameItemClient) mutate(ctx context.Context, m *GameItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GameItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GameItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GameItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GameItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GameItem mutation op: %q", m.Op())
+	}
+}
+
+// GrantJobClient is a client for the GrantJob schema.
+type GrantJobClient struct {
+	config
+}
+
+// NewGrantJobClient returns a client for the GrantJob from the given config.
+func NewGrantJobClient(c config) *GrantJobClient {
+	return &GrantJobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `grantjob.Hooks(f(g(h())))`.
+func (c *GrantJobClient) Use(hooks ...Hook) {
+	c.hooks.GrantJob = append(c.hooks.GrantJob, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `grantjob.Intercept(f(g(h())))`.
+func (c *GrantJobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GrantJob = append(c.inters.GrantJob, interceptors...)
+}
+
+// Create returns a builder for creating a GrantJob entity.
+func (c *GrantJobClient) Create() *GrantJobCreate {
+	mutation := newGrantJobMutation(c.config, OpCreate)
+	return &GrantJobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GrantJob entities.
+func (c *GrantJobClient) CreateBulk(builders ...*GrantJobCreate) *GrantJobCreateBulk {
+	return &GrantJobCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GrantJobClient) MapCreateBulk(slice any, setFunc func(*GrantJobCreate, int)) *GrantJobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GrantJobCreateBulk{err: fmt.Errorf("calling to GrantJobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GrantJobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GrantJobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GrantJob.
+func (c *GrantJobClient) Update() *GrantJobUpdate {
+	mutation := newGrantJobMutation(c.config, OpUpdate)
+	return &GrantJobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GrantJobClient) UpdateOne(gj *GrantJob) *GrantJobUpdateOne {
+	mutation := newGrantJobMutation(c.config, OpUpdateOne, withGrantJob(gj))
+	return &GrantJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GrantJobClient) UpdateOneID(id int) *GrantJobUpdateOne {
+	mutation := newGrantJobMutation(c.config, OpUpdateOne, withGrantJobID(id))
+	return &GrantJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GrantJob.
+func (c *GrantJobClient) Delete() *GrantJobDelete {
+	mutation := newGrantJobMutation(c.config, OpDelete)
+	return &GrantJobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GrantJobClient) DeleteOne(gj *GrantJob) *GrantJobDeleteOne {
+	return c.DeleteOneID(gj.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GrantJobClient) DeleteOneID(id int) *GrantJobDeleteOne {
+	builder := c.Delete().Where(grantjob.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GrantJobDeleteOne{builder}
+}
+
+// Query returns a query builder for GrantJob.
+func (c *GrantJobClient) Query() *GrantJobQuery {
+	return &GrantJobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGrantJob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GrantJob entity by its id.
+func (c *GrantJobClient) Get(ctx context.Context, id int) (*GrantJob, error) {
+	return c.Query().Where(grantjob.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GrantJobClient) GetX(ctx context.Context, id int) *GrantJob {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *GrantJobClient) Hooks() []Hook {
+	return c.hooks.GrantJob
+}
+
+// Interceptors returns the client interceptors.
+func (c *GrantJobClient) Interceptors() []Interceptor {
+	return c.inters.GrantJob
+}
+
+func (c *GrantJobClient) mutate(ctx context.Context, m *GrantJobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GrantJobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GrantJobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GrantJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GrantJobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GrantJob mutation op: %q", m.Op())
+	}
+}
+
+// HardwareIDResetClient is a client for the HardwareIDReset schema.
+type HardwareIDResetClient struct {
+	config
+}
+
+// NewHardwareIDResetClient returns a client for the HardwareIDReset from the given config.
+func NewHardwareIDResetClient(c config) *HardwareIDResetClient {
+	return &HardwareIDResetClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `hardwareidreset.Hooks(f(g(h())))`.
+func (c *HardwareIDResetClient) Use(hooks ...Hook) {
+	c.hooks.HardwareIDReset = append(c.hooks.HardwareIDReset, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `hardwareidreset.Intercept(f(g(h())))`.
+func (c *HardwareIDResetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.HardwareIDReset = append(c.inters.HardwareIDReset, interceptors...)
+}
+
+// Create returns a builder for creating a HardwareIDReset entity.
+func (c *HardwareIDResetClient) Create() *HardwareIDResetCreate {
+	mutation := newHardwareIDResetMutation(c.config, OpCreate)
+	return &HardwareIDResetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of HardwareIDReset entities.
+func (c *HardwareIDResetClient) CreateBulk(builders ...*HardwareIDResetCreate) *HardwareIDResetCreateBulk {
+	return &HardwareIDResetCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *HardwareIDResetClient) MapCreateBulk(slice any, setFunc func(*HardwareIDResetCreate, int)) *HardwareIDResetCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &HardwareIDResetCreateBulk{err: fmt.Errorf("calling to HardwareIDResetClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*HardwareIDResetCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &HardwareIDResetCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for HardwareIDReset.
+func (c *HardwareIDResetClient) Update() *HardwareIDResetUpdate {
+	mutation := newHardwareIDResetMutation(c.config, OpUpdate)
+	return &HardwareIDResetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *HardwareIDResetClient) UpdateOne(hir *HardwareIDReset) *HardwareIDResetUpdateOne {
+	mutation := newHardwareIDResetMutation(c.config, OpUpdateOne, withHardwareIDReset(hir))
+	return &HardwareIDResetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *HardwareIDResetClient) UpdateOneID(id int) *HardwareIDResetUpdateOne {
+	mutation := newHardwareIDResetMutation(c.config, OpUpdateOne, withHardwareIDResetID(id))
+	return &HardwareIDResetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for HardwareIDReset.
+func (c *HardwareIDResetClient) Delete() *HardwareIDResetDelete {
+	mutation := newHardwareIDResetMutation(c.config, OpDelete)
+	return &HardwareIDResetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *HardwareIDResetClient) DeleteOne(hir *HardwareIDReset) *HardwareIDResetDeleteOne {
+	return c.DeleteOneID(hir.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *HardwareIDResetClient) DeleteOneID(id int) *HardwareIDResetDeleteOne {
+	builder := c.Delete().Where(hardwareidreset.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &HardwareIDResetDeleteOne{builder}
+}
+
+// Query returns a query builder for HardwareIDReset.
+func (c *HardwareIDResetClient) Query() *HardwareIDResetQuery {
+	return &HardwareIDResetQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeHardwareIDReset},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a HardwareIDReset entity by its id.
+func (c *HardwareIDResetClient) Get(ctx context.Context, id int) (*HardwareIDReset, error) {
+	return c.Query().Where(hardwareidreset.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *HardwareIDResetClient) GetX(ctx context.Context, id int) *HardwareIDReset {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a HardwareIDReset.
+func (c *HardwareIDResetClient) QueryUser(hir *HardwareIDReset) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := hir.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hardwareidreset.Table, hardwareidreset.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, hardwareidreset.UserTable, hardwareidreset.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(hir.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *HardwareIDResetClient) Hooks() []Hook {
+	return c.hooks.HardwareIDReset
+}
+
+// Interceptors returns the client interceptors.
+func (c *HardwareIDResetClient) Interceptors() []Interceptor {
+	return c.inters.HardwareIDReset
+}
+
+func (c *HardwareIDResetClient) mutate(ctx context.Context, m *HardwareIDResetMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&HardwareIDResetCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&HardwareIDResetUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&HardwareIDResetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&HardwareIDResetDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown HardwareIDReset mutation op: %q", m.Op())
+	}
+}
+
+// InventoryItemClient is a client for the InventoryItem schema.
+type InventoryItemClient struct {
+	config
+}
+
+// NewInventoryItemClient returns a client for the InventoryItem from the given config.
+func NewInventoryItemClient(c config) *InventoryItemClient {
+	return &InventoryItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `inventoryitem.Hooks(f(g(h())))`.
+func (c *InventoryItemClient) Use(hooks ...Hook) {
+	c.hooks.InventoryItem = append(c.hooks.InventoryItem, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `inventoryitem.Intercept(f(g(h())))`.
+func (c *InventoryItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.InventoryItem = append(c.inters.InventoryItem, interceptors...)
+}
+
+// Create returns a builder for creating a InventoryItem entity.
+func (c *InventoryItemClient) Create() *InventoryItemCreate {
+	mutation := newInventoryItemMutation(c.config, OpCreate)
+	return &InventoryItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InventoryItem entities.
+func (c *InventoryItemClient) CreateBulk(builders ...*InventoryItemCreate) *InventoryItemCreateBulk {
+	return &InventoryItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InventoryItemClient) MapCreateBulk(slice any, setFunc func(*InventoryItemCreate, int)) *InventoryItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InventoryItemCreateBulk{err: fmt.Errorf("calling to InventoryItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InventoryItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InventoryItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InventoryItem.
+func (c *InventoryItemClient) Update() *InventoryItemUpdate {
+	mutation := newInventoryItemMutation(c.config, OpUpdate)
+	return &InventoryItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InventoryItemClient) UpdateOne(ii *InventoryItem) *InventoryItemUpdateOne {
+	mutation := newInventoryItemMutation(c.config, OpUpdateOne, withInventoryItem(ii))
+	return &InventoryItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InventoryItemClient) UpdateOneID(id int) *InventoryItemUpdateOne {
+	mutation := newInventoryItemMutation(c.config, OpUpdateOne, withInventoryItemID(id))
+	return &InventoryItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for InventoryItem.
+func (c *InventoryItemClient) Delete() *InventoryItemDelete {
+	mutation := newInventoryItemMutation(c.config, OpDelete)
+	return &InventoryItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InventoryItemClient) DeleteOne(ii *InventoryItem) *InventoryItemDeleteOne {
+	return c.DeleteOneID(ii.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InventoryItemClient) DeleteOneID(id int) *InventoryItemDeleteOne {
+	builder := c.Delete().Where(inventoryitem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InventoryItemDeleteOne{builder}
+}
+
+// Query returns a query builder for InventoryItem.
+func (c *InventoryItemClient) Query() *InventoryItemQuery {
+	return &InventoryItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInventoryItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a InventoryItem entity by its id.
+func (c *InventoryItemClient) Get(ctx context.Context, id int) (*InventoryItem, error) {
+	return c.Query().Where(inventoryitem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InventoryItemClient) GetX(ctx context.Context, id int) *InventoryItem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a InventoryItem.
+func (c *InventoryItemClient) QueryUser(ii *InventoryItem) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ii.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(inventoryitem.Table, inventoryitem.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, inventoryitem.UserTable, inventoryitem.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(ii.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryItem queries the item edge of a InventoryItem.
+func (c *InventoryItemClient) QueryItem(ii *InventoryItem) *GameItemQuery {
+	query := (&GameItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ii.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(inventoryitem.Table, inventoryitem.FieldID, id),
+			sqlgraph.To(gameitem.Table, gameitem.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, inventoryitem.ItemTable, inventoryitem.ItemColumn),
+		)
+		fromV = sqlgraph.Neighbors(ii.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *InventoryItemClient) Hooks() []Hook {
+	return c.hooks.InventoryItem
+}
+
+// Interceptors returns the client interceptors.
+func (c *InventoryItemClient) Interceptors() []Interceptor {
+	return c.inters.InventoryItem
+}
+
+func (c *InventoryItemClient) mutate(ctx context.Context, m *InventoryItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InventoryItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InventoryItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InventoryItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InventoryItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown InventoryItem mutation op: %q", m.Op())
+	}
+}
+
+// LoginHistoryClient is a client for the LoginHistory schema.
+type LoginHistoryClient struct {
+	config
+}
+
+// NewLoginHistoryClient returns a client for the LoginHistory from the given config.
+func NewLoginHistoryClient(c config) *LoginHistoryClient {
+	return &LoginHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `loginhistory.Hooks(f(g(h())))`.
+func (c *LoginHistoryClient) Use(hooks ...Hook) {
+	c.hooks.LoginHistory = append(c.hooks.LoginHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `loginhistory.Intercept(f(g(h())))`.
+func (c *LoginHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LoginHistory = append(c.inters.LoginHistory, interceptors...)
+}
+
+// Create returns a builder for creating a LoginHistory entity.
+func (c *LoginHistoryClient) Create() *LoginHistoryCreate {
+	mutation := newLoginHistoryMutation(c.config, OpCreate)
+	return &LoginHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LoginHistory entities.
+func (c *LoginHistoryClient) CreateBulk(builders ...*LoginHistoryCreate) *LoginHistoryCreateBulk {
+	return &LoginHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LoginHistoryClient) MapCreateBulk(slice any, setFunc func(*LoginHistoryCreate, int)) *LoginHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LoginHistoryCreateBulk{err: fmt.Errorf("calling to LoginHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LoginHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LoginHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LoginHistory.
+func (c *LoginHistoryClient) Update() *LoginHistoryUpdate {
+	mutation := newLoginHistoryMutation(c.config, OpUpdate)
+	return &LoginHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LoginHistoryClient) UpdateOne(lh *LoginHistory) *LoginHistoryUpdateOne {
+	mutation := newLoginHistoryMutation(c.config, OpUpdateOne, withLoginHistory(lh))
+	return &LoginHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LoginHistoryClient) UpdateOneID(id int) *LoginHistoryUpdateOne {
+	mutation := newLoginHistoryMutation(c.config, OpUpdateOne, withLoginHistoryID(id))
+	return &LoginHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LoginHistory.
+func (c *LoginHistoryClient) Delete() *LoginHistoryDelete {
+	mutation := newLoginHistoryMutation(c.config, OpDelete)
+	return &LoginHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LoginHistoryClient) DeleteOne(lh *LoginHistory) *LoginHistoryDeleteOne {
+	return c.DeleteOneID(lh.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LoginHistoryClient) DeleteOneID(id int) *LoginHistoryDeleteOne {
+	builder := c.Delete().Where(loginhistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LoginHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for LoginHistory.
+func (c *LoginHistoryClient) Query() *LoginHistoryQuery {
+	return &LoginHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLoginHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LoginHistory entity by its id.
+func (c *LoginHistoryClient) Get(ctx context.Context, id int) (*LoginHistory, error) {
+	return c.Query().Where(loginhistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LoginHistoryClient) GetX(ctx context.Context, id int) *LoginHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a LoginHistory.
+func (c *LoginHistoryClient) QueryUser(lh *LoginHistory) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lh.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loginhistory.Table, loginhistory.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, loginhistory.UserTable, loginhistory.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(lh.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LoginHistoryClient) Hooks() []Hook {
+	return c.hooks.LoginHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *LoginHistoryClient) Interceptors() []Interceptor {
+	return c.inters.LoginHistory
+}
+
+func (c *LoginHistoryClient) mutate(ctx context.Context, m *LoginHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LoginHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LoginHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LoginHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LoginHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LoginHistory mutation op: %q", m.Op())
+	}
+}
+
+// MatchClient is a client for the Match schema.
+type MatchClient struct {
+	config
+}
+
+// NewMatchClient returns a client for the Match from the given config.
+func NewMatchClient(c config) *MatchClient {
+	return &MatchClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `match.Hooks(f(g(h())))`.
+func (c *MatchClient) Use(hooks ...Hook) {
+	c.hooks.Match = append(c.hooks.Match, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `match.Intercept(f(g(h())))`.
+func (c *MatchClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Match = append(c.inters.Match, interceptors...)
+}
+
+// Create returns a builder for creating a Match entity.
+func (c *MatchClient) Create() *MatchCreate {
+	mutation := newMatchMutation(c.config, OpCreate)
+	return &MatchCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Match entities.
+func (c *MatchClient) CreateBulk(builders ...*MatchCreate) *MatchCreateBulk {
+	return &MatchCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MatchClient) MapCreateBulk(slice any, setFunc func(*MatchCreate, int)) *MatchCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MatchCreateBulk{err: fmt.Errorf("calling to MatchClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MatchCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MatchCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Match.
+func (c *MatchClient) Update() *MatchUpdate {
+	mutation := newMatchMutation(c.config, OpUpdate)
+	return &MatchUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MatchClient) UpdateOne(m *Match) *MatchUpdateOne {
+	mutation := newMatchMutation(c.config, OpUpdateOne, withMatch(m))
+	return &MatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MatchClient) UpdateOneID(id int) *MatchUpdateOne {
+	mutation := newMatchMutation(c.config, OpUpdateOne, withMatchID(id))
+	return &MatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Match.
+func (c *MatchClient) Delete() *MatchDelete {
+	mutation := newMatchMutation(c.config, OpDelete)
+	return &MatchDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MatchClient) DeleteOne(m *Match) *MatchDeleteOne {
+	return c.DeleteOneID(m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MatchClient) DeleteOneID(id int) *MatchDeleteOne {
+	builder := c.Delete().Where(match.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MatchDeleteOne{builder}
+}
+
+// Query returns a query builder for Match.
+func (c *MatchClient) Query() *MatchQuery {
+	return &MatchQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMatch},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Match entity by its id.
+func (c *MatchClient) Get(ctx context.Context, id int) (*Match, error) {
+	return c.Query().Where(match.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MatchClient) GetX(ctx context.Context, id int) *Match {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPlayer1 queries the player1 edge of a Match.
+func (c *MatchClient) QueryPlayer1(m *Match) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(match.Table, match.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, match.Player1Table, match.Player1Column),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPlayer2 queries the player2 edge of a Match.
+func (c *MatchClient) QueryPlayer2(m *Match) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(match.Table, match.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, match.Player2Table, match.Player2Column),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryResults queries the results edge of a Match.
+func (c *MatchClient) QueryResults(m *Match) *PlayerMatchResultQuery {
+	query := (&PlayerMatchResultClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(match.Table, match.FieldID, id),
+			sqlgraph.To(playermatchresult.Table, playermatchresult.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, match.ResultsTable, match.ResultsColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MatchClient) Hooks() []Hook {
+	return c.hooks.Match
+}
+
+// Interceptors returns the client interceptors.
+func (c *MatchClient) Interceptors() []Interceptor {
+	return c.inters.Match
+}
+
+func (c *MatchClient) mutate(ctx context.Context, m *MatchMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MatchCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MatchUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MatchDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Match mutation op: %q", m.Op())
+	}
+}
+
+// PasswordHistoryClient is a client for the PasswordHistory schema.
+type PasswordHistoryClient struct {
+	config
+}
+
+// NewPasswordHistoryClient returns a client for the PasswordHistory from the given config.
+func NewPasswordHistoryClient(c config) *PasswordHistoryClient {
+	return &PasswordHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `passwordhistory.Hooks(f(g(h())))`.
+func (c *PasswordHistoryClient) Use(hooks ...Hook) {
+	c.hooks.PasswordHistory = append(c.hooks.PasswordHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `passwordhistory.Intercept(f(g(h())))`.
+func (c *PasswordHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PasswordHistory = append(c.inters.PasswordHistory, interceptors...)
+}
+
+// Create returns a builder for creating a PasswordHistory entity.
+func (c *PasswordHistoryClient) Create() *PasswordHistoryCreate {
+	mutation := newPasswordHistoryMutation(c.config, OpCreate)
+	return &PasswordHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PasswordHistory entities.
+func (c *PasswordHistoryClient) CreateBulk(builders ...*PasswordHistoryCreate) *PasswordHistoryCreateBulk {
+	return &PasswordHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PasswordHistoryClient) MapCreateBulk(slice any, setFunc func(*PasswordHistoryCreate, int)) *PasswordHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PasswordHistoryCreateBulk{err: fmt.Errorf("calling to PasswordHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PasswordHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PasswordHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PasswordHistory.
+func (c *PasswordHistoryClient) Update() *PasswordHistoryUpdate {
+	mutation := newPasswordHistoryMutation(c.config, OpUpdate)
+	return &PasswordHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PasswordHistoryClient) UpdateOne(ph *PasswordHistory) *PasswordHistoryUpdateOne {
+	mutation := newPasswordHistoryMutation(c.config, OpUpdateOne, withPasswordHistory(ph))
+	return &PasswordHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PasswordHistoryClient) UpdateOneID(id int) *PasswordHistoryUpdateOne {
+	mutation := newPasswordHistoryMutation(c.config, OpUpdateOne, withPasswordHistoryID(id))
+	return &PasswordHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PasswordHistory.
+func (c *PasswordHistoryClient) Delete() *PasswordHistoryDelete {
+	mutation := newPasswordHistoryMutation(c.config, OpDelete)
+	return &PasswordHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PasswordHistoryClient) DeleteOne(ph *PasswordHistory) *PasswordHistoryDeleteOne {
+	return c.DeleteOneID(ph.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PasswordHistoryClient) DeleteOneID(id int) *PasswordHistoryDeleteOne {
+	builder := c.Delete().Where(passwordhistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PasswordHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for PasswordHistory.
+func (c *PasswordHistoryClient) Query() *PasswordHistoryQuery {
+	return &PasswordHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePasswordHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PasswordHistory entity by its id.
+func (c *PasswordHistoryClient) Get(ctx context.Context, id int) (*PasswordHistory, error) {
+	return c.Query().Where(passwordhistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PasswordHistoryClient) GetX(ctx context.Context, id int) *PasswordHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a PasswordHistory.
+func (c *PasswordHistoryClient) QueryUser(ph *PasswordHistory) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ph.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(passwordhistory.Table, passwordhistory.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, passwordhistory.UserTable, passwordhistory.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(ph.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PasswordHistoryClient) Hooks() []Hook {
+	return c.hooks.PasswordHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *PasswordHistoryClient) Interceptors() []Interceptor {
+	return c.inters.PasswordHistory
+}
+
+func (c *PasswordHistoryClient) mutate(ctx context.Context, m *PasswordHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PasswordHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PasswordHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PasswordHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PasswordHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PasswordHistory mutation op: %q", m.Op())
+	}
+}
+
+// PlayerMatchResultClient is a client for the PlayerMatchResult schema.
+type PlayerMatchResultClient struct {
+	config
+}
+
+// NewPlayerMatchResultClient returns a client for the PlayerMatchResult from the given config.
+func NewPlayerMatchResultClient(c config) *PlayerMatchResultClient {
+	return &PlayerMatchResultClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `playermatchresult.Hooks(f(g(h())))`.
+func (c *PlayerMatchResultClient) Use(hooks ...Hook) {
+	c.hooks.PlayerMatchResult = append(c.hooks.PlayerMatchResult, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `playermatchresult.Intercept(f(g(h())))`.
+func (c *PlayerMatchResultClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PlayerMatchResult = append(c.inters.PlayerMatchResult, interceptors...)
+}
+
+// Create returns a builder for creating a PlayerMatchResult entity.
+func (c *PlayerMatchResultClient) Create() *PlayerMatchResultCreate {
+	mutation := newPlayerMatchResultMutation(c.config, OpCreate)
+	return &PlayerMatchResultCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PlayerMatchResult entities.
+func (c *PlayerMatchResultClient) CreateBulk(builders ...*PlayerMatchResultCreate) *PlayerMatchResultCreateBulk {
+	return &PlayerMatchResultCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PlayerMatchResultClient) MapCreateBulk(slice any, setFunc func(*PlayerMatchResultCreate, int)) *PlayerMatchResultCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PlayerMatchResultCreateBulk{err: fmt.Errorf("calling to PlayerMatchResultClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PlayerMatchResultCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PlayerMatchResultCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PlayerMatchResult.
+func (c *PlayerMatchResultClient) Update() *PlayerMatchResultUpdate {
+	mutation := newPlayerMatchResultMutation(c.config, OpUpdate)
+	return &PlayerMatchResultUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PlayerMatchResultClient) UpdateOne(pmr *PlayerMatchResult) *PlayerMatchResultUpdateOne {
+	mutation := newPlayerMatchResultMutation(c.config, OpUpdateOne, withPlayerMatchResult(pmr))
+	return &PlayerMatchResultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PlayerMatchResultClient) UpdateOneID(id int) *PlayerMatchResultUpdateOne {
+	mutation := newPlayerMatchResultMutation(c.config, OpUpdateOne, withPlayerMatchResultID(id))
+	return &PlayerMatchResultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PlayerMatchResult.
+func (c *PlayerMatchResultClient) Delete() *PlayerMatchResultDelete {
+	mutation := newPlayerMatchResultMutation(c.config, OpDelete)
+	return &PlayerMatchResultDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PlayerMatchResultClient) DeleteOne(pmr *PlayerMatchResult) *PlayerMatchResultDeleteOne {
+	return c.DeleteOneID(pmr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PlayerMatchResultClient) DeleteOneID(id int) *PlayerMatchResultDeleteOne {
+	builder := c.Delete().Where(playermatchresult.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PlayerMatchResultDeleteOne{builder}
+}
+
+// Query returns a query builder for PlayerMatchResult.
+func (c *PlayerMatchResultClient) Query() *PlayerMatchResultQuery {
+	return &PlayerMatchResultQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePlayerMatchResult},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PlayerMatchResult entity by its id.
+func (c *PlayerMatchResultClient) Get(ctx context.Context, id int) (*PlayerMatchResult, error) {
+	return c.Query().Where(playermatchresult.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PlayerMatchResultClient) GetX(ctx context.Context, id int) *PlayerMatchResult {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMatch queries the match edge of a PlayerMatchResult.
+func (c *PlayerMatchResultClient) QueryMatch(pmr *PlayerMatchResult) *MatchQuery {
+	query := (&MatchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pmr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playermatchresult.Table, playermatchresult.FieldID, id),
+			sqlgraph.To(match.Table, match.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, playermatchresult.MatchTable, playermatchresult.MatchColumn),
+		)
+		fromV = sqlgraph.Neighbors(pmr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a PlayerMatchResult.
+func (c *PlayerMatchResultClient) QueryUser(pmr *PlayerMatchResult) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pmr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playermatchresult.Table, playermatchresult.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, playermatchresult.UserTable, playermatchresult.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(pmr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PlayerMatchResultClient) Hooks() []Hook {
+	return c.hooks.PlayerMatchResult
+}
+
+// Interceptors returns the client interceptors.
+func (c *PlayerMatchResultClient) Interceptors() []Interceptor {
+	return c.inters.PlayerMatchResult
+}
+
+func (c *PlayerMatchResultClient) mutate(ctx context.Context, m *PlayerMatchResultMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PlayerMatchResultCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PlayerMatchResultUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PlayerMatchResultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PlayerMatchResultDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PlayerMatchResult mutation op: %q", m.Op())
+	}
+}
+
+// RecoveryCodeClient is a client for the RecoveryCode schema.
+type RecoveryCodeClient struct {
+	config
+}
+
+// NewRecoveryCodeClient returns a client for the RecoveryCode from the given config.
+func NewRecoveryCodeClient(c config) *RecoveryCodeClient {
+	return &RecoveryCodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `recoverycode.Hooks(f(g(h())))`.
+func (c *RecoveryCodeClient) Use(hooks ...Hook) {
+	c.hooks.RecoveryCode = append(c.hooks.RecoveryCode, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `recoverycode.Intercept(f(g(h())))`.
+func (c *RecoveryCodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RecoveryCode = append(c.inters.RecoveryCode, interceptors...)
+}
+
+// Create returns a builder for creating a RecoveryCode entity.
+func (c *RecoveryCodeClient) Create() *RecoveryCodeCreate {
+	mutation := newRecoveryCodeMutation(c.config, OpCreate)
+	return &RecoveryCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RecoveryCode entities.
+func (c *RecoveryCodeClient) CreateBulk(builders ...*RecoveryCodeCreate) *RecoveryCodeCreateBulk {
+	return &RecoveryCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RecoveryCodeClient) MapCreateBulk(slice any, setFunc func(*RecoveryCodeCreate, int)) *RecoveryCodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RecoveryCodeCreateBulk{err: fmt.Errorf("calling to RecoveryCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RecoveryCodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RecoveryCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RecoveryCode.
+func (c *RecoveryCodeClient) Update() *RecoveryCodeUpdate {
+	mutation := newRecoveryCodeMutation(c.config, OpUpdate)
+	return &RecoveryCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RecoveryCodeClient) UpdateOne(rc *RecoveryCode) *RecoveryCodeUpdateOne {
+	mutation := newRecoveryCodeMutation(c.config, OpUpdateOne, withRecoveryCode(rc))
+	return &RecoveryCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RecoveryCodeClient) UpdateOneID(id int) *RecoveryCodeUpdateOne {
+	mutation := newRecoveryCodeMutation(c.config, OpUpdateOne, withRecoveryCodeID(id))
+	return &RecoveryCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RecoveryCode.
+func (c *RecoveryCodeClient) Delete() *RecoveryCodeDelete {
+	mutation := newRecoveryCodeMutation(c.config, OpDelete)
+	return &RecoveryCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RecoveryCodeClient) DeleteOne(rc *RecoveryCode) *RecoveryCodeDeleteOne {
+	return c.DeleteOneID(rc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RecoveryCodeClient) DeleteOneID(id int) *RecoveryCodeDeleteOne {
+	builder := c.Delete().Where(recoverycode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RecoveryCodeDeleteOne{builder}
+}
+
+// Query returns a query builder for RecoveryCode.
+func (c *RecoveryCodeClient) Query() *RecoveryCodeQuery {
+	return &RecoveryCodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRecoveryCode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RecoveryCode entity by its id.
+func (c *RecoveryCodeClient) Get(ctx context.Context, id int) (*RecoveryCode, error) {
+	return c.Query().Where(recoverycode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RecoveryCodeClient) GetX(ctx context.Context, id int) *RecoveryCode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a RecoveryCode.
+func (c *RecoveryCodeClient) QueryUser(rc *RecoveryCode) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(recoverycode.Table, recoverycode.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, recoverycode.UserTable, recoverycode.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(rc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RecoveryCodeClient) Hooks() []Hook {
+	return c.hooks.RecoveryCode
+}
+
+// Interceptors returns the client interceptors.
+func (c *RecoveryCodeClient) Interceptors() []Interceptor {
+	return c.inters.RecoveryCode
+}
+
+func (c *RecoveryCodeClient) mutate(ctx context.Context, m *RecoveryCodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RecoveryCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RecoveryCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RecoveryCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RecoveryCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RecoveryCode mutation op: %q", m.Op())
+	}
+}
+
+// RoleClient is a client for the Role schema.
+type RoleClient struct {
+	config
+}
+
+// NewRoleClient returns a client for the Role from the given config.
+func NewRoleClient(c config) *RoleClient {
+	return &RoleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `role.Hooks(f(g(h())))`.
+func (c *RoleClient) Use(hooks ...Hook) {
+	c.hooks.Role = append(c.hooks.Role, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `role.Intercept(f(g(h())))`.
+func (c *RoleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Role = append(c.inters.Role, interceptors...)
+}
+
+// Create returns a builder for creating a Role entity.
+func (c *RoleClient) Create() *RoleCreate {
+	mutation := newRoleMutation(c.config, OpCreate)
+	return &RoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Role entities.
+func (c *RoleClient) CreateBulk(builders ...*RoleCreate) *RoleCreateBulk {
+	return &RoleCreateBulk{config: c.config, builders: builders}
+}
+
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *GameItemClient) MapCreateBulk(slice any, setFunc func(*GameItemCreate, int)) *GameItemCreateBulk {
+func (c *RoleClient) MapCreateBulk(slice any, setFunc func(*RoleCreate, int)) *RoleCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &GameItemCreateBulk{err: fmt.Errorf("calling to GameItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &RoleCreateBulk{err: fmt.Errorf("calling to RoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*GameItemCreate, rv.Len())
+	builders := make([]*RoleCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &GameItemCreateBulk{config: c.config, builders: builders}
+	return &RoleCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for GameItem.
-func (c *GameItemClient) Update() *GameItemUpdate {
-	mutation := newGameItemMutation(c.config, OpUpdate)
-	return &GameItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Role.
+func (c *RoleClient) Update() *RoleUpdate {
+	mutation := newRoleMutation(c.config, OpUpdate)
+	return &RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *GameItemClient) UpdateOne(gi *GameItem) *GameItemUpdateOne {
-	mutation := newGameItemMutation(c.config, OpUpdateOne, withGameItem(gi))
-	return &GameItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RoleClient) UpdateOne(r *Role) *RoleUpdateOne {
+	mutation := newRoleMutation(c.config, OpUpdateOne, withRole(r))
+	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *GameItemClient) UpdateOneID(id int) *GameItemUpdateOne {
-	mutation := newGameItemMutation(c.config, OpUpdateOne, withGameItemID(id))
-	return &GameItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RoleClient) UpdateOneID(id int) *RoleUpdateOne {
+	mutation := newRoleMutation(c.config, OpUpdateOne, withRoleID(id))
+	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for GameItem.
-func (c *GameItemClient) Delete() *GameItemDelete {
-	mutation := newGameItemMutation(c.config, OpDelete)
-	return &GameItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Role.
+func (c *RoleClient) Delete() *RoleDelete {
+	mutation := newRoleMutation(c.config, OpDelete)
+	return &RoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *GameItemClient) DeleteOne(gi *GameItem) *GameItemDeleteOne {
-	return c.DeleteOneID(gi.ID)
+func (c *RoleClient) DeleteOne(r *Role) *RoleDeleteOne {
+	return c.DeleteOneID(r.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *GameItemClient) DeleteOneID(id int) *GameItemDeleteOne {
-	builder := c.Delete().Where(gameitem.ID(id))
+func (c *RoleClient) DeleteOneID(id int) *RoleDeleteOne {
+	builder := c.Delete().Where(role.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &GameItemDeleteOne{builder}
+	return &RoleDeleteOne{builder}
 }
 
-// Query returns a query builder for GameItem.
-func (c *GameItemClient) Query() *GameItemQuery {
-	return &GameItemQuery{
+// Query returns a query builder for Role.
+func (c *RoleClient) Query() *RoleQuery {
+	return &RoleQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeGameItem},
+		ctx:    &QueryContext{Type: TypeRole},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a GameItem entity by its id.
-func (c *GameItemClient) Get(ctx context.Context, id int) (*GameItem, error) {
-	return c.Query().Where(gameitem.ID(id)).Only(ctx)
+// Get returns a Role entity by its id.
+func (c *RoleClient) Get(ctx context.Context, id int) (*Role, error) {
+	return c.Query().Where(role.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *GameItemClient) GetX(ctx context.Context, id int) *GameItem {
+func (c *RoleClient) GetX(ctx context.Context, id int) *Role {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUsers queries the users edge of a Role.
+func (c *RoleClient) QueryUsers(r *Role) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(role.Table, role.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, role.UsersTable, role.UsersPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RoleClient) Hooks() []Hook {
+	return c.hooks.Role
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoleClient) Interceptors() []Interceptor {
+	return c.inters.Role
+}
+
+func (c *RoleClient) mutate(ctx context.Context, m *RoleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Role mutation op: %q", m.Op())
+	}
+}
+
+// SanctionClient is a client for the Sanction schema.
+type SanctionClient struct {
+	config
+}
+
+// NewSanctionClient returns a client for the Sanction from the given config.
+func NewSanctionClient(c config) *SanctionClient {
+	return &SanctionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sanction.Hooks(f(g(h())))`.
+func (c *SanctionClient) Use(hooks ...Hook) {
+	c.hooks.Sanction = append(c.hooks.Sanction, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sanction.Intercept(f(g(h())))`.
+func (c *SanctionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Sanction = append(c.inters.Sanction, interceptors...)
+}
+
+// Create returns a builder for creating a Sanction entity.
+func (c *SanctionClient) Create() *SanctionCreate {
+	mutation := newSanctionMutation(c.config, OpCreate)
+	return &SanctionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Sanction entities.
+func (c *SanctionClient) CreateBulk(builders ...*SanctionCreate) *SanctionCreateBulk {
+	return &SanctionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SanctionClient) MapCreateBulk(slice any, setFunc func(*SanctionCreate, int)) *SanctionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SanctionCreateBulk{err: fmt.Errorf("calling to SanctionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SanctionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SanctionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Sanction.
+func (c *SanctionClient) Update() *SanctionUpdate {
+	mutation := newSanctionMutation(c.config, OpUpdate)
+	return &SanctionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SanctionClient) UpdateOne(s *Sanction) *SanctionUpdateOne {
+	mutation := newSanctionMutation(c.config, OpUpdateOne, withSanction(s))
+	return &SanctionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SanctionClient) UpdateOneID(id int) *SanctionUpdateOne {
+	mutation := newSanctionMutation(c.config, OpUpdateOne, withSanctionID(id))
+	return &SanctionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Sanction.
+func (c *SanctionClient) Delete() *SanctionDelete {
+	mutation := newSanctionMutation(c.config, OpDelete)
+	return &SanctionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SanctionClient) DeleteOne(s *Sanction) *SanctionDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SanctionClient) DeleteOneID(id int) *SanctionDeleteOne {
+	builder := c.Delete().Where(sanction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SanctionDeleteOne{builder}
+}
+
+// Query returns a query builder for Sanction.
+func (c *SanctionClient) Query() *SanctionQuery {
+	return &SanctionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSanction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Sanction entity by its id.
+func (c *SanctionClient) Get(ctx context.Context, id int) (*Sanction, error) {
+	return c.Query().Where(sanction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SanctionClient) GetX(ctx context.Context, id int) *Sanction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Sanction.
+func (c *SanctionClient) QueryUser(s *Sanction) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sanction.Table, sanction.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sanction.UserTable, sanction.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SanctionClient) Hooks() []Hook {
+	return c.hooks.Sanction
+}
+
+// Interceptors returns the client interceptors.
+func (c *SanctionClient) Interceptors() []Interceptor {
+	return c.inters.Sanction
+}
+
+func (c *SanctionClient) mutate(ctx context.Context, m *SanctionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SanctionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SanctionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SanctionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SanctionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Sanction mutation op: %q", m.Op())
+	}
+}
+
+// SeasonClient is a client for the Season schema.
+type SeasonClient struct {
+	config
+}
+
+// NewSeasonClient returns a client for the Season from the given config.
+func NewSeasonClient(c config) *SeasonClient {
+	return &SeasonClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `season.Hooks(f(g(h())))`.
+func (c *SeasonClient) Use(hooks ...Hook) {
+	c.hooks.Season = append(c.hooks.Season, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `season.Intercept(f(g(h())))`.
+func (c *SeasonClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Season = append(c.inters.Season, interceptors...)
+}
+
+// Create returns a builder for creating a Season entity.
+func (c *SeasonClient) Create() *SeasonCreate {
+	mutation := newSeasonMutation(c.config, OpCreate)
+	return &SeasonCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Season entities.
+func (c *SeasonClient) CreateBulk(builders ...*SeasonCreate) *SeasonCreateBulk {
+	return &SeasonCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SeasonClient) MapCreateBulk(slice any, setFunc func(*SeasonCreate, int)) *SeasonCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SeasonCreateBulk{err: fmt.Errorf("calling to SeasonClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SeasonCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SeasonCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Season.
+func (c *SeasonClient) Update() *SeasonUpdate {
+	mutation := newSeasonMutation(c.config, OpUpdate)
+	return &SeasonUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SeasonClient) UpdateOne(s *Season) *SeasonUpdateOne {
+	mutation := newSeasonMutation(c.config, OpUpdateOne, withSeason(s))
+	return &SeasonUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SeasonClient) UpdateOneID(id int) *SeasonUpdateOne {
+	mutation := newSeasonMutation(c.config, OpUpdateOne, withSeasonID(id))
+	return &SeasonUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Season.
+func (c *SeasonClient) Delete() *SeasonDelete {
+	mutation := newSeasonMutation(c.config, OpDelete)
+	return &SeasonDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SeasonClient) DeleteOne(s *Season) *SeasonDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SeasonClient) DeleteOneID(id int) *SeasonDeleteOne {
+	builder := c.Delete().Where(season.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SeasonDeleteOne{builder}
+}
+
+// Query returns a query builder for Season.
+func (c *SeasonClient) Query() *SeasonQuery {
+	return &SeasonQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSeason},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Season entity by its id.
+func (c *SeasonClient) Get(ctx context.Context, id int) (*Season, error) {
+	return c.Query().Where(season.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SeasonClient) GetX(ctx context.Context, id int) *Season {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -677,148 +3378,180 @@ func (c *GameItemClient) GetX(ctx context.Context, id int) *GameItem {
 	return obj
 }
 
-// QueryInventoryItems queries the inventory_items edge of a GameItem.
-func (c *GameItemClient) QueryInventoryItems(gi *GameItem) *InventoryItemQuery {
-	query := (&InventoryItemClient{config: c.config}).Query()
+// QueryTiers queries the tiers edge of a Season.
+func (c *SeasonClient) QueryTiers(s *Season) *SeasonTierQuery {
+	query := (&SeasonTierClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(season.Table, season.FieldID, id),
+			sqlgraph.To(seasontier.Table, seasontier.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, season.TiersTable, season.TiersColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPasses queries the passes edge of a Season.
+func (c *SeasonClient) QueryPasses(s *Season) *SeasonPassQuery {
+	query := (&SeasonPassClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(season.Table, season.FieldID, id),
+			sqlgraph.To(seasonpass.Table, seasonpass.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, season.PassesTable, season.PassesColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRewardClaims queries the reward_claims edge of a Season.
+func (c *SeasonClient) QueryRewardClaims(s *Season) *SeasonRewardClaimQuery {
+	query := (&SeasonRewardClaimClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := gi.ID
+		id := s.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(gameitem.Table, gameitem.FieldID, id),
-			sqlgraph.To(inventoryitem.Table, inventoryitem.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, gameitem.InventoryItemsTable, gameitem.InventoryItemsColumn),
+			sqlgraph.From(season.Table, season.FieldID, id),
+			sqlgraph.To(seasonrewardclaim.Table, seasonrewardclaim.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, season.RewardClaimsTable, season.RewardClaimsColumn),
 		)
-		fromV = sqlgraph.Neighbors(gi.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *GameItemClient) Hooks() []Hook {
-	return c.hooks.GameItem
+func (c *SeasonClient) Hooks() []Hook {
+	return c.hooks.Season
 }
 
 // Interceptors returns the client interceptors.
-func (c *GameItemClient) Interceptors() []Interceptor {
-	return c.inters.GameItem
+func (c *SeasonClient) Interceptors() []Interceptor {
+	return c.inters.Season
 }
 
-func (c *GameItemClient) mutate(ctx context.Context, m *GameItemMutation) (Value, error) {
+func (c *SeasonClient) mutate(ctx context.Context, m *SeasonMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&GameItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SeasonCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&GameItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SeasonUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&GameItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SeasonUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&GameItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SeasonDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown GameItem mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Season mutation op: %q", m.Op())
 	}
 }
 
-// InventoryItemClient is a client for the InventoryItem schema.
-type InventoryItemClient struct {
+// SeasonPassClient is a client for the SeasonPass schema.
+type SeasonPassClient struct {
 	config
 }
 
-// NewInventoryItemClient returns a client for the InventoryItem from the given config.
-func NewInventoryItemClient(c config) *InventoryItemClient {
-	return &InventoryItemClient{config: c}
+// NewSeasonPassClient returns a client for the SeasonPass from the given config.
+func NewSeasonPassClient(c config) *SeasonPassClient {
+	return &SeasonPassClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `inventoryitem.Hooks(f(g(h())))`.
-func (c *InventoryItemClient) Use(hooks ...Hook) {
-	c.hooks.InventoryItem = append(c.hooks.InventoryItem, hooks...)
+// A call to `Use(f, g, h)` equals to `seasonpass.Hooks(f(g(h())))`.
+func (c *SeasonPassClient) Use(hooks ...Hook) {
+	c.hooks.SeasonPass = append(c.hooks.SeasonPass, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `inventoryitem.Intercept(f(g(h())))`.
-func (c *InventoryItemClient) Intercept(interceptors ...Interceptor) {
-	c.inters.InventoryItem = append(c.inters.InventoryItem, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `seasonpass.Intercept(f(g(h())))`.
+func (c *SeasonPassClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SeasonPass = append(c.inters.SeasonPass, interceptors...)
 }
 
-// Create returns a builder for creating a InventoryItem entity.
-func (c *InventoryItemClient) Create() *InventoryItemCreate {
-	mutation := newInventoryItemMutation(c.config, OpCreate)
-	return &InventoryItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SeasonPass entity.
+func (c *SeasonPassClient) Create() *SeasonPassCreate {
+	mutation := newSeasonPassMutation(c.config, OpCreate)
+	return &SeasonPassCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of InventoryItem entities.
-func (c *InventoryItemClient) CreateBulk(builders ...*InventoryItemCreate) *InventoryItemCreateBulk {
-	return &InventoryItemCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SeasonPass entities.
+func (c *SeasonPassClient) CreateBulk(builders ...*SeasonPassCreate) *SeasonPassCreateBulk {
+	return &SeasonPassCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *InventoryItemClient) MapCreateBulk(slice any, setFunc func(*InventoryItemCreate, int)) *InventoryItemCreateBulk {
+func (c *SeasonPassClient) MapCreateBulk(slice any, setFunc func(*SeasonPassCreate, int)) *SeasonPassCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &InventoryItemCreateBulk{err: fmt.Errorf("calling to InventoryItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SeasonPassCreateBulk{err: fmt.Errorf("calling to SeasonPassClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*InventoryItemCreate, rv.Len())
+	builders := make([]*SeasonPassCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &InventoryItemCreateBulk{config: c.config, builders: builders}
+	return &SeasonPassCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for InventoryItem.
-func (c *InventoryItemClient) Update() *InventoryItemUpdate {
-	mutation := newInventoryItemMutation(c.config, OpUpdate)
-	return &InventoryItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SeasonPass.
+func (c *SeasonPassClient) Update() *SeasonPassUpdate {
+	mutation := newSeasonPassMutation(c.config, OpUpdate)
+	return &SeasonPassUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *InventoryItemClient) UpdateOne(ii *InventoryItem) *InventoryItemUpdateOne {
-	mutation := newInventoryItemMutation(c.config, OpUpdateOne, withInventoryItem(ii))
-	return &InventoryItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SeasonPassClient) UpdateOne(sp *SeasonPass) *SeasonPassUpdateOne {
+	mutation := newSeasonPassMutation(c.config, OpUpdateOne, withSeasonPass(sp))
+	return &SeasonPassUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *InventoryItemClient) UpdateOneID(id int) *InventoryItemUpdateOne {
-	mutation := newInventoryItemMutation(c.config, OpUpdateOne, withInventoryItemID(id))
-	return &InventoryItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SeasonPassClient) UpdateOneID(id int) *SeasonPassUpdateOne {
+	mutation := newSeasonPassMutation(c.config, OpUpdateOne, withSeasonPassID(id))
+	return &SeasonPassUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for InventoryItem.
-func (c *InventoryItemClient) Delete() *InventoryItemDelete {
-	mutation := newInventoryItemMutation(c.config, OpDelete)
-	return &InventoryItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SeasonPass.
+func (c *SeasonPassClient) Delete() *SeasonPassDelete {
+	mutation := newSeasonPassMutation(c.config, OpDelete)
+	return &SeasonPassDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *InventoryItemClient) DeleteOne(ii *InventoryItem) *InventoryItemDeleteOne {
-	return c.DeleteOneID(ii.ID)
+func (c *SeasonPassClient) DeleteOne(sp *SeasonPass) *SeasonPassDeleteOne {
+	return c.DeleteOneID(sp.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *InventoryItemClient) DeleteOneID(id int) *InventoryItemDeleteOne {
-	builder := c.Delete().Where(inventoryitem.ID(id))
+func (c *SeasonPassClient) DeleteOneID(id int) *SeasonPassDeleteOne {
+	builder := c.Delete().Where(seasonpass.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &InventoryItemDeleteOne{builder}
+	return &SeasonPassDeleteOne{builder}
 }
 
-// Query returns a query builder for InventoryItem.
-func (c *InventoryItemClient) Query() *InventoryItemQuery {
-	return &InventoryItemQuery{
+// Query returns a query builder for SeasonPass.
+func (c *SeasonPassClient) Query() *SeasonPassQuery {
+	return &SeasonPassQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeInventoryItem},
+		ctx:    &QueryContext{Type: TypeSeasonPass},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a InventoryItem entity by its id.
-func (c *InventoryItemClient) Get(ctx context.Context, id int) (*InventoryItem, error) {
-	return c.Query().Where(inventoryitem.ID(id)).Only(ctx)
+// Get returns a SeasonPass entity by its id.
+func (c *SeasonPassClient) Get(ctx context.Context, id int) (*SeasonPass, error) {
+	return c.Query().Where(seasonpass.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *InventoryItemClient) GetX(ctx context.Context, id int) *InventoryItem {
+func (c *SeasonPassClient) GetX(ctx context.Context, id int) *SeasonPass {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -826,164 +3559,164 @@ func (c *InventoryItemClient) GetX(ctx context.Context, id int) *InventoryItem {
 	return obj
 }
 
-// QueryUser queries the user edge of a InventoryItem.
-func (c *InventoryItemClient) QueryUser(ii *InventoryItem) *UserQuery {
+// QueryUser queries the user edge of a SeasonPass.
+func (c *SeasonPassClient) QueryUser(sp *SeasonPass) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ii.ID
+		id := sp.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(inventoryitem.Table, inventoryitem.FieldID, id),
+			sqlgraph.From(seasonpass.Table, seasonpass.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, inventoryitem.UserTable, inventoryitem.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, seasonpass.UserTable, seasonpass.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(ii.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryItem queries the item edge of a InventoryItem.
-func (c *InventoryItemClient) QueryItem(ii *InventoryItem) *GameItemQuery {
-	query := (&GameItemClient{config: c.config}).Query()
+// QuerySeason queries the season edge of a SeasonPass.
+func (c *SeasonPassClient) QuerySeason(sp *SeasonPass) *SeasonQuery {
+	query := (&SeasonClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ii.ID
+		id := sp.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(inventoryitem.Table, inventoryitem.FieldID, id),
-			sqlgraph.To(gameitem.Table, gameitem.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, inventoryitem.ItemTable, inventoryitem.ItemColumn),
+			sqlgraph.From(seasonpass.Table, seasonpass.FieldID, id),
+			sqlgraph.To(season.Table, season.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, seasonpass.SeasonTable, seasonpass.SeasonColumn),
 		)
-		fromV = sqlgraph.Neighbors(ii.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *InventoryItemClient) Hooks() []Hook {
-	return c.hooks.InventoryItem
+func (c *SeasonPassClient) Hooks() []Hook {
+	return c.hooks.SeasonPass
 }
 
 // Interceptors returns the client interceptors.
-func (c *InventoryItemClient) Interceptors() []Interceptor {
-	return c.inters.InventoryItem
+func (c *SeasonPassClient) Interceptors() []Interceptor {
+	return c.inters.SeasonPass
 }
 
-func (c *InventoryItemClient) mutate(ctx context.Context, m *InventoryItemMutation) (Value, error) {
+func (c *SeasonPassClient) mutate(ctx context.Context, m *SeasonPassMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&InventoryItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SeasonPassCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&InventoryItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SeasonPassUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&InventoryItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SeasonPassUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&InventoryItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SeasonPassDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown InventoryItem mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SeasonPass mutation op: %q", m.Op())
 	}
 }
 
-// MatchClient is a client for the Match schema.
-type MatchClient struct {
+// SeasonRewardClaimClient is a client for the SeasonRewardClaim schema.
+type SeasonRewardClaimClient struct {
 	config
 }
 
-// NewMatchClient returns a client for the Match from the given config.
-func NewMatchClient(c config) *MatchClient {
-	return &MatchClient{config: c}
+// NewSeasonRewardClaimClient returns a client for the SeasonRewardClaim from the given config.
+func NewSeasonRewardClaimClient(c config) *SeasonRewardClaimClient {
+	return &SeasonRewardClaimClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `match.Hooks(f(g(h())))`.
-func (c *MatchClient) Use(hooks ...Hook) {
-	c.hooks.Match = append(c.hooks.Match, hooks...)
+// A call to `Use(f, g, h)` equals to `seasonrewardclaim.Hooks(f(g(h())))`.
+func (c *SeasonRewardClaimClient) Use(hooks ...Hook) {
+	c.hooks.SeasonRewardClaim = append(c.hooks.SeasonRewardClaim, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `match.Intercept(f(g(h())))`.
-func (c *MatchClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Match = append(c.inters.Match, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `seasonrewardclaim.Intercept(f(g(h())))`.
+func (c *SeasonRewardClaimClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SeasonRewardClaim = append(c.inters.SeasonRewardClaim, interceptors...)
 }
 
-// Create returns a builder for creating a Match entity.
-func (c *MatchClient) Create() *MatchCreate {
-	mutation := newMatchMutation(c.config, OpCreate)
-	return &MatchCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SeasonRewardClaim entity.
+func (c *SeasonRewardClaimClient) Create() *SeasonRewardClaimCreate {
+	mutation := newSeasonRewardClaimMutation(c.config, OpCreate)
+	return &SeasonRewardClaimCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Match entities.
-func (c *MatchClient) CreateBulk(builders ...*MatchCreate) *MatchCreateBulk {
-	return &MatchCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SeasonRewardClaim entities.
+func (c *SeasonRewardClaimClient) CreateBulk(builders ...*SeasonRewardClaimCreate) *SeasonRewardClaimCreateBulk {
+	return &SeasonRewardClaimCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MatchClient) MapCreateBulk(slice any, setFunc func(*MatchCreate, int)) *MatchCreateBulk {
+func (c *SeasonRewardClaimClient) MapCreateBulk(slice any, setFunc func(*SeasonRewardClaimCreate, int)) *SeasonRewardClaimCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MatchCreateBulk{err: fmt.Errorf("calling to MatchClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SeasonRewardClaimCreateBulk{err: fmt.Errorf("calling to SeasonRewardClaimClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MatchCreate, rv.Len())
+	builders := make([]*SeasonRewardClaimCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MatchCreateBulk{config: c.config, builders: builders}
+	return &SeasonRewardClaimCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Match.
-func (c *MatchClient) Update() *MatchUpdate {
-	mutation := newMatchMutation(c.config, OpUpdate)
-	return &MatchUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SeasonRewardClaim.
+func (c *SeasonRewardClaimClient) Update() *SeasonRewardClaimUpdate {
+	mutation := newSeasonRewardClaimMutation(c.config, OpUpdate)
+	return &SeasonRewardClaimUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MatchClient) UpdateOne(m *Match) *MatchUpdateOne {
-	mutation := newMatchMutation(c.config, OpUpdateOne, withMatch(m))
-	return &MatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SeasonRewardClaimClient) UpdateOne(src *SeasonRewardClaim) *SeasonRewardClaimUpdateOne {
+	mutation := newSeasonRewardClaimMutation(c.config, OpUpdateOne, withSeasonRewardClaim(src))
+	return &SeasonRewardClaimUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MatchClient) UpdateOneID(id int) *MatchUpdateOne {
-	mutation := newMatchMutation(c.config, OpUpdateOne, withMatchID(id))
-	return &MatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SeasonRewardClaimClient) UpdateOneID(id int) *SeasonRewardClaimUpdateOne {
+	mutation := newSeasonRewardClaimMutation(c.config, OpUpdateOne, withSeasonRewardClaimID(id))
+	return &SeasonRewardClaimUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Match.
-func (c *MatchClient) Delete() *MatchDelete {
-	mutation := newMatchMutation(c.config, OpDelete)
-	return &MatchDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SeasonRewardClaim.
+func (c *SeasonRewardClaimClient) Delete() *SeasonRewardClaimDelete {
+	mutation := newSeasonRewardClaimMutation(c.config, OpDelete)
+	return &SeasonRewardClaimDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MatchClient) DeleteOne(m *Match) *MatchDeleteOne {
-	return c.DeleteOneID(m.ID)
+func (c *SeasonRewardClaimClient) DeleteOne(src *SeasonRewardClaim) *SeasonRewardClaimDeleteOne {
+	return c.DeleteOneID(src.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MatchClient) DeleteOneID(id int) *MatchDeleteOne {
-	builder := c.Delete().Where(match.ID(id))
+func (c *SeasonRewardClaimClient) DeleteOneID(id int) *SeasonRewardClaimDeleteOne {
+	builder := c.Delete().Where(seasonrewardclaim.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MatchDeleteOne{builder}
+	return &SeasonRewardClaimDeleteOne{builder}
 }
 
-// Query returns a query builder for Match.
-func (c *MatchClient) Query() *MatchQuery {
-	return &MatchQuery{
+// Query returns a query builder for SeasonRewardClaim.
+func (c *SeasonRewardClaimClient) Query() *SeasonRewardClaimQuery {
+	return &SeasonRewardClaimQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMatch},
+		ctx:    &QueryContext{Type: TypeSeasonRewardClaim},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Match entity by its id.
-func (c *MatchClient) Get(ctx context.Context, id int) (*Match, error) {
-	return c.Query().Where(match.ID(id)).Only(ctx)
+// Get returns a SeasonRewardClaim entity by its id.
+func (c *SeasonRewardClaimClient) Get(ctx context.Context, id int) (*SeasonRewardClaim, error) {
+	return c.Query().Where(seasonrewardclaim.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MatchClient) GetX(ctx context.Context, id int) *Match {
+func (c *SeasonRewardClaimClient) GetX(ctx context.Context, id int) *SeasonRewardClaim {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -991,241 +3724,209 @@ func (c *MatchClient) GetX(ctx context.Context, id int) *Match {
 	return obj
 }
 
-// QueryPlayer1 queries the player1 edge of a Match.
-func (c *MatchClient) QueryPlayer1(m *Match) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(match.Table, match.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, match.Player1Table, match.Player1Column),
-		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryPlayer2 queries the player2 edge of a Match.
-func (c *MatchClient) QueryPlayer2(m *Match) *UserQuery {
+// QueryUser queries the user edge of a SeasonRewardClaim.
+func (c *SeasonRewardClaimClient) QueryUser(src *SeasonRewardClaim) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
+		id := src.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(match.Table, match.FieldID, id),
+			sqlgraph.From(seasonrewardclaim.Table, seasonrewardclaim.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, match.Player2Table, match.Player2Column),
+			sqlgraph.Edge(sqlgraph.M2O, true, seasonrewardclaim.UserTable, seasonrewardclaim.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(src.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryResults queries the results edge of a Match.
-func (c *MatchClient) QueryResults(m *Match) *PlayerMatchResultQuery {
-	query := (&PlayerMatchResultClient{config: c.config}).Query()
+// QuerySeason queries the season edge of a SeasonRewardClaim.
+func (c *SeasonRewardClaimClient) QuerySeason(src *SeasonRewardClaim) *SeasonQuery {
+	query := (&SeasonClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
+		id := src.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(match.Table, match.FieldID, id),
-			sqlgraph.To(playermatchresult.Table, playermatchresult.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, match.ResultsTable, match.ResultsColumn),
+			sqlgraph.From(seasonrewardclaim.Table, seasonrewardclaim.FieldID, id),
+			sqlgraph.To(season.Table, season.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, seasonrewardclaim.SeasonTable, seasonrewardclaim.SeasonColumn),
 		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(src.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *MatchClient) Hooks() []Hook {
-	return c.hooks.Match
+func (c *SeasonRewardClaimClient) Hooks() []Hook {
+	return c.hooks.SeasonRewardClaim
 }
 
 // Interceptors returns the client interceptors.
-func (c *MatchClient) Interceptors() []Interceptor {
-	return c.inters.Match
+func (c *SeasonRewardClaimClient) Interceptors() []Interceptor {
+	return c.inters.SeasonRewardClaim
 }
 
-func (c *MatchClient) mutate(ctx context.Context, m *MatchMutation) (Value, error) {
+func (c *SeasonRewardClaimClient) mutate(ctx context.Context, m *SeasonRewardClaimMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MatchCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SeasonRewardClaimCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MatchUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SeasonRewardClaimUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SeasonRewardClaimUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MatchDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SeasonRewardClaimDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Match mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SeasonRewardClaim mutation op: %q", m.Op())
 	}
 }
 
-// PlayerMatchResultClient is a client for the PlayerMatchResult schema.
-type PlayerMatchResultClient struct {
+// SeasonTierClient is a client for the SeasonTier schema.
+type SeasonTierClient struct {
 	config
 }
 
-// NewPlayerMatchResultClient returns a client for the PlayerMatchResult from the given config.
-func NewPlayerMatchResultClient(c config) *PlayerMatchResultClient {
-	return &PlayerMatchResultClient{config: c}
+// NewSeasonTierClient returns a client for the SeasonTier from the given config.
+func NewSeasonTierClient(c config) *SeasonTierClient {
+	return &SeasonTierClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `playermatchresult.Hooks(f(g(h())))`.
-func (c *PlayerMatchResultClient) Use(hooks ...Hook) {
-	c.hooks.PlayerMatchResult = append(c.hooks.PlayerMatchResult, hooks...)
+// A call to `Use(f, g, h)` equals to `seasontier.Hooks(f(g(h())))`.
+func (c *SeasonTierClient) Use(hooks ...Hook) {
+	c.hooks.SeasonTier = append(c.hooks.SeasonTier, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `playermatchresult.Intercept(f(g(h())))`.
-func (c *PlayerMatchResultClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PlayerMatchResult = append(c.inters.PlayerMatchResult, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `seasontier.Intercept(f(g(h())))`.
+func (c *SeasonTierClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SeasonTier = append(c.inters.SeasonTier, interceptors...)
 }
 
-// Create returns a builder for creating a PlayerMatchResult entity.
-func (c *PlayerMatchResultClient) Create() *PlayerMatchResultCreate {
-	mutation := newPlayerMatchResultMutation(c.config, OpCreate)
-	return &PlayerMatchResultCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SeasonTier entity.
+func (c *SeasonTierClient) Create() *SeasonTierCreate {
+	mutation := newSeasonTierMutation(c.config, OpCreate)
+	return &SeasonTierCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PlayerMatchResult entities.
-func (c *PlayerMatchResultClient) CreateBulk(builders ...*PlayerMatchResultCreate) *PlayerMatchResultCreateBulk {
-	return &PlayerMatchResultCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SeasonTier entities.
+func (c *SeasonTierClient) CreateBulk(builders ...*SeasonTierCreate) *SeasonTierCreateBulk {
+	return &SeasonTierCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PlayerMatchResultClient) MapCreateBulk(slice any, setFunc func(*PlayerMatchResultCreate, int)) *PlayerMatchResultCreateBulk {
+func (c *SeasonTierClient) MapCreateBulk(slice any, setFunc func(*SeasonTierCreate, int)) *SeasonTierCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PlayerMatchResultCreateBulk{err: fmt.Errorf("calling to PlayerMatchResultClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SeasonTierCreateBulk{err: fmt.Errorf("calling to SeasonTierClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PlayerMatchResultCreate, rv.Len())
+	builders := make([]*SeasonTierCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PlayerMatchResultCreateBulk{config: c.config, builders: builders}
+	return &SeasonTierCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PlayerMatchResult.
-func (c *PlayerMatchResultClient) Update() *PlayerMatchResultUpdate {
-	mutation := newPlayerMatchResultMutation(c.config, OpUpdate)
-	return &PlayerMatchResultUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SeasonTier.
+func (c *SeasonTierClient) Update() *SeasonTierUpdate {
+	mutation := newSeasonTierMutation(c.config, OpUpdate)
+	return &SeasonTierUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PlayerMatchResultClient) UpdateOne(pmr *PlayerMatchResult) *PlayerMatchResultUpdateOne {
-	mutation := newPlayerMatchResultMutation(c.config, OpUpdateOne, withPlayerMatchResult(pmr))
-	return &PlayerMatchResultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SeasonTierClient) UpdateOne(st *SeasonTier) *SeasonTierUpdateOne {
+	mutation := newSeasonTierMutation(c.config, OpUpdateOne, withSeasonTier(st))
+	return &SeasonTierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PlayerMatchResultClient) UpdateOneID(id int) *PlayerMatchResultUpdateOne {
-	mutation := newPlayerMatchResultMutation(c.config, OpUpdateOne, withPlayerMatchResultID(id))
-	return &PlayerMatchResultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SeasonTierClient) UpdateOneID(id int) *SeasonTierUpdateOne {
+	mutation := newSeasonTierMutation(c.config, OpUpdateOne, withSeasonTierID(id))
+	return &SeasonTierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PlayerMatchResult.
-func (c *PlayerMatchResultClient) Delete() *PlayerMatchResultDelete {
-	mutation := newPlayerMatchResultMutation(c.config, OpDelete)
-	return &PlayerMatchResultDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SeasonTier.
+func (c *SeasonTierClient) Delete() *SeasonTierDelete {
+	mutation := newSeasonTierMutation(c.config, OpDelete)
+	return &SeasonTierDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PlayerMatchResultClient) DeleteOne(pmr *PlayerMatchResult) *PlayerMatchResultDeleteOne {
-	return c.DeleteOneID(pmr.ID)
+func (c *SeasonTierClient) DeleteOne(st *SeasonTier) *SeasonTierDeleteOne {
+	return c.DeleteOneID(st.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PlayerMatchResultClient) DeleteOneID(id int) *PlayerMatchResultDeleteOne {
-	builder := c.Delete().Where(playermatchresult.ID(id))
+func (c *SeasonTierClient) DeleteOneID(id int) *SeasonTierDeleteOne {
+	builder := c.Delete().Where(seasontier.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PlayerMatchResultDeleteOne{builder}
+	return &SeasonTierDeleteOne{builder}
 }
 
-// Query returns a query builder for PlayerMatchResult.
-func (c *PlayerMatchResultClient) Query() *PlayerMatchResultQuery {
-	return &PlayerMatchResultQuery{
+// Query returns a query builder for SeasonTier.
+func (c *SeasonTierClient) Query() *SeasonTierQuery {
+	return &SeasonTierQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePlayerMatchResult},
+		ctx:    &QueryContext{Type: TypeSeasonTier},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PlayerMatchResult entity by its id.
-func (c *PlayerMatchResultClient) Get(ctx context.Context, id int) (*PlayerMatchResult, error) {
-	return c.Query().Where(playermatchresult.ID(id)).Only(ctx)
-}
-
-// GetX is like Get, but panics if an error occurs.
-func (c *PlayerMatchResultClient) GetX(ctx context.Context, id int) *PlayerMatchResult {
-	obj, err := c.Get(ctx, id)
-	if err != nil {
-		panic(err)
-	}
-	return obj
-}
-
-// QueryMatch queries the match edge of a PlayerMatchResult.
-func (c *PlayerMatchResultClient) QueryMatch(pmr *PlayerMatchResult) *MatchQuery {
-	query := (&MatchClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pmr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(playermatchresult.Table, playermatchresult.FieldID, id),
-			sqlgraph.To(match.Table, match.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, playermatchresult.MatchTable, playermatchresult.MatchColumn),
-		)
-		fromV = sqlgraph.Neighbors(pmr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryUser queries the user edge of a PlayerMatchResult.
-func (c *PlayerMatchResultClient) QueryUser(pmr *PlayerMatchResult) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// Get returns a SeasonTier entity by its id.
+func (c *SeasonTierClient) Get(ctx context.Context, id int) (*SeasonTier, error) {
+	return c.Query().Where(seasontier.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SeasonTierClient) GetX(ctx context.Context, id int) *SeasonTier {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySeason queries the season edge of a SeasonTier.
+func (c *SeasonTierClient) QuerySeason(st *SeasonTier) *SeasonQuery {
+	query := (&SeasonClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pmr.ID
+		id := st.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(playermatchresult.Table, playermatchresult.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, playermatchresult.UserTable, playermatchresult.UserColumn),
+			sqlgraph.From(seasontier.Table, seasontier.FieldID, id),
+			sqlgraph.To(season.Table, season.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, seasontier.SeasonTable, seasontier.SeasonColumn),
 		)
-		fromV = sqlgraph.Neighbors(pmr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(st.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *PlayerMatchResultClient) Hooks() []Hook {
-	return c.hooks.PlayerMatchResult
+func (c *SeasonTierClient) Hooks() []Hook {
+	return c.hooks.SeasonTier
 }
 
 // Interceptors returns the client interceptors.
-func (c *PlayerMatchResultClient) Interceptors() []Interceptor {
-	return c.inters.PlayerMatchResult
+func (c *SeasonTierClient) Interceptors() []Interceptor {
+	return c.inters.SeasonTier
 }
 
-func (c *PlayerMatchResultClient) mutate(ctx context.Context, m *PlayerMatchResultMutation) (Value, error) {
+func (c *SeasonTierClient) mutate(ctx context.Context, m *SeasonTierMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PlayerMatchResultCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SeasonTierCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PlayerMatchResultUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SeasonTierUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PlayerMatchResultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SeasonTierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PlayerMatchResultDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SeasonTierDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PlayerMatchResult mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SeasonTier mutation op: %q", m.Op())
 	}
 }
 
@@ -1614,6 +4315,214 @@ func (c *UserClient) QueryBalance(u *User) *UserBalanceQuery {
 	return query
 }
 
+// QueryCollectionCompletions queries the collection_completions edge of a User.
+func (c *UserClient) QueryCollectionCompletions(u *User) *CollectionCompletionQuery {
+	query := (&CollectionCompletionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(collectioncompletion.Table, collectioncompletion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.CollectionCompletionsTable, user.CollectionCompletionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySeasonPasses queries the season_passes edge of a User.
+func (c *UserClient) QuerySeasonPasses(u *User) *SeasonPassQuery {
+	query := (&SeasonPassClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(seasonpass.Table, seasonpass.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SeasonPassesTable, user.SeasonPassesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySeasonRewardClaims queries the season_reward_claims edge of a User.
+func (c *UserClient) QuerySeasonRewardClaims(u *User) *SeasonRewardClaimQuery {
+	query := (&SeasonRewardClaimClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(seasonrewardclaim.Table, seasonrewardclaim.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SeasonRewardClaimsTable, user.SeasonRewardClaimsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEmailHistory queries the email_history edge of a User.
+func (c *UserClient) QueryEmailHistory(u *User) *EmailHistoryQuery {
+	query := (&EmailHistoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(emailhistory.Table, emailhistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.EmailHistoryTable, user.EmailHistoryColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUsernameHistory queries the username_history edge of a User.
+func (c *UserClient) QueryUsernameHistory(u *User) *UsernameHistoryQuery {
+	query := (&UsernameHistoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(usernamehistory.Table, usernamehistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.UsernameHistoryTable, user.UsernameHistoryColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPasswordHistory queries the password_history edge of a User.
+func (c *UserClient) QueryPasswordHistory(u *User) *PasswordHistoryQuery {
+	query := (&PasswordHistoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(passwordhistory.Table, passwordhistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.PasswordHistoryTable, user.PasswordHistoryColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLoginHistory queries the login_history edge of a User.
+func (c *UserClient) QueryLoginHistory(u *User) *LoginHistoryQuery {
+	query := (&LoginHistoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(loginhistory.Table, loginhistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LoginHistoryTable, user.LoginHistoryColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryExternalIdentities queries the external_identities edge of a User.
+func (c *UserClient) QueryExternalIdentities(u *User) *ExternalIdentityQuery {
+	query := (&ExternalIdentityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(externalidentity.Table, externalidentity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ExternalIdentitiesTable, user.ExternalIdentitiesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAPITokens queries the api_tokens edge of a User.
+func (c *UserClient) QueryAPITokens(u *User) *APITokenQuery {
+	query := (&APITokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(apitoken.Table, apitoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.APITokensTable, user.APITokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRecoveryCodes queries the recovery_codes edge of a User.
+func (c *UserClient) QueryRecoveryCodes(u *User) *RecoveryCodeQuery {
+	query := (&RecoveryCodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(recoverycode.Table, recoverycode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RecoveryCodesTable, user.RecoveryCodesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryHardwareIDResets queries the hardware_id_resets edge of a User.
+func (c *UserClient) QueryHardwareIDResets(u *User) *HardwareIDResetQuery {
+	query := (&HardwareIDResetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(hardwareidreset.Table, hardwareidreset.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.HardwareIDResetsTable, user.HardwareIDResetsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySanctions queries the sanctions edge of a User.
+func (c *UserClient) QuerySanctions(u *User) *SanctionQuery {
+	query := (&SanctionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(sanction.Table, sanction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SanctionsTable, user.SanctionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRoles queries the roles edge of a User.
+func (c *UserClient) QueryRoles(u *User) *RoleQuery {
+	query := (&RoleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(role.Table, role.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, user.RolesTable, user.RolesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -1635,7 +4544,7 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 	case OpDelete, OpDeleteOne:
 		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown UserDTO mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
 	}
 }
 
@@ -1788,14 +4697,171 @@ func (c *UserBalanceClient) mutate(ctx context.Context, m *UserBalanceMutation)
 	}
 }
 
+// UsernameHistoryClient is a client for the UsernameHistory schema.
+type UsernameHistoryClient struct {
+	config
+}
+
+// NewUsernameHistoryClient returns a client for the UsernameHistory from the given config.
+func NewUsernameHistoryClient(c config) *UsernameHistoryClient {
+	return &UsernameHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `usernamehistory.Hooks(f(g(h())))`.
+func (c *UsernameHistoryClient) Use(hooks ...Hook) {
+	c.hooks.UsernameHistory = append(c.hooks.UsernameHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `usernamehistory.Intercept(f(g(h())))`.
+func (c *UsernameHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UsernameHistory = append(c.inters.UsernameHistory, interceptors...)
+}
+
+// Create returns a builder for creating a UsernameHistory entity.
+func (c *UsernameHistoryClient) Create() *UsernameHistoryCreate {
+	mutation := newUsernameHistoryMutation(c.config, OpCreate)
+	return &UsernameHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UsernameHistory entities.
+func (c *UsernameHistoryClient) CreateBulk(builders ...*UsernameHistoryCreate) *UsernameHistoryCreateBulk {
+	return &UsernameHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UsernameHistoryClient) MapCreateBulk(slice any, setFunc func(*UsernameHistoryCreate, int)) *UsernameHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UsernameHistoryCreateBulk{err: fmt.Errorf("calling to UsernameHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UsernameHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UsernameHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UsernameHistory.
+func (c *UsernameHistoryClient) Update() *UsernameHistoryUpdate {
+	mutation := newUsernameHistoryMutation(c.config, OpUpdate)
+	return &UsernameHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UsernameHistoryClient) UpdateOne(uh *UsernameHistory) *UsernameHistoryUpdateOne {
+	mutation := newUsernameHistoryMutation(c.config, OpUpdateOne, withUsernameHistory(uh))
+	return &UsernameHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UsernameHistoryClient) UpdateOneID(id int) *UsernameHistoryUpdateOne {
+	mutation := newUsernameHistoryMutation(c.config, OpUpdateOne, withUsernameHistoryID(id))
+	return &UsernameHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UsernameHistory.
+func (c *UsernameHistoryClient) Delete() *UsernameHistoryDelete {
+	mutation := newUsernameHistoryMutation(c.config, OpDelete)
+	return &UsernameHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UsernameHistoryClient) DeleteOne(uh *UsernameHistory) *UsernameHistoryDeleteOne {
+	return c.DeleteOneID(uh.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UsernameHistoryClient) DeleteOneID(id int) *UsernameHistoryDeleteOne {
+	builder := c.Delete().Where(usernamehistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UsernameHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for UsernameHistory.
+func (c *UsernameHistoryClient) Query() *UsernameHistoryQuery {
+	return &UsernameHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUsernameHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UsernameHistory entity by its id.
+func (c *UsernameHistoryClient) Get(ctx context.Context, id int) (*UsernameHistory, error) {
+	return c.Query().Where(usernamehistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UsernameHistoryClient) GetX(ctx context.Context, id int) *UsernameHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a UsernameHistory.
+func (c *UsernameHistoryClient) QueryUser(uh *UsernameHistory) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := uh.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(usernamehistory.Table, usernamehistory.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, usernamehistory.UserTable, usernamehistory.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(uh.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UsernameHistoryClient) Hooks() []Hook {
+	return c.hooks.UsernameHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *UsernameHistoryClient) Interceptors() []Interceptor {
+	return c.inters.UsernameHistory
+}
+
+func (c *UsernameHistoryClient) mutate(ctx context.Context, m *UsernameHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UsernameHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UsernameHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UsernameHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UsernameHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UsernameHistory mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		BannedHardwareID, FriendRequest, GameItem, InventoryItem, Match,
-		PlayerMatchResult, Statistic, User, UserBalance []ent.Hook
+		APIToken, AuditLog, BannedHardwareID, CollectionCompletion, CollectionReward,
+		EmailHistory, ExternalIdentity, FriendRequest, GameItem, GrantJob,
+		HardwareIDReset, InventoryItem, LoginHistory, Match, PasswordHistory,
+		PlayerMatchResult, RecoveryCode, Role, Sanction, Season, SeasonPass,
+		SeasonRewardClaim, SeasonTier, Statistic, User, UserBalance,
+		UsernameHistory []ent.Hook
 	}
 	inters struct {
-		BannedHardwareID, FriendRequest, GameItem, InventoryItem, Match,
-		PlayerMatchResult, Statistic, User, UserBalance []ent.Interceptor
+		APIToken, AuditLog, BannedHardwareID, CollectionCompletion, CollectionReward,
+		EmailHistory, ExternalIdentity, FriendRequest, GameItem, GrantJob,
+		HardwareIDReset, InventoryItem, LoginHistory, Match, PasswordHistory,
+		PlayerMatchResult, RecoveryCode, Role, Sanction, Season, SeasonPass,
+		SeasonRewardClaim, SeasonTier, Statistic, User, UserBalance,
+		UsernameHistory []ent.Interceptor
 	}
 )
@@ -0,0 +1,157 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/collectioncompletion"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
+)
+
+// CollectionCompletion is the model entity for the CollectionCompletion schema.
+type CollectionCompletion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Collection holds the value of the "collection" field.
+	Collection string `json:"collection,omitempty"`
+	// CompletedAt holds the value of the "completed_at" field.
+	CompletedAt time.Time `json:"completed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CollectionCompletionQuery when eager-loading is set.
+	Edges        CollectionCompletionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CollectionCompletionEdges holds the relations/edges for other nodes in the graph.
+type CollectionCompletionEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CollectionCompletionEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CollectionCompletion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case collectioncompletion.FieldID, collectioncompletion.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case collectioncompletion.FieldCollection:
+			values[i] = new(sql.NullString)
+		case collectioncompletion.FieldCompletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CollectionCompletion fields.
+func (cc *CollectionCompletion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case collectioncompletion.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			cc.ID = int(value.Int64)
+		case collectioncompletion.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				cc.UserID = int(value.Int64)
+			}
+		case collectioncompletion.FieldCollection:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field collection", values[i])
+			} else if value.Valid {
+				cc.Collection = value.String
+			}
+		case collectioncompletion.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				cc.CompletedAt = value.Time
+			}
+		default:
+			cc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CollectionCompletion.
+// This includes values selected through modifiers, order, etc.
+func (cc *CollectionCompletion) Value(name string) (ent.Value, error) {
+	return cc.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the CollectionCompletion entity.
+func (cc *CollectionCompletion) QueryUser() *UserQuery {
+	return NewCollectionCompletionClient(cc.config).QueryUser(cc)
+}
+
+// Update returns a builder for updating this CollectionCompletion.
+// Note that you need to call CollectionCompletion.Unwrap() before calling this method if this CollectionCompletion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cc *CollectionCompletion) Update() *CollectionCompletionUpdateOne {
+	return NewCollectionCompletionClient(cc.config).UpdateOne(cc)
+}
+
+// Unwrap unwraps the CollectionCompletion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cc *CollectionCompletion) Unwrap() *CollectionCompletion {
+	_tx, ok := cc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CollectionCompletion is not a transactional entity")
+	}
+	cc.config.driver = _tx.drv
+	return cc
+}
+
+// String implements the fmt.Stringer.
+func (cc *CollectionCompletion) String() string {
+	var builder strings.Builder
+	builder.WriteString("CollectionCompletion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cc.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", cc.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("collection=")
+	builder.WriteString(cc.Collection)
+	builder.WriteString(", ")
+	builder.WriteString("completed_at=")
+	builder.WriteString(cc.CompletedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CollectionCompletions is a parsable slice of CollectionCompletion.
+type CollectionCompletions []*CollectionCompletion
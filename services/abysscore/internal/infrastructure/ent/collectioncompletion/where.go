@@ -0,0 +1,234 @@
+// Code generated by ent, DO NOT EDIT.
+
+package collectioncompletion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldEQ(FieldUserID, v))
+}
+
+// Collection applies equality check predicate on the "collection" field. It's identical to CollectionEQ.
+func Collection(v string) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldEQ(FieldCollection, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// CollectionEQ applies the EQ predicate on the "collection" field.
+func CollectionEQ(v string) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldEQ(FieldCollection, v))
+}
+
+// CollectionNEQ applies the NEQ predicate on the "collection" field.
+func CollectionNEQ(v string) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldNEQ(FieldCollection, v))
+}
+
+// CollectionIn applies the In predicate on the "collection" field.
+func CollectionIn(vs ...string) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldIn(FieldCollection, vs...))
+}
+
+// CollectionNotIn applies the NotIn predicate on the "collection" field.
+func CollectionNotIn(vs ...string) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldNotIn(FieldCollection, vs...))
+}
+
+// CollectionGT applies the GT predicate on the "collection" field.
+func CollectionGT(v string) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldGT(FieldCollection, v))
+}
+
+// CollectionGTE applies the GTE predicate on the "collection" field.
+func CollectionGTE(v string) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldGTE(FieldCollection, v))
+}
+
+// CollectionLT applies the LT predicate on the "collection" field.
+func CollectionLT(v string) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldLT(FieldCollection, v))
+}
+
+// CollectionLTE applies the LTE predicate on the "collection" field.
+func CollectionLTE(v string) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldLTE(FieldCollection, v))
+}
+
+// CollectionContains applies the Contains predicate on the "collection" field.
+func CollectionContains(v string) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldContains(FieldCollection, v))
+}
+
+// CollectionHasPrefix applies the HasPrefix predicate on the "collection" field.
+func CollectionHasPrefix(v string) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldHasPrefix(FieldCollection, v))
+}
+
+// CollectionHasSuffix applies the HasSuffix predicate on the "collection" field.
+func CollectionHasSuffix(v string) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldHasSuffix(FieldCollection, v))
+}
+
+// CollectionEqualFold applies the EqualFold predicate on the "collection" field.
+func CollectionEqualFold(v string) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldEqualFold(FieldCollection, v))
+}
+
+// CollectionContainsFold applies the ContainsFold predicate on the "collection" field.
+func CollectionContainsFold(v string) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldContainsFold(FieldCollection, v))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CollectionCompletion) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CollectionCompletion) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CollectionCompletion) predicate.CollectionCompletion {
+	return predicate.CollectionCompletion(sql.NotPredicates(p))
+}
@@ -0,0 +1,267 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/collectioncompletion"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
+)
+
+// CollectionCompletionCreate is the builder for creating a CollectionCompletion entity.
+type CollectionCompletionCreate struct {
+	config
+	mutation *CollectionCompletionMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (ccc *CollectionCompletionCreate) SetUserID(i int) *CollectionCompletionCreate {
+	ccc.mutation.SetUserID(i)
+	return ccc
+}
+
+// SetCollection sets the "collection" field.
+func (ccc *CollectionCompletionCreate) SetCollection(s string) *CollectionCompletionCreate {
+	ccc.mutation.SetCollection(s)
+	return ccc
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (ccc *CollectionCompletionCreate) SetCompletedAt(t time.Time) *CollectionCompletionCreate {
+	ccc.mutation.SetCompletedAt(t)
+	return ccc
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (ccc *CollectionCompletionCreate) SetNillableCompletedAt(t *time.Time) *CollectionCompletionCreate {
+	if t != nil {
+		ccc.SetCompletedAt(*t)
+	}
+	return ccc
+}
+
+// SetID sets the "id" field.
+func (ccc *CollectionCompletionCreate) SetID(i int) *CollectionCompletionCreate {
+	ccc.mutation.SetID(i)
+	return ccc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ccc *CollectionCompletionCreate) SetUser(u *User) *CollectionCompletionCreate {
+	return ccc.SetUserID(u.ID)
+}
+
+// Mutation returns the CollectionCompletionMutation object of the builder.
+func (ccc *CollectionCompletionCreate) Mutation() *CollectionCompletionMutation {
+	return ccc.mutation
+}
+
+// Save creates the CollectionCompletion in the database.
+func (ccc *CollectionCompletionCreate) Save(ctx context.Context) (*CollectionCompletion, error) {
+	ccc.defaults()
+	return withHooks(ctx, ccc.sqlSave, ccc.mutation, ccc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ccc *CollectionCompletionCreate) SaveX(ctx context.Context) *CollectionCompletion {
+	v, err := ccc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccc *CollectionCompletionCreate) Exec(ctx context.Context) error {
+	_, err := ccc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccc *CollectionCompletionCreate) ExecX(ctx context.Context) {
+	if err := ccc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ccc *CollectionCompletionCreate) defaults() {
+	if _, ok := ccc.mutation.CompletedAt(); !ok {
+		v := collectioncompletion.DefaultCompletedAt()
+		ccc.mutation.SetCompletedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ccc *CollectionCompletionCreate) check() error {
+	if _, ok := ccc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "CollectionCompletion.user_id"`)}
+	}
+	if _, ok := ccc.mutation.Collection(); !ok {
+		return &ValidationError{Name: "collection", err: errors.New(`ent: missing required field "CollectionCompletion.collection"`)}
+	}
+	if v, ok := ccc.mutation.Collection(); ok {
+		if err := collectioncompletion.CollectionValidator(v); err != nil {
+			return &ValidationError{Name: "collection", err: fmt.Errorf(`ent: validator failed for field "CollectionCompletion.collection": %w`, err)}
+		}
+	}
+	if _, ok := ccc.mutation.CompletedAt(); !ok {
+		return &ValidationError{Name: "completed_at", err: errors.New(`ent: missing required field "CollectionCompletion.completed_at"`)}
+	}
+	if len(ccc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "CollectionCompletion.user"`)}
+	}
+	return nil
+}
+
+func (ccc *CollectionCompletionCreate) sqlSave(ctx context.Context) (*CollectionCompletion, error) {
+	if err := ccc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ccc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ccc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	ccc.mutation.id = &_node.ID
+	ccc.mutation.done = true
+	return _node, nil
+}
+
+func (ccc *CollectionCompletionCreate) createSpec() (*CollectionCompletion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CollectionCompletion{config: ccc.config}
+		_spec = sqlgraph.NewCreateSpec(collectioncompletion.Table, sqlgraph.NewFieldSpec(collectioncompletion.FieldID, field.TypeInt))
+	)
+	if id, ok := ccc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ccc.mutation.Collection(); ok {
+		_spec.SetField(collectioncompletion.FieldCollection, field.TypeString, value)
+		_node.Collection = value
+	}
+	if value, ok := ccc.mutation.CompletedAt(); ok {
+		_spec.SetField(collectioncompletion.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = value
+	}
+	if nodes := ccc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   collectioncompletion.UserTable,
+			Columns: []string{collectioncompletion.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CollectionCompletionCreateBulk is the builder for creating many CollectionCompletion entities in bulk.
+type CollectionCompletionCreateBulk struct {
+	config
+	err      error
+	builders []*CollectionCompletionCreate
+}
+
+// Save creates the CollectionCompletion entities in the database.
+func (cccb *CollectionCompletionCreateBulk) Save(ctx context.Context) ([]*CollectionCompletion, error) {
+	if cccb.err != nil {
+		return nil, cccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cccb.builders))
+	nodes := make([]*CollectionCompletion, len(cccb.builders))
+	mutators := make([]Mutator, len(cccb.builders))
+	for i := range cccb.builders {
+		func(i int, root context.Context) {
+			builder := cccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CollectionCompletionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cccb *CollectionCompletionCreateBulk) SaveX(ctx context.Context) []*CollectionCompletion {
+	v, err := cccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cccb *CollectionCompletionCreateBulk) Exec(ctx context.Context) error {
+	_, err := cccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cccb *CollectionCompletionCreateBulk) ExecX(ctx context.Context) {
+	if err := cccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
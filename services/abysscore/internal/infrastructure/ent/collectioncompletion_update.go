@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/collectioncompletion"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+)
+
+// CollectionCompletionUpdate is the builder for updating CollectionCompletion entities.
+type CollectionCompletionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CollectionCompletionMutation
+}
+
+// Where appends a list predicates to the CollectionCompletionUpdate builder.
+func (ccu *CollectionCompletionUpdate) Where(ps ...predicate.CollectionCompletion) *CollectionCompletionUpdate {
+	ccu.mutation.Where(ps...)
+	return ccu
+}
+
+// Mutation returns the CollectionCompletionMutation object of the builder.
+func (ccu *CollectionCompletionUpdate) Mutation() *CollectionCompletionMutation {
+	return ccu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ccu *CollectionCompletionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ccu.sqlSave, ccu.mutation, ccu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccu *CollectionCompletionUpdate) SaveX(ctx context.Context) int {
+	affected, err := ccu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ccu *CollectionCompletionUpdate) Exec(ctx context.Context) error {
+	_, err := ccu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccu *CollectionCompletionUpdate) ExecX(ctx context.Context) {
+	if err := ccu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ccu *CollectionCompletionUpdate) check() error {
+	if ccu.mutation.UserCleared() && len(ccu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CollectionCompletion.user"`)
+	}
+	return nil
+}
+
+func (ccu *CollectionCompletionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ccu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(collectioncompletion.Table, collectioncompletion.Columns, sqlgraph.NewFieldSpec(collectioncompletion.FieldID, field.TypeInt))
+	if ps := ccu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ccu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{collectioncompletion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ccu.mutation.done = true
+	return n, nil
+}
+
+// CollectionCompletionUpdateOne is the builder for updating a single CollectionCompletion entity.
+type CollectionCompletionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CollectionCompletionMutation
+}
+
+// Mutation returns the CollectionCompletionMutation object of the builder.
+func (ccuo *CollectionCompletionUpdateOne) Mutation() *CollectionCompletionMutation {
+	return ccuo.mutation
+}
+
+// Where appends a list predicates to the CollectionCompletionUpdate builder.
+func (ccuo *CollectionCompletionUpdateOne) Where(ps ...predicate.CollectionCompletion) *CollectionCompletionUpdateOne {
+	ccuo.mutation.Where(ps...)
+	return ccuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ccuo *CollectionCompletionUpdateOne) Select(field string, fields ...string) *CollectionCompletionUpdateOne {
+	ccuo.fields = append([]string{field}, fields...)
+	return ccuo
+}
+
+// Save executes the query and returns the updated CollectionCompletion entity.
+func (ccuo *CollectionCompletionUpdateOne) Save(ctx context.Context) (*CollectionCompletion, error) {
+	return withHooks(ctx, ccuo.sqlSave, ccuo.mutation, ccuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccuo *CollectionCompletionUpdateOne) SaveX(ctx context.Context) *CollectionCompletion {
+	node, err := ccuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ccuo *CollectionCompletionUpdateOne) Exec(ctx context.Context) error {
+	_, err := ccuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccuo *CollectionCompletionUpdateOne) ExecX(ctx context.Context) {
+	if err := ccuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ccuo *CollectionCompletionUpdateOne) check() error {
+	if ccuo.mutation.UserCleared() && len(ccuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CollectionCompletion.user"`)
+	}
+	return nil
+}
+
+func (ccuo *CollectionCompletionUpdateOne) sqlSave(ctx context.Context) (_node *CollectionCompletion, err error) {
+	if err := ccuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(collectioncompletion.Table, collectioncompletion.Columns, sqlgraph.NewFieldSpec(collectioncompletion.FieldID, field.TypeInt))
+	id, ok := ccuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CollectionCompletion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ccuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, collectioncompletion.FieldID)
+		for _, f := range fields {
+			if !collectioncompletion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != collectioncompletion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ccuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &CollectionCompletion{config: ccuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ccuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{collectioncompletion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ccuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,158 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/collectionreward"
+)
+
+// CollectionReward is the model entity for the CollectionReward schema.
+type CollectionReward struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Collection holds the value of the "collection" field.
+	Collection string `json:"collection,omitempty"`
+	// Coins holds the value of the "coins" field.
+	Coins float64 `json:"coins,omitempty"`
+	// Title holds the value of the "title" field.
+	Title *string `json:"title,omitempty"`
+	// BadgeItemID holds the value of the "badge_item_id" field.
+	BadgeItemID *int `json:"badge_item_id,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CollectionReward) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case collectionreward.FieldCoins:
+			values[i] = new(sql.NullFloat64)
+		case collectionreward.FieldID, collectionreward.FieldBadgeItemID:
+			values[i] = new(sql.NullInt64)
+		case collectionreward.FieldCollection, collectionreward.FieldTitle:
+			values[i] = new(sql.NullString)
+		case collectionreward.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CollectionReward fields.
+func (cr *CollectionReward) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case collectionreward.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			cr.ID = int(value.Int64)
+		case collectionreward.FieldCollection:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field collection", values[i])
+			} else if value.Valid {
+				cr.Collection = value.String
+			}
+		case collectionreward.FieldCoins:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field coins", values[i])
+			} else if value.Valid {
+				cr.Coins = value.Float64
+			}
+		case collectionreward.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				cr.Title = new(string)
+				*cr.Title = value.String
+			}
+		case collectionreward.FieldBadgeItemID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field badge_item_id", values[i])
+			} else if value.Valid {
+				cr.BadgeItemID = new(int)
+				*cr.BadgeItemID = int(value.Int64)
+			}
+		case collectionreward.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				cr.UpdatedAt = value.Time
+			}
+		default:
+			cr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CollectionReward.
+// This includes values selected through modifiers, order, etc.
+func (cr *CollectionReward) Value(name string) (ent.Value, error) {
+	return cr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CollectionReward.
+// Note that you need to call CollectionReward.Unwrap() before calling this method if this CollectionReward
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cr *CollectionReward) Update() *CollectionRewardUpdateOne {
+	return NewCollectionRewardClient(cr.config).UpdateOne(cr)
+}
+
+// Unwrap unwraps the CollectionReward entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cr *CollectionReward) Unwrap() *CollectionReward {
+	_tx, ok := cr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CollectionReward is not a transactional entity")
+	}
+	cr.config.driver = _tx.drv
+	return cr
+}
+
+// String implements the fmt.Stringer.
+func (cr *CollectionReward) String() string {
+	var builder strings.Builder
+	builder.WriteString("CollectionReward(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cr.ID))
+	builder.WriteString("collection=")
+	builder.WriteString(cr.Collection)
+	builder.WriteString(", ")
+	builder.WriteString("coins=")
+	builder.WriteString(fmt.Sprintf("%v", cr.Coins))
+	builder.WriteString(", ")
+	if v := cr.Title; v != nil {
+		builder.WriteString("title=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := cr.BadgeItemID; v != nil {
+		builder.WriteString("badge_item_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(cr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CollectionRewards is a parsable slice of CollectionReward.
+type CollectionRewards []*CollectionReward
@@ -0,0 +1,94 @@
+// Code generated by ent, DO NOT EDIT.
+
+package collectionreward
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the collectionreward type in the database.
+	Label = "collection_reward"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCollection holds the string denoting the collection field in the database.
+	FieldCollection = "collection"
+	// FieldCoins holds the string denoting the coins field in the database.
+	FieldCoins = "coins"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldBadgeItemID holds the string denoting the badge_item_id field in the database.
+	FieldBadgeItemID = "badge_item_id"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the collectionreward in the database.
+	Table = "collection_rewards"
+)
+
+// Columns holds all SQL columns for collectionreward fields.
+var Columns = []string{
+	FieldID,
+	FieldCollection,
+	FieldCoins,
+	FieldTitle,
+	FieldBadgeItemID,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CollectionValidator is a validator for the "collection" field. It is called by the builders before save.
+	CollectionValidator func(string) error
+	// DefaultCoins holds the default value on creation for the "coins" field.
+	DefaultCoins float64
+	// CoinsValidator is a validator for the "coins" field. It is called by the builders before save.
+	CoinsValidator func(float64) error
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the CollectionReward queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCollection orders the results by the collection field.
+func ByCollection(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollection, opts...).ToFunc()
+}
+
+// ByCoins orders the results by the coins field.
+func ByCoins(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCoins, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByBadgeItemID orders the results by the badge_item_id field.
+func ByBadgeItemID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBadgeItemID, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
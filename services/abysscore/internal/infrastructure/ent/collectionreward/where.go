@@ -0,0 +1,365 @@
+// Code generated by ent, DO NOT EDIT.
+
+package collectionreward
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldLTE(FieldID, id))
+}
+
+// Collection applies equality check predicate on the "collection" field. It's identical to CollectionEQ.
+func Collection(v string) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldEQ(FieldCollection, v))
+}
+
+// Coins applies equality check predicate on the "coins" field. It's identical to CoinsEQ.
+func Coins(v float64) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldEQ(FieldCoins, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldEQ(FieldTitle, v))
+}
+
+// BadgeItemID applies equality check predicate on the "badge_item_id" field. It's identical to BadgeItemIDEQ.
+func BadgeItemID(v int) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldEQ(FieldBadgeItemID, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CollectionEQ applies the EQ predicate on the "collection" field.
+func CollectionEQ(v string) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldEQ(FieldCollection, v))
+}
+
+// CollectionNEQ applies the NEQ predicate on the "collection" field.
+func CollectionNEQ(v string) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldNEQ(FieldCollection, v))
+}
+
+// CollectionIn applies the In predicate on the "collection" field.
+func CollectionIn(vs ...string) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldIn(FieldCollection, vs...))
+}
+
+// CollectionNotIn applies the NotIn predicate on the "collection" field.
+func CollectionNotIn(vs ...string) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldNotIn(FieldCollection, vs...))
+}
+
+// CollectionGT applies the GT predicate on the "collection" field.
+func CollectionGT(v string) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldGT(FieldCollection, v))
+}
+
+// CollectionGTE applies the GTE predicate on the "collection" field.
+func CollectionGTE(v string) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldGTE(FieldCollection, v))
+}
+
+// CollectionLT applies the LT predicate on the "collection" field.
+func CollectionLT(v string) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldLT(FieldCollection, v))
+}
+
+// CollectionLTE applies the LTE predicate on the "collection" field.
+func CollectionLTE(v string) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldLTE(FieldCollection, v))
+}
+
+// CollectionContains applies the Contains predicate on the "collection" field.
+func CollectionContains(v string) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldContains(FieldCollection, v))
+}
+
+// CollectionHasPrefix applies the HasPrefix predicate on the "collection" field.
+func CollectionHasPrefix(v string) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldHasPrefix(FieldCollection, v))
+}
+
+// CollectionHasSuffix applies the HasSuffix predicate on the "collection" field.
+func CollectionHasSuffix(v string) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldHasSuffix(FieldCollection, v))
+}
+
+// CollectionEqualFold applies the EqualFold predicate on the "collection" field.
+func CollectionEqualFold(v string) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldEqualFold(FieldCollection, v))
+}
+
+// CollectionContainsFold applies the ContainsFold predicate on the "collection" field.
+func CollectionContainsFold(v string) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldContainsFold(FieldCollection, v))
+}
+
+// CoinsEQ applies the EQ predicate on the "coins" field.
+func CoinsEQ(v float64) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldEQ(FieldCoins, v))
+}
+
+// CoinsNEQ applies the NEQ predicate on the "coins" field.
+func CoinsNEQ(v float64) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldNEQ(FieldCoins, v))
+}
+
+// CoinsIn applies the In predicate on the "coins" field.
+func CoinsIn(vs ...float64) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldIn(FieldCoins, vs...))
+}
+
+// CoinsNotIn applies the NotIn predicate on the "coins" field.
+func CoinsNotIn(vs ...float64) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldNotIn(FieldCoins, vs...))
+}
+
+// CoinsGT applies the GT predicate on the "coins" field.
+func CoinsGT(v float64) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldGT(FieldCoins, v))
+}
+
+// CoinsGTE applies the GTE predicate on the "coins" field.
+func CoinsGTE(v float64) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldGTE(FieldCoins, v))
+}
+
+// CoinsLT applies the LT predicate on the "coins" field.
+func CoinsLT(v float64) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldLT(FieldCoins, v))
+}
+
+// CoinsLTE applies the LTE predicate on the "coins" field.
+func CoinsLTE(v float64) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldLTE(FieldCoins, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleIsNil applies the IsNil predicate on the "title" field.
+func TitleIsNil() predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldIsNull(FieldTitle))
+}
+
+// TitleNotNil applies the NotNil predicate on the "title" field.
+func TitleNotNil() predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldNotNull(FieldTitle))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// BadgeItemIDEQ applies the EQ predicate on the "badge_item_id" field.
+func BadgeItemIDEQ(v int) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldEQ(FieldBadgeItemID, v))
+}
+
+// BadgeItemIDNEQ applies the NEQ predicate on the "badge_item_id" field.
+func BadgeItemIDNEQ(v int) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldNEQ(FieldBadgeItemID, v))
+}
+
+// BadgeItemIDIn applies the In predicate on the "badge_item_id" field.
+func BadgeItemIDIn(vs ...int) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldIn(FieldBadgeItemID, vs...))
+}
+
+// BadgeItemIDNotIn applies the NotIn predicate on the "badge_item_id" field.
+func BadgeItemIDNotIn(vs ...int) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldNotIn(FieldBadgeItemID, vs...))
+}
+
+// BadgeItemIDGT applies the GT predicate on the "badge_item_id" field.
+func BadgeItemIDGT(v int) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldGT(FieldBadgeItemID, v))
+}
+
+// BadgeItemIDGTE applies the GTE predicate on the "badge_item_id" field.
+func BadgeItemIDGTE(v int) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldGTE(FieldBadgeItemID, v))
+}
+
+// BadgeItemIDLT applies the LT predicate on the "badge_item_id" field.
+func BadgeItemIDLT(v int) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldLT(FieldBadgeItemID, v))
+}
+
+// BadgeItemIDLTE applies the LTE predicate on the "badge_item_id" field.
+func BadgeItemIDLTE(v int) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldLTE(FieldBadgeItemID, v))
+}
+
+// BadgeItemIDIsNil applies the IsNil predicate on the "badge_item_id" field.
+func BadgeItemIDIsNil() predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldIsNull(FieldBadgeItemID))
+}
+
+// BadgeItemIDNotNil applies the NotNil predicate on the "badge_item_id" field.
+func BadgeItemIDNotNil() predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldNotNull(FieldBadgeItemID))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CollectionReward) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CollectionReward) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CollectionReward) predicate.CollectionReward {
+	return predicate.CollectionReward(sql.NotPredicates(p))
+}
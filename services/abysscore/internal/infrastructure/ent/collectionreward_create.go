@@ -0,0 +1,298 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/collectionreward"
+)
+
+// CollectionRewardCreate is the builder for creating a CollectionReward entity.
+type CollectionRewardCreate struct {
+	config
+	mutation *CollectionRewardMutation
+	hooks    []Hook
+}
+
+// SetCollection sets the "collection" field.
+func (crc *CollectionRewardCreate) SetCollection(s string) *CollectionRewardCreate {
+	crc.mutation.SetCollection(s)
+	return crc
+}
+
+// SetCoins sets the "coins" field.
+func (crc *CollectionRewardCreate) SetCoins(f float64) *CollectionRewardCreate {
+	crc.mutation.SetCoins(f)
+	return crc
+}
+
+// SetNillableCoins sets the "coins" field if the given value is not nil.
+func (crc *CollectionRewardCreate) SetNillableCoins(f *float64) *CollectionRewardCreate {
+	if f != nil {
+		crc.SetCoins(*f)
+	}
+	return crc
+}
+
+// SetTitle sets the "title" field.
+func (crc *CollectionRewardCreate) SetTitle(s string) *CollectionRewardCreate {
+	crc.mutation.SetTitle(s)
+	return crc
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (crc *CollectionRewardCreate) SetNillableTitle(s *string) *CollectionRewardCreate {
+	if s != nil {
+		crc.SetTitle(*s)
+	}
+	return crc
+}
+
+// SetBadgeItemID sets the "badge_item_id" field.
+func (crc *CollectionRewardCreate) SetBadgeItemID(i int) *CollectionRewardCreate {
+	crc.mutation.SetBadgeItemID(i)
+	return crc
+}
+
+// SetNillableBadgeItemID sets the "badge_item_id" field if the given value is not nil.
+func (crc *CollectionRewardCreate) SetNillableBadgeItemID(i *int) *CollectionRewardCreate {
+	if i != nil {
+		crc.SetBadgeItemID(*i)
+	}
+	return crc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (crc *CollectionRewardCreate) SetUpdatedAt(t time.Time) *CollectionRewardCreate {
+	crc.mutation.SetUpdatedAt(t)
+	return crc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (crc *CollectionRewardCreate) SetNillableUpdatedAt(t *time.Time) *CollectionRewardCreate {
+	if t != nil {
+		crc.SetUpdatedAt(*t)
+	}
+	return crc
+}
+
+// SetID sets the "id" field.
+func (crc *CollectionRewardCreate) SetID(i int) *CollectionRewardCreate {
+	crc.mutation.SetID(i)
+	return crc
+}
+
+// Mutation returns the CollectionRewardMutation object of the builder.
+func (crc *CollectionRewardCreate) Mutation() *CollectionRewardMutation {
+	return crc.mutation
+}
+
+// Save creates the CollectionReward in the database.
+func (crc *CollectionRewardCreate) Save(ctx context.Context) (*CollectionReward, error) {
+	crc.defaults()
+	return withHooks(ctx, crc.sqlSave, crc.mutation, crc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (crc *CollectionRewardCreate) SaveX(ctx context.Context) *CollectionReward {
+	v, err := crc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (crc *CollectionRewardCreate) Exec(ctx context.Context) error {
+	_, err := crc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crc *CollectionRewardCreate) ExecX(ctx context.Context) {
+	if err := crc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (crc *CollectionRewardCreate) defaults() {
+	if _, ok := crc.mutation.Coins(); !ok {
+		v := collectionreward.DefaultCoins
+		crc.mutation.SetCoins(v)
+	}
+	if _, ok := crc.mutation.UpdatedAt(); !ok {
+		v := collectionreward.DefaultUpdatedAt()
+		crc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (crc *CollectionRewardCreate) check() error {
+	if _, ok := crc.mutation.Collection(); !ok {
+		return &ValidationError{Name: "collection", err: errors.New(`ent: missing required field "CollectionReward.collection"`)}
+	}
+	if v, ok := crc.mutation.Collection(); ok {
+		if err := collectionreward.CollectionValidator(v); err != nil {
+			return &ValidationError{Name: "collection", err: fmt.Errorf(`ent: validator failed for field "CollectionReward.collection": %w`, err)}
+		}
+	}
+	if _, ok := crc.mutation.Coins(); !ok {
+		return &ValidationError{Name: "coins", err: errors.New(`ent: missing required field "CollectionReward.coins"`)}
+	}
+	if v, ok := crc.mutation.Coins(); ok {
+		if err := collectionreward.CoinsValidator(v); err != nil {
+			return &ValidationError{Name: "coins", err: fmt.Errorf(`ent: validator failed for field "CollectionReward.coins": %w`, err)}
+		}
+	}
+	if _, ok := crc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CollectionReward.updated_at"`)}
+	}
+	return nil
+}
+
+func (crc *CollectionRewardCreate) sqlSave(ctx context.Context) (*CollectionReward, error) {
+	if err := crc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := crc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, crc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	crc.mutation.id = &_node.ID
+	crc.mutation.done = true
+	return _node, nil
+}
+
+func (crc *CollectionRewardCreate) createSpec() (*CollectionReward, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CollectionReward{config: crc.config}
+		_spec = sqlgraph.NewCreateSpec(collectionreward.Table, sqlgraph.NewFieldSpec(collectionreward.FieldID, field.TypeInt))
+	)
+	if id, ok := crc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := crc.mutation.Collection(); ok {
+		_spec.SetField(collectionreward.FieldCollection, field.TypeString, value)
+		_node.Collection = value
+	}
+	if value, ok := crc.mutation.Coins(); ok {
+		_spec.SetField(collectionreward.FieldCoins, field.TypeFloat64, value)
+		_node.Coins = value
+	}
+	if value, ok := crc.mutation.Title(); ok {
+		_spec.SetField(collectionreward.FieldTitle, field.TypeString, value)
+		_node.Title = &value
+	}
+	if value, ok := crc.mutation.BadgeItemID(); ok {
+		_spec.SetField(collectionreward.FieldBadgeItemID, field.TypeInt, value)
+		_node.BadgeItemID = &value
+	}
+	if value, ok := crc.mutation.UpdatedAt(); ok {
+		_spec.SetField(collectionreward.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// CollectionRewardCreateBulk is the builder for creating many CollectionReward entities in bulk.
+type CollectionRewardCreateBulk struct {
+	config
+	err      error
+	builders []*CollectionRewardCreate
+}
+
+// Save creates the CollectionReward entities in the database.
+func (crcb *CollectionRewardCreateBulk) Save(ctx context.Context) ([]*CollectionReward, error) {
+	if crcb.err != nil {
+		return nil, crcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(crcb.builders))
+	nodes := make([]*CollectionReward, len(crcb.builders))
+	mutators := make([]Mutator, len(crcb.builders))
+	for i := range crcb.builders {
+		func(i int, root context.Context) {
+			builder := crcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CollectionRewardMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, crcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, crcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, crcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (crcb *CollectionRewardCreateBulk) SaveX(ctx context.Context) []*CollectionReward {
+	v, err := crcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (crcb *CollectionRewardCreateBulk) Exec(ctx context.Context) error {
+	_, err := crcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crcb *CollectionRewardCreateBulk) ExecX(ctx context.Context) {
+	if err := crcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
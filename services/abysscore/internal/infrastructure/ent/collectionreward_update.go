@@ -0,0 +1,416 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/collectionreward"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+)
+
+// CollectionRewardUpdate is the builder for updating CollectionReward entities.
+type CollectionRewardUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CollectionRewardMutation
+}
+
+// Where appends a list predicates to the CollectionRewardUpdate builder.
+func (cru *CollectionRewardUpdate) Where(ps ...predicate.CollectionReward) *CollectionRewardUpdate {
+	cru.mutation.Where(ps...)
+	return cru
+}
+
+// SetCoins sets the "coins" field.
+func (cru *CollectionRewardUpdate) SetCoins(f float64) *CollectionRewardUpdate {
+	cru.mutation.ResetCoins()
+	cru.mutation.SetCoins(f)
+	return cru
+}
+
+// SetNillableCoins sets the "coins" field if the given value is not nil.
+func (cru *CollectionRewardUpdate) SetNillableCoins(f *float64) *CollectionRewardUpdate {
+	if f != nil {
+		cru.SetCoins(*f)
+	}
+	return cru
+}
+
+// AddCoins adds f to the "coins" field.
+func (cru *CollectionRewardUpdate) AddCoins(f float64) *CollectionRewardUpdate {
+	cru.mutation.AddCoins(f)
+	return cru
+}
+
+// SetTitle sets the "title" field.
+func (cru *CollectionRewardUpdate) SetTitle(s string) *CollectionRewardUpdate {
+	cru.mutation.SetTitle(s)
+	return cru
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (cru *CollectionRewardUpdate) SetNillableTitle(s *string) *CollectionRewardUpdate {
+	if s != nil {
+		cru.SetTitle(*s)
+	}
+	return cru
+}
+
+// ClearTitle clears the value of the "title" field.
+func (cru *CollectionRewardUpdate) ClearTitle() *CollectionRewardUpdate {
+	cru.mutation.ClearTitle()
+	return cru
+}
+
+// SetBadgeItemID sets the "badge_item_id" field.
+func (cru *CollectionRewardUpdate) SetBadgeItemID(i int) *CollectionRewardUpdate {
+	cru.mutation.ResetBadgeItemID()
+	cru.mutation.SetBadgeItemID(i)
+	return cru
+}
+
+// SetNillableBadgeItemID sets the "badge_item_id" field if the given value is not nil.
+func (cru *CollectionRewardUpdate) SetNillableBadgeItemID(i *int) *CollectionRewardUpdate {
+	if i != nil {
+		cru.SetBadgeItemID(*i)
+	}
+	return cru
+}
+
+// AddBadgeItemID adds i to the "badge_item_id" field.
+func (cru *CollectionRewardUpdate) AddBadgeItemID(i int) *CollectionRewardUpdate {
+	cru.mutation.AddBadgeItemID(i)
+	return cru
+}
+
+// ClearBadgeItemID clears the value of the "badge_item_id" field.
+func (cru *CollectionRewardUpdate) ClearBadgeItemID() *CollectionRewardUpdate {
+	cru.mutation.ClearBadgeItemID()
+	return cru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cru *CollectionRewardUpdate) SetUpdatedAt(t time.Time) *CollectionRewardUpdate {
+	cru.mutation.SetUpdatedAt(t)
+	return cru
+}
+
+// Mutation returns the CollectionRewardMutation object of the builder.
+func (cru *CollectionRewardUpdate) Mutation() *CollectionRewardMutation {
+	return cru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cru *CollectionRewardUpdate) Save(ctx context.Context) (int, error) {
+	cru.defaults()
+	return withHooks(ctx, cru.sqlSave, cru.mutation, cru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cru *CollectionRewardUpdate) SaveX(ctx context.Context) int {
+	affected, err := cru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cru *CollectionRewardUpdate) Exec(ctx context.Context) error {
+	_, err := cru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cru *CollectionRewardUpdate) ExecX(ctx context.Context) {
+	if err := cru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cru *CollectionRewardUpdate) defaults() {
+	if _, ok := cru.mutation.UpdatedAt(); !ok {
+		v := collectionreward.UpdateDefaultUpdatedAt()
+		cru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cru *CollectionRewardUpdate) check() error {
+	if v, ok := cru.mutation.Coins(); ok {
+		if err := collectionreward.CoinsValidator(v); err != nil {
+			return &ValidationError{Name: "coins", err: fmt.Errorf(`ent: validator failed for field "CollectionReward.coins": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cru *CollectionRewardUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(collectionreward.Table, collectionreward.Columns, sqlgraph.NewFieldSpec(collectionreward.FieldID, field.TypeInt))
+	if ps := cru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cru.mutation.Coins(); ok {
+		_spec.SetField(collectionreward.FieldCoins, field.TypeFloat64, value)
+	}
+	if value, ok := cru.mutation.AddedCoins(); ok {
+		_spec.AddField(collectionreward.FieldCoins, field.TypeFloat64, value)
+	}
+	if value, ok := cru.mutation.Title(); ok {
+		_spec.SetField(collectionreward.FieldTitle, field.TypeString, value)
+	}
+	if cru.mutation.TitleCleared() {
+		_spec.ClearField(collectionreward.FieldTitle, field.TypeString)
+	}
+	if value, ok := cru.mutation.BadgeItemID(); ok {
+		_spec.SetField(collectionreward.FieldBadgeItemID, field.TypeInt, value)
+	}
+	if value, ok := cru.mutation.AddedBadgeItemID(); ok {
+		_spec.AddField(collectionreward.FieldBadgeItemID, field.TypeInt, value)
+	}
+	if cru.mutation.BadgeItemIDCleared() {
+		_spec.ClearField(collectionreward.FieldBadgeItemID, field.TypeInt)
+	}
+	if value, ok := cru.mutation.UpdatedAt(); ok {
+		_spec.SetField(collectionreward.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{collectionreward.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cru.mutation.done = true
+	return n, nil
+}
+
+// CollectionRewardUpdateOne is the builder for updating a single CollectionReward entity.
+type CollectionRewardUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CollectionRewardMutation
+}
+
+// SetCoins sets the "coins" field.
+func (cruo *CollectionRewardUpdateOne) SetCoins(f float64) *CollectionRewardUpdateOne {
+	cruo.mutation.ResetCoins()
+	cruo.mutation.SetCoins(f)
+	return cruo
+}
+
+// SetNillableCoins sets the "coins" field if the given value is not nil.
+func (cruo *CollectionRewardUpdateOne) SetNillableCoins(f *float64) *CollectionRewardUpdateOne {
+	if f != nil {
+		cruo.SetCoins(*f)
+	}
+	return cruo
+}
+
+// AddCoins adds f to the "coins" field.
+func (cruo *CollectionRewardUpdateOne) AddCoins(f float64) *CollectionRewardUpdateOne {
+	cruo.mutation.AddCoins(f)
+	return cruo
+}
+
+// SetTitle sets the "title" field.
+func (cruo *CollectionRewardUpdateOne) SetTitle(s string) *CollectionRewardUpdateOne {
+	cruo.mutation.SetTitle(s)
+	return cruo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (cruo *CollectionRewardUpdateOne) SetNillableTitle(s *string) *CollectionRewardUpdateOne {
+	if s != nil {
+		cruo.SetTitle(*s)
+	}
+	return cruo
+}
+
+// ClearTitle clears the value of the "title" field.
+func (cruo *CollectionRewardUpdateOne) ClearTitle() *CollectionRewardUpdateOne {
+	cruo.mutation.ClearTitle()
+	return cruo
+}
+
+// SetBadgeItemID sets the "badge_item_id" field.
+func (cruo *CollectionRewardUpdateOne) SetBadgeItemID(i int) *CollectionRewardUpdateOne {
+	cruo.mutation.ResetBadgeItemID()
+	cruo.mutation.SetBadgeItemID(i)
+	return cruo
+}
+
+// SetNillableBadgeItemID sets the "badge_item_id" field if the given value is not nil.
+func (cruo *CollectionRewardUpdateOne) SetNillableBadgeItemID(i *int) *CollectionRewardUpdateOne {
+	if i != nil {
+		cruo.SetBadgeItemID(*i)
+	}
+	return cruo
+}
+
+// AddBadgeItemID adds i to the "badge_item_id" field.
+func (cruo *CollectionRewardUpdateOne) AddBadgeItemID(i int) *CollectionRewardUpdateOne {
+	cruo.mutation.AddBadgeItemID(i)
+	return cruo
+}
+
+// ClearBadgeItemID clears the value of the "badge_item_id" field.
+func (cruo *CollectionRewardUpdateOne) ClearBadgeItemID() *CollectionRewardUpdateOne {
+	cruo.mutation.ClearBadgeItemID()
+	return cruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cruo *CollectionRewardUpdateOne) SetUpdatedAt(t time.Time) *CollectionRewardUpdateOne {
+	cruo.mutation.SetUpdatedAt(t)
+	return cruo
+}
+
+// Mutation returns the CollectionRewardMutation object of the builder.
+func (cruo *CollectionRewardUpdateOne) Mutation() *CollectionRewardMutation {
+	return cruo.mutation
+}
+
+// Where appends a list predicates to the CollectionRewardUpdate builder.
+func (cruo *CollectionRewardUpdateOne) Where(ps ...predicate.CollectionReward) *CollectionRewardUpdateOne {
+	cruo.mutation.Where(ps...)
+	return cruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cruo *CollectionRewardUpdateOne) Select(field string, fields ...string) *CollectionRewardUpdateOne {
+	cruo.fields = append([]string{field}, fields...)
+	return cruo
+}
+
+// Save executes the query and returns the updated CollectionReward entity.
+func (cruo *CollectionRewardUpdateOne) Save(ctx context.Context) (*CollectionReward, error) {
+	cruo.defaults()
+	return withHooks(ctx, cruo.sqlSave, cruo.mutation, cruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cruo *CollectionRewardUpdateOne) SaveX(ctx context.Context) *CollectionReward {
+	node, err := cruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cruo *CollectionRewardUpdateOne) Exec(ctx context.Context) error {
+	_, err := cruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cruo *CollectionRewardUpdateOne) ExecX(ctx context.Context) {
+	if err := cruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cruo *CollectionRewardUpdateOne) defaults() {
+	if _, ok := cruo.mutation.UpdatedAt(); !ok {
+		v := collectionreward.UpdateDefaultUpdatedAt()
+		cruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cruo *CollectionRewardUpdateOne) check() error {
+	if v, ok := cruo.mutation.Coins(); ok {
+		if err := collectionreward.CoinsValidator(v); err != nil {
+			return &ValidationError{Name: "coins", err: fmt.Errorf(`ent: validator failed for field "CollectionReward.coins": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cruo *CollectionRewardUpdateOne) sqlSave(ctx context.Context) (_node *CollectionReward, err error) {
+	if err := cruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(collectionreward.Table, collectionreward.Columns, sqlgraph.NewFieldSpec(collectionreward.FieldID, field.TypeInt))
+	id, ok := cruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CollectionReward.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, collectionreward.FieldID)
+		for _, f := range fields {
+			if !collectionreward.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != collectionreward.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cruo.mutation.Coins(); ok {
+		_spec.SetField(collectionreward.FieldCoins, field.TypeFloat64, value)
+	}
+	if value, ok := cruo.mutation.AddedCoins(); ok {
+		_spec.AddField(collectionreward.FieldCoins, field.TypeFloat64, value)
+	}
+	if value, ok := cruo.mutation.Title(); ok {
+		_spec.SetField(collectionreward.FieldTitle, field.TypeString, value)
+	}
+	if cruo.mutation.TitleCleared() {
+		_spec.ClearField(collectionreward.FieldTitle, field.TypeString)
+	}
+	if value, ok := cruo.mutation.BadgeItemID(); ok {
+		_spec.SetField(collectionreward.FieldBadgeItemID, field.TypeInt, value)
+	}
+	if value, ok := cruo.mutation.AddedBadgeItemID(); ok {
+		_spec.AddField(collectionreward.FieldBadgeItemID, field.TypeInt, value)
+	}
+	if cruo.mutation.BadgeItemIDCleared() {
+		_spec.ClearField(collectionreward.FieldBadgeItemID, field.TypeInt)
+	}
+	if value, ok := cruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(collectionreward.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &CollectionReward{config: cruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{collectionreward.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/emailhistory"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
+)
+
+// EmailHistory is the model entity for the EmailHistory schema.
+type EmailHistory struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Action holds the value of the "action" field.
+	Action emailhistory.Action `json:"action,omitempty"`
+	// OldEmail holds the value of the "old_email" field.
+	OldEmail *string `json:"old_email,omitempty"`
+	// NewEmail holds the value of the "new_email" field.
+	NewEmail *string `json:"new_email,omitempty"`
+	// PerformerID holds the value of the "performer_id" field.
+	PerformerID *int `json:"performer_id,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason *string `json:"reason,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EmailHistoryQuery when eager-loading is set.
+	Edges        EmailHistoryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// EmailHistoryEdges holds the relations/edges for other nodes in the graph.
+type EmailHistoryEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EmailHistoryEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EmailHistory) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case emailhistory.FieldID, emailhistory.FieldUserID, emailhistory.FieldPerformerID:
+			values[i] = new(sql.NullInt64)
+		case emailhistory.FieldAction, emailhistory.FieldOldEmail, emailhistory.FieldNewEmail, emailhistory.FieldReason:
+			values[i] = new(sql.NullString)
+		case emailhistory.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EmailHistory fields.
+func (eh *EmailHistory) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case emailhistory.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			eh.ID = int(value.Int64)
+		case emailhistory.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				eh.UserID = int(value.Int64)
+			}
+		case emailhistory.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				eh.Action = emailhistory.Action(value.String)
+			}
+		case emailhistory.FieldOldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field old_email", values[i])
+			} else if value.Valid {
+				eh.OldEmail = new(string)
+				*eh.OldEmail = value.String
+			}
+		case emailhistory.FieldNewEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field new_email", values[i])
+			} else if value.Valid {
+				eh.NewEmail = new(string)
+				*eh.NewEmail = value.String
+			}
+		case emailhistory.FieldPerformerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field performer_id", values[i])
+			} else if value.Valid {
+				eh.PerformerID = new(int)
+				*eh.PerformerID = int(value.Int64)
+			}
+		case emailhistory.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				eh.Reason = new(string)
+				*eh.Reason = value.String
+			}
+		case emailhistory.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				eh.CreatedAt = value.Time
+			}
+		default:
+			eh.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EmailHistory.
+// This includes values selected through modifiers, order, etc.
+func (eh *EmailHistory) Value(name string) (ent.Value, error) {
+	return eh.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the EmailHistory entity.
+func (eh *EmailHistory) QueryUser() *UserQuery {
+	return NewEmailHistoryClient(eh.config).QueryUser(eh)
+}
+
+// Update returns a builder for updating this EmailHistory.
+// Note that you need to call EmailHistory.Unwrap() before calling this method if this EmailHistory
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (eh *EmailHistory) Update() *EmailHistoryUpdateOne {
+	return NewEmailHistoryClient(eh.config).UpdateOne(eh)
+}
+
+// Unwrap unwraps the EmailHistory entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (eh *EmailHistory) Unwrap() *EmailHistory {
+	_tx, ok := eh.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EmailHistory is not a transactional entity")
+	}
+	eh.config.driver = _tx.drv
+	return eh
+}
+
+// String implements the fmt.Stringer.
+func (eh *EmailHistory) String() string {
+	var builder strings.Builder
+	builder.WriteString("EmailHistory(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", eh.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", eh.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", eh.Action))
+	builder.WriteString(", ")
+	if v := eh.OldEmail; v != nil {
+		builder.WriteString("old_email=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := eh.NewEmail; v != nil {
+		builder.WriteString("new_email=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := eh.PerformerID; v != nil {
+		builder.WriteString("performer_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := eh.Reason; v != nil {
+		builder.WriteString("reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(eh.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EmailHistories is a parsable slice of EmailHistory.
+type EmailHistories []*EmailHistory
@@ -0,0 +1,479 @@
+// Code generated by ent, DO NOT EDIT.
+
+package emailhistory
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldEQ(FieldUserID, v))
+}
+
+// OldEmail applies equality check predicate on the "old_email" field. It's identical to OldEmailEQ.
+func OldEmail(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldEQ(FieldOldEmail, v))
+}
+
+// NewEmail applies equality check predicate on the "new_email" field. It's identical to NewEmailEQ.
+func NewEmail(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldEQ(FieldNewEmail, v))
+}
+
+// PerformerID applies equality check predicate on the "performer_id" field. It's identical to PerformerIDEQ.
+func PerformerID(v int) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldEQ(FieldPerformerID, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldEQ(FieldReason, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// OldEmailEQ applies the EQ predicate on the "old_email" field.
+func OldEmailEQ(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldEQ(FieldOldEmail, v))
+}
+
+// OldEmailNEQ applies the NEQ predicate on the "old_email" field.
+func OldEmailNEQ(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldNEQ(FieldOldEmail, v))
+}
+
+// OldEmailIn applies the In predicate on the "old_email" field.
+func OldEmailIn(vs ...string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldIn(FieldOldEmail, vs...))
+}
+
+// OldEmailNotIn applies the NotIn predicate on the "old_email" field.
+func OldEmailNotIn(vs ...string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldNotIn(FieldOldEmail, vs...))
+}
+
+// OldEmailGT applies the GT predicate on the "old_email" field.
+func OldEmailGT(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldGT(FieldOldEmail, v))
+}
+
+// OldEmailGTE applies the GTE predicate on the "old_email" field.
+func OldEmailGTE(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldGTE(FieldOldEmail, v))
+}
+
+// OldEmailLT applies the LT predicate on the "old_email" field.
+func OldEmailLT(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldLT(FieldOldEmail, v))
+}
+
+// OldEmailLTE applies the LTE predicate on the "old_email" field.
+func OldEmailLTE(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldLTE(FieldOldEmail, v))
+}
+
+// OldEmailContains applies the Contains predicate on the "old_email" field.
+func OldEmailContains(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldContains(FieldOldEmail, v))
+}
+
+// OldEmailHasPrefix applies the HasPrefix predicate on the "old_email" field.
+func OldEmailHasPrefix(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldHasPrefix(FieldOldEmail, v))
+}
+
+// OldEmailHasSuffix applies the HasSuffix predicate on the "old_email" field.
+func OldEmailHasSuffix(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldHasSuffix(FieldOldEmail, v))
+}
+
+// OldEmailIsNil applies the IsNil predicate on the "old_email" field.
+func OldEmailIsNil() predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldIsNull(FieldOldEmail))
+}
+
+// OldEmailNotNil applies the NotNil predicate on the "old_email" field.
+func OldEmailNotNil() predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldNotNull(FieldOldEmail))
+}
+
+// OldEmailEqualFold applies the EqualFold predicate on the "old_email" field.
+func OldEmailEqualFold(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldEqualFold(FieldOldEmail, v))
+}
+
+// OldEmailContainsFold applies the ContainsFold predicate on the "old_email" field.
+func OldEmailContainsFold(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldContainsFold(FieldOldEmail, v))
+}
+
+// NewEmailEQ applies the EQ predicate on the "new_email" field.
+func NewEmailEQ(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldEQ(FieldNewEmail, v))
+}
+
+// NewEmailNEQ applies the NEQ predicate on the "new_email" field.
+func NewEmailNEQ(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldNEQ(FieldNewEmail, v))
+}
+
+// NewEmailIn applies the In predicate on the "new_email" field.
+func NewEmailIn(vs ...string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldIn(FieldNewEmail, vs...))
+}
+
+// NewEmailNotIn applies the NotIn predicate on the "new_email" field.
+func NewEmailNotIn(vs ...string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldNotIn(FieldNewEmail, vs...))
+}
+
+// NewEmailGT applies the GT predicate on the "new_email" field.
+func NewEmailGT(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldGT(FieldNewEmail, v))
+}
+
+// NewEmailGTE applies the GTE predicate on the "new_email" field.
+func NewEmailGTE(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldGTE(FieldNewEmail, v))
+}
+
+// NewEmailLT applies the LT predicate on the "new_email" field.
+func NewEmailLT(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldLT(FieldNewEmail, v))
+}
+
+// NewEmailLTE applies the LTE predicate on the "new_email" field.
+func NewEmailLTE(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldLTE(FieldNewEmail, v))
+}
+
+// NewEmailContains applies the Contains predicate on the "new_email" field.
+func NewEmailContains(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldContains(FieldNewEmail, v))
+}
+
+// NewEmailHasPrefix applies the HasPrefix predicate on the "new_email" field.
+func NewEmailHasPrefix(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldHasPrefix(FieldNewEmail, v))
+}
+
+// NewEmailHasSuffix applies the HasSuffix predicate on the "new_email" field.
+func NewEmailHasSuffix(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldHasSuffix(FieldNewEmail, v))
+}
+
+// NewEmailIsNil applies the IsNil predicate on the "new_email" field.
+func NewEmailIsNil() predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldIsNull(FieldNewEmail))
+}
+
+// NewEmailNotNil applies the NotNil predicate on the "new_email" field.
+func NewEmailNotNil() predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldNotNull(FieldNewEmail))
+}
+
+// NewEmailEqualFold applies the EqualFold predicate on the "new_email" field.
+func NewEmailEqualFold(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldEqualFold(FieldNewEmail, v))
+}
+
+// NewEmailContainsFold applies the ContainsFold predicate on the "new_email" field.
+func NewEmailContainsFold(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldContainsFold(FieldNewEmail, v))
+}
+
+// PerformerIDEQ applies the EQ predicate on the "performer_id" field.
+func PerformerIDEQ(v int) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldEQ(FieldPerformerID, v))
+}
+
+// PerformerIDNEQ applies the NEQ predicate on the "performer_id" field.
+func PerformerIDNEQ(v int) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldNEQ(FieldPerformerID, v))
+}
+
+// PerformerIDIn applies the In predicate on the "performer_id" field.
+func PerformerIDIn(vs ...int) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldIn(FieldPerformerID, vs...))
+}
+
+// PerformerIDNotIn applies the NotIn predicate on the "performer_id" field.
+func PerformerIDNotIn(vs ...int) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldNotIn(FieldPerformerID, vs...))
+}
+
+// PerformerIDGT applies the GT predicate on the "performer_id" field.
+func PerformerIDGT(v int) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldGT(FieldPerformerID, v))
+}
+
+// PerformerIDGTE applies the GTE predicate on the "performer_id" field.
+func PerformerIDGTE(v int) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldGTE(FieldPerformerID, v))
+}
+
+// PerformerIDLT applies the LT predicate on the "performer_id" field.
+func PerformerIDLT(v int) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldLT(FieldPerformerID, v))
+}
+
+// PerformerIDLTE applies the LTE predicate on the "performer_id" field.
+func PerformerIDLTE(v int) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldLTE(FieldPerformerID, v))
+}
+
+// PerformerIDIsNil applies the IsNil predicate on the "performer_id" field.
+func PerformerIDIsNil() predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldIsNull(FieldPerformerID))
+}
+
+// PerformerIDNotNil applies the NotNil predicate on the "performer_id" field.
+func PerformerIDNotNil() predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldNotNull(FieldPerformerID))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldContainsFold(FieldReason, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.EmailHistory {
+	return predicate.EmailHistory(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.EmailHistory {
+	return predicate.EmailHistory(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EmailHistory) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EmailHistory) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EmailHistory) predicate.EmailHistory {
+	return predicate.EmailHistory(sql.NotPredicates(p))
+}
@@ -0,0 +1,339 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/emailhistory"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
+)
+
+// EmailHistoryCreate is the builder for creating a EmailHistory entity.
+type EmailHistoryCreate struct {
+	config
+	mutation *EmailHistoryMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (ehc *EmailHistoryCreate) SetUserID(i int) *EmailHistoryCreate {
+	ehc.mutation.SetUserID(i)
+	return ehc
+}
+
+// SetAction sets the "action" field.
+func (ehc *EmailHistoryCreate) SetAction(e emailhistory.Action) *EmailHistoryCreate {
+	ehc.mutation.SetAction(e)
+	return ehc
+}
+
+// SetOldEmail sets the "old_email" field.
+func (ehc *EmailHistoryCreate) SetOldEmail(s string) *EmailHistoryCreate {
+	ehc.mutation.SetOldEmail(s)
+	return ehc
+}
+
+// SetNillableOldEmail sets the "old_email" field if the given value is not nil.
+func (ehc *EmailHistoryCreate) SetNillableOldEmail(s *string) *EmailHistoryCreate {
+	if s != nil {
+		ehc.SetOldEmail(*s)
+	}
+	return ehc
+}
+
+// SetNewEmail sets the "new_email" field.
+func (ehc *EmailHistoryCreate) SetNewEmail(s string) *EmailHistoryCreate {
+	ehc.mutation.SetNewEmail(s)
+	return ehc
+}
+
+// SetNillableNewEmail sets the "new_email" field if the given value is not nil.
+func (ehc *EmailHistoryCreate) SetNillableNewEmail(s *string) *EmailHistoryCreate {
+	if s != nil {
+		ehc.SetNewEmail(*s)
+	}
+	return ehc
+}
+
+// SetPerformerID sets the "performer_id" field.
+func (ehc *EmailHistoryCreate) SetPerformerID(i int) *EmailHistoryCreate {
+	ehc.mutation.SetPerformerID(i)
+	return ehc
+}
+
+// SetNillablePerformerID sets the "performer_id" field if the given value is not nil.
+func (ehc *EmailHistoryCreate) SetNillablePerformerID(i *int) *EmailHistoryCreate {
+	if i != nil {
+		ehc.SetPerformerID(*i)
+	}
+	return ehc
+}
+
+// SetReason sets the "reason" field.
+func (ehc *EmailHistoryCreate) SetReason(s string) *EmailHistoryCreate {
+	ehc.mutation.SetReason(s)
+	return ehc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (ehc *EmailHistoryCreate) SetNillableReason(s *string) *EmailHistoryCreate {
+	if s != nil {
+		ehc.SetReason(*s)
+	}
+	return ehc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ehc *EmailHistoryCreate) SetCreatedAt(t time.Time) *EmailHistoryCreate {
+	ehc.mutation.SetCreatedAt(t)
+	return ehc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ehc *EmailHistoryCreate) SetNillableCreatedAt(t *time.Time) *EmailHistoryCreate {
+	if t != nil {
+		ehc.SetCreatedAt(*t)
+	}
+	return ehc
+}
+
+// SetID sets the "id" field.
+func (ehc *EmailHistoryCreate) SetID(i int) *EmailHistoryCreate {
+	ehc.mutation.SetID(i)
+	return ehc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ehc *EmailHistoryCreate) SetUser(u *User) *EmailHistoryCreate {
+	return ehc.SetUserID(u.ID)
+}
+
+// Mutation returns the EmailHistoryMutation object of the builder.
+func (ehc *EmailHistoryCreate) Mutation() *EmailHistoryMutation {
+	return ehc.mutation
+}
+
+// Save creates the EmailHistory in the database.
+func (ehc *EmailHistoryCreate) Save(ctx context.Context) (*EmailHistory, error) {
+	ehc.defaults()
+	return withHooks(ctx, ehc.sqlSave, ehc.mutation, ehc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ehc *EmailHistoryCreate) SaveX(ctx context.Context) *EmailHistory {
+	v, err := ehc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ehc *EmailHistoryCreate) Exec(ctx context.Context) error {
+	_, err := ehc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ehc *EmailHistoryCreate) ExecX(ctx context.Context) {
+	if err := ehc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ehc *EmailHistoryCreate) defaults() {
+	if _, ok := ehc.mutation.CreatedAt(); !ok {
+		v := emailhistory.DefaultCreatedAt()
+		ehc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ehc *EmailHistoryCreate) check() error {
+	if _, ok := ehc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "EmailHistory.user_id"`)}
+	}
+	if _, ok := ehc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "EmailHistory.action"`)}
+	}
+	if v, ok := ehc.mutation.Action(); ok {
+		if err := emailhistory.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "EmailHistory.action": %w`, err)}
+		}
+	}
+	if _, ok := ehc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EmailHistory.created_at"`)}
+	}
+	if len(ehc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "EmailHistory.user"`)}
+	}
+	return nil
+}
+
+func (ehc *EmailHistoryCreate) sqlSave(ctx context.Context) (*EmailHistory, error) {
+	if err := ehc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ehc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ehc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	ehc.mutation.id = &_node.ID
+	ehc.mutation.done = true
+	return _node, nil
+}
+
+func (ehc *EmailHistoryCreate) createSpec() (*EmailHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EmailHistory{config: ehc.config}
+		_spec = sqlgraph.NewCreateSpec(emailhistory.Table, sqlgraph.NewFieldSpec(emailhistory.FieldID, field.TypeInt))
+	)
+	if id, ok := ehc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ehc.mutation.Action(); ok {
+		_spec.SetField(emailhistory.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := ehc.mutation.OldEmail(); ok {
+		_spec.SetField(emailhistory.FieldOldEmail, field.TypeString, value)
+		_node.OldEmail = &value
+	}
+	if value, ok := ehc.mutation.NewEmail(); ok {
+		_spec.SetField(emailhistory.FieldNewEmail, field.TypeString, value)
+		_node.NewEmail = &value
+	}
+	if value, ok := ehc.mutation.PerformerID(); ok {
+		_spec.SetField(emailhistory.FieldPerformerID, field.TypeInt, value)
+		_node.PerformerID = &value
+	}
+	if value, ok := ehc.mutation.Reason(); ok {
+		_spec.SetField(emailhistory.FieldReason, field.TypeString, value)
+		_node.Reason = &value
+	}
+	if value, ok := ehc.mutation.CreatedAt(); ok {
+		_spec.SetField(emailhistory.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := ehc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailhistory.UserTable,
+			Columns: []string{emailhistory.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EmailHistoryCreateBulk is the builder for creating many EmailHistory entities in bulk.
+type EmailHistoryCreateBulk struct {
+	config
+	err      error
+	builders []*EmailHistoryCreate
+}
+
+// Save creates the EmailHistory entities in the database.
+func (ehcb *EmailHistoryCreateBulk) Save(ctx context.Context) ([]*EmailHistory, error) {
+	if ehcb.err != nil {
+		return nil, ehcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ehcb.builders))
+	nodes := make([]*EmailHistory, len(ehcb.builders))
+	mutators := make([]Mutator, len(ehcb.builders))
+	for i := range ehcb.builders {
+		func(i int, root context.Context) {
+			builder := ehcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EmailHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ehcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ehcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ehcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ehcb *EmailHistoryCreateBulk) SaveX(ctx context.Context) []*EmailHistory {
+	v, err := ehcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ehcb *EmailHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := ehcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ehcb *EmailHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := ehcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
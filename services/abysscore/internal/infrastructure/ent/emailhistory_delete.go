@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/emailhistory"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+)
+
+// EmailHistoryDelete is the builder for deleting a EmailHistory entity.
+type EmailHistoryDelete struct {
+	config
+	hooks    []Hook
+	mutation *EmailHistoryMutation
+}
+
+// Where appends a list predicates to the EmailHistoryDelete builder.
+func (ehd *EmailHistoryDelete) Where(ps ...predicate.EmailHistory) *EmailHistoryDelete {
+	ehd.mutation.Where(ps...)
+	return ehd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ehd *EmailHistoryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ehd.sqlExec, ehd.mutation, ehd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ehd *EmailHistoryDelete) ExecX(ctx context.Context) int {
+	n, err := ehd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ehd *EmailHistoryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(emailhistory.Table, sqlgraph.NewFieldSpec(emailhistory.FieldID, field.TypeInt))
+	if ps := ehd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ehd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ehd.mutation.done = true
+	return affected, err
+}
+
+// EmailHistoryDeleteOne is the builder for deleting a single EmailHistory entity.
+type EmailHistoryDeleteOne struct {
+	ehd *EmailHistoryDelete
+}
+
+// Where appends a list predicates to the EmailHistoryDelete builder.
+func (ehdo *EmailHistoryDeleteOne) Where(ps ...predicate.EmailHistory) *EmailHistoryDeleteOne {
+	ehdo.ehd.mutation.Where(ps...)
+	return ehdo
+}
+
+// Exec executes the deletion query.
+func (ehdo *EmailHistoryDeleteOne) Exec(ctx context.Context) error {
+	n, err := ehdo.ehd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{emailhistory.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ehdo *EmailHistoryDeleteOne) ExecX(ctx context.Context) {
+	if err := ehdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/emailhistory"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
+)
+
+// EmailHistoryQuery is the builder for querying EmailHistory entities.
+type EmailHistoryQuery struct {
+	config
+	ctx        *QueryContext
+	order      []emailhistory.OrderOption
+	inters     []Interceptor
+	predicates []predicate.EmailHistory
+	withUser   *UserQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the EmailHistoryQuery builder.
+func (ehq *EmailHistoryQuery) Where(ps ...predicate.EmailHistory) *EmailHistoryQuery {
+	ehq.predicates = append(ehq.predicates, ps...)
+	return ehq
+}
+
+// Limit the number of records to be returned by this query.
+func (ehq *EmailHistoryQuery) Limit(limit int) *EmailHistoryQuery {
+	ehq.ctx.Limit = &limit
+	return ehq
+}
+
+// Offset to start from.
+func (ehq *EmailHistoryQuery) Offset(offset int) *EmailHistoryQuery {
+	ehq.ctx.Offset = &offset
+	return ehq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ehq *EmailHistoryQuery) Unique(unique bool) *EmailHistoryQuery {
+	ehq.ctx.Unique = &unique
+	return ehq
+}
+
+// Order specifies how the records should be ordered.
+func (ehq *EmailHistoryQuery) Order(o ...emailhistory.OrderOption) *EmailHistoryQuery {
+	ehq.order = append(ehq.order, o...)
+	return ehq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (ehq *EmailHistoryQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: ehq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ehq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ehq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(emailhistory.Table, emailhistory.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, emailhistory.UserTable, emailhistory.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ehq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first EmailHistory entity from the query.
+// Returns a *NotFoundError when no EmailHistory was found.
+func (ehq *EmailHistoryQuery) First(ctx context.Context) (*EmailHistory, error) {
+	nodes, err := ehq.Limit(1).All(setContextOp(ctx, ehq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{emailhistory.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ehq *EmailHistoryQuery) FirstX(ctx context.Context) *EmailHistory {
+	node, err := ehq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first EmailHistory ID from the query.
+// Returns a *NotFoundError when no EmailHistory ID was found.
+func (ehq *EmailHistoryQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ehq.Limit(1).IDs(setContextOp(ctx, ehq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{emailhistory.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ehq *EmailHistoryQuery) FirstIDX(ctx context.Context) int {
+	id, err := ehq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single EmailHistory entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one EmailHistory entity is found.
+// Returns a *NotFoundError when no EmailHistory entities are found.
+func (ehq *EmailHistoryQuery) Only(ctx context.Context) (*EmailHistory, error) {
+	nodes, err := ehq.Limit(2).All(setContextOp(ctx, ehq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{emailhistory.Label}
+	default:
+		return nil, &NotSingularError{emailhistory.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ehq *EmailHistoryQuery) OnlyX(ctx context.Context) *EmailHistory {
+	node, err := ehq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only EmailHistory ID in the query.
+// Returns a *NotSingularError when more than one EmailHistory ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ehq *EmailHistoryQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ehq.Limit(2).IDs(setContextOp(ctx, ehq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{emailhistory.Label}
+	default:
+		err = &NotSingularError{emailhistory.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ehq *EmailHistoryQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ehq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of EmailHistories.
+func (ehq *EmailHistoryQuery) All(ctx context.Context) ([]*EmailHistory, error) {
+	ctx = setContextOp(ctx, ehq.ctx, ent.OpQueryAll)
+	if err := ehq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*EmailHistory, *EmailHistoryQuery]()
+	return withInterceptors[[]*EmailHistory](ctx, ehq, qr, ehq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ehq *EmailHistoryQuery) AllX(ctx context.Context) []*EmailHistory {
+	nodes, err := ehq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of EmailHistory IDs.
+func (ehq *EmailHistoryQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ehq.ctx.Unique == nil && ehq.path != nil {
+		ehq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ehq.ctx, ent.OpQueryIDs)
+	if err = ehq.Select(emailhistory.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ehq *EmailHistoryQuery) IDsX(ctx context.Context) []int {
+	ids, err := ehq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ehq *EmailHistoryQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ehq.ctx, ent.OpQueryCount)
+	if err := ehq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ehq, querierCount[*EmailHistoryQuery](), ehq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ehq *EmailHistoryQuery) CountX(ctx context.Context) int {
+	count, err := ehq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ehq *EmailHistoryQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ehq.ctx, ent.OpQueryExist)
+	switch _, err := ehq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ehq *EmailHistoryQuery) ExistX(ctx context.Context) bool {
+	exist, err := ehq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the EmailHistoryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ehq *EmailHistoryQuery) Clone() *EmailHistoryQuery {
+	if ehq == nil {
+		return nil
+	}
+	return &EmailHistoryQuery{
+		config:     ehq.config,
+		ctx:        ehq.ctx.Clone(),
+		order:      append([]emailhistory.OrderOption{}, ehq.order...),
+		inters:     append([]Interceptor{}, ehq.inters...),
+		predicates: append([]predicate.EmailHistory{}, ehq.predicates...),
+		withUser:   ehq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  ehq.sql.Clone(),
+		path: ehq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (ehq *EmailHistoryQuery) WithUser(opts ...func(*UserQuery)) *EmailHistoryQuery {
+	query := (&UserClient{config: ehq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ehq.withUser = query
+	return ehq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID int `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.EmailHistory.Query().
+//		GroupBy(emailhistory.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ehq *EmailHistoryQuery) GroupBy(field string, fields ...string) *EmailHistoryGroupBy {
+	ehq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &EmailHistoryGroupBy{build: ehq}
+	grbuild.flds = &ehq.ctx.Fields
+	grbuild.label = emailhistory.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID int `json:"user_id,omitempty"`
+//	}
+//
+//	client.EmailHistory.Query().
+//		Select(emailhistory.FieldUserID).
+//		Scan(ctx, &v)
+func (ehq *EmailHistoryQuery) Select(fields ...string) *EmailHistorySelect {
+	ehq.ctx.Fields = append(ehq.ctx.Fields, fields...)
+	sbuild := &EmailHistorySelect{EmailHistoryQuery: ehq}
+	sbuild.label = emailhistory.Label
+	sbuild.flds, sbuild.scan = &ehq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a EmailHistorySelect configured with the given aggregations.
+func (ehq *EmailHistoryQuery) Aggregate(fns ...AggregateFunc) *EmailHistorySelect {
+	return ehq.Select().Aggregate(fns...)
+}
+
+func (ehq *EmailHistoryQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ehq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ehq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ehq.ctx.Fields {
+		if !emailhistory.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ehq.path != nil {
+		prev, err := ehq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ehq.sql = prev
+	}
+	return nil
+}
+
+func (ehq *EmailHistoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*EmailHistory, error) {
+	var (
+		nodes       = []*EmailHistory{}
+		_spec       = ehq.querySpec()
+		loadedTypes = [1]bool{
+			ehq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*EmailHistory).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &EmailHistory{config: ehq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ehq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ehq.withUser; query != nil {
+		if err := ehq.loadUser(ctx, query, nodes, nil,
+			func(n *EmailHistory, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ehq *EmailHistoryQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*EmailHistory, init func(*EmailHistory), assign func(*EmailHistory, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*EmailHistory)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (ehq *EmailHistoryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ehq.querySpec()
+	_spec.Node.Columns = ehq.ctx.Fields
+	if len(ehq.ctx.Fields) > 0 {
+		_spec.Unique = ehq.ctx.Unique != nil && *ehq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ehq.driver, _spec)
+}
+
+func (ehq *EmailHistoryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(emailhistory.Table, emailhistory.Columns, sqlgraph.NewFieldSpec(emailhistory.FieldID, field.TypeInt))
+	_spec.From = ehq.sql
+	if unique := ehq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ehq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ehq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, emailhistory.FieldID)
+		for i := range fields {
+			if fields[i] != emailhistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if ehq.withUser != nil {
+			_spec.Node.AddColumnOnce(emailhistory.FieldUserID)
+		}
+	}
+	if ps := ehq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ehq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ehq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ehq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ehq *EmailHistoryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ehq.driver.Dialect())
+	t1 := builder.Table(emailhistory.Table)
+	columns := ehq.ctx.Fields
+	if len(columns) == 0 {
+		columns = emailhistory.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ehq.sql != nil {
+		selector = ehq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ehq.ctx.Unique != nil && *ehq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ehq.predicates {
+		p(selector)
+	}
+	for _, p := range ehq.order {
+		p(selector)
+	}
+	if offset := ehq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ehq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// EmailHistoryGroupBy is the group-by builder for EmailHistory entities.
+type EmailHistoryGroupBy struct {
+	selector
+	build *EmailHistoryQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ehgb *EmailHistoryGroupBy) Aggregate(fns ...AggregateFunc) *EmailHistoryGroupBy {
+	ehgb.fns = append(ehgb.fns, fns...)
+	return ehgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ehgb *EmailHistoryGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ehgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ehgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EmailHistoryQuery, *EmailHistoryGroupBy](ctx, ehgb.build, ehgb, ehgb.build.inters, v)
+}
+
+func (ehgb *EmailHistoryGroupBy) sqlScan(ctx context.Context, root *EmailHistoryQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ehgb.fns))
+	for _, fn := range ehgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ehgb.flds)+len(ehgb.fns))
+		for _, f := range *ehgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ehgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ehgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// EmailHistorySelect is the builder for selecting fields of EmailHistory entities.
+type EmailHistorySelect struct {
+	*EmailHistoryQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ehs *EmailHistorySelect) Aggregate(fns ...AggregateFunc) *EmailHistorySelect {
+	ehs.fns = append(ehs.fns, fns...)
+	return ehs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ehs *EmailHistorySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ehs.ctx, ent.OpQuerySelect)
+	if err := ehs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EmailHistoryQuery, *EmailHistorySelect](ctx, ehs.EmailHistoryQuery, ehs, ehs.inters, v)
+}
+
+func (ehs *EmailHistorySelect) sqlScan(ctx context.Context, root *EmailHistoryQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ehs.fns))
+	for _, fn := range ehs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ehs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ehs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,221 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/emailhistory"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+)
+
+// EmailHistoryUpdate is the builder for updating EmailHistory entities.
+type EmailHistoryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EmailHistoryMutation
+}
+
+// Where appends a list predicates to the EmailHistoryUpdate builder.
+func (ehu *EmailHistoryUpdate) Where(ps ...predicate.EmailHistory) *EmailHistoryUpdate {
+	ehu.mutation.Where(ps...)
+	return ehu
+}
+
+// Mutation returns the EmailHistoryMutation object of the builder.
+func (ehu *EmailHistoryUpdate) Mutation() *EmailHistoryMutation {
+	return ehu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ehu *EmailHistoryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ehu.sqlSave, ehu.mutation, ehu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ehu *EmailHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := ehu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ehu *EmailHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := ehu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ehu *EmailHistoryUpdate) ExecX(ctx context.Context) {
+	if err := ehu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ehu *EmailHistoryUpdate) check() error {
+	if ehu.mutation.UserCleared() && len(ehu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmailHistory.user"`)
+	}
+	return nil
+}
+
+func (ehu *EmailHistoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ehu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailhistory.Table, emailhistory.Columns, sqlgraph.NewFieldSpec(emailhistory.FieldID, field.TypeInt))
+	if ps := ehu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if ehu.mutation.OldEmailCleared() {
+		_spec.ClearField(emailhistory.FieldOldEmail, field.TypeString)
+	}
+	if ehu.mutation.NewEmailCleared() {
+		_spec.ClearField(emailhistory.FieldNewEmail, field.TypeString)
+	}
+	if ehu.mutation.PerformerIDCleared() {
+		_spec.ClearField(emailhistory.FieldPerformerID, field.TypeInt)
+	}
+	if ehu.mutation.ReasonCleared() {
+		_spec.ClearField(emailhistory.FieldReason, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ehu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailhistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ehu.mutation.done = true
+	return n, nil
+}
+
+// EmailHistoryUpdateOne is the builder for updating a single EmailHistory entity.
+type EmailHistoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EmailHistoryMutation
+}
+
+// Mutation returns the EmailHistoryMutation object of the builder.
+func (ehuo *EmailHistoryUpdateOne) Mutation() *EmailHistoryMutation {
+	return ehuo.mutation
+}
+
+// Where appends a list predicates to the EmailHistoryUpdate builder.
+func (ehuo *EmailHistoryUpdateOne) Where(ps ...predicate.EmailHistory) *EmailHistoryUpdateOne {
+	ehuo.mutation.Where(ps...)
+	return ehuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ehuo *EmailHistoryUpdateOne) Select(field string, fields ...string) *EmailHistoryUpdateOne {
+	ehuo.fields = append([]string{field}, fields...)
+	return ehuo
+}
+
+// Save executes the query and returns the updated EmailHistory entity.
+func (ehuo *EmailHistoryUpdateOne) Save(ctx context.Context) (*EmailHistory, error) {
+	return withHooks(ctx, ehuo.sqlSave, ehuo.mutation, ehuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ehuo *EmailHistoryUpdateOne) SaveX(ctx context.Context) *EmailHistory {
+	node, err := ehuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ehuo *EmailHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := ehuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ehuo *EmailHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := ehuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ehuo *EmailHistoryUpdateOne) check() error {
+	if ehuo.mutation.UserCleared() && len(ehuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmailHistory.user"`)
+	}
+	return nil
+}
+
+func (ehuo *EmailHistoryUpdateOne) sqlSave(ctx context.Context) (_node *EmailHistory, err error) {
+	if err := ehuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailhistory.Table, emailhistory.Columns, sqlgraph.NewFieldSpec(emailhistory.FieldID, field.TypeInt))
+	id, ok := ehuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EmailHistory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ehuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, emailhistory.FieldID)
+		for _, f := range fields {
+			if !emailhistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != emailhistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ehuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if ehuo.mutation.OldEmailCleared() {
+		_spec.ClearField(emailhistory.FieldOldEmail, field.TypeString)
+	}
+	if ehuo.mutation.NewEmailCleared() {
+		_spec.ClearField(emailhistory.FieldNewEmail, field.TypeString)
+	}
+	if ehuo.mutation.PerformerIDCleared() {
+		_spec.ClearField(emailhistory.FieldPerformerID, field.TypeInt)
+	}
+	if ehuo.mutation.ReasonCleared() {
+		_spec.ClearField(emailhistory.FieldReason, field.TypeString)
+	}
+	_node = &EmailHistory{config: ehuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ehuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailhistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ehuo.mutation.done = true
+	return _node, nil
+}
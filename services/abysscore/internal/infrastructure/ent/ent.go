@@ -12,15 +12,33 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/apitoken"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/auditlog"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/bannedhardwareid"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/collectioncompletion"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/collectionreward"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/emailhistory"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/externalidentity"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/friendrequest"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/gameitem"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/grantjob"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/hardwareidreset"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/inventoryitem"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/loginhistory"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/match"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/passwordhistory"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/playermatchresult"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/recoverycode"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/role"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/sanction"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/season"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasonpass"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasonrewardclaim"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasontier"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/statistic"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/userbalance"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/usernamehistory"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -81,15 +99,33 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			bannedhardwareid.Table:  bannedhardwareid.ValidColumn,
-			friendrequest.Table:     friendrequest.ValidColumn,
-			gameitem.Table:          gameitem.ValidColumn,
-			inventoryitem.Table:     inventoryitem.ValidColumn,
-			match.Table:             match.ValidColumn,
-			playermatchresult.Table: playermatchresult.ValidColumn,
-			statistic.Table:         statistic.ValidColumn,
-			user.Table:              user.ValidColumn,
-			userbalance.Table:       userbalance.ValidColumn,
+			apitoken.Table:             apitoken.ValidColumn,
+			auditlog.Table:             auditlog.ValidColumn,
+			bannedhardwareid.Table:     bannedhardwareid.ValidColumn,
+			collectioncompletion.Table: collectioncompletion.ValidColumn,
+			collectionreward.Table:     collectionreward.ValidColumn,
+			emailhistory.Table:         emailhistory.ValidColumn,
+			externalidentity.Table:     externalidentity.ValidColumn,
+			friendrequest.Table:        friendrequest.ValidColumn,
+			gameitem.Table:             gameitem.ValidColumn,
+			grantjob.Table:             grantjob.ValidColumn,
+			hardwareidreset.Table:      hardwareidreset.ValidColumn,
+			inventoryitem.Table:        inventoryitem.ValidColumn,
+			loginhistory.Table:         loginhistory.ValidColumn,
+			match.Table:                match.ValidColumn,
+			passwordhistory.Table:      passwordhistory.ValidColumn,
+			playermatchresult.Table:    playermatchresult.ValidColumn,
+			recoverycode.Table:         recoverycode.ValidColumn,
+			role.Table:                 role.ValidColumn,
+			sanction.Table:             sanction.ValidColumn,
+			season.Table:               season.ValidColumn,
+			seasonpass.Table:           seasonpass.ValidColumn,
+			seasonrewardclaim.Table:    seasonrewardclaim.ValidColumn,
+			seasontier.Table:           seasontier.ValidColumn,
+			statistic.Table:            statistic.ValidColumn,
+			user.Table:                 user.ValidColumn,
+			userbalance.Table:          userbalance.ValidColumn,
+			usernamehistory.Table:      usernamehistory.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
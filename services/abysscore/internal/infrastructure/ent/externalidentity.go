@@ -0,0 +1,196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/externalidentity"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
+)
+
+// ExternalIdentity is the model entity for the ExternalIdentity schema.
+type ExternalIdentity struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Provider holds the value of the "provider" field.
+	Provider string `json:"provider,omitempty"`
+	// Subject holds the value of the "subject" field.
+	Subject string `json:"subject,omitempty"`
+	// Email holds the value of the "email" field.
+	Email *string `json:"email,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// LastLoginAt holds the value of the "last_login_at" field.
+	LastLoginAt *time.Time `json:"last_login_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ExternalIdentityQuery when eager-loading is set.
+	Edges        ExternalIdentityEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ExternalIdentityEdges holds the relations/edges for other nodes in the graph.
+type ExternalIdentityEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ExternalIdentityEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ExternalIdentity) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case externalidentity.FieldID, externalidentity.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case externalidentity.FieldProvider, externalidentity.FieldSubject, externalidentity.FieldEmail:
+			values[i] = new(sql.NullString)
+		case externalidentity.FieldCreatedAt, externalidentity.FieldLastLoginAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ExternalIdentity fields.
+func (ei *ExternalIdentity) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case externalidentity.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ei.ID = int(value.Int64)
+		case externalidentity.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ei.UserID = int(value.Int64)
+			}
+		case externalidentity.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				ei.Provider = value.String
+			}
+		case externalidentity.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				ei.Subject = value.String
+			}
+		case externalidentity.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				ei.Email = new(string)
+				*ei.Email = value.String
+			}
+		case externalidentity.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ei.CreatedAt = value.Time
+			}
+		case externalidentity.FieldLastLoginAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_login_at", values[i])
+			} else if value.Valid {
+				ei.LastLoginAt = new(time.Time)
+				*ei.LastLoginAt = value.Time
+			}
+		default:
+			ei.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ExternalIdentity.
+// This includes values selected through modifiers, order, etc.
+func (ei *ExternalIdentity) Value(name string) (ent.Value, error) {
+	return ei.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the ExternalIdentity entity.
+func (ei *ExternalIdentity) QueryUser() *UserQuery {
+	return NewExternalIdentityClient(ei.config).QueryUser(ei)
+}
+
+// Update returns a builder for updating this ExternalIdentity.
+// Note that you need to call ExternalIdentity.Unwrap() before calling this method if this ExternalIdentity
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ei *ExternalIdentity) Update() *ExternalIdentityUpdateOne {
+	return NewExternalIdentityClient(ei.config).UpdateOne(ei)
+}
+
+// Unwrap unwraps the ExternalIdentity entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ei *ExternalIdentity) Unwrap() *ExternalIdentity {
+	_tx, ok := ei.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ExternalIdentity is not a transactional entity")
+	}
+	ei.config.driver = _tx.drv
+	return ei
+}
+
+// String implements the fmt.Stringer.
+func (ei *ExternalIdentity) String() string {
+	var builder strings.Builder
+	builder.WriteString("ExternalIdentity(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ei.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ei.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(ei.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("subject=")
+	builder.WriteString(ei.Subject)
+	builder.WriteString(", ")
+	if v := ei.Email; v != nil {
+		builder.WriteString("email=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ei.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ei.LastLoginAt; v != nil {
+		builder.WriteString("last_login_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ExternalIdentities is a parsable slice of ExternalIdentity.
+type ExternalIdentities []*ExternalIdentity
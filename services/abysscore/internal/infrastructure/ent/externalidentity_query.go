@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/externalidentity"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
+)
+
+// ExternalIdentityQuery is the builder for querying ExternalIdentity entities.
+type ExternalIdentityQuery struct {
+	config
+	ctx        *QueryContext
+	order      []externalidentity.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ExternalIdentity
+	withUser   *UserQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ExternalIdentityQuery builder.
+func (eiq *ExternalIdentityQuery) Where(ps ...predicate.ExternalIdentity) *ExternalIdentityQuery {
+	eiq.predicates = append(eiq.predicates, ps...)
+	return eiq
+}
+
+// Limit the number of records to be returned by this query.
+func (eiq *ExternalIdentityQuery) Limit(limit int) *ExternalIdentityQuery {
+	eiq.ctx.Limit = &limit
+	return eiq
+}
+
+// Offset to start from.
+func (eiq *ExternalIdentityQuery) Offset(offset int) *ExternalIdentityQuery {
+	eiq.ctx.Offset = &offset
+	return eiq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (eiq *ExternalIdentityQuery) Unique(unique bool) *ExternalIdentityQuery {
+	eiq.ctx.Unique = &unique
+	return eiq
+}
+
+// Order specifies how the records should be ordered.
+func (eiq *ExternalIdentityQuery) Order(o ...externalidentity.OrderOption) *ExternalIdentityQuery {
+	eiq.order = append(eiq.order, o...)
+	return eiq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (eiq *ExternalIdentityQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: eiq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eiq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eiq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(externalidentity.Table, externalidentity.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, externalidentity.UserTable, externalidentity.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eiq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ExternalIdentity entity from the query.
+// Returns a *NotFoundError when no ExternalIdentity was found.
+func (eiq *ExternalIdentityQuery) First(ctx context.Context) (*ExternalIdentity, error) {
+	nodes, err := eiq.Limit(1).All(setContextOp(ctx, eiq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{externalidentity.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (eiq *ExternalIdentityQuery) FirstX(ctx context.Context) *ExternalIdentity {
+	node, err := eiq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ExternalIdentity ID from the query.
+// Returns a *NotFoundError when no ExternalIdentity ID was found.
+func (eiq *ExternalIdentityQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = eiq.Limit(1).IDs(setContextOp(ctx, eiq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{externalidentity.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (eiq *ExternalIdentityQuery) FirstIDX(ctx context.Context) int {
+	id, err := eiq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ExternalIdentity entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ExternalIdentity entity is found.
+// Returns a *NotFoundError when no ExternalIdentity entities are found.
+func (eiq *ExternalIdentityQuery) Only(ctx context.Context) (*ExternalIdentity, error) {
+	nodes, err := eiq.Limit(2).All(setContextOp(ctx, eiq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{externalidentity.Label}
+	default:
+		return nil, &NotSingularError{externalidentity.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (eiq *ExternalIdentityQuery) OnlyX(ctx context.Context) *ExternalIdentity {
+	node, err := eiq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ExternalIdentity ID in the query.
+// Returns a *NotSingularError when more than one ExternalIdentity ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (eiq *ExternalIdentityQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = eiq.Limit(2).IDs(setContextOp(ctx, eiq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{externalidentity.Label}
+	default:
+		err = &NotSingularError{externalidentity.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (eiq *ExternalIdentityQuery) OnlyIDX(ctx context.Context) int {
+	id, err := eiq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ExternalIdentities.
+func (eiq *ExternalIdentityQuery) All(ctx context.Context) ([]*ExternalIdentity, error) {
+	ctx = setContextOp(ctx, eiq.ctx, ent.OpQueryAll)
+	if err := eiq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ExternalIdentity, *ExternalIdentityQuery]()
+	return withInterceptors[[]*ExternalIdentity](ctx, eiq, qr, eiq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (eiq *ExternalIdentityQuery) AllX(ctx context.Context) []*ExternalIdentity {
+	nodes, err := eiq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ExternalIdentity IDs.
+func (eiq *ExternalIdentityQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if eiq.ctx.Unique == nil && eiq.path != nil {
+		eiq.Unique(true)
+	}
+	ctx = setContextOp(ctx, eiq.ctx, ent.OpQueryIDs)
+	if err = eiq.Select(externalidentity.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (eiq *ExternalIdentityQuery) IDsX(ctx context.Context) []int {
+	ids, err := eiq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (eiq *ExternalIdentityQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, eiq.ctx, ent.OpQueryCount)
+	if err := eiq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, eiq, querierCount[*ExternalIdentityQuery](), eiq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (eiq *ExternalIdentityQuery) CountX(ctx context.Context) int {
+	count, err := eiq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (eiq *ExternalIdentityQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, eiq.ctx, ent.OpQueryExist)
+	switch _, err := eiq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (eiq *ExternalIdentityQuery) ExistX(ctx context.Context) bool {
+	exist, err := eiq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ExternalIdentityQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (eiq *ExternalIdentityQuery) Clone() *ExternalIdentityQuery {
+	if eiq == nil {
+		return nil
+	}
+	return &ExternalIdentityQuery{
+		config:     eiq.config,
+		ctx:        eiq.ctx.Clone(),
+		order:      append([]externalidentity.OrderOption{}, eiq.order...),
+		inters:     append([]Interceptor{}, eiq.inters...),
+		predicates: append([]predicate.ExternalIdentity{}, eiq.predicates...),
+		withUser:   eiq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  eiq.sql.Clone(),
+		path: eiq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (eiq *ExternalIdentityQuery) WithUser(opts ...func(*UserQuery)) *ExternalIdentityQuery {
+	query := (&UserClient{config: eiq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eiq.withUser = query
+	return eiq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID int `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ExternalIdentity.Query().
+//		GroupBy(externalidentity.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (eiq *ExternalIdentityQuery) GroupBy(field string, fields ...string) *ExternalIdentityGroupBy {
+	eiq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ExternalIdentityGroupBy{build: eiq}
+	grbuild.flds = &eiq.ctx.Fields
+	grbuild.label = externalidentity.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID int `json:"user_id,omitempty"`
+//	}
+//
+//	client.ExternalIdentity.Query().
+//		Select(externalidentity.FieldUserID).
+//		Scan(ctx, &v)
+func (eiq *ExternalIdentityQuery) Select(fields ...string) *ExternalIdentitySelect {
+	eiq.ctx.Fields = append(eiq.ctx.Fields, fields...)
+	sbuild := &ExternalIdentitySelect{ExternalIdentityQuery: eiq}
+	sbuild.label = externalidentity.Label
+	sbuild.flds, sbuild.scan = &eiq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ExternalIdentitySelect configured with the given aggregations.
+func (eiq *ExternalIdentityQuery) Aggregate(fns ...AggregateFunc) *ExternalIdentitySelect {
+	return eiq.Select().Aggregate(fns...)
+}
+
+func (eiq *ExternalIdentityQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range eiq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, eiq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range eiq.ctx.Fields {
+		if !externalidentity.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if eiq.path != nil {
+		prev, err := eiq.path(ctx)
+		if err != nil {
+			return err
+		}
+		eiq.sql = prev
+	}
+	return nil
+}
+
+func (eiq *ExternalIdentityQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ExternalIdentity, error) {
+	var (
+		nodes       = []*ExternalIdentity{}
+		_spec       = eiq.querySpec()
+		loadedTypes = [1]bool{
+			eiq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ExternalIdentity).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ExternalIdentity{config: eiq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, eiq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := eiq.withUser; query != nil {
+		if err := eiq.loadUser(ctx, query, nodes, nil,
+			func(n *ExternalIdentity, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (eiq *ExternalIdentityQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*ExternalIdentity, init func(*ExternalIdentity), assign func(*ExternalIdentity, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ExternalIdentity)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (eiq *ExternalIdentityQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := eiq.querySpec()
+	_spec.Node.Columns = eiq.ctx.Fields
+	if len(eiq.ctx.Fields) > 0 {
+		_spec.Unique = eiq.ctx.Unique != nil && *eiq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, eiq.driver, _spec)
+}
+
+func (eiq *ExternalIdentityQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(externalidentity.Table, externalidentity.Columns, sqlgraph.NewFieldSpec(externalidentity.FieldID, field.TypeInt))
+	_spec.From = eiq.sql
+	if unique := eiq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if eiq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := eiq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, externalidentity.FieldID)
+		for i := range fields {
+			if fields[i] != externalidentity.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if eiq.withUser != nil {
+			_spec.Node.AddColumnOnce(externalidentity.FieldUserID)
+		}
+	}
+	if ps := eiq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := eiq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := eiq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := eiq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (eiq *ExternalIdentityQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(eiq.driver.Dialect())
+	t1 := builder.Table(externalidentity.Table)
+	columns := eiq.ctx.Fields
+	if len(columns) == 0 {
+		columns = externalidentity.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if eiq.sql != nil {
+		selector = eiq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if eiq.ctx.Unique != nil && *eiq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range eiq.predicates {
+		p(selector)
+	}
+	for _, p := range eiq.order {
+		p(selector)
+	}
+	if offset := eiq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := eiq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ExternalIdentityGroupBy is the group-by builder for ExternalIdentity entities.
+type ExternalIdentityGroupBy struct {
+	selector
+	build *ExternalIdentityQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (eigb *ExternalIdentityGroupBy) Aggregate(fns ...AggregateFunc) *ExternalIdentityGroupBy {
+	eigb.fns = append(eigb.fns, fns...)
+	return eigb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (eigb *ExternalIdentityGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, eigb.build.ctx, ent.OpQueryGroupBy)
+	if err := eigb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ExternalIdentityQuery, *ExternalIdentityGroupBy](ctx, eigb.build, eigb, eigb.build.inters, v)
+}
+
+func (eigb *ExternalIdentityGroupBy) sqlScan(ctx context.Context, root *ExternalIdentityQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(eigb.fns))
+	for _, fn := range eigb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*eigb.flds)+len(eigb.fns))
+		for _, f := range *eigb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*eigb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := eigb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ExternalIdentitySelect is the builder for selecting fields of ExternalIdentity entities.
+type ExternalIdentitySelect struct {
+	*ExternalIdentityQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (eis *ExternalIdentitySelect) Aggregate(fns ...AggregateFunc) *ExternalIdentitySelect {
+	eis.fns = append(eis.fns, fns...)
+	return eis
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (eis *ExternalIdentitySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, eis.ctx, ent.OpQuerySelect)
+	if err := eis.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ExternalIdentityQuery, *ExternalIdentitySelect](ctx, eis.ExternalIdentityQuery, eis, eis.inters, v)
+}
+
+func (eis *ExternalIdentitySelect) sqlScan(ctx context.Context, root *ExternalIdentityQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(eis.fns))
+	for _, fn := range eis.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*eis.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := eis.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,302 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/externalidentity"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+)
+
+// ExternalIdentityUpdate is the builder for updating ExternalIdentity entities.
+type ExternalIdentityUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ExternalIdentityMutation
+}
+
+// Where appends a list predicates to the ExternalIdentityUpdate builder.
+func (eiu *ExternalIdentityUpdate) Where(ps ...predicate.ExternalIdentity) *ExternalIdentityUpdate {
+	eiu.mutation.Where(ps...)
+	return eiu
+}
+
+// SetEmail sets the "email" field.
+func (eiu *ExternalIdentityUpdate) SetEmail(s string) *ExternalIdentityUpdate {
+	eiu.mutation.SetEmail(s)
+	return eiu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (eiu *ExternalIdentityUpdate) SetNillableEmail(s *string) *ExternalIdentityUpdate {
+	if s != nil {
+		eiu.SetEmail(*s)
+	}
+	return eiu
+}
+
+// ClearEmail clears the value of the "email" field.
+func (eiu *ExternalIdentityUpdate) ClearEmail() *ExternalIdentityUpdate {
+	eiu.mutation.ClearEmail()
+	return eiu
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (eiu *ExternalIdentityUpdate) SetLastLoginAt(t time.Time) *ExternalIdentityUpdate {
+	eiu.mutation.SetLastLoginAt(t)
+	return eiu
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (eiu *ExternalIdentityUpdate) SetNillableLastLoginAt(t *time.Time) *ExternalIdentityUpdate {
+	if t != nil {
+		eiu.SetLastLoginAt(*t)
+	}
+	return eiu
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (eiu *ExternalIdentityUpdate) ClearLastLoginAt() *ExternalIdentityUpdate {
+	eiu.mutation.ClearLastLoginAt()
+	return eiu
+}
+
+// Mutation returns the ExternalIdentityMutation object of the builder.
+func (eiu *ExternalIdentityUpdate) Mutation() *ExternalIdentityMutation {
+	return eiu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (eiu *ExternalIdentityUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, eiu.sqlSave, eiu.mutation, eiu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eiu *ExternalIdentityUpdate) SaveX(ctx context.Context) int {
+	affected, err := eiu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eiu *ExternalIdentityUpdate) Exec(ctx context.Context) error {
+	_, err := eiu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eiu *ExternalIdentityUpdate) ExecX(ctx context.Context) {
+	if err := eiu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eiu *ExternalIdentityUpdate) check() error {
+	if eiu.mutation.UserCleared() && len(eiu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ExternalIdentity.user"`)
+	}
+	return nil
+}
+
+func (eiu *ExternalIdentityUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := eiu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(externalidentity.Table, externalidentity.Columns, sqlgraph.NewFieldSpec(externalidentity.FieldID, field.TypeInt))
+	if ps := eiu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eiu.mutation.Email(); ok {
+		_spec.SetField(externalidentity.FieldEmail, field.TypeString, value)
+	}
+	if eiu.mutation.EmailCleared() {
+		_spec.ClearField(externalidentity.FieldEmail, field.TypeString)
+	}
+	if value, ok := eiu.mutation.LastLoginAt(); ok {
+		_spec.SetField(externalidentity.FieldLastLoginAt, field.TypeTime, value)
+	}
+	if eiu.mutation.LastLoginAtCleared() {
+		_spec.ClearField(externalidentity.FieldLastLoginAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, eiu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{externalidentity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	eiu.mutation.done = true
+	return n, nil
+}
+
+// ExternalIdentityUpdateOne is the builder for updating a single ExternalIdentity entity.
+type ExternalIdentityUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ExternalIdentityMutation
+}
+
+// SetEmail sets the "email" field.
+func (eiuo *ExternalIdentityUpdateOne) SetEmail(s string) *ExternalIdentityUpdateOne {
+	eiuo.mutation.SetEmail(s)
+	return eiuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (eiuo *ExternalIdentityUpdateOne) SetNillableEmail(s *string) *ExternalIdentityUpdateOne {
+	if s != nil {
+		eiuo.SetEmail(*s)
+	}
+	return eiuo
+}
+
+// ClearEmail clears the value of the "email" field.
+func (eiuo *ExternalIdentityUpdateOne) ClearEmail() *ExternalIdentityUpdateOne {
+	eiuo.mutation.ClearEmail()
+	return eiuo
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (eiuo *ExternalIdentityUpdateOne) SetLastLoginAt(t time.Time) *ExternalIdentityUpdateOne {
+	eiuo.mutation.SetLastLoginAt(t)
+	return eiuo
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (eiuo *ExternalIdentityUpdateOne) SetNillableLastLoginAt(t *time.Time) *ExternalIdentityUpdateOne {
+	if t != nil {
+		eiuo.SetLastLoginAt(*t)
+	}
+	return eiuo
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (eiuo *ExternalIdentityUpdateOne) ClearLastLoginAt() *ExternalIdentityUpdateOne {
+	eiuo.mutation.ClearLastLoginAt()
+	return eiuo
+}
+
+// Mutation returns the ExternalIdentityMutation object of the builder.
+func (eiuo *ExternalIdentityUpdateOne) Mutation() *ExternalIdentityMutation {
+	return eiuo.mutation
+}
+
+// Where appends a list predicates to the ExternalIdentityUpdate builder.
+func (eiuo *ExternalIdentityUpdateOne) Where(ps ...predicate.ExternalIdentity) *ExternalIdentityUpdateOne {
+	eiuo.mutation.Where(ps...)
+	return eiuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (eiuo *ExternalIdentityUpdateOne) Select(field string, fields ...string) *ExternalIdentityUpdateOne {
+	eiuo.fields = append([]string{field}, fields...)
+	return eiuo
+}
+
+// Save executes the query and returns the updated ExternalIdentity entity.
+func (eiuo *ExternalIdentityUpdateOne) Save(ctx context.Context) (*ExternalIdentity, error) {
+	return withHooks(ctx, eiuo.sqlSave, eiuo.mutation, eiuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eiuo *ExternalIdentityUpdateOne) SaveX(ctx context.Context) *ExternalIdentity {
+	node, err := eiuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (eiuo *ExternalIdentityUpdateOne) Exec(ctx context.Context) error {
+	_, err := eiuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eiuo *ExternalIdentityUpdateOne) ExecX(ctx context.Context) {
+	if err := eiuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eiuo *ExternalIdentityUpdateOne) check() error {
+	if eiuo.mutation.UserCleared() && len(eiuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ExternalIdentity.user"`)
+	}
+	return nil
+}
+
+func (eiuo *ExternalIdentityUpdateOne) sqlSave(ctx context.Context) (_node *ExternalIdentity, err error) {
+	if err := eiuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(externalidentity.Table, externalidentity.Columns, sqlgraph.NewFieldSpec(externalidentity.FieldID, field.TypeInt))
+	id, ok := eiuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ExternalIdentity.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := eiuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, externalidentity.FieldID)
+		for _, f := range fields {
+			if !externalidentity.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != externalidentity.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := eiuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eiuo.mutation.Email(); ok {
+		_spec.SetField(externalidentity.FieldEmail, field.TypeString, value)
+	}
+	if eiuo.mutation.EmailCleared() {
+		_spec.ClearField(externalidentity.FieldEmail, field.TypeString)
+	}
+	if value, ok := eiuo.mutation.LastLoginAt(); ok {
+		_spec.SetField(externalidentity.FieldLastLoginAt, field.TypeTime, value)
+	}
+	if eiuo.mutation.LastLoginAtCleared() {
+		_spec.ClearField(externalidentity.FieldLastLoginAt, field.TypeTime)
+	}
+	_node = &ExternalIdentity{config: eiuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, eiuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{externalidentity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	eiuo.mutation.done = true
+	return _node, nil
+}
@@ -27,6 +27,8 @@ type GameItem struct {
 	Rarity int `json:"rarity,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ArchivedAt holds the value of the "archived_at" field.
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the GameItemQuery when eager-loading is set.
 	Edges        GameItemEdges `json:"edges"`
@@ -60,7 +62,7 @@ func (*GameItem) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case gameitem.FieldName, gameitem.FieldCollection:
 			values[i] = new(sql.NullString)
-		case gameitem.FieldCreatedAt:
+		case gameitem.FieldCreatedAt, gameitem.FieldArchivedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -113,6 +115,13 @@ func (gi *GameItem) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				gi.CreatedAt = value.Time
 			}
+		case gameitem.FieldArchivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field archived_at", values[i])
+			} else if value.Valid {
+				gi.ArchivedAt = new(time.Time)
+				*gi.ArchivedAt = value.Time
+			}
 		default:
 			gi.selectValues.Set(columns[i], values[i])
 		}
@@ -168,6 +177,11 @@ func (gi *GameItem) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(gi.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := gi.ArchivedAt; v != nil {
+		builder.WriteString("archived_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
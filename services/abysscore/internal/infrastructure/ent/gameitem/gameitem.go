@@ -24,6 +24,8 @@ const (
 	FieldRarity = "rarity"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
+	// FieldArchivedAt holds the string denoting the archived_at field in the database.
+	FieldArchivedAt = "archived_at"
 	// EdgeInventoryItems holds the string denoting the inventory_items edge name in mutations.
 	EdgeInventoryItems = "inventory_items"
 	// Table holds the table name of the gameitem in the database.
@@ -45,6 +47,7 @@ var Columns = []string{
 	FieldType,
 	FieldRarity,
 	FieldCreatedAt,
+	FieldArchivedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -103,6 +106,11 @@ func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
 }
 
+// ByArchivedAt orders the results by the archived_at field.
+func ByArchivedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArchivedAt, opts...).ToFunc()
+}
+
 // ByInventoryItemsCount orders the results by inventory_items count.
 func ByInventoryItemsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
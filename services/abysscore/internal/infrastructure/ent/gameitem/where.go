@@ -80,6 +80,11 @@ func CreatedAt(v time.Time) predicate.GameItem {
 	return predicate.GameItem(sql.FieldEQ(FieldCreatedAt, v))
 }
 
+// ArchivedAt applies equality check predicate on the "archived_at" field. It's identical to ArchivedAtEQ.
+func ArchivedAt(v time.Time) predicate.GameItem {
+	return predicate.GameItem(sql.FieldEQ(FieldArchivedAt, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.GameItem {
 	return predicate.GameItem(sql.FieldEQ(FieldName, v))
@@ -330,6 +335,56 @@ func CreatedAtLTE(v time.Time) predicate.GameItem {
 	return predicate.GameItem(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// ArchivedAtEQ applies the EQ predicate on the "archived_at" field.
+func ArchivedAtEQ(v time.Time) predicate.GameItem {
+	return predicate.GameItem(sql.FieldEQ(FieldArchivedAt, v))
+}
+
+// ArchivedAtNEQ applies the NEQ predicate on the "archived_at" field.
+func ArchivedAtNEQ(v time.Time) predicate.GameItem {
+	return predicate.GameItem(sql.FieldNEQ(FieldArchivedAt, v))
+}
+
+// ArchivedAtIn applies the In predicate on the "archived_at" field.
+func ArchivedAtIn(vs ...time.Time) predicate.GameItem {
+	return predicate.GameItem(sql.FieldIn(FieldArchivedAt, vs...))
+}
+
+// ArchivedAtNotIn applies the NotIn predicate on the "archived_at" field.
+func ArchivedAtNotIn(vs ...time.Time) predicate.GameItem {
+	return predicate.GameItem(sql.FieldNotIn(FieldArchivedAt, vs...))
+}
+
+// ArchivedAtGT applies the GT predicate on the "archived_at" field.
+func ArchivedAtGT(v time.Time) predicate.GameItem {
+	return predicate.GameItem(sql.FieldGT(FieldArchivedAt, v))
+}
+
+// ArchivedAtGTE applies the GTE predicate on the "archived_at" field.
+func ArchivedAtGTE(v time.Time) predicate.GameItem {
+	return predicate.GameItem(sql.FieldGTE(FieldArchivedAt, v))
+}
+
+// ArchivedAtLT applies the LT predicate on the "archived_at" field.
+func ArchivedAtLT(v time.Time) predicate.GameItem {
+	return predicate.GameItem(sql.FieldLT(FieldArchivedAt, v))
+}
+
+// ArchivedAtLTE applies the LTE predicate on the "archived_at" field.
+func ArchivedAtLTE(v time.Time) predicate.GameItem {
+	return predicate.GameItem(sql.FieldLTE(FieldArchivedAt, v))
+}
+
+// ArchivedAtIsNil applies the IsNil predicate on the "archived_at" field.
+func ArchivedAtIsNil() predicate.GameItem {
+	return predicate.GameItem(sql.FieldIsNull(FieldArchivedAt))
+}
+
+// ArchivedAtNotNil applies the NotNil predicate on the "archived_at" field.
+func ArchivedAtNotNil() predicate.GameItem {
+	return predicate.GameItem(sql.FieldNotNull(FieldArchivedAt))
+}
+
 // HasInventoryItems applies the HasEdge predicate on the "inventory_items" edge.
 func HasInventoryItems() predicate.GameItem {
 	return predicate.GameItem(func(s *sql.Selector) {
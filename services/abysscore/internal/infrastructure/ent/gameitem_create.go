@@ -59,6 +59,20 @@ func (gic *GameItemCreate) SetNillableCreatedAt(t *time.Time) *GameItemCreate {
 	return gic
 }
 
+// SetArchivedAt sets the "archived_at" field.
+func (gic *GameItemCreate) SetArchivedAt(t time.Time) *GameItemCreate {
+	gic.mutation.SetArchivedAt(t)
+	return gic
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (gic *GameItemCreate) SetNillableArchivedAt(t *time.Time) *GameItemCreate {
+	if t != nil {
+		gic.SetArchivedAt(*t)
+	}
+	return gic
+}
+
 // SetID sets the "id" field.
 func (gic *GameItemCreate) SetID(i int) *GameItemCreate {
 	gic.mutation.SetID(i)
@@ -210,6 +224,10 @@ func (gic *GameItemCreate) createSpec() (*GameItem, *sqlgraph.CreateSpec) {
 		_spec.SetField(gameitem.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := gic.mutation.ArchivedAt(); ok {
+		_spec.SetField(gameitem.FieldArchivedAt, field.TypeTime, value)
+		_node.ArchivedAt = &value
+	}
 	if nodes := gic.mutation.InventoryItemsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
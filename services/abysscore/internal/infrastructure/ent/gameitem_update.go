@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -98,6 +99,26 @@ func (giu *GameItemUpdate) AddRarity(i int) *GameItemUpdate {
 	return giu
 }
 
+// SetArchivedAt sets the "archived_at" field.
+func (giu *GameItemUpdate) SetArchivedAt(t time.Time) *GameItemUpdate {
+	giu.mutation.SetArchivedAt(t)
+	return giu
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (giu *GameItemUpdate) SetNillableArchivedAt(t *time.Time) *GameItemUpdate {
+	if t != nil {
+		giu.SetArchivedAt(*t)
+	}
+	return giu
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (giu *GameItemUpdate) ClearArchivedAt() *GameItemUpdate {
+	giu.mutation.ClearArchivedAt()
+	return giu
+}
+
 // AddInventoryItemIDs adds the "inventory_items" edge to the InventoryItem entity by IDs.
 func (giu *GameItemUpdate) AddInventoryItemIDs(ids ...int) *GameItemUpdate {
 	giu.mutation.AddInventoryItemIDs(ids...)
@@ -221,6 +242,12 @@ func (giu *GameItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := giu.mutation.AddedRarity(); ok {
 		_spec.AddField(gameitem.FieldRarity, field.TypeInt, value)
 	}
+	if value, ok := giu.mutation.ArchivedAt(); ok {
+		_spec.SetField(gameitem.FieldArchivedAt, field.TypeTime, value)
+	}
+	if giu.mutation.ArchivedAtCleared() {
+		_spec.ClearField(gameitem.FieldArchivedAt, field.TypeTime)
+	}
 	if giu.mutation.InventoryItemsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -356,6 +383,26 @@ func (giuo *GameItemUpdateOne) AddRarity(i int) *GameItemUpdateOne {
 	return giuo
 }
 
+// SetArchivedAt sets the "archived_at" field.
+func (giuo *GameItemUpdateOne) SetArchivedAt(t time.Time) *GameItemUpdateOne {
+	giuo.mutation.SetArchivedAt(t)
+	return giuo
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (giuo *GameItemUpdateOne) SetNillableArchivedAt(t *time.Time) *GameItemUpdateOne {
+	if t != nil {
+		giuo.SetArchivedAt(*t)
+	}
+	return giuo
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (giuo *GameItemUpdateOne) ClearArchivedAt() *GameItemUpdateOne {
+	giuo.mutation.ClearArchivedAt()
+	return giuo
+}
+
 // AddInventoryItemIDs adds the "inventory_items" edge to the InventoryItem entity by IDs.
 func (giuo *GameItemUpdateOne) AddInventoryItemIDs(ids ...int) *GameItemUpdateOne {
 	giuo.mutation.AddInventoryItemIDs(ids...)
@@ -509,6 +556,12 @@ func (giuo *GameItemUpdateOne) sqlSave(ctx context.Context) (_node *GameItem, er
 	if value, ok := giuo.mutation.AddedRarity(); ok {
 		_spec.AddField(gameitem.FieldRarity, field.TypeInt, value)
 	}
+	if value, ok := giuo.mutation.ArchivedAt(); ok {
+		_spec.SetField(gameitem.FieldArchivedAt, field.TypeTime, value)
+	}
+	if giuo.mutation.ArchivedAtCleared() {
+		_spec.ClearField(gameitem.FieldArchivedAt, field.TypeTime)
+	}
 	if giuo.mutation.InventoryItemsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -0,0 +1,272 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/grantjob"
+)
+
+// GrantJob is the model entity for the GrantJob schema.
+type GrantJob struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Campaign holds the value of the "campaign" field.
+	Campaign string `json:"campaign,omitempty"`
+	// ItemID holds the value of the "item_id" field.
+	ItemID int `json:"item_id,omitempty"`
+	// CreatedByID holds the value of the "created_by_id" field.
+	CreatedByID int `json:"created_by_id,omitempty"`
+	// Target holds the value of the "target" field.
+	Target grantjob.Target `json:"target,omitempty"`
+	// Status holds the value of the "status" field.
+	Status grantjob.Status `json:"status,omitempty"`
+	// Total holds the value of the "total" field.
+	Total int `json:"total,omitempty"`
+	// Processed holds the value of the "processed" field.
+	Processed int `json:"processed,omitempty"`
+	// Granted holds the value of the "granted" field.
+	Granted int `json:"granted,omitempty"`
+	// Skipped holds the value of the "skipped" field.
+	Skipped int `json:"skipped,omitempty"`
+	// Failed holds the value of the "failed" field.
+	Failed int `json:"failed,omitempty"`
+	// Error holds the value of the "error" field.
+	Error *string `json:"error,omitempty"`
+	// Owner holds the value of the "owner" field.
+	Owner *string `json:"owner,omitempty"`
+	// HeartbeatAt holds the value of the "heartbeat_at" field.
+	HeartbeatAt *time.Time `json:"heartbeat_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt   *time.Time `json:"finished_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GrantJob) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case grantjob.FieldID, grantjob.FieldItemID, grantjob.FieldCreatedByID, grantjob.FieldTotal, grantjob.FieldProcessed, grantjob.FieldGranted, grantjob.FieldSkipped, grantjob.FieldFailed:
+			values[i] = new(sql.NullInt64)
+		case grantjob.FieldCampaign, grantjob.FieldTarget, grantjob.FieldStatus, grantjob.FieldError, grantjob.FieldOwner:
+			values[i] = new(sql.NullString)
+		case grantjob.FieldHeartbeatAt, grantjob.FieldCreatedAt, grantjob.FieldFinishedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GrantJob fields.
+func (gj *GrantJob) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case grantjob.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			gj.ID = int(value.Int64)
+		case grantjob.FieldCampaign:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field campaign", values[i])
+			} else if value.Valid {
+				gj.Campaign = value.String
+			}
+		case grantjob.FieldItemID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value.Valid {
+				gj.ItemID = int(value.Int64)
+			}
+		case grantjob.FieldCreatedByID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by_id", values[i])
+			} else if value.Valid {
+				gj.CreatedByID = int(value.Int64)
+			}
+		case grantjob.FieldTarget:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target", values[i])
+			} else if value.Valid {
+				gj.Target = grantjob.Target(value.String)
+			}
+		case grantjob.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				gj.Status = grantjob.Status(value.String)
+			}
+		case grantjob.FieldTotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total", values[i])
+			} else if value.Valid {
+				gj.Total = int(value.Int64)
+			}
+		case grantjob.FieldProcessed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field processed", values[i])
+			} else if value.Valid {
+				gj.Processed = int(value.Int64)
+			}
+		case grantjob.FieldGranted:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field granted", values[i])
+			} else if value.Valid {
+				gj.Granted = int(value.Int64)
+			}
+		case grantjob.FieldSkipped:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field skipped", values[i])
+			} else if value.Valid {
+				gj.Skipped = int(value.Int64)
+			}
+		case grantjob.FieldFailed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failed", values[i])
+			} else if value.Valid {
+				gj.Failed = int(value.Int64)
+			}
+		case grantjob.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				gj.Error = new(string)
+				*gj.Error = value.String
+			}
+		case grantjob.FieldOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner", values[i])
+			} else if value.Valid {
+				gj.Owner = new(string)
+				*gj.Owner = value.String
+			}
+		case grantjob.FieldHeartbeatAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field heartbeat_at", values[i])
+			} else if value.Valid {
+				gj.HeartbeatAt = new(time.Time)
+				*gj.HeartbeatAt = value.Time
+			}
+		case grantjob.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				gj.CreatedAt = value.Time
+			}
+		case grantjob.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				gj.FinishedAt = new(time.Time)
+				*gj.FinishedAt = value.Time
+			}
+		default:
+			gj.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GrantJob.
+// This includes values selected through modifiers, order, etc.
+func (gj *GrantJob) Value(name string) (ent.Value, error) {
+	return gj.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this GrantJob.
+// Note that you need to call GrantJob.Unwrap() before calling this method if this GrantJob
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (gj *GrantJob) Update() *GrantJobUpdateOne {
+	return NewGrantJobClient(gj.config).UpdateOne(gj)
+}
+
+// Unwrap unwraps the GrantJob entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (gj *GrantJob) Unwrap() *GrantJob {
+	_tx, ok := gj.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GrantJob is not a transactional entity")
+	}
+	gj.config.driver = _tx.drv
+	return gj
+}
+
+// String implements the fmt.Stringer.
+func (gj *GrantJob) String() string {
+	var builder strings.Builder
+	builder.WriteString("GrantJob(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", gj.ID))
+	builder.WriteString("campaign=")
+	builder.WriteString(gj.Campaign)
+	builder.WriteString(", ")
+	builder.WriteString("item_id=")
+	builder.WriteString(fmt.Sprintf("%v", gj.ItemID))
+	builder.WriteString(", ")
+	builder.WriteString("created_by_id=")
+	builder.WriteString(fmt.Sprintf("%v", gj.CreatedByID))
+	builder.WriteString(", ")
+	builder.WriteString("target=")
+	builder.WriteString(fmt.Sprintf("%v", gj.Target))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", gj.Status))
+	builder.WriteString(", ")
+	builder.WriteString("total=")
+	builder.WriteString(fmt.Sprintf("%v", gj.Total))
+	builder.WriteString(", ")
+	builder.WriteString("processed=")
+	builder.WriteString(fmt.Sprintf("%v", gj.Processed))
+	builder.WriteString(", ")
+	builder.WriteString("granted=")
+	builder.WriteString(fmt.Sprintf("%v", gj.Granted))
+	builder.WriteString(", ")
+	builder.WriteString("skipped=")
+	builder.WriteString(fmt.Sprintf("%v", gj.Skipped))
+	builder.WriteString(", ")
+	builder.WriteString("failed=")
+	builder.WriteString(fmt.Sprintf("%v", gj.Failed))
+	builder.WriteString(", ")
+	if v := gj.Error; v != nil {
+		builder.WriteString("error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := gj.Owner; v != nil {
+		builder.WriteString("owner=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := gj.HeartbeatAt; v != nil {
+		builder.WriteString("heartbeat_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(gj.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := gj.FinishedAt; v != nil {
+		builder.WriteString("finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GrantJobs is a parsable slice of GrantJob.
+type GrantJobs []*GrantJob
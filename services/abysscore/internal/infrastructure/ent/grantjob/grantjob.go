@@ -0,0 +1,241 @@
+// Code generated by ent, DO NOT EDIT.
+
+package grantjob
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the grantjob type in the database.
+	Label = "grant_job"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCampaign holds the string denoting the campaign field in the database.
+	FieldCampaign = "campaign"
+	// FieldItemID holds the string denoting the item_id field in the database.
+	FieldItemID = "item_id"
+	// FieldCreatedByID holds the string denoting the created_by_id field in the database.
+	FieldCreatedByID = "created_by_id"
+	// FieldTarget holds the string denoting the target field in the database.
+	FieldTarget = "target"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldTotal holds the string denoting the total field in the database.
+	FieldTotal = "total"
+	// FieldProcessed holds the string denoting the processed field in the database.
+	FieldProcessed = "processed"
+	// FieldGranted holds the string denoting the granted field in the database.
+	FieldGranted = "granted"
+	// FieldSkipped holds the string denoting the skipped field in the database.
+	FieldSkipped = "skipped"
+	// FieldFailed holds the string denoting the failed field in the database.
+	FieldFailed = "failed"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldOwner holds the string denoting the owner field in the database.
+	FieldOwner = "owner"
+	// FieldHeartbeatAt holds the string denoting the heartbeat_at field in the database.
+	FieldHeartbeatAt = "heartbeat_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldFinishedAt holds the string denoting the finished_at field in the database.
+	FieldFinishedAt = "finished_at"
+	// Table holds the table name of the grantjob in the database.
+	Table = "grant_jobs"
+)
+
+// Columns holds all SQL columns for grantjob fields.
+var Columns = []string{
+	FieldID,
+	FieldCampaign,
+	FieldItemID,
+	FieldCreatedByID,
+	FieldTarget,
+	FieldStatus,
+	FieldTotal,
+	FieldProcessed,
+	FieldGranted,
+	FieldSkipped,
+	FieldFailed,
+	FieldError,
+	FieldOwner,
+	FieldHeartbeatAt,
+	FieldCreatedAt,
+	FieldFinishedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CampaignValidator is a validator for the "campaign" field. It is called by the builders before save.
+	CampaignValidator func(string) error
+	// DefaultTotal holds the default value on creation for the "total" field.
+	DefaultTotal int
+	// TotalValidator is a validator for the "total" field. It is called by the builders before save.
+	TotalValidator func(int) error
+	// DefaultProcessed holds the default value on creation for the "processed" field.
+	DefaultProcessed int
+	// ProcessedValidator is a validator for the "processed" field. It is called by the builders before save.
+	ProcessedValidator func(int) error
+	// DefaultGranted holds the default value on creation for the "granted" field.
+	DefaultGranted int
+	// GrantedValidator is a validator for the "granted" field. It is called by the builders before save.
+	GrantedValidator func(int) error
+	// DefaultSkipped holds the default value on creation for the "skipped" field.
+	DefaultSkipped int
+	// SkippedValidator is a validator for the "skipped" field. It is called by the builders before save.
+	SkippedValidator func(int) error
+	// DefaultFailed holds the default value on creation for the "failed" field.
+	DefaultFailed int
+	// FailedValidator is a validator for the "failed" field. It is called by the builders before save.
+	FailedValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Target defines the type for the "target" enum field.
+type Target string
+
+// Target values.
+const (
+	TargetUsers  Target = "users"
+	TargetFilter Target = "filter"
+	TargetOnline Target = "online"
+)
+
+func (t Target) String() string {
+	return string(t)
+}
+
+// TargetValidator is a validator for the "target" field enum values. It is called by the builders before save.
+func TargetValidator(t Target) error {
+	switch t {
+	case TargetUsers, TargetFilter, TargetOnline:
+		return nil
+	default:
+		return fmt.Errorf("grantjob: invalid enum value for target field: %q", t)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending   Status = "pending"
+	StatusRunning   Status = "running"
+	StatusCompleted Status = "completed"
+	StatusFailed    Status = "failed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusRunning, StatusCompleted, StatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("grantjob: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the GrantJob queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCampaign orders the results by the campaign field.
+func ByCampaign(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCampaign, opts...).ToFunc()
+}
+
+// ByItemID orders the results by the item_id field.
+func ByItemID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldItemID, opts...).ToFunc()
+}
+
+// ByCreatedByID orders the results by the created_by_id field.
+func ByCreatedByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedByID, opts...).ToFunc()
+}
+
+// ByTarget orders the results by the target field.
+func ByTarget(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTarget, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByTotal orders the results by the total field.
+func ByTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotal, opts...).ToFunc()
+}
+
+// ByProcessed orders the results by the processed field.
+func ByProcessed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProcessed, opts...).ToFunc()
+}
+
+// ByGranted orders the results by the granted field.
+func ByGranted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGranted, opts...).ToFunc()
+}
+
+// BySkipped orders the results by the skipped field.
+func BySkipped(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSkipped, opts...).ToFunc()
+}
+
+// ByFailed orders the results by the failed field.
+func ByFailed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailed, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByOwner orders the results by the owner field.
+func ByOwner(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwner, opts...).ToFunc()
+}
+
+// ByHeartbeatAt orders the results by the heartbeat_at field.
+func ByHeartbeatAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeartbeatAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByFinishedAt orders the results by the finished_at field.
+func ByFinishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishedAt, opts...).ToFunc()
+}
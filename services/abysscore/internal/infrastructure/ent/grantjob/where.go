@@ -0,0 +1,810 @@
+// Code generated by ent, DO NOT EDIT.
+
+package grantjob
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldLTE(FieldID, id))
+}
+
+// Campaign applies equality check predicate on the "campaign" field. It's identical to CampaignEQ.
+func Campaign(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEQ(FieldCampaign, v))
+}
+
+// ItemID applies equality check predicate on the "item_id" field. It's identical to ItemIDEQ.
+func ItemID(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEQ(FieldItemID, v))
+}
+
+// CreatedByID applies equality check predicate on the "created_by_id" field. It's identical to CreatedByIDEQ.
+func CreatedByID(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEQ(FieldCreatedByID, v))
+}
+
+// Total applies equality check predicate on the "total" field. It's identical to TotalEQ.
+func Total(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEQ(FieldTotal, v))
+}
+
+// Processed applies equality check predicate on the "processed" field. It's identical to ProcessedEQ.
+func Processed(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEQ(FieldProcessed, v))
+}
+
+// Granted applies equality check predicate on the "granted" field. It's identical to GrantedEQ.
+func Granted(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEQ(FieldGranted, v))
+}
+
+// Skipped applies equality check predicate on the "skipped" field. It's identical to SkippedEQ.
+func Skipped(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEQ(FieldSkipped, v))
+}
+
+// Failed applies equality check predicate on the "failed" field. It's identical to FailedEQ.
+func Failed(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEQ(FieldFailed, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEQ(FieldError, v))
+}
+
+// Owner applies equality check predicate on the "owner" field. It's identical to OwnerEQ.
+func Owner(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEQ(FieldOwner, v))
+}
+
+// HeartbeatAt applies equality check predicate on the "heartbeat_at" field. It's identical to HeartbeatAtEQ.
+func HeartbeatAt(v time.Time) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEQ(FieldHeartbeatAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// CampaignEQ applies the EQ predicate on the "campaign" field.
+func CampaignEQ(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEQ(FieldCampaign, v))
+}
+
+// CampaignNEQ applies the NEQ predicate on the "campaign" field.
+func CampaignNEQ(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNEQ(FieldCampaign, v))
+}
+
+// CampaignIn applies the In predicate on the "campaign" field.
+func CampaignIn(vs ...string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldIn(FieldCampaign, vs...))
+}
+
+// CampaignNotIn applies the NotIn predicate on the "campaign" field.
+func CampaignNotIn(vs ...string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNotIn(FieldCampaign, vs...))
+}
+
+// CampaignGT applies the GT predicate on the "campaign" field.
+func CampaignGT(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldGT(FieldCampaign, v))
+}
+
+// CampaignGTE applies the GTE predicate on the "campaign" field.
+func CampaignGTE(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldGTE(FieldCampaign, v))
+}
+
+// CampaignLT applies the LT predicate on the "campaign" field.
+func CampaignLT(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldLT(FieldCampaign, v))
+}
+
+// CampaignLTE applies the LTE predicate on the "campaign" field.
+func CampaignLTE(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldLTE(FieldCampaign, v))
+}
+
+// CampaignContains applies the Contains predicate on the "campaign" field.
+func CampaignContains(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldContains(FieldCampaign, v))
+}
+
+// CampaignHasPrefix applies the HasPrefix predicate on the "campaign" field.
+func CampaignHasPrefix(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldHasPrefix(FieldCampaign, v))
+}
+
+// CampaignHasSuffix applies the HasSuffix predicate on the "campaign" field.
+func CampaignHasSuffix(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldHasSuffix(FieldCampaign, v))
+}
+
+// CampaignEqualFold applies the EqualFold predicate on the "campaign" field.
+func CampaignEqualFold(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEqualFold(FieldCampaign, v))
+}
+
+// CampaignContainsFold applies the ContainsFold predicate on the "campaign" field.
+func CampaignContainsFold(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldContainsFold(FieldCampaign, v))
+}
+
+// ItemIDEQ applies the EQ predicate on the "item_id" field.
+func ItemIDEQ(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemIDNEQ applies the NEQ predicate on the "item_id" field.
+func ItemIDNEQ(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNEQ(FieldItemID, v))
+}
+
+// ItemIDIn applies the In predicate on the "item_id" field.
+func ItemIDIn(vs ...int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldIn(FieldItemID, vs...))
+}
+
+// ItemIDNotIn applies the NotIn predicate on the "item_id" field.
+func ItemIDNotIn(vs ...int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNotIn(FieldItemID, vs...))
+}
+
+// ItemIDGT applies the GT predicate on the "item_id" field.
+func ItemIDGT(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldGT(FieldItemID, v))
+}
+
+// ItemIDGTE applies the GTE predicate on the "item_id" field.
+func ItemIDGTE(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldGTE(FieldItemID, v))
+}
+
+// ItemIDLT applies the LT predicate on the "item_id" field.
+func ItemIDLT(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldLT(FieldItemID, v))
+}
+
+// ItemIDLTE applies the LTE predicate on the "item_id" field.
+func ItemIDLTE(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldLTE(FieldItemID, v))
+}
+
+// CreatedByIDEQ applies the EQ predicate on the "created_by_id" field.
+func CreatedByIDEQ(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEQ(FieldCreatedByID, v))
+}
+
+// CreatedByIDNEQ applies the NEQ predicate on the "created_by_id" field.
+func CreatedByIDNEQ(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNEQ(FieldCreatedByID, v))
+}
+
+// CreatedByIDIn applies the In predicate on the "created_by_id" field.
+func CreatedByIDIn(vs ...int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldIn(FieldCreatedByID, vs...))
+}
+
+// CreatedByIDNotIn applies the NotIn predicate on the "created_by_id" field.
+func CreatedByIDNotIn(vs ...int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNotIn(FieldCreatedByID, vs...))
+}
+
+// CreatedByIDGT applies the GT predicate on the "created_by_id" field.
+func CreatedByIDGT(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldGT(FieldCreatedByID, v))
+}
+
+// CreatedByIDGTE applies the GTE predicate on the "created_by_id" field.
+func CreatedByIDGTE(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldGTE(FieldCreatedByID, v))
+}
+
+// CreatedByIDLT applies the LT predicate on the "created_by_id" field.
+func CreatedByIDLT(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldLT(FieldCreatedByID, v))
+}
+
+// CreatedByIDLTE applies the LTE predicate on the "created_by_id" field.
+func CreatedByIDLTE(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldLTE(FieldCreatedByID, v))
+}
+
+// TargetEQ applies the EQ predicate on the "target" field.
+func TargetEQ(v Target) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEQ(FieldTarget, v))
+}
+
+// TargetNEQ applies the NEQ predicate on the "target" field.
+func TargetNEQ(v Target) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNEQ(FieldTarget, v))
+}
+
+// TargetIn applies the In predicate on the "target" field.
+func TargetIn(vs ...Target) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldIn(FieldTarget, vs...))
+}
+
+// TargetNotIn applies the NotIn predicate on the "target" field.
+func TargetNotIn(vs ...Target) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNotIn(FieldTarget, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// TotalEQ applies the EQ predicate on the "total" field.
+func TotalEQ(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEQ(FieldTotal, v))
+}
+
+// TotalNEQ applies the NEQ predicate on the "total" field.
+func TotalNEQ(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNEQ(FieldTotal, v))
+}
+
+// TotalIn applies the In predicate on the "total" field.
+func TotalIn(vs ...int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldIn(FieldTotal, vs...))
+}
+
+// TotalNotIn applies the NotIn predicate on the "total" field.
+func TotalNotIn(vs ...int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNotIn(FieldTotal, vs...))
+}
+
+// TotalGT applies the GT predicate on the "total" field.
+func TotalGT(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldGT(FieldTotal, v))
+}
+
+// TotalGTE applies the GTE predicate on the "total" field.
+func TotalGTE(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldGTE(FieldTotal, v))
+}
+
+// TotalLT applies the LT predicate on the "total" field.
+func TotalLT(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldLT(FieldTotal, v))
+}
+
+// TotalLTE applies the LTE predicate on the "total" field.
+func TotalLTE(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldLTE(FieldTotal, v))
+}
+
+// ProcessedEQ applies the EQ predicate on the "processed" field.
+func ProcessedEQ(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEQ(FieldProcessed, v))
+}
+
+// ProcessedNEQ applies the NEQ predicate on the "processed" field.
+func ProcessedNEQ(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNEQ(FieldProcessed, v))
+}
+
+// ProcessedIn applies the In predicate on the "processed" field.
+func ProcessedIn(vs ...int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldIn(FieldProcessed, vs...))
+}
+
+// ProcessedNotIn applies the NotIn predicate on the "processed" field.
+func ProcessedNotIn(vs ...int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNotIn(FieldProcessed, vs...))
+}
+
+// ProcessedGT applies the GT predicate on the "processed" field.
+func ProcessedGT(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldGT(FieldProcessed, v))
+}
+
+// ProcessedGTE applies the GTE predicate on the "processed" field.
+func ProcessedGTE(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldGTE(FieldProcessed, v))
+}
+
+// ProcessedLT applies the LT predicate on the "processed" field.
+func ProcessedLT(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldLT(FieldProcessed, v))
+}
+
+// ProcessedLTE applies the LTE predicate on the "processed" field.
+func ProcessedLTE(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldLTE(FieldProcessed, v))
+}
+
+// GrantedEQ applies the EQ predicate on the "granted" field.
+func GrantedEQ(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEQ(FieldGranted, v))
+}
+
+// GrantedNEQ applies the NEQ predicate on the "granted" field.
+func GrantedNEQ(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNEQ(FieldGranted, v))
+}
+
+// GrantedIn applies the In predicate on the "granted" field.
+func GrantedIn(vs ...int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldIn(FieldGranted, vs...))
+}
+
+// GrantedNotIn applies the NotIn predicate on the "granted" field.
+func GrantedNotIn(vs ...int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNotIn(FieldGranted, vs...))
+}
+
+// GrantedGT applies the GT predicate on the "granted" field.
+func GrantedGT(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldGT(FieldGranted, v))
+}
+
+// GrantedGTE applies the GTE predicate on the "granted" field.
+func GrantedGTE(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldGTE(FieldGranted, v))
+}
+
+// GrantedLT applies the LT predicate on the "granted" field.
+func GrantedLT(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldLT(FieldGranted, v))
+}
+
+// GrantedLTE applies the LTE predicate on the "granted" field.
+func GrantedLTE(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldLTE(FieldGranted, v))
+}
+
+// SkippedEQ applies the EQ predicate on the "skipped" field.
+func SkippedEQ(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEQ(FieldSkipped, v))
+}
+
+// SkippedNEQ applies the NEQ predicate on the "skipped" field.
+func SkippedNEQ(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNEQ(FieldSkipped, v))
+}
+
+// SkippedIn applies the In predicate on the "skipped" field.
+func SkippedIn(vs ...int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldIn(FieldSkipped, vs...))
+}
+
+// SkippedNotIn applies the NotIn predicate on the "skipped" field.
+func SkippedNotIn(vs ...int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNotIn(FieldSkipped, vs...))
+}
+
+// SkippedGT applies the GT predicate on the "skipped" field.
+func SkippedGT(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldGT(FieldSkipped, v))
+}
+
+// SkippedGTE applies the GTE predicate on the "skipped" field.
+func SkippedGTE(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldGTE(FieldSkipped, v))
+}
+
+// SkippedLT applies the LT predicate on the "skipped" field.
+func SkippedLT(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldLT(FieldSkipped, v))
+}
+
+// SkippedLTE applies the LTE predicate on the "skipped" field.
+func SkippedLTE(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldLTE(FieldSkipped, v))
+}
+
+// FailedEQ applies the EQ predicate on the "failed" field.
+func FailedEQ(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEQ(FieldFailed, v))
+}
+
+// FailedNEQ applies the NEQ predicate on the "failed" field.
+func FailedNEQ(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNEQ(FieldFailed, v))
+}
+
+// FailedIn applies the In predicate on the "failed" field.
+func FailedIn(vs ...int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldIn(FieldFailed, vs...))
+}
+
+// FailedNotIn applies the NotIn predicate on the "failed" field.
+func FailedNotIn(vs ...int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNotIn(FieldFailed, vs...))
+}
+
+// FailedGT applies the GT predicate on the "failed" field.
+func FailedGT(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldGT(FieldFailed, v))
+}
+
+// FailedGTE applies the GTE predicate on the "failed" field.
+func FailedGTE(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldGTE(FieldFailed, v))
+}
+
+// FailedLT applies the LT predicate on the "failed" field.
+func FailedLT(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldLT(FieldFailed, v))
+}
+
+// FailedLTE applies the LTE predicate on the "failed" field.
+func FailedLTE(v int) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldLTE(FieldFailed, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldContainsFold(FieldError, v))
+}
+
+// OwnerEQ applies the EQ predicate on the "owner" field.
+func OwnerEQ(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEQ(FieldOwner, v))
+}
+
+// OwnerNEQ applies the NEQ predicate on the "owner" field.
+func OwnerNEQ(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNEQ(FieldOwner, v))
+}
+
+// OwnerIn applies the In predicate on the "owner" field.
+func OwnerIn(vs ...string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldIn(FieldOwner, vs...))
+}
+
+// OwnerNotIn applies the NotIn predicate on the "owner" field.
+func OwnerNotIn(vs ...string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNotIn(FieldOwner, vs...))
+}
+
+// OwnerGT applies the GT predicate on the "owner" field.
+func OwnerGT(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldGT(FieldOwner, v))
+}
+
+// OwnerGTE applies the GTE predicate on the "owner" field.
+func OwnerGTE(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldGTE(FieldOwner, v))
+}
+
+// OwnerLT applies the LT predicate on the "owner" field.
+func OwnerLT(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldLT(FieldOwner, v))
+}
+
+// OwnerLTE applies the LTE predicate on the "owner" field.
+func OwnerLTE(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldLTE(FieldOwner, v))
+}
+
+// OwnerContains applies the Contains predicate on the "owner" field.
+func OwnerContains(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldContains(FieldOwner, v))
+}
+
+// OwnerHasPrefix applies the HasPrefix predicate on the "owner" field.
+func OwnerHasPrefix(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldHasPrefix(FieldOwner, v))
+}
+
+// OwnerHasSuffix applies the HasSuffix predicate on the "owner" field.
+func OwnerHasSuffix(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldHasSuffix(FieldOwner, v))
+}
+
+// OwnerIsNil applies the IsNil predicate on the "owner" field.
+func OwnerIsNil() predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldIsNull(FieldOwner))
+}
+
+// OwnerNotNil applies the NotNil predicate on the "owner" field.
+func OwnerNotNil() predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNotNull(FieldOwner))
+}
+
+// OwnerEqualFold applies the EqualFold predicate on the "owner" field.
+func OwnerEqualFold(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEqualFold(FieldOwner, v))
+}
+
+// OwnerContainsFold applies the ContainsFold predicate on the "owner" field.
+func OwnerContainsFold(v string) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldContainsFold(FieldOwner, v))
+}
+
+// HeartbeatAtEQ applies the EQ predicate on the "heartbeat_at" field.
+func HeartbeatAtEQ(v time.Time) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEQ(FieldHeartbeatAt, v))
+}
+
+// HeartbeatAtNEQ applies the NEQ predicate on the "heartbeat_at" field.
+func HeartbeatAtNEQ(v time.Time) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNEQ(FieldHeartbeatAt, v))
+}
+
+// HeartbeatAtIn applies the In predicate on the "heartbeat_at" field.
+func HeartbeatAtIn(vs ...time.Time) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldIn(FieldHeartbeatAt, vs...))
+}
+
+// HeartbeatAtNotIn applies the NotIn predicate on the "heartbeat_at" field.
+func HeartbeatAtNotIn(vs ...time.Time) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNotIn(FieldHeartbeatAt, vs...))
+}
+
+// HeartbeatAtGT applies the GT predicate on the "heartbeat_at" field.
+func HeartbeatAtGT(v time.Time) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldGT(FieldHeartbeatAt, v))
+}
+
+// HeartbeatAtGTE applies the GTE predicate on the "heartbeat_at" field.
+func HeartbeatAtGTE(v time.Time) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldGTE(FieldHeartbeatAt, v))
+}
+
+// HeartbeatAtLT applies the LT predicate on the "heartbeat_at" field.
+func HeartbeatAtLT(v time.Time) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldLT(FieldHeartbeatAt, v))
+}
+
+// HeartbeatAtLTE applies the LTE predicate on the "heartbeat_at" field.
+func HeartbeatAtLTE(v time.Time) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldLTE(FieldHeartbeatAt, v))
+}
+
+// HeartbeatAtIsNil applies the IsNil predicate on the "heartbeat_at" field.
+func HeartbeatAtIsNil() predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldIsNull(FieldHeartbeatAt))
+}
+
+// HeartbeatAtNotNil applies the NotNil predicate on the "heartbeat_at" field.
+func HeartbeatAtNotNil() predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNotNull(FieldHeartbeatAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.GrantJob {
+	return predicate.GrantJob(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GrantJob) predicate.GrantJob {
+	return predicate.GrantJob(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GrantJob) predicate.GrantJob {
+	return predicate.GrantJob(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GrantJob) predicate.GrantJob {
+	return predicate.GrantJob(sql.NotPredicates(p))
+}
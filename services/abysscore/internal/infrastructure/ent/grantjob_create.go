@@ -0,0 +1,528 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/grantjob"
+)
+
+// GrantJobCreate is the builder for creating a GrantJob entity.
+type GrantJobCreate struct {
+	config
+	mutation *GrantJobMutation
+	hooks    []Hook
+}
+
+// SetCampaign sets the "campaign" field.
+func (gjc *GrantJobCreate) SetCampaign(s string) *GrantJobCreate {
+	gjc.mutation.SetCampaign(s)
+	return gjc
+}
+
+// SetItemID sets the "item_id" field.
+func (gjc *GrantJobCreate) SetItemID(i int) *GrantJobCreate {
+	gjc.mutation.SetItemID(i)
+	return gjc
+}
+
+// SetCreatedByID sets the "created_by_id" field.
+func (gjc *GrantJobCreate) SetCreatedByID(i int) *GrantJobCreate {
+	gjc.mutation.SetCreatedByID(i)
+	return gjc
+}
+
+// SetTarget sets the "target" field.
+func (gjc *GrantJobCreate) SetTarget(gr grantjob.Target) *GrantJobCreate {
+	gjc.mutation.SetTarget(gr)
+	return gjc
+}
+
+// SetStatus sets the "status" field.
+func (gjc *GrantJobCreate) SetStatus(gr grantjob.Status) *GrantJobCreate {
+	gjc.mutation.SetStatus(gr)
+	return gjc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (gjc *GrantJobCreate) SetNillableStatus(gr *grantjob.Status) *GrantJobCreate {
+	if gr != nil {
+		gjc.SetStatus(*gr)
+	}
+	return gjc
+}
+
+// SetTotal sets the "total" field.
+func (gjc *GrantJobCreate) SetTotal(i int) *GrantJobCreate {
+	gjc.mutation.SetTotal(i)
+	return gjc
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (gjc *GrantJobCreate) SetNillableTotal(i *int) *GrantJobCreate {
+	if i != nil {
+		gjc.SetTotal(*i)
+	}
+	return gjc
+}
+
+// SetProcessed sets the "processed" field.
+func (gjc *GrantJobCreate) SetProcessed(i int) *GrantJobCreate {
+	gjc.mutation.SetProcessed(i)
+	return gjc
+}
+
+// SetNillableProcessed sets the "processed" field if the given value is not nil.
+func (gjc *GrantJobCreate) SetNillableProcessed(i *int) *GrantJobCreate {
+	if i != nil {
+		gjc.SetProcessed(*i)
+	}
+	return gjc
+}
+
+// SetGranted sets the "granted" field.
+func (gjc *GrantJobCreate) SetGranted(i int) *GrantJobCreate {
+	gjc.mutation.SetGranted(i)
+	return gjc
+}
+
+// SetNillableGranted sets the "granted" field if the given value is not nil.
+func (gjc *GrantJobCreate) SetNillableGranted(i *int) *GrantJobCreate {
+	if i != nil {
+		gjc.SetGranted(*i)
+	}
+	return gjc
+}
+
+// SetSkipped sets the "skipped" field.
+func (gjc *GrantJobCreate) SetSkipped(i int) *GrantJobCreate {
+	gjc.mutation.SetSkipped(i)
+	return gjc
+}
+
+// SetNillableSkipped sets the "skipped" field if the given value is not nil.
+func (gjc *GrantJobCreate) SetNillableSkipped(i *int) *GrantJobCreate {
+	if i != nil {
+		gjc.SetSkipped(*i)
+	}
+	return gjc
+}
+
+// SetFailed sets the "failed" field.
+func (gjc *GrantJobCreate) SetFailed(i int) *GrantJobCreate {
+	gjc.mutation.SetFailed(i)
+	return gjc
+}
+
+// SetNillableFailed sets the "failed" field if the given value is not nil.
+func (gjc *GrantJobCreate) SetNillableFailed(i *int) *GrantJobCreate {
+	if i != nil {
+		gjc.SetFailed(*i)
+	}
+	return gjc
+}
+
+// SetError sets the "error" field.
+func (gjc *GrantJobCreate) SetError(s string) *GrantJobCreate {
+	gjc.mutation.SetError(s)
+	return gjc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (gjc *GrantJobCreate) SetNillableError(s *string) *GrantJobCreate {
+	if s != nil {
+		gjc.SetError(*s)
+	}
+	return gjc
+}
+
+// SetOwner sets the "owner" field.
+func (gjc *GrantJobCreate) SetOwner(s string) *GrantJobCreate {
+	gjc.mutation.SetOwner(s)
+	return gjc
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (gjc *GrantJobCreate) SetNillableOwner(s *string) *GrantJobCreate {
+	if s != nil {
+		gjc.SetOwner(*s)
+	}
+	return gjc
+}
+
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (gjc *GrantJobCreate) SetHeartbeatAt(t time.Time) *GrantJobCreate {
+	gjc.mutation.SetHeartbeatAt(t)
+	return gjc
+}
+
+// SetNillableHeartbeatAt sets the "heartbeat_at" field if the given value is not nil.
+func (gjc *GrantJobCreate) SetNillableHeartbeatAt(t *time.Time) *GrantJobCreate {
+	if t != nil {
+		gjc.SetHeartbeatAt(*t)
+	}
+	return gjc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (gjc *GrantJobCreate) SetCreatedAt(t time.Time) *GrantJobCreate {
+	gjc.mutation.SetCreatedAt(t)
+	return gjc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (gjc *GrantJobCreate) SetNillableCreatedAt(t *time.Time) *GrantJobCreate {
+	if t != nil {
+		gjc.SetCreatedAt(*t)
+	}
+	return gjc
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (gjc *GrantJobCreate) SetFinishedAt(t time.Time) *GrantJobCreate {
+	gjc.mutation.SetFinishedAt(t)
+	return gjc
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (gjc *GrantJobCreate) SetNillableFinishedAt(t *time.Time) *GrantJobCreate {
+	if t != nil {
+		gjc.SetFinishedAt(*t)
+	}
+	return gjc
+}
+
+// SetID sets the "id" field.
+func (gjc *GrantJobCreate) SetID(i int) *GrantJobCreate {
+	gjc.mutation.SetID(i)
+	return gjc
+}
+
+// Mutation returns the GrantJobMutation object of the builder.
+func (gjc *GrantJobCreate) Mutation() *GrantJobMutation {
+	return gjc.mutation
+}
+
+// Save creates the GrantJob in the database.
+func (gjc *GrantJobCreate) Save(ctx context.Context) (*GrantJob, error) {
+	gjc.defaults()
+	return withHooks(ctx, gjc.sqlSave, gjc.mutation, gjc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (gjc *GrantJobCreate) SaveX(ctx context.Context) *GrantJob {
+	v, err := gjc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gjc *GrantJobCreate) Exec(ctx context.Context) error {
+	_, err := gjc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gjc *GrantJobCreate) ExecX(ctx context.Context) {
+	if err := gjc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gjc *GrantJobCreate) defaults() {
+	if _, ok := gjc.mutation.Status(); !ok {
+		v := grantjob.DefaultStatus
+		gjc.mutation.SetStatus(v)
+	}
+	if _, ok := gjc.mutation.Total(); !ok {
+		v := grantjob.DefaultTotal
+		gjc.mutation.SetTotal(v)
+	}
+	if _, ok := gjc.mutation.Processed(); !ok {
+		v := grantjob.DefaultProcessed
+		gjc.mutation.SetProcessed(v)
+	}
+	if _, ok := gjc.mutation.Granted(); !ok {
+		v := grantjob.DefaultGranted
+		gjc.mutation.SetGranted(v)
+	}
+	if _, ok := gjc.mutation.Skipped(); !ok {
+		v := grantjob.DefaultSkipped
+		gjc.mutation.SetSkipped(v)
+	}
+	if _, ok := gjc.mutation.Failed(); !ok {
+		v := grantjob.DefaultFailed
+		gjc.mutation.SetFailed(v)
+	}
+	if _, ok := gjc.mutation.CreatedAt(); !ok {
+		v := grantjob.DefaultCreatedAt()
+		gjc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gjc *GrantJobCreate) check() error {
+	if _, ok := gjc.mutation.Campaign(); !ok {
+		return &ValidationError{Name: "campaign", err: errors.New(`ent: missing required field "GrantJob.campaign"`)}
+	}
+	if v, ok := gjc.mutation.Campaign(); ok {
+		if err := grantjob.CampaignValidator(v); err != nil {
+			return &ValidationError{Name: "campaign", err: fmt.Errorf(`ent: validator failed for field "GrantJob.campaign": %w`, err)}
+		}
+	}
+	if _, ok := gjc.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "GrantJob.item_id"`)}
+	}
+	if _, ok := gjc.mutation.CreatedByID(); !ok {
+		return &ValidationError{Name: "created_by_id", err: errors.New(`ent: missing required field "GrantJob.created_by_id"`)}
+	}
+	if _, ok := gjc.mutation.Target(); !ok {
+		return &ValidationError{Name: "target", err: errors.New(`ent: missing required field "GrantJob.target"`)}
+	}
+	if v, ok := gjc.mutation.Target(); ok {
+		if err := grantjob.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "GrantJob.target": %w`, err)}
+		}
+	}
+	if _, ok := gjc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "GrantJob.status"`)}
+	}
+	if v, ok := gjc.mutation.Status(); ok {
+		if err := grantjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "GrantJob.status": %w`, err)}
+		}
+	}
+	if _, ok := gjc.mutation.Total(); !ok {
+		return &ValidationError{Name: "total", err: errors.New(`ent: missing required field "GrantJob.total"`)}
+	}
+	if v, ok := gjc.mutation.Total(); ok {
+		if err := grantjob.TotalValidator(v); err != nil {
+			return &ValidationError{Name: "total", err: fmt.Errorf(`ent: validator failed for field "GrantJob.total": %w`, err)}
+		}
+	}
+	if _, ok := gjc.mutation.Processed(); !ok {
+		return &ValidationError{Name: "processed", err: errors.New(`ent: missing required field "GrantJob.processed"`)}
+	}
+	if v, ok := gjc.mutation.Processed(); ok {
+		if err := grantjob.ProcessedValidator(v); err != nil {
+			return &ValidationError{Name: "processed", err: fmt.Errorf(`ent: validator failed for field "GrantJob.processed": %w`, err)}
+		}
+	}
+	if _, ok := gjc.mutation.Granted(); !ok {
+		return &ValidationError{Name: "granted", err: errors.New(`ent: missing required field "GrantJob.granted"`)}
+	}
+	if v, ok := gjc.mutation.Granted(); ok {
+		if err := grantjob.GrantedValidator(v); err != nil {
+			return &ValidationError{Name: "granted", err: fmt.Errorf(`ent: validator failed for field "GrantJob.granted": %w`, err)}
+		}
+	}
+	if _, ok := gjc.mutation.Skipped(); !ok {
+		return &ValidationError{Name: "skipped", err: errors.New(`ent: missing required field "GrantJob.skipped"`)}
+	}
+	if v, ok := gjc.mutation.Skipped(); ok {
+		if err := grantjob.SkippedValidator(v); err != nil {
+			return &ValidationError{Name: "skipped", err: fmt.Errorf(`ent: validator failed for field "GrantJob.skipped": %w`, err)}
+		}
+	}
+	if _, ok := gjc.mutation.Failed(); !ok {
+		return &ValidationError{Name: "failed", err: errors.New(`ent: missing required field "GrantJob.failed"`)}
+	}
+	if v, ok := gjc.mutation.Failed(); ok {
+		if err := grantjob.FailedValidator(v); err != nil {
+			return &ValidationError{Name: "failed", err: fmt.Errorf(`ent: validator failed for field "GrantJob.failed": %w`, err)}
+		}
+	}
+	if _, ok := gjc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "GrantJob.created_at"`)}
+	}
+	return nil
+}
+
+func (gjc *GrantJobCreate) sqlSave(ctx context.Context) (*GrantJob, error) {
+	if err := gjc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := gjc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, gjc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	gjc.mutation.id = &_node.ID
+	gjc.mutation.done = true
+	return _node, nil
+}
+
+func (gjc *GrantJobCreate) createSpec() (*GrantJob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GrantJob{config: gjc.config}
+		_spec = sqlgraph.NewCreateSpec(grantjob.Table, sqlgraph.NewFieldSpec(grantjob.FieldID, field.TypeInt))
+	)
+	if id, ok := gjc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := gjc.mutation.Campaign(); ok {
+		_spec.SetField(grantjob.FieldCampaign, field.TypeString, value)
+		_node.Campaign = value
+	}
+	if value, ok := gjc.mutation.ItemID(); ok {
+		_spec.SetField(grantjob.FieldItemID, field.TypeInt, value)
+		_node.ItemID = value
+	}
+	if value, ok := gjc.mutation.CreatedByID(); ok {
+		_spec.SetField(grantjob.FieldCreatedByID, field.TypeInt, value)
+		_node.CreatedByID = value
+	}
+	if value, ok := gjc.mutation.Target(); ok {
+		_spec.SetField(grantjob.FieldTarget, field.TypeEnum, value)
+		_node.Target = value
+	}
+	if value, ok := gjc.mutation.Status(); ok {
+		_spec.SetField(grantjob.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := gjc.mutation.Total(); ok {
+		_spec.SetField(grantjob.FieldTotal, field.TypeInt, value)
+		_node.Total = value
+	}
+	if value, ok := gjc.mutation.Processed(); ok {
+		_spec.SetField(grantjob.FieldProcessed, field.TypeInt, value)
+		_node.Processed = value
+	}
+	if value, ok := gjc.mutation.Granted(); ok {
+		_spec.SetField(grantjob.FieldGranted, field.TypeInt, value)
+		_node.Granted = value
+	}
+	if value, ok := gjc.mutation.Skipped(); ok {
+		_spec.SetField(grantjob.FieldSkipped, field.TypeInt, value)
+		_node.Skipped = value
+	}
+	if value, ok := gjc.mutation.Failed(); ok {
+		_spec.SetField(grantjob.FieldFailed, field.TypeInt, value)
+		_node.Failed = value
+	}
+	if value, ok := gjc.mutation.Error(); ok {
+		_spec.SetField(grantjob.FieldError, field.TypeString, value)
+		_node.Error = &value
+	}
+	if value, ok := gjc.mutation.Owner(); ok {
+		_spec.SetField(grantjob.FieldOwner, field.TypeString, value)
+		_node.Owner = &value
+	}
+	if value, ok := gjc.mutation.HeartbeatAt(); ok {
+		_spec.SetField(grantjob.FieldHeartbeatAt, field.TypeTime, value)
+		_node.HeartbeatAt = &value
+	}
+	if value, ok := gjc.mutation.CreatedAt(); ok {
+		_spec.SetField(grantjob.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := gjc.mutation.FinishedAt(); ok {
+		_spec.SetField(grantjob.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	return _node, _spec
+}
+
+// GrantJobCreateBulk is the builder for creating many GrantJob entities in bulk.
+type GrantJobCreateBulk struct {
+	config
+	err      error
+	builders []*GrantJobCreate
+}
+
+// Save creates the GrantJob entities in the database.
+func (gjcb *GrantJobCreateBulk) Save(ctx context.Context) ([]*GrantJob, error) {
+	if gjcb.err != nil {
+		return nil, gjcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(gjcb.builders))
+	nodes := make([]*GrantJob, len(gjcb.builders))
+	mutators := make([]Mutator, len(gjcb.builders))
+	for i := range gjcb.builders {
+		func(i int, root context.Context) {
+			builder := gjcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GrantJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, gjcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, gjcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, gjcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gjcb *GrantJobCreateBulk) SaveX(ctx context.Context) []*GrantJob {
+	v, err := gjcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gjcb *GrantJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := gjcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gjcb *GrantJobCreateBulk) ExecX(ctx context.Context) {
+	if err := gjcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/grantjob"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+)
+
+// GrantJobDelete is the builder for deleting a GrantJob entity.
+type GrantJobDelete struct {
+	config
+	hooks    []Hook
+	mutation *GrantJobMutation
+}
+
+// Where appends a list predicates to the GrantJobDelete builder.
+func (gjd *GrantJobDelete) Where(ps ...predicate.GrantJob) *GrantJobDelete {
+	gjd.mutation.Where(ps...)
+	return gjd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (gjd *GrantJobDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, gjd.sqlExec, gjd.mutation, gjd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gjd *GrantJobDelete) ExecX(ctx context.Context) int {
+	n, err := gjd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (gjd *GrantJobDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(grantjob.Table, sqlgraph.NewFieldSpec(grantjob.FieldID, field.TypeInt))
+	if ps := gjd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, gjd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	gjd.mutation.done = true
+	return affected, err
+}
+
+// GrantJobDeleteOne is the builder for deleting a single GrantJob entity.
+type GrantJobDeleteOne struct {
+	gjd *GrantJobDelete
+}
+
+// Where appends a list predicates to the GrantJobDelete builder.
+func (gjdo *GrantJobDeleteOne) Where(ps ...predicate.GrantJob) *GrantJobDeleteOne {
+	gjdo.gjd.mutation.Where(ps...)
+	return gjdo
+}
+
+// Exec executes the deletion query.
+func (gjdo *GrantJobDeleteOne) Exec(ctx context.Context) error {
+	n, err := gjdo.gjd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{grantjob.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gjdo *GrantJobDeleteOne) ExecX(ctx context.Context) {
+	if err := gjdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
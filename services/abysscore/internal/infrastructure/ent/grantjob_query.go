@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/grantjob"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+)
+
+// GrantJobQuery is the builder for querying GrantJob entities.
+type GrantJobQuery struct {
+	config
+	ctx        *QueryContext
+	order      []grantjob.OrderOption
+	inters     []Interceptor
+	predicates []predicate.GrantJob
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the GrantJobQuery builder.
+func (gjq *GrantJobQuery) Where(ps ...predicate.GrantJob) *GrantJobQuery {
+	gjq.predicates = append(gjq.predicates, ps...)
+	return gjq
+}
+
+// Limit the number of records to be returned by this query.
+func (gjq *GrantJobQuery) Limit(limit int) *GrantJobQuery {
+	gjq.ctx.Limit = &limit
+	return gjq
+}
+
+// Offset to start from.
+func (gjq *GrantJobQuery) Offset(offset int) *GrantJobQuery {
+	gjq.ctx.Offset = &offset
+	return gjq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (gjq *GrantJobQuery) Unique(unique bool) *GrantJobQuery {
+	gjq.ctx.Unique = &unique
+	return gjq
+}
+
+// Order specifies how the records should be ordered.
+func (gjq *GrantJobQuery) Order(o ...grantjob.OrderOption) *GrantJobQuery {
+	gjq.order = append(gjq.order, o...)
+	return gjq
+}
+
+// First returns the first GrantJob entity from the query.
+// Returns a *NotFoundError when no GrantJob was found.
+func (gjq *GrantJobQuery) First(ctx context.Context) (*GrantJob, error) {
+	nodes, err := gjq.Limit(1).All(setContextOp(ctx, gjq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{grantjob.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (gjq *GrantJobQuery) FirstX(ctx context.Context) *GrantJob {
+	node, err := gjq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first GrantJob ID from the query.
+// Returns a *NotFoundError when no GrantJob ID was found.
+func (gjq *GrantJobQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = gjq.Limit(1).IDs(setContextOp(ctx, gjq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{grantjob.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (gjq *GrantJobQuery) FirstIDX(ctx context.Context) int {
+	id, err := gjq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single GrantJob entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one GrantJob entity is found.
+// Returns a *NotFoundError when no GrantJob entities are found.
+func (gjq *GrantJobQuery) Only(ctx context.Context) (*GrantJob, error) {
+	nodes, err := gjq.Limit(2).All(setContextOp(ctx, gjq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{grantjob.Label}
+	default:
+		return nil, &NotSingularError{grantjob.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (gjq *GrantJobQuery) OnlyX(ctx context.Context) *GrantJob {
+	node, err := gjq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only GrantJob ID in the query.
+// Returns a *NotSingularError when more than one GrantJob ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (gjq *GrantJobQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = gjq.Limit(2).IDs(setContextOp(ctx, gjq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{grantjob.Label}
+	default:
+		err = &NotSingularError{grantjob.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (gjq *GrantJobQuery) OnlyIDX(ctx context.Context) int {
+	id, err := gjq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of GrantJobs.
+func (gjq *GrantJobQuery) All(ctx context.Context) ([]*GrantJob, error) {
+	ctx = setContextOp(ctx, gjq.ctx, ent.OpQueryAll)
+	if err := gjq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*GrantJob, *GrantJobQuery]()
+	return withInterceptors[[]*GrantJob](ctx, gjq, qr, gjq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (gjq *GrantJobQuery) AllX(ctx context.Context) []*GrantJob {
+	nodes, err := gjq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of GrantJob IDs.
+func (gjq *GrantJobQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if gjq.ctx.Unique == nil && gjq.path != nil {
+		gjq.Unique(true)
+	}
+	ctx = setContextOp(ctx, gjq.ctx, ent.OpQueryIDs)
+	if err = gjq.Select(grantjob.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (gjq *GrantJobQuery) IDsX(ctx context.Context) []int {
+	ids, err := gjq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (gjq *GrantJobQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, gjq.ctx, ent.OpQueryCount)
+	if err := gjq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, gjq, querierCount[*GrantJobQuery](), gjq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (gjq *GrantJobQuery) CountX(ctx context.Context) int {
+	count, err := gjq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (gjq *GrantJobQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, gjq.ctx, ent.OpQueryExist)
+	switch _, err := gjq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (gjq *GrantJobQuery) ExistX(ctx context.Context) bool {
+	exist, err := gjq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the GrantJobQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (gjq *GrantJobQuery) Clone() *GrantJobQuery {
+	if gjq == nil {
+		return nil
+	}
+	return &GrantJobQuery{
+		config:     gjq.config,
+		ctx:        gjq.ctx.Clone(),
+		order:      append([]grantjob.OrderOption{}, gjq.order...),
+		inters:     append([]Interceptor{}, gjq.inters...),
+		predicates: append([]predicate.GrantJob{}, gjq.predicates...),
+		// clone intermediate query.
+		sql:  gjq.sql.Clone(),
+		path: gjq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Campaign string `json:"campaign,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.GrantJob.Query().
+//		GroupBy(grantjob.FieldCampaign).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (gjq *GrantJobQuery) GroupBy(field string, fields ...string) *GrantJobGroupBy {
+	gjq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &GrantJobGroupBy{build: gjq}
+	grbuild.flds = &gjq.ctx.Fields
+	grbuild.label = grantjob.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Campaign string `json:"campaign,omitempty"`
+//	}
+//
+//	client.GrantJob.Query().
+//		Select(grantjob.FieldCampaign).
+//		Scan(ctx, &v)
+func (gjq *GrantJobQuery) Select(fields ...string) *GrantJobSelect {
+	gjq.ctx.Fields = append(gjq.ctx.Fields, fields...)
+	sbuild := &GrantJobSelect{GrantJobQuery: gjq}
+	sbuild.label = grantjob.Label
+	sbuild.flds, sbuild.scan = &gjq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a GrantJobSelect configured with the given aggregations.
+func (gjq *GrantJobQuery) Aggregate(fns ...AggregateFunc) *GrantJobSelect {
+	return gjq.Select().Aggregate(fns...)
+}
+
+func (gjq *GrantJobQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range gjq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, gjq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range gjq.ctx.Fields {
+		if !grantjob.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if gjq.path != nil {
+		prev, err := gjq.path(ctx)
+		if err != nil {
+			return err
+		}
+		gjq.sql = prev
+	}
+	return nil
+}
+
+func (gjq *GrantJobQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*GrantJob, error) {
+	var (
+		nodes = []*GrantJob{}
+		_spec = gjq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*GrantJob).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &GrantJob{config: gjq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, gjq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (gjq *GrantJobQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := gjq.querySpec()
+	_spec.Node.Columns = gjq.ctx.Fields
+	if len(gjq.ctx.Fields) > 0 {
+		_spec.Unique = gjq.ctx.Unique != nil && *gjq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, gjq.driver, _spec)
+}
+
+func (gjq *GrantJobQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(grantjob.Table, grantjob.Columns, sqlgraph.NewFieldSpec(grantjob.FieldID, field.TypeInt))
+	_spec.From = gjq.sql
+	if unique := gjq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if gjq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := gjq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, grantjob.FieldID)
+		for i := range fields {
+			if fields[i] != grantjob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := gjq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := gjq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := gjq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := gjq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (gjq *GrantJobQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(gjq.driver.Dialect())
+	t1 := builder.Table(grantjob.Table)
+	columns := gjq.ctx.Fields
+	if len(columns) == 0 {
+		columns = grantjob.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if gjq.sql != nil {
+		selector = gjq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if gjq.ctx.Unique != nil && *gjq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range gjq.predicates {
+		p(selector)
+	}
+	for _, p := range gjq.order {
+		p(selector)
+	}
+	if offset := gjq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := gjq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// GrantJobGroupBy is the group-by builder for GrantJob entities.
+type GrantJobGroupBy struct {
+	selector
+	build *GrantJobQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (gjgb *GrantJobGroupBy) Aggregate(fns ...AggregateFunc) *GrantJobGroupBy {
+	gjgb.fns = append(gjgb.fns, fns...)
+	return gjgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (gjgb *GrantJobGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, gjgb.build.ctx, ent.OpQueryGroupBy)
+	if err := gjgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GrantJobQuery, *GrantJobGroupBy](ctx, gjgb.build, gjgb, gjgb.build.inters, v)
+}
+
+func (gjgb *GrantJobGroupBy) sqlScan(ctx context.Context, root *GrantJobQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(gjgb.fns))
+	for _, fn := range gjgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*gjgb.flds)+len(gjgb.fns))
+		for _, f := range *gjgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*gjgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gjgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// GrantJobSelect is the builder for selecting fields of GrantJob entities.
+type GrantJobSelect struct {
+	*GrantJobQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (gjs *GrantJobSelect) Aggregate(fns ...AggregateFunc) *GrantJobSelect {
+	gjs.fns = append(gjs.fns, fns...)
+	return gjs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (gjs *GrantJobSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, gjs.ctx, ent.OpQuerySelect)
+	if err := gjs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GrantJobQuery, *GrantJobSelect](ctx, gjs.GrantJobQuery, gjs, gjs.inters, v)
+}
+
+func (gjs *GrantJobSelect) sqlScan(ctx context.Context, root *GrantJobQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(gjs.fns))
+	for _, fn := range gjs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*gjs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gjs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,718 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/grantjob"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+)
+
+// GrantJobUpdate is the builder for updating GrantJob entities.
+type GrantJobUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GrantJobMutation
+}
+
+// Where appends a list predicates to the GrantJobUpdate builder.
+func (gju *GrantJobUpdate) Where(ps ...predicate.GrantJob) *GrantJobUpdate {
+	gju.mutation.Where(ps...)
+	return gju
+}
+
+// SetStatus sets the "status" field.
+func (gju *GrantJobUpdate) SetStatus(gr grantjob.Status) *GrantJobUpdate {
+	gju.mutation.SetStatus(gr)
+	return gju
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (gju *GrantJobUpdate) SetNillableStatus(gr *grantjob.Status) *GrantJobUpdate {
+	if gr != nil {
+		gju.SetStatus(*gr)
+	}
+	return gju
+}
+
+// SetTotal sets the "total" field.
+func (gju *GrantJobUpdate) SetTotal(i int) *GrantJobUpdate {
+	gju.mutation.ResetTotal()
+	gju.mutation.SetTotal(i)
+	return gju
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (gju *GrantJobUpdate) SetNillableTotal(i *int) *GrantJobUpdate {
+	if i != nil {
+		gju.SetTotal(*i)
+	}
+	return gju
+}
+
+// AddTotal adds i to the "total" field.
+func (gju *GrantJobUpdate) AddTotal(i int) *GrantJobUpdate {
+	gju.mutation.AddTotal(i)
+	return gju
+}
+
+// SetProcessed sets the "processed" field.
+func (gju *GrantJobUpdate) SetProcessed(i int) *GrantJobUpdate {
+	gju.mutation.ResetProcessed()
+	gju.mutation.SetProcessed(i)
+	return gju
+}
+
+// SetNillableProcessed sets the "processed" field if the given value is not nil.
+func (gju *GrantJobUpdate) SetNillableProcessed(i *int) *GrantJobUpdate {
+	if i != nil {
+		gju.SetProcessed(*i)
+	}
+	return gju
+}
+
+// AddProcessed adds i to the "processed" field.
+func (gju *GrantJobUpdate) AddProcessed(i int) *GrantJobUpdate {
+	gju.mutation.AddProcessed(i)
+	return gju
+}
+
+// SetGranted sets the "granted" field.
+func (gju *GrantJobUpdate) SetGranted(i int) *GrantJobUpdate {
+	gju.mutation.ResetGranted()
+	gju.mutation.SetGranted(i)
+	return gju
+}
+
+// SetNillableGranted sets the "granted" field if the given value is not nil.
+func (gju *GrantJobUpdate) SetNillableGranted(i *int) *GrantJobUpdate {
+	if i != nil {
+		gju.SetGranted(*i)
+	}
+	return gju
+}
+
+// AddGranted adds i to the "granted" field.
+func (gju *GrantJobUpdate) AddGranted(i int) *GrantJobUpdate {
+	gju.mutation.AddGranted(i)
+	return gju
+}
+
+// SetSkipped sets the "skipped" field.
+func (gju *GrantJobUpdate) SetSkipped(i int) *GrantJobUpdate {
+	gju.mutation.ResetSkipped()
+	gju.mutation.SetSkipped(i)
+	return gju
+}
+
+// SetNillableSkipped sets the "skipped" field if the given value is not nil.
+func (gju *GrantJobUpdate) SetNillableSkipped(i *int) *GrantJobUpdate {
+	if i != nil {
+		gju.SetSkipped(*i)
+	}
+	return gju
+}
+
+// AddSkipped adds i to the "skipped" field.
+func (gju *GrantJobUpdate) AddSkipped(i int) *GrantJobUpdate {
+	gju.mutation.AddSkipped(i)
+	return gju
+}
+
+// SetFailed sets the "failed" field.
+func (gju *GrantJobUpdate) SetFailed(i int) *GrantJobUpdate {
+	gju.mutation.ResetFailed()
+	gju.mutation.SetFailed(i)
+	return gju
+}
+
+// SetNillableFailed sets the "failed" field if the given value is not nil.
+func (gju *GrantJobUpdate) SetNillableFailed(i *int) *GrantJobUpdate {
+	if i != nil {
+		gju.SetFailed(*i)
+	}
+	return gju
+}
+
+// AddFailed adds i to the "failed" field.
+func (gju *GrantJobUpdate) AddFailed(i int) *GrantJobUpdate {
+	gju.mutation.AddFailed(i)
+	return gju
+}
+
+// SetError sets the "error" field.
+func (gju *GrantJobUpdate) SetError(s string) *GrantJobUpdate {
+	gju.mutation.SetError(s)
+	return gju
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (gju *GrantJobUpdate) SetNillableError(s *string) *GrantJobUpdate {
+	if s != nil {
+		gju.SetError(*s)
+	}
+	return gju
+}
+
+// ClearError clears the value of the "error" field.
+func (gju *GrantJobUpdate) ClearError() *GrantJobUpdate {
+	gju.mutation.ClearError()
+	return gju
+}
+
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (gju *GrantJobUpdate) SetHeartbeatAt(t time.Time) *GrantJobUpdate {
+	gju.mutation.SetHeartbeatAt(t)
+	return gju
+}
+
+// SetNillableHeartbeatAt sets the "heartbeat_at" field if the given value is not nil.
+func (gju *GrantJobUpdate) SetNillableHeartbeatAt(t *time.Time) *GrantJobUpdate {
+	if t != nil {
+		gju.SetHeartbeatAt(*t)
+	}
+	return gju
+}
+
+// ClearHeartbeatAt clears the value of the "heartbeat_at" field.
+func (gju *GrantJobUpdate) ClearHeartbeatAt() *GrantJobUpdate {
+	gju.mutation.ClearHeartbeatAt()
+	return gju
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (gju *GrantJobUpdate) SetFinishedAt(t time.Time) *GrantJobUpdate {
+	gju.mutation.SetFinishedAt(t)
+	return gju
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (gju *GrantJobUpdate) SetNillableFinishedAt(t *time.Time) *GrantJobUpdate {
+	if t != nil {
+		gju.SetFinishedAt(*t)
+	}
+	return gju
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (gju *GrantJobUpdate) ClearFinishedAt() *GrantJobUpdate {
+	gju.mutation.ClearFinishedAt()
+	return gju
+}
+
+// Mutation returns the GrantJobMutation object of the builder.
+func (gju *GrantJobUpdate) Mutation() *GrantJobMutation {
+	return gju.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (gju *GrantJobUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, gju.sqlSave, gju.mutation, gju.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gju *GrantJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := gju.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (gju *GrantJobUpdate) Exec(ctx context.Context) error {
+	_, err := gju.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gju *GrantJobUpdate) ExecX(ctx context.Context) {
+	if err := gju.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gju *GrantJobUpdate) check() error {
+	if v, ok := gju.mutation.Status(); ok {
+		if err := grantjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "GrantJob.status": %w`, err)}
+		}
+	}
+	if v, ok := gju.mutation.Total(); ok {
+		if err := grantjob.TotalValidator(v); err != nil {
+			return &ValidationError{Name: "total", err: fmt.Errorf(`ent: validator failed for field "GrantJob.total": %w`, err)}
+		}
+	}
+	if v, ok := gju.mutation.Processed(); ok {
+		if err := grantjob.ProcessedValidator(v); err != nil {
+			return &ValidationError{Name: "processed", err: fmt.Errorf(`ent: validator failed for field "GrantJob.processed": %w`, err)}
+		}
+	}
+	if v, ok := gju.mutation.Granted(); ok {
+		if err := grantjob.GrantedValidator(v); err != nil {
+			return &ValidationError{Name: "granted", err: fmt.Errorf(`ent: validator failed for field "GrantJob.granted": %w`, err)}
+		}
+	}
+	if v, ok := gju.mutation.Skipped(); ok {
+		if err := grantjob.SkippedValidator(v); err != nil {
+			return &ValidationError{Name: "skipped", err: fmt.Errorf(`ent: validator failed for field "GrantJob.skipped": %w`, err)}
+		}
+	}
+	if v, ok := gju.mutation.Failed(); ok {
+		if err := grantjob.FailedValidator(v); err != nil {
+			return &ValidationError{Name: "failed", err: fmt.Errorf(`ent: validator failed for field "GrantJob.failed": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (gju *GrantJobUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := gju.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(grantjob.Table, grantjob.Columns, sqlgraph.NewFieldSpec(grantjob.FieldID, field.TypeInt))
+	if ps := gju.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gju.mutation.Status(); ok {
+		_spec.SetField(grantjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := gju.mutation.Total(); ok {
+		_spec.SetField(grantjob.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := gju.mutation.AddedTotal(); ok {
+		_spec.AddField(grantjob.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := gju.mutation.Processed(); ok {
+		_spec.SetField(grantjob.FieldProcessed, field.TypeInt, value)
+	}
+	if value, ok := gju.mutation.AddedProcessed(); ok {
+		_spec.AddField(grantjob.FieldProcessed, field.TypeInt, value)
+	}
+	if value, ok := gju.mutation.Granted(); ok {
+		_spec.SetField(grantjob.FieldGranted, field.TypeInt, value)
+	}
+	if value, ok := gju.mutation.AddedGranted(); ok {
+		_spec.AddField(grantjob.FieldGranted, field.TypeInt, value)
+	}
+	if value, ok := gju.mutation.Skipped(); ok {
+		_spec.SetField(grantjob.FieldSkipped, field.TypeInt, value)
+	}
+	if value, ok := gju.mutation.AddedSkipped(); ok {
+		_spec.AddField(grantjob.FieldSkipped, field.TypeInt, value)
+	}
+	if value, ok := gju.mutation.Failed(); ok {
+		_spec.SetField(grantjob.FieldFailed, field.TypeInt, value)
+	}
+	if value, ok := gju.mutation.AddedFailed(); ok {
+		_spec.AddField(grantjob.FieldFailed, field.TypeInt, value)
+	}
+	if value, ok := gju.mutation.Error(); ok {
+		_spec.SetField(grantjob.FieldError, field.TypeString, value)
+	}
+	if gju.mutation.ErrorCleared() {
+		_spec.ClearField(grantjob.FieldError, field.TypeString)
+	}
+	if gju.mutation.OwnerCleared() {
+		_spec.ClearField(grantjob.FieldOwner, field.TypeString)
+	}
+	if value, ok := gju.mutation.HeartbeatAt(); ok {
+		_spec.SetField(grantjob.FieldHeartbeatAt, field.TypeTime, value)
+	}
+	if gju.mutation.HeartbeatAtCleared() {
+		_spec.ClearField(grantjob.FieldHeartbeatAt, field.TypeTime)
+	}
+	if value, ok := gju.mutation.FinishedAt(); ok {
+		_spec.SetField(grantjob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if gju.mutation.FinishedAtCleared() {
+		_spec.ClearField(grantjob.FieldFinishedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, gju.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{grantjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	gju.mutation.done = true
+	return n, nil
+}
+
+// GrantJobUpdateOne is the builder for updating a single GrantJob entity.
+type GrantJobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GrantJobMutation
+}
+
+// SetStatus sets the "status" field.
+func (gjuo *GrantJobUpdateOne) SetStatus(gr grantjob.Status) *GrantJobUpdateOne {
+	gjuo.mutation.SetStatus(gr)
+	return gjuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (gjuo *GrantJobUpdateOne) SetNillableStatus(gr *grantjob.Status) *GrantJobUpdateOne {
+	if gr != nil {
+		gjuo.SetStatus(*gr)
+	}
+	return gjuo
+}
+
+// SetTotal sets the "total" field.
+func (gjuo *GrantJobUpdateOne) SetTotal(i int) *GrantJobUpdateOne {
+	gjuo.mutation.ResetTotal()
+	gjuo.mutation.SetTotal(i)
+	return gjuo
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (gjuo *GrantJobUpdateOne) SetNillableTotal(i *int) *GrantJobUpdateOne {
+	if i != nil {
+		gjuo.SetTotal(*i)
+	}
+	return gjuo
+}
+
+// AddTotal adds i to the "total" field.
+func (gjuo *GrantJobUpdateOne) AddTotal(i int) *GrantJobUpdateOne {
+	gjuo.mutation.AddTotal(i)
+	return gjuo
+}
+
+// SetProcessed sets the "processed" field.
+func (gjuo *GrantJobUpdateOne) SetProcessed(i int) *GrantJobUpdateOne {
+	gjuo.mutation.ResetProcessed()
+	gjuo.mutation.SetProcessed(i)
+	return gjuo
+}
+
+// SetNillableProcessed sets the "processed" field if the given value is not nil.
+func (gjuo *GrantJobUpdateOne) SetNillableProcessed(i *int) *GrantJobUpdateOne {
+	if i != nil {
+		gjuo.SetProcessed(*i)
+	}
+	return gjuo
+}
+
+// AddProcessed adds i to the "processed" field.
+func (gjuo *GrantJobUpdateOne) AddProcessed(i int) *GrantJobUpdateOne {
+	gjuo.mutation.AddProcessed(i)
+	return gjuo
+}
+
+// SetGranted sets the "granted" field.
+func (gjuo *GrantJobUpdateOne) SetGranted(i int) *GrantJobUpdateOne {
+	gjuo.mutation.ResetGranted()
+	gjuo.mutation.SetGranted(i)
+	return gjuo
+}
+
+// SetNillableGranted sets the "granted" field if the given value is not nil.
+func (gjuo *GrantJobUpdateOne) SetNillableGranted(i *int) *GrantJobUpdateOne {
+	if i != nil {
+		gjuo.SetGranted(*i)
+	}
+	return gjuo
+}
+
+// AddGranted adds i to the "granted" field.
+func (gjuo *GrantJobUpdateOne) AddGranted(i int) *GrantJobUpdateOne {
+	gjuo.mutation.AddGranted(i)
+	return gjuo
+}
+
+// SetSkipped sets the "skipped" field.
+func (gjuo *GrantJobUpdateOne) SetSkipped(i int) *GrantJobUpdateOne {
+	gjuo.mutation.ResetSkipped()
+	gjuo.mutation.SetSkipped(i)
+	return gjuo
+}
+
+// SetNillableSkipped sets the "skipped" field if the given value is not nil.
+func (gjuo *GrantJobUpdateOne) SetNillableSkipped(i *int) *GrantJobUpdateOne {
+	if i != nil {
+		gjuo.SetSkipped(*i)
+	}
+	return gjuo
+}
+
+// AddSkipped adds i to the "skipped" field.
+func (gjuo *GrantJobUpdateOne) AddSkipped(i int) *GrantJobUpdateOne {
+	gjuo.mutation.AddSkipped(i)
+	return gjuo
+}
+
+// SetFailed sets the "failed" field.
+func (gjuo *GrantJobUpdateOne) SetFailed(i int) *GrantJobUpdateOne {
+	gjuo.mutation.ResetFailed()
+	gjuo.mutation.SetFailed(i)
+	return gjuo
+}
+
+// SetNillableFailed sets the "failed" field if the given value is not nil.
+func (gjuo *GrantJobUpdateOne) SetNillableFailed(i *int) *GrantJobUpdateOne {
+	if i != nil {
+		gjuo.SetFailed(*i)
+	}
+	return gjuo
+}
+
+// AddFailed adds i to the "failed" field.
+func (gjuo *GrantJobUpdateOne) AddFailed(i int) *GrantJobUpdateOne {
+	gjuo.mutation.AddFailed(i)
+	return gjuo
+}
+
+// SetError sets the "error" field.
+func (gjuo *GrantJobUpdateOne) SetError(s string) *GrantJobUpdateOne {
+	gjuo.mutation.SetError(s)
+	return gjuo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (gjuo *GrantJobUpdateOne) SetNillableError(s *string) *GrantJobUpdateOne {
+	if s != nil {
+		gjuo.SetError(*s)
+	}
+	return gjuo
+}
+
+// ClearError clears the value of the "error" field.
+func (gjuo *GrantJobUpdateOne) ClearError() *GrantJobUpdateOne {
+	gjuo.mutation.ClearError()
+	return gjuo
+}
+
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (gjuo *GrantJobUpdateOne) SetHeartbeatAt(t time.Time) *GrantJobUpdateOne {
+	gjuo.mutation.SetHeartbeatAt(t)
+	return gjuo
+}
+
+// SetNillableHeartbeatAt sets the "heartbeat_at" field if the given value is not nil.
+func (gjuo *GrantJobUpdateOne) SetNillableHeartbeatAt(t *time.Time) *GrantJobUpdateOne {
+	if t != nil {
+		gjuo.SetHeartbeatAt(*t)
+	}
+	return gjuo
+}
+
+// ClearHeartbeatAt clears the value of the "heartbeat_at" field.
+func (gjuo *GrantJobUpdateOne) ClearHeartbeatAt() *GrantJobUpdateOne {
+	gjuo.mutation.ClearHeartbeatAt()
+	return gjuo
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (gjuo *GrantJobUpdateOne) SetFinishedAt(t time.Time) *GrantJobUpdateOne {
+	gjuo.mutation.SetFinishedAt(t)
+	return gjuo
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (gjuo *GrantJobUpdateOne) SetNillableFinishedAt(t *time.Time) *GrantJobUpdateOne {
+	if t != nil {
+		gjuo.SetFinishedAt(*t)
+	}
+	return gjuo
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (gjuo *GrantJobUpdateOne) ClearFinishedAt() *GrantJobUpdateOne {
+	gjuo.mutation.ClearFinishedAt()
+	return gjuo
+}
+
+// Mutation returns the GrantJobMutation object of the builder.
+func (gjuo *GrantJobUpdateOne) Mutation() *GrantJobMutation {
+	return gjuo.mutation
+}
+
+// Where appends a list predicates to the GrantJobUpdate builder.
+func (gjuo *GrantJobUpdateOne) Where(ps ...predicate.GrantJob) *GrantJobUpdateOne {
+	gjuo.mutation.Where(ps...)
+	return gjuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (gjuo *GrantJobUpdateOne) Select(field string, fields ...string) *GrantJobUpdateOne {
+	gjuo.fields = append([]string{field}, fields...)
+	return gjuo
+}
+
+// Save executes the query and returns the updated GrantJob entity.
+func (gjuo *GrantJobUpdateOne) Save(ctx context.Context) (*GrantJob, error) {
+	return withHooks(ctx, gjuo.sqlSave, gjuo.mutation, gjuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gjuo *GrantJobUpdateOne) SaveX(ctx context.Context) *GrantJob {
+	node, err := gjuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (gjuo *GrantJobUpdateOne) Exec(ctx context.Context) error {
+	_, err := gjuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gjuo *GrantJobUpdateOne) ExecX(ctx context.Context) {
+	if err := gjuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gjuo *GrantJobUpdateOne) check() error {
+	if v, ok := gjuo.mutation.Status(); ok {
+		if err := grantjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "GrantJob.status": %w`, err)}
+		}
+	}
+	if v, ok := gjuo.mutation.Total(); ok {
+		if err := grantjob.TotalValidator(v); err != nil {
+			return &ValidationError{Name: "total", err: fmt.Errorf(`ent: validator failed for field "GrantJob.total": %w`, err)}
+		}
+	}
+	if v, ok := gjuo.mutation.Processed(); ok {
+		if err := grantjob.ProcessedValidator(v); err != nil {
+			return &ValidationError{Name: "processed", err: fmt.Errorf(`ent: validator failed for field "GrantJob.processed": %w`, err)}
+		}
+	}
+	if v, ok := gjuo.mutation.Granted(); ok {
+		if err := grantjob.GrantedValidator(v); err != nil {
+			return &ValidationError{Name: "granted", err: fmt.Errorf(`ent: validator failed for field "GrantJob.granted": %w`, err)}
+		}
+	}
+	if v, ok := gjuo.mutation.Skipped(); ok {
+		if err := grantjob.SkippedValidator(v); err != nil {
+			return &ValidationError{Name: "skipped", err: fmt.Errorf(`ent: validator failed for field "GrantJob.skipped": %w`, err)}
+		}
+	}
+	if v, ok := gjuo.mutation.Failed(); ok {
+		if err := grantjob.FailedValidator(v); err != nil {
+			return &ValidationError{Name: "failed", err: fmt.Errorf(`ent: validator failed for field "GrantJob.failed": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (gjuo *GrantJobUpdateOne) sqlSave(ctx context.Context) (_node *GrantJob, err error) {
+	if err := gjuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(grantjob.Table, grantjob.Columns, sqlgraph.NewFieldSpec(grantjob.FieldID, field.TypeInt))
+	id, ok := gjuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GrantJob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := gjuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, grantjob.FieldID)
+		for _, f := range fields {
+			if !grantjob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != grantjob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := gjuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gjuo.mutation.Status(); ok {
+		_spec.SetField(grantjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := gjuo.mutation.Total(); ok {
+		_spec.SetField(grantjob.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := gjuo.mutation.AddedTotal(); ok {
+		_spec.AddField(grantjob.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := gjuo.mutation.Processed(); ok {
+		_spec.SetField(grantjob.FieldProcessed, field.TypeInt, value)
+	}
+	if value, ok := gjuo.mutation.AddedProcessed(); ok {
+		_spec.AddField(grantjob.FieldProcessed, field.TypeInt, value)
+	}
+	if value, ok := gjuo.mutation.Granted(); ok {
+		_spec.SetField(grantjob.FieldGranted, field.TypeInt, value)
+	}
+	if value, ok := gjuo.mutation.AddedGranted(); ok {
+		_spec.AddField(grantjob.FieldGranted, field.TypeInt, value)
+	}
+	if value, ok := gjuo.mutation.Skipped(); ok {
+		_spec.SetField(grantjob.FieldSkipped, field.TypeInt, value)
+	}
+	if value, ok := gjuo.mutation.AddedSkipped(); ok {
+		_spec.AddField(grantjob.FieldSkipped, field.TypeInt, value)
+	}
+	if value, ok := gjuo.mutation.Failed(); ok {
+		_spec.SetField(grantjob.FieldFailed, field.TypeInt, value)
+	}
+	if value, ok := gjuo.mutation.AddedFailed(); ok {
+		_spec.AddField(grantjob.FieldFailed, field.TypeInt, value)
+	}
+	if value, ok := gjuo.mutation.Error(); ok {
+		_spec.SetField(grantjob.FieldError, field.TypeString, value)
+	}
+	if gjuo.mutation.ErrorCleared() {
+		_spec.ClearField(grantjob.FieldError, field.TypeString)
+	}
+	if gjuo.mutation.OwnerCleared() {
+		_spec.ClearField(grantjob.FieldOwner, field.TypeString)
+	}
+	if value, ok := gjuo.mutation.HeartbeatAt(); ok {
+		_spec.SetField(grantjob.FieldHeartbeatAt, field.TypeTime, value)
+	}
+	if gjuo.mutation.HeartbeatAtCleared() {
+		_spec.ClearField(grantjob.FieldHeartbeatAt, field.TypeTime)
+	}
+	if value, ok := gjuo.mutation.FinishedAt(); ok {
+		_spec.SetField(grantjob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if gjuo.mutation.FinishedAtCleared() {
+		_spec.ClearField(grantjob.FieldFinishedAt, field.TypeTime)
+	}
+	_node = &GrantJob{config: gjuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, gjuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{grantjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	gjuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/hardwareidreset"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
+)
+
+// HardwareIDReset is the model entity for the HardwareIDReset schema.
+type HardwareIDReset struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status hardwareidreset.Status `json:"status,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason *string `json:"reason,omitempty"`
+	// OperatorID holds the value of the "operator_id" field.
+	OperatorID *int `json:"operator_id,omitempty"`
+	// OperatorComment holds the value of the "operator_comment" field.
+	OperatorComment *string `json:"operator_comment,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ResolvedAt holds the value of the "resolved_at" field.
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the HardwareIDResetQuery when eager-loading is set.
+	Edges        HardwareIDResetEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// HardwareIDResetEdges holds the relations/edges for other nodes in the graph.
+type HardwareIDResetEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HardwareIDResetEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*HardwareIDReset) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case hardwareidreset.FieldID, hardwareidreset.FieldUserID, hardwareidreset.FieldOperatorID:
+			values[i] = new(sql.NullInt64)
+		case hardwareidreset.FieldStatus, hardwareidreset.FieldReason, hardwareidreset.FieldOperatorComment:
+			values[i] = new(sql.NullString)
+		case hardwareidreset.FieldCreatedAt, hardwareidreset.FieldResolvedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the HardwareIDReset fields.
+func (hir *HardwareIDReset) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case hardwareidreset.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			hir.ID = int(value.Int64)
+		case hardwareidreset.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				hir.UserID = int(value.Int64)
+			}
+		case hardwareidreset.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				hir.Status = hardwareidreset.Status(value.String)
+			}
+		case hardwareidreset.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				hir.Reason = new(string)
+				*hir.Reason = value.String
+			}
+		case hardwareidreset.FieldOperatorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field operator_id", values[i])
+			} else if value.Valid {
+				hir.OperatorID = new(int)
+				*hir.OperatorID = int(value.Int64)
+			}
+		case hardwareidreset.FieldOperatorComment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field operator_comment", values[i])
+			} else if value.Valid {
+				hir.OperatorComment = new(string)
+				*hir.OperatorComment = value.String
+			}
+		case hardwareidreset.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				hir.CreatedAt = value.Time
+			}
+		case hardwareidreset.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				hir.ResolvedAt = new(time.Time)
+				*hir.ResolvedAt = value.Time
+			}
+		default:
+			hir.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the HardwareIDReset.
+// This includes values selected through modifiers, order, etc.
+func (hir *HardwareIDReset) Value(name string) (ent.Value, error) {
+	return hir.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the HardwareIDReset entity.
+func (hir *HardwareIDReset) QueryUser() *UserQuery {
+	return NewHardwareIDResetClient(hir.config).QueryUser(hir)
+}
+
+// Update returns a builder for updating this HardwareIDReset.
+// Note that you need to call HardwareIDReset.Unwrap() before calling this method if this HardwareIDReset
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (hir *HardwareIDReset) Update() *HardwareIDResetUpdateOne {
+	return NewHardwareIDResetClient(hir.config).UpdateOne(hir)
+}
+
+// Unwrap unwraps the HardwareIDReset entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (hir *HardwareIDReset) Unwrap() *HardwareIDReset {
+	_tx, ok := hir.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: HardwareIDReset is not a transactional entity")
+	}
+	hir.config.driver = _tx.drv
+	return hir
+}
+
+// String implements the fmt.Stringer.
+func (hir *HardwareIDReset) String() string {
+	var builder strings.Builder
+	builder.WriteString("HardwareIDReset(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", hir.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", hir.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", hir.Status))
+	builder.WriteString(", ")
+	if v := hir.Reason; v != nil {
+		builder.WriteString("reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := hir.OperatorID; v != nil {
+		builder.WriteString("operator_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := hir.OperatorComment; v != nil {
+		builder.WriteString("operator_comment=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(hir.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := hir.ResolvedAt; v != nil {
+		builder.WriteString("resolved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// HardwareIDResets is a parsable slice of HardwareIDReset.
+type HardwareIDResets []*HardwareIDReset
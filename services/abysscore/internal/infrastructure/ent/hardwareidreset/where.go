@@ -0,0 +1,454 @@
+// Code generated by ent, DO NOT EDIT.
+
+package hardwareidreset
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldEQ(FieldUserID, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldEQ(FieldReason, v))
+}
+
+// OperatorID applies equality check predicate on the "operator_id" field. It's identical to OperatorIDEQ.
+func OperatorID(v int) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldEQ(FieldOperatorID, v))
+}
+
+// OperatorComment applies equality check predicate on the "operator_comment" field. It's identical to OperatorCommentEQ.
+func OperatorComment(v string) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldEQ(FieldOperatorComment, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
+func ResolvedAt(v time.Time) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldContainsFold(FieldReason, v))
+}
+
+// OperatorIDEQ applies the EQ predicate on the "operator_id" field.
+func OperatorIDEQ(v int) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldEQ(FieldOperatorID, v))
+}
+
+// OperatorIDNEQ applies the NEQ predicate on the "operator_id" field.
+func OperatorIDNEQ(v int) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldNEQ(FieldOperatorID, v))
+}
+
+// OperatorIDIn applies the In predicate on the "operator_id" field.
+func OperatorIDIn(vs ...int) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldIn(FieldOperatorID, vs...))
+}
+
+// OperatorIDNotIn applies the NotIn predicate on the "operator_id" field.
+func OperatorIDNotIn(vs ...int) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldNotIn(FieldOperatorID, vs...))
+}
+
+// OperatorIDGT applies the GT predicate on the "operator_id" field.
+func OperatorIDGT(v int) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldGT(FieldOperatorID, v))
+}
+
+// OperatorIDGTE applies the GTE predicate on the "operator_id" field.
+func OperatorIDGTE(v int) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldGTE(FieldOperatorID, v))
+}
+
+// OperatorIDLT applies the LT predicate on the "operator_id" field.
+func OperatorIDLT(v int) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldLT(FieldOperatorID, v))
+}
+
+// OperatorIDLTE applies the LTE predicate on the "operator_id" field.
+func OperatorIDLTE(v int) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldLTE(FieldOperatorID, v))
+}
+
+// OperatorIDIsNil applies the IsNil predicate on the "operator_id" field.
+func OperatorIDIsNil() predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldIsNull(FieldOperatorID))
+}
+
+// OperatorIDNotNil applies the NotNil predicate on the "operator_id" field.
+func OperatorIDNotNil() predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldNotNull(FieldOperatorID))
+}
+
+// OperatorCommentEQ applies the EQ predicate on the "operator_comment" field.
+func OperatorCommentEQ(v string) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldEQ(FieldOperatorComment, v))
+}
+
+// OperatorCommentNEQ applies the NEQ predicate on the "operator_comment" field.
+func OperatorCommentNEQ(v string) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldNEQ(FieldOperatorComment, v))
+}
+
+// OperatorCommentIn applies the In predicate on the "operator_comment" field.
+func OperatorCommentIn(vs ...string) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldIn(FieldOperatorComment, vs...))
+}
+
+// OperatorCommentNotIn applies the NotIn predicate on the "operator_comment" field.
+func OperatorCommentNotIn(vs ...string) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldNotIn(FieldOperatorComment, vs...))
+}
+
+// OperatorCommentGT applies the GT predicate on the "operator_comment" field.
+func OperatorCommentGT(v string) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldGT(FieldOperatorComment, v))
+}
+
+// OperatorCommentGTE applies the GTE predicate on the "operator_comment" field.
+func OperatorCommentGTE(v string) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldGTE(FieldOperatorComment, v))
+}
+
+// OperatorCommentLT applies the LT predicate on the "operator_comment" field.
+func OperatorCommentLT(v string) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldLT(FieldOperatorComment, v))
+}
+
+// OperatorCommentLTE applies the LTE predicate on the "operator_comment" field.
+func OperatorCommentLTE(v string) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldLTE(FieldOperatorComment, v))
+}
+
+// OperatorCommentContains applies the Contains predicate on the "operator_comment" field.
+func OperatorCommentContains(v string) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldContains(FieldOperatorComment, v))
+}
+
+// OperatorCommentHasPrefix applies the HasPrefix predicate on the "operator_comment" field.
+func OperatorCommentHasPrefix(v string) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldHasPrefix(FieldOperatorComment, v))
+}
+
+// OperatorCommentHasSuffix applies the HasSuffix predicate on the "operator_comment" field.
+func OperatorCommentHasSuffix(v string) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldHasSuffix(FieldOperatorComment, v))
+}
+
+// OperatorCommentIsNil applies the IsNil predicate on the "operator_comment" field.
+func OperatorCommentIsNil() predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldIsNull(FieldOperatorComment))
+}
+
+// OperatorCommentNotNil applies the NotNil predicate on the "operator_comment" field.
+func OperatorCommentNotNil() predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldNotNull(FieldOperatorComment))
+}
+
+// OperatorCommentEqualFold applies the EqualFold predicate on the "operator_comment" field.
+func OperatorCommentEqualFold(v string) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldEqualFold(FieldOperatorComment, v))
+}
+
+// OperatorCommentContainsFold applies the ContainsFold predicate on the "operator_comment" field.
+func OperatorCommentContainsFold(v string) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldContainsFold(FieldOperatorComment, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
+func ResolvedAtEQ(v time.Time) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtNEQ applies the NEQ predicate on the "resolved_at" field.
+func ResolvedAtNEQ(v time.Time) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldNEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtIn applies the In predicate on the "resolved_at" field.
+func ResolvedAtIn(vs ...time.Time) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtNotIn applies the NotIn predicate on the "resolved_at" field.
+func ResolvedAtNotIn(vs ...time.Time) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldNotIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtGT applies the GT predicate on the "resolved_at" field.
+func ResolvedAtGT(v time.Time) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldGT(FieldResolvedAt, v))
+}
+
+// ResolvedAtGTE applies the GTE predicate on the "resolved_at" field.
+func ResolvedAtGTE(v time.Time) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldGTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtLT applies the LT predicate on the "resolved_at" field.
+func ResolvedAtLT(v time.Time) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldLT(FieldResolvedAt, v))
+}
+
+// ResolvedAtLTE applies the LTE predicate on the "resolved_at" field.
+func ResolvedAtLTE(v time.Time) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldLTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtIsNil applies the IsNil predicate on the "resolved_at" field.
+func ResolvedAtIsNil() predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldIsNull(FieldResolvedAt))
+}
+
+// ResolvedAtNotNil applies the NotNil predicate on the "resolved_at" field.
+func ResolvedAtNotNil() predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.FieldNotNull(FieldResolvedAt))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.HardwareIDReset) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.HardwareIDReset) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.HardwareIDReset) predicate.HardwareIDReset {
+	return predicate.HardwareIDReset(sql.NotPredicates(p))
+}
@@ -0,0 +1,351 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/hardwareidreset"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
+)
+
+// HardwareIDResetCreate is the builder for creating a HardwareIDReset entity.
+type HardwareIDResetCreate struct {
+	config
+	mutation *HardwareIDResetMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (hirc *HardwareIDResetCreate) SetUserID(i int) *HardwareIDResetCreate {
+	hirc.mutation.SetUserID(i)
+	return hirc
+}
+
+// SetStatus sets the "status" field.
+func (hirc *HardwareIDResetCreate) SetStatus(h hardwareidreset.Status) *HardwareIDResetCreate {
+	hirc.mutation.SetStatus(h)
+	return hirc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (hirc *HardwareIDResetCreate) SetNillableStatus(h *hardwareidreset.Status) *HardwareIDResetCreate {
+	if h != nil {
+		hirc.SetStatus(*h)
+	}
+	return hirc
+}
+
+// SetReason sets the "reason" field.
+func (hirc *HardwareIDResetCreate) SetReason(s string) *HardwareIDResetCreate {
+	hirc.mutation.SetReason(s)
+	return hirc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (hirc *HardwareIDResetCreate) SetNillableReason(s *string) *HardwareIDResetCreate {
+	if s != nil {
+		hirc.SetReason(*s)
+	}
+	return hirc
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (hirc *HardwareIDResetCreate) SetOperatorID(i int) *HardwareIDResetCreate {
+	hirc.mutation.SetOperatorID(i)
+	return hirc
+}
+
+// SetNillableOperatorID sets the "operator_id" field if the given value is not nil.
+func (hirc *HardwareIDResetCreate) SetNillableOperatorID(i *int) *HardwareIDResetCreate {
+	if i != nil {
+		hirc.SetOperatorID(*i)
+	}
+	return hirc
+}
+
+// SetOperatorComment sets the "operator_comment" field.
+func (hirc *HardwareIDResetCreate) SetOperatorComment(s string) *HardwareIDResetCreate {
+	hirc.mutation.SetOperatorComment(s)
+	return hirc
+}
+
+// SetNillableOperatorComment sets the "operator_comment" field if the given value is not nil.
+func (hirc *HardwareIDResetCreate) SetNillableOperatorComment(s *string) *HardwareIDResetCreate {
+	if s != nil {
+		hirc.SetOperatorComment(*s)
+	}
+	return hirc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (hirc *HardwareIDResetCreate) SetCreatedAt(t time.Time) *HardwareIDResetCreate {
+	hirc.mutation.SetCreatedAt(t)
+	return hirc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (hirc *HardwareIDResetCreate) SetNillableCreatedAt(t *time.Time) *HardwareIDResetCreate {
+	if t != nil {
+		hirc.SetCreatedAt(*t)
+	}
+	return hirc
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (hirc *HardwareIDResetCreate) SetResolvedAt(t time.Time) *HardwareIDResetCreate {
+	hirc.mutation.SetResolvedAt(t)
+	return hirc
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (hirc *HardwareIDResetCreate) SetNillableResolvedAt(t *time.Time) *HardwareIDResetCreate {
+	if t != nil {
+		hirc.SetResolvedAt(*t)
+	}
+	return hirc
+}
+
+// SetID sets the "id" field.
+func (hirc *HardwareIDResetCreate) SetID(i int) *HardwareIDResetCreate {
+	hirc.mutation.SetID(i)
+	return hirc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (hirc *HardwareIDResetCreate) SetUser(u *User) *HardwareIDResetCreate {
+	return hirc.SetUserID(u.ID)
+}
+
+// Mutation returns the HardwareIDResetMutation object of the builder.
+func (hirc *HardwareIDResetCreate) Mutation() *HardwareIDResetMutation {
+	return hirc.mutation
+}
+
+// Save creates the HardwareIDReset in the database.
+func (hirc *HardwareIDResetCreate) Save(ctx context.Context) (*HardwareIDReset, error) {
+	hirc.defaults()
+	return withHooks(ctx, hirc.sqlSave, hirc.mutation, hirc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (hirc *HardwareIDResetCreate) SaveX(ctx context.Context) *HardwareIDReset {
+	v, err := hirc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (hirc *HardwareIDResetCreate) Exec(ctx context.Context) error {
+	_, err := hirc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hirc *HardwareIDResetCreate) ExecX(ctx context.Context) {
+	if err := hirc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (hirc *HardwareIDResetCreate) defaults() {
+	if _, ok := hirc.mutation.Status(); !ok {
+		v := hardwareidreset.DefaultStatus
+		hirc.mutation.SetStatus(v)
+	}
+	if _, ok := hirc.mutation.CreatedAt(); !ok {
+		v := hardwareidreset.DefaultCreatedAt()
+		hirc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (hirc *HardwareIDResetCreate) check() error {
+	if _, ok := hirc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "HardwareIDReset.user_id"`)}
+	}
+	if _, ok := hirc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "HardwareIDReset.status"`)}
+	}
+	if v, ok := hirc.mutation.Status(); ok {
+		if err := hardwareidreset.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "HardwareIDReset.status": %w`, err)}
+		}
+	}
+	if _, ok := hirc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "HardwareIDReset.created_at"`)}
+	}
+	if len(hirc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "HardwareIDReset.user"`)}
+	}
+	return nil
+}
+
+func (hirc *HardwareIDResetCreate) sqlSave(ctx context.Context) (*HardwareIDReset, error) {
+	if err := hirc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := hirc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, hirc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	hirc.mutation.id = &_node.ID
+	hirc.mutation.done = true
+	return _node, nil
+}
+
+func (hirc *HardwareIDResetCreate) createSpec() (*HardwareIDReset, *sqlgraph.CreateSpec) {
+	var (
+		_node = &HardwareIDReset{config: hirc.config}
+		_spec = sqlgraph.NewCreateSpec(hardwareidreset.Table, sqlgraph.NewFieldSpec(hardwareidreset.FieldID, field.TypeInt))
+	)
+	if id, ok := hirc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := hirc.mutation.Status(); ok {
+		_spec.SetField(hardwareidreset.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := hirc.mutation.Reason(); ok {
+		_spec.SetField(hardwareidreset.FieldReason, field.TypeString, value)
+		_node.Reason = &value
+	}
+	if value, ok := hirc.mutation.OperatorID(); ok {
+		_spec.SetField(hardwareidreset.FieldOperatorID, field.TypeInt, value)
+		_node.OperatorID = &value
+	}
+	if value, ok := hirc.mutation.OperatorComment(); ok {
+		_spec.SetField(hardwareidreset.FieldOperatorComment, field.TypeString, value)
+		_node.OperatorComment = &value
+	}
+	if value, ok := hirc.mutation.CreatedAt(); ok {
+		_spec.SetField(hardwareidreset.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := hirc.mutation.ResolvedAt(); ok {
+		_spec.SetField(hardwareidreset.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = &value
+	}
+	if nodes := hirc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   hardwareidreset.UserTable,
+			Columns: []string{hardwareidreset.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// HardwareIDResetCreateBulk is the builder for creating many HardwareIDReset entities in bulk.
+type HardwareIDResetCreateBulk struct {
+	config
+	err      error
+	builders []*HardwareIDResetCreate
+}
+
+// Save creates the HardwareIDReset entities in the database.
+func (hircb *HardwareIDResetCreateBulk) Save(ctx context.Context) ([]*HardwareIDReset, error) {
+	if hircb.err != nil {
+		return nil, hircb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(hircb.builders))
+	nodes := make([]*HardwareIDReset, len(hircb.builders))
+	mutators := make([]Mutator, len(hircb.builders))
+	for i := range hircb.builders {
+		func(i int, root context.Context) {
+			builder := hircb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*HardwareIDResetMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, hircb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, hircb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, hircb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (hircb *HardwareIDResetCreateBulk) SaveX(ctx context.Context) []*HardwareIDReset {
+	v, err := hircb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (hircb *HardwareIDResetCreateBulk) Exec(ctx context.Context) error {
+	_, err := hircb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hircb *HardwareIDResetCreateBulk) ExecX(ctx context.Context) {
+	if err := hircb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
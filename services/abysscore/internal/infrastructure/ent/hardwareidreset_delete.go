@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/hardwareidreset"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+)
+
+// HardwareIDResetDelete is the builder for deleting a HardwareIDReset entity.
+type HardwareIDResetDelete struct {
+	config
+	hooks    []Hook
+	mutation *HardwareIDResetMutation
+}
+
+// Where appends a list predicates to the HardwareIDResetDelete builder.
+func (hird *HardwareIDResetDelete) Where(ps ...predicate.HardwareIDReset) *HardwareIDResetDelete {
+	hird.mutation.Where(ps...)
+	return hird
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (hird *HardwareIDResetDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, hird.sqlExec, hird.mutation, hird.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hird *HardwareIDResetDelete) ExecX(ctx context.Context) int {
+	n, err := hird.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (hird *HardwareIDResetDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(hardwareidreset.Table, sqlgraph.NewFieldSpec(hardwareidreset.FieldID, field.TypeInt))
+	if ps := hird.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, hird.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	hird.mutation.done = true
+	return affected, err
+}
+
+// HardwareIDResetDeleteOne is the builder for deleting a single HardwareIDReset entity.
+type HardwareIDResetDeleteOne struct {
+	hird *HardwareIDResetDelete
+}
+
+// Where appends a list predicates to the HardwareIDResetDelete builder.
+func (hirdo *HardwareIDResetDeleteOne) Where(ps ...predicate.HardwareIDReset) *HardwareIDResetDeleteOne {
+	hirdo.hird.mutation.Where(ps...)
+	return hirdo
+}
+
+// Exec executes the deletion query.
+func (hirdo *HardwareIDResetDeleteOne) Exec(ctx context.Context) error {
+	n, err := hirdo.hird.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{hardwareidreset.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hirdo *HardwareIDResetDeleteOne) ExecX(ctx context.Context) {
+	if err := hirdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/hardwareidreset"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
+)
+
+// HardwareIDResetQuery is the builder for querying HardwareIDReset entities.
+type HardwareIDResetQuery struct {
+	config
+	ctx        *QueryContext
+	order      []hardwareidreset.OrderOption
+	inters     []Interceptor
+	predicates []predicate.HardwareIDReset
+	withUser   *UserQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the HardwareIDResetQuery builder.
+func (hirq *HardwareIDResetQuery) Where(ps ...predicate.HardwareIDReset) *HardwareIDResetQuery {
+	hirq.predicates = append(hirq.predicates, ps...)
+	return hirq
+}
+
+// Limit the number of records to be returned by this query.
+func (hirq *HardwareIDResetQuery) Limit(limit int) *HardwareIDResetQuery {
+	hirq.ctx.Limit = &limit
+	return hirq
+}
+
+// Offset to start from.
+func (hirq *HardwareIDResetQuery) Offset(offset int) *HardwareIDResetQuery {
+	hirq.ctx.Offset = &offset
+	return hirq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (hirq *HardwareIDResetQuery) Unique(unique bool) *HardwareIDResetQuery {
+	hirq.ctx.Unique = &unique
+	return hirq
+}
+
+// Order specifies how the records should be ordered.
+func (hirq *HardwareIDResetQuery) Order(o ...hardwareidreset.OrderOption) *HardwareIDResetQuery {
+	hirq.order = append(hirq.order, o...)
+	return hirq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (hirq *HardwareIDResetQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: hirq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := hirq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := hirq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hardwareidreset.Table, hardwareidreset.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, hardwareidreset.UserTable, hardwareidreset.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(hirq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first HardwareIDReset entity from the query.
+// Returns a *NotFoundError when no HardwareIDReset was found.
+func (hirq *HardwareIDResetQuery) First(ctx context.Context) (*HardwareIDReset, error) {
+	nodes, err := hirq.Limit(1).All(setContextOp(ctx, hirq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{hardwareidreset.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (hirq *HardwareIDResetQuery) FirstX(ctx context.Context) *HardwareIDReset {
+	node, err := hirq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first HardwareIDReset ID from the query.
+// Returns a *NotFoundError when no HardwareIDReset ID was found.
+func (hirq *HardwareIDResetQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = hirq.Limit(1).IDs(setContextOp(ctx, hirq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{hardwareidreset.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (hirq *HardwareIDResetQuery) FirstIDX(ctx context.Context) int {
+	id, err := hirq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single HardwareIDReset entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one HardwareIDReset entity is found.
+// Returns a *NotFoundError when no HardwareIDReset entities are found.
+func (hirq *HardwareIDResetQuery) Only(ctx context.Context) (*HardwareIDReset, error) {
+	nodes, err := hirq.Limit(2).All(setContextOp(ctx, hirq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{hardwareidreset.Label}
+	default:
+		return nil, &NotSingularError{hardwareidreset.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (hirq *HardwareIDResetQuery) OnlyX(ctx context.Context) *HardwareIDReset {
+	node, err := hirq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only HardwareIDReset ID in the query.
+// Returns a *NotSingularError when more than one HardwareIDReset ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (hirq *HardwareIDResetQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = hirq.Limit(2).IDs(setContextOp(ctx, hirq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{hardwareidreset.Label}
+	default:
+		err = &NotSingularError{hardwareidreset.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (hirq *HardwareIDResetQuery) OnlyIDX(ctx context.Context) int {
+	id, err := hirq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of HardwareIDResets.
+func (hirq *HardwareIDResetQuery) All(ctx context.Context) ([]*HardwareIDReset, error) {
+	ctx = setContextOp(ctx, hirq.ctx, ent.OpQueryAll)
+	if err := hirq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*HardwareIDReset, *HardwareIDResetQuery]()
+	return withInterceptors[[]*HardwareIDReset](ctx, hirq, qr, hirq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (hirq *HardwareIDResetQuery) AllX(ctx context.Context) []*HardwareIDReset {
+	nodes, err := hirq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of HardwareIDReset IDs.
+func (hirq *HardwareIDResetQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if hirq.ctx.Unique == nil && hirq.path != nil {
+		hirq.Unique(true)
+	}
+	ctx = setContextOp(ctx, hirq.ctx, ent.OpQueryIDs)
+	if err = hirq.Select(hardwareidreset.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (hirq *HardwareIDResetQuery) IDsX(ctx context.Context) []int {
+	ids, err := hirq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (hirq *HardwareIDResetQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, hirq.ctx, ent.OpQueryCount)
+	if err := hirq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, hirq, querierCount[*HardwareIDResetQuery](), hirq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (hirq *HardwareIDResetQuery) CountX(ctx context.Context) int {
+	count, err := hirq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (hirq *HardwareIDResetQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, hirq.ctx, ent.OpQueryExist)
+	switch _, err := hirq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (hirq *HardwareIDResetQuery) ExistX(ctx context.Context) bool {
+	exist, err := hirq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the HardwareIDResetQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (hirq *HardwareIDResetQuery) Clone() *HardwareIDResetQuery {
+	if hirq == nil {
+		return nil
+	}
+	return &HardwareIDResetQuery{
+		config:     hirq.config,
+		ctx:        hirq.ctx.Clone(),
+		order:      append([]hardwareidreset.OrderOption{}, hirq.order...),
+		inters:     append([]Interceptor{}, hirq.inters...),
+		predicates: append([]predicate.HardwareIDReset{}, hirq.predicates...),
+		withUser:   hirq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  hirq.sql.Clone(),
+		path: hirq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (hirq *HardwareIDResetQuery) WithUser(opts ...func(*UserQuery)) *HardwareIDResetQuery {
+	query := (&UserClient{config: hirq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	hirq.withUser = query
+	return hirq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID int `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.HardwareIDReset.Query().
+//		GroupBy(hardwareidreset.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (hirq *HardwareIDResetQuery) GroupBy(field string, fields ...string) *HardwareIDResetGroupBy {
+	hirq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &HardwareIDResetGroupBy{build: hirq}
+	grbuild.flds = &hirq.ctx.Fields
+	grbuild.label = hardwareidreset.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID int `json:"user_id,omitempty"`
+//	}
+//
+//	client.HardwareIDReset.Query().
+//		Select(hardwareidreset.FieldUserID).
+//		Scan(ctx, &v)
+func (hirq *HardwareIDResetQuery) Select(fields ...string) *HardwareIDResetSelect {
+	hirq.ctx.Fields = append(hirq.ctx.Fields, fields...)
+	sbuild := &HardwareIDResetSelect{HardwareIDResetQuery: hirq}
+	sbuild.label = hardwareidreset.Label
+	sbuild.flds, sbuild.scan = &hirq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a HardwareIDResetSelect configured with the given aggregations.
+func (hirq *HardwareIDResetQuery) Aggregate(fns ...AggregateFunc) *HardwareIDResetSelect {
+	return hirq.Select().Aggregate(fns...)
+}
+
+func (hirq *HardwareIDResetQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range hirq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, hirq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range hirq.ctx.Fields {
+		if !hardwareidreset.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if hirq.path != nil {
+		prev, err := hirq.path(ctx)
+		if err != nil {
+			return err
+		}
+		hirq.sql = prev
+	}
+	return nil
+}
+
+func (hirq *HardwareIDResetQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*HardwareIDReset, error) {
+	var (
+		nodes       = []*HardwareIDReset{}
+		_spec       = hirq.querySpec()
+		loadedTypes = [1]bool{
+			hirq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*HardwareIDReset).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &HardwareIDReset{config: hirq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, hirq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := hirq.withUser; query != nil {
+		if err := hirq.loadUser(ctx, query, nodes, nil,
+			func(n *HardwareIDReset, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (hirq *HardwareIDResetQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*HardwareIDReset, init func(*HardwareIDReset), assign func(*HardwareIDReset, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*HardwareIDReset)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (hirq *HardwareIDResetQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := hirq.querySpec()
+	_spec.Node.Columns = hirq.ctx.Fields
+	if len(hirq.ctx.Fields) > 0 {
+		_spec.Unique = hirq.ctx.Unique != nil && *hirq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, hirq.driver, _spec)
+}
+
+func (hirq *HardwareIDResetQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(hardwareidreset.Table, hardwareidreset.Columns, sqlgraph.NewFieldSpec(hardwareidreset.FieldID, field.TypeInt))
+	_spec.From = hirq.sql
+	if unique := hirq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if hirq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := hirq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, hardwareidreset.FieldID)
+		for i := range fields {
+			if fields[i] != hardwareidreset.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if hirq.withUser != nil {
+			_spec.Node.AddColumnOnce(hardwareidreset.FieldUserID)
+		}
+	}
+	if ps := hirq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := hirq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := hirq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := hirq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (hirq *HardwareIDResetQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(hirq.driver.Dialect())
+	t1 := builder.Table(hardwareidreset.Table)
+	columns := hirq.ctx.Fields
+	if len(columns) == 0 {
+		columns = hardwareidreset.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if hirq.sql != nil {
+		selector = hirq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if hirq.ctx.Unique != nil && *hirq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range hirq.predicates {
+		p(selector)
+	}
+	for _, p := range hirq.order {
+		p(selector)
+	}
+	if offset := hirq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := hirq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// HardwareIDResetGroupBy is the group-by builder for HardwareIDReset entities.
+type HardwareIDResetGroupBy struct {
+	selector
+	build *HardwareIDResetQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (hirgb *HardwareIDResetGroupBy) Aggregate(fns ...AggregateFunc) *HardwareIDResetGroupBy {
+	hirgb.fns = append(hirgb.fns, fns...)
+	return hirgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (hirgb *HardwareIDResetGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, hirgb.build.ctx, ent.OpQueryGroupBy)
+	if err := hirgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*HardwareIDResetQuery, *HardwareIDResetGroupBy](ctx, hirgb.build, hirgb, hirgb.build.inters, v)
+}
+
+func (hirgb *HardwareIDResetGroupBy) sqlScan(ctx context.Context, root *HardwareIDResetQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(hirgb.fns))
+	for _, fn := range hirgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*hirgb.flds)+len(hirgb.fns))
+		for _, f := range *hirgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*hirgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := hirgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// HardwareIDResetSelect is the builder for selecting fields of HardwareIDReset entities.
+type HardwareIDResetSelect struct {
+	*HardwareIDResetQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (hirs *HardwareIDResetSelect) Aggregate(fns ...AggregateFunc) *HardwareIDResetSelect {
+	hirs.fns = append(hirs.fns, fns...)
+	return hirs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (hirs *HardwareIDResetSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, hirs.ctx, ent.OpQuerySelect)
+	if err := hirs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*HardwareIDResetQuery, *HardwareIDResetSelect](ctx, hirs.HardwareIDResetQuery, hirs, hirs.inters, v)
+}
+
+func (hirs *HardwareIDResetSelect) sqlScan(ctx context.Context, root *HardwareIDResetQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(hirs.fns))
+	for _, fn := range hirs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*hirs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := hirs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,424 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/hardwareidreset"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+)
+
+// HardwareIDResetUpdate is the builder for updating HardwareIDReset entities.
+type HardwareIDResetUpdate struct {
+	config
+	hooks    []Hook
+	mutation *HardwareIDResetMutation
+}
+
+// Where appends a list predicates to the HardwareIDResetUpdate builder.
+func (hiru *HardwareIDResetUpdate) Where(ps ...predicate.HardwareIDReset) *HardwareIDResetUpdate {
+	hiru.mutation.Where(ps...)
+	return hiru
+}
+
+// SetStatus sets the "status" field.
+func (hiru *HardwareIDResetUpdate) SetStatus(h hardwareidreset.Status) *HardwareIDResetUpdate {
+	hiru.mutation.SetStatus(h)
+	return hiru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (hiru *HardwareIDResetUpdate) SetNillableStatus(h *hardwareidreset.Status) *HardwareIDResetUpdate {
+	if h != nil {
+		hiru.SetStatus(*h)
+	}
+	return hiru
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (hiru *HardwareIDResetUpdate) SetOperatorID(i int) *HardwareIDResetUpdate {
+	hiru.mutation.ResetOperatorID()
+	hiru.mutation.SetOperatorID(i)
+	return hiru
+}
+
+// SetNillableOperatorID sets the "operator_id" field if the given value is not nil.
+func (hiru *HardwareIDResetUpdate) SetNillableOperatorID(i *int) *HardwareIDResetUpdate {
+	if i != nil {
+		hiru.SetOperatorID(*i)
+	}
+	return hiru
+}
+
+// AddOperatorID adds i to the "operator_id" field.
+func (hiru *HardwareIDResetUpdate) AddOperatorID(i int) *HardwareIDResetUpdate {
+	hiru.mutation.AddOperatorID(i)
+	return hiru
+}
+
+// ClearOperatorID clears the value of the "operator_id" field.
+func (hiru *HardwareIDResetUpdate) ClearOperatorID() *HardwareIDResetUpdate {
+	hiru.mutation.ClearOperatorID()
+	return hiru
+}
+
+// SetOperatorComment sets the "operator_comment" field.
+func (hiru *HardwareIDResetUpdate) SetOperatorComment(s string) *HardwareIDResetUpdate {
+	hiru.mutation.SetOperatorComment(s)
+	return hiru
+}
+
+// SetNillableOperatorComment sets the "operator_comment" field if the given value is not nil.
+func (hiru *HardwareIDResetUpdate) SetNillableOperatorComment(s *string) *HardwareIDResetUpdate {
+	if s != nil {
+		hiru.SetOperatorComment(*s)
+	}
+	return hiru
+}
+
+// ClearOperatorComment clears the value of the "operator_comment" field.
+func (hiru *HardwareIDResetUpdate) ClearOperatorComment() *HardwareIDResetUpdate {
+	hiru.mutation.ClearOperatorComment()
+	return hiru
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (hiru *HardwareIDResetUpdate) SetResolvedAt(t time.Time) *HardwareIDResetUpdate {
+	hiru.mutation.SetResolvedAt(t)
+	return hiru
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (hiru *HardwareIDResetUpdate) SetNillableResolvedAt(t *time.Time) *HardwareIDResetUpdate {
+	if t != nil {
+		hiru.SetResolvedAt(*t)
+	}
+	return hiru
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (hiru *HardwareIDResetUpdate) ClearResolvedAt() *HardwareIDResetUpdate {
+	hiru.mutation.ClearResolvedAt()
+	return hiru
+}
+
+// Mutation returns the HardwareIDResetMutation object of the builder.
+func (hiru *HardwareIDResetUpdate) Mutation() *HardwareIDResetMutation {
+	return hiru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (hiru *HardwareIDResetUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, hiru.sqlSave, hiru.mutation, hiru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (hiru *HardwareIDResetUpdate) SaveX(ctx context.Context) int {
+	affected, err := hiru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (hiru *HardwareIDResetUpdate) Exec(ctx context.Context) error {
+	_, err := hiru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hiru *HardwareIDResetUpdate) ExecX(ctx context.Context) {
+	if err := hiru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (hiru *HardwareIDResetUpdate) check() error {
+	if v, ok := hiru.mutation.Status(); ok {
+		if err := hardwareidreset.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "HardwareIDReset.status": %w`, err)}
+		}
+	}
+	if hiru.mutation.UserCleared() && len(hiru.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "HardwareIDReset.user"`)
+	}
+	return nil
+}
+
+func (hiru *HardwareIDResetUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := hiru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(hardwareidreset.Table, hardwareidreset.Columns, sqlgraph.NewFieldSpec(hardwareidreset.FieldID, field.TypeInt))
+	if ps := hiru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := hiru.mutation.Status(); ok {
+		_spec.SetField(hardwareidreset.FieldStatus, field.TypeEnum, value)
+	}
+	if hiru.mutation.ReasonCleared() {
+		_spec.ClearField(hardwareidreset.FieldReason, field.TypeString)
+	}
+	if value, ok := hiru.mutation.OperatorID(); ok {
+		_spec.SetField(hardwareidreset.FieldOperatorID, field.TypeInt, value)
+	}
+	if value, ok := hiru.mutation.AddedOperatorID(); ok {
+		_spec.AddField(hardwareidreset.FieldOperatorID, field.TypeInt, value)
+	}
+	if hiru.mutation.OperatorIDCleared() {
+		_spec.ClearField(hardwareidreset.FieldOperatorID, field.TypeInt)
+	}
+	if value, ok := hiru.mutation.OperatorComment(); ok {
+		_spec.SetField(hardwareidreset.FieldOperatorComment, field.TypeString, value)
+	}
+	if hiru.mutation.OperatorCommentCleared() {
+		_spec.ClearField(hardwareidreset.FieldOperatorComment, field.TypeString)
+	}
+	if value, ok := hiru.mutation.ResolvedAt(); ok {
+		_spec.SetField(hardwareidreset.FieldResolvedAt, field.TypeTime, value)
+	}
+	if hiru.mutation.ResolvedAtCleared() {
+		_spec.ClearField(hardwareidreset.FieldResolvedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, hiru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{hardwareidreset.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	hiru.mutation.done = true
+	return n, nil
+}
+
+// HardwareIDResetUpdateOne is the builder for updating a single HardwareIDReset entity.
+type HardwareIDResetUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *HardwareIDResetMutation
+}
+
+// SetStatus sets the "status" field.
+func (hiruo *HardwareIDResetUpdateOne) SetStatus(h hardwareidreset.Status) *HardwareIDResetUpdateOne {
+	hiruo.mutation.SetStatus(h)
+	return hiruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (hiruo *HardwareIDResetUpdateOne) SetNillableStatus(h *hardwareidreset.Status) *HardwareIDResetUpdateOne {
+	if h != nil {
+		hiruo.SetStatus(*h)
+	}
+	return hiruo
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (hiruo *HardwareIDResetUpdateOne) SetOperatorID(i int) *HardwareIDResetUpdateOne {
+	hiruo.mutation.ResetOperatorID()
+	hiruo.mutation.SetOperatorID(i)
+	return hiruo
+}
+
+// SetNillableOperatorID sets the "operator_id" field if the given value is not nil.
+func (hiruo *HardwareIDResetUpdateOne) SetNillableOperatorID(i *int) *HardwareIDResetUpdateOne {
+	if i != nil {
+		hiruo.SetOperatorID(*i)
+	}
+	return hiruo
+}
+
+// AddOperatorID adds i to the "operator_id" field.
+func (hiruo *HardwareIDResetUpdateOne) AddOperatorID(i int) *HardwareIDResetUpdateOne {
+	hiruo.mutation.AddOperatorID(i)
+	return hiruo
+}
+
+// ClearOperatorID clears the value of the "operator_id" field.
+func (hiruo *HardwareIDResetUpdateOne) ClearOperatorID() *HardwareIDResetUpdateOne {
+	hiruo.mutation.ClearOperatorID()
+	return hiruo
+}
+
+// SetOperatorComment sets the "operator_comment" field.
+func (hiruo *HardwareIDResetUpdateOne) SetOperatorComment(s string) *HardwareIDResetUpdateOne {
+	hiruo.mutation.SetOperatorComment(s)
+	return hiruo
+}
+
+// SetNillableOperatorComment sets the "operator_comment" field if the given value is not nil.
+func (hiruo *HardwareIDResetUpdateOne) SetNillableOperatorComment(s *string) *HardwareIDResetUpdateOne {
+	if s != nil {
+		hiruo.SetOperatorComment(*s)
+	}
+	return hiruo
+}
+
+// ClearOperatorComment clears the value of the "operator_comment" field.
+func (hiruo *HardwareIDResetUpdateOne) ClearOperatorComment() *HardwareIDResetUpdateOne {
+	hiruo.mutation.ClearOperatorComment()
+	return hiruo
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (hiruo *HardwareIDResetUpdateOne) SetResolvedAt(t time.Time) *HardwareIDResetUpdateOne {
+	hiruo.mutation.SetResolvedAt(t)
+	return hiruo
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (hiruo *HardwareIDResetUpdateOne) SetNillableResolvedAt(t *time.Time) *HardwareIDResetUpdateOne {
+	if t != nil {
+		hiruo.SetResolvedAt(*t)
+	}
+	return hiruo
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (hiruo *HardwareIDResetUpdateOne) ClearResolvedAt() *HardwareIDResetUpdateOne {
+	hiruo.mutation.ClearResolvedAt()
+	return hiruo
+}
+
+// Mutation returns the HardwareIDResetMutation object of the builder.
+func (hiruo *HardwareIDResetUpdateOne) Mutation() *HardwareIDResetMutation {
+	return hiruo.mutation
+}
+
+// Where appends a list predicates to the HardwareIDResetUpdate builder.
+func (hiruo *HardwareIDResetUpdateOne) Where(ps ...predicate.HardwareIDReset) *HardwareIDResetUpdateOne {
+	hiruo.mutation.Where(ps...)
+	return hiruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (hiruo *HardwareIDResetUpdateOne) Select(field string, fields ...string) *HardwareIDResetUpdateOne {
+	hiruo.fields = append([]string{field}, fields...)
+	return hiruo
+}
+
+// Save executes the query and returns the updated HardwareIDReset entity.
+func (hiruo *HardwareIDResetUpdateOne) Save(ctx context.Context) (*HardwareIDReset, error) {
+	return withHooks(ctx, hiruo.sqlSave, hiruo.mutation, hiruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (hiruo *HardwareIDResetUpdateOne) SaveX(ctx context.Context) *HardwareIDReset {
+	node, err := hiruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (hiruo *HardwareIDResetUpdateOne) Exec(ctx context.Context) error {
+	_, err := hiruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hiruo *HardwareIDResetUpdateOne) ExecX(ctx context.Context) {
+	if err := hiruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (hiruo *HardwareIDResetUpdateOne) check() error {
+	if v, ok := hiruo.mutation.Status(); ok {
+		if err := hardwareidreset.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "HardwareIDReset.status": %w`, err)}
+		}
+	}
+	if hiruo.mutation.UserCleared() && len(hiruo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "HardwareIDReset.user"`)
+	}
+	return nil
+}
+
+func (hiruo *HardwareIDResetUpdateOne) sqlSave(ctx context.Context) (_node *HardwareIDReset, err error) {
+	if err := hiruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(hardwareidreset.Table, hardwareidreset.Columns, sqlgraph.NewFieldSpec(hardwareidreset.FieldID, field.TypeInt))
+	id, ok := hiruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "HardwareIDReset.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := hiruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, hardwareidreset.FieldID)
+		for _, f := range fields {
+			if !hardwareidreset.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != hardwareidreset.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := hiruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := hiruo.mutation.Status(); ok {
+		_spec.SetField(hardwareidreset.FieldStatus, field.TypeEnum, value)
+	}
+	if hiruo.mutation.ReasonCleared() {
+		_spec.ClearField(hardwareidreset.FieldReason, field.TypeString)
+	}
+	if value, ok := hiruo.mutation.OperatorID(); ok {
+		_spec.SetField(hardwareidreset.FieldOperatorID, field.TypeInt, value)
+	}
+	if value, ok := hiruo.mutation.AddedOperatorID(); ok {
+		_spec.AddField(hardwareidreset.FieldOperatorID, field.TypeInt, value)
+	}
+	if hiruo.mutation.OperatorIDCleared() {
+		_spec.ClearField(hardwareidreset.FieldOperatorID, field.TypeInt)
+	}
+	if value, ok := hiruo.mutation.OperatorComment(); ok {
+		_spec.SetField(hardwareidreset.FieldOperatorComment, field.TypeString, value)
+	}
+	if hiruo.mutation.OperatorCommentCleared() {
+		_spec.ClearField(hardwareidreset.FieldOperatorComment, field.TypeString)
+	}
+	if value, ok := hiruo.mutation.ResolvedAt(); ok {
+		_spec.SetField(hardwareidreset.FieldResolvedAt, field.TypeTime, value)
+	}
+	if hiruo.mutation.ResolvedAtCleared() {
+		_spec.ClearField(hardwareidreset.FieldResolvedAt, field.TypeTime)
+	}
+	_node = &HardwareIDReset{config: hiruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, hiruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{hardwareidreset.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	hiruo.mutation.done = true
+	return _node, nil
+}
@@ -9,6 +9,30 @@ import (
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
 )
 
+// The APITokenFunc type is an adapter to allow the use of ordinary
+// function as APIToken mutator.
+type APITokenFunc func(context.Context, *ent.APITokenMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f APITokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.APITokenMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.APITokenMutation", m)
+}
+
+// The AuditLogFunc type is an adapter to allow the use of ordinary
+// function as AuditLog mutator.
+type AuditLogFunc func(context.Context, *ent.AuditLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AuditLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditLogMutation", m)
+}
+
 // The BannedHardwareIDFunc type is an adapter to allow the use of ordinary
 // function as BannedHardwareID mutator.
 type BannedHardwareIDFunc func(context.Context, *ent.BannedHardwareIDMutation) (ent.Value, error)
@@ -21,6 +45,54 @@ func (f BannedHardwareIDFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.V
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BannedHardwareIDMutation", m)
 }
 
+// The CollectionCompletionFunc type is an adapter to allow the use of ordinary
+// function as CollectionCompletion mutator.
+type CollectionCompletionFunc func(context.Context, *ent.CollectionCompletionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CollectionCompletionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CollectionCompletionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CollectionCompletionMutation", m)
+}
+
+// The CollectionRewardFunc type is an adapter to allow the use of ordinary
+// function as CollectionReward mutator.
+type CollectionRewardFunc func(context.Context, *ent.CollectionRewardMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CollectionRewardFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CollectionRewardMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CollectionRewardMutation", m)
+}
+
+// The EmailHistoryFunc type is an adapter to allow the use of ordinary
+// function as EmailHistory mutator.
+type EmailHistoryFunc func(context.Context, *ent.EmailHistoryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EmailHistoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EmailHistoryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmailHistoryMutation", m)
+}
+
+// The ExternalIdentityFunc type is an adapter to allow the use of ordinary
+// function as ExternalIdentity mutator.
+type ExternalIdentityFunc func(context.Context, *ent.ExternalIdentityMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ExternalIdentityFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ExternalIdentityMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ExternalIdentityMutation", m)
+}
+
 // The FriendRequestFunc type is an adapter to allow the use of ordinary
 // function as FriendRequest mutator.
 type FriendRequestFunc func(context.Context, *ent.FriendRequestMutation) (ent.Value, error)
@@ -45,6 +117,30 @@ func (f GameItemFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GameItemMutation", m)
 }
 
+// The GrantJobFunc type is an adapter to allow the use of ordinary
+// function as GrantJob mutator.
+type GrantJobFunc func(context.Context, *ent.GrantJobMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GrantJobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GrantJobMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GrantJobMutation", m)
+}
+
+// The HardwareIDResetFunc type is an adapter to allow the use of ordinary
+// function as HardwareIDReset mutator.
+type HardwareIDResetFunc func(context.Context, *ent.HardwareIDResetMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f HardwareIDResetFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.HardwareIDResetMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.HardwareIDResetMutation", m)
+}
+
 // The InventoryItemFunc type is an adapter to allow the use of ordinary
 // function as InventoryItem mutator.
 type InventoryItemFunc func(context.Context, *ent.InventoryItemMutation) (ent.Value, error)
@@ -57,6 +153,18 @@ func (f InventoryItemFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InventoryItemMutation", m)
 }
 
+// The LoginHistoryFunc type is an adapter to allow the use of ordinary
+// function as LoginHistory mutator.
+type LoginHistoryFunc func(context.Context, *ent.LoginHistoryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LoginHistoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LoginHistoryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LoginHistoryMutation", m)
+}
+
 // The MatchFunc type is an adapter to allow the use of ordinary
 // function as Match mutator.
 type MatchFunc func(context.Context, *ent.MatchMutation) (ent.Value, error)
@@ -69,6 +177,18 @@ func (f MatchFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MatchMutation", m)
 }
 
+// The PasswordHistoryFunc type is an adapter to allow the use of ordinary
+// function as PasswordHistory mutator.
+type PasswordHistoryFunc func(context.Context, *ent.PasswordHistoryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PasswordHistoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PasswordHistoryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PasswordHistoryMutation", m)
+}
+
 // The PlayerMatchResultFunc type is an adapter to allow the use of ordinary
 // function as PlayerMatchResult mutator.
 type PlayerMatchResultFunc func(context.Context, *ent.PlayerMatchResultMutation) (ent.Value, error)
@@ -81,6 +201,90 @@ func (f PlayerMatchResultFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PlayerMatchResultMutation", m)
 }
 
+// The RecoveryCodeFunc type is an adapter to allow the use of ordinary
+// function as RecoveryCode mutator.
+type RecoveryCodeFunc func(context.Context, *ent.RecoveryCodeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RecoveryCodeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RecoveryCodeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RecoveryCodeMutation", m)
+}
+
+// The RoleFunc type is an adapter to allow the use of ordinary
+// function as Role mutator.
+type RoleFunc func(context.Context, *ent.RoleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RoleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RoleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RoleMutation", m)
+}
+
+// The SanctionFunc type is an adapter to allow the use of ordinary
+// function as Sanction mutator.
+type SanctionFunc func(context.Context, *ent.SanctionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SanctionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SanctionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SanctionMutation", m)
+}
+
+// The SeasonFunc type is an adapter to allow the use of ordinary
+// function as Season mutator.
+type SeasonFunc func(context.Context, *ent.SeasonMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SeasonFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SeasonMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SeasonMutation", m)
+}
+
+// The SeasonPassFunc type is an adapter to allow the use of ordinary
+// function as SeasonPass mutator.
+type SeasonPassFunc func(context.Context, *ent.SeasonPassMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SeasonPassFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SeasonPassMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SeasonPassMutation", m)
+}
+
+// The SeasonRewardClaimFunc type is an adapter to allow the use of ordinary
+// function as SeasonRewardClaim mutator.
+type SeasonRewardClaimFunc func(context.Context, *ent.SeasonRewardClaimMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SeasonRewardClaimFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SeasonRewardClaimMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SeasonRewardClaimMutation", m)
+}
+
+// The SeasonTierFunc type is an adapter to allow the use of ordinary
+// function as SeasonTier mutator.
+type SeasonTierFunc func(context.Context, *ent.SeasonTierMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SeasonTierFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SeasonTierMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SeasonTierMutation", m)
+}
+
 // The StatisticFunc type is an adapter to allow the use of ordinary
 // function as Statistic mutator.
 type StatisticFunc func(context.Context, *ent.StatisticMutation) (ent.Value, error)
@@ -94,7 +298,7 @@ func (f StatisticFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 }
 
 // The UserFunc type is an adapter to allow the use of ordinary
-// function as UserDTO mutator.
+// function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
 
 // Mutate calls f(ctx, m).
@@ -117,6 +321,18 @@ func (f UserBalanceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserBalanceMutation", m)
 }
 
+// The UsernameHistoryFunc type is an adapter to allow the use of ordinary
+// function as UsernameHistory mutator.
+type UsernameHistoryFunc func(context.Context, *ent.UsernameHistoryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UsernameHistoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UsernameHistoryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UsernameHistoryMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
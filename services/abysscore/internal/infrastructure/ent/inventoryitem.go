@@ -25,6 +25,8 @@ type InventoryItem struct {
 	ItemID int `json:"item_id,omitempty"`
 	// ReceivedFromID holds the value of the "received_from_id" field.
 	ReceivedFromID *int `json:"received_from_id,omitempty"`
+	// Campaign holds the value of the "campaign" field.
+	Campaign *string `json:"campaign,omitempty"`
 	// ObtainedAt holds the value of the "obtained_at" field.
 	ObtainedAt time.Time `json:"obtained_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -73,6 +75,8 @@ func (*InventoryItem) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case inventoryitem.FieldID, inventoryitem.FieldUserID, inventoryitem.FieldItemID, inventoryitem.FieldReceivedFromID:
 			values[i] = new(sql.NullInt64)
+		case inventoryitem.FieldCampaign:
+			values[i] = new(sql.NullString)
 		case inventoryitem.FieldObtainedAt:
 			values[i] = new(sql.NullTime)
 		default:
@@ -115,6 +119,13 @@ func (ii *InventoryItem) assignValues(columns []string, values []any) error {
 				ii.ReceivedFromID = new(int)
 				*ii.ReceivedFromID = int(value.Int64)
 			}
+		case inventoryitem.FieldCampaign:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field campaign", values[i])
+			} else if value.Valid {
+				ii.Campaign = new(string)
+				*ii.Campaign = value.String
+			}
 		case inventoryitem.FieldObtainedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field obtained_at", values[i])
@@ -178,6 +189,11 @@ func (ii *InventoryItem) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	if v := ii.Campaign; v != nil {
+		builder.WriteString("campaign=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("obtained_at=")
 	builder.WriteString(ii.ObtainedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
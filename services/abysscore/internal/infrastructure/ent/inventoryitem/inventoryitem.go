@@ -20,6 +20,8 @@ const (
 	FieldItemID = "item_id"
 	// FieldReceivedFromID holds the string denoting the received_from_id field in the database.
 	FieldReceivedFromID = "received_from_id"
+	// FieldCampaign holds the string denoting the campaign field in the database.
+	FieldCampaign = "campaign"
 	// FieldObtainedAt holds the string denoting the obtained_at field in the database.
 	FieldObtainedAt = "obtained_at"
 	// EdgeUser holds the string denoting the user edge name in mutations.
@@ -30,7 +32,7 @@ const (
 	Table = "inventory_items"
 	// UserTable is the table that holds the user relation/edge.
 	UserTable = "inventory_items"
-	// UserInverseTable is the table name for the UserDTO entity.
+	// UserInverseTable is the table name for the User entity.
 	// It exists in this package in order to avoid circular dependency with the "user" package.
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
@@ -50,6 +52,7 @@ var Columns = []string{
 	FieldUserID,
 	FieldItemID,
 	FieldReceivedFromID,
+	FieldCampaign,
 	FieldObtainedAt,
 }
 
@@ -93,6 +96,11 @@ func ByReceivedFromID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldReceivedFromID, opts...).ToFunc()
 }
 
+// ByCampaign orders the results by the campaign field.
+func ByCampaign(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCampaign, opts...).ToFunc()
+}
+
 // ByObtainedAt orders the results by the obtained_at field.
 func ByObtainedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldObtainedAt, opts...).ToFunc()
@@ -70,6 +70,11 @@ func ReceivedFromID(v int) predicate.InventoryItem {
 	return predicate.InventoryItem(sql.FieldEQ(FieldReceivedFromID, v))
 }
 
+// Campaign applies equality check predicate on the "campaign" field. It's identical to CampaignEQ.
+func Campaign(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldEQ(FieldCampaign, v))
+}
+
 // ObtainedAt applies equality check predicate on the "obtained_at" field. It's identical to ObtainedAtEQ.
 func ObtainedAt(v time.Time) predicate.InventoryItem {
 	return predicate.InventoryItem(sql.FieldEQ(FieldObtainedAt, v))
@@ -155,6 +160,81 @@ func ReceivedFromIDLTE(v int) predicate.InventoryItem {
 	return predicate.InventoryItem(sql.FieldLTE(FieldReceivedFromID, v))
 }
 
+// CampaignEQ applies the EQ predicate on the "campaign" field.
+func CampaignEQ(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldEQ(FieldCampaign, v))
+}
+
+// CampaignNEQ applies the NEQ predicate on the "campaign" field.
+func CampaignNEQ(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldNEQ(FieldCampaign, v))
+}
+
+// CampaignIn applies the In predicate on the "campaign" field.
+func CampaignIn(vs ...string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldIn(FieldCampaign, vs...))
+}
+
+// CampaignNotIn applies the NotIn predicate on the "campaign" field.
+func CampaignNotIn(vs ...string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldNotIn(FieldCampaign, vs...))
+}
+
+// CampaignGT applies the GT predicate on the "campaign" field.
+func CampaignGT(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldGT(FieldCampaign, v))
+}
+
+// CampaignGTE applies the GTE predicate on the "campaign" field.
+func CampaignGTE(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldGTE(FieldCampaign, v))
+}
+
+// CampaignLT applies the LT predicate on the "campaign" field.
+func CampaignLT(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldLT(FieldCampaign, v))
+}
+
+// CampaignLTE applies the LTE predicate on the "campaign" field.
+func CampaignLTE(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldLTE(FieldCampaign, v))
+}
+
+// CampaignContains applies the Contains predicate on the "campaign" field.
+func CampaignContains(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldContains(FieldCampaign, v))
+}
+
+// CampaignHasPrefix applies the HasPrefix predicate on the "campaign" field.
+func CampaignHasPrefix(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldHasPrefix(FieldCampaign, v))
+}
+
+// CampaignHasSuffix applies the HasSuffix predicate on the "campaign" field.
+func CampaignHasSuffix(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldHasSuffix(FieldCampaign, v))
+}
+
+// CampaignIsNil applies the IsNil predicate on the "campaign" field.
+func CampaignIsNil() predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldIsNull(FieldCampaign))
+}
+
+// CampaignNotNil applies the NotNil predicate on the "campaign" field.
+func CampaignNotNil() predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldNotNull(FieldCampaign))
+}
+
+// CampaignEqualFold applies the EqualFold predicate on the "campaign" field.
+func CampaignEqualFold(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldEqualFold(FieldCampaign, v))
+}
+
+// CampaignContainsFold applies the ContainsFold predicate on the "campaign" field.
+func CampaignContainsFold(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldContainsFold(FieldCampaign, v))
+}
+
 // ObtainedAtEQ applies the EQ predicate on the "obtained_at" field.
 func ObtainedAtEQ(v time.Time) predicate.InventoryItem {
 	return predicate.InventoryItem(sql.FieldEQ(FieldObtainedAt, v))
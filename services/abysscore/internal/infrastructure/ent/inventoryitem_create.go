@@ -48,6 +48,20 @@ func (iic *InventoryItemCreate) SetNillableReceivedFromID(i *int) *InventoryItem
 	return iic
 }
 
+// SetCampaign sets the "campaign" field.
+func (iic *InventoryItemCreate) SetCampaign(s string) *InventoryItemCreate {
+	iic.mutation.SetCampaign(s)
+	return iic
+}
+
+// SetNillableCampaign sets the "campaign" field if the given value is not nil.
+func (iic *InventoryItemCreate) SetNillableCampaign(s *string) *InventoryItemCreate {
+	if s != nil {
+		iic.SetCampaign(*s)
+	}
+	return iic
+}
+
 // SetObtainedAt sets the "obtained_at" field.
 func (iic *InventoryItemCreate) SetObtainedAt(t time.Time) *InventoryItemCreate {
 	iic.mutation.SetObtainedAt(t)
@@ -179,6 +193,10 @@ func (iic *InventoryItemCreate) createSpec() (*InventoryItem, *sqlgraph.CreateSp
 		_spec.SetField(inventoryitem.FieldReceivedFromID, field.TypeInt, value)
 		_node.ReceivedFromID = &value
 	}
+	if value, ok := iic.mutation.Campaign(); ok {
+		_spec.SetField(inventoryitem.FieldCampaign, field.TypeString, value)
+		_node.Campaign = &value
+	}
 	if value, ok := iic.mutation.ObtainedAt(); ok {
 		_spec.SetField(inventoryitem.FieldObtainedAt, field.TypeTime, value)
 		_node.ObtainedAt = value
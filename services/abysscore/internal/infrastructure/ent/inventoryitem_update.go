@@ -97,6 +97,9 @@ func (iiu *InventoryItemUpdate) sqlSave(ctx context.Context) (n int, err error)
 			}
 		}
 	}
+	if iiu.mutation.CampaignCleared() {
+		_spec.ClearField(inventoryitem.FieldCampaign, field.TypeString)
+	}
 	if value, ok := iiu.mutation.ObtainedAt(); ok {
 		_spec.SetField(inventoryitem.FieldObtainedAt, field.TypeTime, value)
 	}
@@ -219,6 +222,9 @@ func (iiuo *InventoryItemUpdateOne) sqlSave(ctx context.Context) (_node *Invento
 			}
 		}
 	}
+	if iiuo.mutation.CampaignCleared() {
+		_spec.ClearField(inventoryitem.FieldCampaign, field.TypeString)
+	}
 	if value, ok := iiuo.mutation.ObtainedAt(); ok {
 		_spec.SetField(inventoryitem.FieldObtainedAt, field.TypeTime, value)
 	}
@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/loginhistory"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
+)
+
+// LoginHistory is the model entity for the LoginHistory schema.
+type LoginHistory struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// IP holds the value of the "ip" field.
+	IP string `json:"ip,omitempty"`
+	// UserAgent holds the value of the "user_agent" field.
+	UserAgent string `json:"user_agent,omitempty"`
+	// HardwareFingerprint holds the value of the "hardware_fingerprint" field.
+	HardwareFingerprint string `json:"hardware_fingerprint,omitempty"`
+	// HardwareIDMatch holds the value of the "hardware_id_match" field.
+	HardwareIDMatch bool `json:"hardware_id_match,omitempty"`
+	// Success holds the value of the "success" field.
+	Success bool `json:"success,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LoginHistoryQuery when eager-loading is set.
+	Edges        LoginHistoryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LoginHistoryEdges holds the relations/edges for other nodes in the graph.
+type LoginHistoryEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LoginHistoryEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LoginHistory) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case loginhistory.FieldHardwareIDMatch, loginhistory.FieldSuccess:
+			values[i] = new(sql.NullBool)
+		case loginhistory.FieldID, loginhistory.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case loginhistory.FieldIP, loginhistory.FieldUserAgent, loginhistory.FieldHardwareFingerprint:
+			values[i] = new(sql.NullString)
+		case loginhistory.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LoginHistory fields.
+func (lh *LoginHistory) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case loginhistory.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			lh.ID = int(value.Int64)
+		case loginhistory.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				lh.UserID = int(value.Int64)
+			}
+		case loginhistory.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				lh.IP = value.String
+			}
+		case loginhistory.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				lh.UserAgent = value.String
+			}
+		case loginhistory.FieldHardwareFingerprint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hardware_fingerprint", values[i])
+			} else if value.Valid {
+				lh.HardwareFingerprint = value.String
+			}
+		case loginhistory.FieldHardwareIDMatch:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field hardware_id_match", values[i])
+			} else if value.Valid {
+				lh.HardwareIDMatch = value.Bool
+			}
+		case loginhistory.FieldSuccess:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field success", values[i])
+			} else if value.Valid {
+				lh.Success = value.Bool
+			}
+		case loginhistory.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				lh.CreatedAt = value.Time
+			}
+		default:
+			lh.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LoginHistory.
+// This includes values selected through modifiers, order, etc.
+func (lh *LoginHistory) Value(name string) (ent.Value, error) {
+	return lh.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the LoginHistory entity.
+func (lh *LoginHistory) QueryUser() *UserQuery {
+	return NewLoginHistoryClient(lh.config).QueryUser(lh)
+}
+
+// Update returns a builder for updating this LoginHistory.
+// Note that you need to call LoginHistory.Unwrap() before calling this method if this LoginHistory
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (lh *LoginHistory) Update() *LoginHistoryUpdateOne {
+	return NewLoginHistoryClient(lh.config).UpdateOne(lh)
+}
+
+// Unwrap unwraps the LoginHistory entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (lh *LoginHistory) Unwrap() *LoginHistory {
+	_tx, ok := lh.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LoginHistory is not a transactional entity")
+	}
+	lh.config.driver = _tx.drv
+	return lh
+}
+
+// String implements the fmt.Stringer.
+func (lh *LoginHistory) String() string {
+	var builder strings.Builder
+	builder.WriteString("LoginHistory(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", lh.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", lh.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(lh.IP)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(lh.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("hardware_fingerprint=")
+	builder.WriteString(lh.HardwareFingerprint)
+	builder.WriteString(", ")
+	builder.WriteString("hardware_id_match=")
+	builder.WriteString(fmt.Sprintf("%v", lh.HardwareIDMatch))
+	builder.WriteString(", ")
+	builder.WriteString("success=")
+	builder.WriteString(fmt.Sprintf("%v", lh.Success))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(lh.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LoginHistories is a parsable slice of LoginHistory.
+type LoginHistories []*LoginHistory
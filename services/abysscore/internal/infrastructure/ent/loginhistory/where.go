@@ -0,0 +1,404 @@
+// Code generated by ent, DO NOT EDIT.
+
+package loginhistory
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldEQ(FieldUserID, v))
+}
+
+// IP applies equality check predicate on the "ip" field. It's identical to IPEQ.
+func IP(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldEQ(FieldIP, v))
+}
+
+// UserAgent applies equality check predicate on the "user_agent" field. It's identical to UserAgentEQ.
+func UserAgent(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// HardwareFingerprint applies equality check predicate on the "hardware_fingerprint" field. It's identical to HardwareFingerprintEQ.
+func HardwareFingerprint(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldEQ(FieldHardwareFingerprint, v))
+}
+
+// HardwareIDMatch applies equality check predicate on the "hardware_id_match" field. It's identical to HardwareIDMatchEQ.
+func HardwareIDMatch(v bool) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldEQ(FieldHardwareIDMatch, v))
+}
+
+// Success applies equality check predicate on the "success" field. It's identical to SuccessEQ.
+func Success(v bool) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldEQ(FieldSuccess, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// IPEQ applies the EQ predicate on the "ip" field.
+func IPEQ(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldEQ(FieldIP, v))
+}
+
+// IPNEQ applies the NEQ predicate on the "ip" field.
+func IPNEQ(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldNEQ(FieldIP, v))
+}
+
+// IPIn applies the In predicate on the "ip" field.
+func IPIn(vs ...string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldIn(FieldIP, vs...))
+}
+
+// IPNotIn applies the NotIn predicate on the "ip" field.
+func IPNotIn(vs ...string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldNotIn(FieldIP, vs...))
+}
+
+// IPGT applies the GT predicate on the "ip" field.
+func IPGT(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldGT(FieldIP, v))
+}
+
+// IPGTE applies the GTE predicate on the "ip" field.
+func IPGTE(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldGTE(FieldIP, v))
+}
+
+// IPLT applies the LT predicate on the "ip" field.
+func IPLT(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldLT(FieldIP, v))
+}
+
+// IPLTE applies the LTE predicate on the "ip" field.
+func IPLTE(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldLTE(FieldIP, v))
+}
+
+// IPContains applies the Contains predicate on the "ip" field.
+func IPContains(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldContains(FieldIP, v))
+}
+
+// IPHasPrefix applies the HasPrefix predicate on the "ip" field.
+func IPHasPrefix(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldHasPrefix(FieldIP, v))
+}
+
+// IPHasSuffix applies the HasSuffix predicate on the "ip" field.
+func IPHasSuffix(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldHasSuffix(FieldIP, v))
+}
+
+// IPEqualFold applies the EqualFold predicate on the "ip" field.
+func IPEqualFold(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldEqualFold(FieldIP, v))
+}
+
+// IPContainsFold applies the ContainsFold predicate on the "ip" field.
+func IPContainsFold(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldContainsFold(FieldIP, v))
+}
+
+// UserAgentEQ applies the EQ predicate on the "user_agent" field.
+func UserAgentEQ(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// UserAgentNEQ applies the NEQ predicate on the "user_agent" field.
+func UserAgentNEQ(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldNEQ(FieldUserAgent, v))
+}
+
+// UserAgentIn applies the In predicate on the "user_agent" field.
+func UserAgentIn(vs ...string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldIn(FieldUserAgent, vs...))
+}
+
+// UserAgentNotIn applies the NotIn predicate on the "user_agent" field.
+func UserAgentNotIn(vs ...string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldNotIn(FieldUserAgent, vs...))
+}
+
+// UserAgentGT applies the GT predicate on the "user_agent" field.
+func UserAgentGT(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldGT(FieldUserAgent, v))
+}
+
+// UserAgentGTE applies the GTE predicate on the "user_agent" field.
+func UserAgentGTE(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldGTE(FieldUserAgent, v))
+}
+
+// UserAgentLT applies the LT predicate on the "user_agent" field.
+func UserAgentLT(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldLT(FieldUserAgent, v))
+}
+
+// UserAgentLTE applies the LTE predicate on the "user_agent" field.
+func UserAgentLTE(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldLTE(FieldUserAgent, v))
+}
+
+// UserAgentContains applies the Contains predicate on the "user_agent" field.
+func UserAgentContains(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldContains(FieldUserAgent, v))
+}
+
+// UserAgentHasPrefix applies the HasPrefix predicate on the "user_agent" field.
+func UserAgentHasPrefix(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldHasPrefix(FieldUserAgent, v))
+}
+
+// UserAgentHasSuffix applies the HasSuffix predicate on the "user_agent" field.
+func UserAgentHasSuffix(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldHasSuffix(FieldUserAgent, v))
+}
+
+// UserAgentEqualFold applies the EqualFold predicate on the "user_agent" field.
+func UserAgentEqualFold(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldEqualFold(FieldUserAgent, v))
+}
+
+// UserAgentContainsFold applies the ContainsFold predicate on the "user_agent" field.
+func UserAgentContainsFold(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldContainsFold(FieldUserAgent, v))
+}
+
+// HardwareFingerprintEQ applies the EQ predicate on the "hardware_fingerprint" field.
+func HardwareFingerprintEQ(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldEQ(FieldHardwareFingerprint, v))
+}
+
+// HardwareFingerprintNEQ applies the NEQ predicate on the "hardware_fingerprint" field.
+func HardwareFingerprintNEQ(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldNEQ(FieldHardwareFingerprint, v))
+}
+
+// HardwareFingerprintIn applies the In predicate on the "hardware_fingerprint" field.
+func HardwareFingerprintIn(vs ...string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldIn(FieldHardwareFingerprint, vs...))
+}
+
+// HardwareFingerprintNotIn applies the NotIn predicate on the "hardware_fingerprint" field.
+func HardwareFingerprintNotIn(vs ...string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldNotIn(FieldHardwareFingerprint, vs...))
+}
+
+// HardwareFingerprintGT applies the GT predicate on the "hardware_fingerprint" field.
+func HardwareFingerprintGT(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldGT(FieldHardwareFingerprint, v))
+}
+
+// HardwareFingerprintGTE applies the GTE predicate on the "hardware_fingerprint" field.
+func HardwareFingerprintGTE(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldGTE(FieldHardwareFingerprint, v))
+}
+
+// HardwareFingerprintLT applies the LT predicate on the "hardware_fingerprint" field.
+func HardwareFingerprintLT(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldLT(FieldHardwareFingerprint, v))
+}
+
+// HardwareFingerprintLTE applies the LTE predicate on the "hardware_fingerprint" field.
+func HardwareFingerprintLTE(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldLTE(FieldHardwareFingerprint, v))
+}
+
+// HardwareFingerprintContains applies the Contains predicate on the "hardware_fingerprint" field.
+func HardwareFingerprintContains(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldContains(FieldHardwareFingerprint, v))
+}
+
+// HardwareFingerprintHasPrefix applies the HasPrefix predicate on the "hardware_fingerprint" field.
+func HardwareFingerprintHasPrefix(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldHasPrefix(FieldHardwareFingerprint, v))
+}
+
+// HardwareFingerprintHasSuffix applies the HasSuffix predicate on the "hardware_fingerprint" field.
+func HardwareFingerprintHasSuffix(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldHasSuffix(FieldHardwareFingerprint, v))
+}
+
+// HardwareFingerprintEqualFold applies the EqualFold predicate on the "hardware_fingerprint" field.
+func HardwareFingerprintEqualFold(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldEqualFold(FieldHardwareFingerprint, v))
+}
+
+// HardwareFingerprintContainsFold applies the ContainsFold predicate on the "hardware_fingerprint" field.
+func HardwareFingerprintContainsFold(v string) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldContainsFold(FieldHardwareFingerprint, v))
+}
+
+// HardwareIDMatchEQ applies the EQ predicate on the "hardware_id_match" field.
+func HardwareIDMatchEQ(v bool) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldEQ(FieldHardwareIDMatch, v))
+}
+
+// HardwareIDMatchNEQ applies the NEQ predicate on the "hardware_id_match" field.
+func HardwareIDMatchNEQ(v bool) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldNEQ(FieldHardwareIDMatch, v))
+}
+
+// SuccessEQ applies the EQ predicate on the "success" field.
+func SuccessEQ(v bool) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldEQ(FieldSuccess, v))
+}
+
+// SuccessNEQ applies the NEQ predicate on the "success" field.
+func SuccessNEQ(v bool) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldNEQ(FieldSuccess, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.LoginHistory {
+	return predicate.LoginHistory(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.LoginHistory {
+	return predicate.LoginHistory(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LoginHistory) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LoginHistory) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LoginHistory) predicate.LoginHistory {
+	return predicate.LoginHistory(sql.NotPredicates(p))
+}
@@ -0,0 +1,314 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/loginhistory"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
+)
+
+// LoginHistoryCreate is the builder for creating a LoginHistory entity.
+type LoginHistoryCreate struct {
+	config
+	mutation *LoginHistoryMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (lhc *LoginHistoryCreate) SetUserID(i int) *LoginHistoryCreate {
+	lhc.mutation.SetUserID(i)
+	return lhc
+}
+
+// SetIP sets the "ip" field.
+func (lhc *LoginHistoryCreate) SetIP(s string) *LoginHistoryCreate {
+	lhc.mutation.SetIP(s)
+	return lhc
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (lhc *LoginHistoryCreate) SetUserAgent(s string) *LoginHistoryCreate {
+	lhc.mutation.SetUserAgent(s)
+	return lhc
+}
+
+// SetHardwareFingerprint sets the "hardware_fingerprint" field.
+func (lhc *LoginHistoryCreate) SetHardwareFingerprint(s string) *LoginHistoryCreate {
+	lhc.mutation.SetHardwareFingerprint(s)
+	return lhc
+}
+
+// SetHardwareIDMatch sets the "hardware_id_match" field.
+func (lhc *LoginHistoryCreate) SetHardwareIDMatch(b bool) *LoginHistoryCreate {
+	lhc.mutation.SetHardwareIDMatch(b)
+	return lhc
+}
+
+// SetSuccess sets the "success" field.
+func (lhc *LoginHistoryCreate) SetSuccess(b bool) *LoginHistoryCreate {
+	lhc.mutation.SetSuccess(b)
+	return lhc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lhc *LoginHistoryCreate) SetCreatedAt(t time.Time) *LoginHistoryCreate {
+	lhc.mutation.SetCreatedAt(t)
+	return lhc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lhc *LoginHistoryCreate) SetNillableCreatedAt(t *time.Time) *LoginHistoryCreate {
+	if t != nil {
+		lhc.SetCreatedAt(*t)
+	}
+	return lhc
+}
+
+// SetID sets the "id" field.
+func (lhc *LoginHistoryCreate) SetID(i int) *LoginHistoryCreate {
+	lhc.mutation.SetID(i)
+	return lhc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (lhc *LoginHistoryCreate) SetUser(u *User) *LoginHistoryCreate {
+	return lhc.SetUserID(u.ID)
+}
+
+// Mutation returns the LoginHistoryMutation object of the builder.
+func (lhc *LoginHistoryCreate) Mutation() *LoginHistoryMutation {
+	return lhc.mutation
+}
+
+// Save creates the LoginHistory in the database.
+func (lhc *LoginHistoryCreate) Save(ctx context.Context) (*LoginHistory, error) {
+	lhc.defaults()
+	return withHooks(ctx, lhc.sqlSave, lhc.mutation, lhc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lhc *LoginHistoryCreate) SaveX(ctx context.Context) *LoginHistory {
+	v, err := lhc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lhc *LoginHistoryCreate) Exec(ctx context.Context) error {
+	_, err := lhc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lhc *LoginHistoryCreate) ExecX(ctx context.Context) {
+	if err := lhc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lhc *LoginHistoryCreate) defaults() {
+	if _, ok := lhc.mutation.CreatedAt(); !ok {
+		v := loginhistory.DefaultCreatedAt()
+		lhc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lhc *LoginHistoryCreate) check() error {
+	if _, ok := lhc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "LoginHistory.user_id"`)}
+	}
+	if _, ok := lhc.mutation.IP(); !ok {
+		return &ValidationError{Name: "ip", err: errors.New(`ent: missing required field "LoginHistory.ip"`)}
+	}
+	if _, ok := lhc.mutation.UserAgent(); !ok {
+		return &ValidationError{Name: "user_agent", err: errors.New(`ent: missing required field "LoginHistory.user_agent"`)}
+	}
+	if _, ok := lhc.mutation.HardwareFingerprint(); !ok {
+		return &ValidationError{Name: "hardware_fingerprint", err: errors.New(`ent: missing required field "LoginHistory.hardware_fingerprint"`)}
+	}
+	if _, ok := lhc.mutation.HardwareIDMatch(); !ok {
+		return &ValidationError{Name: "hardware_id_match", err: errors.New(`ent: missing required field "LoginHistory.hardware_id_match"`)}
+	}
+	if _, ok := lhc.mutation.Success(); !ok {
+		return &ValidationError{Name: "success", err: errors.New(`ent: missing required field "LoginHistory.success"`)}
+	}
+	if _, ok := lhc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LoginHistory.created_at"`)}
+	}
+	if len(lhc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "LoginHistory.user"`)}
+	}
+	return nil
+}
+
+func (lhc *LoginHistoryCreate) sqlSave(ctx context.Context) (*LoginHistory, error) {
+	if err := lhc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lhc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lhc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	lhc.mutation.id = &_node.ID
+	lhc.mutation.done = true
+	return _node, nil
+}
+
+func (lhc *LoginHistoryCreate) createSpec() (*LoginHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LoginHistory{config: lhc.config}
+		_spec = sqlgraph.NewCreateSpec(loginhistory.Table, sqlgraph.NewFieldSpec(loginhistory.FieldID, field.TypeInt))
+	)
+	if id, ok := lhc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := lhc.mutation.IP(); ok {
+		_spec.SetField(loginhistory.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := lhc.mutation.UserAgent(); ok {
+		_spec.SetField(loginhistory.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := lhc.mutation.HardwareFingerprint(); ok {
+		_spec.SetField(loginhistory.FieldHardwareFingerprint, field.TypeString, value)
+		_node.HardwareFingerprint = value
+	}
+	if value, ok := lhc.mutation.HardwareIDMatch(); ok {
+		_spec.SetField(loginhistory.FieldHardwareIDMatch, field.TypeBool, value)
+		_node.HardwareIDMatch = value
+	}
+	if value, ok := lhc.mutation.Success(); ok {
+		_spec.SetField(loginhistory.FieldSuccess, field.TypeBool, value)
+		_node.Success = value
+	}
+	if value, ok := lhc.mutation.CreatedAt(); ok {
+		_spec.SetField(loginhistory.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := lhc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loginhistory.UserTable,
+			Columns: []string{loginhistory.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LoginHistoryCreateBulk is the builder for creating many LoginHistory entities in bulk.
+type LoginHistoryCreateBulk struct {
+	config
+	err      error
+	builders []*LoginHistoryCreate
+}
+
+// Save creates the LoginHistory entities in the database.
+func (lhcb *LoginHistoryCreateBulk) Save(ctx context.Context) ([]*LoginHistory, error) {
+	if lhcb.err != nil {
+		return nil, lhcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lhcb.builders))
+	nodes := make([]*LoginHistory, len(lhcb.builders))
+	mutators := make([]Mutator, len(lhcb.builders))
+	for i := range lhcb.builders {
+		func(i int, root context.Context) {
+			builder := lhcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LoginHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lhcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lhcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lhcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lhcb *LoginHistoryCreateBulk) SaveX(ctx context.Context) []*LoginHistory {
+	v, err := lhcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lhcb *LoginHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := lhcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lhcb *LoginHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := lhcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
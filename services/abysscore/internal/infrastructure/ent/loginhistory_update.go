@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/loginhistory"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+)
+
+// LoginHistoryUpdate is the builder for updating LoginHistory entities.
+type LoginHistoryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LoginHistoryMutation
+}
+
+// Where appends a list predicates to the LoginHistoryUpdate builder.
+func (lhu *LoginHistoryUpdate) Where(ps ...predicate.LoginHistory) *LoginHistoryUpdate {
+	lhu.mutation.Where(ps...)
+	return lhu
+}
+
+// Mutation returns the LoginHistoryMutation object of the builder.
+func (lhu *LoginHistoryUpdate) Mutation() *LoginHistoryMutation {
+	return lhu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lhu *LoginHistoryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, lhu.sqlSave, lhu.mutation, lhu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lhu *LoginHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := lhu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lhu *LoginHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := lhu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lhu *LoginHistoryUpdate) ExecX(ctx context.Context) {
+	if err := lhu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lhu *LoginHistoryUpdate) check() error {
+	if lhu.mutation.UserCleared() && len(lhu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LoginHistory.user"`)
+	}
+	return nil
+}
+
+func (lhu *LoginHistoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := lhu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loginhistory.Table, loginhistory.Columns, sqlgraph.NewFieldSpec(loginhistory.FieldID, field.TypeInt))
+	if ps := lhu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lhu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginhistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lhu.mutation.done = true
+	return n, nil
+}
+
+// LoginHistoryUpdateOne is the builder for updating a single LoginHistory entity.
+type LoginHistoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LoginHistoryMutation
+}
+
+// Mutation returns the LoginHistoryMutation object of the builder.
+func (lhuo *LoginHistoryUpdateOne) Mutation() *LoginHistoryMutation {
+	return lhuo.mutation
+}
+
+// Where appends a list predicates to the LoginHistoryUpdate builder.
+func (lhuo *LoginHistoryUpdateOne) Where(ps ...predicate.LoginHistory) *LoginHistoryUpdateOne {
+	lhuo.mutation.Where(ps...)
+	return lhuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (lhuo *LoginHistoryUpdateOne) Select(field string, fields ...string) *LoginHistoryUpdateOne {
+	lhuo.fields = append([]string{field}, fields...)
+	return lhuo
+}
+
+// Save executes the query and returns the updated LoginHistory entity.
+func (lhuo *LoginHistoryUpdateOne) Save(ctx context.Context) (*LoginHistory, error) {
+	return withHooks(ctx, lhuo.sqlSave, lhuo.mutation, lhuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lhuo *LoginHistoryUpdateOne) SaveX(ctx context.Context) *LoginHistory {
+	node, err := lhuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (lhuo *LoginHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := lhuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lhuo *LoginHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := lhuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lhuo *LoginHistoryUpdateOne) check() error {
+	if lhuo.mutation.UserCleared() && len(lhuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LoginHistory.user"`)
+	}
+	return nil
+}
+
+func (lhuo *LoginHistoryUpdateOne) sqlSave(ctx context.Context) (_node *LoginHistory, err error) {
+	if err := lhuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loginhistory.Table, loginhistory.Columns, sqlgraph.NewFieldSpec(loginhistory.FieldID, field.TypeInt))
+	id, ok := lhuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LoginHistory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := lhuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loginhistory.FieldID)
+		for _, f := range fields {
+			if !loginhistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != loginhistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := lhuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &LoginHistory{config: lhuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, lhuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginhistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	lhuo.mutation.done = true
+	return _node, nil
+}
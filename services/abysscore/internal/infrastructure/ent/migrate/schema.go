@@ -8,12 +8,81 @@ import (
 )
 
 var (
+	// APITokensColumns holds the columns for the "api_tokens" table.
+	APITokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "token_prefix", Type: field.TypeString},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// APITokensTable holds the schema information for the "api_tokens" table.
+	APITokensTable = &schema.Table{
+		Name:       "api_tokens",
+		Columns:    APITokensColumns,
+		PrimaryKey: []*schema.Column{APITokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "api_tokens_users_api_tokens",
+				Columns:    []*schema.Column{APITokensColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "actor_id", Type: field.TypeInt},
+		{Name: "actor_username", Type: field.TypeString},
+		{Name: "action", Type: field.TypeString},
+		{Name: "target_type", Type: field.TypeString},
+		{Name: "target_id", Type: field.TypeString, Nullable: true},
+		{Name: "before", Type: field.TypeJSON, Nullable: true},
+		{Name: "after", Type: field.TypeJSON, Nullable: true},
+		{Name: "request_id", Type: field.TypeString, Nullable: true},
+		{Name: "ip", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[10]},
+			},
+			{
+				Name:    "auditlog_actor_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1], AuditLogsColumns[10]},
+			},
+			{
+				Name:    "auditlog_action_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[3], AuditLogsColumns[10]},
+			},
+			{
+				Name:    "auditlog_target_type_target_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[4], AuditLogsColumns[5]},
+			},
+		},
+	}
 	// BannedHardwareIdsColumns holds the columns for the "banned_hardware_ids" table.
 	BannedHardwareIdsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "hardware_id", Type: field.TypeString, Unique: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "ban_reason", Type: field.TypeString, Nullable: true},
+		{Name: "performer_id", Type: field.TypeInt, Nullable: true},
 	}
 	// BannedHardwareIdsTable holds the schema information for the "banned_hardware_ids" table.
 	BannedHardwareIdsTable = &schema.Table{
@@ -28,6 +97,124 @@ var (
 			},
 		},
 	}
+	// CollectionCompletionsColumns holds the columns for the "collection_completions" table.
+	CollectionCompletionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "collection", Type: field.TypeString},
+		{Name: "completed_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// CollectionCompletionsTable holds the schema information for the "collection_completions" table.
+	CollectionCompletionsTable = &schema.Table{
+		Name:       "collection_completions",
+		Columns:    CollectionCompletionsColumns,
+		PrimaryKey: []*schema.Column{CollectionCompletionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "collection_completions_users_collection_completions",
+				Columns:    []*schema.Column{CollectionCompletionsColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "collectioncompletion_user_id_collection",
+				Unique:  true,
+				Columns: []*schema.Column{CollectionCompletionsColumns[3], CollectionCompletionsColumns[1]},
+			},
+		},
+	}
+	// CollectionRewardsColumns holds the columns for the "collection_rewards" table.
+	CollectionRewardsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "collection", Type: field.TypeString, Unique: true},
+		{Name: "coins", Type: field.TypeFloat64, Default: 0},
+		{Name: "title", Type: field.TypeString, Nullable: true},
+		{Name: "badge_item_id", Type: field.TypeInt, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// CollectionRewardsTable holds the schema information for the "collection_rewards" table.
+	CollectionRewardsTable = &schema.Table{
+		Name:       "collection_rewards",
+		Columns:    CollectionRewardsColumns,
+		PrimaryKey: []*schema.Column{CollectionRewardsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "collectionreward_collection",
+				Unique:  true,
+				Columns: []*schema.Column{CollectionRewardsColumns[1]},
+			},
+		},
+	}
+	// EmailHistoriesColumns holds the columns for the "email_histories" table.
+	EmailHistoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"linked", "changed", "unlinked"}},
+		{Name: "old_email", Type: field.TypeString, Nullable: true},
+		{Name: "new_email", Type: field.TypeString, Nullable: true},
+		{Name: "performer_id", Type: field.TypeInt, Nullable: true},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// EmailHistoriesTable holds the schema information for the "email_histories" table.
+	EmailHistoriesTable = &schema.Table{
+		Name:       "email_histories",
+		Columns:    EmailHistoriesColumns,
+		PrimaryKey: []*schema.Column{EmailHistoriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "email_histories_users_email_history",
+				Columns:    []*schema.Column{EmailHistoriesColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "emailhistory_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{EmailHistoriesColumns[7], EmailHistoriesColumns[6]},
+			},
+		},
+	}
+	// ExternalIdentitiesColumns holds the columns for the "external_identities" table.
+	ExternalIdentitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "email", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_login_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// ExternalIdentitiesTable holds the schema information for the "external_identities" table.
+	ExternalIdentitiesTable = &schema.Table{
+		Name:       "external_identities",
+		Columns:    ExternalIdentitiesColumns,
+		PrimaryKey: []*schema.Column{ExternalIdentitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "external_identities_users_external_identities",
+				Columns:    []*schema.Column{ExternalIdentitiesColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "externalidentity_provider_subject",
+				Unique:  true,
+				Columns: []*schema.Column{ExternalIdentitiesColumns[1], ExternalIdentitiesColumns[2]},
+			},
+			{
+				Name:    "externalidentity_user_id_provider",
+				Unique:  true,
+				Columns: []*schema.Column{ExternalIdentitiesColumns[6], ExternalIdentitiesColumns[1]},
+			},
+		},
+	}
 	// FriendRequestsColumns holds the columns for the "friend_requests" table.
 	FriendRequestsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -63,6 +250,7 @@ var (
 		{Name: "type", Type: field.TypeInt},
 		{Name: "rarity", Type: field.TypeInt},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "archived_at", Type: field.TypeTime, Nullable: true},
 	}
 	// GameItemsTable holds the schema information for the "game_items" table.
 	GameItemsTable = &schema.Table{
@@ -70,10 +258,85 @@ var (
 		Columns:    GameItemsColumns,
 		PrimaryKey: []*schema.Column{GameItemsColumns[0]},
 	}
+	// GrantJobsColumns holds the columns for the "grant_jobs" table.
+	GrantJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "campaign", Type: field.TypeString},
+		{Name: "item_id", Type: field.TypeInt},
+		{Name: "created_by_id", Type: field.TypeInt},
+		{Name: "target", Type: field.TypeEnum, Enums: []string{"users", "filter", "online"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "completed", "failed"}, Default: "pending"},
+		{Name: "total", Type: field.TypeInt, Default: 0},
+		{Name: "processed", Type: field.TypeInt, Default: 0},
+		{Name: "granted", Type: field.TypeInt, Default: 0},
+		{Name: "skipped", Type: field.TypeInt, Default: 0},
+		{Name: "failed", Type: field.TypeInt, Default: 0},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "owner", Type: field.TypeString, Nullable: true},
+		{Name: "heartbeat_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+	}
+	// GrantJobsTable holds the schema information for the "grant_jobs" table.
+	GrantJobsTable = &schema.Table{
+		Name:       "grant_jobs",
+		Columns:    GrantJobsColumns,
+		PrimaryKey: []*schema.Column{GrantJobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "grantjob_campaign",
+				Unique:  false,
+				Columns: []*schema.Column{GrantJobsColumns[1]},
+			},
+			{
+				Name:    "grantjob_status_heartbeat_at",
+				Unique:  false,
+				Columns: []*schema.Column{GrantJobsColumns[5], GrantJobsColumns[13]},
+			},
+		},
+	}
+	// HardwareIDResetsColumns holds the columns for the "hardware_id_resets" table.
+	HardwareIDResetsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "approved", "rejected"}, Default: "pending"},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+		{Name: "operator_id", Type: field.TypeInt, Nullable: true},
+		{Name: "operator_comment", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// HardwareIDResetsTable holds the schema information for the "hardware_id_resets" table.
+	HardwareIDResetsTable = &schema.Table{
+		Name:       "hardware_id_resets",
+		Columns:    HardwareIDResetsColumns,
+		PrimaryKey: []*schema.Column{HardwareIDResetsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "hardware_id_resets_users_hardware_id_resets",
+				Columns:    []*schema.Column{HardwareIDResetsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "hardwareidreset_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{HardwareIDResetsColumns[1], HardwareIDResetsColumns[5]},
+			},
+			{
+				Name:    "hardwareidreset_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{HardwareIDResetsColumns[7]},
+			},
+		},
+	}
 	// InventoryItemsColumns holds the columns for the "inventory_items" table.
 	InventoryItemsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "received_from_id", Type: field.TypeInt, Default: 0},
+		{Name: "campaign", Type: field.TypeString, Nullable: true},
 		{Name: "obtained_at", Type: field.TypeTime},
 		{Name: "item_id", Type: field.TypeInt},
 		{Name: "user_id", Type: field.TypeInt},
@@ -86,13 +349,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "inventory_items_game_items_inventory_items",
-				Columns:    []*schema.Column{InventoryItemsColumns[3]},
+				Columns:    []*schema.Column{InventoryItemsColumns[4]},
 				RefColumns: []*schema.Column{GameItemsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "inventory_items_users_items",
-				Columns:    []*schema.Column{InventoryItemsColumns[4]},
+				Columns:    []*schema.Column{InventoryItemsColumns[5]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -101,7 +364,54 @@ var (
 			{
 				Name:    "inventoryitem_user_id_item_id",
 				Unique:  false,
-				Columns: []*schema.Column{InventoryItemsColumns[4], InventoryItemsColumns[3]},
+				Columns: []*schema.Column{InventoryItemsColumns[5], InventoryItemsColumns[4]},
+			},
+			{
+				Name:    "inventoryitem_user_id_item_id_campaign",
+				Unique:  true,
+				Columns: []*schema.Column{InventoryItemsColumns[5], InventoryItemsColumns[4], InventoryItemsColumns[2]},
+			},
+		},
+	}
+	// LoginHistoriesColumns holds the columns for the "login_histories" table.
+	LoginHistoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "ip", Type: field.TypeString},
+		{Name: "user_agent", Type: field.TypeString},
+		{Name: "hardware_fingerprint", Type: field.TypeString},
+		{Name: "hardware_id_match", Type: field.TypeBool},
+		{Name: "success", Type: field.TypeBool},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// LoginHistoriesTable holds the schema information for the "login_histories" table.
+	LoginHistoriesTable = &schema.Table{
+		Name:       "login_histories",
+		Columns:    LoginHistoriesColumns,
+		PrimaryKey: []*schema.Column{LoginHistoriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "login_histories_users_login_history",
+				Columns:    []*schema.Column{LoginHistoriesColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "loginhistory_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{LoginHistoriesColumns[7], LoginHistoriesColumns[6]},
+			},
+			{
+				Name:    "loginhistory_user_id_success_ip",
+				Unique:  false,
+				Columns: []*schema.Column{LoginHistoriesColumns[7], LoginHistoriesColumns[5], LoginHistoriesColumns[1]},
+			},
+			{
+				Name:    "loginhistory_user_id_success_hardware_fingerprint",
+				Unique:  false,
+				Columns: []*schema.Column{LoginHistoriesColumns[7], LoginHistoriesColumns[5], LoginHistoriesColumns[3]},
 			},
 		},
 	}
@@ -137,6 +447,34 @@ var (
 			},
 		},
 	}
+	// PasswordHistoriesColumns holds the columns for the "password_histories" table.
+	PasswordHistoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "password", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// PasswordHistoriesTable holds the schema information for the "password_histories" table.
+	PasswordHistoriesTable = &schema.Table{
+		Name:       "password_histories",
+		Columns:    PasswordHistoriesColumns,
+		PrimaryKey: []*schema.Column{PasswordHistoriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "password_histories_users_password_history",
+				Columns:    []*schema.Column{PasswordHistoriesColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "passwordhistory_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PasswordHistoriesColumns[3], PasswordHistoriesColumns[2]},
+			},
+		},
+	}
 	// PlayerMatchResultsColumns holds the columns for the "player_match_results" table.
 	PlayerMatchResultsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -166,10 +504,224 @@ var (
 			},
 		},
 	}
+	// RecoveryCodesColumns holds the columns for the "recovery_codes" table.
+	RecoveryCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code_hash", Type: field.TypeString},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// RecoveryCodesTable holds the schema information for the "recovery_codes" table.
+	RecoveryCodesTable = &schema.Table{
+		Name:       "recovery_codes",
+		Columns:    RecoveryCodesColumns,
+		PrimaryKey: []*schema.Column{RecoveryCodesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "recovery_codes_users_recovery_codes",
+				Columns:    []*schema.Column{RecoveryCodesColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "recoverycode_user_id_code_hash",
+				Unique:  true,
+				Columns: []*schema.Column{RecoveryCodesColumns[4], RecoveryCodesColumns[1]},
+			},
+		},
+	}
+	// RolesColumns holds the columns for the "roles" table.
+	RolesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "permissions", Type: field.TypeJSON},
+		{Name: "system", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// RolesTable holds the schema information for the "roles" table.
+	RolesTable = &schema.Table{
+		Name:       "roles",
+		Columns:    RolesColumns,
+		PrimaryKey: []*schema.Column{RolesColumns[0]},
+	}
+	// SanctionsColumns holds the columns for the "sanctions" table.
+	SanctionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"account", "search"}},
+		{Name: "level", Type: field.TypeInt},
+		{Name: "previous_level", Type: field.TypeInt},
+		{Name: "reason", Type: field.TypeString},
+		{Name: "blocked_until", Type: field.TypeTime},
+		{Name: "performer_id", Type: field.TypeInt},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "lifted", "revoked"}, Default: "active"},
+		{Name: "resolver_id", Type: field.TypeInt, Nullable: true},
+		{Name: "resolution_comment", Type: field.TypeString, Nullable: true},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "appeal_status", Type: field.TypeEnum, Nullable: true, Enums: []string{"pending", "accepted", "rejected"}},
+		{Name: "appeal_message", Type: field.TypeString, Nullable: true},
+		{Name: "appealed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// SanctionsTable holds the schema information for the "sanctions" table.
+	SanctionsTable = &schema.Table{
+		Name:       "sanctions",
+		Columns:    SanctionsColumns,
+		PrimaryKey: []*schema.Column{SanctionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sanctions_users_sanctions",
+				Columns:    []*schema.Column{SanctionsColumns[15]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sanction_user_id_type_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SanctionsColumns[15], SanctionsColumns[1], SanctionsColumns[14]},
+			},
+			{
+				Name:    "sanction_appeal_status_appealed_at",
+				Unique:  false,
+				Columns: []*schema.Column{SanctionsColumns[11], SanctionsColumns[13]},
+			},
+		},
+	}
+	// SeasonsColumns holds the columns for the "seasons" table.
+	SeasonsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "number", Type: field.TypeInt, Unique: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "starts_at", Type: field.TypeTime},
+		{Name: "ends_at", Type: field.TypeTime},
+		{Name: "premium_price", Type: field.TypeFloat64, Default: 0},
+		{Name: "rolled_over_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// SeasonsTable holds the schema information for the "seasons" table.
+	SeasonsTable = &schema.Table{
+		Name:       "seasons",
+		Columns:    SeasonsColumns,
+		PrimaryKey: []*schema.Column{SeasonsColumns[0]},
+	}
+	// SeasonPassesColumns holds the columns for the "season_passes" table.
+	SeasonPassesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "premium", Type: field.TypeBool, Default: false},
+		{Name: "premium_purchased_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "season_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// SeasonPassesTable holds the schema information for the "season_passes" table.
+	SeasonPassesTable = &schema.Table{
+		Name:       "season_passes",
+		Columns:    SeasonPassesColumns,
+		PrimaryKey: []*schema.Column{SeasonPassesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "season_passes_seasons_passes",
+				Columns:    []*schema.Column{SeasonPassesColumns[4]},
+				RefColumns: []*schema.Column{SeasonsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "season_passes_users_season_passes",
+				Columns:    []*schema.Column{SeasonPassesColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "seasonpass_user_id_season_id",
+				Unique:  true,
+				Columns: []*schema.Column{SeasonPassesColumns[5], SeasonPassesColumns[4]},
+			},
+		},
+	}
+	// SeasonRewardClaimsColumns holds the columns for the "season_reward_claims" table.
+	SeasonRewardClaimsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "level", Type: field.TypeInt},
+		{Name: "track", Type: field.TypeEnum, Enums: []string{"free", "premium"}},
+		{Name: "inventory_item_id", Type: field.TypeInt, Nullable: true},
+		{Name: "coins", Type: field.TypeFloat64, Default: 0},
+		{Name: "automatic", Type: field.TypeBool, Default: false},
+		{Name: "claimed_at", Type: field.TypeTime},
+		{Name: "season_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// SeasonRewardClaimsTable holds the schema information for the "season_reward_claims" table.
+	SeasonRewardClaimsTable = &schema.Table{
+		Name:       "season_reward_claims",
+		Columns:    SeasonRewardClaimsColumns,
+		PrimaryKey: []*schema.Column{SeasonRewardClaimsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "season_reward_claims_seasons_reward_claims",
+				Columns:    []*schema.Column{SeasonRewardClaimsColumns[7]},
+				RefColumns: []*schema.Column{SeasonsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "season_reward_claims_users_season_reward_claims",
+				Columns:    []*schema.Column{SeasonRewardClaimsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "seasonrewardclaim_user_id_season_id_level_track",
+				Unique:  true,
+				Columns: []*schema.Column{SeasonRewardClaimsColumns[8], SeasonRewardClaimsColumns[7], SeasonRewardClaimsColumns[1], SeasonRewardClaimsColumns[2]},
+			},
+		},
+	}
+	// SeasonTiersColumns holds the columns for the "season_tiers" table.
+	SeasonTiersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "level", Type: field.TypeInt},
+		{Name: "xp_required", Type: field.TypeInt},
+		{Name: "free_item_id", Type: field.TypeInt, Nullable: true},
+		{Name: "free_coins", Type: field.TypeFloat64, Default: 0},
+		{Name: "premium_item_id", Type: field.TypeInt, Nullable: true},
+		{Name: "premium_coins", Type: field.TypeFloat64, Default: 0},
+		{Name: "season_id", Type: field.TypeInt},
+	}
+	// SeasonTiersTable holds the schema information for the "season_tiers" table.
+	SeasonTiersTable = &schema.Table{
+		Name:       "season_tiers",
+		Columns:    SeasonTiersColumns,
+		PrimaryKey: []*schema.Column{SeasonTiersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "season_tiers_seasons_tiers",
+				Columns:    []*schema.Column{SeasonTiersColumns[7]},
+				RefColumns: []*schema.Column{SeasonsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "seasontier_season_id_level",
+				Unique:  true,
+				Columns: []*schema.Column{SeasonTiersColumns[7], SeasonTiersColumns[1]},
+			},
+		},
+	}
 	// StatisticsColumns holds the columns for the "statistics" table.
 	StatisticsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "type", Type: field.TypeEnum, Enums: []string{"global"}, Default: "global"},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"global", "season"}, Default: "global"},
 		{Name: "period", Type: field.TypeInt, Default: 0},
 		{Name: "xp", Type: field.TypeInt, Default: 0},
 		{Name: "match_count", Type: field.TypeInt, Default: 0},
@@ -205,6 +757,13 @@ var (
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "statistic_user_id_type_period",
+				Unique:  true,
+				Columns: []*schema.Column{StatisticsColumns[22], StatisticsColumns[1], StatisticsColumns[2]},
+			},
+		},
 	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
@@ -213,10 +772,14 @@ var (
 		{Name: "email", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "password", Type: field.TypeString},
 		{Name: "hardware_id", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "hardware_id_fingerprint", Type: field.TypeString, Nullable: true},
+		{Name: "totp_secret", Type: field.TypeString, Nullable: true},
+		{Name: "totp_enabled_at", Type: field.TypeTime, Nullable: true},
 		{Name: "access_level", Type: field.TypeString},
 		{Name: "genshin_uid", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "hoyolab_login", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "avatar_url", Type: field.TypeString, Nullable: true},
+		{Name: "title", Type: field.TypeString, Nullable: true},
 		{Name: "invites_enabled", Type: field.TypeBool, Default: false},
 		{Name: "login_at", Type: field.TypeTime},
 		{Name: "login_streak", Type: field.TypeInt, Default: 0},
@@ -227,6 +790,9 @@ var (
 		{Name: "account_blocked_until", Type: field.TypeTime, Nullable: true},
 		{Name: "account_block_reason", Type: field.TypeString, Nullable: true},
 		{Name: "account_blocked_level", Type: field.TypeInt, Default: 0},
+		{Name: "login_locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "deletion_scheduled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "current_item_in_profile_id", Type: field.TypeInt, Nullable: true},
 		{Name: "current_match_id", Type: field.TypeInt, Nullable: true},
 	}
@@ -238,17 +804,24 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "users_inventory_items_current_item",
-				Columns:    []*schema.Column{UsersColumns[19]},
+				Columns:    []*schema.Column{UsersColumns[26]},
 				RefColumns: []*schema.Column{InventoryItemsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "users_matches_current_match",
-				Columns:    []*schema.Column{UsersColumns[20]},
+				Columns:    []*schema.Column{UsersColumns[27]},
 				RefColumns: []*schema.Column{MatchesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "user_hardware_id_fingerprint",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[5]},
+			},
+		},
 	}
 	// UserBalancesColumns holds the columns for the "user_balances" table.
 	UserBalancesColumns = []*schema.Column{
@@ -279,6 +852,42 @@ var (
 			},
 		},
 	}
+	// UsernameHistoriesColumns holds the columns for the "username_histories" table.
+	UsernameHistoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "old_username", Type: field.TypeString},
+		{Name: "new_username", Type: field.TypeString},
+		{Name: "coins_spent", Type: field.TypeFloat64, Default: 0},
+		{Name: "released_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// UsernameHistoriesTable holds the schema information for the "username_histories" table.
+	UsernameHistoriesTable = &schema.Table{
+		Name:       "username_histories",
+		Columns:    UsernameHistoriesColumns,
+		PrimaryKey: []*schema.Column{UsernameHistoriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "username_histories_users_username_history",
+				Columns:    []*schema.Column{UsernameHistoriesColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usernamehistory_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{UsernameHistoriesColumns[6], UsernameHistoriesColumns[5]},
+			},
+			{
+				Name:    "usernamehistory_old_username_released_at",
+				Unique:  false,
+				Columns: []*schema.Column{UsernameHistoriesColumns[1], UsernameHistoriesColumns[4]},
+			},
+		},
+	}
 	// UserFriendsColumns holds the columns for the "user_friends" table.
 	UserFriendsColumns = []*schema.Column{
 		{Name: "user_id", Type: field.TypeInt},
@@ -304,34 +913,95 @@ var (
 			},
 		},
 	}
+	// UserRolesColumns holds the columns for the "user_roles" table.
+	UserRolesColumns = []*schema.Column{
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "role_id", Type: field.TypeInt},
+	}
+	// UserRolesTable holds the schema information for the "user_roles" table.
+	UserRolesTable = &schema.Table{
+		Name:       "user_roles",
+		Columns:    UserRolesColumns,
+		PrimaryKey: []*schema.Column{UserRolesColumns[0], UserRolesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_roles_user_id",
+				Columns:    []*schema.Column{UserRolesColumns[0]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "user_roles_role_id",
+				Columns:    []*schema.Column{UserRolesColumns[1]},
+				RefColumns: []*schema.Column{RolesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APITokensTable,
+		AuditLogsTable,
 		BannedHardwareIdsTable,
+		CollectionCompletionsTable,
+		CollectionRewardsTable,
+		EmailHistoriesTable,
+		ExternalIdentitiesTable,
 		FriendRequestsTable,
 		GameItemsTable,
+		GrantJobsTable,
+		HardwareIDResetsTable,
 		InventoryItemsTable,
+		LoginHistoriesTable,
 		MatchesTable,
+		PasswordHistoriesTable,
 		PlayerMatchResultsTable,
+		RecoveryCodesTable,
+		RolesTable,
+		SanctionsTable,
+		SeasonsTable,
+		SeasonPassesTable,
+		SeasonRewardClaimsTable,
+		SeasonTiersTable,
 		StatisticsTable,
 		UsersTable,
 		UserBalancesTable,
+		UsernameHistoriesTable,
 		UserFriendsTable,
+		UserRolesTable,
 	}
 )
 
 func init() {
+	APITokensTable.ForeignKeys[0].RefTable = UsersTable
+	CollectionCompletionsTable.ForeignKeys[0].RefTable = UsersTable
+	EmailHistoriesTable.ForeignKeys[0].RefTable = UsersTable
+	ExternalIdentitiesTable.ForeignKeys[0].RefTable = UsersTable
 	FriendRequestsTable.ForeignKeys[0].RefTable = UsersTable
 	FriendRequestsTable.ForeignKeys[1].RefTable = UsersTable
+	HardwareIDResetsTable.ForeignKeys[0].RefTable = UsersTable
 	InventoryItemsTable.ForeignKeys[0].RefTable = GameItemsTable
 	InventoryItemsTable.ForeignKeys[1].RefTable = UsersTable
+	LoginHistoriesTable.ForeignKeys[0].RefTable = UsersTable
 	MatchesTable.ForeignKeys[0].RefTable = UsersTable
 	MatchesTable.ForeignKeys[1].RefTable = UsersTable
+	PasswordHistoriesTable.ForeignKeys[0].RefTable = UsersTable
 	PlayerMatchResultsTable.ForeignKeys[0].RefTable = MatchesTable
 	PlayerMatchResultsTable.ForeignKeys[1].RefTable = UsersTable
+	RecoveryCodesTable.ForeignKeys[0].RefTable = UsersTable
+	SanctionsTable.ForeignKeys[0].RefTable = UsersTable
+	SeasonPassesTable.ForeignKeys[0].RefTable = SeasonsTable
+	SeasonPassesTable.ForeignKeys[1].RefTable = UsersTable
+	SeasonRewardClaimsTable.ForeignKeys[0].RefTable = SeasonsTable
+	SeasonRewardClaimsTable.ForeignKeys[1].RefTable = UsersTable
+	SeasonTiersTable.ForeignKeys[0].RefTable = SeasonsTable
 	StatisticsTable.ForeignKeys[0].RefTable = UsersTable
 	UsersTable.ForeignKeys[0].RefTable = InventoryItemsTable
 	UsersTable.ForeignKeys[1].RefTable = MatchesTable
 	UserBalancesTable.ForeignKeys[0].RefTable = UsersTable
+	UsernameHistoriesTable.ForeignKeys[0].RefTable = UsersTable
 	UserFriendsTable.ForeignKeys[0].RefTable = UsersTable
 	UserFriendsTable.ForeignKeys[1].RefTable = UsersTable
+	UserRolesTable.ForeignKeys[0].RefTable = UsersTable
+	UserRolesTable.ForeignKeys[1].RefTable = RolesTable
 }
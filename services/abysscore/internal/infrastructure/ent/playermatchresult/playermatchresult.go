@@ -39,7 +39,7 @@ const (
 	MatchColumn = "match_id"
 	// UserTable is the table that holds the user relation/edge.
 	UserTable = "player_match_results"
-	// UserInverseTable is the table name for the UserDTO entity.
+	// UserInverseTable is the table name for the User entity.
 	// It exists in this package in order to avoid circular dependency with the "user" package.
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
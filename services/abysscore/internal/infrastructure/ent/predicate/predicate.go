@@ -6,24 +6,75 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// APIToken is the predicate function for apitoken builders.
+type APIToken func(*sql.Selector)
+
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
 // BannedHardwareID is the predicate function for bannedhardwareid builders.
 type BannedHardwareID func(*sql.Selector)
 
+// CollectionCompletion is the predicate function for collectioncompletion builders.
+type CollectionCompletion func(*sql.Selector)
+
+// CollectionReward is the predicate function for collectionreward builders.
+type CollectionReward func(*sql.Selector)
+
+// EmailHistory is the predicate function for emailhistory builders.
+type EmailHistory func(*sql.Selector)
+
+// ExternalIdentity is the predicate function for externalidentity builders.
+type ExternalIdentity func(*sql.Selector)
+
 // FriendRequest is the predicate function for friendrequest builders.
 type FriendRequest func(*sql.Selector)
 
 // GameItem is the predicate function for gameitem builders.
 type GameItem func(*sql.Selector)
 
+// GrantJob is the predicate function for grantjob builders.
+type GrantJob func(*sql.Selector)
+
+// HardwareIDReset is the predicate function for hardwareidreset builders.
+type HardwareIDReset func(*sql.Selector)
+
 // InventoryItem is the predicate function for inventoryitem builders.
 type InventoryItem func(*sql.Selector)
 
+// LoginHistory is the predicate function for loginhistory builders.
+type LoginHistory func(*sql.Selector)
+
 // Match is the predicate function for match builders.
 type Match func(*sql.Selector)
 
+// PasswordHistory is the predicate function for passwordhistory builders.
+type PasswordHistory func(*sql.Selector)
+
 // PlayerMatchResult is the predicate function for playermatchresult builders.
 type PlayerMatchResult func(*sql.Selector)
 
+// RecoveryCode is the predicate function for recoverycode builders.
+type RecoveryCode func(*sql.Selector)
+
+// Role is the predicate function for role builders.
+type Role func(*sql.Selector)
+
+// Sanction is the predicate function for sanction builders.
+type Sanction func(*sql.Selector)
+
+// Season is the predicate function for season builders.
+type Season func(*sql.Selector)
+
+// SeasonPass is the predicate function for seasonpass builders.
+type SeasonPass func(*sql.Selector)
+
+// SeasonRewardClaim is the predicate function for seasonrewardclaim builders.
+type SeasonRewardClaim func(*sql.Selector)
+
+// SeasonTier is the predicate function for seasontier builders.
+type SeasonTier func(*sql.Selector)
+
 // Statistic is the predicate function for statistic builders.
 type Statistic func(*sql.Selector)
 
@@ -32,3 +83,6 @@ type User func(*sql.Selector)
 
 // UserBalance is the predicate function for userbalance builders.
 type UserBalance func(*sql.Selector)
+
+// UsernameHistory is the predicate function for usernamehistory builders.
+type UsernameHistory func(*sql.Selector)
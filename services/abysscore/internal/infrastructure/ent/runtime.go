@@ -5,22 +5,115 @@ package ent
 import (
 	"time"
 
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/apitoken"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/auditlog"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/bannedhardwareid"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/collectioncompletion"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/collectionreward"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/emailhistory"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/externalidentity"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/friendrequest"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/gameitem"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/grantjob"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/hardwareidreset"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/inventoryitem"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/loginhistory"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/match"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/passwordhistory"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/playermatchresult"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/recoverycode"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/role"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/sanction"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/access_level"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/season"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasonpass"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasonrewardclaim"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasontier"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/statistic"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/userbalance"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/usernamehistory"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apitokenFields := schema.APIToken{}.Fields()
+	_ = apitokenFields
+	// apitokenDescName is the schema descriptor for name field.
+	apitokenDescName := apitokenFields[2].Descriptor()
+	// apitoken.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apitoken.NameValidator = apitokenDescName.Validators[0].(func(string) error)
+	// apitokenDescTokenHash is the schema descriptor for token_hash field.
+	apitokenDescTokenHash := apitokenFields[3].Descriptor()
+	// apitoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	apitoken.TokenHashValidator = apitokenDescTokenHash.Validators[0].(func(string) error)
+	// apitokenDescCreatedAt is the schema descriptor for created_at field.
+	apitokenDescCreatedAt := apitokenFields[8].Descriptor()
+	// apitoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apitoken.DefaultCreatedAt = apitokenDescCreatedAt.Default.(func() time.Time)
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescAction is the schema descriptor for action field.
+	auditlogDescAction := auditlogFields[3].Descriptor()
+	// auditlog.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	auditlog.ActionValidator = auditlogDescAction.Validators[0].(func(string) error)
+	// auditlogDescTargetType is the schema descriptor for target_type field.
+	auditlogDescTargetType := auditlogFields[4].Descriptor()
+	// auditlog.TargetTypeValidator is a validator for the "target_type" field. It is called by the builders before save.
+	auditlog.TargetTypeValidator = auditlogDescTargetType.Validators[0].(func(string) error)
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[10].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	bannedhardwareidFields := schema.BannedHardwareID{}.Fields()
+	_ = bannedhardwareidFields
+	// bannedhardwareidDescCreatedAt is the schema descriptor for created_at field.
+	bannedhardwareidDescCreatedAt := bannedhardwareidFields[2].Descriptor()
+	// bannedhardwareid.DefaultCreatedAt holds the default value on creation for the created_at field.
+	bannedhardwareid.DefaultCreatedAt = bannedhardwareidDescCreatedAt.Default.(func() time.Time)
+	collectioncompletionFields := schema.CollectionCompletion{}.Fields()
+	_ = collectioncompletionFields
+	// collectioncompletionDescCollection is the schema descriptor for collection field.
+	collectioncompletionDescCollection := collectioncompletionFields[2].Descriptor()
+	// collectioncompletion.CollectionValidator is a validator for the "collection" field. It is called by the builders before save.
+	collectioncompletion.CollectionValidator = collectioncompletionDescCollection.Validators[0].(func(string) error)
+	// collectioncompletionDescCompletedAt is the schema descriptor for completed_at field.
+	collectioncompletionDescCompletedAt := collectioncompletionFields[3].Descriptor()
+	// collectioncompletion.DefaultCompletedAt holds the default value on creation for the completed_at field.
+	collectioncompletion.DefaultCompletedAt = collectioncompletionDescCompletedAt.Default.(func() time.Time)
+	collectionrewardFields := schema.CollectionReward{}.Fields()
+	_ = collectionrewardFields
+	// collectionrewardDescCollection is the schema descriptor for collection field.
+	collectionrewardDescCollection := collectionrewardFields[1].Descriptor()
+	// collectionreward.CollectionValidator is a validator for the "collection" field. It is called by the builders before save.
+	collectionreward.CollectionValidator = collectionrewardDescCollection.Validators[0].(func(string) error)
+	// collectionrewardDescCoins is the schema descriptor for coins field.
+	collectionrewardDescCoins := collectionrewardFields[2].Descriptor()
+	// collectionreward.DefaultCoins holds the default value on creation for the coins field.
+	collectionreward.DefaultCoins = collectionrewardDescCoins.Default.(float64)
+	// collectionreward.CoinsValidator is a validator for the "coins" field. It is called by the builders before save.
+	collectionreward.CoinsValidator = collectionrewardDescCoins.Validators[0].(func(float64) error)
+	// collectionrewardDescUpdatedAt is the schema descriptor for updated_at field.
+	collectionrewardDescUpdatedAt := collectionrewardFields[5].Descriptor()
+	// collectionreward.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	collectionreward.DefaultUpdatedAt = collectionrewardDescUpdatedAt.Default.(func() time.Time)
+	// collectionreward.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	collectionreward.UpdateDefaultUpdatedAt = collectionrewardDescUpdatedAt.UpdateDefault.(func() time.Time)
+	emailhistoryFields := schema.EmailHistory{}.Fields()
+	_ = emailhistoryFields
+	// emailhistoryDescCreatedAt is the schema descriptor for created_at field.
+	emailhistoryDescCreatedAt := emailhistoryFields[7].Descriptor()
+	// emailhistory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	emailhistory.DefaultCreatedAt = emailhistoryDescCreatedAt.Default.(func() time.Time)
+	externalidentityFields := schema.ExternalIdentity{}.Fields()
+	_ = externalidentityFields
+	// externalidentityDescCreatedAt is the schema descriptor for created_at field.
+	externalidentityDescCreatedAt := externalidentityFields[5].Descriptor()
+	// externalidentity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	externalidentity.DefaultCreatedAt = externalidentityDescCreatedAt.Default.(func() time.Time)
 	friendrequestFields := schema.FriendRequest{}.Fields()
 	_ = friendrequestFields
 	// friendrequestDescCreatedAt is the schema descriptor for created_at field.
@@ -49,6 +142,52 @@ func init() {
 	gameitemDescCreatedAt := gameitemFields[5].Descriptor()
 	// gameitem.DefaultCreatedAt holds the default value on creation for the created_at field.
 	gameitem.DefaultCreatedAt = gameitemDescCreatedAt.Default.(func() time.Time)
+	grantjobFields := schema.GrantJob{}.Fields()
+	_ = grantjobFields
+	// grantjobDescCampaign is the schema descriptor for campaign field.
+	grantjobDescCampaign := grantjobFields[1].Descriptor()
+	// grantjob.CampaignValidator is a validator for the "campaign" field. It is called by the builders before save.
+	grantjob.CampaignValidator = grantjobDescCampaign.Validators[0].(func(string) error)
+	// grantjobDescTotal is the schema descriptor for total field.
+	grantjobDescTotal := grantjobFields[6].Descriptor()
+	// grantjob.DefaultTotal holds the default value on creation for the total field.
+	grantjob.DefaultTotal = grantjobDescTotal.Default.(int)
+	// grantjob.TotalValidator is a validator for the "total" field. It is called by the builders before save.
+	grantjob.TotalValidator = grantjobDescTotal.Validators[0].(func(int) error)
+	// grantjobDescProcessed is the schema descriptor for processed field.
+	grantjobDescProcessed := grantjobFields[7].Descriptor()
+	// grantjob.DefaultProcessed holds the default value on creation for the processed field.
+	grantjob.DefaultProcessed = grantjobDescProcessed.Default.(int)
+	// grantjob.ProcessedValidator is a validator for the "processed" field. It is called by the builders before save.
+	grantjob.ProcessedValidator = grantjobDescProcessed.Validators[0].(func(int) error)
+	// grantjobDescGranted is the schema descriptor for granted field.
+	grantjobDescGranted := grantjobFields[8].Descriptor()
+	// grantjob.DefaultGranted holds the default value on creation for the granted field.
+	grantjob.DefaultGranted = grantjobDescGranted.Default.(int)
+	// grantjob.GrantedValidator is a validator for the "granted" field. It is called by the builders before save.
+	grantjob.GrantedValidator = grantjobDescGranted.Validators[0].(func(int) error)
+	// grantjobDescSkipped is the schema descriptor for skipped field.
+	grantjobDescSkipped := grantjobFields[9].Descriptor()
+	// grantjob.DefaultSkipped holds the default value on creation for the skipped field.
+	grantjob.DefaultSkipped = grantjobDescSkipped.Default.(int)
+	// grantjob.SkippedValidator is a validator for the "skipped" field. It is called by the builders before save.
+	grantjob.SkippedValidator = grantjobDescSkipped.Validators[0].(func(int) error)
+	// grantjobDescFailed is the schema descriptor for failed field.
+	grantjobDescFailed := grantjobFields[10].Descriptor()
+	// grantjob.DefaultFailed holds the default value on creation for the failed field.
+	grantjob.DefaultFailed = grantjobDescFailed.Default.(int)
+	// grantjob.FailedValidator is a validator for the "failed" field. It is called by the builders before save.
+	grantjob.FailedValidator = grantjobDescFailed.Validators[0].(func(int) error)
+	// grantjobDescCreatedAt is the schema descriptor for created_at field.
+	grantjobDescCreatedAt := grantjobFields[14].Descriptor()
+	// grantjob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	grantjob.DefaultCreatedAt = grantjobDescCreatedAt.Default.(func() time.Time)
+	hardwareidresetFields := schema.HardwareIDReset{}.Fields()
+	_ = hardwareidresetFields
+	// hardwareidresetDescCreatedAt is the schema descriptor for created_at field.
+	hardwareidresetDescCreatedAt := hardwareidresetFields[6].Descriptor()
+	// hardwareidreset.DefaultCreatedAt holds the default value on creation for the created_at field.
+	hardwareidreset.DefaultCreatedAt = hardwareidresetDescCreatedAt.Default.(func() time.Time)
 	inventoryitemFields := schema.InventoryItem{}.Fields()
 	_ = inventoryitemFields
 	// inventoryitemDescReceivedFromID is the schema descriptor for received_from_id field.
@@ -56,9 +195,15 @@ func init() {
 	// inventoryitem.DefaultReceivedFromID holds the default value on creation for the received_from_id field.
 	inventoryitem.DefaultReceivedFromID = inventoryitemDescReceivedFromID.Default.(int)
 	// inventoryitemDescObtainedAt is the schema descriptor for obtained_at field.
-	inventoryitemDescObtainedAt := inventoryitemFields[4].Descriptor()
+	inventoryitemDescObtainedAt := inventoryitemFields[5].Descriptor()
 	// inventoryitem.DefaultObtainedAt holds the default value on creation for the obtained_at field.
 	inventoryitem.DefaultObtainedAt = inventoryitemDescObtainedAt.Default.(func() time.Time)
+	loginhistoryFields := schema.LoginHistory{}.Fields()
+	_ = loginhistoryFields
+	// loginhistoryDescCreatedAt is the schema descriptor for created_at field.
+	loginhistoryDescCreatedAt := loginhistoryFields[7].Descriptor()
+	// loginhistory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	loginhistory.DefaultCreatedAt = loginhistoryDescCreatedAt.Default.(func() time.Time)
 	matchFields := schema.Match{}.Fields()
 	_ = matchFields
 	// matchDescPlayer1PenaltyTime is the schema descriptor for player1_penalty_time field.
@@ -81,6 +226,12 @@ func init() {
 	matchDescChangedToCurrentStatusAt := matchFields[8].Descriptor()
 	// match.DefaultChangedToCurrentStatusAt holds the default value on creation for the changed_to_current_status_at field.
 	match.DefaultChangedToCurrentStatusAt = matchDescChangedToCurrentStatusAt.Default.(func() time.Time)
+	passwordhistoryFields := schema.PasswordHistory{}.Fields()
+	_ = passwordhistoryFields
+	// passwordhistoryDescCreatedAt is the schema descriptor for created_at field.
+	passwordhistoryDescCreatedAt := passwordhistoryFields[3].Descriptor()
+	// passwordhistory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	passwordhistory.DefaultCreatedAt = passwordhistoryDescCreatedAt.Default.(func() time.Time)
 	playermatchresultFields := schema.PlayerMatchResult{}.Fields()
 	_ = playermatchresultFields
 	// playermatchresultDescMatchID is the schema descriptor for match_id field.
@@ -103,6 +254,122 @@ func init() {
 	playermatchresultDescCreatedAt := playermatchresultFields[5].Descriptor()
 	// playermatchresult.DefaultCreatedAt holds the default value on creation for the created_at field.
 	playermatchresult.DefaultCreatedAt = playermatchresultDescCreatedAt.Default.(func() time.Time)
+	recoverycodeFields := schema.RecoveryCode{}.Fields()
+	_ = recoverycodeFields
+	// recoverycodeDescCodeHash is the schema descriptor for code_hash field.
+	recoverycodeDescCodeHash := recoverycodeFields[2].Descriptor()
+	// recoverycode.CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	recoverycode.CodeHashValidator = recoverycodeDescCodeHash.Validators[0].(func(string) error)
+	// recoverycodeDescCreatedAt is the schema descriptor for created_at field.
+	recoverycodeDescCreatedAt := recoverycodeFields[4].Descriptor()
+	// recoverycode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	recoverycode.DefaultCreatedAt = recoverycodeDescCreatedAt.Default.(func() time.Time)
+	roleFields := schema.Role{}.Fields()
+	_ = roleFields
+	// roleDescName is the schema descriptor for name field.
+	roleDescName := roleFields[1].Descriptor()
+	// role.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	role.NameValidator = roleDescName.Validators[0].(func(string) error)
+	// roleDescSystem is the schema descriptor for system field.
+	roleDescSystem := roleFields[4].Descriptor()
+	// role.DefaultSystem holds the default value on creation for the system field.
+	role.DefaultSystem = roleDescSystem.Default.(bool)
+	// roleDescCreatedAt is the schema descriptor for created_at field.
+	roleDescCreatedAt := roleFields[5].Descriptor()
+	// role.DefaultCreatedAt holds the default value on creation for the created_at field.
+	role.DefaultCreatedAt = roleDescCreatedAt.Default.(func() time.Time)
+	// roleDescUpdatedAt is the schema descriptor for updated_at field.
+	roleDescUpdatedAt := roleFields[6].Descriptor()
+	// role.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	role.DefaultUpdatedAt = roleDescUpdatedAt.Default.(func() time.Time)
+	// role.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	role.UpdateDefaultUpdatedAt = roleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	sanctionFields := schema.Sanction{}.Fields()
+	_ = sanctionFields
+	// sanctionDescLevel is the schema descriptor for level field.
+	sanctionDescLevel := sanctionFields[3].Descriptor()
+	// sanction.LevelValidator is a validator for the "level" field. It is called by the builders before save.
+	sanction.LevelValidator = sanctionDescLevel.Validators[0].(func(int) error)
+	// sanctionDescPreviousLevel is the schema descriptor for previous_level field.
+	sanctionDescPreviousLevel := sanctionFields[4].Descriptor()
+	// sanction.PreviousLevelValidator is a validator for the "previous_level" field. It is called by the builders before save.
+	sanction.PreviousLevelValidator = sanctionDescPreviousLevel.Validators[0].(func(int) error)
+	// sanctionDescCreatedAt is the schema descriptor for created_at field.
+	sanctionDescCreatedAt := sanctionFields[15].Descriptor()
+	// sanction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	sanction.DefaultCreatedAt = sanctionDescCreatedAt.Default.(func() time.Time)
+	seasonFields := schema.Season{}.Fields()
+	_ = seasonFields
+	// seasonDescNumber is the schema descriptor for number field.
+	seasonDescNumber := seasonFields[1].Descriptor()
+	// season.NumberValidator is a validator for the "number" field. It is called by the builders before save.
+	season.NumberValidator = seasonDescNumber.Validators[0].(func(int) error)
+	// seasonDescName is the schema descriptor for name field.
+	seasonDescName := seasonFields[2].Descriptor()
+	// season.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	season.NameValidator = seasonDescName.Validators[0].(func(string) error)
+	// seasonDescPremiumPrice is the schema descriptor for premium_price field.
+	seasonDescPremiumPrice := seasonFields[5].Descriptor()
+	// season.DefaultPremiumPrice holds the default value on creation for the premium_price field.
+	season.DefaultPremiumPrice = seasonDescPremiumPrice.Default.(float64)
+	// season.PremiumPriceValidator is a validator for the "premium_price" field. It is called by the builders before save.
+	season.PremiumPriceValidator = seasonDescPremiumPrice.Validators[0].(func(float64) error)
+	// seasonDescCreatedAt is the schema descriptor for created_at field.
+	seasonDescCreatedAt := seasonFields[7].Descriptor()
+	// season.DefaultCreatedAt holds the default value on creation for the created_at field.
+	season.DefaultCreatedAt = seasonDescCreatedAt.Default.(func() time.Time)
+	seasonpassFields := schema.SeasonPass{}.Fields()
+	_ = seasonpassFields
+	// seasonpassDescPremium is the schema descriptor for premium field.
+	seasonpassDescPremium := seasonpassFields[3].Descriptor()
+	// seasonpass.DefaultPremium holds the default value on creation for the premium field.
+	seasonpass.DefaultPremium = seasonpassDescPremium.Default.(bool)
+	// seasonpassDescCreatedAt is the schema descriptor for created_at field.
+	seasonpassDescCreatedAt := seasonpassFields[5].Descriptor()
+	// seasonpass.DefaultCreatedAt holds the default value on creation for the created_at field.
+	seasonpass.DefaultCreatedAt = seasonpassDescCreatedAt.Default.(func() time.Time)
+	seasonrewardclaimFields := schema.SeasonRewardClaim{}.Fields()
+	_ = seasonrewardclaimFields
+	// seasonrewardclaimDescLevel is the schema descriptor for level field.
+	seasonrewardclaimDescLevel := seasonrewardclaimFields[3].Descriptor()
+	// seasonrewardclaim.LevelValidator is a validator for the "level" field. It is called by the builders before save.
+	seasonrewardclaim.LevelValidator = seasonrewardclaimDescLevel.Validators[0].(func(int) error)
+	// seasonrewardclaimDescCoins is the schema descriptor for coins field.
+	seasonrewardclaimDescCoins := seasonrewardclaimFields[6].Descriptor()
+	// seasonrewardclaim.DefaultCoins holds the default value on creation for the coins field.
+	seasonrewardclaim.DefaultCoins = seasonrewardclaimDescCoins.Default.(float64)
+	// seasonrewardclaim.CoinsValidator is a validator for the "coins" field. It is called by the builders before save.
+	seasonrewardclaim.CoinsValidator = seasonrewardclaimDescCoins.Validators[0].(func(float64) error)
+	// seasonrewardclaimDescAutomatic is the schema descriptor for automatic field.
+	seasonrewardclaimDescAutomatic := seasonrewardclaimFields[7].Descriptor()
+	// seasonrewardclaim.DefaultAutomatic holds the default value on creation for the automatic field.
+	seasonrewardclaim.DefaultAutomatic = seasonrewardclaimDescAutomatic.Default.(bool)
+	// seasonrewardclaimDescClaimedAt is the schema descriptor for claimed_at field.
+	seasonrewardclaimDescClaimedAt := seasonrewardclaimFields[8].Descriptor()
+	// seasonrewardclaim.DefaultClaimedAt holds the default value on creation for the claimed_at field.
+	seasonrewardclaim.DefaultClaimedAt = seasonrewardclaimDescClaimedAt.Default.(func() time.Time)
+	seasontierFields := schema.SeasonTier{}.Fields()
+	_ = seasontierFields
+	// seasontierDescLevel is the schema descriptor for level field.
+	seasontierDescLevel := seasontierFields[2].Descriptor()
+	// seasontier.LevelValidator is a validator for the "level" field. It is called by the builders before save.
+	seasontier.LevelValidator = seasontierDescLevel.Validators[0].(func(int) error)
+	// seasontierDescXpRequired is the schema descriptor for xp_required field.
+	seasontierDescXpRequired := seasontierFields[3].Descriptor()
+	// seasontier.XpRequiredValidator is a validator for the "xp_required" field. It is called by the builders before save.
+	seasontier.XpRequiredValidator = seasontierDescXpRequired.Validators[0].(func(int) error)
+	// seasontierDescFreeCoins is the schema descriptor for free_coins field.
+	seasontierDescFreeCoins := seasontierFields[5].Descriptor()
+	// seasontier.DefaultFreeCoins holds the default value on creation for the free_coins field.
+	seasontier.DefaultFreeCoins = seasontierDescFreeCoins.Default.(float64)
+	// seasontier.FreeCoinsValidator is a validator for the "free_coins" field. It is called by the builders before save.
+	seasontier.FreeCoinsValidator = seasontierDescFreeCoins.Validators[0].(func(float64) error)
+	// seasontierDescPremiumCoins is the schema descriptor for premium_coins field.
+	seasontierDescPremiumCoins := seasontierFields[7].Descriptor()
+	// seasontier.DefaultPremiumCoins holds the default value on creation for the premium_coins field.
+	seasontier.DefaultPremiumCoins = seasontierDescPremiumCoins.Default.(float64)
+	// seasontier.PremiumCoinsValidator is a validator for the "premium_coins" field. It is called by the builders before save.
+	seasontier.PremiumCoinsValidator = seasontierDescPremiumCoins.Validators[0].(func(float64) error)
 	statisticFields := schema.Statistic{}.Fields()
 	_ = statisticFields
 	// statisticDescPeriod is the schema descriptor for period field.
@@ -234,33 +501,33 @@ func init() {
 	// user.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
 	user.PasswordValidator = userDescPassword.Validators[0].(func(string) error)
 	// userDescAccessLevel is the schema descriptor for access_level field.
-	userDescAccessLevel := userFields[5].Descriptor()
+	userDescAccessLevel := userFields[8].Descriptor()
 	// user.DefaultAccessLevel holds the default value on creation for the access_level field.
 	user.DefaultAccessLevel = userDescAccessLevel.Default.(func() access_level.AccessLevel)
 	// userDescInvitesEnabled is the schema descriptor for invites_enabled field.
-	userDescInvitesEnabled := userFields[11].Descriptor()
+	userDescInvitesEnabled := userFields[15].Descriptor()
 	// user.DefaultInvitesEnabled holds the default value on creation for the invites_enabled field.
 	user.DefaultInvitesEnabled = userDescInvitesEnabled.Default.(bool)
 	// userDescLoginAt is the schema descriptor for login_at field.
-	userDescLoginAt := userFields[12].Descriptor()
+	userDescLoginAt := userFields[16].Descriptor()
 	// user.DefaultLoginAt holds the default value on creation for the login_at field.
 	user.DefaultLoginAt = userDescLoginAt.Default.(func() time.Time)
 	// userDescLoginStreak is the schema descriptor for login_streak field.
-	userDescLoginStreak := userFields[13].Descriptor()
+	userDescLoginStreak := userFields[17].Descriptor()
 	// user.DefaultLoginStreak holds the default value on creation for the login_streak field.
 	user.DefaultLoginStreak = userDescLoginStreak.Default.(int)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[14].Descriptor()
+	userDescCreatedAt := userFields[18].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescSearchBlockedLevel is the schema descriptor for search_blocked_level field.
-	userDescSearchBlockedLevel := userFields[17].Descriptor()
+	userDescSearchBlockedLevel := userFields[21].Descriptor()
 	// user.DefaultSearchBlockedLevel holds the default value on creation for the search_blocked_level field.
 	user.DefaultSearchBlockedLevel = userDescSearchBlockedLevel.Default.(int)
 	// user.SearchBlockedLevelValidator is a validator for the "search_blocked_level" field. It is called by the builders before save.
 	user.SearchBlockedLevelValidator = userDescSearchBlockedLevel.Validators[0].(func(int) error)
 	// userDescAccountBlockedLevel is the schema descriptor for account_blocked_level field.
-	userDescAccountBlockedLevel := userFields[20].Descriptor()
+	userDescAccountBlockedLevel := userFields[24].Descriptor()
 	// user.DefaultAccountBlockedLevel holds the default value on creation for the account_blocked_level field.
 	user.DefaultAccountBlockedLevel = userDescAccountBlockedLevel.Default.(int)
 	// user.AccountBlockedLevelValidator is a validator for the "account_blocked_level" field. It is called by the builders before save.
@@ -281,4 +548,14 @@ func init() {
 	userbalanceDescCreatedAt := userbalanceFields[4].Descriptor()
 	// userbalance.DefaultCreatedAt holds the default value on creation for the created_at field.
 	userbalance.DefaultCreatedAt = userbalanceDescCreatedAt.Default.(func() time.Time)
+	usernamehistoryFields := schema.UsernameHistory{}.Fields()
+	_ = usernamehistoryFields
+	// usernamehistoryDescCoinsSpent is the schema descriptor for coins_spent field.
+	usernamehistoryDescCoinsSpent := usernamehistoryFields[4].Descriptor()
+	// usernamehistory.DefaultCoinsSpent holds the default value on creation for the coins_spent field.
+	usernamehistory.DefaultCoinsSpent = usernamehistoryDescCoinsSpent.Default.(float64)
+	// usernamehistoryDescCreatedAt is the schema descriptor for created_at field.
+	usernamehistoryDescCreatedAt := usernamehistoryFields[6].Descriptor()
+	// usernamehistory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	usernamehistory.DefaultCreatedAt = usernamehistoryDescCreatedAt.Default.(func() time.Time)
 }
@@ -0,0 +1,307 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/sanction"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
+)
+
+// Sanction is the model entity for the Sanction schema.
+type Sanction struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type sanction.Type `json:"type,omitempty"`
+	// Level holds the value of the "level" field.
+	Level int `json:"level,omitempty"`
+	// PreviousLevel holds the value of the "previous_level" field.
+	PreviousLevel int `json:"previous_level,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// BlockedUntil holds the value of the "blocked_until" field.
+	BlockedUntil time.Time `json:"blocked_until,omitempty"`
+	// PerformerID holds the value of the "performer_id" field.
+	PerformerID int `json:"performer_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status sanction.Status `json:"status,omitempty"`
+	// ResolverID holds the value of the "resolver_id" field.
+	ResolverID *int `json:"resolver_id,omitempty"`
+	// ResolutionComment holds the value of the "resolution_comment" field.
+	ResolutionComment *string `json:"resolution_comment,omitempty"`
+	// ResolvedAt holds the value of the "resolved_at" field.
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	// AppealStatus holds the value of the "appeal_status" field.
+	AppealStatus *sanction.AppealStatus `json:"appeal_status,omitempty"`
+	// AppealMessage holds the value of the "appeal_message" field.
+	AppealMessage *string `json:"appeal_message,omitempty"`
+	// AppealedAt holds the value of the "appealed_at" field.
+	AppealedAt *time.Time `json:"appealed_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SanctionQuery when eager-loading is set.
+	Edges        SanctionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SanctionEdges holds the relations/edges for other nodes in the graph.
+type SanctionEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SanctionEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Sanction) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sanction.FieldID, sanction.FieldUserID, sanction.FieldLevel, sanction.FieldPreviousLevel, sanction.FieldPerformerID, sanction.FieldResolverID:
+			values[i] = new(sql.NullInt64)
+		case sanction.FieldType, sanction.FieldReason, sanction.FieldStatus, sanction.FieldResolutionComment, sanction.FieldAppealStatus, sanction.FieldAppealMessage:
+			values[i] = new(sql.NullString)
+		case sanction.FieldBlockedUntil, sanction.FieldResolvedAt, sanction.FieldAppealedAt, sanction.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Sanction fields.
+func (s *Sanction) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sanction.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			s.ID = int(value.Int64)
+		case sanction.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				s.UserID = int(value.Int64)
+			}
+		case sanction.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				s.Type = sanction.Type(value.String)
+			}
+		case sanction.FieldLevel:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field level", values[i])
+			} else if value.Valid {
+				s.Level = int(value.Int64)
+			}
+		case sanction.FieldPreviousLevel:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_level", values[i])
+			} else if value.Valid {
+				s.PreviousLevel = int(value.Int64)
+			}
+		case sanction.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				s.Reason = value.String
+			}
+		case sanction.FieldBlockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field blocked_until", values[i])
+			} else if value.Valid {
+				s.BlockedUntil = value.Time
+			}
+		case sanction.FieldPerformerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field performer_id", values[i])
+			} else if value.Valid {
+				s.PerformerID = int(value.Int64)
+			}
+		case sanction.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				s.Status = sanction.Status(value.String)
+			}
+		case sanction.FieldResolverID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field resolver_id", values[i])
+			} else if value.Valid {
+				s.ResolverID = new(int)
+				*s.ResolverID = int(value.Int64)
+			}
+		case sanction.FieldResolutionComment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resolution_comment", values[i])
+			} else if value.Valid {
+				s.ResolutionComment = new(string)
+				*s.ResolutionComment = value.String
+			}
+		case sanction.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				s.ResolvedAt = new(time.Time)
+				*s.ResolvedAt = value.Time
+			}
+		case sanction.FieldAppealStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field appeal_status", values[i])
+			} else if value.Valid {
+				s.AppealStatus = new(sanction.AppealStatus)
+				*s.AppealStatus = sanction.AppealStatus(value.String)
+			}
+		case sanction.FieldAppealMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field appeal_message", values[i])
+			} else if value.Valid {
+				s.AppealMessage = new(string)
+				*s.AppealMessage = value.String
+			}
+		case sanction.FieldAppealedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field appealed_at", values[i])
+			} else if value.Valid {
+				s.AppealedAt = new(time.Time)
+				*s.AppealedAt = value.Time
+			}
+		case sanction.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Sanction.
+// This includes values selected through modifiers, order, etc.
+func (s *Sanction) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Sanction entity.
+func (s *Sanction) QueryUser() *UserQuery {
+	return NewSanctionClient(s.config).QueryUser(s)
+}
+
+// Update returns a builder for updating this Sanction.
+// Note that you need to call Sanction.Unwrap() before calling this method if this Sanction
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Sanction) Update() *SanctionUpdateOne {
+	return NewSanctionClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Sanction entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Sanction) Unwrap() *Sanction {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Sanction is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Sanction) String() string {
+	var builder strings.Builder
+	builder.WriteString("Sanction(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", s.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", s.Type))
+	builder.WriteString(", ")
+	builder.WriteString("level=")
+	builder.WriteString(fmt.Sprintf("%v", s.Level))
+	builder.WriteString(", ")
+	builder.WriteString("previous_level=")
+	builder.WriteString(fmt.Sprintf("%v", s.PreviousLevel))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(s.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("blocked_until=")
+	builder.WriteString(s.BlockedUntil.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("performer_id=")
+	builder.WriteString(fmt.Sprintf("%v", s.PerformerID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", s.Status))
+	builder.WriteString(", ")
+	if v := s.ResolverID; v != nil {
+		builder.WriteString("resolver_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := s.ResolutionComment; v != nil {
+		builder.WriteString("resolution_comment=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := s.ResolvedAt; v != nil {
+		builder.WriteString("resolved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := s.AppealStatus; v != nil {
+		builder.WriteString("appeal_status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := s.AppealMessage; v != nil {
+		builder.WriteString("appeal_message=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := s.AppealedAt; v != nil {
+		builder.WriteString("appealed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Sanctions is a parsable slice of Sanction.
+type Sanctions []*Sanction
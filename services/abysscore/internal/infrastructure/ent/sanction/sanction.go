@@ -0,0 +1,269 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sanction
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the sanction type in the database.
+	Label = "sanction"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldLevel holds the string denoting the level field in the database.
+	FieldLevel = "level"
+	// FieldPreviousLevel holds the string denoting the previous_level field in the database.
+	FieldPreviousLevel = "previous_level"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldBlockedUntil holds the string denoting the blocked_until field in the database.
+	FieldBlockedUntil = "blocked_until"
+	// FieldPerformerID holds the string denoting the performer_id field in the database.
+	FieldPerformerID = "performer_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldResolverID holds the string denoting the resolver_id field in the database.
+	FieldResolverID = "resolver_id"
+	// FieldResolutionComment holds the string denoting the resolution_comment field in the database.
+	FieldResolutionComment = "resolution_comment"
+	// FieldResolvedAt holds the string denoting the resolved_at field in the database.
+	FieldResolvedAt = "resolved_at"
+	// FieldAppealStatus holds the string denoting the appeal_status field in the database.
+	FieldAppealStatus = "appeal_status"
+	// FieldAppealMessage holds the string denoting the appeal_message field in the database.
+	FieldAppealMessage = "appeal_message"
+	// FieldAppealedAt holds the string denoting the appealed_at field in the database.
+	FieldAppealedAt = "appealed_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the sanction in the database.
+	Table = "sanctions"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "sanctions"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+)
+
+// Columns holds all SQL columns for sanction fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldType,
+	FieldLevel,
+	FieldPreviousLevel,
+	FieldReason,
+	FieldBlockedUntil,
+	FieldPerformerID,
+	FieldStatus,
+	FieldResolverID,
+	FieldResolutionComment,
+	FieldResolvedAt,
+	FieldAppealStatus,
+	FieldAppealMessage,
+	FieldAppealedAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// LevelValidator is a validator for the "level" field. It is called by the builders before save.
+	LevelValidator func(int) error
+	// PreviousLevelValidator is a validator for the "previous_level" field. It is called by the builders before save.
+	PreviousLevelValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Type defines the type for the "type" enum field.
+type Type string
+
+// Type values.
+const (
+	TypeAccount Type = "account"
+	TypeSearch  Type = "search"
+)
+
+func (_type Type) String() string {
+	return string(_type)
+}
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type Type) error {
+	switch _type {
+	case TypeAccount, TypeSearch:
+		return nil
+	default:
+		return fmt.Errorf("sanction: invalid enum value for type field: %q", _type)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusActive is the default value of the Status enum.
+const DefaultStatus = StatusActive
+
+// Status values.
+const (
+	StatusActive  Status = "active"
+	StatusLifted  Status = "lifted"
+	StatusRevoked Status = "revoked"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusActive, StatusLifted, StatusRevoked:
+		return nil
+	default:
+		return fmt.Errorf("sanction: invalid enum value for status field: %q", s)
+	}
+}
+
+// AppealStatus defines the type for the "appeal_status" enum field.
+type AppealStatus string
+
+// AppealStatus values.
+const (
+	AppealStatusPending  AppealStatus = "pending"
+	AppealStatusAccepted AppealStatus = "accepted"
+	AppealStatusRejected AppealStatus = "rejected"
+)
+
+func (as AppealStatus) String() string {
+	return string(as)
+}
+
+// AppealStatusValidator is a validator for the "appeal_status" field enum values. It is called by the builders before save.
+func AppealStatusValidator(as AppealStatus) error {
+	switch as {
+	case AppealStatusPending, AppealStatusAccepted, AppealStatusRejected:
+		return nil
+	default:
+		return fmt.Errorf("sanction: invalid enum value for appeal_status field: %q", as)
+	}
+}
+
+// OrderOption defines the ordering options for the Sanction queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByLevel orders the results by the level field.
+func ByLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLevel, opts...).ToFunc()
+}
+
+// ByPreviousLevel orders the results by the previous_level field.
+func ByPreviousLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousLevel, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByBlockedUntil orders the results by the blocked_until field.
+func ByBlockedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlockedUntil, opts...).ToFunc()
+}
+
+// ByPerformerID orders the results by the performer_id field.
+func ByPerformerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPerformerID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByResolverID orders the results by the resolver_id field.
+func ByResolverID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolverID, opts...).ToFunc()
+}
+
+// ByResolutionComment orders the results by the resolution_comment field.
+func ByResolutionComment(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolutionComment, opts...).ToFunc()
+}
+
+// ByResolvedAt orders the results by the resolved_at field.
+func ByResolvedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolvedAt, opts...).ToFunc()
+}
+
+// ByAppealStatus orders the results by the appeal_status field.
+func ByAppealStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAppealStatus, opts...).ToFunc()
+}
+
+// ByAppealMessage orders the results by the appeal_message field.
+func ByAppealMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAppealMessage, opts...).ToFunc()
+}
+
+// ByAppealedAt orders the results by the appealed_at field.
+func ByAppealedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAppealedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
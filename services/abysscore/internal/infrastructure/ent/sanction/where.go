@@ -0,0 +1,809 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sanction
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldUserID, v))
+}
+
+// Level applies equality check predicate on the "level" field. It's identical to LevelEQ.
+func Level(v int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldLevel, v))
+}
+
+// PreviousLevel applies equality check predicate on the "previous_level" field. It's identical to PreviousLevelEQ.
+func PreviousLevel(v int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldPreviousLevel, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldReason, v))
+}
+
+// BlockedUntil applies equality check predicate on the "blocked_until" field. It's identical to BlockedUntilEQ.
+func BlockedUntil(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldBlockedUntil, v))
+}
+
+// PerformerID applies equality check predicate on the "performer_id" field. It's identical to PerformerIDEQ.
+func PerformerID(v int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldPerformerID, v))
+}
+
+// ResolverID applies equality check predicate on the "resolver_id" field. It's identical to ResolverIDEQ.
+func ResolverID(v int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldResolverID, v))
+}
+
+// ResolutionComment applies equality check predicate on the "resolution_comment" field. It's identical to ResolutionCommentEQ.
+func ResolutionComment(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldResolutionComment, v))
+}
+
+// ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
+func ResolvedAt(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// AppealMessage applies equality check predicate on the "appeal_message" field. It's identical to AppealMessageEQ.
+func AppealMessage(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldAppealMessage, v))
+}
+
+// AppealedAt applies equality check predicate on the "appealed_at" field. It's identical to AppealedAtEQ.
+func AppealedAt(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldAppealedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.Sanction {
+	return predicate.Sanction(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNotIn(FieldType, vs...))
+}
+
+// LevelEQ applies the EQ predicate on the "level" field.
+func LevelEQ(v int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldLevel, v))
+}
+
+// LevelNEQ applies the NEQ predicate on the "level" field.
+func LevelNEQ(v int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNEQ(FieldLevel, v))
+}
+
+// LevelIn applies the In predicate on the "level" field.
+func LevelIn(vs ...int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldIn(FieldLevel, vs...))
+}
+
+// LevelNotIn applies the NotIn predicate on the "level" field.
+func LevelNotIn(vs ...int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNotIn(FieldLevel, vs...))
+}
+
+// LevelGT applies the GT predicate on the "level" field.
+func LevelGT(v int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGT(FieldLevel, v))
+}
+
+// LevelGTE applies the GTE predicate on the "level" field.
+func LevelGTE(v int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGTE(FieldLevel, v))
+}
+
+// LevelLT applies the LT predicate on the "level" field.
+func LevelLT(v int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLT(FieldLevel, v))
+}
+
+// LevelLTE applies the LTE predicate on the "level" field.
+func LevelLTE(v int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLTE(FieldLevel, v))
+}
+
+// PreviousLevelEQ applies the EQ predicate on the "previous_level" field.
+func PreviousLevelEQ(v int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldPreviousLevel, v))
+}
+
+// PreviousLevelNEQ applies the NEQ predicate on the "previous_level" field.
+func PreviousLevelNEQ(v int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNEQ(FieldPreviousLevel, v))
+}
+
+// PreviousLevelIn applies the In predicate on the "previous_level" field.
+func PreviousLevelIn(vs ...int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldIn(FieldPreviousLevel, vs...))
+}
+
+// PreviousLevelNotIn applies the NotIn predicate on the "previous_level" field.
+func PreviousLevelNotIn(vs ...int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNotIn(FieldPreviousLevel, vs...))
+}
+
+// PreviousLevelGT applies the GT predicate on the "previous_level" field.
+func PreviousLevelGT(v int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGT(FieldPreviousLevel, v))
+}
+
+// PreviousLevelGTE applies the GTE predicate on the "previous_level" field.
+func PreviousLevelGTE(v int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGTE(FieldPreviousLevel, v))
+}
+
+// PreviousLevelLT applies the LT predicate on the "previous_level" field.
+func PreviousLevelLT(v int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLT(FieldPreviousLevel, v))
+}
+
+// PreviousLevelLTE applies the LTE predicate on the "previous_level" field.
+func PreviousLevelLTE(v int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLTE(FieldPreviousLevel, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldContainsFold(FieldReason, v))
+}
+
+// BlockedUntilEQ applies the EQ predicate on the "blocked_until" field.
+func BlockedUntilEQ(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldBlockedUntil, v))
+}
+
+// BlockedUntilNEQ applies the NEQ predicate on the "blocked_until" field.
+func BlockedUntilNEQ(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNEQ(FieldBlockedUntil, v))
+}
+
+// BlockedUntilIn applies the In predicate on the "blocked_until" field.
+func BlockedUntilIn(vs ...time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldIn(FieldBlockedUntil, vs...))
+}
+
+// BlockedUntilNotIn applies the NotIn predicate on the "blocked_until" field.
+func BlockedUntilNotIn(vs ...time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNotIn(FieldBlockedUntil, vs...))
+}
+
+// BlockedUntilGT applies the GT predicate on the "blocked_until" field.
+func BlockedUntilGT(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGT(FieldBlockedUntil, v))
+}
+
+// BlockedUntilGTE applies the GTE predicate on the "blocked_until" field.
+func BlockedUntilGTE(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGTE(FieldBlockedUntil, v))
+}
+
+// BlockedUntilLT applies the LT predicate on the "blocked_until" field.
+func BlockedUntilLT(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLT(FieldBlockedUntil, v))
+}
+
+// BlockedUntilLTE applies the LTE predicate on the "blocked_until" field.
+func BlockedUntilLTE(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLTE(FieldBlockedUntil, v))
+}
+
+// PerformerIDEQ applies the EQ predicate on the "performer_id" field.
+func PerformerIDEQ(v int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldPerformerID, v))
+}
+
+// PerformerIDNEQ applies the NEQ predicate on the "performer_id" field.
+func PerformerIDNEQ(v int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNEQ(FieldPerformerID, v))
+}
+
+// PerformerIDIn applies the In predicate on the "performer_id" field.
+func PerformerIDIn(vs ...int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldIn(FieldPerformerID, vs...))
+}
+
+// PerformerIDNotIn applies the NotIn predicate on the "performer_id" field.
+func PerformerIDNotIn(vs ...int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNotIn(FieldPerformerID, vs...))
+}
+
+// PerformerIDGT applies the GT predicate on the "performer_id" field.
+func PerformerIDGT(v int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGT(FieldPerformerID, v))
+}
+
+// PerformerIDGTE applies the GTE predicate on the "performer_id" field.
+func PerformerIDGTE(v int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGTE(FieldPerformerID, v))
+}
+
+// PerformerIDLT applies the LT predicate on the "performer_id" field.
+func PerformerIDLT(v int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLT(FieldPerformerID, v))
+}
+
+// PerformerIDLTE applies the LTE predicate on the "performer_id" field.
+func PerformerIDLTE(v int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLTE(FieldPerformerID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Sanction {
+	return predicate.Sanction(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ResolverIDEQ applies the EQ predicate on the "resolver_id" field.
+func ResolverIDEQ(v int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldResolverID, v))
+}
+
+// ResolverIDNEQ applies the NEQ predicate on the "resolver_id" field.
+func ResolverIDNEQ(v int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNEQ(FieldResolverID, v))
+}
+
+// ResolverIDIn applies the In predicate on the "resolver_id" field.
+func ResolverIDIn(vs ...int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldIn(FieldResolverID, vs...))
+}
+
+// ResolverIDNotIn applies the NotIn predicate on the "resolver_id" field.
+func ResolverIDNotIn(vs ...int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNotIn(FieldResolverID, vs...))
+}
+
+// ResolverIDGT applies the GT predicate on the "resolver_id" field.
+func ResolverIDGT(v int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGT(FieldResolverID, v))
+}
+
+// ResolverIDGTE applies the GTE predicate on the "resolver_id" field.
+func ResolverIDGTE(v int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGTE(FieldResolverID, v))
+}
+
+// ResolverIDLT applies the LT predicate on the "resolver_id" field.
+func ResolverIDLT(v int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLT(FieldResolverID, v))
+}
+
+// ResolverIDLTE applies the LTE predicate on the "resolver_id" field.
+func ResolverIDLTE(v int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLTE(FieldResolverID, v))
+}
+
+// ResolverIDIsNil applies the IsNil predicate on the "resolver_id" field.
+func ResolverIDIsNil() predicate.Sanction {
+	return predicate.Sanction(sql.FieldIsNull(FieldResolverID))
+}
+
+// ResolverIDNotNil applies the NotNil predicate on the "resolver_id" field.
+func ResolverIDNotNil() predicate.Sanction {
+	return predicate.Sanction(sql.FieldNotNull(FieldResolverID))
+}
+
+// ResolutionCommentEQ applies the EQ predicate on the "resolution_comment" field.
+func ResolutionCommentEQ(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldResolutionComment, v))
+}
+
+// ResolutionCommentNEQ applies the NEQ predicate on the "resolution_comment" field.
+func ResolutionCommentNEQ(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNEQ(FieldResolutionComment, v))
+}
+
+// ResolutionCommentIn applies the In predicate on the "resolution_comment" field.
+func ResolutionCommentIn(vs ...string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldIn(FieldResolutionComment, vs...))
+}
+
+// ResolutionCommentNotIn applies the NotIn predicate on the "resolution_comment" field.
+func ResolutionCommentNotIn(vs ...string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNotIn(FieldResolutionComment, vs...))
+}
+
+// ResolutionCommentGT applies the GT predicate on the "resolution_comment" field.
+func ResolutionCommentGT(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGT(FieldResolutionComment, v))
+}
+
+// ResolutionCommentGTE applies the GTE predicate on the "resolution_comment" field.
+func ResolutionCommentGTE(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGTE(FieldResolutionComment, v))
+}
+
+// ResolutionCommentLT applies the LT predicate on the "resolution_comment" field.
+func ResolutionCommentLT(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLT(FieldResolutionComment, v))
+}
+
+// ResolutionCommentLTE applies the LTE predicate on the "resolution_comment" field.
+func ResolutionCommentLTE(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLTE(FieldResolutionComment, v))
+}
+
+// ResolutionCommentContains applies the Contains predicate on the "resolution_comment" field.
+func ResolutionCommentContains(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldContains(FieldResolutionComment, v))
+}
+
+// ResolutionCommentHasPrefix applies the HasPrefix predicate on the "resolution_comment" field.
+func ResolutionCommentHasPrefix(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldHasPrefix(FieldResolutionComment, v))
+}
+
+// ResolutionCommentHasSuffix applies the HasSuffix predicate on the "resolution_comment" field.
+func ResolutionCommentHasSuffix(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldHasSuffix(FieldResolutionComment, v))
+}
+
+// ResolutionCommentIsNil applies the IsNil predicate on the "resolution_comment" field.
+func ResolutionCommentIsNil() predicate.Sanction {
+	return predicate.Sanction(sql.FieldIsNull(FieldResolutionComment))
+}
+
+// ResolutionCommentNotNil applies the NotNil predicate on the "resolution_comment" field.
+func ResolutionCommentNotNil() predicate.Sanction {
+	return predicate.Sanction(sql.FieldNotNull(FieldResolutionComment))
+}
+
+// ResolutionCommentEqualFold applies the EqualFold predicate on the "resolution_comment" field.
+func ResolutionCommentEqualFold(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEqualFold(FieldResolutionComment, v))
+}
+
+// ResolutionCommentContainsFold applies the ContainsFold predicate on the "resolution_comment" field.
+func ResolutionCommentContainsFold(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldContainsFold(FieldResolutionComment, v))
+}
+
+// ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
+func ResolvedAtEQ(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtNEQ applies the NEQ predicate on the "resolved_at" field.
+func ResolvedAtNEQ(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtIn applies the In predicate on the "resolved_at" field.
+func ResolvedAtIn(vs ...time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtNotIn applies the NotIn predicate on the "resolved_at" field.
+func ResolvedAtNotIn(vs ...time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNotIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtGT applies the GT predicate on the "resolved_at" field.
+func ResolvedAtGT(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGT(FieldResolvedAt, v))
+}
+
+// ResolvedAtGTE applies the GTE predicate on the "resolved_at" field.
+func ResolvedAtGTE(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtLT applies the LT predicate on the "resolved_at" field.
+func ResolvedAtLT(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLT(FieldResolvedAt, v))
+}
+
+// ResolvedAtLTE applies the LTE predicate on the "resolved_at" field.
+func ResolvedAtLTE(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtIsNil applies the IsNil predicate on the "resolved_at" field.
+func ResolvedAtIsNil() predicate.Sanction {
+	return predicate.Sanction(sql.FieldIsNull(FieldResolvedAt))
+}
+
+// ResolvedAtNotNil applies the NotNil predicate on the "resolved_at" field.
+func ResolvedAtNotNil() predicate.Sanction {
+	return predicate.Sanction(sql.FieldNotNull(FieldResolvedAt))
+}
+
+// AppealStatusEQ applies the EQ predicate on the "appeal_status" field.
+func AppealStatusEQ(v AppealStatus) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldAppealStatus, v))
+}
+
+// AppealStatusNEQ applies the NEQ predicate on the "appeal_status" field.
+func AppealStatusNEQ(v AppealStatus) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNEQ(FieldAppealStatus, v))
+}
+
+// AppealStatusIn applies the In predicate on the "appeal_status" field.
+func AppealStatusIn(vs ...AppealStatus) predicate.Sanction {
+	return predicate.Sanction(sql.FieldIn(FieldAppealStatus, vs...))
+}
+
+// AppealStatusNotIn applies the NotIn predicate on the "appeal_status" field.
+func AppealStatusNotIn(vs ...AppealStatus) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNotIn(FieldAppealStatus, vs...))
+}
+
+// AppealStatusIsNil applies the IsNil predicate on the "appeal_status" field.
+func AppealStatusIsNil() predicate.Sanction {
+	return predicate.Sanction(sql.FieldIsNull(FieldAppealStatus))
+}
+
+// AppealStatusNotNil applies the NotNil predicate on the "appeal_status" field.
+func AppealStatusNotNil() predicate.Sanction {
+	return predicate.Sanction(sql.FieldNotNull(FieldAppealStatus))
+}
+
+// AppealMessageEQ applies the EQ predicate on the "appeal_message" field.
+func AppealMessageEQ(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldAppealMessage, v))
+}
+
+// AppealMessageNEQ applies the NEQ predicate on the "appeal_message" field.
+func AppealMessageNEQ(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNEQ(FieldAppealMessage, v))
+}
+
+// AppealMessageIn applies the In predicate on the "appeal_message" field.
+func AppealMessageIn(vs ...string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldIn(FieldAppealMessage, vs...))
+}
+
+// AppealMessageNotIn applies the NotIn predicate on the "appeal_message" field.
+func AppealMessageNotIn(vs ...string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNotIn(FieldAppealMessage, vs...))
+}
+
+// AppealMessageGT applies the GT predicate on the "appeal_message" field.
+func AppealMessageGT(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGT(FieldAppealMessage, v))
+}
+
+// AppealMessageGTE applies the GTE predicate on the "appeal_message" field.
+func AppealMessageGTE(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGTE(FieldAppealMessage, v))
+}
+
+// AppealMessageLT applies the LT predicate on the "appeal_message" field.
+func AppealMessageLT(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLT(FieldAppealMessage, v))
+}
+
+// AppealMessageLTE applies the LTE predicate on the "appeal_message" field.
+func AppealMessageLTE(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLTE(FieldAppealMessage, v))
+}
+
+// AppealMessageContains applies the Contains predicate on the "appeal_message" field.
+func AppealMessageContains(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldContains(FieldAppealMessage, v))
+}
+
+// AppealMessageHasPrefix applies the HasPrefix predicate on the "appeal_message" field.
+func AppealMessageHasPrefix(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldHasPrefix(FieldAppealMessage, v))
+}
+
+// AppealMessageHasSuffix applies the HasSuffix predicate on the "appeal_message" field.
+func AppealMessageHasSuffix(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldHasSuffix(FieldAppealMessage, v))
+}
+
+// AppealMessageIsNil applies the IsNil predicate on the "appeal_message" field.
+func AppealMessageIsNil() predicate.Sanction {
+	return predicate.Sanction(sql.FieldIsNull(FieldAppealMessage))
+}
+
+// AppealMessageNotNil applies the NotNil predicate on the "appeal_message" field.
+func AppealMessageNotNil() predicate.Sanction {
+	return predicate.Sanction(sql.FieldNotNull(FieldAppealMessage))
+}
+
+// AppealMessageEqualFold applies the EqualFold predicate on the "appeal_message" field.
+func AppealMessageEqualFold(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEqualFold(FieldAppealMessage, v))
+}
+
+// AppealMessageContainsFold applies the ContainsFold predicate on the "appeal_message" field.
+func AppealMessageContainsFold(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldContainsFold(FieldAppealMessage, v))
+}
+
+// AppealedAtEQ applies the EQ predicate on the "appealed_at" field.
+func AppealedAtEQ(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldAppealedAt, v))
+}
+
+// AppealedAtNEQ applies the NEQ predicate on the "appealed_at" field.
+func AppealedAtNEQ(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNEQ(FieldAppealedAt, v))
+}
+
+// AppealedAtIn applies the In predicate on the "appealed_at" field.
+func AppealedAtIn(vs ...time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldIn(FieldAppealedAt, vs...))
+}
+
+// AppealedAtNotIn applies the NotIn predicate on the "appealed_at" field.
+func AppealedAtNotIn(vs ...time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNotIn(FieldAppealedAt, vs...))
+}
+
+// AppealedAtGT applies the GT predicate on the "appealed_at" field.
+func AppealedAtGT(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGT(FieldAppealedAt, v))
+}
+
+// AppealedAtGTE applies the GTE predicate on the "appealed_at" field.
+func AppealedAtGTE(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGTE(FieldAppealedAt, v))
+}
+
+// AppealedAtLT applies the LT predicate on the "appealed_at" field.
+func AppealedAtLT(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLT(FieldAppealedAt, v))
+}
+
+// AppealedAtLTE applies the LTE predicate on the "appealed_at" field.
+func AppealedAtLTE(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLTE(FieldAppealedAt, v))
+}
+
+// AppealedAtIsNil applies the IsNil predicate on the "appealed_at" field.
+func AppealedAtIsNil() predicate.Sanction {
+	return predicate.Sanction(sql.FieldIsNull(FieldAppealedAt))
+}
+
+// AppealedAtNotNil applies the NotNil predicate on the "appealed_at" field.
+func AppealedAtNotNil() predicate.Sanction {
+	return predicate.Sanction(sql.FieldNotNull(FieldAppealedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Sanction {
+	return predicate.Sanction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Sanction {
+	return predicate.Sanction(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Sanction) predicate.Sanction {
+	return predicate.Sanction(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Sanction) predicate.Sanction {
+	return predicate.Sanction(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Sanction) predicate.Sanction {
+	return predicate.Sanction(sql.NotPredicates(p))
+}
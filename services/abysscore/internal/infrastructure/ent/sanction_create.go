@@ -0,0 +1,485 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/sanction"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
+)
+
+// SanctionCreate is the builder for creating a Sanction entity.
+type SanctionCreate struct {
+	config
+	mutation *SanctionMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (sc *SanctionCreate) SetUserID(i int) *SanctionCreate {
+	sc.mutation.SetUserID(i)
+	return sc
+}
+
+// SetType sets the "type" field.
+func (sc *SanctionCreate) SetType(s sanction.Type) *SanctionCreate {
+	sc.mutation.SetType(s)
+	return sc
+}
+
+// SetLevel sets the "level" field.
+func (sc *SanctionCreate) SetLevel(i int) *SanctionCreate {
+	sc.mutation.SetLevel(i)
+	return sc
+}
+
+// SetPreviousLevel sets the "previous_level" field.
+func (sc *SanctionCreate) SetPreviousLevel(i int) *SanctionCreate {
+	sc.mutation.SetPreviousLevel(i)
+	return sc
+}
+
+// SetReason sets the "reason" field.
+func (sc *SanctionCreate) SetReason(s string) *SanctionCreate {
+	sc.mutation.SetReason(s)
+	return sc
+}
+
+// SetBlockedUntil sets the "blocked_until" field.
+func (sc *SanctionCreate) SetBlockedUntil(t time.Time) *SanctionCreate {
+	sc.mutation.SetBlockedUntil(t)
+	return sc
+}
+
+// SetPerformerID sets the "performer_id" field.
+func (sc *SanctionCreate) SetPerformerID(i int) *SanctionCreate {
+	sc.mutation.SetPerformerID(i)
+	return sc
+}
+
+// SetStatus sets the "status" field.
+func (sc *SanctionCreate) SetStatus(s sanction.Status) *SanctionCreate {
+	sc.mutation.SetStatus(s)
+	return sc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sc *SanctionCreate) SetNillableStatus(s *sanction.Status) *SanctionCreate {
+	if s != nil {
+		sc.SetStatus(*s)
+	}
+	return sc
+}
+
+// SetResolverID sets the "resolver_id" field.
+func (sc *SanctionCreate) SetResolverID(i int) *SanctionCreate {
+	sc.mutation.SetResolverID(i)
+	return sc
+}
+
+// SetNillableResolverID sets the "resolver_id" field if the given value is not nil.
+func (sc *SanctionCreate) SetNillableResolverID(i *int) *SanctionCreate {
+	if i != nil {
+		sc.SetResolverID(*i)
+	}
+	return sc
+}
+
+// SetResolutionComment sets the "resolution_comment" field.
+func (sc *SanctionCreate) SetResolutionComment(s string) *SanctionCreate {
+	sc.mutation.SetResolutionComment(s)
+	return sc
+}
+
+// SetNillableResolutionComment sets the "resolution_comment" field if the given value is not nil.
+func (sc *SanctionCreate) SetNillableResolutionComment(s *string) *SanctionCreate {
+	if s != nil {
+		sc.SetResolutionComment(*s)
+	}
+	return sc
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (sc *SanctionCreate) SetResolvedAt(t time.Time) *SanctionCreate {
+	sc.mutation.SetResolvedAt(t)
+	return sc
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (sc *SanctionCreate) SetNillableResolvedAt(t *time.Time) *SanctionCreate {
+	if t != nil {
+		sc.SetResolvedAt(*t)
+	}
+	return sc
+}
+
+// SetAppealStatus sets the "appeal_status" field.
+func (sc *SanctionCreate) SetAppealStatus(ss sanction.AppealStatus) *SanctionCreate {
+	sc.mutation.SetAppealStatus(ss)
+	return sc
+}
+
+// SetNillableAppealStatus sets the "appeal_status" field if the given value is not nil.
+func (sc *SanctionCreate) SetNillableAppealStatus(ss *sanction.AppealStatus) *SanctionCreate {
+	if ss != nil {
+		sc.SetAppealStatus(*ss)
+	}
+	return sc
+}
+
+// SetAppealMessage sets the "appeal_message" field.
+func (sc *SanctionCreate) SetAppealMessage(s string) *SanctionCreate {
+	sc.mutation.SetAppealMessage(s)
+	return sc
+}
+
+// SetNillableAppealMessage sets the "appeal_message" field if the given value is not nil.
+func (sc *SanctionCreate) SetNillableAppealMessage(s *string) *SanctionCreate {
+	if s != nil {
+		sc.SetAppealMessage(*s)
+	}
+	return sc
+}
+
+// SetAppealedAt sets the "appealed_at" field.
+func (sc *SanctionCreate) SetAppealedAt(t time.Time) *SanctionCreate {
+	sc.mutation.SetAppealedAt(t)
+	return sc
+}
+
+// SetNillableAppealedAt sets the "appealed_at" field if the given value is not nil.
+func (sc *SanctionCreate) SetNillableAppealedAt(t *time.Time) *SanctionCreate {
+	if t != nil {
+		sc.SetAppealedAt(*t)
+	}
+	return sc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *SanctionCreate) SetCreatedAt(t time.Time) *SanctionCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *SanctionCreate) SetNillableCreatedAt(t *time.Time) *SanctionCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetID sets the "id" field.
+func (sc *SanctionCreate) SetID(i int) *SanctionCreate {
+	sc.mutation.SetID(i)
+	return sc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (sc *SanctionCreate) SetUser(u *User) *SanctionCreate {
+	return sc.SetUserID(u.ID)
+}
+
+// Mutation returns the SanctionMutation object of the builder.
+func (sc *SanctionCreate) Mutation() *SanctionMutation {
+	return sc.mutation
+}
+
+// Save creates the Sanction in the database.
+func (sc *SanctionCreate) Save(ctx context.Context) (*Sanction, error) {
+	sc.defaults()
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *SanctionCreate) SaveX(ctx context.Context) *Sanction {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *SanctionCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *SanctionCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *SanctionCreate) defaults() {
+	if _, ok := sc.mutation.Status(); !ok {
+		v := sanction.DefaultStatus
+		sc.mutation.SetStatus(v)
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := sanction.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *SanctionCreate) check() error {
+	if _, ok := sc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Sanction.user_id"`)}
+	}
+	if _, ok := sc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Sanction.type"`)}
+	}
+	if v, ok := sc.mutation.GetType(); ok {
+		if err := sanction.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Sanction.type": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Level(); !ok {
+		return &ValidationError{Name: "level", err: errors.New(`ent: missing required field "Sanction.level"`)}
+	}
+	if v, ok := sc.mutation.Level(); ok {
+		if err := sanction.LevelValidator(v); err != nil {
+			return &ValidationError{Name: "level", err: fmt.Errorf(`ent: validator failed for field "Sanction.level": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.PreviousLevel(); !ok {
+		return &ValidationError{Name: "previous_level", err: errors.New(`ent: missing required field "Sanction.previous_level"`)}
+	}
+	if v, ok := sc.mutation.PreviousLevel(); ok {
+		if err := sanction.PreviousLevelValidator(v); err != nil {
+			return &ValidationError{Name: "previous_level", err: fmt.Errorf(`ent: validator failed for field "Sanction.previous_level": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "Sanction.reason"`)}
+	}
+	if _, ok := sc.mutation.BlockedUntil(); !ok {
+		return &ValidationError{Name: "blocked_until", err: errors.New(`ent: missing required field "Sanction.blocked_until"`)}
+	}
+	if _, ok := sc.mutation.PerformerID(); !ok {
+		return &ValidationError{Name: "performer_id", err: errors.New(`ent: missing required field "Sanction.performer_id"`)}
+	}
+	if _, ok := sc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Sanction.status"`)}
+	}
+	if v, ok := sc.mutation.Status(); ok {
+		if err := sanction.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Sanction.status": %w`, err)}
+		}
+	}
+	if v, ok := sc.mutation.AppealStatus(); ok {
+		if err := sanction.AppealStatusValidator(v); err != nil {
+			return &ValidationError{Name: "appeal_status", err: fmt.Errorf(`ent: validator failed for field "Sanction.appeal_status": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Sanction.created_at"`)}
+	}
+	if len(sc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Sanction.user"`)}
+	}
+	return nil
+}
+
+func (sc *SanctionCreate) sqlSave(ctx context.Context) (*Sanction, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *SanctionCreate) createSpec() (*Sanction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Sanction{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(sanction.Table, sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt))
+	)
+	if id, ok := sc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sc.mutation.GetType(); ok {
+		_spec.SetField(sanction.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := sc.mutation.Level(); ok {
+		_spec.SetField(sanction.FieldLevel, field.TypeInt, value)
+		_node.Level = value
+	}
+	if value, ok := sc.mutation.PreviousLevel(); ok {
+		_spec.SetField(sanction.FieldPreviousLevel, field.TypeInt, value)
+		_node.PreviousLevel = value
+	}
+	if value, ok := sc.mutation.Reason(); ok {
+		_spec.SetField(sanction.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := sc.mutation.BlockedUntil(); ok {
+		_spec.SetField(sanction.FieldBlockedUntil, field.TypeTime, value)
+		_node.BlockedUntil = value
+	}
+	if value, ok := sc.mutation.PerformerID(); ok {
+		_spec.SetField(sanction.FieldPerformerID, field.TypeInt, value)
+		_node.PerformerID = value
+	}
+	if value, ok := sc.mutation.Status(); ok {
+		_spec.SetField(sanction.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := sc.mutation.ResolverID(); ok {
+		_spec.SetField(sanction.FieldResolverID, field.TypeInt, value)
+		_node.ResolverID = &value
+	}
+	if value, ok := sc.mutation.ResolutionComment(); ok {
+		_spec.SetField(sanction.FieldResolutionComment, field.TypeString, value)
+		_node.ResolutionComment = &value
+	}
+	if value, ok := sc.mutation.ResolvedAt(); ok {
+		_spec.SetField(sanction.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = &value
+	}
+	if value, ok := sc.mutation.AppealStatus(); ok {
+		_spec.SetField(sanction.FieldAppealStatus, field.TypeEnum, value)
+		_node.AppealStatus = &value
+	}
+	if value, ok := sc.mutation.AppealMessage(); ok {
+		_spec.SetField(sanction.FieldAppealMessage, field.TypeString, value)
+		_node.AppealMessage = &value
+	}
+	if value, ok := sc.mutation.AppealedAt(); ok {
+		_spec.SetField(sanction.FieldAppealedAt, field.TypeTime, value)
+		_node.AppealedAt = &value
+	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.SetField(sanction.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := sc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sanction.UserTable,
+			Columns: []string{sanction.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SanctionCreateBulk is the builder for creating many Sanction entities in bulk.
+type SanctionCreateBulk struct {
+	config
+	err      error
+	builders []*SanctionCreate
+}
+
+// Save creates the Sanction entities in the database.
+func (scb *SanctionCreateBulk) Save(ctx context.Context) ([]*Sanction, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Sanction, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SanctionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *SanctionCreateBulk) SaveX(ctx context.Context) []*Sanction {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *SanctionCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *SanctionCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
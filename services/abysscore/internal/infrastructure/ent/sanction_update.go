@@ -0,0 +1,584 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/sanction"
+)
+
+// SanctionUpdate is the builder for updating Sanction entities.
+type SanctionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SanctionMutation
+}
+
+// Where appends a list predicates to the SanctionUpdate builder.
+func (su *SanctionUpdate) Where(ps ...predicate.Sanction) *SanctionUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetStatus sets the "status" field.
+func (su *SanctionUpdate) SetStatus(s sanction.Status) *SanctionUpdate {
+	su.mutation.SetStatus(s)
+	return su
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (su *SanctionUpdate) SetNillableStatus(s *sanction.Status) *SanctionUpdate {
+	if s != nil {
+		su.SetStatus(*s)
+	}
+	return su
+}
+
+// SetResolverID sets the "resolver_id" field.
+func (su *SanctionUpdate) SetResolverID(i int) *SanctionUpdate {
+	su.mutation.ResetResolverID()
+	su.mutation.SetResolverID(i)
+	return su
+}
+
+// SetNillableResolverID sets the "resolver_id" field if the given value is not nil.
+func (su *SanctionUpdate) SetNillableResolverID(i *int) *SanctionUpdate {
+	if i != nil {
+		su.SetResolverID(*i)
+	}
+	return su
+}
+
+// AddResolverID adds i to the "resolver_id" field.
+func (su *SanctionUpdate) AddResolverID(i int) *SanctionUpdate {
+	su.mutation.AddResolverID(i)
+	return su
+}
+
+// ClearResolverID clears the value of the "resolver_id" field.
+func (su *SanctionUpdate) ClearResolverID() *SanctionUpdate {
+	su.mutation.ClearResolverID()
+	return su
+}
+
+// SetResolutionComment sets the "resolution_comment" field.
+func (su *SanctionUpdate) SetResolutionComment(s string) *SanctionUpdate {
+	su.mutation.SetResolutionComment(s)
+	return su
+}
+
+// SetNillableResolutionComment sets the "resolution_comment" field if the given value is not nil.
+func (su *SanctionUpdate) SetNillableResolutionComment(s *string) *SanctionUpdate {
+	if s != nil {
+		su.SetResolutionComment(*s)
+	}
+	return su
+}
+
+// ClearResolutionComment clears the value of the "resolution_comment" field.
+func (su *SanctionUpdate) ClearResolutionComment() *SanctionUpdate {
+	su.mutation.ClearResolutionComment()
+	return su
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (su *SanctionUpdate) SetResolvedAt(t time.Time) *SanctionUpdate {
+	su.mutation.SetResolvedAt(t)
+	return su
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (su *SanctionUpdate) SetNillableResolvedAt(t *time.Time) *SanctionUpdate {
+	if t != nil {
+		su.SetResolvedAt(*t)
+	}
+	return su
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (su *SanctionUpdate) ClearResolvedAt() *SanctionUpdate {
+	su.mutation.ClearResolvedAt()
+	return su
+}
+
+// SetAppealStatus sets the "appeal_status" field.
+func (su *SanctionUpdate) SetAppealStatus(ss sanction.AppealStatus) *SanctionUpdate {
+	su.mutation.SetAppealStatus(ss)
+	return su
+}
+
+// SetNillableAppealStatus sets the "appeal_status" field if the given value is not nil.
+func (su *SanctionUpdate) SetNillableAppealStatus(ss *sanction.AppealStatus) *SanctionUpdate {
+	if ss != nil {
+		su.SetAppealStatus(*ss)
+	}
+	return su
+}
+
+// ClearAppealStatus clears the value of the "appeal_status" field.
+func (su *SanctionUpdate) ClearAppealStatus() *SanctionUpdate {
+	su.mutation.ClearAppealStatus()
+	return su
+}
+
+// SetAppealMessage sets the "appeal_message" field.
+func (su *SanctionUpdate) SetAppealMessage(s string) *SanctionUpdate {
+	su.mutation.SetAppealMessage(s)
+	return su
+}
+
+// SetNillableAppealMessage sets the "appeal_message" field if the given value is not nil.
+func (su *SanctionUpdate) SetNillableAppealMessage(s *string) *SanctionUpdate {
+	if s != nil {
+		su.SetAppealMessage(*s)
+	}
+	return su
+}
+
+// ClearAppealMessage clears the value of the "appeal_message" field.
+func (su *SanctionUpdate) ClearAppealMessage() *SanctionUpdate {
+	su.mutation.ClearAppealMessage()
+	return su
+}
+
+// SetAppealedAt sets the "appealed_at" field.
+func (su *SanctionUpdate) SetAppealedAt(t time.Time) *SanctionUpdate {
+	su.mutation.SetAppealedAt(t)
+	return su
+}
+
+// SetNillableAppealedAt sets the "appealed_at" field if the given value is not nil.
+func (su *SanctionUpdate) SetNillableAppealedAt(t *time.Time) *SanctionUpdate {
+	if t != nil {
+		su.SetAppealedAt(*t)
+	}
+	return su
+}
+
+// ClearAppealedAt clears the value of the "appealed_at" field.
+func (su *SanctionUpdate) ClearAppealedAt() *SanctionUpdate {
+	su.mutation.ClearAppealedAt()
+	return su
+}
+
+// Mutation returns the SanctionMutation object of the builder.
+func (su *SanctionUpdate) Mutation() *SanctionMutation {
+	return su.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *SanctionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *SanctionUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *SanctionUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *SanctionUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *SanctionUpdate) check() error {
+	if v, ok := su.mutation.Status(); ok {
+		if err := sanction.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Sanction.status": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.AppealStatus(); ok {
+		if err := sanction.AppealStatusValidator(v); err != nil {
+			return &ValidationError{Name: "appeal_status", err: fmt.Errorf(`ent: validator failed for field "Sanction.appeal_status": %w`, err)}
+		}
+	}
+	if su.mutation.UserCleared() && len(su.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Sanction.user"`)
+	}
+	return nil
+}
+
+func (su *SanctionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sanction.Table, sanction.Columns, sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.Status(); ok {
+		_spec.SetField(sanction.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := su.mutation.ResolverID(); ok {
+		_spec.SetField(sanction.FieldResolverID, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedResolverID(); ok {
+		_spec.AddField(sanction.FieldResolverID, field.TypeInt, value)
+	}
+	if su.mutation.ResolverIDCleared() {
+		_spec.ClearField(sanction.FieldResolverID, field.TypeInt)
+	}
+	if value, ok := su.mutation.ResolutionComment(); ok {
+		_spec.SetField(sanction.FieldResolutionComment, field.TypeString, value)
+	}
+	if su.mutation.ResolutionCommentCleared() {
+		_spec.ClearField(sanction.FieldResolutionComment, field.TypeString)
+	}
+	if value, ok := su.mutation.ResolvedAt(); ok {
+		_spec.SetField(sanction.FieldResolvedAt, field.TypeTime, value)
+	}
+	if su.mutation.ResolvedAtCleared() {
+		_spec.ClearField(sanction.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.AppealStatus(); ok {
+		_spec.SetField(sanction.FieldAppealStatus, field.TypeEnum, value)
+	}
+	if su.mutation.AppealStatusCleared() {
+		_spec.ClearField(sanction.FieldAppealStatus, field.TypeEnum)
+	}
+	if value, ok := su.mutation.AppealMessage(); ok {
+		_spec.SetField(sanction.FieldAppealMessage, field.TypeString, value)
+	}
+	if su.mutation.AppealMessageCleared() {
+		_spec.ClearField(sanction.FieldAppealMessage, field.TypeString)
+	}
+	if value, ok := su.mutation.AppealedAt(); ok {
+		_spec.SetField(sanction.FieldAppealedAt, field.TypeTime, value)
+	}
+	if su.mutation.AppealedAtCleared() {
+		_spec.ClearField(sanction.FieldAppealedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sanction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// SanctionUpdateOne is the builder for updating a single Sanction entity.
+type SanctionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SanctionMutation
+}
+
+// SetStatus sets the "status" field.
+func (suo *SanctionUpdateOne) SetStatus(s sanction.Status) *SanctionUpdateOne {
+	suo.mutation.SetStatus(s)
+	return suo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (suo *SanctionUpdateOne) SetNillableStatus(s *sanction.Status) *SanctionUpdateOne {
+	if s != nil {
+		suo.SetStatus(*s)
+	}
+	return suo
+}
+
+// SetResolverID sets the "resolver_id" field.
+func (suo *SanctionUpdateOne) SetResolverID(i int) *SanctionUpdateOne {
+	suo.mutation.ResetResolverID()
+	suo.mutation.SetResolverID(i)
+	return suo
+}
+
+// SetNillableResolverID sets the "resolver_id" field if the given value is not nil.
+func (suo *SanctionUpdateOne) SetNillableResolverID(i *int) *SanctionUpdateOne {
+	if i != nil {
+		suo.SetResolverID(*i)
+	}
+	return suo
+}
+
+// AddResolverID adds i to the "resolver_id" field.
+func (suo *SanctionUpdateOne) AddResolverID(i int) *SanctionUpdateOne {
+	suo.mutation.AddResolverID(i)
+	return suo
+}
+
+// ClearResolverID clears the value of the "resolver_id" field.
+func (suo *SanctionUpdateOne) ClearResolverID() *SanctionUpdateOne {
+	suo.mutation.ClearResolverID()
+	return suo
+}
+
+// SetResolutionComment sets the "resolution_comment" field.
+func (suo *SanctionUpdateOne) SetResolutionComment(s string) *SanctionUpdateOne {
+	suo.mutation.SetResolutionComment(s)
+	return suo
+}
+
+// SetNillableResolutionComment sets the "resolution_comment" field if the given value is not nil.
+func (suo *SanctionUpdateOne) SetNillableResolutionComment(s *string) *SanctionUpdateOne {
+	if s != nil {
+		suo.SetResolutionComment(*s)
+	}
+	return suo
+}
+
+// ClearResolutionComment clears the value of the "resolution_comment" field.
+func (suo *SanctionUpdateOne) ClearResolutionComment() *SanctionUpdateOne {
+	suo.mutation.ClearResolutionComment()
+	return suo
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (suo *SanctionUpdateOne) SetResolvedAt(t time.Time) *SanctionUpdateOne {
+	suo.mutation.SetResolvedAt(t)
+	return suo
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (suo *SanctionUpdateOne) SetNillableResolvedAt(t *time.Time) *SanctionUpdateOne {
+	if t != nil {
+		suo.SetResolvedAt(*t)
+	}
+	return suo
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (suo *SanctionUpdateOne) ClearResolvedAt() *SanctionUpdateOne {
+	suo.mutation.ClearResolvedAt()
+	return suo
+}
+
+// SetAppealStatus sets the "appeal_status" field.
+func (suo *SanctionUpdateOne) SetAppealStatus(ss sanction.AppealStatus) *SanctionUpdateOne {
+	suo.mutation.SetAppealStatus(ss)
+	return suo
+}
+
+// SetNillableAppealStatus sets the "appeal_status" field if the given value is not nil.
+func (suo *SanctionUpdateOne) SetNillableAppealStatus(ss *sanction.AppealStatus) *SanctionUpdateOne {
+	if ss != nil {
+		suo.SetAppealStatus(*ss)
+	}
+	return suo
+}
+
+// ClearAppealStatus clears the value of the "appeal_status" field.
+func (suo *SanctionUpdateOne) ClearAppealStatus() *SanctionUpdateOne {
+	suo.mutation.ClearAppealStatus()
+	return suo
+}
+
+// SetAppealMessage sets the "appeal_message" field.
+func (suo *SanctionUpdateOne) SetAppealMessage(s string) *SanctionUpdateOne {
+	suo.mutation.SetAppealMessage(s)
+	return suo
+}
+
+// SetNillableAppealMessage sets the "appeal_message" field if the given value is not nil.
+func (suo *SanctionUpdateOne) SetNillableAppealMessage(s *string) *SanctionUpdateOne {
+	if s != nil {
+		suo.SetAppealMessage(*s)
+	}
+	return suo
+}
+
+// ClearAppealMessage clears the value of the "appeal_message" field.
+func (suo *SanctionUpdateOne) ClearAppealMessage() *SanctionUpdateOne {
+	suo.mutation.ClearAppealMessage()
+	return suo
+}
+
+// SetAppealedAt sets the "appealed_at" field.
+func (suo *SanctionUpdateOne) SetAppealedAt(t time.Time) *SanctionUpdateOne {
+	suo.mutation.SetAppealedAt(t)
+	return suo
+}
+
+// SetNillableAppealedAt sets the "appealed_at" field if the given value is not nil.
+func (suo *SanctionUpdateOne) SetNillableAppealedAt(t *time.Time) *SanctionUpdateOne {
+	if t != nil {
+		suo.SetAppealedAt(*t)
+	}
+	return suo
+}
+
+// ClearAppealedAt clears the value of the "appealed_at" field.
+func (suo *SanctionUpdateOne) ClearAppealedAt() *SanctionUpdateOne {
+	suo.mutation.ClearAppealedAt()
+	return suo
+}
+
+// Mutation returns the SanctionMutation object of the builder.
+func (suo *SanctionUpdateOne) Mutation() *SanctionMutation {
+	return suo.mutation
+}
+
+// Where appends a list predicates to the SanctionUpdate builder.
+func (suo *SanctionUpdateOne) Where(ps ...predicate.Sanction) *SanctionUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *SanctionUpdateOne) Select(field string, fields ...string) *SanctionUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Sanction entity.
+func (suo *SanctionUpdateOne) Save(ctx context.Context) (*Sanction, error) {
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *SanctionUpdateOne) SaveX(ctx context.Context) *Sanction {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *SanctionUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *SanctionUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *SanctionUpdateOne) check() error {
+	if v, ok := suo.mutation.Status(); ok {
+		if err := sanction.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Sanction.status": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.AppealStatus(); ok {
+		if err := sanction.AppealStatusValidator(v); err != nil {
+			return &ValidationError{Name: "appeal_status", err: fmt.Errorf(`ent: validator failed for field "Sanction.appeal_status": %w`, err)}
+		}
+	}
+	if suo.mutation.UserCleared() && len(suo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Sanction.user"`)
+	}
+	return nil
+}
+
+func (suo *SanctionUpdateOne) sqlSave(ctx context.Context) (_node *Sanction, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sanction.Table, sanction.Columns, sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Sanction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sanction.FieldID)
+		for _, f := range fields {
+			if !sanction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sanction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.Status(); ok {
+		_spec.SetField(sanction.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := suo.mutation.ResolverID(); ok {
+		_spec.SetField(sanction.FieldResolverID, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedResolverID(); ok {
+		_spec.AddField(sanction.FieldResolverID, field.TypeInt, value)
+	}
+	if suo.mutation.ResolverIDCleared() {
+		_spec.ClearField(sanction.FieldResolverID, field.TypeInt)
+	}
+	if value, ok := suo.mutation.ResolutionComment(); ok {
+		_spec.SetField(sanction.FieldResolutionComment, field.TypeString, value)
+	}
+	if suo.mutation.ResolutionCommentCleared() {
+		_spec.ClearField(sanction.FieldResolutionComment, field.TypeString)
+	}
+	if value, ok := suo.mutation.ResolvedAt(); ok {
+		_spec.SetField(sanction.FieldResolvedAt, field.TypeTime, value)
+	}
+	if suo.mutation.ResolvedAtCleared() {
+		_spec.ClearField(sanction.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.AppealStatus(); ok {
+		_spec.SetField(sanction.FieldAppealStatus, field.TypeEnum, value)
+	}
+	if suo.mutation.AppealStatusCleared() {
+		_spec.ClearField(sanction.FieldAppealStatus, field.TypeEnum)
+	}
+	if value, ok := suo.mutation.AppealMessage(); ok {
+		_spec.SetField(sanction.FieldAppealMessage, field.TypeString, value)
+	}
+	if suo.mutation.AppealMessageCleared() {
+		_spec.ClearField(sanction.FieldAppealMessage, field.TypeString)
+	}
+	if value, ok := suo.mutation.AppealedAt(); ok {
+		_spec.SetField(sanction.FieldAppealedAt, field.TypeTime, value)
+	}
+	if suo.mutation.AppealedAtCleared() {
+		_spec.ClearField(sanction.FieldAppealedAt, field.TypeTime)
+	}
+	_node = &Sanction{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sanction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/apiscope"
+)
+
+// APIToken is a personal token for community tools, only its hash is stored.
+type APIToken struct {
+	ent.Schema
+}
+
+func (APIToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+
+		field.Int("user_id").Immutable(),
+		field.String("name").NotEmpty(),
+		field.String("token_hash").NotEmpty().Unique().Sensitive().Immutable(),
+		// token_prefix is the beginning of the token, so user can recognize it in the list
+		field.String("token_prefix").Immutable(),
+		field.JSON("scopes", []apiscope.Scope{}),
+
+		field.Time("expires_at").Immutable(),
+		field.Time("last_used_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (APIToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("api_tokens").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
@@ -0,0 +1,24 @@
+package apiscope
+
+import (
+	"slices"
+)
+
+// Scope limits what personal API token can be used for, tokens are read-only.
+type Scope string
+
+const (
+	ReadMatches Scope = "read:matches"
+	ReadProfile Scope = "read:profile"
+)
+
+var all = []Scope{ReadMatches, ReadProfile}
+
+// All returns all known scopes in stable order.
+func All() []Scope {
+	return slices.Clone(all)
+}
+
+func (s Scope) IsValid() bool {
+	return slices.Contains(all, s)
+}
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditLog is append-only, all fields are immutable and the repository exposes no update or delete.
+type AuditLog struct {
+	ent.Schema
+}
+
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+
+		// actor_id has no edge, so records outlive the actor account
+		field.Int("actor_id").Immutable(),
+		field.String("actor_username").Immutable(),
+		field.String("action").NotEmpty().Immutable(),
+		field.String("target_type").NotEmpty().Immutable(),
+		field.String("target_id").Optional().Nillable().Immutable(),
+		// before and after hold only changed fields
+		field.JSON("before", map[string]any{}).Optional().Immutable(),
+		field.JSON("after", map[string]any{}).Optional().Immutable(),
+		field.String("request_id").Optional().Nillable().Immutable(),
+		field.String("ip").Optional().Nillable().Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("actor_id", "created_at"),
+		index.Fields("action", "created_at"),
+		index.Fields("target_type", "target_id"),
+	}
+}
@@ -19,6 +19,8 @@ func (BannedHardwareID) Fields() []ent.Field {
 		field.String("hardware_id").Unique().Immutable().Sensitive(),
 		field.Time("created_at").Default(time.Now).Immutable(),
 		field.String("ban_reason").Nillable().Optional(),
+		// performer_id is nil for bans created before the admin API
+		field.Int("performer_id").Optional().Nillable().Immutable(),
 	}
 }
 
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CollectionCompletion marks that user has completed the collection and received its reward.
+type CollectionCompletion struct {
+	ent.Schema
+}
+
+func (CollectionCompletion) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+
+		field.Int("user_id").Immutable(),
+		field.String("collection").NotEmpty().Immutable(),
+
+		field.Time("completed_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (CollectionCompletion) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("collection_completions").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (CollectionCompletion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "collection").Unique(),
+	}
+}
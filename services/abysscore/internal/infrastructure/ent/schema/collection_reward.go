@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CollectionReward is granted once to a user who owns every item of the collection.
+type CollectionReward struct {
+	ent.Schema
+}
+
+func (CollectionReward) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+
+		field.String("collection").NotEmpty().Unique().Immutable(),
+
+		field.Float("coins").Default(0).Min(0),
+		field.String("title").Optional().Nillable(),
+		field.Int("badge_item_id").Optional().Nillable(),
+
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (CollectionReward) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("collection").Unique(),
+	}
+}
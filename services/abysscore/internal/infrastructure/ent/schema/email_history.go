@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type EmailHistory struct {
+	ent.Schema
+}
+
+func (EmailHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+
+		field.Int("user_id").Immutable(),
+		field.Enum("action").Values("linked", "changed", "unlinked").Immutable(),
+
+		field.String("old_email").Optional().Nillable().Immutable(),
+		field.String("new_email").Optional().Nillable().Immutable(),
+
+		// performer_id is set when email was changed by admin instead of the user
+		field.Int("performer_id").Optional().Nillable().Immutable(),
+		field.String("reason").Optional().Nillable().Immutable(),
+
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (EmailHistory) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("email_history").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (EmailHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+	}
+}
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ExternalIdentity links user to the account of OIDC provider.
+type ExternalIdentity struct {
+	ent.Schema
+}
+
+func (ExternalIdentity) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+
+		field.Int("user_id").Immutable(),
+		field.String("provider").Immutable(),
+		// subject is the provider's stable user id ("sub" claim)
+		field.String("subject").Immutable(),
+		field.String("email").Optional().Nillable(),
+
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("last_login_at").Optional().Nillable(),
+	}
+}
+
+func (ExternalIdentity) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("external_identities").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (ExternalIdentity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider", "subject").Unique(),
+		index.Fields("user_id", "provider").Unique(),
+	}
+}
@@ -22,6 +22,8 @@ func (GameItem) Fields() []ent.Field {
 		field.Int("rarity").Positive(),
 
 		field.Time("created_at").Default(time.Now).Immutable(),
+		// archived items are hidden from catalog but stay referenced by inventories
+		field.Time("archived_at").Optional().Nillable(),
 	}
 }
 
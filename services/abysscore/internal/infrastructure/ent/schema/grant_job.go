@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// GrantJob is a background bulk grant of one item to many users within a campaign.
+type GrantJob struct {
+	ent.Schema
+}
+
+func (GrantJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+
+		field.String("campaign").NotEmpty().Immutable(),
+		field.Int("item_id").Immutable(),
+		field.Int("created_by_id").Immutable(),
+
+		field.Enum("target").Values("users", "filter", "online").Immutable(),
+
+		field.Enum("status").
+			Values("pending", "running", "completed", "failed").
+			Default("pending"),
+
+		field.Int("total").Default(0).Min(0),
+		field.Int("processed").Default(0).Min(0),
+		field.Int("granted").Default(0).Min(0),
+		field.Int("skipped").Default(0).Min(0),
+		field.Int("failed").Default(0).Min(0),
+
+		field.String("error").Optional().Nillable(),
+
+		// owner is the id of the instance running the job, it updates heartbeat_at while the job runs,
+		// so jobs of stopped instances are told apart from jobs of live ones
+		field.String("owner").Optional().Nillable().Immutable(),
+		field.Time("heartbeat_at").Optional().Nillable(),
+
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("finished_at").Optional().Nillable(),
+	}
+}
+
+func (GrantJob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("campaign"),
+		index.Fields("status", "heartbeat_at"),
+	}
+}
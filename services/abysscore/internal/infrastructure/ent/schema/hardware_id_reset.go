@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// HardwareIDReset is either a reset performed by admin or a user request waiting for admin decision.
+type HardwareIDReset struct {
+	ent.Schema
+}
+
+func (HardwareIDReset) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+
+		field.Int("user_id").Immutable(),
+		field.Enum("status").Values("pending", "approved", "rejected").Default("pending"),
+		field.String("reason").Optional().Nillable().Immutable(),
+
+		field.Int("operator_id").Optional().Nillable(),
+		field.String("operator_comment").Optional().Nillable(),
+
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("resolved_at").Optional().Nillable(),
+	}
+}
+
+func (HardwareIDReset) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("hardware_id_resets").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (HardwareIDReset) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "created_at"),
+		index.Fields("user_id"),
+	}
+}
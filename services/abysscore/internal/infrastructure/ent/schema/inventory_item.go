@@ -28,6 +28,12 @@ func (InventoryItem) Fields() []ent.Field {
 			Nillable(), // nil if from trade
 		// TODO: relationship with trades
 
+		// campaign makes grants idempotent per (user, item, campaign), nil for regular grants
+		field.String("campaign").
+			Optional().
+			Nillable().
+			Immutable(),
+
 		field.Time("obtained_at").Default(time.Now),
 	}
 }
@@ -53,5 +59,6 @@ func (InventoryItem) Edges() []ent.Edge {
 func (InventoryItem) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("user_id", "item_id"),
+		index.Fields("user_id", "item_id", "campaign").Unique(),
 	}
 }
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type LoginHistory struct {
+	ent.Schema
+}
+
+func (LoginHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+
+		field.Int("user_id").Immutable(),
+		field.String("ip").Immutable(),
+		field.String("user_agent").Immutable(),
+		// hardware_fingerprint is a short hash of raw hardware id sent on login
+		field.String("hardware_fingerprint").Immutable(),
+		field.Bool("hardware_id_match").Immutable(),
+		field.Bool("success").Immutable(),
+
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (LoginHistory) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("login_history").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (LoginHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+		index.Fields("user_id", "success", "ip"),
+		index.Fields("user_id", "success", "hardware_fingerprint"),
+	}
+}
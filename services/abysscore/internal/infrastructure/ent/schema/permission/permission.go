@@ -0,0 +1,124 @@
+package permission
+
+import (
+	"slices"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/access_level"
+)
+
+type Permission string
+
+const (
+	ViewUsers     Permission = "users:view"
+	ViewInventory Permission = "inventory:view"
+	ViewMatches   Permission = "matches:view"
+
+	ChangeUserEmail Permission = "users:change_email"
+	ManageSanctions Permission = "sanctions:manage"
+	ManageSeasons   Permission = "seasons:manage"
+
+	CreateItem Permission = "items:create"
+	GiveItem   Permission = "items:give"
+	RevokeItem Permission = "items:revoke"
+	UpdateItem Permission = "items:update"
+	DeleteItem Permission = "items:delete"
+
+	ResetHardwareID Permission = "hardware_id:reset"
+	BanHardwareID   Permission = "hardware_id:ban"
+
+	ManageRoles  Permission = "roles:manage"
+	ViewAuditLog Permission = "audit_log:view"
+)
+
+// legacyAccessLevels maps permissions to the access level that granted them before roles.
+var legacyAccessLevels = map[Permission]access_level.AccessLevel{
+	ViewUsers:       access_level.ViewAllUsers,
+	ViewInventory:   access_level.ViewInventory,
+	ViewMatches:     access_level.ViewMatches,
+	ChangeUserEmail: access_level.Admin,
+	ManageSanctions: access_level.Admin,
+	ManageSeasons:   access_level.Admin,
+	CreateItem:      access_level.CreateItem,
+	GiveItem:        access_level.GiveItem,
+	RevokeItem:      access_level.RevokeItem,
+	UpdateItem:      access_level.UpdateItem,
+	ResetHardwareID: access_level.ResetHwid,
+	BanHardwareID:   access_level.ResetHwid,
+	ManageRoles:     access_level.AddAdmin,
+	ViewAuditLog:    access_level.AddAdmin,
+	DeleteItem:      access_level.DeleteItem,
+}
+
+// All returns all known permissions in stable order.
+func All() []Permission {
+	all := make([]Permission, 0, len(legacyAccessLevels))
+	for p := range legacyAccessLevels {
+		all = append(all, p)
+	}
+
+	slices.Sort(all)
+
+	return all
+}
+
+func (p Permission) IsValid() bool {
+	_, ok := legacyAccessLevels[p]
+
+	return ok
+}
+
+// FromAccessLevel returns permissions granted by the access level, levels were ordered, so all lower ones are included.
+func FromAccessLevel(level access_level.AccessLevel) []Permission {
+	granted := make([]Permission, 0)
+
+	for _, p := range All() {
+		if legacyAccessLevels[p] <= level {
+			granted = append(granted, p)
+		}
+	}
+
+	return granted
+}
+
+type Set map[Permission]struct{}
+
+func NewSet(permissions ...Permission) Set {
+	set := make(Set, len(permissions))
+	for _, p := range permissions {
+		set[p] = struct{}{}
+	}
+
+	return set
+}
+
+func (s Set) Has(p Permission) bool {
+	_, ok := s[p]
+
+	return ok
+}
+
+func (s Set) HasAny(permissions ...Permission) bool {
+	return slices.ContainsFunc(permissions, s.Has)
+}
+
+func (s Set) HasAll(permissions ...Permission) bool {
+	for _, p := range permissions {
+		if !s.Has(p) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Slice returns permissions of the set in stable order.
+func (s Set) Slice() []Permission {
+	permissions := make([]Permission, 0, len(s))
+	for p := range s {
+		permissions = append(permissions, p)
+	}
+
+	slices.Sort(permissions)
+
+	return permissions
+}
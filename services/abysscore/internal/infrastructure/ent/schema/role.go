@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/permission"
+)
+
+type Role struct {
+	ent.Schema
+}
+
+func (Role) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+
+		field.String("name").NotEmpty().Unique(),
+		field.String("description").Optional().Nillable(),
+		field.JSON("permissions", []permission.Permission{}),
+		// system role is migrated from the top access level, it gets all permissions on startup
+		// and can't be changed or deleted through API
+		field.Bool("system").Default(false).Immutable(),
+
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (Role) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("users", User.Type).
+			Ref("roles"),
+	}
+}
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type Sanction struct {
+	ent.Schema
+}
+
+func (Sanction) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+
+		field.Int("user_id").Immutable(),
+		field.Enum("type").Values("account", "search").Immutable(),
+		field.Int("level").Min(0).Immutable(),
+		// previous_level is restored when the sanction is revoked
+		field.Int("previous_level").Min(0).Immutable(),
+		field.String("reason").Immutable(),
+		// blocked_until equals created_at for warnings
+		field.Time("blocked_until").Immutable(),
+		field.Int("performer_id").Immutable(),
+
+		field.Enum("status").Values("active", "lifted", "revoked").Default("active"),
+		field.Int("resolver_id").Optional().Nillable(),
+		field.String("resolution_comment").Optional().Nillable(),
+		field.Time("resolved_at").Optional().Nillable(),
+
+		field.Enum("appeal_status").Values("pending", "accepted", "rejected").Optional().Nillable(),
+		field.String("appeal_message").Optional().Nillable(),
+		field.Time("appealed_at").Optional().Nillable(),
+
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (Sanction) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("sanctions").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (Sanction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "type", "created_at"),
+		index.Fields("appeal_status", "appealed_at"),
+	}
+}
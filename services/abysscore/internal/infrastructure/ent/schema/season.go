@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Season is a battle pass season, its number is used as period of season statistics.
+type Season struct {
+	ent.Schema
+}
+
+func (Season) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+
+		field.Int("number").Positive().Unique().Immutable(),
+		field.String("name").NotEmpty(),
+
+		field.Time("starts_at"),
+		field.Time("ends_at"),
+
+		field.Float("premium_price").Default(0).Min(0),
+
+		field.Time("rolled_over_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (Season) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("tiers", SeasonTier.Type),
+		edge.To("passes", SeasonPass.Type),
+		edge.To("reward_claims", SeasonRewardClaim.Type),
+	}
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SeasonPass stores premium track ownership, XP is stored in season Statistic.
+type SeasonPass struct {
+	ent.Schema
+}
+
+func (SeasonPass) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+
+		field.Int("user_id").Immutable(),
+		field.Int("season_id").Immutable(),
+
+		field.Bool("premium").Default(false),
+		field.Time("premium_purchased_at").Optional().Nillable(),
+
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (SeasonPass) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("season_passes").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+
+		edge.From("season", Season.Type).
+			Ref("passes").
+			Field("season_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (SeasonPass) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "season_id").Unique(),
+	}
+}
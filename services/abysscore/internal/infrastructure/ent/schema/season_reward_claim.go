@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type SeasonRewardClaim struct {
+	ent.Schema
+}
+
+func (SeasonRewardClaim) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+
+		field.Int("user_id").Immutable(),
+		field.Int("season_id").Immutable(),
+		field.Int("level").Positive().Immutable(),
+		field.Enum("track").Values("free", "premium").Immutable(),
+
+		field.Int("inventory_item_id").Optional().Nillable().Immutable(),
+		field.Float("coins").Default(0).Min(0).Immutable(),
+
+		// automatic is true if reward was granted on season rollover
+		field.Bool("automatic").Default(false).Immutable(),
+
+		field.Time("claimed_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (SeasonRewardClaim) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("season_reward_claims").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+
+		edge.From("season", Season.Type).
+			Ref("reward_claims").
+			Field("season_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (SeasonRewardClaim) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "season_id", "level", "track").Unique(),
+	}
+}
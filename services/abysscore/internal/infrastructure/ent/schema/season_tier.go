@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type SeasonTier struct {
+	ent.Schema
+}
+
+func (SeasonTier) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+
+		field.Int("season_id").Immutable(),
+		field.Int("level").Positive().Immutable(),
+		field.Int("xp_required").NonNegative(),
+
+		field.Int("free_item_id").Optional().Nillable(),
+		field.Float("free_coins").Default(0).Min(0),
+
+		field.Int("premium_item_id").Optional().Nillable(),
+		field.Float("premium_coins").Default(0).Min(0),
+	}
+}
+
+func (SeasonTier) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("season", Season.Type).
+			Ref("tiers").
+			Field("season_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (SeasonTier) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("season_id", "level").Unique(),
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 const globalStatisticPeriod = 0
@@ -20,34 +21,34 @@ func (Statistic) Fields() []ent.Field {
 
 		field.Int("user_id").Immutable(),
 
-		field.Enum("type").Values("global").Default("global"),
-		field.Int("period").Default(globalStatisticPeriod).Positive(),
+		field.Enum("type").Values("global", "season").Default("global"),
+		field.Int("period").Default(globalStatisticPeriod).NonNegative(), // season number for "season" type
 
-		field.Int("xp").Default(0).Positive(),
+		field.Int("xp").Default(0).NonNegative(),
 
-		field.Int("match_count").Default(0).Positive(),
-		field.Int("wins_count").Default(0).Positive(),
-		field.Int("loses_count").Default(0).Positive(),
-		field.Int("draws_count").Default(0).Positive(),
+		field.Int("match_count").Default(0).NonNegative(),
+		field.Int("wins_count").Default(0).NonNegative(),
+		field.Int("loses_count").Default(0).NonNegative(),
+		field.Int("draws_count").Default(0).NonNegative(),
 
-		field.Int("result_time").Default(0).Positive(),
-		field.Int("retry_time").Default(0).Positive(),
-		field.Int("retry_count").Default(0).Positive(),
+		field.Int("result_time").Default(0).NonNegative(),
+		field.Int("retry_time").Default(0).NonNegative(),
+		field.Int("retry_count").Default(0).NonNegative(),
 
-		field.Int("best_result_time").Default(0).Positive(),
-		field.Int("best_retry_count").Default(0).Positive(),
-		field.Int("best_match_time").Default(0).Positive(),
+		field.Int("best_result_time").Default(0).NonNegative(),
+		field.Int("best_retry_count").Default(0).NonNegative(),
+		field.Int("best_match_time").Default(0).NonNegative(),
 
-		field.Int("worst_result_time").Default(0).Positive(),
-		field.Int("worst_retry_count").Default(0).Positive(),
-		field.Int("worst_match_time").Default(0).Positive(),
+		field.Int("worst_result_time").Default(0).NonNegative(),
+		field.Int("worst_retry_count").Default(0).NonNegative(),
+		field.Int("worst_match_time").Default(0).NonNegative(),
 
-		field.Int("max_win_streak").Default(0).Positive(),
-		field.Int("max_lose_streak").Default(0).Positive(),
+		field.Int("max_win_streak").Default(0).NonNegative(),
+		field.Int("max_lose_streak").Default(0).NonNegative(),
 
-		field.Int("max_login_streak").Default(0).Positive(),
+		field.Int("max_login_streak").Default(0).NonNegative(),
 
-		field.Int("search_score").Default(0).Positive(),
+		field.Int("search_score").Default(0).NonNegative(),
 
 		field.Time("created_at").Default(time.Now).Immutable(),
 	}
@@ -63,3 +64,9 @@ func (Statistic) Edges() []ent.Edge {
 			Field("user_id"),
 	}
 }
+
+func (Statistic) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "type", "period").Unique(),
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/access_level"
 )
 
@@ -21,7 +22,16 @@ func (User) Fields() []ent.Field {
 		field.String("email").Nillable().Optional().Unique(),
 		field.String("password").NotEmpty().Sensitive(),
 		field.String("hardware_id").Nillable().Optional().Unique().Sensitive(),
+		// hardware_id_fingerprint is a short hash of raw hardware id, accounts are searched by it
+		// because encryption of hardware_id is randomized
+		field.String("hardware_id_fingerprint").Nillable().Optional(),
 
+		// totp_secret is encrypted the same way as hardware_id
+		field.String("totp_secret").Nillable().Optional().Sensitive(),
+		field.Time("totp_enabled_at").Nillable().Optional(),
+
+		// Deprecated: permissions are granted with roles.
+		// Value is migrated to roles on startup and reset to User, the column is kept until all instances are migrated.
 		field.String("access_level").
 			GoType(access_level.AccessLevel(0)).
 			DefaultFunc(
@@ -37,6 +47,7 @@ func (User) Fields() []ent.Field {
 		field.Int("current_item_in_profile_id").Optional().Nillable().Unique(),
 
 		field.String("avatar_url").Optional().Nillable(),
+		field.String("title").Optional().Nillable(),
 
 		field.Bool("invites_enabled").Default(false),
 
@@ -52,6 +63,15 @@ func (User) Fields() []ent.Field {
 		field.Time("account_blocked_until").Optional().Nillable(),
 		field.String("account_block_reason").Optional().Nillable(),
 		field.Int("account_blocked_level").Default(0).Min(0),
+
+		// login_locked_until is set after too many failed logins, it is independent of sanctions
+		field.Time("login_locked_until").Optional().Nillable(),
+
+		// deletion_scheduled_at is set when user requests account deletion,
+		// personal data is erased at this time unless the request is cancelled
+		field.Time("deletion_scheduled_at").Optional().Nillable(),
+		// deleted_at is set when personal data is erased, the row is kept as a placeholder player of past matches
+		field.Time("deleted_at").Optional().Nillable(),
 	}
 }
 
@@ -76,5 +96,29 @@ func (User) Edges() []ent.Edge {
 
 		edge.To("balance", UserBalance.Type).
 			Unique(),
+
+		edge.To("collection_completions", CollectionCompletion.Type),
+
+		edge.To("season_passes", SeasonPass.Type),
+		edge.To("season_reward_claims", SeasonRewardClaim.Type),
+
+		edge.To("email_history", EmailHistory.Type),
+		edge.To("username_history", UsernameHistory.Type),
+		edge.To("password_history", PasswordHistory.Type),
+		edge.To("login_history", LoginHistory.Type),
+		edge.To("external_identities", ExternalIdentity.Type),
+		edge.To("api_tokens", APIToken.Type),
+
+		edge.To("recovery_codes", RecoveryCode.Type),
+
+		edge.To("hardware_id_resets", HardwareIDReset.Type),
+		edge.To("sanctions", Sanction.Type),
+		edge.To("roles", Role.Type),
+	}
+}
+
+func (User) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("hardware_id_fingerprint"),
 	}
 }
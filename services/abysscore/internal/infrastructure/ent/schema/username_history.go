@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type UsernameHistory struct {
+	ent.Schema
+}
+
+func (UsernameHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+
+		field.Int("user_id").Immutable(),
+
+		field.String("old_username").Immutable(),
+		field.String("new_username").Immutable(),
+
+		field.Float("coins_spent").Default(0).Immutable(),
+
+		// old_username still resolves to the user and can't be taken by others until released_at
+		field.Time("released_at").Immutable(),
+
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (UsernameHistory) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("username_history").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (UsernameHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+		index.Fields("old_username", "released_at"),
+	}
+}
@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/season"
+)
+
+// Season is the model entity for the Season schema.
+type Season struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Number holds the value of the "number" field.
+	Number int `json:"number,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// StartsAt holds the value of the "starts_at" field.
+	StartsAt time.Time `json:"starts_at,omitempty"`
+	// EndsAt holds the value of the "ends_at" field.
+	EndsAt time.Time `json:"ends_at,omitempty"`
+	// PremiumPrice holds the value of the "premium_price" field.
+	PremiumPrice float64 `json:"premium_price,omitempty"`
+	// RolledOverAt holds the value of the "rolled_over_at" field.
+	RolledOverAt *time.Time `json:"rolled_over_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SeasonQuery when eager-loading is set.
+	Edges        SeasonEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SeasonEdges holds the relations/edges for other nodes in the graph.
+type SeasonEdges struct {
+	// Tiers holds the value of the tiers edge.
+	Tiers []*SeasonTier `json:"tiers,omitempty"`
+	// Passes holds the value of the passes edge.
+	Passes []*SeasonPass `json:"passes,omitempty"`
+	// RewardClaims holds the value of the reward_claims edge.
+	RewardClaims []*SeasonRewardClaim `json:"reward_claims,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// TiersOrErr returns the Tiers value or an error if the edge
+// was not loaded in eager-loading.
+func (e SeasonEdges) TiersOrErr() ([]*SeasonTier, error) {
+	if e.loadedTypes[0] {
+		return e.Tiers, nil
+	}
+	return nil, &NotLoadedError{edge: "tiers"}
+}
+
+// PassesOrErr returns the Passes value or an error if the edge
+// was not loaded in eager-loading.
+func (e SeasonEdges) PassesOrErr() ([]*SeasonPass, error) {
+	if e.loadedTypes[1] {
+		return e.Passes, nil
+	}
+	return nil, &NotLoadedError{edge: "passes"}
+}
+
+// RewardClaimsOrErr returns the RewardClaims value or an error if the edge
+// was not loaded in eager-loading.
+func (e SeasonEdges) RewardClaimsOrErr() ([]*SeasonRewardClaim, error) {
+	if e.loadedTypes[2] {
+		return e.RewardClaims, nil
+	}
+	return nil, &NotLoadedError{edge: "reward_claims"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Season) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case season.FieldPremiumPrice:
+			values[i] = new(sql.NullFloat64)
+		case season.FieldID, season.FieldNumber:
+			values[i] = new(sql.NullInt64)
+		case season.FieldName:
+			values[i] = new(sql.NullString)
+		case season.FieldStartsAt, season.FieldEndsAt, season.FieldRolledOverAt, season.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Season fields.
+func (s *Season) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case season.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			s.ID = int(value.Int64)
+		case season.FieldNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field number", values[i])
+			} else if value.Valid {
+				s.Number = int(value.Int64)
+			}
+		case season.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				s.Name = value.String
+			}
+		case season.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				s.StartsAt = value.Time
+			}
+		case season.FieldEndsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ends_at", values[i])
+			} else if value.Valid {
+				s.EndsAt = value.Time
+			}
+		case season.FieldPremiumPrice:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field premium_price", values[i])
+			} else if value.Valid {
+				s.PremiumPrice = value.Float64
+			}
+		case season.FieldRolledOverAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field rolled_over_at", values[i])
+			} else if value.Valid {
+				s.RolledOverAt = new(time.Time)
+				*s.RolledOverAt = value.Time
+			}
+		case season.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Season.
+// This includes values selected through modifiers, order, etc.
+func (s *Season) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// QueryTiers queries the "tiers" edge of the Season entity.
+func (s *Season) QueryTiers() *SeasonTierQuery {
+	return NewSeasonClient(s.config).QueryTiers(s)
+}
+
+// QueryPasses queries the "passes" edge of the Season entity.
+func (s *Season) QueryPasses() *SeasonPassQuery {
+	return NewSeasonClient(s.config).QueryPasses(s)
+}
+
+// QueryRewardClaims queries the "reward_claims" edge of the Season entity.
+func (s *Season) QueryRewardClaims() *SeasonRewardClaimQuery {
+	return NewSeasonClient(s.config).QueryRewardClaims(s)
+}
+
+// Update returns a builder for updating this Season.
+// Note that you need to call Season.Unwrap() before calling this method if this Season
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Season) Update() *SeasonUpdateOne {
+	return NewSeasonClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Season entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Season) Unwrap() *Season {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Season is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Season) String() string {
+	var builder strings.Builder
+	builder.WriteString("Season(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("number=")
+	builder.WriteString(fmt.Sprintf("%v", s.Number))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(s.Name)
+	builder.WriteString(", ")
+	builder.WriteString("starts_at=")
+	builder.WriteString(s.StartsAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("ends_at=")
+	builder.WriteString(s.EndsAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("premium_price=")
+	builder.WriteString(fmt.Sprintf("%v", s.PremiumPrice))
+	builder.WriteString(", ")
+	if v := s.RolledOverAt; v != nil {
+		builder.WriteString("rolled_over_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Seasons is a parsable slice of Season.
+type Seasons []*Season
@@ -0,0 +1,201 @@
+// Code generated by ent, DO NOT EDIT.
+
+package season
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the season type in the database.
+	Label = "season"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldNumber holds the string denoting the number field in the database.
+	FieldNumber = "number"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldStartsAt holds the string denoting the starts_at field in the database.
+	FieldStartsAt = "starts_at"
+	// FieldEndsAt holds the string denoting the ends_at field in the database.
+	FieldEndsAt = "ends_at"
+	// FieldPremiumPrice holds the string denoting the premium_price field in the database.
+	FieldPremiumPrice = "premium_price"
+	// FieldRolledOverAt holds the string denoting the rolled_over_at field in the database.
+	FieldRolledOverAt = "rolled_over_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeTiers holds the string denoting the tiers edge name in mutations.
+	EdgeTiers = "tiers"
+	// EdgePasses holds the string denoting the passes edge name in mutations.
+	EdgePasses = "passes"
+	// EdgeRewardClaims holds the string denoting the reward_claims edge name in mutations.
+	EdgeRewardClaims = "reward_claims"
+	// Table holds the table name of the season in the database.
+	Table = "seasons"
+	// TiersTable is the table that holds the tiers relation/edge.
+	TiersTable = "season_tiers"
+	// TiersInverseTable is the table name for the SeasonTier entity.
+	// It exists in this package in order to avoid circular dependency with the "seasontier" package.
+	TiersInverseTable = "season_tiers"
+	// TiersColumn is the table column denoting the tiers relation/edge.
+	TiersColumn = "season_id"
+	// PassesTable is the table that holds the passes relation/edge.
+	PassesTable = "season_passes"
+	// PassesInverseTable is the table name for the SeasonPass entity.
+	// It exists in this package in order to avoid circular dependency with the "seasonpass" package.
+	PassesInverseTable = "season_passes"
+	// PassesColumn is the table column denoting the passes relation/edge.
+	PassesColumn = "season_id"
+	// RewardClaimsTable is the table that holds the reward_claims relation/edge.
+	RewardClaimsTable = "season_reward_claims"
+	// RewardClaimsInverseTable is the table name for the SeasonRewardClaim entity.
+	// It exists in this package in order to avoid circular dependency with the "seasonrewardclaim" package.
+	RewardClaimsInverseTable = "season_reward_claims"
+	// RewardClaimsColumn is the table column denoting the reward_claims relation/edge.
+	RewardClaimsColumn = "season_id"
+)
+
+// Columns holds all SQL columns for season fields.
+var Columns = []string{
+	FieldID,
+	FieldNumber,
+	FieldName,
+	FieldStartsAt,
+	FieldEndsAt,
+	FieldPremiumPrice,
+	FieldRolledOverAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NumberValidator is a validator for the "number" field. It is called by the builders before save.
+	NumberValidator func(int) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultPremiumPrice holds the default value on creation for the "premium_price" field.
+	DefaultPremiumPrice float64
+	// PremiumPriceValidator is a validator for the "premium_price" field. It is called by the builders before save.
+	PremiumPriceValidator func(float64) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Season queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByNumber orders the results by the number field.
+func ByNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNumber, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByStartsAt orders the results by the starts_at field.
+func ByStartsAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartsAt, opts...).ToFunc()
+}
+
+// ByEndsAt orders the results by the ends_at field.
+func ByEndsAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndsAt, opts...).ToFunc()
+}
+
+// ByPremiumPrice orders the results by the premium_price field.
+func ByPremiumPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPremiumPrice, opts...).ToFunc()
+}
+
+// ByRolledOverAt orders the results by the rolled_over_at field.
+func ByRolledOverAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRolledOverAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByTiersCount orders the results by tiers count.
+func ByTiersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTiersStep(), opts...)
+	}
+}
+
+// ByTiers orders the results by tiers terms.
+func ByTiers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTiersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPassesCount orders the results by passes count.
+func ByPassesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPassesStep(), opts...)
+	}
+}
+
+// ByPasses orders the results by passes terms.
+func ByPasses(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPassesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRewardClaimsCount orders the results by reward_claims count.
+func ByRewardClaimsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRewardClaimsStep(), opts...)
+	}
+}
+
+// ByRewardClaims orders the results by reward_claims terms.
+func ByRewardClaims(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRewardClaimsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newTiersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TiersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TiersTable, TiersColumn),
+	)
+}
+func newPassesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PassesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PassesTable, PassesColumn),
+	)
+}
+func newRewardClaimsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RewardClaimsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RewardClaimsTable, RewardClaimsColumn),
+	)
+}
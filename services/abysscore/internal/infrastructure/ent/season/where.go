@@ -0,0 +1,490 @@
+// Code generated by ent, DO NOT EDIT.
+
+package season
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Season {
+	return predicate.Season(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Season {
+	return predicate.Season(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Season {
+	return predicate.Season(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Season {
+	return predicate.Season(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Season {
+	return predicate.Season(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Season {
+	return predicate.Season(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Season {
+	return predicate.Season(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Season {
+	return predicate.Season(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Season {
+	return predicate.Season(sql.FieldLTE(FieldID, id))
+}
+
+// Number applies equality check predicate on the "number" field. It's identical to NumberEQ.
+func Number(v int) predicate.Season {
+	return predicate.Season(sql.FieldEQ(FieldNumber, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Season {
+	return predicate.Season(sql.FieldEQ(FieldName, v))
+}
+
+// StartsAt applies equality check predicate on the "starts_at" field. It's identical to StartsAtEQ.
+func StartsAt(v time.Time) predicate.Season {
+	return predicate.Season(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// EndsAt applies equality check predicate on the "ends_at" field. It's identical to EndsAtEQ.
+func EndsAt(v time.Time) predicate.Season {
+	return predicate.Season(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// PremiumPrice applies equality check predicate on the "premium_price" field. It's identical to PremiumPriceEQ.
+func PremiumPrice(v float64) predicate.Season {
+	return predicate.Season(sql.FieldEQ(FieldPremiumPrice, v))
+}
+
+// RolledOverAt applies equality check predicate on the "rolled_over_at" field. It's identical to RolledOverAtEQ.
+func RolledOverAt(v time.Time) predicate.Season {
+	return predicate.Season(sql.FieldEQ(FieldRolledOverAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Season {
+	return predicate.Season(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// NumberEQ applies the EQ predicate on the "number" field.
+func NumberEQ(v int) predicate.Season {
+	return predicate.Season(sql.FieldEQ(FieldNumber, v))
+}
+
+// NumberNEQ applies the NEQ predicate on the "number" field.
+func NumberNEQ(v int) predicate.Season {
+	return predicate.Season(sql.FieldNEQ(FieldNumber, v))
+}
+
+// NumberIn applies the In predicate on the "number" field.
+func NumberIn(vs ...int) predicate.Season {
+	return predicate.Season(sql.FieldIn(FieldNumber, vs...))
+}
+
+// NumberNotIn applies the NotIn predicate on the "number" field.
+func NumberNotIn(vs ...int) predicate.Season {
+	return predicate.Season(sql.FieldNotIn(FieldNumber, vs...))
+}
+
+// NumberGT applies the GT predicate on the "number" field.
+func NumberGT(v int) predicate.Season {
+	return predicate.Season(sql.FieldGT(FieldNumber, v))
+}
+
+// NumberGTE applies the GTE predicate on the "number" field.
+func NumberGTE(v int) predicate.Season {
+	return predicate.Season(sql.FieldGTE(FieldNumber, v))
+}
+
+// NumberLT applies the LT predicate on the "number" field.
+func NumberLT(v int) predicate.Season {
+	return predicate.Season(sql.FieldLT(FieldNumber, v))
+}
+
+// NumberLTE applies the LTE predicate on the "number" field.
+func NumberLTE(v int) predicate.Season {
+	return predicate.Season(sql.FieldLTE(FieldNumber, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Season {
+	return predicate.Season(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Season {
+	return predicate.Season(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Season {
+	return predicate.Season(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Season {
+	return predicate.Season(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Season {
+	return predicate.Season(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Season {
+	return predicate.Season(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Season {
+	return predicate.Season(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Season {
+	return predicate.Season(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Season {
+	return predicate.Season(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Season {
+	return predicate.Season(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Season {
+	return predicate.Season(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Season {
+	return predicate.Season(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Season {
+	return predicate.Season(sql.FieldContainsFold(FieldName, v))
+}
+
+// StartsAtEQ applies the EQ predicate on the "starts_at" field.
+func StartsAtEQ(v time.Time) predicate.Season {
+	return predicate.Season(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// StartsAtNEQ applies the NEQ predicate on the "starts_at" field.
+func StartsAtNEQ(v time.Time) predicate.Season {
+	return predicate.Season(sql.FieldNEQ(FieldStartsAt, v))
+}
+
+// StartsAtIn applies the In predicate on the "starts_at" field.
+func StartsAtIn(vs ...time.Time) predicate.Season {
+	return predicate.Season(sql.FieldIn(FieldStartsAt, vs...))
+}
+
+// StartsAtNotIn applies the NotIn predicate on the "starts_at" field.
+func StartsAtNotIn(vs ...time.Time) predicate.Season {
+	return predicate.Season(sql.FieldNotIn(FieldStartsAt, vs...))
+}
+
+// StartsAtGT applies the GT predicate on the "starts_at" field.
+func StartsAtGT(v time.Time) predicate.Season {
+	return predicate.Season(sql.FieldGT(FieldStartsAt, v))
+}
+
+// StartsAtGTE applies the GTE predicate on the "starts_at" field.
+func StartsAtGTE(v time.Time) predicate.Season {
+	return predicate.Season(sql.FieldGTE(FieldStartsAt, v))
+}
+
+// StartsAtLT applies the LT predicate on the "starts_at" field.
+func StartsAtLT(v time.Time) predicate.Season {
+	return predicate.Season(sql.FieldLT(FieldStartsAt, v))
+}
+
+// StartsAtLTE applies the LTE predicate on the "starts_at" field.
+func StartsAtLTE(v time.Time) predicate.Season {
+	return predicate.Season(sql.FieldLTE(FieldStartsAt, v))
+}
+
+// EndsAtEQ applies the EQ predicate on the "ends_at" field.
+func EndsAtEQ(v time.Time) predicate.Season {
+	return predicate.Season(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// EndsAtNEQ applies the NEQ predicate on the "ends_at" field.
+func EndsAtNEQ(v time.Time) predicate.Season {
+	return predicate.Season(sql.FieldNEQ(FieldEndsAt, v))
+}
+
+// EndsAtIn applies the In predicate on the "ends_at" field.
+func EndsAtIn(vs ...time.Time) predicate.Season {
+	return predicate.Season(sql.FieldIn(FieldEndsAt, vs...))
+}
+
+// EndsAtNotIn applies the NotIn predicate on the "ends_at" field.
+func EndsAtNotIn(vs ...time.Time) predicate.Season {
+	return predicate.Season(sql.FieldNotIn(FieldEndsAt, vs...))
+}
+
+// EndsAtGT applies the GT predicate on the "ends_at" field.
+func EndsAtGT(v time.Time) predicate.Season {
+	return predicate.Season(sql.FieldGT(FieldEndsAt, v))
+}
+
+// EndsAtGTE applies the GTE predicate on the "ends_at" field.
+func EndsAtGTE(v time.Time) predicate.Season {
+	return predicate.Season(sql.FieldGTE(FieldEndsAt, v))
+}
+
+// EndsAtLT applies the LT predicate on the "ends_at" field.
+func EndsAtLT(v time.Time) predicate.Season {
+	return predicate.Season(sql.FieldLT(FieldEndsAt, v))
+}
+
+// EndsAtLTE applies the LTE predicate on the "ends_at" field.
+func EndsAtLTE(v time.Time) predicate.Season {
+	return predicate.Season(sql.FieldLTE(FieldEndsAt, v))
+}
+
+// PremiumPriceEQ applies the EQ predicate on the "premium_price" field.
+func PremiumPriceEQ(v float64) predicate.Season {
+	return predicate.Season(sql.FieldEQ(FieldPremiumPrice, v))
+}
+
+// PremiumPriceNEQ applies the NEQ predicate on the "premium_price" field.
+func PremiumPriceNEQ(v float64) predicate.Season {
+	return predicate.Season(sql.FieldNEQ(FieldPremiumPrice, v))
+}
+
+// PremiumPriceIn applies the In predicate on the "premium_price" field.
+func PremiumPriceIn(vs ...float64) predicate.Season {
+	return predicate.Season(sql.FieldIn(FieldPremiumPrice, vs...))
+}
+
+// PremiumPriceNotIn applies the NotIn predicate on the "premium_price" field.
+func PremiumPriceNotIn(vs ...float64) predicate.Season {
+	return predicate.Season(sql.FieldNotIn(FieldPremiumPrice, vs...))
+}
+
+// PremiumPriceGT applies the GT predicate on the "premium_price" field.
+func PremiumPriceGT(v float64) predicate.Season {
+	return predicate.Season(sql.FieldGT(FieldPremiumPrice, v))
+}
+
+// PremiumPriceGTE applies the GTE predicate on the "premium_price" field.
+func PremiumPriceGTE(v float64) predicate.Season {
+	return predicate.Season(sql.FieldGTE(FieldPremiumPrice, v))
+}
+
+// PremiumPriceLT applies the LT predicate on the "premium_price" field.
+func PremiumPriceLT(v float64) predicate.Season {
+	return predicate.Season(sql.FieldLT(FieldPremiumPrice, v))
+}
+
+// PremiumPriceLTE applies the LTE predicate on the "premium_price" field.
+func PremiumPriceLTE(v float64) predicate.Season {
+	return predicate.Season(sql.FieldLTE(FieldPremiumPrice, v))
+}
+
+// RolledOverAtEQ applies the EQ predicate on the "rolled_over_at" field.
+func RolledOverAtEQ(v time.Time) predicate.Season {
+	return predicate.Season(sql.FieldEQ(FieldRolledOverAt, v))
+}
+
+// RolledOverAtNEQ applies the NEQ predicate on the "rolled_over_at" field.
+func RolledOverAtNEQ(v time.Time) predicate.Season {
+	return predicate.Season(sql.FieldNEQ(FieldRolledOverAt, v))
+}
+
+// RolledOverAtIn applies the In predicate on the "rolled_over_at" field.
+func RolledOverAtIn(vs ...time.Time) predicate.Season {
+	return predicate.Season(sql.FieldIn(FieldRolledOverAt, vs...))
+}
+
+// RolledOverAtNotIn applies the NotIn predicate on the "rolled_over_at" field.
+func RolledOverAtNotIn(vs ...time.Time) predicate.Season {
+	return predicate.Season(sql.FieldNotIn(FieldRolledOverAt, vs...))
+}
+
+// RolledOverAtGT applies the GT predicate on the "rolled_over_at" field.
+func RolledOverAtGT(v time.Time) predicate.Season {
+	return predicate.Season(sql.FieldGT(FieldRolledOverAt, v))
+}
+
+// RolledOverAtGTE applies the GTE predicate on the "rolled_over_at" field.
+func RolledOverAtGTE(v time.Time) predicate.Season {
+	return predicate.Season(sql.FieldGTE(FieldRolledOverAt, v))
+}
+
+// RolledOverAtLT applies the LT predicate on the "rolled_over_at" field.
+func RolledOverAtLT(v time.Time) predicate.Season {
+	return predicate.Season(sql.FieldLT(FieldRolledOverAt, v))
+}
+
+// RolledOverAtLTE applies the LTE predicate on the "rolled_over_at" field.
+func RolledOverAtLTE(v time.Time) predicate.Season {
+	return predicate.Season(sql.FieldLTE(FieldRolledOverAt, v))
+}
+
+// RolledOverAtIsNil applies the IsNil predicate on the "rolled_over_at" field.
+func RolledOverAtIsNil() predicate.Season {
+	return predicate.Season(sql.FieldIsNull(FieldRolledOverAt))
+}
+
+// RolledOverAtNotNil applies the NotNil predicate on the "rolled_over_at" field.
+func RolledOverAtNotNil() predicate.Season {
+	return predicate.Season(sql.FieldNotNull(FieldRolledOverAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Season {
+	return predicate.Season(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Season {
+	return predicate.Season(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Season {
+	return predicate.Season(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Season {
+	return predicate.Season(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Season {
+	return predicate.Season(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Season {
+	return predicate.Season(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Season {
+	return predicate.Season(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Season {
+	return predicate.Season(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasTiers applies the HasEdge predicate on the "tiers" edge.
+func HasTiers() predicate.Season {
+	return predicate.Season(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TiersTable, TiersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTiersWith applies the HasEdge predicate on the "tiers" edge with a given conditions (other predicates).
+func HasTiersWith(preds ...predicate.SeasonTier) predicate.Season {
+	return predicate.Season(func(s *sql.Selector) {
+		step := newTiersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPasses applies the HasEdge predicate on the "passes" edge.
+func HasPasses() predicate.Season {
+	return predicate.Season(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PassesTable, PassesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPassesWith applies the HasEdge predicate on the "passes" edge with a given conditions (other predicates).
+func HasPassesWith(preds ...predicate.SeasonPass) predicate.Season {
+	return predicate.Season(func(s *sql.Selector) {
+		step := newPassesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRewardClaims applies the HasEdge predicate on the "reward_claims" edge.
+func HasRewardClaims() predicate.Season {
+	return predicate.Season(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RewardClaimsTable, RewardClaimsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRewardClaimsWith applies the HasEdge predicate on the "reward_claims" edge with a given conditions (other predicates).
+func HasRewardClaimsWith(preds ...predicate.SeasonRewardClaim) predicate.Season {
+	return predicate.Season(func(s *sql.Selector) {
+		step := newRewardClaimsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Season) predicate.Season {
+	return predicate.Season(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Season) predicate.Season {
+	return predicate.Season(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Season) predicate.Season {
+	return predicate.Season(sql.NotPredicates(p))
+}
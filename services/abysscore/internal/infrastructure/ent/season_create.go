@@ -0,0 +1,420 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/season"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasonpass"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasonrewardclaim"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasontier"
+)
+
+// SeasonCreate is the builder for creating a Season entity.
+type SeasonCreate struct {
+	config
+	mutation *SeasonMutation
+	hooks    []Hook
+}
+
+// SetNumber sets the "number" field.
+func (sc *SeasonCreate) SetNumber(i int) *SeasonCreate {
+	sc.mutation.SetNumber(i)
+	return sc
+}
+
+// SetName sets the "name" field.
+func (sc *SeasonCreate) SetName(s string) *SeasonCreate {
+	sc.mutation.SetName(s)
+	return sc
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (sc *SeasonCreate) SetStartsAt(t time.Time) *SeasonCreate {
+	sc.mutation.SetStartsAt(t)
+	return sc
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (sc *SeasonCreate) SetEndsAt(t time.Time) *SeasonCreate {
+	sc.mutation.SetEndsAt(t)
+	return sc
+}
+
+// SetPremiumPrice sets the "premium_price" field.
+func (sc *SeasonCreate) SetPremiumPrice(f float64) *SeasonCreate {
+	sc.mutation.SetPremiumPrice(f)
+	return sc
+}
+
+// SetNillablePremiumPrice sets the "premium_price" field if the given value is not nil.
+func (sc *SeasonCreate) SetNillablePremiumPrice(f *float64) *SeasonCreate {
+	if f != nil {
+		sc.SetPremiumPrice(*f)
+	}
+	return sc
+}
+
+// SetRolledOverAt sets the "rolled_over_at" field.
+func (sc *SeasonCreate) SetRolledOverAt(t time.Time) *SeasonCreate {
+	sc.mutation.SetRolledOverAt(t)
+	return sc
+}
+
+// SetNillableRolledOverAt sets the "rolled_over_at" field if the given value is not nil.
+func (sc *SeasonCreate) SetNillableRolledOverAt(t *time.Time) *SeasonCreate {
+	if t != nil {
+		sc.SetRolledOverAt(*t)
+	}
+	return sc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *SeasonCreate) SetCreatedAt(t time.Time) *SeasonCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *SeasonCreate) SetNillableCreatedAt(t *time.Time) *SeasonCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetID sets the "id" field.
+func (sc *SeasonCreate) SetID(i int) *SeasonCreate {
+	sc.mutation.SetID(i)
+	return sc
+}
+
+// AddTierIDs adds the "tiers" edge to the SeasonTier entity by IDs.
+func (sc *SeasonCreate) AddTierIDs(ids ...int) *SeasonCreate {
+	sc.mutation.AddTierIDs(ids...)
+	return sc
+}
+
+// AddTiers adds the "tiers" edges to the SeasonTier entity.
+func (sc *SeasonCreate) AddTiers(s ...*SeasonTier) *SeasonCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return sc.AddTierIDs(ids...)
+}
+
+// AddPassIDs adds the "passes" edge to the SeasonPass entity by IDs.
+func (sc *SeasonCreate) AddPassIDs(ids ...int) *SeasonCreate {
+	sc.mutation.AddPassIDs(ids...)
+	return sc
+}
+
+// AddPasses adds the "passes" edges to the SeasonPass entity.
+func (sc *SeasonCreate) AddPasses(s ...*SeasonPass) *SeasonCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return sc.AddPassIDs(ids...)
+}
+
+// AddRewardClaimIDs adds the "reward_claims" edge to the SeasonRewardClaim entity by IDs.
+func (sc *SeasonCreate) AddRewardClaimIDs(ids ...int) *SeasonCreate {
+	sc.mutation.AddRewardClaimIDs(ids...)
+	return sc
+}
+
+// AddRewardClaims adds the "reward_claims" edges to the SeasonRewardClaim entity.
+func (sc *SeasonCreate) AddRewardClaims(s ...*SeasonRewardClaim) *SeasonCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return sc.AddRewardClaimIDs(ids...)
+}
+
+// Mutation returns the SeasonMutation object of the builder.
+func (sc *SeasonCreate) Mutation() *SeasonMutation {
+	return sc.mutation
+}
+
+// Save creates the Season in the database.
+func (sc *SeasonCreate) Save(ctx context.Context) (*Season, error) {
+	sc.defaults()
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *SeasonCreate) SaveX(ctx context.Context) *Season {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *SeasonCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *SeasonCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *SeasonCreate) defaults() {
+	if _, ok := sc.mutation.PremiumPrice(); !ok {
+		v := season.DefaultPremiumPrice
+		sc.mutation.SetPremiumPrice(v)
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := season.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *SeasonCreate) check() error {
+	if _, ok := sc.mutation.Number(); !ok {
+		return &ValidationError{Name: "number", err: errors.New(`ent: missing required field "Season.number"`)}
+	}
+	if v, ok := sc.mutation.Number(); ok {
+		if err := season.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "Season.number": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Season.name"`)}
+	}
+	if v, ok := sc.mutation.Name(); ok {
+		if err := season.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Season.name": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.StartsAt(); !ok {
+		return &ValidationError{Name: "starts_at", err: errors.New(`ent: missing required field "Season.starts_at"`)}
+	}
+	if _, ok := sc.mutation.EndsAt(); !ok {
+		return &ValidationError{Name: "ends_at", err: errors.New(`ent: missing required field "Season.ends_at"`)}
+	}
+	if _, ok := sc.mutation.PremiumPrice(); !ok {
+		return &ValidationError{Name: "premium_price", err: errors.New(`ent: missing required field "Season.premium_price"`)}
+	}
+	if v, ok := sc.mutation.PremiumPrice(); ok {
+		if err := season.PremiumPriceValidator(v); err != nil {
+			return &ValidationError{Name: "premium_price", err: fmt.Errorf(`ent: validator failed for field "Season.premium_price": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Season.created_at"`)}
+	}
+	return nil
+}
+
+func (sc *SeasonCreate) sqlSave(ctx context.Context) (*Season, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *SeasonCreate) createSpec() (*Season, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Season{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(season.Table, sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt))
+	)
+	if id, ok := sc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sc.mutation.Number(); ok {
+		_spec.SetField(season.FieldNumber, field.TypeInt, value)
+		_node.Number = value
+	}
+	if value, ok := sc.mutation.Name(); ok {
+		_spec.SetField(season.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := sc.mutation.StartsAt(); ok {
+		_spec.SetField(season.FieldStartsAt, field.TypeTime, value)
+		_node.StartsAt = value
+	}
+	if value, ok := sc.mutation.EndsAt(); ok {
+		_spec.SetField(season.FieldEndsAt, field.TypeTime, value)
+		_node.EndsAt = value
+	}
+	if value, ok := sc.mutation.PremiumPrice(); ok {
+		_spec.SetField(season.FieldPremiumPrice, field.TypeFloat64, value)
+		_node.PremiumPrice = value
+	}
+	if value, ok := sc.mutation.RolledOverAt(); ok {
+		_spec.SetField(season.FieldRolledOverAt, field.TypeTime, value)
+		_node.RolledOverAt = &value
+	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.SetField(season.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := sc.mutation.TiersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.TiersTable,
+			Columns: []string{season.TiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasontier.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.PassesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.PassesTable,
+			Columns: []string{season.PassesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasonpass.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.RewardClaimsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.RewardClaimsTable,
+			Columns: []string{season.RewardClaimsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasonrewardclaim.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SeasonCreateBulk is the builder for creating many Season entities in bulk.
+type SeasonCreateBulk struct {
+	config
+	err      error
+	builders []*SeasonCreate
+}
+
+// Save creates the Season entities in the database.
+func (scb *SeasonCreateBulk) Save(ctx context.Context) ([]*Season, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Season, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SeasonMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *SeasonCreateBulk) SaveX(ctx context.Context) []*Season {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *SeasonCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *SeasonCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
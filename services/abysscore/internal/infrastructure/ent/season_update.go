@@ -0,0 +1,909 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/season"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasonpass"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasonrewardclaim"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasontier"
+)
+
+// SeasonUpdate is the builder for updating Season entities.
+type SeasonUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SeasonMutation
+}
+
+// Where appends a list predicates to the SeasonUpdate builder.
+func (su *SeasonUpdate) Where(ps ...predicate.Season) *SeasonUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetName sets the "name" field.
+func (su *SeasonUpdate) SetName(s string) *SeasonUpdate {
+	su.mutation.SetName(s)
+	return su
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (su *SeasonUpdate) SetNillableName(s *string) *SeasonUpdate {
+	if s != nil {
+		su.SetName(*s)
+	}
+	return su
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (su *SeasonUpdate) SetStartsAt(t time.Time) *SeasonUpdate {
+	su.mutation.SetStartsAt(t)
+	return su
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (su *SeasonUpdate) SetNillableStartsAt(t *time.Time) *SeasonUpdate {
+	if t != nil {
+		su.SetStartsAt(*t)
+	}
+	return su
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (su *SeasonUpdate) SetEndsAt(t time.Time) *SeasonUpdate {
+	su.mutation.SetEndsAt(t)
+	return su
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (su *SeasonUpdate) SetNillableEndsAt(t *time.Time) *SeasonUpdate {
+	if t != nil {
+		su.SetEndsAt(*t)
+	}
+	return su
+}
+
+// SetPremiumPrice sets the "premium_price" field.
+func (su *SeasonUpdate) SetPremiumPrice(f float64) *SeasonUpdate {
+	su.mutation.ResetPremiumPrice()
+	su.mutation.SetPremiumPrice(f)
+	return su
+}
+
+// SetNillablePremiumPrice sets the "premium_price" field if the given value is not nil.
+func (su *SeasonUpdate) SetNillablePremiumPrice(f *float64) *SeasonUpdate {
+	if f != nil {
+		su.SetPremiumPrice(*f)
+	}
+	return su
+}
+
+// AddPremiumPrice adds f to the "premium_price" field.
+func (su *SeasonUpdate) AddPremiumPrice(f float64) *SeasonUpdate {
+	su.mutation.AddPremiumPrice(f)
+	return su
+}
+
+// SetRolledOverAt sets the "rolled_over_at" field.
+func (su *SeasonUpdate) SetRolledOverAt(t time.Time) *SeasonUpdate {
+	su.mutation.SetRolledOverAt(t)
+	return su
+}
+
+// SetNillableRolledOverAt sets the "rolled_over_at" field if the given value is not nil.
+func (su *SeasonUpdate) SetNillableRolledOverAt(t *time.Time) *SeasonUpdate {
+	if t != nil {
+		su.SetRolledOverAt(*t)
+	}
+	return su
+}
+
+// ClearRolledOverAt clears the value of the "rolled_over_at" field.
+func (su *SeasonUpdate) ClearRolledOverAt() *SeasonUpdate {
+	su.mutation.ClearRolledOverAt()
+	return su
+}
+
+// AddTierIDs adds the "tiers" edge to the SeasonTier entity by IDs.
+func (su *SeasonUpdate) AddTierIDs(ids ...int) *SeasonUpdate {
+	su.mutation.AddTierIDs(ids...)
+	return su
+}
+
+// AddTiers adds the "tiers" edges to the SeasonTier entity.
+func (su *SeasonUpdate) AddTiers(s ...*SeasonTier) *SeasonUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.AddTierIDs(ids...)
+}
+
+// AddPassIDs adds the "passes" edge to the SeasonPass entity by IDs.
+func (su *SeasonUpdate) AddPassIDs(ids ...int) *SeasonUpdate {
+	su.mutation.AddPassIDs(ids...)
+	return su
+}
+
+// AddPasses adds the "passes" edges to the SeasonPass entity.
+func (su *SeasonUpdate) AddPasses(s ...*SeasonPass) *SeasonUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.AddPassIDs(ids...)
+}
+
+// AddRewardClaimIDs adds the "reward_claims" edge to the SeasonRewardClaim entity by IDs.
+func (su *SeasonUpdate) AddRewardClaimIDs(ids ...int) *SeasonUpdate {
+	su.mutation.AddRewardClaimIDs(ids...)
+	return su
+}
+
+// AddRewardClaims adds the "reward_claims" edges to the SeasonRewardClaim entity.
+func (su *SeasonUpdate) AddRewardClaims(s ...*SeasonRewardClaim) *SeasonUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.AddRewardClaimIDs(ids...)
+}
+
+// Mutation returns the SeasonMutation object of the builder.
+func (su *SeasonUpdate) Mutation() *SeasonMutation {
+	return su.mutation
+}
+
+// ClearTiers clears all "tiers" edges to the SeasonTier entity.
+func (su *SeasonUpdate) ClearTiers() *SeasonUpdate {
+	su.mutation.ClearTiers()
+	return su
+}
+
+// RemoveTierIDs removes the "tiers" edge to SeasonTier entities by IDs.
+func (su *SeasonUpdate) RemoveTierIDs(ids ...int) *SeasonUpdate {
+	su.mutation.RemoveTierIDs(ids...)
+	return su
+}
+
+// RemoveTiers removes "tiers" edges to SeasonTier entities.
+func (su *SeasonUpdate) RemoveTiers(s ...*SeasonTier) *SeasonUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.RemoveTierIDs(ids...)
+}
+
+// ClearPasses clears all "passes" edges to the SeasonPass entity.
+func (su *SeasonUpdate) ClearPasses() *SeasonUpdate {
+	su.mutation.ClearPasses()
+	return su
+}
+
+// RemovePassIDs removes the "passes" edge to SeasonPass entities by IDs.
+func (su *SeasonUpdate) RemovePassIDs(ids ...int) *SeasonUpdate {
+	su.mutation.RemovePassIDs(ids...)
+	return su
+}
+
+// RemovePasses removes "passes" edges to SeasonPass entities.
+func (su *SeasonUpdate) RemovePasses(s ...*SeasonPass) *SeasonUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.RemovePassIDs(ids...)
+}
+
+// ClearRewardClaims clears all "reward_claims" edges to the SeasonRewardClaim entity.
+func (su *SeasonUpdate) ClearRewardClaims() *SeasonUpdate {
+	su.mutation.ClearRewardClaims()
+	return su
+}
+
+// RemoveRewardClaimIDs removes the "reward_claims" edge to SeasonRewardClaim entities by IDs.
+func (su *SeasonUpdate) RemoveRewardClaimIDs(ids ...int) *SeasonUpdate {
+	su.mutation.RemoveRewardClaimIDs(ids...)
+	return su
+}
+
+// RemoveRewardClaims removes "reward_claims" edges to SeasonRewardClaim entities.
+func (su *SeasonUpdate) RemoveRewardClaims(s ...*SeasonRewardClaim) *SeasonUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.RemoveRewardClaimIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *SeasonUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *SeasonUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *SeasonUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *SeasonUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *SeasonUpdate) check() error {
+	if v, ok := su.mutation.Name(); ok {
+		if err := season.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Season.name": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.PremiumPrice(); ok {
+		if err := season.PremiumPriceValidator(v); err != nil {
+			return &ValidationError{Name: "premium_price", err: fmt.Errorf(`ent: validator failed for field "Season.premium_price": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (su *SeasonUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(season.Table, season.Columns, sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.Name(); ok {
+		_spec.SetField(season.FieldName, field.TypeString, value)
+	}
+	if value, ok := su.mutation.StartsAt(); ok {
+		_spec.SetField(season.FieldStartsAt, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.EndsAt(); ok {
+		_spec.SetField(season.FieldEndsAt, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.PremiumPrice(); ok {
+		_spec.SetField(season.FieldPremiumPrice, field.TypeFloat64, value)
+	}
+	if value, ok := su.mutation.AddedPremiumPrice(); ok {
+		_spec.AddField(season.FieldPremiumPrice, field.TypeFloat64, value)
+	}
+	if value, ok := su.mutation.RolledOverAt(); ok {
+		_spec.SetField(season.FieldRolledOverAt, field.TypeTime, value)
+	}
+	if su.mutation.RolledOverAtCleared() {
+		_spec.ClearField(season.FieldRolledOverAt, field.TypeTime)
+	}
+	if su.mutation.TiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.TiersTable,
+			Columns: []string{season.TiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasontier.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedTiersIDs(); len(nodes) > 0 && !su.mutation.TiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.TiersTable,
+			Columns: []string{season.TiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasontier.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.TiersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.TiersTable,
+			Columns: []string{season.TiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasontier.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.PassesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.PassesTable,
+			Columns: []string{season.PassesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasonpass.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedPassesIDs(); len(nodes) > 0 && !su.mutation.PassesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.PassesTable,
+			Columns: []string{season.PassesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasonpass.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.PassesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.PassesTable,
+			Columns: []string{season.PassesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasonpass.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.RewardClaimsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.RewardClaimsTable,
+			Columns: []string{season.RewardClaimsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasonrewardclaim.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedRewardClaimsIDs(); len(nodes) > 0 && !su.mutation.RewardClaimsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.RewardClaimsTable,
+			Columns: []string{season.RewardClaimsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasonrewardclaim.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RewardClaimsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.RewardClaimsTable,
+			Columns: []string{season.RewardClaimsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasonrewardclaim.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{season.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// SeasonUpdateOne is the builder for updating a single Season entity.
+type SeasonUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SeasonMutation
+}
+
+// SetName sets the "name" field.
+func (suo *SeasonUpdateOne) SetName(s string) *SeasonUpdateOne {
+	suo.mutation.SetName(s)
+	return suo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (suo *SeasonUpdateOne) SetNillableName(s *string) *SeasonUpdateOne {
+	if s != nil {
+		suo.SetName(*s)
+	}
+	return suo
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (suo *SeasonUpdateOne) SetStartsAt(t time.Time) *SeasonUpdateOne {
+	suo.mutation.SetStartsAt(t)
+	return suo
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (suo *SeasonUpdateOne) SetNillableStartsAt(t *time.Time) *SeasonUpdateOne {
+	if t != nil {
+		suo.SetStartsAt(*t)
+	}
+	return suo
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (suo *SeasonUpdateOne) SetEndsAt(t time.Time) *SeasonUpdateOne {
+	suo.mutation.SetEndsAt(t)
+	return suo
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (suo *SeasonUpdateOne) SetNillableEndsAt(t *time.Time) *SeasonUpdateOne {
+	if t != nil {
+		suo.SetEndsAt(*t)
+	}
+	return suo
+}
+
+// SetPremiumPrice sets the "premium_price" field.
+func (suo *SeasonUpdateOne) SetPremiumPrice(f float64) *SeasonUpdateOne {
+	suo.mutation.ResetPremiumPrice()
+	suo.mutation.SetPremiumPrice(f)
+	return suo
+}
+
+// SetNillablePremiumPrice sets the "premium_price" field if the given value is not nil.
+func (suo *SeasonUpdateOne) SetNillablePremiumPrice(f *float64) *SeasonUpdateOne {
+	if f != nil {
+		suo.SetPremiumPrice(*f)
+	}
+	return suo
+}
+
+// AddPremiumPrice adds f to the "premium_price" field.
+func (suo *SeasonUpdateOne) AddPremiumPrice(f float64) *SeasonUpdateOne {
+	suo.mutation.AddPremiumPrice(f)
+	return suo
+}
+
+// SetRolledOverAt sets the "rolled_over_at" field.
+func (suo *SeasonUpdateOne) SetRolledOverAt(t time.Time) *SeasonUpdateOne {
+	suo.mutation.SetRolledOverAt(t)
+	return suo
+}
+
+// SetNillableRolledOverAt sets the "rolled_over_at" field if the given value is not nil.
+func (suo *SeasonUpdateOne) SetNillableRolledOverAt(t *time.Time) *SeasonUpdateOne {
+	if t != nil {
+		suo.SetRolledOverAt(*t)
+	}
+	return suo
+}
+
+// ClearRolledOverAt clears the value of the "rolled_over_at" field.
+func (suo *SeasonUpdateOne) ClearRolledOverAt() *SeasonUpdateOne {
+	suo.mutation.ClearRolledOverAt()
+	return suo
+}
+
+// AddTierIDs adds the "tiers" edge to the SeasonTier entity by IDs.
+func (suo *SeasonUpdateOne) AddTierIDs(ids ...int) *SeasonUpdateOne {
+	suo.mutation.AddTierIDs(ids...)
+	return suo
+}
+
+// AddTiers adds the "tiers" edges to the SeasonTier entity.
+func (suo *SeasonUpdateOne) AddTiers(s ...*SeasonTier) *SeasonUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.AddTierIDs(ids...)
+}
+
+// AddPassIDs adds the "passes" edge to the SeasonPass entity by IDs.
+func (suo *SeasonUpdateOne) AddPassIDs(ids ...int) *SeasonUpdateOne {
+	suo.mutation.AddPassIDs(ids...)
+	return suo
+}
+
+// AddPasses adds the "passes" edges to the SeasonPass entity.
+func (suo *SeasonUpdateOne) AddPasses(s ...*SeasonPass) *SeasonUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.AddPassIDs(ids...)
+}
+
+// AddRewardClaimIDs adds the "reward_claims" edge to the SeasonRewardClaim entity by IDs.
+func (suo *SeasonUpdateOne) AddRewardClaimIDs(ids ...int) *SeasonUpdateOne {
+	suo.mutation.AddRewardClaimIDs(ids...)
+	return suo
+}
+
+// AddRewardClaims adds the "reward_claims" edges to the SeasonRewardClaim entity.
+func (suo *SeasonUpdateOne) AddRewardClaims(s ...*SeasonRewardClaim) *SeasonUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.AddRewardClaimIDs(ids...)
+}
+
+// Mutation returns the SeasonMutation object of the builder.
+func (suo *SeasonUpdateOne) Mutation() *SeasonMutation {
+	return suo.mutation
+}
+
+// ClearTiers clears all "tiers" edges to the SeasonTier entity.
+func (suo *SeasonUpdateOne) ClearTiers() *SeasonUpdateOne {
+	suo.mutation.ClearTiers()
+	return suo
+}
+
+// RemoveTierIDs removes the "tiers" edge to SeasonTier entities by IDs.
+func (suo *SeasonUpdateOne) RemoveTierIDs(ids ...int) *SeasonUpdateOne {
+	suo.mutation.RemoveTierIDs(ids...)
+	return suo
+}
+
+// RemoveTiers removes "tiers" edges to SeasonTier entities.
+func (suo *SeasonUpdateOne) RemoveTiers(s ...*SeasonTier) *SeasonUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.RemoveTierIDs(ids...)
+}
+
+// ClearPasses clears all "passes" edges to the SeasonPass entity.
+func (suo *SeasonUpdateOne) ClearPasses() *SeasonUpdateOne {
+	suo.mutation.ClearPasses()
+	return suo
+}
+
+// RemovePassIDs removes the "passes" edge to SeasonPass entities by IDs.
+func (suo *SeasonUpdateOne) RemovePassIDs(ids ...int) *SeasonUpdateOne {
+	suo.mutation.RemovePassIDs(ids...)
+	return suo
+}
+
+// RemovePasses removes "passes" edges to SeasonPass entities.
+func (suo *SeasonUpdateOne) RemovePasses(s ...*SeasonPass) *SeasonUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.RemovePassIDs(ids...)
+}
+
+// ClearRewardClaims clears all "reward_claims" edges to the SeasonRewardClaim entity.
+func (suo *SeasonUpdateOne) ClearRewardClaims() *SeasonUpdateOne {
+	suo.mutation.ClearRewardClaims()
+	return suo
+}
+
+// RemoveRewardClaimIDs removes the "reward_claims" edge to SeasonRewardClaim entities by IDs.
+func (suo *SeasonUpdateOne) RemoveRewardClaimIDs(ids ...int) *SeasonUpdateOne {
+	suo.mutation.RemoveRewardClaimIDs(ids...)
+	return suo
+}
+
+// RemoveRewardClaims removes "reward_claims" edges to SeasonRewardClaim entities.
+func (suo *SeasonUpdateOne) RemoveRewardClaims(s ...*SeasonRewardClaim) *SeasonUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.RemoveRewardClaimIDs(ids...)
+}
+
+// Where appends a list predicates to the SeasonUpdate builder.
+func (suo *SeasonUpdateOne) Where(ps ...predicate.Season) *SeasonUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *SeasonUpdateOne) Select(field string, fields ...string) *SeasonUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Season entity.
+func (suo *SeasonUpdateOne) Save(ctx context.Context) (*Season, error) {
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *SeasonUpdateOne) SaveX(ctx context.Context) *Season {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *SeasonUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *SeasonUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *SeasonUpdateOne) check() error {
+	if v, ok := suo.mutation.Name(); ok {
+		if err := season.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Season.name": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.PremiumPrice(); ok {
+		if err := season.PremiumPriceValidator(v); err != nil {
+			return &ValidationError{Name: "premium_price", err: fmt.Errorf(`ent: validator failed for field "Season.premium_price": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (suo *SeasonUpdateOne) sqlSave(ctx context.Context) (_node *Season, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(season.Table, season.Columns, sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Season.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, season.FieldID)
+		for _, f := range fields {
+			if !season.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != season.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.Name(); ok {
+		_spec.SetField(season.FieldName, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.StartsAt(); ok {
+		_spec.SetField(season.FieldStartsAt, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.EndsAt(); ok {
+		_spec.SetField(season.FieldEndsAt, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.PremiumPrice(); ok {
+		_spec.SetField(season.FieldPremiumPrice, field.TypeFloat64, value)
+	}
+	if value, ok := suo.mutation.AddedPremiumPrice(); ok {
+		_spec.AddField(season.FieldPremiumPrice, field.TypeFloat64, value)
+	}
+	if value, ok := suo.mutation.RolledOverAt(); ok {
+		_spec.SetField(season.FieldRolledOverAt, field.TypeTime, value)
+	}
+	if suo.mutation.RolledOverAtCleared() {
+		_spec.ClearField(season.FieldRolledOverAt, field.TypeTime)
+	}
+	if suo.mutation.TiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.TiersTable,
+			Columns: []string{season.TiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasontier.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedTiersIDs(); len(nodes) > 0 && !suo.mutation.TiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.TiersTable,
+			Columns: []string{season.TiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasontier.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.TiersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.TiersTable,
+			Columns: []string{season.TiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasontier.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.PassesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.PassesTable,
+			Columns: []string{season.PassesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasonpass.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedPassesIDs(); len(nodes) > 0 && !suo.mutation.PassesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.PassesTable,
+			Columns: []string{season.PassesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasonpass.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.PassesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.PassesTable,
+			Columns: []string{season.PassesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasonpass.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.RewardClaimsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.RewardClaimsTable,
+			Columns: []string{season.RewardClaimsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasonrewardclaim.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedRewardClaimsIDs(); len(nodes) > 0 && !suo.mutation.RewardClaimsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.RewardClaimsTable,
+			Columns: []string{season.RewardClaimsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasonrewardclaim.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RewardClaimsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.RewardClaimsTable,
+			Columns: []string{season.RewardClaimsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasonrewardclaim.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Season{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{season.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
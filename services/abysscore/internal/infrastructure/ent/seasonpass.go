@@ -0,0 +1,201 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/season"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasonpass"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
+)
+
+// SeasonPass is the model entity for the SeasonPass schema.
+type SeasonPass struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// SeasonID holds the value of the "season_id" field.
+	SeasonID int `json:"season_id,omitempty"`
+	// Premium holds the value of the "premium" field.
+	Premium bool `json:"premium,omitempty"`
+	// PremiumPurchasedAt holds the value of the "premium_purchased_at" field.
+	PremiumPurchasedAt *time.Time `json:"premium_purchased_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SeasonPassQuery when eager-loading is set.
+	Edges        SeasonPassEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SeasonPassEdges holds the relations/edges for other nodes in the graph.
+type SeasonPassEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Season holds the value of the season edge.
+	Season *Season `json:"season,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SeasonPassEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// SeasonOrErr returns the Season value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SeasonPassEdges) SeasonOrErr() (*Season, error) {
+	if e.Season != nil {
+		return e.Season, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: season.Label}
+	}
+	return nil, &NotLoadedError{edge: "season"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SeasonPass) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case seasonpass.FieldPremium:
+			values[i] = new(sql.NullBool)
+		case seasonpass.FieldID, seasonpass.FieldUserID, seasonpass.FieldSeasonID:
+			values[i] = new(sql.NullInt64)
+		case seasonpass.FieldPremiumPurchasedAt, seasonpass.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SeasonPass fields.
+func (sp *SeasonPass) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case seasonpass.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sp.ID = int(value.Int64)
+		case seasonpass.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				sp.UserID = int(value.Int64)
+			}
+		case seasonpass.FieldSeasonID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field season_id", values[i])
+			} else if value.Valid {
+				sp.SeasonID = int(value.Int64)
+			}
+		case seasonpass.FieldPremium:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field premium", values[i])
+			} else if value.Valid {
+				sp.Premium = value.Bool
+			}
+		case seasonpass.FieldPremiumPurchasedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field premium_purchased_at", values[i])
+			} else if value.Valid {
+				sp.PremiumPurchasedAt = new(time.Time)
+				*sp.PremiumPurchasedAt = value.Time
+			}
+		case seasonpass.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sp.CreatedAt = value.Time
+			}
+		default:
+			sp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SeasonPass.
+// This includes values selected through modifiers, order, etc.
+func (sp *SeasonPass) Value(name string) (ent.Value, error) {
+	return sp.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the SeasonPass entity.
+func (sp *SeasonPass) QueryUser() *UserQuery {
+	return NewSeasonPassClient(sp.config).QueryUser(sp)
+}
+
+// QuerySeason queries the "season" edge of the SeasonPass entity.
+func (sp *SeasonPass) QuerySeason() *SeasonQuery {
+	return NewSeasonPassClient(sp.config).QuerySeason(sp)
+}
+
+// Update returns a builder for updating this SeasonPass.
+// Note that you need to call SeasonPass.Unwrap() before calling this method if this SeasonPass
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sp *SeasonPass) Update() *SeasonPassUpdateOne {
+	return NewSeasonPassClient(sp.config).UpdateOne(sp)
+}
+
+// Unwrap unwraps the SeasonPass entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sp *SeasonPass) Unwrap() *SeasonPass {
+	_tx, ok := sp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SeasonPass is not a transactional entity")
+	}
+	sp.config.driver = _tx.drv
+	return sp
+}
+
+// String implements the fmt.Stringer.
+func (sp *SeasonPass) String() string {
+	var builder strings.Builder
+	builder.WriteString("SeasonPass(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sp.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", sp.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("season_id=")
+	builder.WriteString(fmt.Sprintf("%v", sp.SeasonID))
+	builder.WriteString(", ")
+	builder.WriteString("premium=")
+	builder.WriteString(fmt.Sprintf("%v", sp.Premium))
+	builder.WriteString(", ")
+	if v := sp.PremiumPurchasedAt; v != nil {
+		builder.WriteString("premium_purchased_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sp.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SeasonPasses is a parsable slice of SeasonPass.
+type SeasonPasses []*SeasonPass
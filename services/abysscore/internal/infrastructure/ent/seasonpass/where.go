@@ -0,0 +1,282 @@
+// Code generated by ent, DO NOT EDIT.
+
+package seasonpass
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldEQ(FieldUserID, v))
+}
+
+// SeasonID applies equality check predicate on the "season_id" field. It's identical to SeasonIDEQ.
+func SeasonID(v int) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldEQ(FieldSeasonID, v))
+}
+
+// Premium applies equality check predicate on the "premium" field. It's identical to PremiumEQ.
+func Premium(v bool) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldEQ(FieldPremium, v))
+}
+
+// PremiumPurchasedAt applies equality check predicate on the "premium_purchased_at" field. It's identical to PremiumPurchasedAtEQ.
+func PremiumPurchasedAt(v time.Time) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldEQ(FieldPremiumPurchasedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// SeasonIDEQ applies the EQ predicate on the "season_id" field.
+func SeasonIDEQ(v int) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldEQ(FieldSeasonID, v))
+}
+
+// SeasonIDNEQ applies the NEQ predicate on the "season_id" field.
+func SeasonIDNEQ(v int) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldNEQ(FieldSeasonID, v))
+}
+
+// SeasonIDIn applies the In predicate on the "season_id" field.
+func SeasonIDIn(vs ...int) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldIn(FieldSeasonID, vs...))
+}
+
+// SeasonIDNotIn applies the NotIn predicate on the "season_id" field.
+func SeasonIDNotIn(vs ...int) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldNotIn(FieldSeasonID, vs...))
+}
+
+// PremiumEQ applies the EQ predicate on the "premium" field.
+func PremiumEQ(v bool) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldEQ(FieldPremium, v))
+}
+
+// PremiumNEQ applies the NEQ predicate on the "premium" field.
+func PremiumNEQ(v bool) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldNEQ(FieldPremium, v))
+}
+
+// PremiumPurchasedAtEQ applies the EQ predicate on the "premium_purchased_at" field.
+func PremiumPurchasedAtEQ(v time.Time) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldEQ(FieldPremiumPurchasedAt, v))
+}
+
+// PremiumPurchasedAtNEQ applies the NEQ predicate on the "premium_purchased_at" field.
+func PremiumPurchasedAtNEQ(v time.Time) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldNEQ(FieldPremiumPurchasedAt, v))
+}
+
+// PremiumPurchasedAtIn applies the In predicate on the "premium_purchased_at" field.
+func PremiumPurchasedAtIn(vs ...time.Time) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldIn(FieldPremiumPurchasedAt, vs...))
+}
+
+// PremiumPurchasedAtNotIn applies the NotIn predicate on the "premium_purchased_at" field.
+func PremiumPurchasedAtNotIn(vs ...time.Time) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldNotIn(FieldPremiumPurchasedAt, vs...))
+}
+
+// PremiumPurchasedAtGT applies the GT predicate on the "premium_purchased_at" field.
+func PremiumPurchasedAtGT(v time.Time) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldGT(FieldPremiumPurchasedAt, v))
+}
+
+// PremiumPurchasedAtGTE applies the GTE predicate on the "premium_purchased_at" field.
+func PremiumPurchasedAtGTE(v time.Time) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldGTE(FieldPremiumPurchasedAt, v))
+}
+
+// PremiumPurchasedAtLT applies the LT predicate on the "premium_purchased_at" field.
+func PremiumPurchasedAtLT(v time.Time) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldLT(FieldPremiumPurchasedAt, v))
+}
+
+// PremiumPurchasedAtLTE applies the LTE predicate on the "premium_purchased_at" field.
+func PremiumPurchasedAtLTE(v time.Time) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldLTE(FieldPremiumPurchasedAt, v))
+}
+
+// PremiumPurchasedAtIsNil applies the IsNil predicate on the "premium_purchased_at" field.
+func PremiumPurchasedAtIsNil() predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldIsNull(FieldPremiumPurchasedAt))
+}
+
+// PremiumPurchasedAtNotNil applies the NotNil predicate on the "premium_purchased_at" field.
+func PremiumPurchasedAtNotNil() predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldNotNull(FieldPremiumPurchasedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.SeasonPass {
+	return predicate.SeasonPass(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.SeasonPass {
+	return predicate.SeasonPass(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSeason applies the HasEdge predicate on the "season" edge.
+func HasSeason() predicate.SeasonPass {
+	return predicate.SeasonPass(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SeasonTable, SeasonColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSeasonWith applies the HasEdge predicate on the "season" edge with a given conditions (other predicates).
+func HasSeasonWith(preds ...predicate.Season) predicate.SeasonPass {
+	return predicate.SeasonPass(func(s *sql.Selector) {
+		step := newSeasonStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SeasonPass) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SeasonPass) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SeasonPass) predicate.SeasonPass {
+	return predicate.SeasonPass(sql.NotPredicates(p))
+}
@@ -0,0 +1,327 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/season"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasonpass"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
+)
+
+// SeasonPassCreate is the builder for creating a SeasonPass entity.
+type SeasonPassCreate struct {
+	config
+	mutation *SeasonPassMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (spc *SeasonPassCreate) SetUserID(i int) *SeasonPassCreate {
+	spc.mutation.SetUserID(i)
+	return spc
+}
+
+// SetSeasonID sets the "season_id" field.
+func (spc *SeasonPassCreate) SetSeasonID(i int) *SeasonPassCreate {
+	spc.mutation.SetSeasonID(i)
+	return spc
+}
+
+// SetPremium sets the "premium" field.
+func (spc *SeasonPassCreate) SetPremium(b bool) *SeasonPassCreate {
+	spc.mutation.SetPremium(b)
+	return spc
+}
+
+// SetNillablePremium sets the "premium" field if the given value is not nil.
+func (spc *SeasonPassCreate) SetNillablePremium(b *bool) *SeasonPassCreate {
+	if b != nil {
+		spc.SetPremium(*b)
+	}
+	return spc
+}
+
+// SetPremiumPurchasedAt sets the "premium_purchased_at" field.
+func (spc *SeasonPassCreate) SetPremiumPurchasedAt(t time.Time) *SeasonPassCreate {
+	spc.mutation.SetPremiumPurchasedAt(t)
+	return spc
+}
+
+// SetNillablePremiumPurchasedAt sets the "premium_purchased_at" field if the given value is not nil.
+func (spc *SeasonPassCreate) SetNillablePremiumPurchasedAt(t *time.Time) *SeasonPassCreate {
+	if t != nil {
+		spc.SetPremiumPurchasedAt(*t)
+	}
+	return spc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (spc *SeasonPassCreate) SetCreatedAt(t time.Time) *SeasonPassCreate {
+	spc.mutation.SetCreatedAt(t)
+	return spc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (spc *SeasonPassCreate) SetNillableCreatedAt(t *time.Time) *SeasonPassCreate {
+	if t != nil {
+		spc.SetCreatedAt(*t)
+	}
+	return spc
+}
+
+// SetID sets the "id" field.
+func (spc *SeasonPassCreate) SetID(i int) *SeasonPassCreate {
+	spc.mutation.SetID(i)
+	return spc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (spc *SeasonPassCreate) SetUser(u *User) *SeasonPassCreate {
+	return spc.SetUserID(u.ID)
+}
+
+// SetSeason sets the "season" edge to the Season entity.
+func (spc *SeasonPassCreate) SetSeason(s *Season) *SeasonPassCreate {
+	return spc.SetSeasonID(s.ID)
+}
+
+// Mutation returns the SeasonPassMutation object of the builder.
+func (spc *SeasonPassCreate) Mutation() *SeasonPassMutation {
+	return spc.mutation
+}
+
+// Save creates the SeasonPass in the database.
+func (spc *SeasonPassCreate) Save(ctx context.Context) (*SeasonPass, error) {
+	spc.defaults()
+	return withHooks(ctx, spc.sqlSave, spc.mutation, spc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (spc *SeasonPassCreate) SaveX(ctx context.Context) *SeasonPass {
+	v, err := spc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (spc *SeasonPassCreate) Exec(ctx context.Context) error {
+	_, err := spc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (spc *SeasonPassCreate) ExecX(ctx context.Context) {
+	if err := spc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (spc *SeasonPassCreate) defaults() {
+	if _, ok := spc.mutation.Premium(); !ok {
+		v := seasonpass.DefaultPremium
+		spc.mutation.SetPremium(v)
+	}
+	if _, ok := spc.mutation.CreatedAt(); !ok {
+		v := seasonpass.DefaultCreatedAt()
+		spc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (spc *SeasonPassCreate) check() error {
+	if _, ok := spc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "SeasonPass.user_id"`)}
+	}
+	if _, ok := spc.mutation.SeasonID(); !ok {
+		return &ValidationError{Name: "season_id", err: errors.New(`ent: missing required field "SeasonPass.season_id"`)}
+	}
+	if _, ok := spc.mutation.Premium(); !ok {
+		return &ValidationError{Name: "premium", err: errors.New(`ent: missing required field "SeasonPass.premium"`)}
+	}
+	if _, ok := spc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SeasonPass.created_at"`)}
+	}
+	if len(spc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "SeasonPass.user"`)}
+	}
+	if len(spc.mutation.SeasonIDs()) == 0 {
+		return &ValidationError{Name: "season", err: errors.New(`ent: missing required edge "SeasonPass.season"`)}
+	}
+	return nil
+}
+
+func (spc *SeasonPassCreate) sqlSave(ctx context.Context) (*SeasonPass, error) {
+	if err := spc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := spc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, spc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	spc.mutation.id = &_node.ID
+	spc.mutation.done = true
+	return _node, nil
+}
+
+func (spc *SeasonPassCreate) createSpec() (*SeasonPass, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SeasonPass{config: spc.config}
+		_spec = sqlgraph.NewCreateSpec(seasonpass.Table, sqlgraph.NewFieldSpec(seasonpass.FieldID, field.TypeInt))
+	)
+	if id, ok := spc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := spc.mutation.Premium(); ok {
+		_spec.SetField(seasonpass.FieldPremium, field.TypeBool, value)
+		_node.Premium = value
+	}
+	if value, ok := spc.mutation.PremiumPurchasedAt(); ok {
+		_spec.SetField(seasonpass.FieldPremiumPurchasedAt, field.TypeTime, value)
+		_node.PremiumPurchasedAt = &value
+	}
+	if value, ok := spc.mutation.CreatedAt(); ok {
+		_spec.SetField(seasonpass.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := spc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   seasonpass.UserTable,
+			Columns: []string{seasonpass.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := spc.mutation.SeasonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   seasonpass.SeasonTable,
+			Columns: []string{seasonpass.SeasonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SeasonID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SeasonPassCreateBulk is the builder for creating many SeasonPass entities in bulk.
+type SeasonPassCreateBulk struct {
+	config
+	err      error
+	builders []*SeasonPassCreate
+}
+
+// Save creates the SeasonPass entities in the database.
+func (spcb *SeasonPassCreateBulk) Save(ctx context.Context) ([]*SeasonPass, error) {
+	if spcb.err != nil {
+		return nil, spcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(spcb.builders))
+	nodes := make([]*SeasonPass, len(spcb.builders))
+	mutators := make([]Mutator, len(spcb.builders))
+	for i := range spcb.builders {
+		func(i int, root context.Context) {
+			builder := spcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SeasonPassMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, spcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, spcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, spcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (spcb *SeasonPassCreateBulk) SaveX(ctx context.Context) []*SeasonPass {
+	v, err := spcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (spcb *SeasonPassCreateBulk) Exec(ctx context.Context) error {
+	_, err := spcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (spcb *SeasonPassCreateBulk) ExecX(ctx context.Context) {
+	if err := spcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
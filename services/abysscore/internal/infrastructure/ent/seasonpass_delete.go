@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasonpass"
+)
+
+// SeasonPassDelete is the builder for deleting a SeasonPass entity.
+type SeasonPassDelete struct {
+	config
+	hooks    []Hook
+	mutation *SeasonPassMutation
+}
+
+// Where appends a list predicates to the SeasonPassDelete builder.
+func (spd *SeasonPassDelete) Where(ps ...predicate.SeasonPass) *SeasonPassDelete {
+	spd.mutation.Where(ps...)
+	return spd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (spd *SeasonPassDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, spd.sqlExec, spd.mutation, spd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (spd *SeasonPassDelete) ExecX(ctx context.Context) int {
+	n, err := spd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (spd *SeasonPassDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(seasonpass.Table, sqlgraph.NewFieldSpec(seasonpass.FieldID, field.TypeInt))
+	if ps := spd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, spd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	spd.mutation.done = true
+	return affected, err
+}
+
+// SeasonPassDeleteOne is the builder for deleting a single SeasonPass entity.
+type SeasonPassDeleteOne struct {
+	spd *SeasonPassDelete
+}
+
+// Where appends a list predicates to the SeasonPassDelete builder.
+func (spdo *SeasonPassDeleteOne) Where(ps ...predicate.SeasonPass) *SeasonPassDeleteOne {
+	spdo.spd.mutation.Where(ps...)
+	return spdo
+}
+
+// Exec executes the deletion query.
+func (spdo *SeasonPassDeleteOne) Exec(ctx context.Context) error {
+	n, err := spdo.spd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{seasonpass.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (spdo *SeasonPassDeleteOne) ExecX(ctx context.Context) {
+	if err := spdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
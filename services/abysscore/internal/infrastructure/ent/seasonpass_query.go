@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/season"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasonpass"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
+)
+
+// SeasonPassQuery is the builder for querying SeasonPass entities.
+type SeasonPassQuery struct {
+	config
+	ctx        *QueryContext
+	order      []seasonpass.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SeasonPass
+	withUser   *UserQuery
+	withSeason *SeasonQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SeasonPassQuery builder.
+func (spq *SeasonPassQuery) Where(ps ...predicate.SeasonPass) *SeasonPassQuery {
+	spq.predicates = append(spq.predicates, ps...)
+	return spq
+}
+
+// Limit the number of records to be returned by this query.
+func (spq *SeasonPassQuery) Limit(limit int) *SeasonPassQuery {
+	spq.ctx.Limit = &limit
+	return spq
+}
+
+// Offset to start from.
+func (spq *SeasonPassQuery) Offset(offset int) *SeasonPassQuery {
+	spq.ctx.Offset = &offset
+	return spq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (spq *SeasonPassQuery) Unique(unique bool) *SeasonPassQuery {
+	spq.ctx.Unique = &unique
+	return spq
+}
+
+// Order specifies how the records should be ordered.
+func (spq *SeasonPassQuery) Order(o ...seasonpass.OrderOption) *SeasonPassQuery {
+	spq.order = append(spq.order, o...)
+	return spq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (spq *SeasonPassQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: spq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := spq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := spq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(seasonpass.Table, seasonpass.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, seasonpass.UserTable, seasonpass.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(spq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySeason chains the current query on the "season" edge.
+func (spq *SeasonPassQuery) QuerySeason() *SeasonQuery {
+	query := (&SeasonClient{config: spq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := spq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := spq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(seasonpass.Table, seasonpass.FieldID, selector),
+			sqlgraph.To(season.Table, season.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, seasonpass.SeasonTable, seasonpass.SeasonColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(spq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SeasonPass entity from the query.
+// Returns a *NotFoundError when no SeasonPass was found.
+func (spq *SeasonPassQuery) First(ctx context.Context) (*SeasonPass, error) {
+	nodes, err := spq.Limit(1).All(setContextOp(ctx, spq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{seasonpass.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (spq *SeasonPassQuery) FirstX(ctx context.Context) *SeasonPass {
+	node, err := spq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SeasonPass ID from the query.
+// Returns a *NotFoundError when no SeasonPass ID was found.
+func (spq *SeasonPassQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = spq.Limit(1).IDs(setContextOp(ctx, spq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{seasonpass.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (spq *SeasonPassQuery) FirstIDX(ctx context.Context) int {
+	id, err := spq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SeasonPass entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SeasonPass entity is found.
+// Returns a *NotFoundError when no SeasonPass entities are found.
+func (spq *SeasonPassQuery) Only(ctx context.Context) (*SeasonPass, error) {
+	nodes, err := spq.Limit(2).All(setContextOp(ctx, spq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{seasonpass.Label}
+	default:
+		return nil, &NotSingularError{seasonpass.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (spq *SeasonPassQuery) OnlyX(ctx context.Context) *SeasonPass {
+	node, err := spq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SeasonPass ID in the query.
+// Returns a *NotSingularError when more than one SeasonPass ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (spq *SeasonPassQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = spq.Limit(2).IDs(setContextOp(ctx, spq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{seasonpass.Label}
+	default:
+		err = &NotSingularError{seasonpass.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (spq *SeasonPassQuery) OnlyIDX(ctx context.Context) int {
+	id, err := spq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SeasonPasses.
+func (spq *SeasonPassQuery) All(ctx context.Context) ([]*SeasonPass, error) {
+	ctx = setContextOp(ctx, spq.ctx, ent.OpQueryAll)
+	if err := spq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SeasonPass, *SeasonPassQuery]()
+	return withInterceptors[[]*SeasonPass](ctx, spq, qr, spq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (spq *SeasonPassQuery) AllX(ctx context.Context) []*SeasonPass {
+	nodes, err := spq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SeasonPass IDs.
+func (spq *SeasonPassQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if spq.ctx.Unique == nil && spq.path != nil {
+		spq.Unique(true)
+	}
+	ctx = setContextOp(ctx, spq.ctx, ent.OpQueryIDs)
+	if err = spq.Select(seasonpass.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (spq *SeasonPassQuery) IDsX(ctx context.Context) []int {
+	ids, err := spq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (spq *SeasonPassQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, spq.ctx, ent.OpQueryCount)
+	if err := spq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, spq, querierCount[*SeasonPassQuery](), spq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (spq *SeasonPassQuery) CountX(ctx context.Context) int {
+	count, err := spq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (spq *SeasonPassQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, spq.ctx, ent.OpQueryExist)
+	switch _, err := spq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (spq *SeasonPassQuery) ExistX(ctx context.Context) bool {
+	exist, err := spq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SeasonPassQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (spq *SeasonPassQuery) Clone() *SeasonPassQuery {
+	if spq == nil {
+		return nil
+	}
+	return &SeasonPassQuery{
+		config:     spq.config,
+		ctx:        spq.ctx.Clone(),
+		order:      append([]seasonpass.OrderOption{}, spq.order...),
+		inters:     append([]Interceptor{}, spq.inters...),
+		predicates: append([]predicate.SeasonPass{}, spq.predicates...),
+		withUser:   spq.withUser.Clone(),
+		withSeason: spq.withSeason.Clone(),
+		// clone intermediate query.
+		sql:  spq.sql.Clone(),
+		path: spq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (spq *SeasonPassQuery) WithUser(opts ...func(*UserQuery)) *SeasonPassQuery {
+	query := (&UserClient{config: spq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	spq.withUser = query
+	return spq
+}
+
+// WithSeason tells the query-builder to eager-load the nodes that are connected to
+// the "season" edge. The optional arguments are used to configure the query builder of the edge.
+func (spq *SeasonPassQuery) WithSeason(opts ...func(*SeasonQuery)) *SeasonPassQuery {
+	query := (&SeasonClient{config: spq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	spq.withSeason = query
+	return spq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID int `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SeasonPass.Query().
+//		GroupBy(seasonpass.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (spq *SeasonPassQuery) GroupBy(field string, fields ...string) *SeasonPassGroupBy {
+	spq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SeasonPassGroupBy{build: spq}
+	grbuild.flds = &spq.ctx.Fields
+	grbuild.label = seasonpass.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID int `json:"user_id,omitempty"`
+//	}
+//
+//	client.SeasonPass.Query().
+//		Select(seasonpass.FieldUserID).
+//		Scan(ctx, &v)
+func (spq *SeasonPassQuery) Select(fields ...string) *SeasonPassSelect {
+	spq.ctx.Fields = append(spq.ctx.Fields, fields...)
+	sbuild := &SeasonPassSelect{SeasonPassQuery: spq}
+	sbuild.label = seasonpass.Label
+	sbuild.flds, sbuild.scan = &spq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SeasonPassSelect configured with the given aggregations.
+func (spq *SeasonPassQuery) Aggregate(fns ...AggregateFunc) *SeasonPassSelect {
+	return spq.Select().Aggregate(fns...)
+}
+
+func (spq *SeasonPassQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range spq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, spq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range spq.ctx.Fields {
+		if !seasonpass.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if spq.path != nil {
+		prev, err := spq.path(ctx)
+		if err != nil {
+			return err
+		}
+		spq.sql = prev
+	}
+	return nil
+}
+
+func (spq *SeasonPassQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SeasonPass, error) {
+	var (
+		nodes       = []*SeasonPass{}
+		_spec       = spq.querySpec()
+		loadedTypes = [2]bool{
+			spq.withUser != nil,
+			spq.withSeason != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SeasonPass).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SeasonPass{config: spq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, spq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := spq.withUser; query != nil {
+		if err := spq.loadUser(ctx, query, nodes, nil,
+			func(n *SeasonPass, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := spq.withSeason; query != nil {
+		if err := spq.loadSeason(ctx, query, nodes, nil,
+			func(n *SeasonPass, e *Season) { n.Edges.Season = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (spq *SeasonPassQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*SeasonPass, init func(*SeasonPass), assign func(*SeasonPass, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SeasonPass)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (spq *SeasonPassQuery) loadSeason(ctx context.Context, query *SeasonQuery, nodes []*SeasonPass, init func(*SeasonPass), assign func(*SeasonPass, *Season)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SeasonPass)
+	for i := range nodes {
+		fk := nodes[i].SeasonID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(season.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "season_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (spq *SeasonPassQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := spq.querySpec()
+	_spec.Node.Columns = spq.ctx.Fields
+	if len(spq.ctx.Fields) > 0 {
+		_spec.Unique = spq.ctx.Unique != nil && *spq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, spq.driver, _spec)
+}
+
+func (spq *SeasonPassQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(seasonpass.Table, seasonpass.Columns, sqlgraph.NewFieldSpec(seasonpass.FieldID, field.TypeInt))
+	_spec.From = spq.sql
+	if unique := spq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if spq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := spq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, seasonpass.FieldID)
+		for i := range fields {
+			if fields[i] != seasonpass.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if spq.withUser != nil {
+			_spec.Node.AddColumnOnce(seasonpass.FieldUserID)
+		}
+		if spq.withSeason != nil {
+			_spec.Node.AddColumnOnce(seasonpass.FieldSeasonID)
+		}
+	}
+	if ps := spq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := spq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := spq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := spq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (spq *SeasonPassQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(spq.driver.Dialect())
+	t1 := builder.Table(seasonpass.Table)
+	columns := spq.ctx.Fields
+	if len(columns) == 0 {
+		columns = seasonpass.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if spq.sql != nil {
+		selector = spq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if spq.ctx.Unique != nil && *spq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range spq.predicates {
+		p(selector)
+	}
+	for _, p := range spq.order {
+		p(selector)
+	}
+	if offset := spq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := spq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SeasonPassGroupBy is the group-by builder for SeasonPass entities.
+type SeasonPassGroupBy struct {
+	selector
+	build *SeasonPassQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (spgb *SeasonPassGroupBy) Aggregate(fns ...AggregateFunc) *SeasonPassGroupBy {
+	spgb.fns = append(spgb.fns, fns...)
+	return spgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (spgb *SeasonPassGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, spgb.build.ctx, ent.OpQueryGroupBy)
+	if err := spgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SeasonPassQuery, *SeasonPassGroupBy](ctx, spgb.build, spgb, spgb.build.inters, v)
+}
+
+func (spgb *SeasonPassGroupBy) sqlScan(ctx context.Context, root *SeasonPassQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(spgb.fns))
+	for _, fn := range spgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*spgb.flds)+len(spgb.fns))
+		for _, f := range *spgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*spgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := spgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SeasonPassSelect is the builder for selecting fields of SeasonPass entities.
+type SeasonPassSelect struct {
+	*SeasonPassQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sps *SeasonPassSelect) Aggregate(fns ...AggregateFunc) *SeasonPassSelect {
+	sps.fns = append(sps.fns, fns...)
+	return sps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sps *SeasonPassSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sps.ctx, ent.OpQuerySelect)
+	if err := sps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SeasonPassQuery, *SeasonPassSelect](ctx, sps.SeasonPassQuery, sps, sps.inters, v)
+}
+
+func (sps *SeasonPassSelect) sqlScan(ctx context.Context, root *SeasonPassQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sps.fns))
+	for _, fn := range sps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,290 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasonpass"
+)
+
+// SeasonPassUpdate is the builder for updating SeasonPass entities.
+type SeasonPassUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SeasonPassMutation
+}
+
+// Where appends a list predicates to the SeasonPassUpdate builder.
+func (spu *SeasonPassUpdate) Where(ps ...predicate.SeasonPass) *SeasonPassUpdate {
+	spu.mutation.Where(ps...)
+	return spu
+}
+
+// SetPremium sets the "premium" field.
+func (spu *SeasonPassUpdate) SetPremium(b bool) *SeasonPassUpdate {
+	spu.mutation.SetPremium(b)
+	return spu
+}
+
+// SetNillablePremium sets the "premium" field if the given value is not nil.
+func (spu *SeasonPassUpdate) SetNillablePremium(b *bool) *SeasonPassUpdate {
+	if b != nil {
+		spu.SetPremium(*b)
+	}
+	return spu
+}
+
+// SetPremiumPurchasedAt sets the "premium_purchased_at" field.
+func (spu *SeasonPassUpdate) SetPremiumPurchasedAt(t time.Time) *SeasonPassUpdate {
+	spu.mutation.SetPremiumPurchasedAt(t)
+	return spu
+}
+
+// SetNillablePremiumPurchasedAt sets the "premium_purchased_at" field if the given value is not nil.
+func (spu *SeasonPassUpdate) SetNillablePremiumPurchasedAt(t *time.Time) *SeasonPassUpdate {
+	if t != nil {
+		spu.SetPremiumPurchasedAt(*t)
+	}
+	return spu
+}
+
+// ClearPremiumPurchasedAt clears the value of the "premium_purchased_at" field.
+func (spu *SeasonPassUpdate) ClearPremiumPurchasedAt() *SeasonPassUpdate {
+	spu.mutation.ClearPremiumPurchasedAt()
+	return spu
+}
+
+// Mutation returns the SeasonPassMutation object of the builder.
+func (spu *SeasonPassUpdate) Mutation() *SeasonPassMutation {
+	return spu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (spu *SeasonPassUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, spu.sqlSave, spu.mutation, spu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (spu *SeasonPassUpdate) SaveX(ctx context.Context) int {
+	affected, err := spu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (spu *SeasonPassUpdate) Exec(ctx context.Context) error {
+	_, err := spu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (spu *SeasonPassUpdate) ExecX(ctx context.Context) {
+	if err := spu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (spu *SeasonPassUpdate) check() error {
+	if spu.mutation.UserCleared() && len(spu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SeasonPass.user"`)
+	}
+	if spu.mutation.SeasonCleared() && len(spu.mutation.SeasonIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SeasonPass.season"`)
+	}
+	return nil
+}
+
+func (spu *SeasonPassUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := spu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(seasonpass.Table, seasonpass.Columns, sqlgraph.NewFieldSpec(seasonpass.FieldID, field.TypeInt))
+	if ps := spu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := spu.mutation.Premium(); ok {
+		_spec.SetField(seasonpass.FieldPremium, field.TypeBool, value)
+	}
+	if value, ok := spu.mutation.PremiumPurchasedAt(); ok {
+		_spec.SetField(seasonpass.FieldPremiumPurchasedAt, field.TypeTime, value)
+	}
+	if spu.mutation.PremiumPurchasedAtCleared() {
+		_spec.ClearField(seasonpass.FieldPremiumPurchasedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, spu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{seasonpass.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	spu.mutation.done = true
+	return n, nil
+}
+
+// SeasonPassUpdateOne is the builder for updating a single SeasonPass entity.
+type SeasonPassUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SeasonPassMutation
+}
+
+// SetPremium sets the "premium" field.
+func (spuo *SeasonPassUpdateOne) SetPremium(b bool) *SeasonPassUpdateOne {
+	spuo.mutation.SetPremium(b)
+	return spuo
+}
+
+// SetNillablePremium sets the "premium" field if the given value is not nil.
+func (spuo *SeasonPassUpdateOne) SetNillablePremium(b *bool) *SeasonPassUpdateOne {
+	if b != nil {
+		spuo.SetPremium(*b)
+	}
+	return spuo
+}
+
+// SetPremiumPurchasedAt sets the "premium_purchased_at" field.
+func (spuo *SeasonPassUpdateOne) SetPremiumPurchasedAt(t time.Time) *SeasonPassUpdateOne {
+	spuo.mutation.SetPremiumPurchasedAt(t)
+	return spuo
+}
+
+// SetNillablePremiumPurchasedAt sets the "premium_purchased_at" field if the given value is not nil.
+func (spuo *SeasonPassUpdateOne) SetNillablePremiumPurchasedAt(t *time.Time) *SeasonPassUpdateOne {
+	if t != nil {
+		spuo.SetPremiumPurchasedAt(*t)
+	}
+	return spuo
+}
+
+// ClearPremiumPurchasedAt clears the value of the "premium_purchased_at" field.
+func (spuo *SeasonPassUpdateOne) ClearPremiumPurchasedAt() *SeasonPassUpdateOne {
+	spuo.mutation.ClearPremiumPurchasedAt()
+	return spuo
+}
+
+// Mutation returns the SeasonPassMutation object of the builder.
+func (spuo *SeasonPassUpdateOne) Mutation() *SeasonPassMutation {
+	return spuo.mutation
+}
+
+// Where appends a list predicates to the SeasonPassUpdate builder.
+func (spuo *SeasonPassUpdateOne) Where(ps ...predicate.SeasonPass) *SeasonPassUpdateOne {
+	spuo.mutation.Where(ps...)
+	return spuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (spuo *SeasonPassUpdateOne) Select(field string, fields ...string) *SeasonPassUpdateOne {
+	spuo.fields = append([]string{field}, fields...)
+	return spuo
+}
+
+// Save executes the query and returns the updated SeasonPass entity.
+func (spuo *SeasonPassUpdateOne) Save(ctx context.Context) (*SeasonPass, error) {
+	return withHooks(ctx, spuo.sqlSave, spuo.mutation, spuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (spuo *SeasonPassUpdateOne) SaveX(ctx context.Context) *SeasonPass {
+	node, err := spuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (spuo *SeasonPassUpdateOne) Exec(ctx context.Context) error {
+	_, err := spuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (spuo *SeasonPassUpdateOne) ExecX(ctx context.Context) {
+	if err := spuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (spuo *SeasonPassUpdateOne) check() error {
+	if spuo.mutation.UserCleared() && len(spuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SeasonPass.user"`)
+	}
+	if spuo.mutation.SeasonCleared() && len(spuo.mutation.SeasonIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SeasonPass.season"`)
+	}
+	return nil
+}
+
+func (spuo *SeasonPassUpdateOne) sqlSave(ctx context.Context) (_node *SeasonPass, err error) {
+	if err := spuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(seasonpass.Table, seasonpass.Columns, sqlgraph.NewFieldSpec(seasonpass.FieldID, field.TypeInt))
+	id, ok := spuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SeasonPass.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := spuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, seasonpass.FieldID)
+		for _, f := range fields {
+			if !seasonpass.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != seasonpass.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := spuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := spuo.mutation.Premium(); ok {
+		_spec.SetField(seasonpass.FieldPremium, field.TypeBool, value)
+	}
+	if value, ok := spuo.mutation.PremiumPurchasedAt(); ok {
+		_spec.SetField(seasonpass.FieldPremiumPurchasedAt, field.TypeTime, value)
+	}
+	if spuo.mutation.PremiumPurchasedAtCleared() {
+		_spec.ClearField(seasonpass.FieldPremiumPurchasedAt, field.TypeTime)
+	}
+	_node = &SeasonPass{config: spuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, spuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{seasonpass.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	spuo.mutation.done = true
+	return _node, nil
+}
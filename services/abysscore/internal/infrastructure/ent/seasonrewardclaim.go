@@ -0,0 +1,238 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/season"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasonrewardclaim"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
+)
+
+// SeasonRewardClaim is the model entity for the SeasonRewardClaim schema.
+type SeasonRewardClaim struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// SeasonID holds the value of the "season_id" field.
+	SeasonID int `json:"season_id,omitempty"`
+	// Level holds the value of the "level" field.
+	Level int `json:"level,omitempty"`
+	// Track holds the value of the "track" field.
+	Track seasonrewardclaim.Track `json:"track,omitempty"`
+	// InventoryItemID holds the value of the "inventory_item_id" field.
+	InventoryItemID *int `json:"inventory_item_id,omitempty"`
+	// Coins holds the value of the "coins" field.
+	Coins float64 `json:"coins,omitempty"`
+	// Automatic holds the value of the "automatic" field.
+	Automatic bool `json:"automatic,omitempty"`
+	// ClaimedAt holds the value of the "claimed_at" field.
+	ClaimedAt time.Time `json:"claimed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SeasonRewardClaimQuery when eager-loading is set.
+	Edges        SeasonRewardClaimEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SeasonRewardClaimEdges holds the relations/edges for other nodes in the graph.
+type SeasonRewardClaimEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Season holds the value of the season edge.
+	Season *Season `json:"season,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SeasonRewardClaimEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// SeasonOrErr returns the Season value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SeasonRewardClaimEdges) SeasonOrErr() (*Season, error) {
+	if e.Season != nil {
+		return e.Season, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: season.Label}
+	}
+	return nil, &NotLoadedError{edge: "season"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SeasonRewardClaim) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case seasonrewardclaim.FieldAutomatic:
+			values[i] = new(sql.NullBool)
+		case seasonrewardclaim.FieldCoins:
+			values[i] = new(sql.NullFloat64)
+		case seasonrewardclaim.FieldID, seasonrewardclaim.FieldUserID, seasonrewardclaim.FieldSeasonID, seasonrewardclaim.FieldLevel, seasonrewardclaim.FieldInventoryItemID:
+			values[i] = new(sql.NullInt64)
+		case seasonrewardclaim.FieldTrack:
+			values[i] = new(sql.NullString)
+		case seasonrewardclaim.FieldClaimedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SeasonRewardClaim fields.
+func (src *SeasonRewardClaim) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case seasonrewardclaim.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			src.ID = int(value.Int64)
+		case seasonrewardclaim.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				src.UserID = int(value.Int64)
+			}
+		case seasonrewardclaim.FieldSeasonID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field season_id", values[i])
+			} else if value.Valid {
+				src.SeasonID = int(value.Int64)
+			}
+		case seasonrewardclaim.FieldLevel:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field level", values[i])
+			} else if value.Valid {
+				src.Level = int(value.Int64)
+			}
+		case seasonrewardclaim.FieldTrack:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field track", values[i])
+			} else if value.Valid {
+				src.Track = seasonrewardclaim.Track(value.String)
+			}
+		case seasonrewardclaim.FieldInventoryItemID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field inventory_item_id", values[i])
+			} else if value.Valid {
+				src.InventoryItemID = new(int)
+				*src.InventoryItemID = int(value.Int64)
+			}
+		case seasonrewardclaim.FieldCoins:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field coins", values[i])
+			} else if value.Valid {
+				src.Coins = value.Float64
+			}
+		case seasonrewardclaim.FieldAutomatic:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field automatic", values[i])
+			} else if value.Valid {
+				src.Automatic = value.Bool
+			}
+		case seasonrewardclaim.FieldClaimedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field claimed_at", values[i])
+			} else if value.Valid {
+				src.ClaimedAt = value.Time
+			}
+		default:
+			src.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SeasonRewardClaim.
+// This includes values selected through modifiers, order, etc.
+func (src *SeasonRewardClaim) Value(name string) (ent.Value, error) {
+	return src.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the SeasonRewardClaim entity.
+func (src *SeasonRewardClaim) QueryUser() *UserQuery {
+	return NewSeasonRewardClaimClient(src.config).QueryUser(src)
+}
+
+// QuerySeason queries the "season" edge of the SeasonRewardClaim entity.
+func (src *SeasonRewardClaim) QuerySeason() *SeasonQuery {
+	return NewSeasonRewardClaimClient(src.config).QuerySeason(src)
+}
+
+// Update returns a builder for updating this SeasonRewardClaim.
+// Note that you need to call SeasonRewardClaim.Unwrap() before calling this method if this SeasonRewardClaim
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (src *SeasonRewardClaim) Update() *SeasonRewardClaimUpdateOne {
+	return NewSeasonRewardClaimClient(src.config).UpdateOne(src)
+}
+
+// Unwrap unwraps the SeasonRewardClaim entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (src *SeasonRewardClaim) Unwrap() *SeasonRewardClaim {
+	_tx, ok := src.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SeasonRewardClaim is not a transactional entity")
+	}
+	src.config.driver = _tx.drv
+	return src
+}
+
+// String implements the fmt.Stringer.
+func (src *SeasonRewardClaim) String() string {
+	var builder strings.Builder
+	builder.WriteString("SeasonRewardClaim(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", src.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", src.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("season_id=")
+	builder.WriteString(fmt.Sprintf("%v", src.SeasonID))
+	builder.WriteString(", ")
+	builder.WriteString("level=")
+	builder.WriteString(fmt.Sprintf("%v", src.Level))
+	builder.WriteString(", ")
+	builder.WriteString("track=")
+	builder.WriteString(fmt.Sprintf("%v", src.Track))
+	builder.WriteString(", ")
+	if v := src.InventoryItemID; v != nil {
+		builder.WriteString("inventory_item_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("coins=")
+	builder.WriteString(fmt.Sprintf("%v", src.Coins))
+	builder.WriteString(", ")
+	builder.WriteString("automatic=")
+	builder.WriteString(fmt.Sprintf("%v", src.Automatic))
+	builder.WriteString(", ")
+	builder.WriteString("claimed_at=")
+	builder.WriteString(src.ClaimedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SeasonRewardClaims is a parsable slice of SeasonRewardClaim.
+type SeasonRewardClaims []*SeasonRewardClaim
@@ -0,0 +1,189 @@
+// Code generated by ent, DO NOT EDIT.
+
+package seasonrewardclaim
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the seasonrewardclaim type in the database.
+	Label = "season_reward_claim"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldSeasonID holds the string denoting the season_id field in the database.
+	FieldSeasonID = "season_id"
+	// FieldLevel holds the string denoting the level field in the database.
+	FieldLevel = "level"
+	// FieldTrack holds the string denoting the track field in the database.
+	FieldTrack = "track"
+	// FieldInventoryItemID holds the string denoting the inventory_item_id field in the database.
+	FieldInventoryItemID = "inventory_item_id"
+	// FieldCoins holds the string denoting the coins field in the database.
+	FieldCoins = "coins"
+	// FieldAutomatic holds the string denoting the automatic field in the database.
+	FieldAutomatic = "automatic"
+	// FieldClaimedAt holds the string denoting the claimed_at field in the database.
+	FieldClaimedAt = "claimed_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgeSeason holds the string denoting the season edge name in mutations.
+	EdgeSeason = "season"
+	// Table holds the table name of the seasonrewardclaim in the database.
+	Table = "season_reward_claims"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "season_reward_claims"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+	// SeasonTable is the table that holds the season relation/edge.
+	SeasonTable = "season_reward_claims"
+	// SeasonInverseTable is the table name for the Season entity.
+	// It exists in this package in order to avoid circular dependency with the "season" package.
+	SeasonInverseTable = "seasons"
+	// SeasonColumn is the table column denoting the season relation/edge.
+	SeasonColumn = "season_id"
+)
+
+// Columns holds all SQL columns for seasonrewardclaim fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldSeasonID,
+	FieldLevel,
+	FieldTrack,
+	FieldInventoryItemID,
+	FieldCoins,
+	FieldAutomatic,
+	FieldClaimedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// LevelValidator is a validator for the "level" field. It is called by the builders before save.
+	LevelValidator func(int) error
+	// DefaultCoins holds the default value on creation for the "coins" field.
+	DefaultCoins float64
+	// CoinsValidator is a validator for the "coins" field. It is called by the builders before save.
+	CoinsValidator func(float64) error
+	// DefaultAutomatic holds the default value on creation for the "automatic" field.
+	DefaultAutomatic bool
+	// DefaultClaimedAt holds the default value on creation for the "claimed_at" field.
+	DefaultClaimedAt func() time.Time
+)
+
+// Track defines the type for the "track" enum field.
+type Track string
+
+// Track values.
+const (
+	TrackFree    Track = "free"
+	TrackPremium Track = "premium"
+)
+
+func (t Track) String() string {
+	return string(t)
+}
+
+// TrackValidator is a validator for the "track" field enum values. It is called by the builders before save.
+func TrackValidator(t Track) error {
+	switch t {
+	case TrackFree, TrackPremium:
+		return nil
+	default:
+		return fmt.Errorf("seasonrewardclaim: invalid enum value for track field: %q", t)
+	}
+}
+
+// OrderOption defines the ordering options for the SeasonRewardClaim queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// BySeasonID orders the results by the season_id field.
+func BySeasonID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeasonID, opts...).ToFunc()
+}
+
+// ByLevel orders the results by the level field.
+func ByLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLevel, opts...).ToFunc()
+}
+
+// ByTrack orders the results by the track field.
+func ByTrack(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrack, opts...).ToFunc()
+}
+
+// ByInventoryItemID orders the results by the inventory_item_id field.
+func ByInventoryItemID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInventoryItemID, opts...).ToFunc()
+}
+
+// ByCoins orders the results by the coins field.
+func ByCoins(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCoins, opts...).ToFunc()
+}
+
+// ByAutomatic orders the results by the automatic field.
+func ByAutomatic(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutomatic, opts...).ToFunc()
+}
+
+// ByClaimedAt orders the results by the claimed_at field.
+func ByClaimedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClaimedAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// BySeasonField orders the results by season field.
+func BySeasonField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSeasonStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
+func newSeasonStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SeasonInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SeasonTable, SeasonColumn),
+	)
+}
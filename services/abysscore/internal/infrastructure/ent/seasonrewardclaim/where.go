@@ -0,0 +1,392 @@
+// Code generated by ent, DO NOT EDIT.
+
+package seasonrewardclaim
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldEQ(FieldUserID, v))
+}
+
+// SeasonID applies equality check predicate on the "season_id" field. It's identical to SeasonIDEQ.
+func SeasonID(v int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldEQ(FieldSeasonID, v))
+}
+
+// Level applies equality check predicate on the "level" field. It's identical to LevelEQ.
+func Level(v int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldEQ(FieldLevel, v))
+}
+
+// InventoryItemID applies equality check predicate on the "inventory_item_id" field. It's identical to InventoryItemIDEQ.
+func InventoryItemID(v int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldEQ(FieldInventoryItemID, v))
+}
+
+// Coins applies equality check predicate on the "coins" field. It's identical to CoinsEQ.
+func Coins(v float64) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldEQ(FieldCoins, v))
+}
+
+// Automatic applies equality check predicate on the "automatic" field. It's identical to AutomaticEQ.
+func Automatic(v bool) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldEQ(FieldAutomatic, v))
+}
+
+// ClaimedAt applies equality check predicate on the "claimed_at" field. It's identical to ClaimedAtEQ.
+func ClaimedAt(v time.Time) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldEQ(FieldClaimedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// SeasonIDEQ applies the EQ predicate on the "season_id" field.
+func SeasonIDEQ(v int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldEQ(FieldSeasonID, v))
+}
+
+// SeasonIDNEQ applies the NEQ predicate on the "season_id" field.
+func SeasonIDNEQ(v int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldNEQ(FieldSeasonID, v))
+}
+
+// SeasonIDIn applies the In predicate on the "season_id" field.
+func SeasonIDIn(vs ...int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldIn(FieldSeasonID, vs...))
+}
+
+// SeasonIDNotIn applies the NotIn predicate on the "season_id" field.
+func SeasonIDNotIn(vs ...int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldNotIn(FieldSeasonID, vs...))
+}
+
+// LevelEQ applies the EQ predicate on the "level" field.
+func LevelEQ(v int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldEQ(FieldLevel, v))
+}
+
+// LevelNEQ applies the NEQ predicate on the "level" field.
+func LevelNEQ(v int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldNEQ(FieldLevel, v))
+}
+
+// LevelIn applies the In predicate on the "level" field.
+func LevelIn(vs ...int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldIn(FieldLevel, vs...))
+}
+
+// LevelNotIn applies the NotIn predicate on the "level" field.
+func LevelNotIn(vs ...int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldNotIn(FieldLevel, vs...))
+}
+
+// LevelGT applies the GT predicate on the "level" field.
+func LevelGT(v int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldGT(FieldLevel, v))
+}
+
+// LevelGTE applies the GTE predicate on the "level" field.
+func LevelGTE(v int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldGTE(FieldLevel, v))
+}
+
+// LevelLT applies the LT predicate on the "level" field.
+func LevelLT(v int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldLT(FieldLevel, v))
+}
+
+// LevelLTE applies the LTE predicate on the "level" field.
+func LevelLTE(v int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldLTE(FieldLevel, v))
+}
+
+// TrackEQ applies the EQ predicate on the "track" field.
+func TrackEQ(v Track) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldEQ(FieldTrack, v))
+}
+
+// TrackNEQ applies the NEQ predicate on the "track" field.
+func TrackNEQ(v Track) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldNEQ(FieldTrack, v))
+}
+
+// TrackIn applies the In predicate on the "track" field.
+func TrackIn(vs ...Track) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldIn(FieldTrack, vs...))
+}
+
+// TrackNotIn applies the NotIn predicate on the "track" field.
+func TrackNotIn(vs ...Track) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldNotIn(FieldTrack, vs...))
+}
+
+// InventoryItemIDEQ applies the EQ predicate on the "inventory_item_id" field.
+func InventoryItemIDEQ(v int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldEQ(FieldInventoryItemID, v))
+}
+
+// InventoryItemIDNEQ applies the NEQ predicate on the "inventory_item_id" field.
+func InventoryItemIDNEQ(v int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldNEQ(FieldInventoryItemID, v))
+}
+
+// InventoryItemIDIn applies the In predicate on the "inventory_item_id" field.
+func InventoryItemIDIn(vs ...int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldIn(FieldInventoryItemID, vs...))
+}
+
+// InventoryItemIDNotIn applies the NotIn predicate on the "inventory_item_id" field.
+func InventoryItemIDNotIn(vs ...int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldNotIn(FieldInventoryItemID, vs...))
+}
+
+// InventoryItemIDGT applies the GT predicate on the "inventory_item_id" field.
+func InventoryItemIDGT(v int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldGT(FieldInventoryItemID, v))
+}
+
+// InventoryItemIDGTE applies the GTE predicate on the "inventory_item_id" field.
+func InventoryItemIDGTE(v int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldGTE(FieldInventoryItemID, v))
+}
+
+// InventoryItemIDLT applies the LT predicate on the "inventory_item_id" field.
+func InventoryItemIDLT(v int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldLT(FieldInventoryItemID, v))
+}
+
+// InventoryItemIDLTE applies the LTE predicate on the "inventory_item_id" field.
+func InventoryItemIDLTE(v int) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldLTE(FieldInventoryItemID, v))
+}
+
+// InventoryItemIDIsNil applies the IsNil predicate on the "inventory_item_id" field.
+func InventoryItemIDIsNil() predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldIsNull(FieldInventoryItemID))
+}
+
+// InventoryItemIDNotNil applies the NotNil predicate on the "inventory_item_id" field.
+func InventoryItemIDNotNil() predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldNotNull(FieldInventoryItemID))
+}
+
+// CoinsEQ applies the EQ predicate on the "coins" field.
+func CoinsEQ(v float64) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldEQ(FieldCoins, v))
+}
+
+// CoinsNEQ applies the NEQ predicate on the "coins" field.
+func CoinsNEQ(v float64) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldNEQ(FieldCoins, v))
+}
+
+// CoinsIn applies the In predicate on the "coins" field.
+func CoinsIn(vs ...float64) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldIn(FieldCoins, vs...))
+}
+
+// CoinsNotIn applies the NotIn predicate on the "coins" field.
+func CoinsNotIn(vs ...float64) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldNotIn(FieldCoins, vs...))
+}
+
+// CoinsGT applies the GT predicate on the "coins" field.
+func CoinsGT(v float64) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldGT(FieldCoins, v))
+}
+
+// CoinsGTE applies the GTE predicate on the "coins" field.
+func CoinsGTE(v float64) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldGTE(FieldCoins, v))
+}
+
+// CoinsLT applies the LT predicate on the "coins" field.
+func CoinsLT(v float64) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldLT(FieldCoins, v))
+}
+
+// CoinsLTE applies the LTE predicate on the "coins" field.
+func CoinsLTE(v float64) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldLTE(FieldCoins, v))
+}
+
+// AutomaticEQ applies the EQ predicate on the "automatic" field.
+func AutomaticEQ(v bool) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldEQ(FieldAutomatic, v))
+}
+
+// AutomaticNEQ applies the NEQ predicate on the "automatic" field.
+func AutomaticNEQ(v bool) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldNEQ(FieldAutomatic, v))
+}
+
+// ClaimedAtEQ applies the EQ predicate on the "claimed_at" field.
+func ClaimedAtEQ(v time.Time) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldEQ(FieldClaimedAt, v))
+}
+
+// ClaimedAtNEQ applies the NEQ predicate on the "claimed_at" field.
+func ClaimedAtNEQ(v time.Time) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldNEQ(FieldClaimedAt, v))
+}
+
+// ClaimedAtIn applies the In predicate on the "claimed_at" field.
+func ClaimedAtIn(vs ...time.Time) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldIn(FieldClaimedAt, vs...))
+}
+
+// ClaimedAtNotIn applies the NotIn predicate on the "claimed_at" field.
+func ClaimedAtNotIn(vs ...time.Time) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldNotIn(FieldClaimedAt, vs...))
+}
+
+// ClaimedAtGT applies the GT predicate on the "claimed_at" field.
+func ClaimedAtGT(v time.Time) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldGT(FieldClaimedAt, v))
+}
+
+// ClaimedAtGTE applies the GTE predicate on the "claimed_at" field.
+func ClaimedAtGTE(v time.Time) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldGTE(FieldClaimedAt, v))
+}
+
+// ClaimedAtLT applies the LT predicate on the "claimed_at" field.
+func ClaimedAtLT(v time.Time) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldLT(FieldClaimedAt, v))
+}
+
+// ClaimedAtLTE applies the LTE predicate on the "claimed_at" field.
+func ClaimedAtLTE(v time.Time) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.FieldLTE(FieldClaimedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSeason applies the HasEdge predicate on the "season" edge.
+func HasSeason() predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SeasonTable, SeasonColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSeasonWith applies the HasEdge predicate on the "season" edge with a given conditions (other predicates).
+func HasSeasonWith(preds ...predicate.Season) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(func(s *sql.Selector) {
+		step := newSeasonStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SeasonRewardClaim) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SeasonRewardClaim) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SeasonRewardClaim) predicate.SeasonRewardClaim {
+	return predicate.SeasonRewardClaim(sql.NotPredicates(p))
+}
@@ -0,0 +1,393 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/season"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasonrewardclaim"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
+)
+
+// SeasonRewardClaimCreate is the builder for creating a SeasonRewardClaim entity.
+type SeasonRewardClaimCreate struct {
+	config
+	mutation *SeasonRewardClaimMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (srcc *SeasonRewardClaimCreate) SetUserID(i int) *SeasonRewardClaimCreate {
+	srcc.mutation.SetUserID(i)
+	return srcc
+}
+
+// SetSeasonID sets the "season_id" field.
+func (srcc *SeasonRewardClaimCreate) SetSeasonID(i int) *SeasonRewardClaimCreate {
+	srcc.mutation.SetSeasonID(i)
+	return srcc
+}
+
+// SetLevel sets the "level" field.
+func (srcc *SeasonRewardClaimCreate) SetLevel(i int) *SeasonRewardClaimCreate {
+	srcc.mutation.SetLevel(i)
+	return srcc
+}
+
+// SetTrack sets the "track" field.
+func (srcc *SeasonRewardClaimCreate) SetTrack(s seasonrewardclaim.Track) *SeasonRewardClaimCreate {
+	srcc.mutation.SetTrack(s)
+	return srcc
+}
+
+// SetInventoryItemID sets the "inventory_item_id" field.
+func (srcc *SeasonRewardClaimCreate) SetInventoryItemID(i int) *SeasonRewardClaimCreate {
+	srcc.mutation.SetInventoryItemID(i)
+	return srcc
+}
+
+// SetNillableInventoryItemID sets the "inventory_item_id" field if the given value is not nil.
+func (srcc *SeasonRewardClaimCreate) SetNillableInventoryItemID(i *int) *SeasonRewardClaimCreate {
+	if i != nil {
+		srcc.SetInventoryItemID(*i)
+	}
+	return srcc
+}
+
+// SetCoins sets the "coins" field.
+func (srcc *SeasonRewardClaimCreate) SetCoins(f float64) *SeasonRewardClaimCreate {
+	srcc.mutation.SetCoins(f)
+	return srcc
+}
+
+// SetNillableCoins sets the "coins" field if the given value is not nil.
+func (srcc *SeasonRewardClaimCreate) SetNillableCoins(f *float64) *SeasonRewardClaimCreate {
+	if f != nil {
+		srcc.SetCoins(*f)
+	}
+	return srcc
+}
+
+// SetAutomatic sets the "automatic" field.
+func (srcc *SeasonRewardClaimCreate) SetAutomatic(b bool) *SeasonRewardClaimCreate {
+	srcc.mutation.SetAutomatic(b)
+	return srcc
+}
+
+// SetNillableAutomatic sets the "automatic" field if the given value is not nil.
+func (srcc *SeasonRewardClaimCreate) SetNillableAutomatic(b *bool) *SeasonRewardClaimCreate {
+	if b != nil {
+		srcc.SetAutomatic(*b)
+	}
+	return srcc
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (srcc *SeasonRewardClaimCreate) SetClaimedAt(t time.Time) *SeasonRewardClaimCreate {
+	srcc.mutation.SetClaimedAt(t)
+	return srcc
+}
+
+// SetNillableClaimedAt sets the "claimed_at" field if the given value is not nil.
+func (srcc *SeasonRewardClaimCreate) SetNillableClaimedAt(t *time.Time) *SeasonRewardClaimCreate {
+	if t != nil {
+		srcc.SetClaimedAt(*t)
+	}
+	return srcc
+}
+
+// SetID sets the "id" field.
+func (srcc *SeasonRewardClaimCreate) SetID(i int) *SeasonRewardClaimCreate {
+	srcc.mutation.SetID(i)
+	return srcc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (srcc *SeasonRewardClaimCreate) SetUser(u *User) *SeasonRewardClaimCreate {
+	return srcc.SetUserID(u.ID)
+}
+
+// SetSeason sets the "season" edge to the Season entity.
+func (srcc *SeasonRewardClaimCreate) SetSeason(s *Season) *SeasonRewardClaimCreate {
+	return srcc.SetSeasonID(s.ID)
+}
+
+// Mutation returns the SeasonRewardClaimMutation object of the builder.
+func (srcc *SeasonRewardClaimCreate) Mutation() *SeasonRewardClaimMutation {
+	return srcc.mutation
+}
+
+// Save creates the SeasonRewardClaim in the database.
+func (srcc *SeasonRewardClaimCreate) Save(ctx context.Context) (*SeasonRewardClaim, error) {
+	srcc.defaults()
+	return withHooks(ctx, srcc.sqlSave, srcc.mutation, srcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (srcc *SeasonRewardClaimCreate) SaveX(ctx context.Context) *SeasonRewardClaim {
+	v, err := srcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (srcc *SeasonRewardClaimCreate) Exec(ctx context.Context) error {
+	_, err := srcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srcc *SeasonRewardClaimCreate) ExecX(ctx context.Context) {
+	if err := srcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (srcc *SeasonRewardClaimCreate) defaults() {
+	if _, ok := srcc.mutation.Coins(); !ok {
+		v := seasonrewardclaim.DefaultCoins
+		srcc.mutation.SetCoins(v)
+	}
+	if _, ok := srcc.mutation.Automatic(); !ok {
+		v := seasonrewardclaim.DefaultAutomatic
+		srcc.mutation.SetAutomatic(v)
+	}
+	if _, ok := srcc.mutation.ClaimedAt(); !ok {
+		v := seasonrewardclaim.DefaultClaimedAt()
+		srcc.mutation.SetClaimedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (srcc *SeasonRewardClaimCreate) check() error {
+	if _, ok := srcc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "SeasonRewardClaim.user_id"`)}
+	}
+	if _, ok := srcc.mutation.SeasonID(); !ok {
+		return &ValidationError{Name: "season_id", err: errors.New(`ent: missing required field "SeasonRewardClaim.season_id"`)}
+	}
+	if _, ok := srcc.mutation.Level(); !ok {
+		return &ValidationError{Name: "level", err: errors.New(`ent: missing required field "SeasonRewardClaim.level"`)}
+	}
+	if v, ok := srcc.mutation.Level(); ok {
+		if err := seasonrewardclaim.LevelValidator(v); err != nil {
+			return &ValidationError{Name: "level", err: fmt.Errorf(`ent: validator failed for field "SeasonRewardClaim.level": %w`, err)}
+		}
+	}
+	if _, ok := srcc.mutation.Track(); !ok {
+		return &ValidationError{Name: "track", err: errors.New(`ent: missing required field "SeasonRewardClaim.track"`)}
+	}
+	if v, ok := srcc.mutation.Track(); ok {
+		if err := seasonrewardclaim.TrackValidator(v); err != nil {
+			return &ValidationError{Name: "track", err: fmt.Errorf(`ent: validator failed for field "SeasonRewardClaim.track": %w`, err)}
+		}
+	}
+	if _, ok := srcc.mutation.Coins(); !ok {
+		return &ValidationError{Name: "coins", err: errors.New(`ent: missing required field "SeasonRewardClaim.coins"`)}
+	}
+	if v, ok := srcc.mutation.Coins(); ok {
+		if err := seasonrewardclaim.CoinsValidator(v); err != nil {
+			return &ValidationError{Name: "coins", err: fmt.Errorf(`ent: validator failed for field "SeasonRewardClaim.coins": %w`, err)}
+		}
+	}
+	if _, ok := srcc.mutation.Automatic(); !ok {
+		return &ValidationError{Name: "automatic", err: errors.New(`ent: missing required field "SeasonRewardClaim.automatic"`)}
+	}
+	if _, ok := srcc.mutation.ClaimedAt(); !ok {
+		return &ValidationError{Name: "claimed_at", err: errors.New(`ent: missing required field "SeasonRewardClaim.claimed_at"`)}
+	}
+	if len(srcc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "SeasonRewardClaim.user"`)}
+	}
+	if len(srcc.mutation.SeasonIDs()) == 0 {
+		return &ValidationError{Name: "season", err: errors.New(`ent: missing required edge "SeasonRewardClaim.season"`)}
+	}
+	return nil
+}
+
+func (srcc *SeasonRewardClaimCreate) sqlSave(ctx context.Context) (*SeasonRewardClaim, error) {
+	if err := srcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := srcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, srcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	srcc.mutation.id = &_node.ID
+	srcc.mutation.done = true
+	return _node, nil
+}
+
+func (srcc *SeasonRewardClaimCreate) createSpec() (*SeasonRewardClaim, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SeasonRewardClaim{config: srcc.config}
+		_spec = sqlgraph.NewCreateSpec(seasonrewardclaim.Table, sqlgraph.NewFieldSpec(seasonrewardclaim.FieldID, field.TypeInt))
+	)
+	if id, ok := srcc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := srcc.mutation.Level(); ok {
+		_spec.SetField(seasonrewardclaim.FieldLevel, field.TypeInt, value)
+		_node.Level = value
+	}
+	if value, ok := srcc.mutation.Track(); ok {
+		_spec.SetField(seasonrewardclaim.FieldTrack, field.TypeEnum, value)
+		_node.Track = value
+	}
+	if value, ok := srcc.mutation.InventoryItemID(); ok {
+		_spec.SetField(seasonrewardclaim.FieldInventoryItemID, field.TypeInt, value)
+		_node.InventoryItemID = &value
+	}
+	if value, ok := srcc.mutation.Coins(); ok {
+		_spec.SetField(seasonrewardclaim.FieldCoins, field.TypeFloat64, value)
+		_node.Coins = value
+	}
+	if value, ok := srcc.mutation.Automatic(); ok {
+		_spec.SetField(seasonrewardclaim.FieldAutomatic, field.TypeBool, value)
+		_node.Automatic = value
+	}
+	if value, ok := srcc.mutation.ClaimedAt(); ok {
+		_spec.SetField(seasonrewardclaim.FieldClaimedAt, field.TypeTime, value)
+		_node.ClaimedAt = value
+	}
+	if nodes := srcc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   seasonrewardclaim.UserTable,
+			Columns: []string{seasonrewardclaim.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := srcc.mutation.SeasonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   seasonrewardclaim.SeasonTable,
+			Columns: []string{seasonrewardclaim.SeasonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SeasonID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SeasonRewardClaimCreateBulk is the builder for creating many SeasonRewardClaim entities in bulk.
+type SeasonRewardClaimCreateBulk struct {
+	config
+	err      error
+	builders []*SeasonRewardClaimCreate
+}
+
+// Save creates the SeasonRewardClaim entities in the database.
+func (srccb *SeasonRewardClaimCreateBulk) Save(ctx context.Context) ([]*SeasonRewardClaim, error) {
+	if srccb.err != nil {
+		return nil, srccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(srccb.builders))
+	nodes := make([]*SeasonRewardClaim, len(srccb.builders))
+	mutators := make([]Mutator, len(srccb.builders))
+	for i := range srccb.builders {
+		func(i int, root context.Context) {
+			builder := srccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SeasonRewardClaimMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, srccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, srccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, srccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (srccb *SeasonRewardClaimCreateBulk) SaveX(ctx context.Context) []*SeasonRewardClaim {
+	v, err := srccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (srccb *SeasonRewardClaimCreateBulk) Exec(ctx context.Context) error {
+	_, err := srccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srccb *SeasonRewardClaimCreateBulk) ExecX(ctx context.Context) {
+	if err := srccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
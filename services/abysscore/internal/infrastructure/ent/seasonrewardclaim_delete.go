@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasonrewardclaim"
+)
+
+// SeasonRewardClaimDelete is the builder for deleting a SeasonRewardClaim entity.
+type SeasonRewardClaimDelete struct {
+	config
+	hooks    []Hook
+	mutation *SeasonRewardClaimMutation
+}
+
+// Where appends a list predicates to the SeasonRewardClaimDelete builder.
+func (srcd *SeasonRewardClaimDelete) Where(ps ...predicate.SeasonRewardClaim) *SeasonRewardClaimDelete {
+	srcd.mutation.Where(ps...)
+	return srcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (srcd *SeasonRewardClaimDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, srcd.sqlExec, srcd.mutation, srcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srcd *SeasonRewardClaimDelete) ExecX(ctx context.Context) int {
+	n, err := srcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (srcd *SeasonRewardClaimDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(seasonrewardclaim.Table, sqlgraph.NewFieldSpec(seasonrewardclaim.FieldID, field.TypeInt))
+	if ps := srcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, srcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	srcd.mutation.done = true
+	return affected, err
+}
+
+// SeasonRewardClaimDeleteOne is the builder for deleting a single SeasonRewardClaim entity.
+type SeasonRewardClaimDeleteOne struct {
+	srcd *SeasonRewardClaimDelete
+}
+
+// Where appends a list predicates to the SeasonRewardClaimDelete builder.
+func (srcdo *SeasonRewardClaimDeleteOne) Where(ps ...predicate.SeasonRewardClaim) *SeasonRewardClaimDeleteOne {
+	srcdo.srcd.mutation.Where(ps...)
+	return srcdo
+}
+
+// Exec executes the deletion query.
+func (srcdo *SeasonRewardClaimDeleteOne) Exec(ctx context.Context) error {
+	n, err := srcdo.srcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{seasonrewardclaim.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srcdo *SeasonRewardClaimDeleteOne) ExecX(ctx context.Context) {
+	if err := srcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/season"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasonrewardclaim"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
+)
+
+// SeasonRewardClaimQuery is the builder for querying SeasonRewardClaim entities.
+type SeasonRewardClaimQuery struct {
+	config
+	ctx        *QueryContext
+	order      []seasonrewardclaim.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SeasonRewardClaim
+	withUser   *UserQuery
+	withSeason *SeasonQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SeasonRewardClaimQuery builder.
+func (srcq *SeasonRewardClaimQuery) Where(ps ...predicate.SeasonRewardClaim) *SeasonRewardClaimQuery {
+	srcq.predicates = append(srcq.predicates, ps...)
+	return srcq
+}
+
+// Limit the number of records to be returned by this query.
+func (srcq *SeasonRewardClaimQuery) Limit(limit int) *SeasonRewardClaimQuery {
+	srcq.ctx.Limit = &limit
+	return srcq
+}
+
+// Offset to start from.
+func (srcq *SeasonRewardClaimQuery) Offset(offset int) *SeasonRewardClaimQuery {
+	srcq.ctx.Offset = &offset
+	return srcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (srcq *SeasonRewardClaimQuery) Unique(unique bool) *SeasonRewardClaimQuery {
+	srcq.ctx.Unique = &unique
+	return srcq
+}
+
+// Order specifies how the records should be ordered.
+func (srcq *SeasonRewardClaimQuery) Order(o ...seasonrewardclaim.OrderOption) *SeasonRewardClaimQuery {
+	srcq.order = append(srcq.order, o...)
+	return srcq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (srcq *SeasonRewardClaimQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: srcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := srcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := srcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(seasonrewardclaim.Table, seasonrewardclaim.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, seasonrewardclaim.UserTable, seasonrewardclaim.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(srcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySeason chains the current query on the "season" edge.
+func (srcq *SeasonRewardClaimQuery) QuerySeason() *SeasonQuery {
+	query := (&SeasonClient{config: srcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := srcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := srcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(seasonrewardclaim.Table, seasonrewardclaim.FieldID, selector),
+			sqlgraph.To(season.Table, season.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, seasonrewardclaim.SeasonTable, seasonrewardclaim.SeasonColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(srcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SeasonRewardClaim entity from the query.
+// Returns a *NotFoundError when no SeasonRewardClaim was found.
+func (srcq *SeasonRewardClaimQuery) First(ctx context.Context) (*SeasonRewardClaim, error) {
+	nodes, err := srcq.Limit(1).All(setContextOp(ctx, srcq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{seasonrewardclaim.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (srcq *SeasonRewardClaimQuery) FirstX(ctx context.Context) *SeasonRewardClaim {
+	node, err := srcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SeasonRewardClaim ID from the query.
+// Returns a *NotFoundError when no SeasonRewardClaim ID was found.
+func (srcq *SeasonRewardClaimQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = srcq.Limit(1).IDs(setContextOp(ctx, srcq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{seasonrewardclaim.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (srcq *SeasonRewardClaimQuery) FirstIDX(ctx context.Context) int {
+	id, err := srcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SeasonRewardClaim entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SeasonRewardClaim entity is found.
+// Returns a *NotFoundError when no SeasonRewardClaim entities are found.
+func (srcq *SeasonRewardClaimQuery) Only(ctx context.Context) (*SeasonRewardClaim, error) {
+	nodes, err := srcq.Limit(2).All(setContextOp(ctx, srcq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{seasonrewardclaim.Label}
+	default:
+		return nil, &NotSingularError{seasonrewardclaim.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (srcq *SeasonRewardClaimQuery) OnlyX(ctx context.Context) *SeasonRewardClaim {
+	node, err := srcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SeasonRewardClaim ID in the query.
+// Returns a *NotSingularError when more than one SeasonRewardClaim ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (srcq *SeasonRewardClaimQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = srcq.Limit(2).IDs(setContextOp(ctx, srcq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{seasonrewardclaim.Label}
+	default:
+		err = &NotSingularError{seasonrewardclaim.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (srcq *SeasonRewardClaimQuery) OnlyIDX(ctx context.Context) int {
+	id, err := srcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SeasonRewardClaims.
+func (srcq *SeasonRewardClaimQuery) All(ctx context.Context) ([]*SeasonRewardClaim, error) {
+	ctx = setContextOp(ctx, srcq.ctx, ent.OpQueryAll)
+	if err := srcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SeasonRewardClaim, *SeasonRewardClaimQuery]()
+	return withInterceptors[[]*SeasonRewardClaim](ctx, srcq, qr, srcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (srcq *SeasonRewardClaimQuery) AllX(ctx context.Context) []*SeasonRewardClaim {
+	nodes, err := srcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SeasonRewardClaim IDs.
+func (srcq *SeasonRewardClaimQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if srcq.ctx.Unique == nil && srcq.path != nil {
+		srcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, srcq.ctx, ent.OpQueryIDs)
+	if err = srcq.Select(seasonrewardclaim.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (srcq *SeasonRewardClaimQuery) IDsX(ctx context.Context) []int {
+	ids, err := srcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (srcq *SeasonRewardClaimQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, srcq.ctx, ent.OpQueryCount)
+	if err := srcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, srcq, querierCount[*SeasonRewardClaimQuery](), srcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (srcq *SeasonRewardClaimQuery) CountX(ctx context.Context) int {
+	count, err := srcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (srcq *SeasonRewardClaimQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, srcq.ctx, ent.OpQueryExist)
+	switch _, err := srcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (srcq *SeasonRewardClaimQuery) ExistX(ctx context.Context) bool {
+	exist, err := srcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SeasonRewardClaimQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (srcq *SeasonRewardClaimQuery) Clone() *SeasonRewardClaimQuery {
+	if srcq == nil {
+		return nil
+	}
+	return &SeasonRewardClaimQuery{
+		config:     srcq.config,
+		ctx:        srcq.ctx.Clone(),
+		order:      append([]seasonrewardclaim.OrderOption{}, srcq.order...),
+		inters:     append([]Interceptor{}, srcq.inters...),
+		predicates: append([]predicate.SeasonRewardClaim{}, srcq.predicates...),
+		withUser:   srcq.withUser.Clone(),
+		withSeason: srcq.withSeason.Clone(),
+		// clone intermediate query.
+		sql:  srcq.sql.Clone(),
+		path: srcq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (srcq *SeasonRewardClaimQuery) WithUser(opts ...func(*UserQuery)) *SeasonRewardClaimQuery {
+	query := (&UserClient{config: srcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	srcq.withUser = query
+	return srcq
+}
+
+// WithSeason tells the query-builder to eager-load the nodes that are connected to
+// the "season" edge. The optional arguments are used to configure the query builder of the edge.
+func (srcq *SeasonRewardClaimQuery) WithSeason(opts ...func(*SeasonQuery)) *SeasonRewardClaimQuery {
+	query := (&SeasonClient{config: srcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	srcq.withSeason = query
+	return srcq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID int `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SeasonRewardClaim.Query().
+//		GroupBy(seasonrewardclaim.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (srcq *SeasonRewardClaimQuery) GroupBy(field string, fields ...string) *SeasonRewardClaimGroupBy {
+	srcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SeasonRewardClaimGroupBy{build: srcq}
+	grbuild.flds = &srcq.ctx.Fields
+	grbuild.label = seasonrewardclaim.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID int `json:"user_id,omitempty"`
+//	}
+//
+//	client.SeasonRewardClaim.Query().
+//		Select(seasonrewardclaim.FieldUserID).
+//		Scan(ctx, &v)
+func (srcq *SeasonRewardClaimQuery) Select(fields ...string) *SeasonRewardClaimSelect {
+	srcq.ctx.Fields = append(srcq.ctx.Fields, fields...)
+	sbuild := &SeasonRewardClaimSelect{SeasonRewardClaimQuery: srcq}
+	sbuild.label = seasonrewardclaim.Label
+	sbuild.flds, sbuild.scan = &srcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SeasonRewardClaimSelect configured with the given aggregations.
+func (srcq *SeasonRewardClaimQuery) Aggregate(fns ...AggregateFunc) *SeasonRewardClaimSelect {
+	return srcq.Select().Aggregate(fns...)
+}
+
+func (srcq *SeasonRewardClaimQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range srcq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, srcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range srcq.ctx.Fields {
+		if !seasonrewardclaim.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if srcq.path != nil {
+		prev, err := srcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		srcq.sql = prev
+	}
+	return nil
+}
+
+func (srcq *SeasonRewardClaimQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SeasonRewardClaim, error) {
+	var (
+		nodes       = []*SeasonRewardClaim{}
+		_spec       = srcq.querySpec()
+		loadedTypes = [2]bool{
+			srcq.withUser != nil,
+			srcq.withSeason != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SeasonRewardClaim).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SeasonRewardClaim{config: srcq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, srcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := srcq.withUser; query != nil {
+		if err := srcq.loadUser(ctx, query, nodes, nil,
+			func(n *SeasonRewardClaim, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := srcq.withSeason; query != nil {
+		if err := srcq.loadSeason(ctx, query, nodes, nil,
+			func(n *SeasonRewardClaim, e *Season) { n.Edges.Season = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (srcq *SeasonRewardClaimQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*SeasonRewardClaim, init func(*SeasonRewardClaim), assign func(*SeasonRewardClaim, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SeasonRewardClaim)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (srcq *SeasonRewardClaimQuery) loadSeason(ctx context.Context, query *SeasonQuery, nodes []*SeasonRewardClaim, init func(*SeasonRewardClaim), assign func(*SeasonRewardClaim, *Season)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SeasonRewardClaim)
+	for i := range nodes {
+		fk := nodes[i].SeasonID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(season.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "season_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (srcq *SeasonRewardClaimQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := srcq.querySpec()
+	_spec.Node.Columns = srcq.ctx.Fields
+	if len(srcq.ctx.Fields) > 0 {
+		_spec.Unique = srcq.ctx.Unique != nil && *srcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, srcq.driver, _spec)
+}
+
+func (srcq *SeasonRewardClaimQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(seasonrewardclaim.Table, seasonrewardclaim.Columns, sqlgraph.NewFieldSpec(seasonrewardclaim.FieldID, field.TypeInt))
+	_spec.From = srcq.sql
+	if unique := srcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if srcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := srcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, seasonrewardclaim.FieldID)
+		for i := range fields {
+			if fields[i] != seasonrewardclaim.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if srcq.withUser != nil {
+			_spec.Node.AddColumnOnce(seasonrewardclaim.FieldUserID)
+		}
+		if srcq.withSeason != nil {
+			_spec.Node.AddColumnOnce(seasonrewardclaim.FieldSeasonID)
+		}
+	}
+	if ps := srcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := srcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := srcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := srcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (srcq *SeasonRewardClaimQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(srcq.driver.Dialect())
+	t1 := builder.Table(seasonrewardclaim.Table)
+	columns := srcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = seasonrewardclaim.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if srcq.sql != nil {
+		selector = srcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if srcq.ctx.Unique != nil && *srcq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range srcq.predicates {
+		p(selector)
+	}
+	for _, p := range srcq.order {
+		p(selector)
+	}
+	if offset := srcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := srcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SeasonRewardClaimGroupBy is the group-by builder for SeasonRewardClaim entities.
+type SeasonRewardClaimGroupBy struct {
+	selector
+	build *SeasonRewardClaimQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (srcgb *SeasonRewardClaimGroupBy) Aggregate(fns ...AggregateFunc) *SeasonRewardClaimGroupBy {
+	srcgb.fns = append(srcgb.fns, fns...)
+	return srcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (srcgb *SeasonRewardClaimGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, srcgb.build.ctx, ent.OpQueryGroupBy)
+	if err := srcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SeasonRewardClaimQuery, *SeasonRewardClaimGroupBy](ctx, srcgb.build, srcgb, srcgb.build.inters, v)
+}
+
+func (srcgb *SeasonRewardClaimGroupBy) sqlScan(ctx context.Context, root *SeasonRewardClaimQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(srcgb.fns))
+	for _, fn := range srcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*srcgb.flds)+len(srcgb.fns))
+		for _, f := range *srcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*srcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := srcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SeasonRewardClaimSelect is the builder for selecting fields of SeasonRewardClaim entities.
+type SeasonRewardClaimSelect struct {
+	*SeasonRewardClaimQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (srcs *SeasonRewardClaimSelect) Aggregate(fns ...AggregateFunc) *SeasonRewardClaimSelect {
+	srcs.fns = append(srcs.fns, fns...)
+	return srcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (srcs *SeasonRewardClaimSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, srcs.ctx, ent.OpQuerySelect)
+	if err := srcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SeasonRewardClaimQuery, *SeasonRewardClaimSelect](ctx, srcs.SeasonRewardClaimQuery, srcs, srcs.inters, v)
+}
+
+func (srcs *SeasonRewardClaimSelect) sqlScan(ctx context.Context, root *SeasonRewardClaimQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(srcs.fns))
+	for _, fn := range srcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*srcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := srcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
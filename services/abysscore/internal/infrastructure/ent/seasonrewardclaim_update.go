@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasonrewardclaim"
+)
+
+// SeasonRewardClaimUpdate is the builder for updating SeasonRewardClaim entities.
+type SeasonRewardClaimUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SeasonRewardClaimMutation
+}
+
+// Where appends a list predicates to the SeasonRewardClaimUpdate builder.
+func (srcu *SeasonRewardClaimUpdate) Where(ps ...predicate.SeasonRewardClaim) *SeasonRewardClaimUpdate {
+	srcu.mutation.Where(ps...)
+	return srcu
+}
+
+// Mutation returns the SeasonRewardClaimMutation object of the builder.
+func (srcu *SeasonRewardClaimUpdate) Mutation() *SeasonRewardClaimMutation {
+	return srcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (srcu *SeasonRewardClaimUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, srcu.sqlSave, srcu.mutation, srcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (srcu *SeasonRewardClaimUpdate) SaveX(ctx context.Context) int {
+	affected, err := srcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (srcu *SeasonRewardClaimUpdate) Exec(ctx context.Context) error {
+	_, err := srcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srcu *SeasonRewardClaimUpdate) ExecX(ctx context.Context) {
+	if err := srcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (srcu *SeasonRewardClaimUpdate) check() error {
+	if srcu.mutation.UserCleared() && len(srcu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SeasonRewardClaim.user"`)
+	}
+	if srcu.mutation.SeasonCleared() && len(srcu.mutation.SeasonIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SeasonRewardClaim.season"`)
+	}
+	return nil
+}
+
+func (srcu *SeasonRewardClaimUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := srcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(seasonrewardclaim.Table, seasonrewardclaim.Columns, sqlgraph.NewFieldSpec(seasonrewardclaim.FieldID, field.TypeInt))
+	if ps := srcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if srcu.mutation.InventoryItemIDCleared() {
+		_spec.ClearField(seasonrewardclaim.FieldInventoryItemID, field.TypeInt)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, srcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{seasonrewardclaim.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	srcu.mutation.done = true
+	return n, nil
+}
+
+// SeasonRewardClaimUpdateOne is the builder for updating a single SeasonRewardClaim entity.
+type SeasonRewardClaimUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SeasonRewardClaimMutation
+}
+
+// Mutation returns the SeasonRewardClaimMutation object of the builder.
+func (srcuo *SeasonRewardClaimUpdateOne) Mutation() *SeasonRewardClaimMutation {
+	return srcuo.mutation
+}
+
+// Where appends a list predicates to the SeasonRewardClaimUpdate builder.
+func (srcuo *SeasonRewardClaimUpdateOne) Where(ps ...predicate.SeasonRewardClaim) *SeasonRewardClaimUpdateOne {
+	srcuo.mutation.Where(ps...)
+	return srcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (srcuo *SeasonRewardClaimUpdateOne) Select(field string, fields ...string) *SeasonRewardClaimUpdateOne {
+	srcuo.fields = append([]string{field}, fields...)
+	return srcuo
+}
+
+// Save executes the query and returns the updated SeasonRewardClaim entity.
+func (srcuo *SeasonRewardClaimUpdateOne) Save(ctx context.Context) (*SeasonRewardClaim, error) {
+	return withHooks(ctx, srcuo.sqlSave, srcuo.mutation, srcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (srcuo *SeasonRewardClaimUpdateOne) SaveX(ctx context.Context) *SeasonRewardClaim {
+	node, err := srcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (srcuo *SeasonRewardClaimUpdateOne) Exec(ctx context.Context) error {
+	_, err := srcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srcuo *SeasonRewardClaimUpdateOne) ExecX(ctx context.Context) {
+	if err := srcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (srcuo *SeasonRewardClaimUpdateOne) check() error {
+	if srcuo.mutation.UserCleared() && len(srcuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SeasonRewardClaim.user"`)
+	}
+	if srcuo.mutation.SeasonCleared() && len(srcuo.mutation.SeasonIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SeasonRewardClaim.season"`)
+	}
+	return nil
+}
+
+func (srcuo *SeasonRewardClaimUpdateOne) sqlSave(ctx context.Context) (_node *SeasonRewardClaim, err error) {
+	if err := srcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(seasonrewardclaim.Table, seasonrewardclaim.Columns, sqlgraph.NewFieldSpec(seasonrewardclaim.FieldID, field.TypeInt))
+	id, ok := srcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SeasonRewardClaim.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := srcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, seasonrewardclaim.FieldID)
+		for _, f := range fields {
+			if !seasonrewardclaim.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != seasonrewardclaim.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := srcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if srcuo.mutation.InventoryItemIDCleared() {
+		_spec.ClearField(seasonrewardclaim.FieldInventoryItemID, field.TypeInt)
+	}
+	_node = &SeasonRewardClaim{config: srcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, srcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{seasonrewardclaim.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	srcuo.mutation.done = true
+	return _node, nil
+}
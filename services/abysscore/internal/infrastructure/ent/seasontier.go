@@ -0,0 +1,204 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/season"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasontier"
+)
+
+// SeasonTier is the model entity for the SeasonTier schema.
+type SeasonTier struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// SeasonID holds the value of the "season_id" field.
+	SeasonID int `json:"season_id,omitempty"`
+	// Level holds the value of the "level" field.
+	Level int `json:"level,omitempty"`
+	// XpRequired holds the value of the "xp_required" field.
+	XpRequired int `json:"xp_required,omitempty"`
+	// FreeItemID holds the value of the "free_item_id" field.
+	FreeItemID *int `json:"free_item_id,omitempty"`
+	// FreeCoins holds the value of the "free_coins" field.
+	FreeCoins float64 `json:"free_coins,omitempty"`
+	// PremiumItemID holds the value of the "premium_item_id" field.
+	PremiumItemID *int `json:"premium_item_id,omitempty"`
+	// PremiumCoins holds the value of the "premium_coins" field.
+	PremiumCoins float64 `json:"premium_coins,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SeasonTierQuery when eager-loading is set.
+	Edges        SeasonTierEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SeasonTierEdges holds the relations/edges for other nodes in the graph.
+type SeasonTierEdges struct {
+	// Season holds the value of the season edge.
+	Season *Season `json:"season,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// SeasonOrErr returns the Season value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SeasonTierEdges) SeasonOrErr() (*Season, error) {
+	if e.Season != nil {
+		return e.Season, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: season.Label}
+	}
+	return nil, &NotLoadedError{edge: "season"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SeasonTier) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case seasontier.FieldFreeCoins, seasontier.FieldPremiumCoins:
+			values[i] = new(sql.NullFloat64)
+		case seasontier.FieldID, seasontier.FieldSeasonID, seasontier.FieldLevel, seasontier.FieldXpRequired, seasontier.FieldFreeItemID, seasontier.FieldPremiumItemID:
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SeasonTier fields.
+func (st *SeasonTier) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case seasontier.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			st.ID = int(value.Int64)
+		case seasontier.FieldSeasonID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field season_id", values[i])
+			} else if value.Valid {
+				st.SeasonID = int(value.Int64)
+			}
+		case seasontier.FieldLevel:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field level", values[i])
+			} else if value.Valid {
+				st.Level = int(value.Int64)
+			}
+		case seasontier.FieldXpRequired:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field xp_required", values[i])
+			} else if value.Valid {
+				st.XpRequired = int(value.Int64)
+			}
+		case seasontier.FieldFreeItemID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field free_item_id", values[i])
+			} else if value.Valid {
+				st.FreeItemID = new(int)
+				*st.FreeItemID = int(value.Int64)
+			}
+		case seasontier.FieldFreeCoins:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field free_coins", values[i])
+			} else if value.Valid {
+				st.FreeCoins = value.Float64
+			}
+		case seasontier.FieldPremiumItemID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field premium_item_id", values[i])
+			} else if value.Valid {
+				st.PremiumItemID = new(int)
+				*st.PremiumItemID = int(value.Int64)
+			}
+		case seasontier.FieldPremiumCoins:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field premium_coins", values[i])
+			} else if value.Valid {
+				st.PremiumCoins = value.Float64
+			}
+		default:
+			st.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SeasonTier.
+// This includes values selected through modifiers, order, etc.
+func (st *SeasonTier) Value(name string) (ent.Value, error) {
+	return st.selectValues.Get(name)
+}
+
+// QuerySeason queries the "season" edge of the SeasonTier entity.
+func (st *SeasonTier) QuerySeason() *SeasonQuery {
+	return NewSeasonTierClient(st.config).QuerySeason(st)
+}
+
+// Update returns a builder for updating this SeasonTier.
+// Note that you need to call SeasonTier.Unwrap() before calling this method if this SeasonTier
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (st *SeasonTier) Update() *SeasonTierUpdateOne {
+	return NewSeasonTierClient(st.config).UpdateOne(st)
+}
+
+// Unwrap unwraps the SeasonTier entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (st *SeasonTier) Unwrap() *SeasonTier {
+	_tx, ok := st.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SeasonTier is not a transactional entity")
+	}
+	st.config.driver = _tx.drv
+	return st
+}
+
+// String implements the fmt.Stringer.
+func (st *SeasonTier) String() string {
+	var builder strings.Builder
+	builder.WriteString("SeasonTier(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", st.ID))
+	builder.WriteString("season_id=")
+	builder.WriteString(fmt.Sprintf("%v", st.SeasonID))
+	builder.WriteString(", ")
+	builder.WriteString("level=")
+	builder.WriteString(fmt.Sprintf("%v", st.Level))
+	builder.WriteString(", ")
+	builder.WriteString("xp_required=")
+	builder.WriteString(fmt.Sprintf("%v", st.XpRequired))
+	builder.WriteString(", ")
+	if v := st.FreeItemID; v != nil {
+		builder.WriteString("free_item_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("free_coins=")
+	builder.WriteString(fmt.Sprintf("%v", st.FreeCoins))
+	builder.WriteString(", ")
+	if v := st.PremiumItemID; v != nil {
+		builder.WriteString("premium_item_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("premium_coins=")
+	builder.WriteString(fmt.Sprintf("%v", st.PremiumCoins))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SeasonTiers is a parsable slice of SeasonTier.
+type SeasonTiers []*SeasonTier
@@ -0,0 +1,134 @@
+// Code generated by ent, DO NOT EDIT.
+
+package seasontier
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the seasontier type in the database.
+	Label = "season_tier"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSeasonID holds the string denoting the season_id field in the database.
+	FieldSeasonID = "season_id"
+	// FieldLevel holds the string denoting the level field in the database.
+	FieldLevel = "level"
+	// FieldXpRequired holds the string denoting the xp_required field in the database.
+	FieldXpRequired = "xp_required"
+	// FieldFreeItemID holds the string denoting the free_item_id field in the database.
+	FieldFreeItemID = "free_item_id"
+	// FieldFreeCoins holds the string denoting the free_coins field in the database.
+	FieldFreeCoins = "free_coins"
+	// FieldPremiumItemID holds the string denoting the premium_item_id field in the database.
+	FieldPremiumItemID = "premium_item_id"
+	// FieldPremiumCoins holds the string denoting the premium_coins field in the database.
+	FieldPremiumCoins = "premium_coins"
+	// EdgeSeason holds the string denoting the season edge name in mutations.
+	EdgeSeason = "season"
+	// Table holds the table name of the seasontier in the database.
+	Table = "season_tiers"
+	// SeasonTable is the table that holds the season relation/edge.
+	SeasonTable = "season_tiers"
+	// SeasonInverseTable is the table name for the Season entity.
+	// It exists in this package in order to avoid circular dependency with the "season" package.
+	SeasonInverseTable = "seasons"
+	// SeasonColumn is the table column denoting the season relation/edge.
+	SeasonColumn = "season_id"
+)
+
+// Columns holds all SQL columns for seasontier fields.
+var Columns = []string{
+	FieldID,
+	FieldSeasonID,
+	FieldLevel,
+	FieldXpRequired,
+	FieldFreeItemID,
+	FieldFreeCoins,
+	FieldPremiumItemID,
+	FieldPremiumCoins,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// LevelValidator is a validator for the "level" field. It is called by the builders before save.
+	LevelValidator func(int) error
+	// XpRequiredValidator is a validator for the "xp_required" field. It is called by the builders before save.
+	XpRequiredValidator func(int) error
+	// DefaultFreeCoins holds the default value on creation for the "free_coins" field.
+	DefaultFreeCoins float64
+	// FreeCoinsValidator is a validator for the "free_coins" field. It is called by the builders before save.
+	FreeCoinsValidator func(float64) error
+	// DefaultPremiumCoins holds the default value on creation for the "premium_coins" field.
+	DefaultPremiumCoins float64
+	// PremiumCoinsValidator is a validator for the "premium_coins" field. It is called by the builders before save.
+	PremiumCoinsValidator func(float64) error
+)
+
+// OrderOption defines the ordering options for the SeasonTier queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySeasonID orders the results by the season_id field.
+func BySeasonID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeasonID, opts...).ToFunc()
+}
+
+// ByLevel orders the results by the level field.
+func ByLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLevel, opts...).ToFunc()
+}
+
+// ByXpRequired orders the results by the xp_required field.
+func ByXpRequired(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldXpRequired, opts...).ToFunc()
+}
+
+// ByFreeItemID orders the results by the free_item_id field.
+func ByFreeItemID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFreeItemID, opts...).ToFunc()
+}
+
+// ByFreeCoins orders the results by the free_coins field.
+func ByFreeCoins(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFreeCoins, opts...).ToFunc()
+}
+
+// ByPremiumItemID orders the results by the premium_item_id field.
+func ByPremiumItemID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPremiumItemID, opts...).ToFunc()
+}
+
+// ByPremiumCoins orders the results by the premium_coins field.
+func ByPremiumCoins(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPremiumCoins, opts...).ToFunc()
+}
+
+// BySeasonField orders the results by season field.
+func BySeasonField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSeasonStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newSeasonStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SeasonInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SeasonTable, SeasonColumn),
+	)
+}
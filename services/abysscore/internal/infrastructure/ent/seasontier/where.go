@@ -0,0 +1,407 @@
+// Code generated by ent, DO NOT EDIT.
+
+package seasontier
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldLTE(FieldID, id))
+}
+
+// SeasonID applies equality check predicate on the "season_id" field. It's identical to SeasonIDEQ.
+func SeasonID(v int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldEQ(FieldSeasonID, v))
+}
+
+// Level applies equality check predicate on the "level" field. It's identical to LevelEQ.
+func Level(v int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldEQ(FieldLevel, v))
+}
+
+// XpRequired applies equality check predicate on the "xp_required" field. It's identical to XpRequiredEQ.
+func XpRequired(v int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldEQ(FieldXpRequired, v))
+}
+
+// FreeItemID applies equality check predicate on the "free_item_id" field. It's identical to FreeItemIDEQ.
+func FreeItemID(v int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldEQ(FieldFreeItemID, v))
+}
+
+// FreeCoins applies equality check predicate on the "free_coins" field. It's identical to FreeCoinsEQ.
+func FreeCoins(v float64) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldEQ(FieldFreeCoins, v))
+}
+
+// PremiumItemID applies equality check predicate on the "premium_item_id" field. It's identical to PremiumItemIDEQ.
+func PremiumItemID(v int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldEQ(FieldPremiumItemID, v))
+}
+
+// PremiumCoins applies equality check predicate on the "premium_coins" field. It's identical to PremiumCoinsEQ.
+func PremiumCoins(v float64) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldEQ(FieldPremiumCoins, v))
+}
+
+// SeasonIDEQ applies the EQ predicate on the "season_id" field.
+func SeasonIDEQ(v int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldEQ(FieldSeasonID, v))
+}
+
+// SeasonIDNEQ applies the NEQ predicate on the "season_id" field.
+func SeasonIDNEQ(v int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldNEQ(FieldSeasonID, v))
+}
+
+// SeasonIDIn applies the In predicate on the "season_id" field.
+func SeasonIDIn(vs ...int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldIn(FieldSeasonID, vs...))
+}
+
+// SeasonIDNotIn applies the NotIn predicate on the "season_id" field.
+func SeasonIDNotIn(vs ...int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldNotIn(FieldSeasonID, vs...))
+}
+
+// LevelEQ applies the EQ predicate on the "level" field.
+func LevelEQ(v int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldEQ(FieldLevel, v))
+}
+
+// LevelNEQ applies the NEQ predicate on the "level" field.
+func LevelNEQ(v int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldNEQ(FieldLevel, v))
+}
+
+// LevelIn applies the In predicate on the "level" field.
+func LevelIn(vs ...int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldIn(FieldLevel, vs...))
+}
+
+// LevelNotIn applies the NotIn predicate on the "level" field.
+func LevelNotIn(vs ...int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldNotIn(FieldLevel, vs...))
+}
+
+// LevelGT applies the GT predicate on the "level" field.
+func LevelGT(v int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldGT(FieldLevel, v))
+}
+
+// LevelGTE applies the GTE predicate on the "level" field.
+func LevelGTE(v int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldGTE(FieldLevel, v))
+}
+
+// LevelLT applies the LT predicate on the "level" field.
+func LevelLT(v int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldLT(FieldLevel, v))
+}
+
+// LevelLTE applies the LTE predicate on the "level" field.
+func LevelLTE(v int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldLTE(FieldLevel, v))
+}
+
+// XpRequiredEQ applies the EQ predicate on the "xp_required" field.
+func XpRequiredEQ(v int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldEQ(FieldXpRequired, v))
+}
+
+// XpRequiredNEQ applies the NEQ predicate on the "xp_required" field.
+func XpRequiredNEQ(v int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldNEQ(FieldXpRequired, v))
+}
+
+// XpRequiredIn applies the In predicate on the "xp_required" field.
+func XpRequiredIn(vs ...int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldIn(FieldXpRequired, vs...))
+}
+
+// XpRequiredNotIn applies the NotIn predicate on the "xp_required" field.
+func XpRequiredNotIn(vs ...int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldNotIn(FieldXpRequired, vs...))
+}
+
+// XpRequiredGT applies the GT predicate on the "xp_required" field.
+func XpRequiredGT(v int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldGT(FieldXpRequired, v))
+}
+
+// XpRequiredGTE applies the GTE predicate on the "xp_required" field.
+func XpRequiredGTE(v int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldGTE(FieldXpRequired, v))
+}
+
+// XpRequiredLT applies the LT predicate on the "xp_required" field.
+func XpRequiredLT(v int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldLT(FieldXpRequired, v))
+}
+
+// XpRequiredLTE applies the LTE predicate on the "xp_required" field.
+func XpRequiredLTE(v int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldLTE(FieldXpRequired, v))
+}
+
+// FreeItemIDEQ applies the EQ predicate on the "free_item_id" field.
+func FreeItemIDEQ(v int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldEQ(FieldFreeItemID, v))
+}
+
+// FreeItemIDNEQ applies the NEQ predicate on the "free_item_id" field.
+func FreeItemIDNEQ(v int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldNEQ(FieldFreeItemID, v))
+}
+
+// FreeItemIDIn applies the In predicate on the "free_item_id" field.
+func FreeItemIDIn(vs ...int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldIn(FieldFreeItemID, vs...))
+}
+
+// FreeItemIDNotIn applies the NotIn predicate on the "free_item_id" field.
+func FreeItemIDNotIn(vs ...int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldNotIn(FieldFreeItemID, vs...))
+}
+
+// FreeItemIDGT applies the GT predicate on the "free_item_id" field.
+func FreeItemIDGT(v int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldGT(FieldFreeItemID, v))
+}
+
+// FreeItemIDGTE applies the GTE predicate on the "free_item_id" field.
+func FreeItemIDGTE(v int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldGTE(FieldFreeItemID, v))
+}
+
+// FreeItemIDLT applies the LT predicate on the "free_item_id" field.
+func FreeItemIDLT(v int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldLT(FieldFreeItemID, v))
+}
+
+// FreeItemIDLTE applies the LTE predicate on the "free_item_id" field.
+func FreeItemIDLTE(v int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldLTE(FieldFreeItemID, v))
+}
+
+// FreeItemIDIsNil applies the IsNil predicate on the "free_item_id" field.
+func FreeItemIDIsNil() predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldIsNull(FieldFreeItemID))
+}
+
+// FreeItemIDNotNil applies the NotNil predicate on the "free_item_id" field.
+func FreeItemIDNotNil() predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldNotNull(FieldFreeItemID))
+}
+
+// FreeCoinsEQ applies the EQ predicate on the "free_coins" field.
+func FreeCoinsEQ(v float64) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldEQ(FieldFreeCoins, v))
+}
+
+// FreeCoinsNEQ applies the NEQ predicate on the "free_coins" field.
+func FreeCoinsNEQ(v float64) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldNEQ(FieldFreeCoins, v))
+}
+
+// FreeCoinsIn applies the In predicate on the "free_coins" field.
+func FreeCoinsIn(vs ...float64) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldIn(FieldFreeCoins, vs...))
+}
+
+// FreeCoinsNotIn applies the NotIn predicate on the "free_coins" field.
+func FreeCoinsNotIn(vs ...float64) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldNotIn(FieldFreeCoins, vs...))
+}
+
+// FreeCoinsGT applies the GT predicate on the "free_coins" field.
+func FreeCoinsGT(v float64) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldGT(FieldFreeCoins, v))
+}
+
+// FreeCoinsGTE applies the GTE predicate on the "free_coins" field.
+func FreeCoinsGTE(v float64) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldGTE(FieldFreeCoins, v))
+}
+
+// FreeCoinsLT applies the LT predicate on the "free_coins" field.
+func FreeCoinsLT(v float64) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldLT(FieldFreeCoins, v))
+}
+
+// FreeCoinsLTE applies the LTE predicate on the "free_coins" field.
+func FreeCoinsLTE(v float64) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldLTE(FieldFreeCoins, v))
+}
+
+// PremiumItemIDEQ applies the EQ predicate on the "premium_item_id" field.
+func PremiumItemIDEQ(v int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldEQ(FieldPremiumItemID, v))
+}
+
+// PremiumItemIDNEQ applies the NEQ predicate on the "premium_item_id" field.
+func PremiumItemIDNEQ(v int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldNEQ(FieldPremiumItemID, v))
+}
+
+// PremiumItemIDIn applies the In predicate on the "premium_item_id" field.
+func PremiumItemIDIn(vs ...int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldIn(FieldPremiumItemID, vs...))
+}
+
+// PremiumItemIDNotIn applies the NotIn predicate on the "premium_item_id" field.
+func PremiumItemIDNotIn(vs ...int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldNotIn(FieldPremiumItemID, vs...))
+}
+
+// PremiumItemIDGT applies the GT predicate on the "premium_item_id" field.
+func PremiumItemIDGT(v int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldGT(FieldPremiumItemID, v))
+}
+
+// PremiumItemIDGTE applies the GTE predicate on the "premium_item_id" field.
+func PremiumItemIDGTE(v int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldGTE(FieldPremiumItemID, v))
+}
+
+// PremiumItemIDLT applies the LT predicate on the "premium_item_id" field.
+func PremiumItemIDLT(v int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldLT(FieldPremiumItemID, v))
+}
+
+// PremiumItemIDLTE applies the LTE predicate on the "premium_item_id" field.
+func PremiumItemIDLTE(v int) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldLTE(FieldPremiumItemID, v))
+}
+
+// PremiumItemIDIsNil applies the IsNil predicate on the "premium_item_id" field.
+func PremiumItemIDIsNil() predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldIsNull(FieldPremiumItemID))
+}
+
+// PremiumItemIDNotNil applies the NotNil predicate on the "premium_item_id" field.
+func PremiumItemIDNotNil() predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldNotNull(FieldPremiumItemID))
+}
+
+// PremiumCoinsEQ applies the EQ predicate on the "premium_coins" field.
+func PremiumCoinsEQ(v float64) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldEQ(FieldPremiumCoins, v))
+}
+
+// PremiumCoinsNEQ applies the NEQ predicate on the "premium_coins" field.
+func PremiumCoinsNEQ(v float64) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldNEQ(FieldPremiumCoins, v))
+}
+
+// PremiumCoinsIn applies the In predicate on the "premium_coins" field.
+func PremiumCoinsIn(vs ...float64) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldIn(FieldPremiumCoins, vs...))
+}
+
+// PremiumCoinsNotIn applies the NotIn predicate on the "premium_coins" field.
+func PremiumCoinsNotIn(vs ...float64) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldNotIn(FieldPremiumCoins, vs...))
+}
+
+// PremiumCoinsGT applies the GT predicate on the "premium_coins" field.
+func PremiumCoinsGT(v float64) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldGT(FieldPremiumCoins, v))
+}
+
+// PremiumCoinsGTE applies the GTE predicate on the "premium_coins" field.
+func PremiumCoinsGTE(v float64) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldGTE(FieldPremiumCoins, v))
+}
+
+// PremiumCoinsLT applies the LT predicate on the "premium_coins" field.
+func PremiumCoinsLT(v float64) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldLT(FieldPremiumCoins, v))
+}
+
+// PremiumCoinsLTE applies the LTE predicate on the "premium_coins" field.
+func PremiumCoinsLTE(v float64) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.FieldLTE(FieldPremiumCoins, v))
+}
+
+// HasSeason applies the HasEdge predicate on the "season" edge.
+func HasSeason() predicate.SeasonTier {
+	return predicate.SeasonTier(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SeasonTable, SeasonColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSeasonWith applies the HasEdge predicate on the "season" edge with a given conditions (other predicates).
+func HasSeasonWith(preds ...predicate.Season) predicate.SeasonTier {
+	return predicate.SeasonTier(func(s *sql.Selector) {
+		step := newSeasonStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SeasonTier) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SeasonTier) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SeasonTier) predicate.SeasonTier {
+	return predicate.SeasonTier(sql.NotPredicates(p))
+}
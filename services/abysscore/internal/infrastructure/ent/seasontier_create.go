@@ -0,0 +1,355 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/season"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasontier"
+)
+
+// SeasonTierCreate is the builder for creating a SeasonTier entity.
+type SeasonTierCreate struct {
+	config
+	mutation *SeasonTierMutation
+	hooks    []Hook
+}
+
+// SetSeasonID sets the "season_id" field.
+func (stc *SeasonTierCreate) SetSeasonID(i int) *SeasonTierCreate {
+	stc.mutation.SetSeasonID(i)
+	return stc
+}
+
+// SetLevel sets the "level" field.
+func (stc *SeasonTierCreate) SetLevel(i int) *SeasonTierCreate {
+	stc.mutation.SetLevel(i)
+	return stc
+}
+
+// SetXpRequired sets the "xp_required" field.
+func (stc *SeasonTierCreate) SetXpRequired(i int) *SeasonTierCreate {
+	stc.mutation.SetXpRequired(i)
+	return stc
+}
+
+// SetFreeItemID sets the "free_item_id" field.
+func (stc *SeasonTierCreate) SetFreeItemID(i int) *SeasonTierCreate {
+	stc.mutation.SetFreeItemID(i)
+	return stc
+}
+
+// SetNillableFreeItemID sets the "free_item_id" field if the given value is not nil.
+func (stc *SeasonTierCreate) SetNillableFreeItemID(i *int) *SeasonTierCreate {
+	if i != nil {
+		stc.SetFreeItemID(*i)
+	}
+	return stc
+}
+
+// SetFreeCoins sets the "free_coins" field.
+func (stc *SeasonTierCreate) SetFreeCoins(f float64) *SeasonTierCreate {
+	stc.mutation.SetFreeCoins(f)
+	return stc
+}
+
+// SetNillableFreeCoins sets the "free_coins" field if the given value is not nil.
+func (stc *SeasonTierCreate) SetNillableFreeCoins(f *float64) *SeasonTierCreate {
+	if f != nil {
+		stc.SetFreeCoins(*f)
+	}
+	return stc
+}
+
+// SetPremiumItemID sets the "premium_item_id" field.
+func (stc *SeasonTierCreate) SetPremiumItemID(i int) *SeasonTierCreate {
+	stc.mutation.SetPremiumItemID(i)
+	return stc
+}
+
+// SetNillablePremiumItemID sets the "premium_item_id" field if the given value is not nil.
+func (stc *SeasonTierCreate) SetNillablePremiumItemID(i *int) *SeasonTierCreate {
+	if i != nil {
+		stc.SetPremiumItemID(*i)
+	}
+	return stc
+}
+
+// SetPremiumCoins sets the "premium_coins" field.
+func (stc *SeasonTierCreate) SetPremiumCoins(f float64) *SeasonTierCreate {
+	stc.mutation.SetPremiumCoins(f)
+	return stc
+}
+
+// SetNillablePremiumCoins sets the "premium_coins" field if the given value is not nil.
+func (stc *SeasonTierCreate) SetNillablePremiumCoins(f *float64) *SeasonTierCreate {
+	if f != nil {
+		stc.SetPremiumCoins(*f)
+	}
+	return stc
+}
+
+// SetID sets the "id" field.
+func (stc *SeasonTierCreate) SetID(i int) *SeasonTierCreate {
+	stc.mutation.SetID(i)
+	return stc
+}
+
+// SetSeason sets the "season" edge to the Season entity.
+func (stc *SeasonTierCreate) SetSeason(s *Season) *SeasonTierCreate {
+	return stc.SetSeasonID(s.ID)
+}
+
+// Mutation returns the SeasonTierMutation object of the builder.
+func (stc *SeasonTierCreate) Mutation() *SeasonTierMutation {
+	return stc.mutation
+}
+
+// Save creates the SeasonTier in the database.
+func (stc *SeasonTierCreate) Save(ctx context.Context) (*SeasonTier, error) {
+	stc.defaults()
+	return withHooks(ctx, stc.sqlSave, stc.mutation, stc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (stc *SeasonTierCreate) SaveX(ctx context.Context) *SeasonTier {
+	v, err := stc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (stc *SeasonTierCreate) Exec(ctx context.Context) error {
+	_, err := stc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stc *SeasonTierCreate) ExecX(ctx context.Context) {
+	if err := stc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (stc *SeasonTierCreate) defaults() {
+	if _, ok := stc.mutation.FreeCoins(); !ok {
+		v := seasontier.DefaultFreeCoins
+		stc.mutation.SetFreeCoins(v)
+	}
+	if _, ok := stc.mutation.PremiumCoins(); !ok {
+		v := seasontier.DefaultPremiumCoins
+		stc.mutation.SetPremiumCoins(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (stc *SeasonTierCreate) check() error {
+	if _, ok := stc.mutation.SeasonID(); !ok {
+		return &ValidationError{Name: "season_id", err: errors.New(`ent: missing required field "SeasonTier.season_id"`)}
+	}
+	if _, ok := stc.mutation.Level(); !ok {
+		return &ValidationError{Name: "level", err: errors.New(`ent: missing required field "SeasonTier.level"`)}
+	}
+	if v, ok := stc.mutation.Level(); ok {
+		if err := seasontier.LevelValidator(v); err != nil {
+			return &ValidationError{Name: "level", err: fmt.Errorf(`ent: validator failed for field "SeasonTier.level": %w`, err)}
+		}
+	}
+	if _, ok := stc.mutation.XpRequired(); !ok {
+		return &ValidationError{Name: "xp_required", err: errors.New(`ent: missing required field "SeasonTier.xp_required"`)}
+	}
+	if v, ok := stc.mutation.XpRequired(); ok {
+		if err := seasontier.XpRequiredValidator(v); err != nil {
+			return &ValidationError{Name: "xp_required", err: fmt.Errorf(`ent: validator failed for field "SeasonTier.xp_required": %w`, err)}
+		}
+	}
+	if _, ok := stc.mutation.FreeCoins(); !ok {
+		return &ValidationError{Name: "free_coins", err: errors.New(`ent: missing required field "SeasonTier.free_coins"`)}
+	}
+	if v, ok := stc.mutation.FreeCoins(); ok {
+		if err := seasontier.FreeCoinsValidator(v); err != nil {
+			return &ValidationError{Name: "free_coins", err: fmt.Errorf(`ent: validator failed for field "SeasonTier.free_coins": %w`, err)}
+		}
+	}
+	if _, ok := stc.mutation.PremiumCoins(); !ok {
+		return &ValidationError{Name: "premium_coins", err: errors.New(`ent: missing required field "SeasonTier.premium_coins"`)}
+	}
+	if v, ok := stc.mutation.PremiumCoins(); ok {
+		if err := seasontier.PremiumCoinsValidator(v); err != nil {
+			return &ValidationError{Name: "premium_coins", err: fmt.Errorf(`ent: validator failed for field "SeasonTier.premium_coins": %w`, err)}
+		}
+	}
+	if len(stc.mutation.SeasonIDs()) == 0 {
+		return &ValidationError{Name: "season", err: errors.New(`ent: missing required edge "SeasonTier.season"`)}
+	}
+	return nil
+}
+
+func (stc *SeasonTierCreate) sqlSave(ctx context.Context) (*SeasonTier, error) {
+	if err := stc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := stc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, stc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	stc.mutation.id = &_node.ID
+	stc.mutation.done = true
+	return _node, nil
+}
+
+func (stc *SeasonTierCreate) createSpec() (*SeasonTier, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SeasonTier{config: stc.config}
+		_spec = sqlgraph.NewCreateSpec(seasontier.Table, sqlgraph.NewFieldSpec(seasontier.FieldID, field.TypeInt))
+	)
+	if id, ok := stc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := stc.mutation.Level(); ok {
+		_spec.SetField(seasontier.FieldLevel, field.TypeInt, value)
+		_node.Level = value
+	}
+	if value, ok := stc.mutation.XpRequired(); ok {
+		_spec.SetField(seasontier.FieldXpRequired, field.TypeInt, value)
+		_node.XpRequired = value
+	}
+	if value, ok := stc.mutation.FreeItemID(); ok {
+		_spec.SetField(seasontier.FieldFreeItemID, field.TypeInt, value)
+		_node.FreeItemID = &value
+	}
+	if value, ok := stc.mutation.FreeCoins(); ok {
+		_spec.SetField(seasontier.FieldFreeCoins, field.TypeFloat64, value)
+		_node.FreeCoins = value
+	}
+	if value, ok := stc.mutation.PremiumItemID(); ok {
+		_spec.SetField(seasontier.FieldPremiumItemID, field.TypeInt, value)
+		_node.PremiumItemID = &value
+	}
+	if value, ok := stc.mutation.PremiumCoins(); ok {
+		_spec.SetField(seasontier.FieldPremiumCoins, field.TypeFloat64, value)
+		_node.PremiumCoins = value
+	}
+	if nodes := stc.mutation.SeasonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   seasontier.SeasonTable,
+			Columns: []string{seasontier.SeasonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SeasonID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SeasonTierCreateBulk is the builder for creating many SeasonTier entities in bulk.
+type SeasonTierCreateBulk struct {
+	config
+	err      error
+	builders []*SeasonTierCreate
+}
+
+// Save creates the SeasonTier entities in the database.
+func (stcb *SeasonTierCreateBulk) Save(ctx context.Context) ([]*SeasonTier, error) {
+	if stcb.err != nil {
+		return nil, stcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(stcb.builders))
+	nodes := make([]*SeasonTier, len(stcb.builders))
+	mutators := make([]Mutator, len(stcb.builders))
+	for i := range stcb.builders {
+		func(i int, root context.Context) {
+			builder := stcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SeasonTierMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, stcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, stcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, stcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (stcb *SeasonTierCreateBulk) SaveX(ctx context.Context) []*SeasonTier {
+	v, err := stcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (stcb *SeasonTierCreateBulk) Exec(ctx context.Context) error {
+	_, err := stcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stcb *SeasonTierCreateBulk) ExecX(ctx context.Context) {
+	if err := stcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,533 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasontier"
+)
+
+// SeasonTierUpdate is the builder for updating SeasonTier entities.
+type SeasonTierUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SeasonTierMutation
+}
+
+// Where appends a list predicates to the SeasonTierUpdate builder.
+func (stu *SeasonTierUpdate) Where(ps ...predicate.SeasonTier) *SeasonTierUpdate {
+	stu.mutation.Where(ps...)
+	return stu
+}
+
+// SetXpRequired sets the "xp_required" field.
+func (stu *SeasonTierUpdate) SetXpRequired(i int) *SeasonTierUpdate {
+	stu.mutation.ResetXpRequired()
+	stu.mutation.SetXpRequired(i)
+	return stu
+}
+
+// SetNillableXpRequired sets the "xp_required" field if the given value is not nil.
+func (stu *SeasonTierUpdate) SetNillableXpRequired(i *int) *SeasonTierUpdate {
+	if i != nil {
+		stu.SetXpRequired(*i)
+	}
+	return stu
+}
+
+// AddXpRequired adds i to the "xp_required" field.
+func (stu *SeasonTierUpdate) AddXpRequired(i int) *SeasonTierUpdate {
+	stu.mutation.AddXpRequired(i)
+	return stu
+}
+
+// SetFreeItemID sets the "free_item_id" field.
+func (stu *SeasonTierUpdate) SetFreeItemID(i int) *SeasonTierUpdate {
+	stu.mutation.ResetFreeItemID()
+	stu.mutation.SetFreeItemID(i)
+	return stu
+}
+
+// SetNillableFreeItemID sets the "free_item_id" field if the given value is not nil.
+func (stu *SeasonTierUpdate) SetNillableFreeItemID(i *int) *SeasonTierUpdate {
+	if i != nil {
+		stu.SetFreeItemID(*i)
+	}
+	return stu
+}
+
+// AddFreeItemID adds i to the "free_item_id" field.
+func (stu *SeasonTierUpdate) AddFreeItemID(i int) *SeasonTierUpdate {
+	stu.mutation.AddFreeItemID(i)
+	return stu
+}
+
+// ClearFreeItemID clears the value of the "free_item_id" field.
+func (stu *SeasonTierUpdate) ClearFreeItemID() *SeasonTierUpdate {
+	stu.mutation.ClearFreeItemID()
+	return stu
+}
+
+// SetFreeCoins sets the "free_coins" field.
+func (stu *SeasonTierUpdate) SetFreeCoins(f float64) *SeasonTierUpdate {
+	stu.mutation.ResetFreeCoins()
+	stu.mutation.SetFreeCoins(f)
+	return stu
+}
+
+// SetNillableFreeCoins sets the "free_coins" field if the given value is not nil.
+func (stu *SeasonTierUpdate) SetNillableFreeCoins(f *float64) *SeasonTierUpdate {
+	if f != nil {
+		stu.SetFreeCoins(*f)
+	}
+	return stu
+}
+
+// AddFreeCoins adds f to the "free_coins" field.
+func (stu *SeasonTierUpdate) AddFreeCoins(f float64) *SeasonTierUpdate {
+	stu.mutation.AddFreeCoins(f)
+	return stu
+}
+
+// SetPremiumItemID sets the "premium_item_id" field.
+func (stu *SeasonTierUpdate) SetPremiumItemID(i int) *SeasonTierUpdate {
+	stu.mutation.ResetPremiumItemID()
+	stu.mutation.SetPremiumItemID(i)
+	return stu
+}
+
+// SetNillablePremiumItemID sets the "premium_item_id" field if the given value is not nil.
+func (stu *SeasonTierUpdate) SetNillablePremiumItemID(i *int) *SeasonTierUpdate {
+	if i != nil {
+		stu.SetPremiumItemID(*i)
+	}
+	return stu
+}
+
+// AddPremiumItemID adds i to the "premium_item_id" field.
+func (stu *SeasonTierUpdate) AddPremiumItemID(i int) *SeasonTierUpdate {
+	stu.mutation.AddPremiumItemID(i)
+	return stu
+}
+
+// ClearPremiumItemID clears the value of the "premium_item_id" field.
+func (stu *SeasonTierUpdate) ClearPremiumItemID() *SeasonTierUpdate {
+	stu.mutation.ClearPremiumItemID()
+	return stu
+}
+
+// SetPremiumCoins sets the "premium_coins" field.
+func (stu *SeasonTierUpdate) SetPremiumCoins(f float64) *SeasonTierUpdate {
+	stu.mutation.ResetPremiumCoins()
+	stu.mutation.SetPremiumCoins(f)
+	return stu
+}
+
+// SetNillablePremiumCoins sets the "premium_coins" field if the given value is not nil.
+func (stu *SeasonTierUpdate) SetNillablePremiumCoins(f *float64) *SeasonTierUpdate {
+	if f != nil {
+		stu.SetPremiumCoins(*f)
+	}
+	return stu
+}
+
+// AddPremiumCoins adds f to the "premium_coins" field.
+func (stu *SeasonTierUpdate) AddPremiumCoins(f float64) *SeasonTierUpdate {
+	stu.mutation.AddPremiumCoins(f)
+	return stu
+}
+
+// Mutation returns the SeasonTierMutation object of the builder.
+func (stu *SeasonTierUpdate) Mutation() *SeasonTierMutation {
+	return stu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (stu *SeasonTierUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, stu.sqlSave, stu.mutation, stu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (stu *SeasonTierUpdate) SaveX(ctx context.Context) int {
+	affected, err := stu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (stu *SeasonTierUpdate) Exec(ctx context.Context) error {
+	_, err := stu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stu *SeasonTierUpdate) ExecX(ctx context.Context) {
+	if err := stu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (stu *SeasonTierUpdate) check() error {
+	if v, ok := stu.mutation.XpRequired(); ok {
+		if err := seasontier.XpRequiredValidator(v); err != nil {
+			return &ValidationError{Name: "xp_required", err: fmt.Errorf(`ent: validator failed for field "SeasonTier.xp_required": %w`, err)}
+		}
+	}
+	if v, ok := stu.mutation.FreeCoins(); ok {
+		if err := seasontier.FreeCoinsValidator(v); err != nil {
+			return &ValidationError{Name: "free_coins", err: fmt.Errorf(`ent: validator failed for field "SeasonTier.free_coins": %w`, err)}
+		}
+	}
+	if v, ok := stu.mutation.PremiumCoins(); ok {
+		if err := seasontier.PremiumCoinsValidator(v); err != nil {
+			return &ValidationError{Name: "premium_coins", err: fmt.Errorf(`ent: validator failed for field "SeasonTier.premium_coins": %w`, err)}
+		}
+	}
+	if stu.mutation.SeasonCleared() && len(stu.mutation.SeasonIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SeasonTier.season"`)
+	}
+	return nil
+}
+
+func (stu *SeasonTierUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := stu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(seasontier.Table, seasontier.Columns, sqlgraph.NewFieldSpec(seasontier.FieldID, field.TypeInt))
+	if ps := stu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := stu.mutation.XpRequired(); ok {
+		_spec.SetField(seasontier.FieldXpRequired, field.TypeInt, value)
+	}
+	if value, ok := stu.mutation.AddedXpRequired(); ok {
+		_spec.AddField(seasontier.FieldXpRequired, field.TypeInt, value)
+	}
+	if value, ok := stu.mutation.FreeItemID(); ok {
+		_spec.SetField(seasontier.FieldFreeItemID, field.TypeInt, value)
+	}
+	if value, ok := stu.mutation.AddedFreeItemID(); ok {
+		_spec.AddField(seasontier.FieldFreeItemID, field.TypeInt, value)
+	}
+	if stu.mutation.FreeItemIDCleared() {
+		_spec.ClearField(seasontier.FieldFreeItemID, field.TypeInt)
+	}
+	if value, ok := stu.mutation.FreeCoins(); ok {
+		_spec.SetField(seasontier.FieldFreeCoins, field.TypeFloat64, value)
+	}
+	if value, ok := stu.mutation.AddedFreeCoins(); ok {
+		_spec.AddField(seasontier.FieldFreeCoins, field.TypeFloat64, value)
+	}
+	if value, ok := stu.mutation.PremiumItemID(); ok {
+		_spec.SetField(seasontier.FieldPremiumItemID, field.TypeInt, value)
+	}
+	if value, ok := stu.mutation.AddedPremiumItemID(); ok {
+		_spec.AddField(seasontier.FieldPremiumItemID, field.TypeInt, value)
+	}
+	if stu.mutation.PremiumItemIDCleared() {
+		_spec.ClearField(seasontier.FieldPremiumItemID, field.TypeInt)
+	}
+	if value, ok := stu.mutation.PremiumCoins(); ok {
+		_spec.SetField(seasontier.FieldPremiumCoins, field.TypeFloat64, value)
+	}
+	if value, ok := stu.mutation.AddedPremiumCoins(); ok {
+		_spec.AddField(seasontier.FieldPremiumCoins, field.TypeFloat64, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, stu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{seasontier.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	stu.mutation.done = true
+	return n, nil
+}
+
+// SeasonTierUpdateOne is the builder for updating a single SeasonTier entity.
+type SeasonTierUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SeasonTierMutation
+}
+
+// SetXpRequired sets the "xp_required" field.
+func (stuo *SeasonTierUpdateOne) SetXpRequired(i int) *SeasonTierUpdateOne {
+	stuo.mutation.ResetXpRequired()
+	stuo.mutation.SetXpRequired(i)
+	return stuo
+}
+
+// SetNillableXpRequired sets the "xp_required" field if the given value is not nil.
+func (stuo *SeasonTierUpdateOne) SetNillableXpRequired(i *int) *SeasonTierUpdateOne {
+	if i != nil {
+		stuo.SetXpRequired(*i)
+	}
+	return stuo
+}
+
+// AddXpRequired adds i to the "xp_required" field.
+func (stuo *SeasonTierUpdateOne) AddXpRequired(i int) *SeasonTierUpdateOne {
+	stuo.mutation.AddXpRequired(i)
+	return stuo
+}
+
+// SetFreeItemID sets the "free_item_id" field.
+func (stuo *SeasonTierUpdateOne) SetFreeItemID(i int) *SeasonTierUpdateOne {
+	stuo.mutation.ResetFreeItemID()
+	stuo.mutation.SetFreeItemID(i)
+	return stuo
+}
+
+// SetNillableFreeItemID sets the "free_item_id" field if the given value is not nil.
+func (stuo *SeasonTierUpdateOne) SetNillableFreeItemID(i *int) *SeasonTierUpdateOne {
+	if i != nil {
+		stuo.SetFreeItemID(*i)
+	}
+	return stuo
+}
+
+// AddFreeItemID adds i to the "free_item_id" field.
+func (stuo *SeasonTierUpdateOne) AddFreeItemID(i int) *SeasonTierUpdateOne {
+	stuo.mutation.AddFreeItemID(i)
+	return stuo
+}
+
+// ClearFreeItemID clears the value of the "free_item_id" field.
+func (stuo *SeasonTierUpdateOne) ClearFreeItemID() *SeasonTierUpdateOne {
+	stuo.mutation.ClearFreeItemID()
+	return stuo
+}
+
+// SetFreeCoins sets the "free_coins" field.
+func (stuo *SeasonTierUpdateOne) SetFreeCoins(f float64) *SeasonTierUpdateOne {
+	stuo.mutation.ResetFreeCoins()
+	stuo.mutation.SetFreeCoins(f)
+	return stuo
+}
+
+// SetNillableFreeCoins sets the "free_coins" field if the given value is not nil.
+func (stuo *SeasonTierUpdateOne) SetNillableFreeCoins(f *float64) *SeasonTierUpdateOne {
+	if f != nil {
+		stuo.SetFreeCoins(*f)
+	}
+	return stuo
+}
+
+// AddFreeCoins adds f to the "free_coins" field.
+func (stuo *SeasonTierUpdateOne) AddFreeCoins(f float64) *SeasonTierUpdateOne {
+	stuo.mutation.AddFreeCoins(f)
+	return stuo
+}
+
+// SetPremiumItemID sets the "premium_item_id" field.
+func (stuo *SeasonTierUpdateOne) SetPremiumItemID(i int) *SeasonTierUpdateOne {
+	stuo.mutation.ResetPremiumItemID()
+	stuo.mutation.SetPremiumItemID(i)
+	return stuo
+}
+
+// SetNillablePremiumItemID sets the "premium_item_id" field if the given value is not nil.
+func (stuo *SeasonTierUpdateOne) SetNillablePremiumItemID(i *int) *SeasonTierUpdateOne {
+	if i != nil {
+		stuo.SetPremiumItemID(*i)
+	}
+	return stuo
+}
+
+// AddPremiumItemID adds i to the "premium_item_id" field.
+func (stuo *SeasonTierUpdateOne) AddPremiumItemID(i int) *SeasonTierUpdateOne {
+	stuo.mutation.AddPremiumItemID(i)
+	return stuo
+}
+
+// ClearPremiumItemID clears the value of the "premium_item_id" field.
+func (stuo *SeasonTierUpdateOne) ClearPremiumItemID() *SeasonTierUpdateOne {
+	stuo.mutation.ClearPremiumItemID()
+	return stuo
+}
+
+// SetPremiumCoins sets the "premium_coins" field.
+func (stuo *SeasonTierUpdateOne) SetPremiumCoins(f float64) *SeasonTierUpdateOne {
+	stuo.mutation.ResetPremiumCoins()
+	stuo.mutation.SetPremiumCoins(f)
+	return stuo
+}
+
+// SetNillablePremiumCoins sets the "premium_coins" field if the given value is not nil.
+func (stuo *SeasonTierUpdateOne) SetNillablePremiumCoins(f *float64) *SeasonTierUpdateOne {
+	if f != nil {
+		stuo.SetPremiumCoins(*f)
+	}
+	return stuo
+}
+
+// AddPremiumCoins adds f to the "premium_coins" field.
+func (stuo *SeasonTierUpdateOne) AddPremiumCoins(f float64) *SeasonTierUpdateOne {
+	stuo.mutation.AddPremiumCoins(f)
+	return stuo
+}
+
+// Mutation returns the SeasonTierMutation object of the builder.
+func (stuo *SeasonTierUpdateOne) Mutation() *SeasonTierMutation {
+	return stuo.mutation
+}
+
+// Where appends a list predicates to the SeasonTierUpdate builder.
+func (stuo *SeasonTierUpdateOne) Where(ps ...predicate.SeasonTier) *SeasonTierUpdateOne {
+	stuo.mutation.Where(ps...)
+	return stuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (stuo *SeasonTierUpdateOne) Select(field string, fields ...string) *SeasonTierUpdateOne {
+	stuo.fields = append([]string{field}, fields...)
+	return stuo
+}
+
+// Save executes the query and returns the updated SeasonTier entity.
+func (stuo *SeasonTierUpdateOne) Save(ctx context.Context) (*SeasonTier, error) {
+	return withHooks(ctx, stuo.sqlSave, stuo.mutation, stuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (stuo *SeasonTierUpdateOne) SaveX(ctx context.Context) *SeasonTier {
+	node, err := stuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (stuo *SeasonTierUpdateOne) Exec(ctx context.Context) error {
+	_, err := stuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stuo *SeasonTierUpdateOne) ExecX(ctx context.Context) {
+	if err := stuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (stuo *SeasonTierUpdateOne) check() error {
+	if v, ok := stuo.mutation.XpRequired(); ok {
+		if err := seasontier.XpRequiredValidator(v); err != nil {
+			return &ValidationError{Name: "xp_required", err: fmt.Errorf(`ent: validator failed for field "SeasonTier.xp_required": %w`, err)}
+		}
+	}
+	if v, ok := stuo.mutation.FreeCoins(); ok {
+		if err := seasontier.FreeCoinsValidator(v); err != nil {
+			return &ValidationError{Name: "free_coins", err: fmt.Errorf(`ent: validator failed for field "SeasonTier.free_coins": %w`, err)}
+		}
+	}
+	if v, ok := stuo.mutation.PremiumCoins(); ok {
+		if err := seasontier.PremiumCoinsValidator(v); err != nil {
+			return &ValidationError{Name: "premium_coins", err: fmt.Errorf(`ent: validator failed for field "SeasonTier.premium_coins": %w`, err)}
+		}
+	}
+	if stuo.mutation.SeasonCleared() && len(stuo.mutation.SeasonIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SeasonTier.season"`)
+	}
+	return nil
+}
+
+func (stuo *SeasonTierUpdateOne) sqlSave(ctx context.Context) (_node *SeasonTier, err error) {
+	if err := stuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(seasontier.Table, seasontier.Columns, sqlgraph.NewFieldSpec(seasontier.FieldID, field.TypeInt))
+	id, ok := stuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SeasonTier.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := stuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, seasontier.FieldID)
+		for _, f := range fields {
+			if !seasontier.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != seasontier.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := stuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := stuo.mutation.XpRequired(); ok {
+		_spec.SetField(seasontier.FieldXpRequired, field.TypeInt, value)
+	}
+	if value, ok := stuo.mutation.AddedXpRequired(); ok {
+		_spec.AddField(seasontier.FieldXpRequired, field.TypeInt, value)
+	}
+	if value, ok := stuo.mutation.FreeItemID(); ok {
+		_spec.SetField(seasontier.FieldFreeItemID, field.TypeInt, value)
+	}
+	if value, ok := stuo.mutation.AddedFreeItemID(); ok {
+		_spec.AddField(seasontier.FieldFreeItemID, field.TypeInt, value)
+	}
+	if stuo.mutation.FreeItemIDCleared() {
+		_spec.ClearField(seasontier.FieldFreeItemID, field.TypeInt)
+	}
+	if value, ok := stuo.mutation.FreeCoins(); ok {
+		_spec.SetField(seasontier.FieldFreeCoins, field.TypeFloat64, value)
+	}
+	if value, ok := stuo.mutation.AddedFreeCoins(); ok {
+		_spec.AddField(seasontier.FieldFreeCoins, field.TypeFloat64, value)
+	}
+	if value, ok := stuo.mutation.PremiumItemID(); ok {
+		_spec.SetField(seasontier.FieldPremiumItemID, field.TypeInt, value)
+	}
+	if value, ok := stuo.mutation.AddedPremiumItemID(); ok {
+		_spec.AddField(seasontier.FieldPremiumItemID, field.TypeInt, value)
+	}
+	if stuo.mutation.PremiumItemIDCleared() {
+		_spec.ClearField(seasontier.FieldPremiumItemID, field.TypeInt)
+	}
+	if value, ok := stuo.mutation.PremiumCoins(); ok {
+		_spec.SetField(seasontier.FieldPremiumCoins, field.TypeFloat64, value)
+	}
+	if value, ok := stuo.mutation.AddedPremiumCoins(); ok {
+		_spec.AddField(seasontier.FieldPremiumCoins, field.TypeFloat64, value)
+	}
+	_node = &SeasonTier{config: stuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, stuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{seasontier.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	stuo.mutation.done = true
+	return _node, nil
+}
@@ -65,7 +65,7 @@ const (
 	Table = "statistics"
 	// UserTable is the table that holds the user relation/edge.
 	UserTable = "statistics"
-	// UserInverseTable is the table name for the UserDTO entity.
+	// UserInverseTable is the table name for the User entity.
 	// It exists in this package in order to avoid circular dependency with the "user" package.
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
@@ -199,6 +199,7 @@ const DefaultType = TypeGlobal
 // Type values.
 const (
 	TypeGlobal Type = "global"
+	TypeSeason Type = "season"
 )
 
 func (_type Type) String() string {
@@ -208,7 +209,7 @@ func (_type Type) String() string {
 // TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
 func TypeValidator(_type Type) error {
 	switch _type {
-	case TypeGlobal:
+	case TypeGlobal, TypeSeason:
 		return nil
 	default:
 		return fmt.Errorf("statistic: invalid enum value for type field: %q", _type)
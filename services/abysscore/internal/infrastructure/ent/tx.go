@@ -12,24 +12,60 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// APIToken is the client for interacting with the APIToken builders.
+	APIToken *APITokenClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
 	// BannedHardwareID is the client for interacting with the BannedHardwareID builders.
 	BannedHardwareID *BannedHardwareIDClient
+	// CollectionCompletion is the client for interacting with the CollectionCompletion builders.
+	CollectionCompletion *CollectionCompletionClient
+	// CollectionReward is the client for interacting with the CollectionReward builders.
+	CollectionReward *CollectionRewardClient
+	// EmailHistory is the client for interacting with the EmailHistory builders.
+	EmailHistory *EmailHistoryClient
+	// ExternalIdentity is the client for interacting with the ExternalIdentity builders.
+	ExternalIdentity *ExternalIdentityClient
 	// FriendRequest is the client for interacting with the FriendRequest builders.
 	FriendRequest *FriendRequestClient
 	// GameItem is the client for interacting with the GameItem builders.
 	GameItem *GameItemClient
+	// GrantJob is the client for interacting with the GrantJob builders.
+	GrantJob *GrantJobClient
+	// HardwareIDReset is the client for interacting with the HardwareIDReset builders.
+	HardwareIDReset *HardwareIDResetClient
 	// InventoryItem is the client for interacting with the InventoryItem builders.
 	InventoryItem *InventoryItemClient
+	// LoginHistory is the client for interacting with the LoginHistory builders.
+	LoginHistory *LoginHistoryClient
 	// Match is the client for interacting with the Match builders.
 	Match *MatchClient
+	// PasswordHistory is the client for interacting with the PasswordHistory builders.
+	PasswordHistory *PasswordHistoryClient
 	// PlayerMatchResult is the client for interacting with the PlayerMatchResult builders.
 	PlayerMatchResult *PlayerMatchResultClient
+	// RecoveryCode is the client for interacting with the RecoveryCode builders.
+	RecoveryCode *RecoveryCodeClient
+	// Role is the client for interacting with the Role builders.
+	Role *RoleClient
+	// Sanction is the client for interacting with the Sanction builders.
+	Sanction *SanctionClient
+	// Season is the client for interacting with the Season builders.
+	Season *SeasonClient
+	// SeasonPass is the client for interacting with the SeasonPass builders.
+	SeasonPass *SeasonPassClient
+	// SeasonRewardClaim is the client for interacting with the SeasonRewardClaim builders.
+	SeasonRewardClaim *SeasonRewardClaimClient
+	// SeasonTier is the client for interacting with the SeasonTier builders.
+	SeasonTier *SeasonTierClient
 	// Statistic is the client for interacting with the Statistic builders.
 	Statistic *StatisticClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UserBalance is the client for interacting with the UserBalance builders.
 	UserBalance *UserBalanceClient
+	// UsernameHistory is the client for interacting with the UsernameHistory builders.
+	UsernameHistory *UsernameHistoryClient
 
 	// lazily loaded.
 	client     *Client
@@ -161,15 +197,33 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.APIToken = NewAPITokenClient(tx.config)
+	tx.AuditLog = NewAuditLogClient(tx.config)
 	tx.BannedHardwareID = NewBannedHardwareIDClient(tx.config)
+	tx.CollectionCompletion = NewCollectionCompletionClient(tx.config)
+	tx.CollectionReward = NewCollectionRewardClient(tx.config)
+	tx.EmailHistory = NewEmailHistoryClient(tx.config)
+	tx.ExternalIdentity = NewExternalIdentityClient(tx.config)
 	tx.FriendRequest = NewFriendRequestClient(tx.config)
 	tx.GameItem = NewGameItemClient(tx.config)
+	tx.GrantJob = NewGrantJobClient(tx.config)
+	tx.HardwareIDReset = NewHardwareIDResetClient(tx.config)
 	tx.InventoryItem = NewInventoryItemClient(tx.config)
+	tx.LoginHistory = NewLoginHistoryClient(tx.config)
 	tx.Match = NewMatchClient(tx.config)
+	tx.PasswordHistory = NewPasswordHistoryClient(tx.config)
 	tx.PlayerMatchResult = NewPlayerMatchResultClient(tx.config)
+	tx.RecoveryCode = NewRecoveryCodeClient(tx.config)
+	tx.Role = NewRoleClient(tx.config)
+	tx.Sanction = NewSanctionClient(tx.config)
+	tx.Season = NewSeasonClient(tx.config)
+	tx.SeasonPass = NewSeasonPassClient(tx.config)
+	tx.SeasonRewardClaim = NewSeasonRewardClaimClient(tx.config)
+	tx.SeasonTier = NewSeasonTierClient(tx.config)
 	tx.Statistic = NewStatisticClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.UserBalance = NewUserBalanceClient(tx.config)
+	tx.UsernameHistory = NewUsernameHistoryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -179,7 +233,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: BannedHardwareID.QueryXXX(), the query will be executed
+// applies a query, for example: APIToken.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -29,6 +29,12 @@ type User struct {
 	Password string `json:"-"`
 	// HardwareID holds the value of the "hardware_id" field.
 	HardwareID *string `json:"-"`
+	// HardwareIDFingerprint holds the value of the "hardware_id_fingerprint" field.
+	HardwareIDFingerprint *string `json:"hardware_id_fingerprint,omitempty"`
+	// TotpSecret holds the value of the "totp_secret" field.
+	TotpSecret *string `json:"-"`
+	// TotpEnabledAt holds the value of the "totp_enabled_at" field.
+	TotpEnabledAt *time.Time `json:"totp_enabled_at,omitempty"`
 	// AccessLevel holds the value of the "access_level" field.
 	AccessLevel access_level.AccessLevel `json:"access_level,omitempty"`
 	// GenshinUID holds the value of the "genshin_uid" field.
@@ -41,6 +47,8 @@ type User struct {
 	CurrentItemInProfileID *int `json:"current_item_in_profile_id,omitempty"`
 	// AvatarURL holds the value of the "avatar_url" field.
 	AvatarURL *string `json:"avatar_url,omitempty"`
+	// Title holds the value of the "title" field.
+	Title *string `json:"title,omitempty"`
 	// InvitesEnabled holds the value of the "invites_enabled" field.
 	InvitesEnabled bool `json:"invites_enabled,omitempty"`
 	// LoginAt holds the value of the "login_at" field.
@@ -61,6 +69,12 @@ type User struct {
 	AccountBlockReason *string `json:"account_block_reason,omitempty"`
 	// AccountBlockedLevel holds the value of the "account_blocked_level" field.
 	AccountBlockedLevel int `json:"account_blocked_level,omitempty"`
+	// LoginLockedUntil holds the value of the "login_locked_until" field.
+	LoginLockedUntil *time.Time `json:"login_locked_until,omitempty"`
+	// DeletionScheduledAt holds the value of the "deletion_scheduled_at" field.
+	DeletionScheduledAt *time.Time `json:"deletion_scheduled_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -85,9 +99,35 @@ type UserEdges struct {
 	CurrentMatch *Match `json:"current_match,omitempty"`
 	// Balance holds the value of the balance edge.
 	Balance *UserBalance `json:"balance,omitempty"`
+	// CollectionCompletions holds the value of the collection_completions edge.
+	CollectionCompletions []*CollectionCompletion `json:"collection_completions,omitempty"`
+	// SeasonPasses holds the value of the season_passes edge.
+	SeasonPasses []*SeasonPass `json:"season_passes,omitempty"`
+	// SeasonRewardClaims holds the value of the season_reward_claims edge.
+	SeasonRewardClaims []*SeasonRewardClaim `json:"season_reward_claims,omitempty"`
+	// EmailHistory holds the value of the email_history edge.
+	EmailHistory []*EmailHistory `json:"email_history,omitempty"`
+	// UsernameHistory holds the value of the username_history edge.
+	UsernameHistory []*UsernameHistory `json:"username_history,omitempty"`
+	// PasswordHistory holds the value of the password_history edge.
+	PasswordHistory []*PasswordHistory `json:"password_history,omitempty"`
+	// LoginHistory holds the value of the login_history edge.
+	LoginHistory []*LoginHistory `json:"login_history,omitempty"`
+	// ExternalIdentities holds the value of the external_identities edge.
+	ExternalIdentities []*ExternalIdentity `json:"external_identities,omitempty"`
+	// APITokens holds the value of the api_tokens edge.
+	APITokens []*APIToken `json:"api_tokens,omitempty"`
+	// RecoveryCodes holds the value of the recovery_codes edge.
+	RecoveryCodes []*RecoveryCode `json:"recovery_codes,omitempty"`
+	// HardwareIDResets holds the value of the hardware_id_resets edge.
+	HardwareIDResets []*HardwareIDReset `json:"hardware_id_resets,omitempty"`
+	// Sanctions holds the value of the sanctions edge.
+	Sanctions []*Sanction `json:"sanctions,omitempty"`
+	// Roles holds the value of the roles edge.
+	Roles []*Role `json:"roles,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [8]bool
+	loadedTypes [21]bool
 }
 
 // StatisticsOrErr returns the Statistics value or an error if the edge
@@ -168,6 +208,123 @@ func (e UserEdges) BalanceOrErr() (*UserBalance, error) {
 	return nil, &NotLoadedError{edge: "balance"}
 }
 
+// CollectionCompletionsOrErr returns the CollectionCompletions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) CollectionCompletionsOrErr() ([]*CollectionCompletion, error) {
+	if e.loadedTypes[8] {
+		return e.CollectionCompletions, nil
+	}
+	return nil, &NotLoadedError{edge: "collection_completions"}
+}
+
+// SeasonPassesOrErr returns the SeasonPasses value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SeasonPassesOrErr() ([]*SeasonPass, error) {
+	if e.loadedTypes[9] {
+		return e.SeasonPasses, nil
+	}
+	return nil, &NotLoadedError{edge: "season_passes"}
+}
+
+// SeasonRewardClaimsOrErr returns the SeasonRewardClaims value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SeasonRewardClaimsOrErr() ([]*SeasonRewardClaim, error) {
+	if e.loadedTypes[10] {
+		return e.SeasonRewardClaims, nil
+	}
+	return nil, &NotLoadedError{edge: "season_reward_claims"}
+}
+
+// EmailHistoryOrErr returns the EmailHistory value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) EmailHistoryOrErr() ([]*EmailHistory, error) {
+	if e.loadedTypes[11] {
+		return e.EmailHistory, nil
+	}
+	return nil, &NotLoadedError{edge: "email_history"}
+}
+
+// UsernameHistoryOrErr returns the UsernameHistory value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) UsernameHistoryOrErr() ([]*UsernameHistory, error) {
+	if e.loadedTypes[12] {
+		return e.UsernameHistory, nil
+	}
+	return nil, &NotLoadedError{edge: "username_history"}
+}
+
+// PasswordHistoryOrErr returns the PasswordHistory value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PasswordHistoryOrErr() ([]*PasswordHistory, error) {
+	if e.loadedTypes[13] {
+		return e.PasswordHistory, nil
+	}
+	return nil, &NotLoadedError{edge: "password_history"}
+}
+
+// LoginHistoryOrErr returns the LoginHistory value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) LoginHistoryOrErr() ([]*LoginHistory, error) {
+	if e.loadedTypes[14] {
+		return e.LoginHistory, nil
+	}
+	return nil, &NotLoadedError{edge: "login_history"}
+}
+
+// ExternalIdentitiesOrErr returns the ExternalIdentities value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ExternalIdentitiesOrErr() ([]*ExternalIdentity, error) {
+	if e.loadedTypes[15] {
+		return e.ExternalIdentities, nil
+	}
+	return nil, &NotLoadedError{edge: "external_identities"}
+}
+
+// APITokensOrErr returns the APITokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) APITokensOrErr() ([]*APIToken, error) {
+	if e.loadedTypes[16] {
+		return e.APITokens, nil
+	}
+	return nil, &NotLoadedError{edge: "api_tokens"}
+}
+
+// RecoveryCodesOrErr returns the RecoveryCodes value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RecoveryCodesOrErr() ([]*RecoveryCode, error) {
+	if e.loadedTypes[17] {
+		return e.RecoveryCodes, nil
+	}
+	return nil, &NotLoadedError{edge: "recovery_codes"}
+}
+
+// HardwareIDResetsOrErr returns the HardwareIDResets value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) HardwareIDResetsOrErr() ([]*HardwareIDReset, error) {
+	if e.loadedTypes[18] {
+		return e.HardwareIDResets, nil
+	}
+	return nil, &NotLoadedError{edge: "hardware_id_resets"}
+}
+
+// SanctionsOrErr returns the Sanctions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SanctionsOrErr() ([]*Sanction, error) {
+	if e.loadedTypes[19] {
+		return e.Sanctions, nil
+	}
+	return nil, &NotLoadedError{edge: "sanctions"}
+}
+
+// RolesOrErr returns the Roles value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RolesOrErr() ([]*Role, error) {
+	if e.loadedTypes[20] {
+		return e.Roles, nil
+	}
+	return nil, &NotLoadedError{edge: "roles"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -179,9 +336,9 @@ func (*User) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case user.FieldID, user.FieldCurrentMatchID, user.FieldCurrentItemInProfileID, user.FieldLoginStreak, user.FieldSearchBlockedLevel, user.FieldAccountBlockedLevel:
 			values[i] = new(sql.NullInt64)
-		case user.FieldUsername, user.FieldEmail, user.FieldPassword, user.FieldHardwareID, user.FieldGenshinUID, user.FieldHoyolabLogin, user.FieldAvatarURL, user.FieldSearchBlockReason, user.FieldAccountBlockReason:
+		case user.FieldUsername, user.FieldEmail, user.FieldPassword, user.FieldHardwareID, user.FieldHardwareIDFingerprint, user.FieldTotpSecret, user.FieldGenshinUID, user.FieldHoyolabLogin, user.FieldAvatarURL, user.FieldTitle, user.FieldSearchBlockReason, user.FieldAccountBlockReason:
 			values[i] = new(sql.NullString)
-		case user.FieldLoginAt, user.FieldCreatedAt, user.FieldSearchBlockedUntil, user.FieldAccountBlockedUntil:
+		case user.FieldTotpEnabledAt, user.FieldLoginAt, user.FieldCreatedAt, user.FieldSearchBlockedUntil, user.FieldAccountBlockedUntil, user.FieldLoginLockedUntil, user.FieldDeletionScheduledAt, user.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -230,6 +387,27 @@ func (u *User) assignValues(columns []string, values []any) error {
 				u.HardwareID = new(string)
 				*u.HardwareID = value.String
 			}
+		case user.FieldHardwareIDFingerprint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hardware_id_fingerprint", values[i])
+			} else if value.Valid {
+				u.HardwareIDFingerprint = new(string)
+				*u.HardwareIDFingerprint = value.String
+			}
+		case user.FieldTotpSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_secret", values[i])
+			} else if value.Valid {
+				u.TotpSecret = new(string)
+				*u.TotpSecret = value.String
+			}
+		case user.FieldTotpEnabledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_enabled_at", values[i])
+			} else if value.Valid {
+				u.TotpEnabledAt = new(time.Time)
+				*u.TotpEnabledAt = value.Time
+			}
 		case user.FieldAccessLevel:
 			if value, ok := values[i].(*access_level.AccessLevel); !ok {
 				return fmt.Errorf("unexpected type %T for field access_level", values[i])
@@ -271,6 +449,13 @@ func (u *User) assignValues(columns []string, values []any) error {
 				u.AvatarURL = new(string)
 				*u.AvatarURL = value.String
 			}
+		case user.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				u.Title = new(string)
+				*u.Title = value.String
+			}
 		case user.FieldInvitesEnabled:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field invites_enabled", values[i])
@@ -335,6 +520,27 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.AccountBlockedLevel = int(value.Int64)
 			}
+		case user.FieldLoginLockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field login_locked_until", values[i])
+			} else if value.Valid {
+				u.LoginLockedUntil = new(time.Time)
+				*u.LoginLockedUntil = value.Time
+			}
+		case user.FieldDeletionScheduledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deletion_scheduled_at", values[i])
+			} else if value.Valid {
+				u.DeletionScheduledAt = new(time.Time)
+				*u.DeletionScheduledAt = value.Time
+			}
+		case user.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				u.DeletedAt = new(time.Time)
+				*u.DeletedAt = value.Time
+			}
 		default:
 			u.selectValues.Set(columns[i], values[i])
 		}
@@ -388,6 +594,71 @@ func (u *User) QueryBalance() *UserBalanceQuery {
 	return NewUserClient(u.config).QueryBalance(u)
 }
 
+// QueryCollectionCompletions queries the "collection_completions" edge of the User entity.
+func (u *User) QueryCollectionCompletions() *CollectionCompletionQuery {
+	return NewUserClient(u.config).QueryCollectionCompletions(u)
+}
+
+// QuerySeasonPasses queries the "season_passes" edge of the User entity.
+func (u *User) QuerySeasonPasses() *SeasonPassQuery {
+	return NewUserClient(u.config).QuerySeasonPasses(u)
+}
+
+// QuerySeasonRewardClaims queries the "season_reward_claims" edge of the User entity.
+func (u *User) QuerySeasonRewardClaims() *SeasonRewardClaimQuery {
+	return NewUserClient(u.config).QuerySeasonRewardClaims(u)
+}
+
+// QueryEmailHistory queries the "email_history" edge of the User entity.
+func (u *User) QueryEmailHistory() *EmailHistoryQuery {
+	return NewUserClient(u.config).QueryEmailHistory(u)
+}
+
+// QueryUsernameHistory queries the "username_history" edge of the User entity.
+func (u *User) QueryUsernameHistory() *UsernameHistoryQuery {
+	return NewUserClient(u.config).QueryUsernameHistory(u)
+}
+
+// QueryPasswordHistory queries the "password_history" edge of the User entity.
+func (u *User) QueryPasswordHistory() *PasswordHistoryQuery {
+	return NewUserClient(u.config).QueryPasswordHistory(u)
+}
+
+// QueryLoginHistory queries the "login_history" edge of the User entity.
+func (u *User) QueryLoginHistory() *LoginHistoryQuery {
+	return NewUserClient(u.config).QueryLoginHistory(u)
+}
+
+// QueryExternalIdentities queries the "external_identities" edge of the User entity.
+func (u *User) QueryExternalIdentities() *ExternalIdentityQuery {
+	return NewUserClient(u.config).QueryExternalIdentities(u)
+}
+
+// QueryAPITokens queries the "api_tokens" edge of the User entity.
+func (u *User) QueryAPITokens() *APITokenQuery {
+	return NewUserClient(u.config).QueryAPITokens(u)
+}
+
+// QueryRecoveryCodes queries the "recovery_codes" edge of the User entity.
+func (u *User) QueryRecoveryCodes() *RecoveryCodeQuery {
+	return NewUserClient(u.config).QueryRecoveryCodes(u)
+}
+
+// QueryHardwareIDResets queries the "hardware_id_resets" edge of the User entity.
+func (u *User) QueryHardwareIDResets() *HardwareIDResetQuery {
+	return NewUserClient(u.config).QueryHardwareIDResets(u)
+}
+
+// QuerySanctions queries the "sanctions" edge of the User entity.
+func (u *User) QuerySanctions() *SanctionQuery {
+	return NewUserClient(u.config).QuerySanctions(u)
+}
+
+// QueryRoles queries the "roles" edge of the User entity.
+func (u *User) QueryRoles() *RoleQuery {
+	return NewUserClient(u.config).QueryRoles(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -400,7 +671,7 @@ func (u *User) Update() *UserUpdateOne {
 func (u *User) Unwrap() *User {
 	_tx, ok := u.config.driver.(*txDriver)
 	if !ok {
-		panic("ent: UserDTO is not a transactional entity")
+		panic("ent: User is not a transactional entity")
 	}
 	u.config.driver = _tx.drv
 	return u
@@ -409,7 +680,7 @@ func (u *User) Unwrap() *User {
 // String implements the fmt.Stringer.
 func (u *User) String() string {
 	var builder strings.Builder
-	builder.WriteString("UserDTO(")
+	builder.WriteString("User(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", u.ID))
 	builder.WriteString("username=")
 	builder.WriteString(u.Username)
@@ -423,6 +694,18 @@ func (u *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("hardware_id=<sensitive>")
 	builder.WriteString(", ")
+	if v := u.HardwareIDFingerprint; v != nil {
+		builder.WriteString("hardware_id_fingerprint=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("totp_secret=<sensitive>")
+	builder.WriteString(", ")
+	if v := u.TotpEnabledAt; v != nil {
+		builder.WriteString("totp_enabled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("access_level=")
 	builder.WriteString(fmt.Sprintf("%v", u.AccessLevel))
 	builder.WriteString(", ")
@@ -451,6 +734,11 @@ func (u *User) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := u.Title; v != nil {
+		builder.WriteString("title=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("invites_enabled=")
 	builder.WriteString(fmt.Sprintf("%v", u.InvitesEnabled))
 	builder.WriteString(", ")
@@ -488,6 +776,21 @@ func (u *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("account_blocked_level=")
 	builder.WriteString(fmt.Sprintf("%v", u.AccountBlockedLevel))
+	builder.WriteString(", ")
+	if v := u.LoginLockedUntil; v != nil {
+		builder.WriteString("login_locked_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := u.DeletionScheduledAt; v != nil {
+		builder.WriteString("deletion_scheduled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := u.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -23,6 +23,12 @@ const (
 	FieldPassword = "password"
 	// FieldHardwareID holds the string denoting the hardware_id field in the database.
 	FieldHardwareID = "hardware_id"
+	// FieldHardwareIDFingerprint holds the string denoting the hardware_id_fingerprint field in the database.
+	FieldHardwareIDFingerprint = "hardware_id_fingerprint"
+	// FieldTotpSecret holds the string denoting the totp_secret field in the database.
+	FieldTotpSecret = "totp_secret"
+	// FieldTotpEnabledAt holds the string denoting the totp_enabled_at field in the database.
+	FieldTotpEnabledAt = "totp_enabled_at"
 	// FieldAccessLevel holds the string denoting the access_level field in the database.
 	FieldAccessLevel = "access_level"
 	// FieldGenshinUID holds the string denoting the genshin_uid field in the database.
@@ -35,6 +41,8 @@ const (
 	FieldCurrentItemInProfileID = "current_item_in_profile_id"
 	// FieldAvatarURL holds the string denoting the avatar_url field in the database.
 	FieldAvatarURL = "avatar_url"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
 	// FieldInvitesEnabled holds the string denoting the invites_enabled field in the database.
 	FieldInvitesEnabled = "invites_enabled"
 	// FieldLoginAt holds the string denoting the login_at field in the database.
@@ -55,6 +63,12 @@ const (
 	FieldAccountBlockReason = "account_block_reason"
 	// FieldAccountBlockedLevel holds the string denoting the account_blocked_level field in the database.
 	FieldAccountBlockedLevel = "account_blocked_level"
+	// FieldLoginLockedUntil holds the string denoting the login_locked_until field in the database.
+	FieldLoginLockedUntil = "login_locked_until"
+	// FieldDeletionScheduledAt holds the string denoting the deletion_scheduled_at field in the database.
+	FieldDeletionScheduledAt = "deletion_scheduled_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// EdgeStatistics holds the string denoting the statistics edge name in mutations.
 	EdgeStatistics = "statistics"
 	// EdgeFriends holds the string denoting the friends edge name in mutations.
@@ -71,6 +85,32 @@ const (
 	EdgeCurrentMatch = "current_match"
 	// EdgeBalance holds the string denoting the balance edge name in mutations.
 	EdgeBalance = "balance"
+	// EdgeCollectionCompletions holds the string denoting the collection_completions edge name in mutations.
+	EdgeCollectionCompletions = "collection_completions"
+	// EdgeSeasonPasses holds the string denoting the season_passes edge name in mutations.
+	EdgeSeasonPasses = "season_passes"
+	// EdgeSeasonRewardClaims holds the string denoting the season_reward_claims edge name in mutations.
+	EdgeSeasonRewardClaims = "season_reward_claims"
+	// EdgeEmailHistory holds the string denoting the email_history edge name in mutations.
+	EdgeEmailHistory = "email_history"
+	// EdgeUsernameHistory holds the string denoting the username_history edge name in mutations.
+	EdgeUsernameHistory = "username_history"
+	// EdgePasswordHistory holds the string denoting the password_history edge name in mutations.
+	EdgePasswordHistory = "password_history"
+	// EdgeLoginHistory holds the string denoting the login_history edge name in mutations.
+	EdgeLoginHistory = "login_history"
+	// EdgeExternalIdentities holds the string denoting the external_identities edge name in mutations.
+	EdgeExternalIdentities = "external_identities"
+	// EdgeAPITokens holds the string denoting the api_tokens edge name in mutations.
+	EdgeAPITokens = "api_tokens"
+	// EdgeRecoveryCodes holds the string denoting the recovery_codes edge name in mutations.
+	EdgeRecoveryCodes = "recovery_codes"
+	// EdgeHardwareIDResets holds the string denoting the hardware_id_resets edge name in mutations.
+	EdgeHardwareIDResets = "hardware_id_resets"
+	// EdgeSanctions holds the string denoting the sanctions edge name in mutations.
+	EdgeSanctions = "sanctions"
+	// EdgeRoles holds the string denoting the roles edge name in mutations.
+	EdgeRoles = "roles"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// StatisticsTable is the table that holds the statistics relation/edge.
@@ -124,6 +164,95 @@ const (
 	BalanceInverseTable = "user_balances"
 	// BalanceColumn is the table column denoting the balance relation/edge.
 	BalanceColumn = "user_id"
+	// CollectionCompletionsTable is the table that holds the collection_completions relation/edge.
+	CollectionCompletionsTable = "collection_completions"
+	// CollectionCompletionsInverseTable is the table name for the CollectionCompletion entity.
+	// It exists in this package in order to avoid circular dependency with the "collectioncompletion" package.
+	CollectionCompletionsInverseTable = "collection_completions"
+	// CollectionCompletionsColumn is the table column denoting the collection_completions relation/edge.
+	CollectionCompletionsColumn = "user_id"
+	// SeasonPassesTable is the table that holds the season_passes relation/edge.
+	SeasonPassesTable = "season_passes"
+	// SeasonPassesInverseTable is the table name for the SeasonPass entity.
+	// It exists in this package in order to avoid circular dependency with the "seasonpass" package.
+	SeasonPassesInverseTable = "season_passes"
+	// SeasonPassesColumn is the table column denoting the season_passes relation/edge.
+	SeasonPassesColumn = "user_id"
+	// SeasonRewardClaimsTable is the table that holds the season_reward_claims relation/edge.
+	SeasonRewardClaimsTable = "season_reward_claims"
+	// SeasonRewardClaimsInverseTable is the table name for the SeasonRewardClaim entity.
+	// It exists in this package in order to avoid circular dependency with the "seasonrewardclaim" package.
+	SeasonRewardClaimsInverseTable = "season_reward_claims"
+	// SeasonRewardClaimsColumn is the table column denoting the season_reward_claims relation/edge.
+	SeasonRewardClaimsColumn = "user_id"
+	// EmailHistoryTable is the table that holds the email_history relation/edge.
+	EmailHistoryTable = "email_histories"
+	// EmailHistoryInverseTable is the table name for the EmailHistory entity.
+	// It exists in this package in order to avoid circular dependency with the "emailhistory" package.
+	EmailHistoryInverseTable = "email_histories"
+	// EmailHistoryColumn is the table column denoting the email_history relation/edge.
+	EmailHistoryColumn = "user_id"
+	// UsernameHistoryTable is the table that holds the username_history relation/edge.
+	UsernameHistoryTable = "username_histories"
+	// UsernameHistoryInverseTable is the table name for the UsernameHistory entity.
+	// It exists in this package in order to avoid circular dependency with the "usernamehistory" package.
+	UsernameHistoryInverseTable = "username_histories"
+	// UsernameHistoryColumn is the table column denoting the username_history relation/edge.
+	UsernameHistoryColumn = "user_id"
+	// PasswordHistoryTable is the table that holds the password_history relation/edge.
+	PasswordHistoryTable = "password_histories"
+	// PasswordHistoryInverseTable is the table name for the PasswordHistory entity.
+	// It exists in this package in order to avoid circular dependency with the "passwordhistory" package.
+	PasswordHistoryInverseTable = "password_histories"
+	// PasswordHistoryColumn is the table column denoting the password_history relation/edge.
+	PasswordHistoryColumn = "user_id"
+	// LoginHistoryTable is the table that holds the login_history relation/edge.
+	LoginHistoryTable = "login_histories"
+	// LoginHistoryInverseTable is the table name for the LoginHistory entity.
+	// It exists in this package in order to avoid circular dependency with the "loginhistory" package.
+	LoginHistoryInverseTable = "login_histories"
+	// LoginHistoryColumn is the table column denoting the login_history relation/edge.
+	LoginHistoryColumn = "user_id"
+	// ExternalIdentitiesTable is the table that holds the external_identities relation/edge.
+	ExternalIdentitiesTable = "external_identities"
+	// ExternalIdentitiesInverseTable is the table name for the ExternalIdentity entity.
+	// It exists in this package in order to avoid circular dependency with the "externalidentity" package.
+	ExternalIdentitiesInverseTable = "external_identities"
+	// ExternalIdentitiesColumn is the table column denoting the external_identities relation/edge.
+	ExternalIdentitiesColumn = "user_id"
+	// APITokensTable is the table that holds the api_tokens relation/edge.
+	APITokensTable = "api_tokens"
+	// APITokensInverseTable is the table name for the APIToken entity.
+	// It exists in this package in order to avoid circular dependency with the "apitoken" package.
+	APITokensInverseTable = "api_tokens"
+	// APITokensColumn is the table column denoting the api_tokens relation/edge.
+	APITokensColumn = "user_id"
+	// RecoveryCodesTable is the table that holds the recovery_codes relation/edge.
+	RecoveryCodesTable = "recovery_codes"
+	// RecoveryCodesInverseTable is the table name for the RecoveryCode entity.
+	// It exists in this package in order to avoid circular dependency with the "recoverycode" package.
+	RecoveryCodesInverseTable = "recovery_codes"
+	// RecoveryCodesColumn is the table column denoting the recovery_codes relation/edge.
+	RecoveryCodesColumn = "user_id"
+	// HardwareIDResetsTable is the table that holds the hardware_id_resets relation/edge.
+	HardwareIDResetsTable = "hardware_id_resets"
+	// HardwareIDResetsInverseTable is the table name for the HardwareIDReset entity.
+	// It exists in this package in order to avoid circular dependency with the "hardwareidreset" package.
+	HardwareIDResetsInverseTable = "hardware_id_resets"
+	// HardwareIDResetsColumn is the table column denoting the hardware_id_resets relation/edge.
+	HardwareIDResetsColumn = "user_id"
+	// SanctionsTable is the table that holds the sanctions relation/edge.
+	SanctionsTable = "sanctions"
+	// SanctionsInverseTable is the table name for the Sanction entity.
+	// It exists in this package in order to avoid circular dependency with the "sanction" package.
+	SanctionsInverseTable = "sanctions"
+	// SanctionsColumn is the table column denoting the sanctions relation/edge.
+	SanctionsColumn = "user_id"
+	// RolesTable is the table that holds the roles relation/edge. The primary key declared below.
+	RolesTable = "user_roles"
+	// RolesInverseTable is the table name for the Role entity.
+	// It exists in this package in order to avoid circular dependency with the "role" package.
+	RolesInverseTable = "roles"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -133,12 +262,16 @@ var Columns = []string{
 	FieldEmail,
 	FieldPassword,
 	FieldHardwareID,
+	FieldHardwareIDFingerprint,
+	FieldTotpSecret,
+	FieldTotpEnabledAt,
 	FieldAccessLevel,
 	FieldGenshinUID,
 	FieldHoyolabLogin,
 	FieldCurrentMatchID,
 	FieldCurrentItemInProfileID,
 	FieldAvatarURL,
+	FieldTitle,
 	FieldInvitesEnabled,
 	FieldLoginAt,
 	FieldLoginStreak,
@@ -149,12 +282,18 @@ var Columns = []string{
 	FieldAccountBlockedUntil,
 	FieldAccountBlockReason,
 	FieldAccountBlockedLevel,
+	FieldLoginLockedUntil,
+	FieldDeletionScheduledAt,
+	FieldDeletedAt,
 }
 
 var (
 	// FriendsPrimaryKey and FriendsColumn2 are the table columns denoting the
 	// primary key for the friends relation (M2M).
 	FriendsPrimaryKey = []string{"user_id", "friend_id"}
+	// RolesPrimaryKey and RolesColumn2 are the table columns denoting the
+	// primary key for the roles relation (M2M).
+	RolesPrimaryKey = []string{"user_id", "role_id"}
 )
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -192,7 +331,7 @@ var (
 	AccountBlockedLevelValidator func(int) error
 )
 
-// OrderOption defines the ordering options for the UserDTO queries.
+// OrderOption defines the ordering options for the User queries.
 type OrderOption func(*sql.Selector)
 
 // ByID orders the results by the id field.
@@ -220,6 +359,21 @@ func ByHardwareID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldHardwareID, opts...).ToFunc()
 }
 
+// ByHardwareIDFingerprint orders the results by the hardware_id_fingerprint field.
+func ByHardwareIDFingerprint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHardwareIDFingerprint, opts...).ToFunc()
+}
+
+// ByTotpSecret orders the results by the totp_secret field.
+func ByTotpSecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotpSecret, opts...).ToFunc()
+}
+
+// ByTotpEnabledAt orders the results by the totp_enabled_at field.
+func ByTotpEnabledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotpEnabledAt, opts...).ToFunc()
+}
+
 // ByAccessLevel orders the results by the access_level field.
 func ByAccessLevel(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAccessLevel, opts...).ToFunc()
@@ -250,6 +404,11 @@ func ByAvatarURL(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAvatarURL, opts...).ToFunc()
 }
 
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
 // ByInvitesEnabled orders the results by the invites_enabled field.
 func ByInvitesEnabled(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldInvitesEnabled, opts...).ToFunc()
@@ -300,6 +459,21 @@ func ByAccountBlockedLevel(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAccountBlockedLevel, opts...).ToFunc()
 }
 
+// ByLoginLockedUntil orders the results by the login_locked_until field.
+func ByLoginLockedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLoginLockedUntil, opts...).ToFunc()
+}
+
+// ByDeletionScheduledAt orders the results by the deletion_scheduled_at field.
+func ByDeletionScheduledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletionScheduledAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByStatisticsCount orders the results by statistics count.
 func ByStatisticsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -390,6 +564,188 @@ func ByBalanceField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newBalanceStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByCollectionCompletionsCount orders the results by collection_completions count.
+func ByCollectionCompletionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCollectionCompletionsStep(), opts...)
+	}
+}
+
+// ByCollectionCompletions orders the results by collection_completions terms.
+func ByCollectionCompletions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCollectionCompletionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySeasonPassesCount orders the results by season_passes count.
+func BySeasonPassesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSeasonPassesStep(), opts...)
+	}
+}
+
+// BySeasonPasses orders the results by season_passes terms.
+func BySeasonPasses(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSeasonPassesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySeasonRewardClaimsCount orders the results by season_reward_claims count.
+func BySeasonRewardClaimsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSeasonRewardClaimsStep(), opts...)
+	}
+}
+
+// BySeasonRewardClaims orders the results by season_reward_claims terms.
+func BySeasonRewardClaims(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSeasonRewardClaimsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEmailHistoryCount orders the results by email_history count.
+func ByEmailHistoryCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEmailHistoryStep(), opts...)
+	}
+}
+
+// ByEmailHistory orders the results by email_history terms.
+func ByEmailHistory(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEmailHistoryStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByUsernameHistoryCount orders the results by username_history count.
+func ByUsernameHistoryCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newUsernameHistoryStep(), opts...)
+	}
+}
+
+// ByUsernameHistory orders the results by username_history terms.
+func ByUsernameHistory(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUsernameHistoryStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPasswordHistoryCount orders the results by password_history count.
+func ByPasswordHistoryCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPasswordHistoryStep(), opts...)
+	}
+}
+
+// ByPasswordHistory orders the results by password_history terms.
+func ByPasswordHistory(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPasswordHistoryStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLoginHistoryCount orders the results by login_history count.
+func ByLoginHistoryCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLoginHistoryStep(), opts...)
+	}
+}
+
+// ByLoginHistory orders the results by login_history terms.
+func ByLoginHistory(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLoginHistoryStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByExternalIdentitiesCount orders the results by external_identities count.
+func ByExternalIdentitiesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newExternalIdentitiesStep(), opts...)
+	}
+}
+
+// ByExternalIdentities orders the results by external_identities terms.
+func ByExternalIdentities(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newExternalIdentitiesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAPITokensCount orders the results by api_tokens count.
+func ByAPITokensCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAPITokensStep(), opts...)
+	}
+}
+
+// ByAPITokens orders the results by api_tokens terms.
+func ByAPITokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAPITokensStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRecoveryCodesCount orders the results by recovery_codes count.
+func ByRecoveryCodesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRecoveryCodesStep(), opts...)
+	}
+}
+
+// ByRecoveryCodes orders the results by recovery_codes terms.
+func ByRecoveryCodes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRecoveryCodesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByHardwareIDResetsCount orders the results by hardware_id_resets count.
+func ByHardwareIDResetsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newHardwareIDResetsStep(), opts...)
+	}
+}
+
+// ByHardwareIDResets orders the results by hardware_id_resets terms.
+func ByHardwareIDResets(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHardwareIDResetsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySanctionsCount orders the results by sanctions count.
+func BySanctionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSanctionsStep(), opts...)
+	}
+}
+
+// BySanctions orders the results by sanctions terms.
+func BySanctions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSanctionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRolesCount orders the results by roles count.
+func ByRolesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRolesStep(), opts...)
+	}
+}
+
+// ByRoles orders the results by roles terms.
+func ByRoles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRolesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newStatisticsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -446,3 +802,94 @@ func newBalanceStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, false, BalanceTable, BalanceColumn),
 	)
 }
+func newCollectionCompletionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CollectionCompletionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CollectionCompletionsTable, CollectionCompletionsColumn),
+	)
+}
+func newSeasonPassesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SeasonPassesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SeasonPassesTable, SeasonPassesColumn),
+	)
+}
+func newSeasonRewardClaimsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SeasonRewardClaimsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SeasonRewardClaimsTable, SeasonRewardClaimsColumn),
+	)
+}
+func newEmailHistoryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EmailHistoryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EmailHistoryTable, EmailHistoryColumn),
+	)
+}
+func newUsernameHistoryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UsernameHistoryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, UsernameHistoryTable, UsernameHistoryColumn),
+	)
+}
+func newPasswordHistoryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PasswordHistoryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PasswordHistoryTable, PasswordHistoryColumn),
+	)
+}
+func newLoginHistoryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LoginHistoryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LoginHistoryTable, LoginHistoryColumn),
+	)
+}
+func newExternalIdentitiesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ExternalIdentitiesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ExternalIdentitiesTable, ExternalIdentitiesColumn),
+	)
+}
+func newAPITokensStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(APITokensInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, APITokensTable, APITokensColumn),
+	)
+}
+func newRecoveryCodesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RecoveryCodesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RecoveryCodesTable, RecoveryCodesColumn),
+	)
+}
+func newHardwareIDResetsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HardwareIDResetsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, HardwareIDResetsTable, HardwareIDResetsColumn),
+	)
+}
+func newSanctionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SanctionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SanctionsTable, SanctionsColumn),
+	)
+}
+func newRolesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RolesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, RolesTable, RolesPrimaryKey...),
+	)
+}
@@ -76,6 +76,21 @@ func HardwareID(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldHardwareID, v))
 }
 
+// HardwareIDFingerprint applies equality check predicate on the "hardware_id_fingerprint" field. It's identical to HardwareIDFingerprintEQ.
+func HardwareIDFingerprint(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldHardwareIDFingerprint, v))
+}
+
+// TotpSecret applies equality check predicate on the "totp_secret" field. It's identical to TotpSecretEQ.
+func TotpSecret(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTotpSecret, v))
+}
+
+// TotpEnabledAt applies equality check predicate on the "totp_enabled_at" field. It's identical to TotpEnabledAtEQ.
+func TotpEnabledAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTotpEnabledAt, v))
+}
+
 // AccessLevel applies equality check predicate on the "access_level" field. It's identical to AccessLevelEQ.
 func AccessLevel(v access_level.AccessLevel) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldAccessLevel, v))
@@ -106,6 +121,11 @@ func AvatarURL(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldAvatarURL, v))
 }
 
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTitle, v))
+}
+
 // InvitesEnabled applies equality check predicate on the "invites_enabled" field. It's identical to InvitesEnabledEQ.
 func InvitesEnabled(v bool) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldInvitesEnabled, v))
@@ -156,6 +176,21 @@ func AccountBlockedLevel(v int) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldAccountBlockedLevel, v))
 }
 
+// LoginLockedUntil applies equality check predicate on the "login_locked_until" field. It's identical to LoginLockedUntilEQ.
+func LoginLockedUntil(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldLoginLockedUntil, v))
+}
+
+// DeletionScheduledAt applies equality check predicate on the "deletion_scheduled_at" field. It's identical to DeletionScheduledAtEQ.
+func DeletionScheduledAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldDeletionScheduledAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // UsernameEQ applies the EQ predicate on the "username" field.
 func UsernameEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldUsername, v))
@@ -436,6 +471,206 @@ func HardwareIDContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldHardwareID, v))
 }
 
+// HardwareIDFingerprintEQ applies the EQ predicate on the "hardware_id_fingerprint" field.
+func HardwareIDFingerprintEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldHardwareIDFingerprint, v))
+}
+
+// HardwareIDFingerprintNEQ applies the NEQ predicate on the "hardware_id_fingerprint" field.
+func HardwareIDFingerprintNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldHardwareIDFingerprint, v))
+}
+
+// HardwareIDFingerprintIn applies the In predicate on the "hardware_id_fingerprint" field.
+func HardwareIDFingerprintIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldHardwareIDFingerprint, vs...))
+}
+
+// HardwareIDFingerprintNotIn applies the NotIn predicate on the "hardware_id_fingerprint" field.
+func HardwareIDFingerprintNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldHardwareIDFingerprint, vs...))
+}
+
+// HardwareIDFingerprintGT applies the GT predicate on the "hardware_id_fingerprint" field.
+func HardwareIDFingerprintGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldHardwareIDFingerprint, v))
+}
+
+// HardwareIDFingerprintGTE applies the GTE predicate on the "hardware_id_fingerprint" field.
+func HardwareIDFingerprintGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldHardwareIDFingerprint, v))
+}
+
+// HardwareIDFingerprintLT applies the LT predicate on the "hardware_id_fingerprint" field.
+func HardwareIDFingerprintLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldHardwareIDFingerprint, v))
+}
+
+// HardwareIDFingerprintLTE applies the LTE predicate on the "hardware_id_fingerprint" field.
+func HardwareIDFingerprintLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldHardwareIDFingerprint, v))
+}
+
+// HardwareIDFingerprintContains applies the Contains predicate on the "hardware_id_fingerprint" field.
+func HardwareIDFingerprintContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldHardwareIDFingerprint, v))
+}
+
+// HardwareIDFingerprintHasPrefix applies the HasPrefix predicate on the "hardware_id_fingerprint" field.
+func HardwareIDFingerprintHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldHardwareIDFingerprint, v))
+}
+
+// HardwareIDFingerprintHasSuffix applies the HasSuffix predicate on the "hardware_id_fingerprint" field.
+func HardwareIDFingerprintHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldHardwareIDFingerprint, v))
+}
+
+// HardwareIDFingerprintIsNil applies the IsNil predicate on the "hardware_id_fingerprint" field.
+func HardwareIDFingerprintIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldHardwareIDFingerprint))
+}
+
+// HardwareIDFingerprintNotNil applies the NotNil predicate on the "hardware_id_fingerprint" field.
+func HardwareIDFingerprintNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldHardwareIDFingerprint))
+}
+
+// HardwareIDFingerprintEqualFold applies the EqualFold predicate on the "hardware_id_fingerprint" field.
+func HardwareIDFingerprintEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldHardwareIDFingerprint, v))
+}
+
+// HardwareIDFingerprintContainsFold applies the ContainsFold predicate on the "hardware_id_fingerprint" field.
+func HardwareIDFingerprintContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldHardwareIDFingerprint, v))
+}
+
+// TotpSecretEQ applies the EQ predicate on the "totp_secret" field.
+func TotpSecretEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTotpSecret, v))
+}
+
+// TotpSecretNEQ applies the NEQ predicate on the "totp_secret" field.
+func TotpSecretNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldTotpSecret, v))
+}
+
+// TotpSecretIn applies the In predicate on the "totp_secret" field.
+func TotpSecretIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldTotpSecret, vs...))
+}
+
+// TotpSecretNotIn applies the NotIn predicate on the "totp_secret" field.
+func TotpSecretNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldTotpSecret, vs...))
+}
+
+// TotpSecretGT applies the GT predicate on the "totp_secret" field.
+func TotpSecretGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldTotpSecret, v))
+}
+
+// TotpSecretGTE applies the GTE predicate on the "totp_secret" field.
+func TotpSecretGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldTotpSecret, v))
+}
+
+// TotpSecretLT applies the LT predicate on the "totp_secret" field.
+func TotpSecretLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldTotpSecret, v))
+}
+
+// TotpSecretLTE applies the LTE predicate on the "totp_secret" field.
+func TotpSecretLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldTotpSecret, v))
+}
+
+// TotpSecretContains applies the Contains predicate on the "totp_secret" field.
+func TotpSecretContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldTotpSecret, v))
+}
+
+// TotpSecretHasPrefix applies the HasPrefix predicate on the "totp_secret" field.
+func TotpSecretHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldTotpSecret, v))
+}
+
+// TotpSecretHasSuffix applies the HasSuffix predicate on the "totp_secret" field.
+func TotpSecretHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldTotpSecret, v))
+}
+
+// TotpSecretIsNil applies the IsNil predicate on the "totp_secret" field.
+func TotpSecretIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldTotpSecret))
+}
+
+// TotpSecretNotNil applies the NotNil predicate on the "totp_secret" field.
+func TotpSecretNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldTotpSecret))
+}
+
+// TotpSecretEqualFold applies the EqualFold predicate on the "totp_secret" field.
+func TotpSecretEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldTotpSecret, v))
+}
+
+// TotpSecretContainsFold applies the ContainsFold predicate on the "totp_secret" field.
+func TotpSecretContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldTotpSecret, v))
+}
+
+// TotpEnabledAtEQ applies the EQ predicate on the "totp_enabled_at" field.
+func TotpEnabledAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTotpEnabledAt, v))
+}
+
+// TotpEnabledAtNEQ applies the NEQ predicate on the "totp_enabled_at" field.
+func TotpEnabledAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldTotpEnabledAt, v))
+}
+
+// TotpEnabledAtIn applies the In predicate on the "totp_enabled_at" field.
+func TotpEnabledAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldTotpEnabledAt, vs...))
+}
+
+// TotpEnabledAtNotIn applies the NotIn predicate on the "totp_enabled_at" field.
+func TotpEnabledAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldTotpEnabledAt, vs...))
+}
+
+// TotpEnabledAtGT applies the GT predicate on the "totp_enabled_at" field.
+func TotpEnabledAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldTotpEnabledAt, v))
+}
+
+// TotpEnabledAtGTE applies the GTE predicate on the "totp_enabled_at" field.
+func TotpEnabledAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldTotpEnabledAt, v))
+}
+
+// TotpEnabledAtLT applies the LT predicate on the "totp_enabled_at" field.
+func TotpEnabledAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldTotpEnabledAt, v))
+}
+
+// TotpEnabledAtLTE applies the LTE predicate on the "totp_enabled_at" field.
+func TotpEnabledAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldTotpEnabledAt, v))
+}
+
+// TotpEnabledAtIsNil applies the IsNil predicate on the "totp_enabled_at" field.
+func TotpEnabledAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldTotpEnabledAt))
+}
+
+// TotpEnabledAtNotNil applies the NotNil predicate on the "totp_enabled_at" field.
+func TotpEnabledAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldTotpEnabledAt))
+}
+
 // AccessLevelEQ applies the EQ predicate on the "access_level" field.
 func AccessLevelEQ(v access_level.AccessLevel) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldAccessLevel, v))
@@ -791,6 +1026,81 @@ func AvatarURLContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldAvatarURL, v))
 }
 
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleIsNil applies the IsNil predicate on the "title" field.
+func TitleIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldTitle))
+}
+
+// TitleNotNil applies the NotNil predicate on the "title" field.
+func TitleNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldTitle))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldTitle, v))
+}
+
 // InvitesEnabledEQ applies the EQ predicate on the "invites_enabled" field.
 func InvitesEnabledEQ(v bool) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldInvitesEnabled, v))
@@ -1251,6 +1561,156 @@ func AccountBlockedLevelLTE(v int) predicate.User {
 	return predicate.User(sql.FieldLTE(FieldAccountBlockedLevel, v))
 }
 
+// LoginLockedUntilEQ applies the EQ predicate on the "login_locked_until" field.
+func LoginLockedUntilEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldLoginLockedUntil, v))
+}
+
+// LoginLockedUntilNEQ applies the NEQ predicate on the "login_locked_until" field.
+func LoginLockedUntilNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldLoginLockedUntil, v))
+}
+
+// LoginLockedUntilIn applies the In predicate on the "login_locked_until" field.
+func LoginLockedUntilIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldLoginLockedUntil, vs...))
+}
+
+// LoginLockedUntilNotIn applies the NotIn predicate on the "login_locked_until" field.
+func LoginLockedUntilNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldLoginLockedUntil, vs...))
+}
+
+// LoginLockedUntilGT applies the GT predicate on the "login_locked_until" field.
+func LoginLockedUntilGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldLoginLockedUntil, v))
+}
+
+// LoginLockedUntilGTE applies the GTE predicate on the "login_locked_until" field.
+func LoginLockedUntilGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldLoginLockedUntil, v))
+}
+
+// LoginLockedUntilLT applies the LT predicate on the "login_locked_until" field.
+func LoginLockedUntilLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldLoginLockedUntil, v))
+}
+
+// LoginLockedUntilLTE applies the LTE predicate on the "login_locked_until" field.
+func LoginLockedUntilLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldLoginLockedUntil, v))
+}
+
+// LoginLockedUntilIsNil applies the IsNil predicate on the "login_locked_until" field.
+func LoginLockedUntilIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldLoginLockedUntil))
+}
+
+// LoginLockedUntilNotNil applies the NotNil predicate on the "login_locked_until" field.
+func LoginLockedUntilNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldLoginLockedUntil))
+}
+
+// DeletionScheduledAtEQ applies the EQ predicate on the "deletion_scheduled_at" field.
+func DeletionScheduledAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldDeletionScheduledAt, v))
+}
+
+// DeletionScheduledAtNEQ applies the NEQ predicate on the "deletion_scheduled_at" field.
+func DeletionScheduledAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldDeletionScheduledAt, v))
+}
+
+// DeletionScheduledAtIn applies the In predicate on the "deletion_scheduled_at" field.
+func DeletionScheduledAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldDeletionScheduledAt, vs...))
+}
+
+// DeletionScheduledAtNotIn applies the NotIn predicate on the "deletion_scheduled_at" field.
+func DeletionScheduledAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldDeletionScheduledAt, vs...))
+}
+
+// DeletionScheduledAtGT applies the GT predicate on the "deletion_scheduled_at" field.
+func DeletionScheduledAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldDeletionScheduledAt, v))
+}
+
+// DeletionScheduledAtGTE applies the GTE predicate on the "deletion_scheduled_at" field.
+func DeletionScheduledAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldDeletionScheduledAt, v))
+}
+
+// DeletionScheduledAtLT applies the LT predicate on the "deletion_scheduled_at" field.
+func DeletionScheduledAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldDeletionScheduledAt, v))
+}
+
+// DeletionScheduledAtLTE applies the LTE predicate on the "deletion_scheduled_at" field.
+func DeletionScheduledAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldDeletionScheduledAt, v))
+}
+
+// DeletionScheduledAtIsNil applies the IsNil predicate on the "deletion_scheduled_at" field.
+func DeletionScheduledAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldDeletionScheduledAt))
+}
+
+// DeletionScheduledAtNotNil applies the NotNil predicate on the "deletion_scheduled_at" field.
+func DeletionScheduledAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldDeletionScheduledAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // HasStatistics applies the HasEdge predicate on the "statistics" edge.
 func HasStatistics() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -1435,6 +1895,305 @@ func HasBalanceWith(preds ...predicate.UserBalance) predicate.User {
 	})
 }
 
+// HasCollectionCompletions applies the HasEdge predicate on the "collection_completions" edge.
+func HasCollectionCompletions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CollectionCompletionsTable, CollectionCompletionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCollectionCompletionsWith applies the HasEdge predicate on the "collection_completions" edge with a given conditions (other predicates).
+func HasCollectionCompletionsWith(preds ...predicate.CollectionCompletion) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newCollectionCompletionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSeasonPasses applies the HasEdge predicate on the "season_passes" edge.
+func HasSeasonPasses() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SeasonPassesTable, SeasonPassesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSeasonPassesWith applies the HasEdge predicate on the "season_passes" edge with a given conditions (other predicates).
+func HasSeasonPassesWith(preds ...predicate.SeasonPass) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newSeasonPassesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSeasonRewardClaims applies the HasEdge predicate on the "season_reward_claims" edge.
+func HasSeasonRewardClaims() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SeasonRewardClaimsTable, SeasonRewardClaimsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSeasonRewardClaimsWith applies the HasEdge predicate on the "season_reward_claims" edge with a given conditions (other predicates).
+func HasSeasonRewardClaimsWith(preds ...predicate.SeasonRewardClaim) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newSeasonRewardClaimsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEmailHistory applies the HasEdge predicate on the "email_history" edge.
+func HasEmailHistory() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EmailHistoryTable, EmailHistoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEmailHistoryWith applies the HasEdge predicate on the "email_history" edge with a given conditions (other predicates).
+func HasEmailHistoryWith(preds ...predicate.EmailHistory) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newEmailHistoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUsernameHistory applies the HasEdge predicate on the "username_history" edge.
+func HasUsernameHistory() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, UsernameHistoryTable, UsernameHistoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUsernameHistoryWith applies the HasEdge predicate on the "username_history" edge with a given conditions (other predicates).
+func HasUsernameHistoryWith(preds ...predicate.UsernameHistory) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newUsernameHistoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPasswordHistory applies the HasEdge predicate on the "password_history" edge.
+func HasPasswordHistory() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PasswordHistoryTable, PasswordHistoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPasswordHistoryWith applies the HasEdge predicate on the "password_history" edge with a given conditions (other predicates).
+func HasPasswordHistoryWith(preds ...predicate.PasswordHistory) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newPasswordHistoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLoginHistory applies the HasEdge predicate on the "login_history" edge.
+func HasLoginHistory() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LoginHistoryTable, LoginHistoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLoginHistoryWith applies the HasEdge predicate on the "login_history" edge with a given conditions (other predicates).
+func HasLoginHistoryWith(preds ...predicate.LoginHistory) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newLoginHistoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasExternalIdentities applies the HasEdge predicate on the "external_identities" edge.
+func HasExternalIdentities() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ExternalIdentitiesTable, ExternalIdentitiesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasExternalIdentitiesWith applies the HasEdge predicate on the "external_identities" edge with a given conditions (other predicates).
+func HasExternalIdentitiesWith(preds ...predicate.ExternalIdentity) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newExternalIdentitiesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAPITokens applies the HasEdge predicate on the "api_tokens" edge.
+func HasAPITokens() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, APITokensTable, APITokensColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAPITokensWith applies the HasEdge predicate on the "api_tokens" edge with a given conditions (other predicates).
+func HasAPITokensWith(preds ...predicate.APIToken) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newAPITokensStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRecoveryCodes applies the HasEdge predicate on the "recovery_codes" edge.
+func HasRecoveryCodes() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RecoveryCodesTable, RecoveryCodesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRecoveryCodesWith applies the HasEdge predicate on the "recovery_codes" edge with a given conditions (other predicates).
+func HasRecoveryCodesWith(preds ...predicate.RecoveryCode) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newRecoveryCodesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasHardwareIDResets applies the HasEdge predicate on the "hardware_id_resets" edge.
+func HasHardwareIDResets() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, HardwareIDResetsTable, HardwareIDResetsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHardwareIDResetsWith applies the HasEdge predicate on the "hardware_id_resets" edge with a given conditions (other predicates).
+func HasHardwareIDResetsWith(preds ...predicate.HardwareIDReset) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newHardwareIDResetsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSanctions applies the HasEdge predicate on the "sanctions" edge.
+func HasSanctions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SanctionsTable, SanctionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSanctionsWith applies the HasEdge predicate on the "sanctions" edge with a given conditions (other predicates).
+func HasSanctionsWith(preds ...predicate.Sanction) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newSanctionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRoles applies the HasEdge predicate on the "roles" edge.
+func HasRoles() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, RolesTable, RolesPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRolesWith applies the HasEdge predicate on the "roles" edge with a given conditions (other predicates).
+func HasRolesWith(preds ...predicate.Role) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newRolesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
@@ -10,13 +10,26 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/apitoken"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/collectioncompletion"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/emailhistory"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/externalidentity"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/friendrequest"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/hardwareidreset"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/inventoryitem"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/loginhistory"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/match"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/passwordhistory"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/recoverycode"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/role"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/sanction"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/access_level"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasonpass"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasonrewardclaim"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/statistic"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/userbalance"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/usernamehistory"
 )
 
 // UserCreate is the builder for creating a User entity.
@@ -66,6 +79,48 @@ func (uc *UserCreate) SetNillableHardwareID(s *string) *UserCreate {
 	return uc
 }
 
+// SetHardwareIDFingerprint sets the "hardware_id_fingerprint" field.
+func (uc *UserCreate) SetHardwareIDFingerprint(s string) *UserCreate {
+	uc.mutation.SetHardwareIDFingerprint(s)
+	return uc
+}
+
+// SetNillableHardwareIDFingerprint sets the "hardware_id_fingerprint" field if the given value is not nil.
+func (uc *UserCreate) SetNillableHardwareIDFingerprint(s *string) *UserCreate {
+	if s != nil {
+		uc.SetHardwareIDFingerprint(*s)
+	}
+	return uc
+}
+
+// SetTotpSecret sets the "totp_secret" field.
+func (uc *UserCreate) SetTotpSecret(s string) *UserCreate {
+	uc.mutation.SetTotpSecret(s)
+	return uc
+}
+
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTotpSecret(s *string) *UserCreate {
+	if s != nil {
+		uc.SetTotpSecret(*s)
+	}
+	return uc
+}
+
+// SetTotpEnabledAt sets the "totp_enabled_at" field.
+func (uc *UserCreate) SetTotpEnabledAt(t time.Time) *UserCreate {
+	uc.mutation.SetTotpEnabledAt(t)
+	return uc
+}
+
+// SetNillableTotpEnabledAt sets the "totp_enabled_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTotpEnabledAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetTotpEnabledAt(*t)
+	}
+	return uc
+}
+
 // SetAccessLevel sets the "access_level" field.
 func (uc *UserCreate) SetAccessLevel(all access_level.AccessLevel) *UserCreate {
 	uc.mutation.SetAccessLevel(all)
@@ -150,6 +205,20 @@ func (uc *UserCreate) SetNillableAvatarURL(s *string) *UserCreate {
 	return uc
 }
 
+// SetTitle sets the "title" field.
+func (uc *UserCreate) SetTitle(s string) *UserCreate {
+	uc.mutation.SetTitle(s)
+	return uc
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTitle(s *string) *UserCreate {
+	if s != nil {
+		uc.SetTitle(*s)
+	}
+	return uc
+}
+
 // SetInvitesEnabled sets the "invites_enabled" field.
 func (uc *UserCreate) SetInvitesEnabled(b bool) *UserCreate {
 	uc.mutation.SetInvitesEnabled(b)
@@ -290,6 +359,48 @@ func (uc *UserCreate) SetNillableAccountBlockedLevel(i *int) *UserCreate {
 	return uc
 }
 
+// SetLoginLockedUntil sets the "login_locked_until" field.
+func (uc *UserCreate) SetLoginLockedUntil(t time.Time) *UserCreate {
+	uc.mutation.SetLoginLockedUntil(t)
+	return uc
+}
+
+// SetNillableLoginLockedUntil sets the "login_locked_until" field if the given value is not nil.
+func (uc *UserCreate) SetNillableLoginLockedUntil(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetLoginLockedUntil(*t)
+	}
+	return uc
+}
+
+// SetDeletionScheduledAt sets the "deletion_scheduled_at" field.
+func (uc *UserCreate) SetDeletionScheduledAt(t time.Time) *UserCreate {
+	uc.mutation.SetDeletionScheduledAt(t)
+	return uc
+}
+
+// SetNillableDeletionScheduledAt sets the "deletion_scheduled_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableDeletionScheduledAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetDeletionScheduledAt(*t)
+	}
+	return uc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (uc *UserCreate) SetDeletedAt(t time.Time) *UserCreate {
+	uc.mutation.SetDeletedAt(t)
+	return uc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableDeletedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetDeletedAt(*t)
+	}
+	return uc
+}
+
 // SetID sets the "id" field.
 func (uc *UserCreate) SetID(i int) *UserCreate {
 	uc.mutation.SetID(i)
@@ -414,6 +525,201 @@ func (uc *UserCreate) SetBalance(u *UserBalance) *UserCreate {
 	return uc.SetBalanceID(u.ID)
 }
 
+// AddCollectionCompletionIDs adds the "collection_completions" edge to the CollectionCompletion entity by IDs.
+func (uc *UserCreate) AddCollectionCompletionIDs(ids ...int) *UserCreate {
+	uc.mutation.AddCollectionCompletionIDs(ids...)
+	return uc
+}
+
+// AddCollectionCompletions adds the "collection_completions" edges to the CollectionCompletion entity.
+func (uc *UserCreate) AddCollectionCompletions(c ...*CollectionCompletion) *UserCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uc.AddCollectionCompletionIDs(ids...)
+}
+
+// AddSeasonPassIDs adds the "season_passes" edge to the SeasonPass entity by IDs.
+func (uc *UserCreate) AddSeasonPassIDs(ids ...int) *UserCreate {
+	uc.mutation.AddSeasonPassIDs(ids...)
+	return uc
+}
+
+// AddSeasonPasses adds the "season_passes" edges to the SeasonPass entity.
+func (uc *UserCreate) AddSeasonPasses(s ...*SeasonPass) *UserCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uc.AddSeasonPassIDs(ids...)
+}
+
+// AddSeasonRewardClaimIDs adds the "season_reward_claims" edge to the SeasonRewardClaim entity by IDs.
+func (uc *UserCreate) AddSeasonRewardClaimIDs(ids ...int) *UserCreate {
+	uc.mutation.AddSeasonRewardClaimIDs(ids...)
+	return uc
+}
+
+// AddSeasonRewardClaims adds the "season_reward_claims" edges to the SeasonRewardClaim entity.
+func (uc *UserCreate) AddSeasonRewardClaims(s ...*SeasonRewardClaim) *UserCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uc.AddSeasonRewardClaimIDs(ids...)
+}
+
+// AddEmailHistoryIDs adds the "email_history" edge to the EmailHistory entity by IDs.
+func (uc *UserCreate) AddEmailHistoryIDs(ids ...int) *UserCreate {
+	uc.mutation.AddEmailHistoryIDs(ids...)
+	return uc
+}
+
+// AddEmailHistory adds the "email_history" edges to the EmailHistory entity.
+func (uc *UserCreate) AddEmailHistory(e ...*EmailHistory) *UserCreate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uc.AddEmailHistoryIDs(ids...)
+}
+
+// AddUsernameHistoryIDs adds the "username_history" edge to the UsernameHistory entity by IDs.
+func (uc *UserCreate) AddUsernameHistoryIDs(ids ...int) *UserCreate {
+	uc.mutation.AddUsernameHistoryIDs(ids...)
+	return uc
+}
+
+// AddUsernameHistory adds the "username_history" edges to the UsernameHistory entity.
+func (uc *UserCreate) AddUsernameHistory(u ...*UsernameHistory) *UserCreate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uc.AddUsernameHistoryIDs(ids...)
+}
+
+// AddPasswordHistoryIDs adds the "password_history" edge to the PasswordHistory entity by IDs.
+func (uc *UserCreate) AddPasswordHistoryIDs(ids ...int) *UserCreate {
+	uc.mutation.AddPasswordHistoryIDs(ids...)
+	return uc
+}
+
+// AddPasswordHistory adds the "password_history" edges to the PasswordHistory entity.
+func (uc *UserCreate) AddPasswordHistory(p ...*PasswordHistory) *UserCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uc.AddPasswordHistoryIDs(ids...)
+}
+
+// AddLoginHistoryIDs adds the "login_history" edge to the LoginHistory entity by IDs.
+func (uc *UserCreate) AddLoginHistoryIDs(ids ...int) *UserCreate {
+	uc.mutation.AddLoginHistoryIDs(ids...)
+	return uc
+}
+
+// AddLoginHistory adds the "login_history" edges to the LoginHistory entity.
+func (uc *UserCreate) AddLoginHistory(l ...*LoginHistory) *UserCreate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return uc.AddLoginHistoryIDs(ids...)
+}
+
+// AddExternalIdentityIDs adds the "external_identities" edge to the ExternalIdentity entity by IDs.
+func (uc *UserCreate) AddExternalIdentityIDs(ids ...int) *UserCreate {
+	uc.mutation.AddExternalIdentityIDs(ids...)
+	return uc
+}
+
+// AddExternalIdentities adds the "external_identities" edges to the ExternalIdentity entity.
+func (uc *UserCreate) AddExternalIdentities(e ...*ExternalIdentity) *UserCreate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uc.AddExternalIdentityIDs(ids...)
+}
+
+// AddAPITokenIDs adds the "api_tokens" edge to the APIToken entity by IDs.
+func (uc *UserCreate) AddAPITokenIDs(ids ...int) *UserCreate {
+	uc.mutation.AddAPITokenIDs(ids...)
+	return uc
+}
+
+// AddAPITokens adds the "api_tokens" edges to the APIToken entity.
+func (uc *UserCreate) AddAPITokens(a ...*APIToken) *UserCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uc.AddAPITokenIDs(ids...)
+}
+
+// AddRecoveryCodeIDs adds the "recovery_codes" edge to the RecoveryCode entity by IDs.
+func (uc *UserCreate) AddRecoveryCodeIDs(ids ...int) *UserCreate {
+	uc.mutation.AddRecoveryCodeIDs(ids...)
+	return uc
+}
+
+// AddRecoveryCodes adds the "recovery_codes" edges to the RecoveryCode entity.
+func (uc *UserCreate) AddRecoveryCodes(r ...*RecoveryCode) *UserCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uc.AddRecoveryCodeIDs(ids...)
+}
+
+// AddHardwareIDResetIDs adds the "hardware_id_resets" edge to the HardwareIDReset entity by IDs.
+func (uc *UserCreate) AddHardwareIDResetIDs(ids ...int) *UserCreate {
+	uc.mutation.AddHardwareIDResetIDs(ids...)
+	return uc
+}
+
+// AddHardwareIDResets adds the "hardware_id_resets" edges to the HardwareIDReset entity.
+func (uc *UserCreate) AddHardwareIDResets(h ...*HardwareIDReset) *UserCreate {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return uc.AddHardwareIDResetIDs(ids...)
+}
+
+// AddSanctionIDs adds the "sanctions" edge to the Sanction entity by IDs.
+func (uc *UserCreate) AddSanctionIDs(ids ...int) *UserCreate {
+	uc.mutation.AddSanctionIDs(ids...)
+	return uc
+}
+
+// AddSanctions adds the "sanctions" edges to the Sanction entity.
+func (uc *UserCreate) AddSanctions(s ...*Sanction) *UserCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uc.AddSanctionIDs(ids...)
+}
+
+// AddRoleIDs adds the "roles" edge to the Role entity by IDs.
+func (uc *UserCreate) AddRoleIDs(ids ...int) *UserCreate {
+	uc.mutation.AddRoleIDs(ids...)
+	return uc
+}
+
+// AddRoles adds the "roles" edges to the Role entity.
+func (uc *UserCreate) AddRoles(r ...*Role) *UserCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uc.AddRoleIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -482,50 +788,50 @@ func (uc *UserCreate) defaults() {
 // check runs all checks and user-defined validators on the builder.
 func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.Username(); !ok {
-		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "UserDTO.username"`)}
+		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "User.username"`)}
 	}
 	if v, ok := uc.mutation.Username(); ok {
 		if err := user.UsernameValidator(v); err != nil {
-			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "UserDTO.username": %w`, err)}
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "User.username": %w`, err)}
 		}
 	}
 	if _, ok := uc.mutation.Password(); !ok {
-		return &ValidationError{Name: "password", err: errors.New(`ent: missing required field "UserDTO.password"`)}
+		return &ValidationError{Name: "password", err: errors.New(`ent: missing required field "User.password"`)}
 	}
 	if v, ok := uc.mutation.Password(); ok {
 		if err := user.PasswordValidator(v); err != nil {
-			return &ValidationError{Name: "password", err: fmt.Errorf(`ent: validator failed for field "UserDTO.password": %w`, err)}
+			return &ValidationError{Name: "password", err: fmt.Errorf(`ent: validator failed for field "User.password": %w`, err)}
 		}
 	}
 	if _, ok := uc.mutation.AccessLevel(); !ok {
-		return &ValidationError{Name: "access_level", err: errors.New(`ent: missing required field "UserDTO.access_level"`)}
+		return &ValidationError{Name: "access_level", err: errors.New(`ent: missing required field "User.access_level"`)}
 	}
 	if _, ok := uc.mutation.InvitesEnabled(); !ok {
-		return &ValidationError{Name: "invites_enabled", err: errors.New(`ent: missing required field "UserDTO.invites_enabled"`)}
+		return &ValidationError{Name: "invites_enabled", err: errors.New(`ent: missing required field "User.invites_enabled"`)}
 	}
 	if _, ok := uc.mutation.LoginAt(); !ok {
-		return &ValidationError{Name: "login_at", err: errors.New(`ent: missing required field "UserDTO.login_at"`)}
+		return &ValidationError{Name: "login_at", err: errors.New(`ent: missing required field "User.login_at"`)}
 	}
 	if _, ok := uc.mutation.LoginStreak(); !ok {
-		return &ValidationError{Name: "login_streak", err: errors.New(`ent: missing required field "UserDTO.login_streak"`)}
+		return &ValidationError{Name: "login_streak", err: errors.New(`ent: missing required field "User.login_streak"`)}
 	}
 	if _, ok := uc.mutation.CreatedAt(); !ok {
-		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserDTO.created_at"`)}
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "User.created_at"`)}
 	}
 	if _, ok := uc.mutation.SearchBlockedLevel(); !ok {
-		return &ValidationError{Name: "search_blocked_level", err: errors.New(`ent: missing required field "UserDTO.search_blocked_level"`)}
+		return &ValidationError{Name: "search_blocked_level", err: errors.New(`ent: missing required field "User.search_blocked_level"`)}
 	}
 	if v, ok := uc.mutation.SearchBlockedLevel(); ok {
 		if err := user.SearchBlockedLevelValidator(v); err != nil {
-			return &ValidationError{Name: "search_blocked_level", err: fmt.Errorf(`ent: validator failed for field "UserDTO.search_blocked_level": %w`, err)}
+			return &ValidationError{Name: "search_blocked_level", err: fmt.Errorf(`ent: validator failed for field "User.search_blocked_level": %w`, err)}
 		}
 	}
 	if _, ok := uc.mutation.AccountBlockedLevel(); !ok {
-		return &ValidationError{Name: "account_blocked_level", err: errors.New(`ent: missing required field "UserDTO.account_blocked_level"`)}
+		return &ValidationError{Name: "account_blocked_level", err: errors.New(`ent: missing required field "User.account_blocked_level"`)}
 	}
 	if v, ok := uc.mutation.AccountBlockedLevel(); ok {
 		if err := user.AccountBlockedLevelValidator(v); err != nil {
-			return &ValidationError{Name: "account_blocked_level", err: fmt.Errorf(`ent: validator failed for field "UserDTO.account_blocked_level": %w`, err)}
+			return &ValidationError{Name: "account_blocked_level", err: fmt.Errorf(`ent: validator failed for field "User.account_blocked_level": %w`, err)}
 		}
 	}
 	return nil
@@ -576,6 +882,18 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldHardwareID, field.TypeString, value)
 		_node.HardwareID = &value
 	}
+	if value, ok := uc.mutation.HardwareIDFingerprint(); ok {
+		_spec.SetField(user.FieldHardwareIDFingerprint, field.TypeString, value)
+		_node.HardwareIDFingerprint = &value
+	}
+	if value, ok := uc.mutation.TotpSecret(); ok {
+		_spec.SetField(user.FieldTotpSecret, field.TypeString, value)
+		_node.TotpSecret = &value
+	}
+	if value, ok := uc.mutation.TotpEnabledAt(); ok {
+		_spec.SetField(user.FieldTotpEnabledAt, field.TypeTime, value)
+		_node.TotpEnabledAt = &value
+	}
 	if value, ok := uc.mutation.AccessLevel(); ok {
 		_spec.SetField(user.FieldAccessLevel, field.TypeString, value)
 		_node.AccessLevel = value
@@ -592,6 +910,10 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldAvatarURL, field.TypeString, value)
 		_node.AvatarURL = &value
 	}
+	if value, ok := uc.mutation.Title(); ok {
+		_spec.SetField(user.FieldTitle, field.TypeString, value)
+		_node.Title = &value
+	}
 	if value, ok := uc.mutation.InvitesEnabled(); ok {
 		_spec.SetField(user.FieldInvitesEnabled, field.TypeBool, value)
 		_node.InvitesEnabled = value
@@ -632,6 +954,18 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldAccountBlockedLevel, field.TypeInt, value)
 		_node.AccountBlockedLevel = value
 	}
+	if value, ok := uc.mutation.LoginLockedUntil(); ok {
+		_spec.SetField(user.FieldLoginLockedUntil, field.TypeTime, value)
+		_node.LoginLockedUntil = &value
+	}
+	if value, ok := uc.mutation.DeletionScheduledAt(); ok {
+		_spec.SetField(user.FieldDeletionScheduledAt, field.TypeTime, value)
+		_node.DeletionScheduledAt = &value
+	}
+	if value, ok := uc.mutation.DeletedAt(); ok {
+		_spec.SetField(user.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if nodes := uc.mutation.StatisticsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -762,6 +1096,214 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.CollectionCompletionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CollectionCompletionsTable,
+			Columns: []string{user.CollectionCompletionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectioncompletion.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.SeasonPassesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SeasonPassesTable,
+			Columns: []string{user.SeasonPassesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasonpass.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.SeasonRewardClaimsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SeasonRewardClaimsTable,
+			Columns: []string{user.SeasonRewardClaimsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasonrewardclaim.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.EmailHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailHistoryTable,
+			Columns: []string{user.EmailHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailhistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.UsernameHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsernameHistoryTable,
+			Columns: []string{user.UsernameHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usernamehistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.PasswordHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasswordHistoryTable,
+			Columns: []string{user.PasswordHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(passwordhistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.LoginHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LoginHistoryTable,
+			Columns: []string{user.LoginHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loginhistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.ExternalIdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ExternalIdentitiesTable,
+			Columns: []string{user.ExternalIdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(externalidentity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.APITokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APITokensTable,
+			Columns: []string{user.APITokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apitoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.RecoveryCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RecoveryCodesTable,
+			Columns: []string{user.RecoveryCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recoverycode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.HardwareIDResetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HardwareIDResetsTable,
+			Columns: []string{user.HardwareIDResetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hardwareidreset.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.SanctionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SanctionsTable,
+			Columns: []string{user.SanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.RolesTable,
+			Columns: user.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
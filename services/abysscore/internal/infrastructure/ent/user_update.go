@@ -11,14 +11,27 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/apitoken"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/collectioncompletion"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/emailhistory"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/externalidentity"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/friendrequest"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/hardwareidreset"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/inventoryitem"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/loginhistory"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/match"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/passwordhistory"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/recoverycode"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/role"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/sanction"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/access_level"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasonpass"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasonrewardclaim"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/statistic"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/userbalance"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/usernamehistory"
 )
 
 // UserUpdate is the builder for updating User entities.
@@ -102,6 +115,66 @@ func (uu *UserUpdate) ClearHardwareID() *UserUpdate {
 	return uu
 }
 
+// SetHardwareIDFingerprint sets the "hardware_id_fingerprint" field.
+func (uu *UserUpdate) SetHardwareIDFingerprint(s string) *UserUpdate {
+	uu.mutation.SetHardwareIDFingerprint(s)
+	return uu
+}
+
+// SetNillableHardwareIDFingerprint sets the "hardware_id_fingerprint" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableHardwareIDFingerprint(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetHardwareIDFingerprint(*s)
+	}
+	return uu
+}
+
+// ClearHardwareIDFingerprint clears the value of the "hardware_id_fingerprint" field.
+func (uu *UserUpdate) ClearHardwareIDFingerprint() *UserUpdate {
+	uu.mutation.ClearHardwareIDFingerprint()
+	return uu
+}
+
+// SetTotpSecret sets the "totp_secret" field.
+func (uu *UserUpdate) SetTotpSecret(s string) *UserUpdate {
+	uu.mutation.SetTotpSecret(s)
+	return uu
+}
+
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableTotpSecret(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetTotpSecret(*s)
+	}
+	return uu
+}
+
+// ClearTotpSecret clears the value of the "totp_secret" field.
+func (uu *UserUpdate) ClearTotpSecret() *UserUpdate {
+	uu.mutation.ClearTotpSecret()
+	return uu
+}
+
+// SetTotpEnabledAt sets the "totp_enabled_at" field.
+func (uu *UserUpdate) SetTotpEnabledAt(t time.Time) *UserUpdate {
+	uu.mutation.SetTotpEnabledAt(t)
+	return uu
+}
+
+// SetNillableTotpEnabledAt sets the "totp_enabled_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableTotpEnabledAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetTotpEnabledAt(*t)
+	}
+	return uu
+}
+
+// ClearTotpEnabledAt clears the value of the "totp_enabled_at" field.
+func (uu *UserUpdate) ClearTotpEnabledAt() *UserUpdate {
+	uu.mutation.ClearTotpEnabledAt()
+	return uu
+}
+
 // SetAccessLevel sets the "access_level" field.
 func (uu *UserUpdate) SetAccessLevel(all access_level.AccessLevel) *UserUpdate {
 	uu.mutation.SetAccessLevel(all)
@@ -216,6 +289,26 @@ func (uu *UserUpdate) ClearAvatarURL() *UserUpdate {
 	return uu
 }
 
+// SetTitle sets the "title" field.
+func (uu *UserUpdate) SetTitle(s string) *UserUpdate {
+	uu.mutation.SetTitle(s)
+	return uu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableTitle(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetTitle(*s)
+	}
+	return uu
+}
+
+// ClearTitle clears the value of the "title" field.
+func (uu *UserUpdate) ClearTitle() *UserUpdate {
+	uu.mutation.ClearTitle()
+	return uu
+}
+
 // SetInvitesEnabled sets the "invites_enabled" field.
 func (uu *UserUpdate) SetInvitesEnabled(b bool) *UserUpdate {
 	uu.mutation.SetInvitesEnabled(b)
@@ -387,6 +480,66 @@ func (uu *UserUpdate) AddAccountBlockedLevel(i int) *UserUpdate {
 	return uu
 }
 
+// SetLoginLockedUntil sets the "login_locked_until" field.
+func (uu *UserUpdate) SetLoginLockedUntil(t time.Time) *UserUpdate {
+	uu.mutation.SetLoginLockedUntil(t)
+	return uu
+}
+
+// SetNillableLoginLockedUntil sets the "login_locked_until" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableLoginLockedUntil(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetLoginLockedUntil(*t)
+	}
+	return uu
+}
+
+// ClearLoginLockedUntil clears the value of the "login_locked_until" field.
+func (uu *UserUpdate) ClearLoginLockedUntil() *UserUpdate {
+	uu.mutation.ClearLoginLockedUntil()
+	return uu
+}
+
+// SetDeletionScheduledAt sets the "deletion_scheduled_at" field.
+func (uu *UserUpdate) SetDeletionScheduledAt(t time.Time) *UserUpdate {
+	uu.mutation.SetDeletionScheduledAt(t)
+	return uu
+}
+
+// SetNillableDeletionScheduledAt sets the "deletion_scheduled_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableDeletionScheduledAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetDeletionScheduledAt(*t)
+	}
+	return uu
+}
+
+// ClearDeletionScheduledAt clears the value of the "deletion_scheduled_at" field.
+func (uu *UserUpdate) ClearDeletionScheduledAt() *UserUpdate {
+	uu.mutation.ClearDeletionScheduledAt()
+	return uu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (uu *UserUpdate) SetDeletedAt(t time.Time) *UserUpdate {
+	uu.mutation.SetDeletedAt(t)
+	return uu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableDeletedAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetDeletedAt(*t)
+	}
+	return uu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (uu *UserUpdate) ClearDeletedAt() *UserUpdate {
+	uu.mutation.ClearDeletedAt()
+	return uu
+}
+
 // AddStatisticIDs adds the "statistics" edge to the Statistic entity by IDs.
 func (uu *UserUpdate) AddStatisticIDs(ids ...int) *UserUpdate {
 	uu.mutation.AddStatisticIDs(ids...)
@@ -505,6 +658,201 @@ func (uu *UserUpdate) SetBalance(u *UserBalance) *UserUpdate {
 	return uu.SetBalanceID(u.ID)
 }
 
+// AddCollectionCompletionIDs adds the "collection_completions" edge to the CollectionCompletion entity by IDs.
+func (uu *UserUpdate) AddCollectionCompletionIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddCollectionCompletionIDs(ids...)
+	return uu
+}
+
+// AddCollectionCompletions adds the "collection_completions" edges to the CollectionCompletion entity.
+func (uu *UserUpdate) AddCollectionCompletions(c ...*CollectionCompletion) *UserUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.AddCollectionCompletionIDs(ids...)
+}
+
+// AddSeasonPassIDs adds the "season_passes" edge to the SeasonPass entity by IDs.
+func (uu *UserUpdate) AddSeasonPassIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddSeasonPassIDs(ids...)
+	return uu
+}
+
+// AddSeasonPasses adds the "season_passes" edges to the SeasonPass entity.
+func (uu *UserUpdate) AddSeasonPasses(s ...*SeasonPass) *UserUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.AddSeasonPassIDs(ids...)
+}
+
+// AddSeasonRewardClaimIDs adds the "season_reward_claims" edge to the SeasonRewardClaim entity by IDs.
+func (uu *UserUpdate) AddSeasonRewardClaimIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddSeasonRewardClaimIDs(ids...)
+	return uu
+}
+
+// AddSeasonRewardClaims adds the "season_reward_claims" edges to the SeasonRewardClaim entity.
+func (uu *UserUpdate) AddSeasonRewardClaims(s ...*SeasonRewardClaim) *UserUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.AddSeasonRewardClaimIDs(ids...)
+}
+
+// AddEmailHistoryIDs adds the "email_history" edge to the EmailHistory entity by IDs.
+func (uu *UserUpdate) AddEmailHistoryIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddEmailHistoryIDs(ids...)
+	return uu
+}
+
+// AddEmailHistory adds the "email_history" edges to the EmailHistory entity.
+func (uu *UserUpdate) AddEmailHistory(e ...*EmailHistory) *UserUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uu.AddEmailHistoryIDs(ids...)
+}
+
+// AddUsernameHistoryIDs adds the "username_history" edge to the UsernameHistory entity by IDs.
+func (uu *UserUpdate) AddUsernameHistoryIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddUsernameHistoryIDs(ids...)
+	return uu
+}
+
+// AddUsernameHistory adds the "username_history" edges to the UsernameHistory entity.
+func (uu *UserUpdate) AddUsernameHistory(u ...*UsernameHistory) *UserUpdate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uu.AddUsernameHistoryIDs(ids...)
+}
+
+// AddPasswordHistoryIDs adds the "password_history" edge to the PasswordHistory entity by IDs.
+func (uu *UserUpdate) AddPasswordHistoryIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddPasswordHistoryIDs(ids...)
+	return uu
+}
+
+// AddPasswordHistory adds the "password_history" edges to the PasswordHistory entity.
+func (uu *UserUpdate) AddPasswordHistory(p ...*PasswordHistory) *UserUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uu.AddPasswordHistoryIDs(ids...)
+}
+
+// AddLoginHistoryIDs adds the "login_history" edge to the LoginHistory entity by IDs.
+func (uu *UserUpdate) AddLoginHistoryIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddLoginHistoryIDs(ids...)
+	return uu
+}
+
+// AddLoginHistory adds the "login_history" edges to the LoginHistory entity.
+func (uu *UserUpdate) AddLoginHistory(l ...*LoginHistory) *UserUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return uu.AddLoginHistoryIDs(ids...)
+}
+
+// AddExternalIdentityIDs adds the "external_identities" edge to the ExternalIdentity entity by IDs.
+func (uu *UserUpdate) AddExternalIdentityIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddExternalIdentityIDs(ids...)
+	return uu
+}
+
+// AddExternalIdentities adds the "external_identities" edges to the ExternalIdentity entity.
+func (uu *UserUpdate) AddExternalIdentities(e ...*ExternalIdentity) *UserUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uu.AddExternalIdentityIDs(ids...)
+}
+
+// AddAPITokenIDs adds the "api_tokens" edge to the APIToken entity by IDs.
+func (uu *UserUpdate) AddAPITokenIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddAPITokenIDs(ids...)
+	return uu
+}
+
+// AddAPITokens adds the "api_tokens" edges to the APIToken entity.
+func (uu *UserUpdate) AddAPITokens(a ...*APIToken) *UserUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.AddAPITokenIDs(ids...)
+}
+
+// AddRecoveryCodeIDs adds the "recovery_codes" edge to the RecoveryCode entity by IDs.
+func (uu *UserUpdate) AddRecoveryCodeIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddRecoveryCodeIDs(ids...)
+	return uu
+}
+
+// AddRecoveryCodes adds the "recovery_codes" edges to the RecoveryCode entity.
+func (uu *UserUpdate) AddRecoveryCodes(r ...*RecoveryCode) *UserUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.AddRecoveryCodeIDs(ids...)
+}
+
+// AddHardwareIDResetIDs adds the "hardware_id_resets" edge to the HardwareIDReset entity by IDs.
+func (uu *UserUpdate) AddHardwareIDResetIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddHardwareIDResetIDs(ids...)
+	return uu
+}
+
+// AddHardwareIDResets adds the "hardware_id_resets" edges to the HardwareIDReset entity.
+func (uu *UserUpdate) AddHardwareIDResets(h ...*HardwareIDReset) *UserUpdate {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return uu.AddHardwareIDResetIDs(ids...)
+}
+
+// AddSanctionIDs adds the "sanctions" edge to the Sanction entity by IDs.
+func (uu *UserUpdate) AddSanctionIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddSanctionIDs(ids...)
+	return uu
+}
+
+// AddSanctions adds the "sanctions" edges to the Sanction entity.
+func (uu *UserUpdate) AddSanctions(s ...*Sanction) *UserUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.AddSanctionIDs(ids...)
+}
+
+// AddRoleIDs adds the "roles" edge to the Role entity by IDs.
+func (uu *UserUpdate) AddRoleIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddRoleIDs(ids...)
+	return uu
+}
+
+// AddRoles adds the "roles" edges to the Role entity.
+func (uu *UserUpdate) AddRoles(r ...*Role) *UserUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.AddRoleIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -633,63 +981,336 @@ func (uu *UserUpdate) ClearBalance() *UserUpdate {
 	return uu
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
-	return withHooks(ctx, uu.sqlSave, uu.mutation, uu.hooks)
+// ClearCollectionCompletions clears all "collection_completions" edges to the CollectionCompletion entity.
+func (uu *UserUpdate) ClearCollectionCompletions() *UserUpdate {
+	uu.mutation.ClearCollectionCompletions()
+	return uu
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (uu *UserUpdate) SaveX(ctx context.Context) int {
-	affected, err := uu.Save(ctx)
-	if err != nil {
-		panic(err)
+// RemoveCollectionCompletionIDs removes the "collection_completions" edge to CollectionCompletion entities by IDs.
+func (uu *UserUpdate) RemoveCollectionCompletionIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveCollectionCompletionIDs(ids...)
+	return uu
+}
+
+// RemoveCollectionCompletions removes "collection_completions" edges to CollectionCompletion entities.
+func (uu *UserUpdate) RemoveCollectionCompletions(c ...*CollectionCompletion) *UserUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
 	}
-	return affected
+	return uu.RemoveCollectionCompletionIDs(ids...)
 }
 
-// Exec executes the query.
-func (uu *UserUpdate) Exec(ctx context.Context) error {
-	_, err := uu.Save(ctx)
-	return err
+// ClearSeasonPasses clears all "season_passes" edges to the SeasonPass entity.
+func (uu *UserUpdate) ClearSeasonPasses() *UserUpdate {
+	uu.mutation.ClearSeasonPasses()
+	return uu
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (uu *UserUpdate) ExecX(ctx context.Context) {
-	if err := uu.Exec(ctx); err != nil {
-		panic(err)
+// RemoveSeasonPassIDs removes the "season_passes" edge to SeasonPass entities by IDs.
+func (uu *UserUpdate) RemoveSeasonPassIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveSeasonPassIDs(ids...)
+	return uu
+}
+
+// RemoveSeasonPasses removes "season_passes" edges to SeasonPass entities.
+func (uu *UserUpdate) RemoveSeasonPasses(s ...*SeasonPass) *UserUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
 	}
+	return uu.RemoveSeasonPassIDs(ids...)
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (uu *UserUpdate) check() error {
-	if v, ok := uu.mutation.Username(); ok {
-		if err := user.UsernameValidator(v); err != nil {
-			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "UserDTO.username": %w`, err)}
-		}
+// ClearSeasonRewardClaims clears all "season_reward_claims" edges to the SeasonRewardClaim entity.
+func (uu *UserUpdate) ClearSeasonRewardClaims() *UserUpdate {
+	uu.mutation.ClearSeasonRewardClaims()
+	return uu
+}
+
+// RemoveSeasonRewardClaimIDs removes the "season_reward_claims" edge to SeasonRewardClaim entities by IDs.
+func (uu *UserUpdate) RemoveSeasonRewardClaimIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveSeasonRewardClaimIDs(ids...)
+	return uu
+}
+
+// RemoveSeasonRewardClaims removes "season_reward_claims" edges to SeasonRewardClaim entities.
+func (uu *UserUpdate) RemoveSeasonRewardClaims(s ...*SeasonRewardClaim) *UserUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
 	}
-	if v, ok := uu.mutation.Password(); ok {
-		if err := user.PasswordValidator(v); err != nil {
-			return &ValidationError{Name: "password", err: fmt.Errorf(`ent: validator failed for field "UserDTO.password": %w`, err)}
-		}
+	return uu.RemoveSeasonRewardClaimIDs(ids...)
+}
+
+// ClearEmailHistory clears all "email_history" edges to the EmailHistory entity.
+func (uu *UserUpdate) ClearEmailHistory() *UserUpdate {
+	uu.mutation.ClearEmailHistory()
+	return uu
+}
+
+// RemoveEmailHistoryIDs removes the "email_history" edge to EmailHistory entities by IDs.
+func (uu *UserUpdate) RemoveEmailHistoryIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveEmailHistoryIDs(ids...)
+	return uu
+}
+
+// RemoveEmailHistory removes "email_history" edges to EmailHistory entities.
+func (uu *UserUpdate) RemoveEmailHistory(e ...*EmailHistory) *UserUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
 	}
-	if v, ok := uu.mutation.SearchBlockedLevel(); ok {
-		if err := user.SearchBlockedLevelValidator(v); err != nil {
-			return &ValidationError{Name: "search_blocked_level", err: fmt.Errorf(`ent: validator failed for field "UserDTO.search_blocked_level": %w`, err)}
-		}
+	return uu.RemoveEmailHistoryIDs(ids...)
+}
+
+// ClearUsernameHistory clears all "username_history" edges to the UsernameHistory entity.
+func (uu *UserUpdate) ClearUsernameHistory() *UserUpdate {
+	uu.mutation.ClearUsernameHistory()
+	return uu
+}
+
+// RemoveUsernameHistoryIDs removes the "username_history" edge to UsernameHistory entities by IDs.
+func (uu *UserUpdate) RemoveUsernameHistoryIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveUsernameHistoryIDs(ids...)
+	return uu
+}
+
+// RemoveUsernameHistory removes "username_history" edges to UsernameHistory entities.
+func (uu *UserUpdate) RemoveUsernameHistory(u ...*UsernameHistory) *UserUpdate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
 	}
-	if v, ok := uu.mutation.AccountBlockedLevel(); ok {
-		if err := user.AccountBlockedLevelValidator(v); err != nil {
-			return &ValidationError{Name: "account_blocked_level", err: fmt.Errorf(`ent: validator failed for field "UserDTO.account_blocked_level": %w`, err)}
-		}
+	return uu.RemoveUsernameHistoryIDs(ids...)
+}
+
+// ClearPasswordHistory clears all "password_history" edges to the PasswordHistory entity.
+func (uu *UserUpdate) ClearPasswordHistory() *UserUpdate {
+	uu.mutation.ClearPasswordHistory()
+	return uu
+}
+
+// RemovePasswordHistoryIDs removes the "password_history" edge to PasswordHistory entities by IDs.
+func (uu *UserUpdate) RemovePasswordHistoryIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemovePasswordHistoryIDs(ids...)
+	return uu
+}
+
+// RemovePasswordHistory removes "password_history" edges to PasswordHistory entities.
+func (uu *UserUpdate) RemovePasswordHistory(p ...*PasswordHistory) *UserUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
-	return nil
+	return uu.RemovePasswordHistoryIDs(ids...)
 }
 
-func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	if err := uu.check(); err != nil {
-		return n, err
+// ClearLoginHistory clears all "login_history" edges to the LoginHistory entity.
+func (uu *UserUpdate) ClearLoginHistory() *UserUpdate {
+	uu.mutation.ClearLoginHistory()
+	return uu
+}
+
+// RemoveLoginHistoryIDs removes the "login_history" edge to LoginHistory entities by IDs.
+func (uu *UserUpdate) RemoveLoginHistoryIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveLoginHistoryIDs(ids...)
+	return uu
+}
+
+// RemoveLoginHistory removes "login_history" edges to LoginHistory entities.
+func (uu *UserUpdate) RemoveLoginHistory(l ...*LoginHistory) *UserUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
 	}
-	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
+	return uu.RemoveLoginHistoryIDs(ids...)
+}
+
+// ClearExternalIdentities clears all "external_identities" edges to the ExternalIdentity entity.
+func (uu *UserUpdate) ClearExternalIdentities() *UserUpdate {
+	uu.mutation.ClearExternalIdentities()
+	return uu
+}
+
+// RemoveExternalIdentityIDs removes the "external_identities" edge to ExternalIdentity entities by IDs.
+func (uu *UserUpdate) RemoveExternalIdentityIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveExternalIdentityIDs(ids...)
+	return uu
+}
+
+// RemoveExternalIdentities removes "external_identities" edges to ExternalIdentity entities.
+func (uu *UserUpdate) RemoveExternalIdentities(e ...*ExternalIdentity) *UserUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uu.RemoveExternalIdentityIDs(ids...)
+}
+
+// ClearAPITokens clears all "api_tokens" edges to the APIToken entity.
+func (uu *UserUpdate) ClearAPITokens() *UserUpdate {
+	uu.mutation.ClearAPITokens()
+	return uu
+}
+
+// RemoveAPITokenIDs removes the "api_tokens" edge to APIToken entities by IDs.
+func (uu *UserUpdate) RemoveAPITokenIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveAPITokenIDs(ids...)
+	return uu
+}
+
+// RemoveAPITokens removes "api_tokens" edges to APIToken entities.
+func (uu *UserUpdate) RemoveAPITokens(a ...*APIToken) *UserUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.RemoveAPITokenIDs(ids...)
+}
+
+// ClearRecoveryCodes clears all "recovery_codes" edges to the RecoveryCode entity.
+func (uu *UserUpdate) ClearRecoveryCodes() *UserUpdate {
+	uu.mutation.ClearRecoveryCodes()
+	return uu
+}
+
+// RemoveRecoveryCodeIDs removes the "recovery_codes" edge to RecoveryCode entities by IDs.
+func (uu *UserUpdate) RemoveRecoveryCodeIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveRecoveryCodeIDs(ids...)
+	return uu
+}
+
+// RemoveRecoveryCodes removes "recovery_codes" edges to RecoveryCode entities.
+func (uu *UserUpdate) RemoveRecoveryCodes(r ...*RecoveryCode) *UserUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.RemoveRecoveryCodeIDs(ids...)
+}
+
+// ClearHardwareIDResets clears all "hardware_id_resets" edges to the HardwareIDReset entity.
+func (uu *UserUpdate) ClearHardwareIDResets() *UserUpdate {
+	uu.mutation.ClearHardwareIDResets()
+	return uu
+}
+
+// RemoveHardwareIDResetIDs removes the "hardware_id_resets" edge to HardwareIDReset entities by IDs.
+func (uu *UserUpdate) RemoveHardwareIDResetIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveHardwareIDResetIDs(ids...)
+	return uu
+}
+
+// RemoveHardwareIDResets removes "hardware_id_resets" edges to HardwareIDReset entities.
+func (uu *UserUpdate) RemoveHardwareIDResets(h ...*HardwareIDReset) *UserUpdate {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return uu.RemoveHardwareIDResetIDs(ids...)
+}
+
+// ClearSanctions clears all "sanctions" edges to the Sanction entity.
+func (uu *UserUpdate) ClearSanctions() *UserUpdate {
+	uu.mutation.ClearSanctions()
+	return uu
+}
+
+// RemoveSanctionIDs removes the "sanctions" edge to Sanction entities by IDs.
+func (uu *UserUpdate) RemoveSanctionIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveSanctionIDs(ids...)
+	return uu
+}
+
+// RemoveSanctions removes "sanctions" edges to Sanction entities.
+func (uu *UserUpdate) RemoveSanctions(s ...*Sanction) *UserUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.RemoveSanctionIDs(ids...)
+}
+
+// ClearRoles clears all "roles" edges to the Role entity.
+func (uu *UserUpdate) ClearRoles() *UserUpdate {
+	uu.mutation.ClearRoles()
+	return uu
+}
+
+// RemoveRoleIDs removes the "roles" edge to Role entities by IDs.
+func (uu *UserUpdate) RemoveRoleIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveRoleIDs(ids...)
+	return uu
+}
+
+// RemoveRoles removes "roles" edges to Role entities.
+func (uu *UserUpdate) RemoveRoles(r ...*Role) *UserUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.RemoveRoleIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, uu.sqlSave, uu.mutation, uu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uu *UserUpdate) SaveX(ctx context.Context) int {
+	affected, err := uu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (uu *UserUpdate) Exec(ctx context.Context) error {
+	_, err := uu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uu *UserUpdate) ExecX(ctx context.Context) {
+	if err := uu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uu *UserUpdate) check() error {
+	if v, ok := uu.mutation.Username(); ok {
+		if err := user.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "User.username": %w`, err)}
+		}
+	}
+	if v, ok := uu.mutation.Password(); ok {
+		if err := user.PasswordValidator(v); err != nil {
+			return &ValidationError{Name: "password", err: fmt.Errorf(`ent: validator failed for field "User.password": %w`, err)}
+		}
+	}
+	if v, ok := uu.mutation.SearchBlockedLevel(); ok {
+		if err := user.SearchBlockedLevelValidator(v); err != nil {
+			return &ValidationError{Name: "search_blocked_level", err: fmt.Errorf(`ent: validator failed for field "User.search_blocked_level": %w`, err)}
+		}
+	}
+	if v, ok := uu.mutation.AccountBlockedLevel(); ok {
+		if err := user.AccountBlockedLevelValidator(v); err != nil {
+			return &ValidationError{Name: "account_blocked_level", err: fmt.Errorf(`ent: validator failed for field "User.account_blocked_level": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := uu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
 	if ps := uu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -715,6 +1336,24 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if uu.mutation.HardwareIDCleared() {
 		_spec.ClearField(user.FieldHardwareID, field.TypeString)
 	}
+	if value, ok := uu.mutation.HardwareIDFingerprint(); ok {
+		_spec.SetField(user.FieldHardwareIDFingerprint, field.TypeString, value)
+	}
+	if uu.mutation.HardwareIDFingerprintCleared() {
+		_spec.ClearField(user.FieldHardwareIDFingerprint, field.TypeString)
+	}
+	if value, ok := uu.mutation.TotpSecret(); ok {
+		_spec.SetField(user.FieldTotpSecret, field.TypeString, value)
+	}
+	if uu.mutation.TotpSecretCleared() {
+		_spec.ClearField(user.FieldTotpSecret, field.TypeString)
+	}
+	if value, ok := uu.mutation.TotpEnabledAt(); ok {
+		_spec.SetField(user.FieldTotpEnabledAt, field.TypeTime, value)
+	}
+	if uu.mutation.TotpEnabledAtCleared() {
+		_spec.ClearField(user.FieldTotpEnabledAt, field.TypeTime)
+	}
 	if value, ok := uu.mutation.AccessLevel(); ok {
 		_spec.SetField(user.FieldAccessLevel, field.TypeString, value)
 	}
@@ -736,6 +1375,12 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if uu.mutation.AvatarURLCleared() {
 		_spec.ClearField(user.FieldAvatarURL, field.TypeString)
 	}
+	if value, ok := uu.mutation.Title(); ok {
+		_spec.SetField(user.FieldTitle, field.TypeString, value)
+	}
+	if uu.mutation.TitleCleared() {
+		_spec.ClearField(user.FieldTitle, field.TypeString)
+	}
 	if value, ok := uu.mutation.InvitesEnabled(); ok {
 		_spec.SetField(user.FieldInvitesEnabled, field.TypeBool, value)
 	}
@@ -784,6 +1429,24 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := uu.mutation.AddedAccountBlockedLevel(); ok {
 		_spec.AddField(user.FieldAccountBlockedLevel, field.TypeInt, value)
 	}
+	if value, ok := uu.mutation.LoginLockedUntil(); ok {
+		_spec.SetField(user.FieldLoginLockedUntil, field.TypeTime, value)
+	}
+	if uu.mutation.LoginLockedUntilCleared() {
+		_spec.ClearField(user.FieldLoginLockedUntil, field.TypeTime)
+	}
+	if value, ok := uu.mutation.DeletionScheduledAt(); ok {
+		_spec.SetField(user.FieldDeletionScheduledAt, field.TypeTime, value)
+	}
+	if uu.mutation.DeletionScheduledAtCleared() {
+		_spec.ClearField(user.FieldDeletionScheduledAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.DeletedAt(); ok {
+		_spec.SetField(user.FieldDeletedAt, field.TypeTime, value)
+	}
+	if uu.mutation.DeletedAtCleared() {
+		_spec.ClearField(user.FieldDeletedAt, field.TypeTime)
+	}
 	if uu.mutation.StatisticsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1096,828 +1759,2600 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if uu.mutation.CollectionCompletionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CollectionCompletionsTable,
+			Columns: []string{user.CollectionCompletionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectioncompletion.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	uu.mutation.done = true
-	return n, nil
-}
-
-// UserUpdateOne is the builder for updating a single User entity.
-type UserUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
-}
-
-// SetUsername sets the "username" field.
-func (uuo *UserUpdateOne) SetUsername(s string) *UserUpdateOne {
-	uuo.mutation.SetUsername(s)
-	return uuo
-}
-
-// SetNillableUsername sets the "username" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableUsername(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetUsername(*s)
+	if nodes := uu.mutation.RemovedCollectionCompletionsIDs(); len(nodes) > 0 && !uu.mutation.CollectionCompletionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CollectionCompletionsTable,
+			Columns: []string{user.CollectionCompletionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectioncompletion.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// SetEmail sets the "email" field.
-func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
-	uuo.mutation.SetEmail(s)
-	return uuo
-}
-
-// SetNillableEmail sets the "email" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableEmail(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetEmail(*s)
+	if nodes := uu.mutation.CollectionCompletionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CollectionCompletionsTable,
+			Columns: []string{user.CollectionCompletionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectioncompletion.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo
-}
-
-// ClearEmail clears the value of the "email" field.
-func (uuo *UserUpdateOne) ClearEmail() *UserUpdateOne {
-	uuo.mutation.ClearEmail()
-	return uuo
-}
-
-// SetPassword sets the "password" field.
-func (uuo *UserUpdateOne) SetPassword(s string) *UserUpdateOne {
-	uuo.mutation.SetPassword(s)
-	return uuo
-}
-
-// SetNillablePassword sets the "password" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillablePassword(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetPassword(*s)
+	if uu.mutation.SeasonPassesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SeasonPassesTable,
+			Columns: []string{user.SeasonPassesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasonpass.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// SetHardwareID sets the "hardware_id" field.
-func (uuo *UserUpdateOne) SetHardwareID(s string) *UserUpdateOne {
-	uuo.mutation.SetHardwareID(s)
-	return uuo
-}
-
-// SetNillableHardwareID sets the "hardware_id" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableHardwareID(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetHardwareID(*s)
+	if nodes := uu.mutation.RemovedSeasonPassesIDs(); len(nodes) > 0 && !uu.mutation.SeasonPassesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SeasonPassesTable,
+			Columns: []string{user.SeasonPassesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasonpass.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// ClearHardwareID clears the value of the "hardware_id" field.
-func (uuo *UserUpdateOne) ClearHardwareID() *UserUpdateOne {
-	uuo.mutation.ClearHardwareID()
-	return uuo
-}
-
+	if nodes := uu.mutation.SeasonPassesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SeasonPassesTable,
+			Columns: []string{user.SeasonPassesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasonpass.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.SeasonRewardClaimsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SeasonRewardClaimsTable,
+			Columns: []string{user.SeasonRewardClaimsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasonrewardclaim.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedSeasonRewardClaimsIDs(); len(nodes) > 0 && !uu.mutation.SeasonRewardClaimsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SeasonRewardClaimsTable,
+			Columns: []string{user.SeasonRewardClaimsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasonrewardclaim.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.SeasonRewardClaimsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SeasonRewardClaimsTable,
+			Columns: []string{user.SeasonRewardClaimsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasonrewardclaim.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.EmailHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailHistoryTable,
+			Columns: []string{user.EmailHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailhistory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedEmailHistoryIDs(); len(nodes) > 0 && !uu.mutation.EmailHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailHistoryTable,
+			Columns: []string{user.EmailHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailhistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.EmailHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailHistoryTable,
+			Columns: []string{user.EmailHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailhistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.UsernameHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsernameHistoryTable,
+			Columns: []string{user.UsernameHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usernamehistory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedUsernameHistoryIDs(); len(nodes) > 0 && !uu.mutation.UsernameHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsernameHistoryTable,
+			Columns: []string{user.UsernameHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usernamehistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.UsernameHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsernameHistoryTable,
+			Columns: []string{user.UsernameHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usernamehistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.PasswordHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasswordHistoryTable,
+			Columns: []string{user.PasswordHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(passwordhistory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedPasswordHistoryIDs(); len(nodes) > 0 && !uu.mutation.PasswordHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasswordHistoryTable,
+			Columns: []string{user.PasswordHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(passwordhistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.PasswordHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasswordHistoryTable,
+			Columns: []string{user.PasswordHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(passwordhistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.LoginHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LoginHistoryTable,
+			Columns: []string{user.LoginHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loginhistory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedLoginHistoryIDs(); len(nodes) > 0 && !uu.mutation.LoginHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LoginHistoryTable,
+			Columns: []string{user.LoginHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loginhistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.LoginHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LoginHistoryTable,
+			Columns: []string{user.LoginHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loginhistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.ExternalIdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ExternalIdentitiesTable,
+			Columns: []string{user.ExternalIdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(externalidentity.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedExternalIdentitiesIDs(); len(nodes) > 0 && !uu.mutation.ExternalIdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ExternalIdentitiesTable,
+			Columns: []string{user.ExternalIdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(externalidentity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.ExternalIdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ExternalIdentitiesTable,
+			Columns: []string{user.ExternalIdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(externalidentity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.APITokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APITokensTable,
+			Columns: []string{user.APITokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apitoken.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedAPITokensIDs(); len(nodes) > 0 && !uu.mutation.APITokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APITokensTable,
+			Columns: []string{user.APITokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apitoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.APITokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APITokensTable,
+			Columns: []string{user.APITokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apitoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.RecoveryCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RecoveryCodesTable,
+			Columns: []string{user.RecoveryCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recoverycode.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedRecoveryCodesIDs(); len(nodes) > 0 && !uu.mutation.RecoveryCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RecoveryCodesTable,
+			Columns: []string{user.RecoveryCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recoverycode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RecoveryCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RecoveryCodesTable,
+			Columns: []string{user.RecoveryCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recoverycode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.HardwareIDResetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HardwareIDResetsTable,
+			Columns: []string{user.HardwareIDResetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hardwareidreset.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedHardwareIDResetsIDs(); len(nodes) > 0 && !uu.mutation.HardwareIDResetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HardwareIDResetsTable,
+			Columns: []string{user.HardwareIDResetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hardwareidreset.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.HardwareIDResetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HardwareIDResetsTable,
+			Columns: []string{user.HardwareIDResetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hardwareidreset.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.SanctionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SanctionsTable,
+			Columns: []string{user.SanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedSanctionsIDs(); len(nodes) > 0 && !uu.mutation.SanctionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SanctionsTable,
+			Columns: []string{user.SanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.SanctionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SanctionsTable,
+			Columns: []string{user.SanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.RolesTable,
+			Columns: user.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedRolesIDs(); len(nodes) > 0 && !uu.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.RolesTable,
+			Columns: user.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.RolesTable,
+			Columns: user.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uu.mutation.done = true
+	return n, nil
+}
+
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserMutation
+}
+
+// SetUsername sets the "username" field.
+func (uuo *UserUpdateOne) SetUsername(s string) *UserUpdateOne {
+	uuo.mutation.SetUsername(s)
+	return uuo
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableUsername(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetUsername(*s)
+	}
+	return uuo
+}
+
+// SetEmail sets the "email" field.
+func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
+	uuo.mutation.SetEmail(s)
+	return uuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableEmail(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetEmail(*s)
+	}
+	return uuo
+}
+
+// ClearEmail clears the value of the "email" field.
+func (uuo *UserUpdateOne) ClearEmail() *UserUpdateOne {
+	uuo.mutation.ClearEmail()
+	return uuo
+}
+
+// SetPassword sets the "password" field.
+func (uuo *UserUpdateOne) SetPassword(s string) *UserUpdateOne {
+	uuo.mutation.SetPassword(s)
+	return uuo
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePassword(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetPassword(*s)
+	}
+	return uuo
+}
+
+// SetHardwareID sets the "hardware_id" field.
+func (uuo *UserUpdateOne) SetHardwareID(s string) *UserUpdateOne {
+	uuo.mutation.SetHardwareID(s)
+	return uuo
+}
+
+// SetNillableHardwareID sets the "hardware_id" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableHardwareID(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetHardwareID(*s)
+	}
+	return uuo
+}
+
+// ClearHardwareID clears the value of the "hardware_id" field.
+func (uuo *UserUpdateOne) ClearHardwareID() *UserUpdateOne {
+	uuo.mutation.ClearHardwareID()
+	return uuo
+}
+
+// SetHardwareIDFingerprint sets the "hardware_id_fingerprint" field.
+func (uuo *UserUpdateOne) SetHardwareIDFingerprint(s string) *UserUpdateOne {
+	uuo.mutation.SetHardwareIDFingerprint(s)
+	return uuo
+}
+
+// SetNillableHardwareIDFingerprint sets the "hardware_id_fingerprint" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableHardwareIDFingerprint(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetHardwareIDFingerprint(*s)
+	}
+	return uuo
+}
+
+// ClearHardwareIDFingerprint clears the value of the "hardware_id_fingerprint" field.
+func (uuo *UserUpdateOne) ClearHardwareIDFingerprint() *UserUpdateOne {
+	uuo.mutation.ClearHardwareIDFingerprint()
+	return uuo
+}
+
+// SetTotpSecret sets the "totp_secret" field.
+func (uuo *UserUpdateOne) SetTotpSecret(s string) *UserUpdateOne {
+	uuo.mutation.SetTotpSecret(s)
+	return uuo
+}
+
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableTotpSecret(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetTotpSecret(*s)
+	}
+	return uuo
+}
+
+// ClearTotpSecret clears the value of the "totp_secret" field.
+func (uuo *UserUpdateOne) ClearTotpSecret() *UserUpdateOne {
+	uuo.mutation.ClearTotpSecret()
+	return uuo
+}
+
+// SetTotpEnabledAt sets the "totp_enabled_at" field.
+func (uuo *UserUpdateOne) SetTotpEnabledAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetTotpEnabledAt(t)
+	return uuo
+}
+
+// SetNillableTotpEnabledAt sets the "totp_enabled_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableTotpEnabledAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetTotpEnabledAt(*t)
+	}
+	return uuo
+}
+
+// ClearTotpEnabledAt clears the value of the "totp_enabled_at" field.
+func (uuo *UserUpdateOne) ClearTotpEnabledAt() *UserUpdateOne {
+	uuo.mutation.ClearTotpEnabledAt()
+	return uuo
+}
+
 // SetAccessLevel sets the "access_level" field.
 func (uuo *UserUpdateOne) SetAccessLevel(all access_level.AccessLevel) *UserUpdateOne {
 	uuo.mutation.SetAccessLevel(all)
 	return uuo
 }
 
-// SetNillableAccessLevel sets the "access_level" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableAccessLevel(all *access_level.AccessLevel) *UserUpdateOne {
-	if all != nil {
-		uuo.SetAccessLevel(*all)
+// SetNillableAccessLevel sets the "access_level" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableAccessLevel(all *access_level.AccessLevel) *UserUpdateOne {
+	if all != nil {
+		uuo.SetAccessLevel(*all)
+	}
+	return uuo
+}
+
+// SetGenshinUID sets the "genshin_uid" field.
+func (uuo *UserUpdateOne) SetGenshinUID(s string) *UserUpdateOne {
+	uuo.mutation.SetGenshinUID(s)
+	return uuo
+}
+
+// SetNillableGenshinUID sets the "genshin_uid" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableGenshinUID(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetGenshinUID(*s)
+	}
+	return uuo
+}
+
+// ClearGenshinUID clears the value of the "genshin_uid" field.
+func (uuo *UserUpdateOne) ClearGenshinUID() *UserUpdateOne {
+	uuo.mutation.ClearGenshinUID()
+	return uuo
+}
+
+// SetHoyolabLogin sets the "hoyolab_login" field.
+func (uuo *UserUpdateOne) SetHoyolabLogin(s string) *UserUpdateOne {
+	uuo.mutation.SetHoyolabLogin(s)
+	return uuo
+}
+
+// SetNillableHoyolabLogin sets the "hoyolab_login" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableHoyolabLogin(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetHoyolabLogin(*s)
+	}
+	return uuo
+}
+
+// ClearHoyolabLogin clears the value of the "hoyolab_login" field.
+func (uuo *UserUpdateOne) ClearHoyolabLogin() *UserUpdateOne {
+	uuo.mutation.ClearHoyolabLogin()
+	return uuo
+}
+
+// SetCurrentMatchID sets the "current_match_id" field.
+func (uuo *UserUpdateOne) SetCurrentMatchID(i int) *UserUpdateOne {
+	uuo.mutation.SetCurrentMatchID(i)
+	return uuo
+}
+
+// SetNillableCurrentMatchID sets the "current_match_id" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableCurrentMatchID(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetCurrentMatchID(*i)
+	}
+	return uuo
+}
+
+// ClearCurrentMatchID clears the value of the "current_match_id" field.
+func (uuo *UserUpdateOne) ClearCurrentMatchID() *UserUpdateOne {
+	uuo.mutation.ClearCurrentMatchID()
+	return uuo
+}
+
+// SetCurrentItemInProfileID sets the "current_item_in_profile_id" field.
+func (uuo *UserUpdateOne) SetCurrentItemInProfileID(i int) *UserUpdateOne {
+	uuo.mutation.SetCurrentItemInProfileID(i)
+	return uuo
+}
+
+// SetNillableCurrentItemInProfileID sets the "current_item_in_profile_id" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableCurrentItemInProfileID(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetCurrentItemInProfileID(*i)
+	}
+	return uuo
+}
+
+// ClearCurrentItemInProfileID clears the value of the "current_item_in_profile_id" field.
+func (uuo *UserUpdateOne) ClearCurrentItemInProfileID() *UserUpdateOne {
+	uuo.mutation.ClearCurrentItemInProfileID()
+	return uuo
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (uuo *UserUpdateOne) SetAvatarURL(s string) *UserUpdateOne {
+	uuo.mutation.SetAvatarURL(s)
+	return uuo
+}
+
+// SetNillableAvatarURL sets the "avatar_url" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableAvatarURL(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetAvatarURL(*s)
+	}
+	return uuo
+}
+
+// ClearAvatarURL clears the value of the "avatar_url" field.
+func (uuo *UserUpdateOne) ClearAvatarURL() *UserUpdateOne {
+	uuo.mutation.ClearAvatarURL()
+	return uuo
+}
+
+// SetTitle sets the "title" field.
+func (uuo *UserUpdateOne) SetTitle(s string) *UserUpdateOne {
+	uuo.mutation.SetTitle(s)
+	return uuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableTitle(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetTitle(*s)
+	}
+	return uuo
+}
+
+// ClearTitle clears the value of the "title" field.
+func (uuo *UserUpdateOne) ClearTitle() *UserUpdateOne {
+	uuo.mutation.ClearTitle()
+	return uuo
+}
+
+// SetInvitesEnabled sets the "invites_enabled" field.
+func (uuo *UserUpdateOne) SetInvitesEnabled(b bool) *UserUpdateOne {
+	uuo.mutation.SetInvitesEnabled(b)
+	return uuo
+}
+
+// SetNillableInvitesEnabled sets the "invites_enabled" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableInvitesEnabled(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetInvitesEnabled(*b)
+	}
+	return uuo
+}
+
+// SetLoginAt sets the "login_at" field.
+func (uuo *UserUpdateOne) SetLoginAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetLoginAt(t)
+	return uuo
+}
+
+// SetNillableLoginAt sets the "login_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableLoginAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetLoginAt(*t)
+	}
+	return uuo
+}
+
+// SetLoginStreak sets the "login_streak" field.
+func (uuo *UserUpdateOne) SetLoginStreak(i int) *UserUpdateOne {
+	uuo.mutation.ResetLoginStreak()
+	uuo.mutation.SetLoginStreak(i)
+	return uuo
+}
+
+// SetNillableLoginStreak sets the "login_streak" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableLoginStreak(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetLoginStreak(*i)
+	}
+	return uuo
+}
+
+// AddLoginStreak adds i to the "login_streak" field.
+func (uuo *UserUpdateOne) AddLoginStreak(i int) *UserUpdateOne {
+	uuo.mutation.AddLoginStreak(i)
+	return uuo
+}
+
+// SetSearchBlockedUntil sets the "search_blocked_until" field.
+func (uuo *UserUpdateOne) SetSearchBlockedUntil(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetSearchBlockedUntil(t)
+	return uuo
+}
+
+// SetNillableSearchBlockedUntil sets the "search_blocked_until" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableSearchBlockedUntil(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetSearchBlockedUntil(*t)
+	}
+	return uuo
+}
+
+// ClearSearchBlockedUntil clears the value of the "search_blocked_until" field.
+func (uuo *UserUpdateOne) ClearSearchBlockedUntil() *UserUpdateOne {
+	uuo.mutation.ClearSearchBlockedUntil()
+	return uuo
+}
+
+// SetSearchBlockReason sets the "search_block_reason" field.
+func (uuo *UserUpdateOne) SetSearchBlockReason(s string) *UserUpdateOne {
+	uuo.mutation.SetSearchBlockReason(s)
+	return uuo
+}
+
+// SetNillableSearchBlockReason sets the "search_block_reason" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableSearchBlockReason(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetSearchBlockReason(*s)
+	}
+	return uuo
+}
+
+// ClearSearchBlockReason clears the value of the "search_block_reason" field.
+func (uuo *UserUpdateOne) ClearSearchBlockReason() *UserUpdateOne {
+	uuo.mutation.ClearSearchBlockReason()
+	return uuo
+}
+
+// SetSearchBlockedLevel sets the "search_blocked_level" field.
+func (uuo *UserUpdateOne) SetSearchBlockedLevel(i int) *UserUpdateOne {
+	uuo.mutation.ResetSearchBlockedLevel()
+	uuo.mutation.SetSearchBlockedLevel(i)
+	return uuo
+}
+
+// SetNillableSearchBlockedLevel sets the "search_blocked_level" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableSearchBlockedLevel(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetSearchBlockedLevel(*i)
+	}
+	return uuo
+}
+
+// AddSearchBlockedLevel adds i to the "search_blocked_level" field.
+func (uuo *UserUpdateOne) AddSearchBlockedLevel(i int) *UserUpdateOne {
+	uuo.mutation.AddSearchBlockedLevel(i)
+	return uuo
+}
+
+// SetAccountBlockedUntil sets the "account_blocked_until" field.
+func (uuo *UserUpdateOne) SetAccountBlockedUntil(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetAccountBlockedUntil(t)
+	return uuo
+}
+
+// SetNillableAccountBlockedUntil sets the "account_blocked_until" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableAccountBlockedUntil(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetAccountBlockedUntil(*t)
+	}
+	return uuo
+}
+
+// ClearAccountBlockedUntil clears the value of the "account_blocked_until" field.
+func (uuo *UserUpdateOne) ClearAccountBlockedUntil() *UserUpdateOne {
+	uuo.mutation.ClearAccountBlockedUntil()
+	return uuo
+}
+
+// SetAccountBlockReason sets the "account_block_reason" field.
+func (uuo *UserUpdateOne) SetAccountBlockReason(s string) *UserUpdateOne {
+	uuo.mutation.SetAccountBlockReason(s)
+	return uuo
+}
+
+// SetNillableAccountBlockReason sets the "account_block_reason" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableAccountBlockReason(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetAccountBlockReason(*s)
+	}
+	return uuo
+}
+
+// ClearAccountBlockReason clears the value of the "account_block_reason" field.
+func (uuo *UserUpdateOne) ClearAccountBlockReason() *UserUpdateOne {
+	uuo.mutation.ClearAccountBlockReason()
+	return uuo
+}
+
+// SetAccountBlockedLevel sets the "account_blocked_level" field.
+func (uuo *UserUpdateOne) SetAccountBlockedLevel(i int) *UserUpdateOne {
+	uuo.mutation.ResetAccountBlockedLevel()
+	uuo.mutation.SetAccountBlockedLevel(i)
+	return uuo
+}
+
+// SetNillableAccountBlockedLevel sets the "account_blocked_level" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableAccountBlockedLevel(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetAccountBlockedLevel(*i)
+	}
+	return uuo
+}
+
+// AddAccountBlockedLevel adds i to the "account_blocked_level" field.
+func (uuo *UserUpdateOne) AddAccountBlockedLevel(i int) *UserUpdateOne {
+	uuo.mutation.AddAccountBlockedLevel(i)
+	return uuo
+}
+
+// SetLoginLockedUntil sets the "login_locked_until" field.
+func (uuo *UserUpdateOne) SetLoginLockedUntil(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetLoginLockedUntil(t)
+	return uuo
+}
+
+// SetNillableLoginLockedUntil sets the "login_locked_until" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableLoginLockedUntil(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetLoginLockedUntil(*t)
+	}
+	return uuo
+}
+
+// ClearLoginLockedUntil clears the value of the "login_locked_until" field.
+func (uuo *UserUpdateOne) ClearLoginLockedUntil() *UserUpdateOne {
+	uuo.mutation.ClearLoginLockedUntil()
+	return uuo
+}
+
+// SetDeletionScheduledAt sets the "deletion_scheduled_at" field.
+func (uuo *UserUpdateOne) SetDeletionScheduledAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetDeletionScheduledAt(t)
+	return uuo
+}
+
+// SetNillableDeletionScheduledAt sets the "deletion_scheduled_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableDeletionScheduledAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetDeletionScheduledAt(*t)
+	}
+	return uuo
+}
+
+// ClearDeletionScheduledAt clears the value of the "deletion_scheduled_at" field.
+func (uuo *UserUpdateOne) ClearDeletionScheduledAt() *UserUpdateOne {
+	uuo.mutation.ClearDeletionScheduledAt()
+	return uuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (uuo *UserUpdateOne) SetDeletedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetDeletedAt(t)
+	return uuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableDeletedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetDeletedAt(*t)
+	}
+	return uuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (uuo *UserUpdateOne) ClearDeletedAt() *UserUpdateOne {
+	uuo.mutation.ClearDeletedAt()
+	return uuo
+}
+
+// AddStatisticIDs adds the "statistics" edge to the Statistic entity by IDs.
+func (uuo *UserUpdateOne) AddStatisticIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddStatisticIDs(ids...)
+	return uuo
+}
+
+// AddStatistics adds the "statistics" edges to the Statistic entity.
+func (uuo *UserUpdateOne) AddStatistics(s ...*Statistic) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.AddStatisticIDs(ids...)
+}
+
+// AddFriendIDs adds the "friends" edge to the User entity by IDs.
+func (uuo *UserUpdateOne) AddFriendIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddFriendIDs(ids...)
+	return uuo
+}
+
+// AddFriends adds the "friends" edges to the User entity.
+func (uuo *UserUpdateOne) AddFriends(u ...*User) *UserUpdateOne {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uuo.AddFriendIDs(ids...)
+}
+
+// AddSentFriendRequestIDs adds the "sent_friend_requests" edge to the FriendRequest entity by IDs.
+func (uuo *UserUpdateOne) AddSentFriendRequestIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddSentFriendRequestIDs(ids...)
+	return uuo
+}
+
+// AddSentFriendRequests adds the "sent_friend_requests" edges to the FriendRequest entity.
+func (uuo *UserUpdateOne) AddSentFriendRequests(f ...*FriendRequest) *UserUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
 	}
+	return uuo.AddSentFriendRequestIDs(ids...)
+}
+
+// AddReceivedFriendRequestIDs adds the "received_friend_requests" edge to the FriendRequest entity by IDs.
+func (uuo *UserUpdateOne) AddReceivedFriendRequestIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddReceivedFriendRequestIDs(ids...)
 	return uuo
 }
 
-// SetGenshinUID sets the "genshin_uid" field.
-func (uuo *UserUpdateOne) SetGenshinUID(s string) *UserUpdateOne {
-	uuo.mutation.SetGenshinUID(s)
+// AddReceivedFriendRequests adds the "received_friend_requests" edges to the FriendRequest entity.
+func (uuo *UserUpdateOne) AddReceivedFriendRequests(f ...*FriendRequest) *UserUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.AddReceivedFriendRequestIDs(ids...)
+}
+
+// AddItemIDs adds the "items" edge to the InventoryItem entity by IDs.
+func (uuo *UserUpdateOne) AddItemIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddItemIDs(ids...)
 	return uuo
 }
 
-// SetNillableGenshinUID sets the "genshin_uid" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableGenshinUID(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetGenshinUID(*s)
+// AddItems adds the "items" edges to the InventoryItem entity.
+func (uuo *UserUpdateOne) AddItems(i ...*InventoryItem) *UserUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
 	}
+	return uuo.AddItemIDs(ids...)
+}
+
+// SetCurrentItemID sets the "current_item" edge to the InventoryItem entity by ID.
+func (uuo *UserUpdateOne) SetCurrentItemID(id int) *UserUpdateOne {
+	uuo.mutation.SetCurrentItemID(id)
 	return uuo
 }
 
-// ClearGenshinUID clears the value of the "genshin_uid" field.
-func (uuo *UserUpdateOne) ClearGenshinUID() *UserUpdateOne {
-	uuo.mutation.ClearGenshinUID()
+// SetNillableCurrentItemID sets the "current_item" edge to the InventoryItem entity by ID if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableCurrentItemID(id *int) *UserUpdateOne {
+	if id != nil {
+		uuo = uuo.SetCurrentItemID(*id)
+	}
+	return uuo
+}
+
+// SetCurrentItem sets the "current_item" edge to the InventoryItem entity.
+func (uuo *UserUpdateOne) SetCurrentItem(i *InventoryItem) *UserUpdateOne {
+	return uuo.SetCurrentItemID(i.ID)
+}
+
+// SetCurrentMatch sets the "current_match" edge to the Match entity.
+func (uuo *UserUpdateOne) SetCurrentMatch(m *Match) *UserUpdateOne {
+	return uuo.SetCurrentMatchID(m.ID)
+}
+
+// SetBalanceID sets the "balance" edge to the UserBalance entity by ID.
+func (uuo *UserUpdateOne) SetBalanceID(id int) *UserUpdateOne {
+	uuo.mutation.SetBalanceID(id)
+	return uuo
+}
+
+// SetNillableBalanceID sets the "balance" edge to the UserBalance entity by ID if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableBalanceID(id *int) *UserUpdateOne {
+	if id != nil {
+		uuo = uuo.SetBalanceID(*id)
+	}
+	return uuo
+}
+
+// SetBalance sets the "balance" edge to the UserBalance entity.
+func (uuo *UserUpdateOne) SetBalance(u *UserBalance) *UserUpdateOne {
+	return uuo.SetBalanceID(u.ID)
+}
+
+// AddCollectionCompletionIDs adds the "collection_completions" edge to the CollectionCompletion entity by IDs.
+func (uuo *UserUpdateOne) AddCollectionCompletionIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddCollectionCompletionIDs(ids...)
+	return uuo
+}
+
+// AddCollectionCompletions adds the "collection_completions" edges to the CollectionCompletion entity.
+func (uuo *UserUpdateOne) AddCollectionCompletions(c ...*CollectionCompletion) *UserUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.AddCollectionCompletionIDs(ids...)
+}
+
+// AddSeasonPassIDs adds the "season_passes" edge to the SeasonPass entity by IDs.
+func (uuo *UserUpdateOne) AddSeasonPassIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddSeasonPassIDs(ids...)
+	return uuo
+}
+
+// AddSeasonPasses adds the "season_passes" edges to the SeasonPass entity.
+func (uuo *UserUpdateOne) AddSeasonPasses(s ...*SeasonPass) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.AddSeasonPassIDs(ids...)
+}
+
+// AddSeasonRewardClaimIDs adds the "season_reward_claims" edge to the SeasonRewardClaim entity by IDs.
+func (uuo *UserUpdateOne) AddSeasonRewardClaimIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddSeasonRewardClaimIDs(ids...)
+	return uuo
+}
+
+// AddSeasonRewardClaims adds the "season_reward_claims" edges to the SeasonRewardClaim entity.
+func (uuo *UserUpdateOne) AddSeasonRewardClaims(s ...*SeasonRewardClaim) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.AddSeasonRewardClaimIDs(ids...)
+}
+
+// AddEmailHistoryIDs adds the "email_history" edge to the EmailHistory entity by IDs.
+func (uuo *UserUpdateOne) AddEmailHistoryIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddEmailHistoryIDs(ids...)
+	return uuo
+}
+
+// AddEmailHistory adds the "email_history" edges to the EmailHistory entity.
+func (uuo *UserUpdateOne) AddEmailHistory(e ...*EmailHistory) *UserUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uuo.AddEmailHistoryIDs(ids...)
+}
+
+// AddUsernameHistoryIDs adds the "username_history" edge to the UsernameHistory entity by IDs.
+func (uuo *UserUpdateOne) AddUsernameHistoryIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddUsernameHistoryIDs(ids...)
+	return uuo
+}
+
+// AddUsernameHistory adds the "username_history" edges to the UsernameHistory entity.
+func (uuo *UserUpdateOne) AddUsernameHistory(u ...*UsernameHistory) *UserUpdateOne {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uuo.AddUsernameHistoryIDs(ids...)
+}
+
+// AddPasswordHistoryIDs adds the "password_history" edge to the PasswordHistory entity by IDs.
+func (uuo *UserUpdateOne) AddPasswordHistoryIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddPasswordHistoryIDs(ids...)
+	return uuo
+}
+
+// AddPasswordHistory adds the "password_history" edges to the PasswordHistory entity.
+func (uuo *UserUpdateOne) AddPasswordHistory(p ...*PasswordHistory) *UserUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.AddPasswordHistoryIDs(ids...)
+}
+
+// AddLoginHistoryIDs adds the "login_history" edge to the LoginHistory entity by IDs.
+func (uuo *UserUpdateOne) AddLoginHistoryIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddLoginHistoryIDs(ids...)
+	return uuo
+}
+
+// AddLoginHistory adds the "login_history" edges to the LoginHistory entity.
+func (uuo *UserUpdateOne) AddLoginHistory(l ...*LoginHistory) *UserUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return uuo.AddLoginHistoryIDs(ids...)
+}
+
+// AddExternalIdentityIDs adds the "external_identities" edge to the ExternalIdentity entity by IDs.
+func (uuo *UserUpdateOne) AddExternalIdentityIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddExternalIdentityIDs(ids...)
+	return uuo
+}
+
+// AddExternalIdentities adds the "external_identities" edges to the ExternalIdentity entity.
+func (uuo *UserUpdateOne) AddExternalIdentities(e ...*ExternalIdentity) *UserUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uuo.AddExternalIdentityIDs(ids...)
+}
+
+// AddAPITokenIDs adds the "api_tokens" edge to the APIToken entity by IDs.
+func (uuo *UserUpdateOne) AddAPITokenIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddAPITokenIDs(ids...)
+	return uuo
+}
+
+// AddAPITokens adds the "api_tokens" edges to the APIToken entity.
+func (uuo *UserUpdateOne) AddAPITokens(a ...*APIToken) *UserUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.AddAPITokenIDs(ids...)
+}
+
+// AddRecoveryCodeIDs adds the "recovery_codes" edge to the RecoveryCode entity by IDs.
+func (uuo *UserUpdateOne) AddRecoveryCodeIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddRecoveryCodeIDs(ids...)
+	return uuo
+}
+
+// AddRecoveryCodes adds the "recovery_codes" edges to the RecoveryCode entity.
+func (uuo *UserUpdateOne) AddRecoveryCodes(r ...*RecoveryCode) *UserUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.AddRecoveryCodeIDs(ids...)
+}
+
+// AddHardwareIDResetIDs adds the "hardware_id_resets" edge to the HardwareIDReset entity by IDs.
+func (uuo *UserUpdateOne) AddHardwareIDResetIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddHardwareIDResetIDs(ids...)
+	return uuo
+}
+
+// AddHardwareIDResets adds the "hardware_id_resets" edges to the HardwareIDReset entity.
+func (uuo *UserUpdateOne) AddHardwareIDResets(h ...*HardwareIDReset) *UserUpdateOne {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return uuo.AddHardwareIDResetIDs(ids...)
+}
+
+// AddSanctionIDs adds the "sanctions" edge to the Sanction entity by IDs.
+func (uuo *UserUpdateOne) AddSanctionIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddSanctionIDs(ids...)
+	return uuo
+}
+
+// AddSanctions adds the "sanctions" edges to the Sanction entity.
+func (uuo *UserUpdateOne) AddSanctions(s ...*Sanction) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.AddSanctionIDs(ids...)
+}
+
+// AddRoleIDs adds the "roles" edge to the Role entity by IDs.
+func (uuo *UserUpdateOne) AddRoleIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddRoleIDs(ids...)
+	return uuo
+}
+
+// AddRoles adds the "roles" edges to the Role entity.
+func (uuo *UserUpdateOne) AddRoles(r ...*Role) *UserUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.AddRoleIDs(ids...)
+}
+
+// Mutation returns the UserMutation object of the builder.
+func (uuo *UserUpdateOne) Mutation() *UserMutation {
+	return uuo.mutation
+}
+
+// ClearStatistics clears all "statistics" edges to the Statistic entity.
+func (uuo *UserUpdateOne) ClearStatistics() *UserUpdateOne {
+	uuo.mutation.ClearStatistics()
+	return uuo
+}
+
+// RemoveStatisticIDs removes the "statistics" edge to Statistic entities by IDs.
+func (uuo *UserUpdateOne) RemoveStatisticIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveStatisticIDs(ids...)
+	return uuo
+}
+
+// RemoveStatistics removes "statistics" edges to Statistic entities.
+func (uuo *UserUpdateOne) RemoveStatistics(s ...*Statistic) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.RemoveStatisticIDs(ids...)
+}
+
+// ClearFriends clears all "friends" edges to the User entity.
+func (uuo *UserUpdateOne) ClearFriends() *UserUpdateOne {
+	uuo.mutation.ClearFriends()
+	return uuo
+}
+
+// RemoveFriendIDs removes the "friends" edge to User entities by IDs.
+func (uuo *UserUpdateOne) RemoveFriendIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveFriendIDs(ids...)
+	return uuo
+}
+
+// RemoveFriends removes "friends" edges to User entities.
+func (uuo *UserUpdateOne) RemoveFriends(u ...*User) *UserUpdateOne {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uuo.RemoveFriendIDs(ids...)
+}
+
+// ClearSentFriendRequests clears all "sent_friend_requests" edges to the FriendRequest entity.
+func (uuo *UserUpdateOne) ClearSentFriendRequests() *UserUpdateOne {
+	uuo.mutation.ClearSentFriendRequests()
+	return uuo
+}
+
+// RemoveSentFriendRequestIDs removes the "sent_friend_requests" edge to FriendRequest entities by IDs.
+func (uuo *UserUpdateOne) RemoveSentFriendRequestIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveSentFriendRequestIDs(ids...)
+	return uuo
+}
+
+// RemoveSentFriendRequests removes "sent_friend_requests" edges to FriendRequest entities.
+func (uuo *UserUpdateOne) RemoveSentFriendRequests(f ...*FriendRequest) *UserUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.RemoveSentFriendRequestIDs(ids...)
+}
+
+// ClearReceivedFriendRequests clears all "received_friend_requests" edges to the FriendRequest entity.
+func (uuo *UserUpdateOne) ClearReceivedFriendRequests() *UserUpdateOne {
+	uuo.mutation.ClearReceivedFriendRequests()
 	return uuo
 }
 
-// SetHoyolabLogin sets the "hoyolab_login" field.
-func (uuo *UserUpdateOne) SetHoyolabLogin(s string) *UserUpdateOne {
-	uuo.mutation.SetHoyolabLogin(s)
+// RemoveReceivedFriendRequestIDs removes the "received_friend_requests" edge to FriendRequest entities by IDs.
+func (uuo *UserUpdateOne) RemoveReceivedFriendRequestIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveReceivedFriendRequestIDs(ids...)
 	return uuo
 }
 
-// SetNillableHoyolabLogin sets the "hoyolab_login" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableHoyolabLogin(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetHoyolabLogin(*s)
+// RemoveReceivedFriendRequests removes "received_friend_requests" edges to FriendRequest entities.
+func (uuo *UserUpdateOne) RemoveReceivedFriendRequests(f ...*FriendRequest) *UserUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
 	}
-	return uuo
+	return uuo.RemoveReceivedFriendRequestIDs(ids...)
 }
 
-// ClearHoyolabLogin clears the value of the "hoyolab_login" field.
-func (uuo *UserUpdateOne) ClearHoyolabLogin() *UserUpdateOne {
-	uuo.mutation.ClearHoyolabLogin()
+// ClearItems clears all "items" edges to the InventoryItem entity.
+func (uuo *UserUpdateOne) ClearItems() *UserUpdateOne {
+	uuo.mutation.ClearItems()
 	return uuo
 }
 
-// SetCurrentMatchID sets the "current_match_id" field.
-func (uuo *UserUpdateOne) SetCurrentMatchID(i int) *UserUpdateOne {
-	uuo.mutation.SetCurrentMatchID(i)
+// RemoveItemIDs removes the "items" edge to InventoryItem entities by IDs.
+func (uuo *UserUpdateOne) RemoveItemIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveItemIDs(ids...)
 	return uuo
 }
 
-// SetNillableCurrentMatchID sets the "current_match_id" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableCurrentMatchID(i *int) *UserUpdateOne {
-	if i != nil {
-		uuo.SetCurrentMatchID(*i)
+// RemoveItems removes "items" edges to InventoryItem entities.
+func (uuo *UserUpdateOne) RemoveItems(i ...*InventoryItem) *UserUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
 	}
-	return uuo
+	return uuo.RemoveItemIDs(ids...)
 }
 
-// ClearCurrentMatchID clears the value of the "current_match_id" field.
-func (uuo *UserUpdateOne) ClearCurrentMatchID() *UserUpdateOne {
-	uuo.mutation.ClearCurrentMatchID()
+// ClearCurrentItem clears the "current_item" edge to the InventoryItem entity.
+func (uuo *UserUpdateOne) ClearCurrentItem() *UserUpdateOne {
+	uuo.mutation.ClearCurrentItem()
 	return uuo
 }
 
-// SetCurrentItemInProfileID sets the "current_item_in_profile_id" field.
-func (uuo *UserUpdateOne) SetCurrentItemInProfileID(i int) *UserUpdateOne {
-	uuo.mutation.SetCurrentItemInProfileID(i)
+// ClearCurrentMatch clears the "current_match" edge to the Match entity.
+func (uuo *UserUpdateOne) ClearCurrentMatch() *UserUpdateOne {
+	uuo.mutation.ClearCurrentMatch()
 	return uuo
 }
 
-// SetNillableCurrentItemInProfileID sets the "current_item_in_profile_id" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableCurrentItemInProfileID(i *int) *UserUpdateOne {
-	if i != nil {
-		uuo.SetCurrentItemInProfileID(*i)
-	}
+// ClearBalance clears the "balance" edge to the UserBalance entity.
+func (uuo *UserUpdateOne) ClearBalance() *UserUpdateOne {
+	uuo.mutation.ClearBalance()
 	return uuo
 }
 
-// ClearCurrentItemInProfileID clears the value of the "current_item_in_profile_id" field.
-func (uuo *UserUpdateOne) ClearCurrentItemInProfileID() *UserUpdateOne {
-	uuo.mutation.ClearCurrentItemInProfileID()
+// ClearCollectionCompletions clears all "collection_completions" edges to the CollectionCompletion entity.
+func (uuo *UserUpdateOne) ClearCollectionCompletions() *UserUpdateOne {
+	uuo.mutation.ClearCollectionCompletions()
 	return uuo
 }
 
-// SetAvatarURL sets the "avatar_url" field.
-func (uuo *UserUpdateOne) SetAvatarURL(s string) *UserUpdateOne {
-	uuo.mutation.SetAvatarURL(s)
+// RemoveCollectionCompletionIDs removes the "collection_completions" edge to CollectionCompletion entities by IDs.
+func (uuo *UserUpdateOne) RemoveCollectionCompletionIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveCollectionCompletionIDs(ids...)
 	return uuo
 }
 
-// SetNillableAvatarURL sets the "avatar_url" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableAvatarURL(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetAvatarURL(*s)
+// RemoveCollectionCompletions removes "collection_completions" edges to CollectionCompletion entities.
+func (uuo *UserUpdateOne) RemoveCollectionCompletions(c ...*CollectionCompletion) *UserUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
 	}
-	return uuo
+	return uuo.RemoveCollectionCompletionIDs(ids...)
 }
 
-// ClearAvatarURL clears the value of the "avatar_url" field.
-func (uuo *UserUpdateOne) ClearAvatarURL() *UserUpdateOne {
-	uuo.mutation.ClearAvatarURL()
+// ClearSeasonPasses clears all "season_passes" edges to the SeasonPass entity.
+func (uuo *UserUpdateOne) ClearSeasonPasses() *UserUpdateOne {
+	uuo.mutation.ClearSeasonPasses()
 	return uuo
 }
 
-// SetInvitesEnabled sets the "invites_enabled" field.
-func (uuo *UserUpdateOne) SetInvitesEnabled(b bool) *UserUpdateOne {
-	uuo.mutation.SetInvitesEnabled(b)
+// RemoveSeasonPassIDs removes the "season_passes" edge to SeasonPass entities by IDs.
+func (uuo *UserUpdateOne) RemoveSeasonPassIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveSeasonPassIDs(ids...)
 	return uuo
 }
 
-// SetNillableInvitesEnabled sets the "invites_enabled" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableInvitesEnabled(b *bool) *UserUpdateOne {
-	if b != nil {
-		uuo.SetInvitesEnabled(*b)
+// RemoveSeasonPasses removes "season_passes" edges to SeasonPass entities.
+func (uuo *UserUpdateOne) RemoveSeasonPasses(s ...*SeasonPass) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
 	}
-	return uuo
-}
-
-// SetLoginAt sets the "login_at" field.
-func (uuo *UserUpdateOne) SetLoginAt(t time.Time) *UserUpdateOne {
-	uuo.mutation.SetLoginAt(t)
-	return uuo
+	return uuo.RemoveSeasonPassIDs(ids...)
 }
 
-// SetNillableLoginAt sets the "login_at" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableLoginAt(t *time.Time) *UserUpdateOne {
-	if t != nil {
-		uuo.SetLoginAt(*t)
-	}
+// ClearSeasonRewardClaims clears all "season_reward_claims" edges to the SeasonRewardClaim entity.
+func (uuo *UserUpdateOne) ClearSeasonRewardClaims() *UserUpdateOne {
+	uuo.mutation.ClearSeasonRewardClaims()
 	return uuo
 }
 
-// SetLoginStreak sets the "login_streak" field.
-func (uuo *UserUpdateOne) SetLoginStreak(i int) *UserUpdateOne {
-	uuo.mutation.ResetLoginStreak()
-	uuo.mutation.SetLoginStreak(i)
+// RemoveSeasonRewardClaimIDs removes the "season_reward_claims" edge to SeasonRewardClaim entities by IDs.
+func (uuo *UserUpdateOne) RemoveSeasonRewardClaimIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveSeasonRewardClaimIDs(ids...)
 	return uuo
 }
 
-// SetNillableLoginStreak sets the "login_streak" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableLoginStreak(i *int) *UserUpdateOne {
-	if i != nil {
-		uuo.SetLoginStreak(*i)
+// RemoveSeasonRewardClaims removes "season_reward_claims" edges to SeasonRewardClaim entities.
+func (uuo *UserUpdateOne) RemoveSeasonRewardClaims(s ...*SeasonRewardClaim) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
 	}
-	return uuo
+	return uuo.RemoveSeasonRewardClaimIDs(ids...)
 }
 
-// AddLoginStreak adds i to the "login_streak" field.
-func (uuo *UserUpdateOne) AddLoginStreak(i int) *UserUpdateOne {
-	uuo.mutation.AddLoginStreak(i)
+// ClearEmailHistory clears all "email_history" edges to the EmailHistory entity.
+func (uuo *UserUpdateOne) ClearEmailHistory() *UserUpdateOne {
+	uuo.mutation.ClearEmailHistory()
 	return uuo
 }
 
-// SetSearchBlockedUntil sets the "search_blocked_until" field.
-func (uuo *UserUpdateOne) SetSearchBlockedUntil(t time.Time) *UserUpdateOne {
-	uuo.mutation.SetSearchBlockedUntil(t)
+// RemoveEmailHistoryIDs removes the "email_history" edge to EmailHistory entities by IDs.
+func (uuo *UserUpdateOne) RemoveEmailHistoryIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveEmailHistoryIDs(ids...)
 	return uuo
 }
 
-// SetNillableSearchBlockedUntil sets the "search_blocked_until" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableSearchBlockedUntil(t *time.Time) *UserUpdateOne {
-	if t != nil {
-		uuo.SetSearchBlockedUntil(*t)
+// RemoveEmailHistory removes "email_history" edges to EmailHistory entities.
+func (uuo *UserUpdateOne) RemoveEmailHistory(e ...*EmailHistory) *UserUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
 	}
-	return uuo
+	return uuo.RemoveEmailHistoryIDs(ids...)
 }
 
-// ClearSearchBlockedUntil clears the value of the "search_blocked_until" field.
-func (uuo *UserUpdateOne) ClearSearchBlockedUntil() *UserUpdateOne {
-	uuo.mutation.ClearSearchBlockedUntil()
+// ClearUsernameHistory clears all "username_history" edges to the UsernameHistory entity.
+func (uuo *UserUpdateOne) ClearUsernameHistory() *UserUpdateOne {
+	uuo.mutation.ClearUsernameHistory()
 	return uuo
 }
 
-// SetSearchBlockReason sets the "search_block_reason" field.
-func (uuo *UserUpdateOne) SetSearchBlockReason(s string) *UserUpdateOne {
-	uuo.mutation.SetSearchBlockReason(s)
+// RemoveUsernameHistoryIDs removes the "username_history" edge to UsernameHistory entities by IDs.
+func (uuo *UserUpdateOne) RemoveUsernameHistoryIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveUsernameHistoryIDs(ids...)
 	return uuo
 }
 
-// SetNillableSearchBlockReason sets the "search_block_reason" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableSearchBlockReason(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetSearchBlockReason(*s)
+// RemoveUsernameHistory removes "username_history" edges to UsernameHistory entities.
+func (uuo *UserUpdateOne) RemoveUsernameHistory(u ...*UsernameHistory) *UserUpdateOne {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
 	}
-	return uuo
+	return uuo.RemoveUsernameHistoryIDs(ids...)
 }
 
-// ClearSearchBlockReason clears the value of the "search_block_reason" field.
-func (uuo *UserUpdateOne) ClearSearchBlockReason() *UserUpdateOne {
-	uuo.mutation.ClearSearchBlockReason()
+// ClearPasswordHistory clears all "password_history" edges to the PasswordHistory entity.
+func (uuo *UserUpdateOne) ClearPasswordHistory() *UserUpdateOne {
+	uuo.mutation.ClearPasswordHistory()
 	return uuo
 }
 
-// SetSearchBlockedLevel sets the "search_blocked_level" field.
-func (uuo *UserUpdateOne) SetSearchBlockedLevel(i int) *UserUpdateOne {
-	uuo.mutation.ResetSearchBlockedLevel()
-	uuo.mutation.SetSearchBlockedLevel(i)
+// RemovePasswordHistoryIDs removes the "password_history" edge to PasswordHistory entities by IDs.
+func (uuo *UserUpdateOne) RemovePasswordHistoryIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemovePasswordHistoryIDs(ids...)
 	return uuo
 }
 
-// SetNillableSearchBlockedLevel sets the "search_blocked_level" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableSearchBlockedLevel(i *int) *UserUpdateOne {
-	if i != nil {
-		uuo.SetSearchBlockedLevel(*i)
+// RemovePasswordHistory removes "password_history" edges to PasswordHistory entities.
+func (uuo *UserUpdateOne) RemovePasswordHistory(p ...*PasswordHistory) *UserUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
-	return uuo
+	return uuo.RemovePasswordHistoryIDs(ids...)
 }
 
-// AddSearchBlockedLevel adds i to the "search_blocked_level" field.
-func (uuo *UserUpdateOne) AddSearchBlockedLevel(i int) *UserUpdateOne {
-	uuo.mutation.AddSearchBlockedLevel(i)
+// ClearLoginHistory clears all "login_history" edges to the LoginHistory entity.
+func (uuo *UserUpdateOne) ClearLoginHistory() *UserUpdateOne {
+	uuo.mutation.ClearLoginHistory()
 	return uuo
 }
 
-// SetAccountBlockedUntil sets the "account_blocked_until" field.
-func (uuo *UserUpdateOne) SetAccountBlockedUntil(t time.Time) *UserUpdateOne {
-	uuo.mutation.SetAccountBlockedUntil(t)
+// RemoveLoginHistoryIDs removes the "login_history" edge to LoginHistory entities by IDs.
+func (uuo *UserUpdateOne) RemoveLoginHistoryIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveLoginHistoryIDs(ids...)
 	return uuo
 }
 
-// SetNillableAccountBlockedUntil sets the "account_blocked_until" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableAccountBlockedUntil(t *time.Time) *UserUpdateOne {
-	if t != nil {
-		uuo.SetAccountBlockedUntil(*t)
+// RemoveLoginHistory removes "login_history" edges to LoginHistory entities.
+func (uuo *UserUpdateOne) RemoveLoginHistory(l ...*LoginHistory) *UserUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
 	}
-	return uuo
+	return uuo.RemoveLoginHistoryIDs(ids...)
 }
 
-// ClearAccountBlockedUntil clears the value of the "account_blocked_until" field.
-func (uuo *UserUpdateOne) ClearAccountBlockedUntil() *UserUpdateOne {
-	uuo.mutation.ClearAccountBlockedUntil()
+// ClearExternalIdentities clears all "external_identities" edges to the ExternalIdentity entity.
+func (uuo *UserUpdateOne) ClearExternalIdentities() *UserUpdateOne {
+	uuo.mutation.ClearExternalIdentities()
 	return uuo
 }
 
-// SetAccountBlockReason sets the "account_block_reason" field.
-func (uuo *UserUpdateOne) SetAccountBlockReason(s string) *UserUpdateOne {
-	uuo.mutation.SetAccountBlockReason(s)
+// RemoveExternalIdentityIDs removes the "external_identities" edge to ExternalIdentity entities by IDs.
+func (uuo *UserUpdateOne) RemoveExternalIdentityIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveExternalIdentityIDs(ids...)
 	return uuo
 }
 
-// SetNillableAccountBlockReason sets the "account_block_reason" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableAccountBlockReason(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetAccountBlockReason(*s)
+// RemoveExternalIdentities removes "external_identities" edges to ExternalIdentity entities.
+func (uuo *UserUpdateOne) RemoveExternalIdentities(e ...*ExternalIdentity) *UserUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
 	}
-	return uuo
+	return uuo.RemoveExternalIdentityIDs(ids...)
 }
 
-// ClearAccountBlockReason clears the value of the "account_block_reason" field.
-func (uuo *UserUpdateOne) ClearAccountBlockReason() *UserUpdateOne {
-	uuo.mutation.ClearAccountBlockReason()
+// ClearAPITokens clears all "api_tokens" edges to the APIToken entity.
+func (uuo *UserUpdateOne) ClearAPITokens() *UserUpdateOne {
+	uuo.mutation.ClearAPITokens()
 	return uuo
 }
 
-// SetAccountBlockedLevel sets the "account_blocked_level" field.
-func (uuo *UserUpdateOne) SetAccountBlockedLevel(i int) *UserUpdateOne {
-	uuo.mutation.ResetAccountBlockedLevel()
-	uuo.mutation.SetAccountBlockedLevel(i)
+// RemoveAPITokenIDs removes the "api_tokens" edge to APIToken entities by IDs.
+func (uuo *UserUpdateOne) RemoveAPITokenIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveAPITokenIDs(ids...)
 	return uuo
 }
 
-// SetNillableAccountBlockedLevel sets the "account_blocked_level" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableAccountBlockedLevel(i *int) *UserUpdateOne {
-	if i != nil {
-		uuo.SetAccountBlockedLevel(*i)
+// RemoveAPITokens removes "api_tokens" edges to APIToken entities.
+func (uuo *UserUpdateOne) RemoveAPITokens(a ...*APIToken) *UserUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
 	}
-	return uuo
+	return uuo.RemoveAPITokenIDs(ids...)
 }
 
-// AddAccountBlockedLevel adds i to the "account_blocked_level" field.
-func (uuo *UserUpdateOne) AddAccountBlockedLevel(i int) *UserUpdateOne {
-	uuo.mutation.AddAccountBlockedLevel(i)
+// ClearRecoveryCodes clears all "recovery_codes" edges to the RecoveryCode entity.
+func (uuo *UserUpdateOne) ClearRecoveryCodes() *UserUpdateOne {
+	uuo.mutation.ClearRecoveryCodes()
 	return uuo
 }
 
-// AddStatisticIDs adds the "statistics" edge to the Statistic entity by IDs.
-func (uuo *UserUpdateOne) AddStatisticIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.AddStatisticIDs(ids...)
+// RemoveRecoveryCodeIDs removes the "recovery_codes" edge to RecoveryCode entities by IDs.
+func (uuo *UserUpdateOne) RemoveRecoveryCodeIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveRecoveryCodeIDs(ids...)
 	return uuo
 }
 
-// AddStatistics adds the "statistics" edges to the Statistic entity.
-func (uuo *UserUpdateOne) AddStatistics(s ...*Statistic) *UserUpdateOne {
-	ids := make([]int, len(s))
-	for i := range s {
-		ids[i] = s[i].ID
+// RemoveRecoveryCodes removes "recovery_codes" edges to RecoveryCode entities.
+func (uuo *UserUpdateOne) RemoveRecoveryCodes(r ...*RecoveryCode) *UserUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
 	}
-	return uuo.AddStatisticIDs(ids...)
+	return uuo.RemoveRecoveryCodeIDs(ids...)
 }
 
-// AddFriendIDs adds the "friends" edge to the User entity by IDs.
-func (uuo *UserUpdateOne) AddFriendIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.AddFriendIDs(ids...)
+// ClearHardwareIDResets clears all "hardware_id_resets" edges to the HardwareIDReset entity.
+func (uuo *UserUpdateOne) ClearHardwareIDResets() *UserUpdateOne {
+	uuo.mutation.ClearHardwareIDResets()
 	return uuo
 }
 
-// AddFriends adds the "friends" edges to the User entity.
-func (uuo *UserUpdateOne) AddFriends(u ...*User) *UserUpdateOne {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return uuo.AddFriendIDs(ids...)
-}
-
-// AddSentFriendRequestIDs adds the "sent_friend_requests" edge to the FriendRequest entity by IDs.
-func (uuo *UserUpdateOne) AddSentFriendRequestIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.AddSentFriendRequestIDs(ids...)
+// RemoveHardwareIDResetIDs removes the "hardware_id_resets" edge to HardwareIDReset entities by IDs.
+func (uuo *UserUpdateOne) RemoveHardwareIDResetIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveHardwareIDResetIDs(ids...)
 	return uuo
 }
 
-// AddSentFriendRequests adds the "sent_friend_requests" edges to the FriendRequest entity.
-func (uuo *UserUpdateOne) AddSentFriendRequests(f ...*FriendRequest) *UserUpdateOne {
-	ids := make([]int, len(f))
-	for i := range f {
-		ids[i] = f[i].ID
+// RemoveHardwareIDResets removes "hardware_id_resets" edges to HardwareIDReset entities.
+func (uuo *UserUpdateOne) RemoveHardwareIDResets(h ...*HardwareIDReset) *UserUpdateOne {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
 	}
-	return uuo.AddSentFriendRequestIDs(ids...)
+	return uuo.RemoveHardwareIDResetIDs(ids...)
 }
 
-// AddReceivedFriendRequestIDs adds the "received_friend_requests" edge to the FriendRequest entity by IDs.
-func (uuo *UserUpdateOne) AddReceivedFriendRequestIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.AddReceivedFriendRequestIDs(ids...)
+// ClearSanctions clears all "sanctions" edges to the Sanction entity.
+func (uuo *UserUpdateOne) ClearSanctions() *UserUpdateOne {
+	uuo.mutation.ClearSanctions()
 	return uuo
 }
 
-// AddReceivedFriendRequests adds the "received_friend_requests" edges to the FriendRequest entity.
-func (uuo *UserUpdateOne) AddReceivedFriendRequests(f ...*FriendRequest) *UserUpdateOne {
-	ids := make([]int, len(f))
-	for i := range f {
-		ids[i] = f[i].ID
-	}
-	return uuo.AddReceivedFriendRequestIDs(ids...)
-}
-
-// AddItemIDs adds the "items" edge to the InventoryItem entity by IDs.
-func (uuo *UserUpdateOne) AddItemIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.AddItemIDs(ids...)
+// RemoveSanctionIDs removes the "sanctions" edge to Sanction entities by IDs.
+func (uuo *UserUpdateOne) RemoveSanctionIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveSanctionIDs(ids...)
 	return uuo
 }
 
-// AddItems adds the "items" edges to the InventoryItem entity.
-func (uuo *UserUpdateOne) AddItems(i ...*InventoryItem) *UserUpdateOne {
-	ids := make([]int, len(i))
-	for j := range i {
-		ids[j] = i[j].ID
+// RemoveSanctions removes "sanctions" edges to Sanction entities.
+func (uuo *UserUpdateOne) RemoveSanctions(s ...*Sanction) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
 	}
-	return uuo.AddItemIDs(ids...)
+	return uuo.RemoveSanctionIDs(ids...)
 }
 
-// SetCurrentItemID sets the "current_item" edge to the InventoryItem entity by ID.
-func (uuo *UserUpdateOne) SetCurrentItemID(id int) *UserUpdateOne {
-	uuo.mutation.SetCurrentItemID(id)
+// ClearRoles clears all "roles" edges to the Role entity.
+func (uuo *UserUpdateOne) ClearRoles() *UserUpdateOne {
+	uuo.mutation.ClearRoles()
 	return uuo
 }
 
-// SetNillableCurrentItemID sets the "current_item" edge to the InventoryItem entity by ID if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableCurrentItemID(id *int) *UserUpdateOne {
-	if id != nil {
-		uuo = uuo.SetCurrentItemID(*id)
-	}
+// RemoveRoleIDs removes the "roles" edge to Role entities by IDs.
+func (uuo *UserUpdateOne) RemoveRoleIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveRoleIDs(ids...)
 	return uuo
 }
 
-// SetCurrentItem sets the "current_item" edge to the InventoryItem entity.
-func (uuo *UserUpdateOne) SetCurrentItem(i *InventoryItem) *UserUpdateOne {
-	return uuo.SetCurrentItemID(i.ID)
+// RemoveRoles removes "roles" edges to Role entities.
+func (uuo *UserUpdateOne) RemoveRoles(r ...*Role) *UserUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.RemoveRoleIDs(ids...)
 }
 
-// SetCurrentMatch sets the "current_match" edge to the Match entity.
-func (uuo *UserUpdateOne) SetCurrentMatch(m *Match) *UserUpdateOne {
-	return uuo.SetCurrentMatchID(m.ID)
+// Where appends a list predicates to the UserUpdate builder.
+func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
+	uuo.mutation.Where(ps...)
+	return uuo
 }
 
-// SetBalanceID sets the "balance" edge to the UserBalance entity by ID.
-func (uuo *UserUpdateOne) SetBalanceID(id int) *UserUpdateOne {
-	uuo.mutation.SetBalanceID(id)
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (uuo *UserUpdateOne) Select(field string, fields ...string) *UserUpdateOne {
+	uuo.fields = append([]string{field}, fields...)
 	return uuo
 }
 
-// SetNillableBalanceID sets the "balance" edge to the UserBalance entity by ID if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableBalanceID(id *int) *UserUpdateOne {
-	if id != nil {
-		uuo = uuo.SetBalanceID(*id)
-	}
-	return uuo
+// Save executes the query and returns the updated User entity.
+func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
+	return withHooks(ctx, uuo.sqlSave, uuo.mutation, uuo.hooks)
 }
 
-// SetBalance sets the "balance" edge to the UserBalance entity.
-func (uuo *UserUpdateOne) SetBalance(u *UserBalance) *UserUpdateOne {
-	return uuo.SetBalanceID(u.ID)
+// SaveX is like Save, but panics if an error occurs.
+func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
+	node, err := uuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
 }
 
-// Mutation returns the UserMutation object of the builder.
-func (uuo *UserUpdateOne) Mutation() *UserMutation {
-	return uuo.mutation
+// Exec executes the query on the entity.
+func (uuo *UserUpdateOne) Exec(ctx context.Context) error {
+	_, err := uuo.Save(ctx)
+	return err
 }
 
-// ClearStatistics clears all "statistics" edges to the Statistic entity.
-func (uuo *UserUpdateOne) ClearStatistics() *UserUpdateOne {
-	uuo.mutation.ClearStatistics()
-	return uuo
+// ExecX is like Exec, but panics if an error occurs.
+func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
+	if err := uuo.Exec(ctx); err != nil {
+		panic(err)
+	}
 }
 
-// RemoveStatisticIDs removes the "statistics" edge to Statistic entities by IDs.
-func (uuo *UserUpdateOne) RemoveStatisticIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemoveStatisticIDs(ids...)
-	return uuo
+// check runs all checks and user-defined validators on the builder.
+func (uuo *UserUpdateOne) check() error {
+	if v, ok := uuo.mutation.Username(); ok {
+		if err := user.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "User.username": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.Password(); ok {
+		if err := user.PasswordValidator(v); err != nil {
+			return &ValidationError{Name: "password", err: fmt.Errorf(`ent: validator failed for field "User.password": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.SearchBlockedLevel(); ok {
+		if err := user.SearchBlockedLevelValidator(v); err != nil {
+			return &ValidationError{Name: "search_blocked_level", err: fmt.Errorf(`ent: validator failed for field "User.search_blocked_level": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.AccountBlockedLevel(); ok {
+		if err := user.AccountBlockedLevelValidator(v); err != nil {
+			return &ValidationError{Name: "account_blocked_level", err: fmt.Errorf(`ent: validator failed for field "User.account_blocked_level": %w`, err)}
+		}
+	}
+	return nil
 }
 
-// RemoveStatistics removes "statistics" edges to Statistic entities.
-func (uuo *UserUpdateOne) RemoveStatistics(s ...*Statistic) *UserUpdateOne {
-	ids := make([]int, len(s))
-	for i := range s {
-		ids[i] = s[i].ID
+func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
+	if err := uuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
+	id, ok := uuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := uuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
+		for _, f := range fields {
+			if !user.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != user.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := uuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uuo.mutation.Username(); ok {
+		_spec.SetField(user.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.Email(); ok {
+		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	}
+	if uuo.mutation.EmailCleared() {
+		_spec.ClearField(user.FieldEmail, field.TypeString)
+	}
+	if value, ok := uuo.mutation.Password(); ok {
+		_spec.SetField(user.FieldPassword, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.HardwareID(); ok {
+		_spec.SetField(user.FieldHardwareID, field.TypeString, value)
+	}
+	if uuo.mutation.HardwareIDCleared() {
+		_spec.ClearField(user.FieldHardwareID, field.TypeString)
+	}
+	if value, ok := uuo.mutation.HardwareIDFingerprint(); ok {
+		_spec.SetField(user.FieldHardwareIDFingerprint, field.TypeString, value)
+	}
+	if uuo.mutation.HardwareIDFingerprintCleared() {
+		_spec.ClearField(user.FieldHardwareIDFingerprint, field.TypeString)
+	}
+	if value, ok := uuo.mutation.TotpSecret(); ok {
+		_spec.SetField(user.FieldTotpSecret, field.TypeString, value)
+	}
+	if uuo.mutation.TotpSecretCleared() {
+		_spec.ClearField(user.FieldTotpSecret, field.TypeString)
+	}
+	if value, ok := uuo.mutation.TotpEnabledAt(); ok {
+		_spec.SetField(user.FieldTotpEnabledAt, field.TypeTime, value)
+	}
+	if uuo.mutation.TotpEnabledAtCleared() {
+		_spec.ClearField(user.FieldTotpEnabledAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.AccessLevel(); ok {
+		_spec.SetField(user.FieldAccessLevel, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.GenshinUID(); ok {
+		_spec.SetField(user.FieldGenshinUID, field.TypeString, value)
+	}
+	if uuo.mutation.GenshinUIDCleared() {
+		_spec.ClearField(user.FieldGenshinUID, field.TypeString)
+	}
+	if value, ok := uuo.mutation.HoyolabLogin(); ok {
+		_spec.SetField(user.FieldHoyolabLogin, field.TypeString, value)
+	}
+	if uuo.mutation.HoyolabLoginCleared() {
+		_spec.ClearField(user.FieldHoyolabLogin, field.TypeString)
+	}
+	if value, ok := uuo.mutation.AvatarURL(); ok {
+		_spec.SetField(user.FieldAvatarURL, field.TypeString, value)
+	}
+	if uuo.mutation.AvatarURLCleared() {
+		_spec.ClearField(user.FieldAvatarURL, field.TypeString)
+	}
+	if value, ok := uuo.mutation.Title(); ok {
+		_spec.SetField(user.FieldTitle, field.TypeString, value)
+	}
+	if uuo.mutation.TitleCleared() {
+		_spec.ClearField(user.FieldTitle, field.TypeString)
+	}
+	if value, ok := uuo.mutation.InvitesEnabled(); ok {
+		_spec.SetField(user.FieldInvitesEnabled, field.TypeBool, value)
+	}
+	if value, ok := uuo.mutation.LoginAt(); ok {
+		_spec.SetField(user.FieldLoginAt, field.TypeTime, value)
+	}
+	if value, ok := uuo.mutation.LoginStreak(); ok {
+		_spec.SetField(user.FieldLoginStreak, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.AddedLoginStreak(); ok {
+		_spec.AddField(user.FieldLoginStreak, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.SearchBlockedUntil(); ok {
+		_spec.SetField(user.FieldSearchBlockedUntil, field.TypeTime, value)
+	}
+	if uuo.mutation.SearchBlockedUntilCleared() {
+		_spec.ClearField(user.FieldSearchBlockedUntil, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.SearchBlockReason(); ok {
+		_spec.SetField(user.FieldSearchBlockReason, field.TypeString, value)
+	}
+	if uuo.mutation.SearchBlockReasonCleared() {
+		_spec.ClearField(user.FieldSearchBlockReason, field.TypeString)
+	}
+	if value, ok := uuo.mutation.SearchBlockedLevel(); ok {
+		_spec.SetField(user.FieldSearchBlockedLevel, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.AddedSearchBlockedLevel(); ok {
+		_spec.AddField(user.FieldSearchBlockedLevel, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.AccountBlockedUntil(); ok {
+		_spec.SetField(user.FieldAccountBlockedUntil, field.TypeTime, value)
+	}
+	if uuo.mutation.AccountBlockedUntilCleared() {
+		_spec.ClearField(user.FieldAccountBlockedUntil, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.AccountBlockReason(); ok {
+		_spec.SetField(user.FieldAccountBlockReason, field.TypeString, value)
+	}
+	if uuo.mutation.AccountBlockReasonCleared() {
+		_spec.ClearField(user.FieldAccountBlockReason, field.TypeString)
+	}
+	if value, ok := uuo.mutation.AccountBlockedLevel(); ok {
+		_spec.SetField(user.FieldAccountBlockedLevel, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.AddedAccountBlockedLevel(); ok {
+		_spec.AddField(user.FieldAccountBlockedLevel, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.LoginLockedUntil(); ok {
+		_spec.SetField(user.FieldLoginLockedUntil, field.TypeTime, value)
+	}
+	if uuo.mutation.LoginLockedUntilCleared() {
+		_spec.ClearField(user.FieldLoginLockedUntil, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.DeletionScheduledAt(); ok {
+		_spec.SetField(user.FieldDeletionScheduledAt, field.TypeTime, value)
+	}
+	if uuo.mutation.DeletionScheduledAtCleared() {
+		_spec.ClearField(user.FieldDeletionScheduledAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.DeletedAt(); ok {
+		_spec.SetField(user.FieldDeletedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(user.FieldDeletedAt, field.TypeTime)
+	}
+	if uuo.mutation.StatisticsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.StatisticsTable,
+			Columns: []string{user.StatisticsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statistic.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedStatisticsIDs(); len(nodes) > 0 && !uuo.mutation.StatisticsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.StatisticsTable,
+			Columns: []string{user.StatisticsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statistic.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.StatisticsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.StatisticsTable,
+			Columns: []string{user.StatisticsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statistic.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.FriendsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.FriendsTable,
+			Columns: user.FriendsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemoveStatisticIDs(ids...)
-}
-
-// ClearFriends clears all "friends" edges to the User entity.
-func (uuo *UserUpdateOne) ClearFriends() *UserUpdateOne {
-	uuo.mutation.ClearFriends()
-	return uuo
-}
-
-// RemoveFriendIDs removes the "friends" edge to User entities by IDs.
-func (uuo *UserUpdateOne) RemoveFriendIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemoveFriendIDs(ids...)
-	return uuo
-}
-
-// RemoveFriends removes "friends" edges to User entities.
-func (uuo *UserUpdateOne) RemoveFriends(u ...*User) *UserUpdateOne {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
+	if nodes := uuo.mutation.RemovedFriendsIDs(); len(nodes) > 0 && !uuo.mutation.FriendsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.FriendsTable,
+			Columns: user.FriendsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemoveFriendIDs(ids...)
-}
-
-// ClearSentFriendRequests clears all "sent_friend_requests" edges to the FriendRequest entity.
-func (uuo *UserUpdateOne) ClearSentFriendRequests() *UserUpdateOne {
-	uuo.mutation.ClearSentFriendRequests()
-	return uuo
-}
-
-// RemoveSentFriendRequestIDs removes the "sent_friend_requests" edge to FriendRequest entities by IDs.
-func (uuo *UserUpdateOne) RemoveSentFriendRequestIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemoveSentFriendRequestIDs(ids...)
-	return uuo
-}
-
-// RemoveSentFriendRequests removes "sent_friend_requests" edges to FriendRequest entities.
-func (uuo *UserUpdateOne) RemoveSentFriendRequests(f ...*FriendRequest) *UserUpdateOne {
-	ids := make([]int, len(f))
-	for i := range f {
-		ids[i] = f[i].ID
+	if nodes := uuo.mutation.FriendsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.FriendsTable,
+			Columns: user.FriendsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.RemoveSentFriendRequestIDs(ids...)
-}
-
-// ClearReceivedFriendRequests clears all "received_friend_requests" edges to the FriendRequest entity.
-func (uuo *UserUpdateOne) ClearReceivedFriendRequests() *UserUpdateOne {
-	uuo.mutation.ClearReceivedFriendRequests()
-	return uuo
-}
-
-// RemoveReceivedFriendRequestIDs removes the "received_friend_requests" edge to FriendRequest entities by IDs.
-func (uuo *UserUpdateOne) RemoveReceivedFriendRequestIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemoveReceivedFriendRequestIDs(ids...)
-	return uuo
-}
-
-// RemoveReceivedFriendRequests removes "received_friend_requests" edges to FriendRequest entities.
-func (uuo *UserUpdateOne) RemoveReceivedFriendRequests(f ...*FriendRequest) *UserUpdateOne {
-	ids := make([]int, len(f))
-	for i := range f {
-		ids[i] = f[i].ID
+	if uuo.mutation.SentFriendRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentFriendRequestsTable,
+			Columns: []string{user.SentFriendRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendrequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemoveReceivedFriendRequestIDs(ids...)
-}
-
-// ClearItems clears all "items" edges to the InventoryItem entity.
-func (uuo *UserUpdateOne) ClearItems() *UserUpdateOne {
-	uuo.mutation.ClearItems()
-	return uuo
-}
-
-// RemoveItemIDs removes the "items" edge to InventoryItem entities by IDs.
-func (uuo *UserUpdateOne) RemoveItemIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemoveItemIDs(ids...)
-	return uuo
-}
-
-// RemoveItems removes "items" edges to InventoryItem entities.
-func (uuo *UserUpdateOne) RemoveItems(i ...*InventoryItem) *UserUpdateOne {
-	ids := make([]int, len(i))
-	for j := range i {
-		ids[j] = i[j].ID
+	if nodes := uuo.mutation.RemovedSentFriendRequestsIDs(); len(nodes) > 0 && !uuo.mutation.SentFriendRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentFriendRequestsTable,
+			Columns: []string{user.SentFriendRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemoveItemIDs(ids...)
-}
-
-// ClearCurrentItem clears the "current_item" edge to the InventoryItem entity.
-func (uuo *UserUpdateOne) ClearCurrentItem() *UserUpdateOne {
-	uuo.mutation.ClearCurrentItem()
-	return uuo
-}
-
-// ClearCurrentMatch clears the "current_match" edge to the Match entity.
-func (uuo *UserUpdateOne) ClearCurrentMatch() *UserUpdateOne {
-	uuo.mutation.ClearCurrentMatch()
-	return uuo
-}
-
-// ClearBalance clears the "balance" edge to the UserBalance entity.
-func (uuo *UserUpdateOne) ClearBalance() *UserUpdateOne {
-	uuo.mutation.ClearBalance()
-	return uuo
-}
-
-// Where appends a list predicates to the UserUpdate builder.
-func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
-	uuo.mutation.Where(ps...)
-	return uuo
-}
-
-// Select allows selecting one or more fields (columns) of the returned entity.
-// The default is selecting all fields defined in the entity schema.
-func (uuo *UserUpdateOne) Select(field string, fields ...string) *UserUpdateOne {
-	uuo.fields = append([]string{field}, fields...)
-	return uuo
-}
-
-// Save executes the query and returns the updated User entity.
-func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
-	return withHooks(ctx, uuo.sqlSave, uuo.mutation, uuo.hooks)
-}
-
-// SaveX is like Save, but panics if an error occurs.
-func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
-	node, err := uuo.Save(ctx)
-	if err != nil {
-		panic(err)
+	if nodes := uuo.mutation.SentFriendRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentFriendRequestsTable,
+			Columns: []string{user.SentFriendRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return node
-}
-
-// Exec executes the query on the entity.
-func (uuo *UserUpdateOne) Exec(ctx context.Context) error {
-	_, err := uuo.Save(ctx)
-	return err
-}
-
-// ExecX is like Exec, but panics if an error occurs.
-func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
-	if err := uuo.Exec(ctx); err != nil {
-		panic(err)
+	if uuo.mutation.ReceivedFriendRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReceivedFriendRequestsTable,
+			Columns: []string{user.ReceivedFriendRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendrequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-}
-
-// check runs all checks and user-defined validators on the builder.
-func (uuo *UserUpdateOne) check() error {
-	if v, ok := uuo.mutation.Username(); ok {
-		if err := user.UsernameValidator(v); err != nil {
-			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "UserDTO.username": %w`, err)}
+	if nodes := uuo.mutation.RemovedReceivedFriendRequestsIDs(); len(nodes) > 0 && !uuo.mutation.ReceivedFriendRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReceivedFriendRequestsTable,
+			Columns: []string{user.ReceivedFriendRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := uuo.mutation.Password(); ok {
-		if err := user.PasswordValidator(v); err != nil {
-			return &ValidationError{Name: "password", err: fmt.Errorf(`ent: validator failed for field "UserDTO.password": %w`, err)}
+	if nodes := uuo.mutation.ReceivedFriendRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReceivedFriendRequestsTable,
+			Columns: []string{user.ReceivedFriendRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if v, ok := uuo.mutation.SearchBlockedLevel(); ok {
-		if err := user.SearchBlockedLevelValidator(v); err != nil {
-			return &ValidationError{Name: "search_blocked_level", err: fmt.Errorf(`ent: validator failed for field "UserDTO.search_blocked_level": %w`, err)}
+	if uuo.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemsTable,
+			Columns: []string{user.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventoryitem.FieldID, field.TypeInt),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := uuo.mutation.AccountBlockedLevel(); ok {
-		if err := user.AccountBlockedLevelValidator(v); err != nil {
-			return &ValidationError{Name: "account_blocked_level", err: fmt.Errorf(`ent: validator failed for field "UserDTO.account_blocked_level": %w`, err)}
+	if nodes := uuo.mutation.RemovedItemsIDs(); len(nodes) > 0 && !uuo.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemsTable,
+			Columns: []string{user.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventoryitem.FieldID, field.TypeInt),
+			},
 		}
-	}
-	return nil
-}
-
-func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
-	if err := uuo.check(); err != nil {
-		return _node, err
-	}
-	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
-	id, ok := uuo.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserDTO.id" for update`)}
-	}
-	_spec.Node.ID.Value = id
-	if fields := uuo.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
-		for _, f := range fields {
-			if !user.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != user.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if ps := uuo.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if nodes := uuo.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemsTable,
+			Columns: []string{user.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventoryitem.FieldID, field.TypeInt),
+			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := uuo.mutation.Username(); ok {
-		_spec.SetField(user.FieldUsername, field.TypeString, value)
-	}
-	if value, ok := uuo.mutation.Email(); ok {
-		_spec.SetField(user.FieldEmail, field.TypeString, value)
-	}
-	if uuo.mutation.EmailCleared() {
-		_spec.ClearField(user.FieldEmail, field.TypeString)
-	}
-	if value, ok := uuo.mutation.Password(); ok {
-		_spec.SetField(user.FieldPassword, field.TypeString, value)
-	}
-	if value, ok := uuo.mutation.HardwareID(); ok {
-		_spec.SetField(user.FieldHardwareID, field.TypeString, value)
-	}
-	if uuo.mutation.HardwareIDCleared() {
-		_spec.ClearField(user.FieldHardwareID, field.TypeString)
-	}
-	if value, ok := uuo.mutation.AccessLevel(); ok {
-		_spec.SetField(user.FieldAccessLevel, field.TypeString, value)
-	}
-	if value, ok := uuo.mutation.GenshinUID(); ok {
-		_spec.SetField(user.FieldGenshinUID, field.TypeString, value)
-	}
-	if uuo.mutation.GenshinUIDCleared() {
-		_spec.ClearField(user.FieldGenshinUID, field.TypeString)
+	if uuo.mutation.CurrentItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   user.CurrentItemTable,
+			Columns: []string{user.CurrentItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventoryitem.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.HoyolabLogin(); ok {
-		_spec.SetField(user.FieldHoyolabLogin, field.TypeString, value)
+	if nodes := uuo.mutation.CurrentItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   user.CurrentItemTable,
+			Columns: []string{user.CurrentItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventoryitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.HoyolabLoginCleared() {
-		_spec.ClearField(user.FieldHoyolabLogin, field.TypeString)
+	if uuo.mutation.CurrentMatchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   user.CurrentMatchTable,
+			Columns: []string{user.CurrentMatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(match.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.AvatarURL(); ok {
-		_spec.SetField(user.FieldAvatarURL, field.TypeString, value)
+	if nodes := uuo.mutation.CurrentMatchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   user.CurrentMatchTable,
+			Columns: []string{user.CurrentMatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(match.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.AvatarURLCleared() {
-		_spec.ClearField(user.FieldAvatarURL, field.TypeString)
+	if uuo.mutation.BalanceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.BalanceTable,
+			Columns: []string{user.BalanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userbalance.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.InvitesEnabled(); ok {
-		_spec.SetField(user.FieldInvitesEnabled, field.TypeBool, value)
+	if nodes := uuo.mutation.BalanceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.BalanceTable,
+			Columns: []string{user.BalanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userbalance.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := uuo.mutation.LoginAt(); ok {
-		_spec.SetField(user.FieldLoginAt, field.TypeTime, value)
+	if uuo.mutation.CollectionCompletionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CollectionCompletionsTable,
+			Columns: []string{user.CollectionCompletionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectioncompletion.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.LoginStreak(); ok {
-		_spec.SetField(user.FieldLoginStreak, field.TypeInt, value)
+	if nodes := uuo.mutation.RemovedCollectionCompletionsIDs(); len(nodes) > 0 && !uuo.mutation.CollectionCompletionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CollectionCompletionsTable,
+			Columns: []string{user.CollectionCompletionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectioncompletion.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.AddedLoginStreak(); ok {
-		_spec.AddField(user.FieldLoginStreak, field.TypeInt, value)
+	if nodes := uuo.mutation.CollectionCompletionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CollectionCompletionsTable,
+			Columns: []string{user.CollectionCompletionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectioncompletion.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := uuo.mutation.SearchBlockedUntil(); ok {
-		_spec.SetField(user.FieldSearchBlockedUntil, field.TypeTime, value)
+	if uuo.mutation.SeasonPassesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SeasonPassesTable,
+			Columns: []string{user.SeasonPassesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasonpass.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if uuo.mutation.SearchBlockedUntilCleared() {
-		_spec.ClearField(user.FieldSearchBlockedUntil, field.TypeTime)
+	if nodes := uuo.mutation.RemovedSeasonPassesIDs(); len(nodes) > 0 && !uuo.mutation.SeasonPassesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SeasonPassesTable,
+			Columns: []string{user.SeasonPassesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasonpass.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.SearchBlockReason(); ok {
-		_spec.SetField(user.FieldSearchBlockReason, field.TypeString, value)
+	if nodes := uuo.mutation.SeasonPassesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SeasonPassesTable,
+			Columns: []string{user.SeasonPassesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasonpass.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.SearchBlockReasonCleared() {
-		_spec.ClearField(user.FieldSearchBlockReason, field.TypeString)
+	if uuo.mutation.SeasonRewardClaimsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SeasonRewardClaimsTable,
+			Columns: []string{user.SeasonRewardClaimsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasonrewardclaim.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.SearchBlockedLevel(); ok {
-		_spec.SetField(user.FieldSearchBlockedLevel, field.TypeInt, value)
+	if nodes := uuo.mutation.RemovedSeasonRewardClaimsIDs(); len(nodes) > 0 && !uuo.mutation.SeasonRewardClaimsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SeasonRewardClaimsTable,
+			Columns: []string{user.SeasonRewardClaimsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasonrewardclaim.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.AddedSearchBlockedLevel(); ok {
-		_spec.AddField(user.FieldSearchBlockedLevel, field.TypeInt, value)
+	if nodes := uuo.mutation.SeasonRewardClaimsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SeasonRewardClaimsTable,
+			Columns: []string{user.SeasonRewardClaimsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(seasonrewardclaim.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := uuo.mutation.AccountBlockedUntil(); ok {
-		_spec.SetField(user.FieldAccountBlockedUntil, field.TypeTime, value)
+	if uuo.mutation.EmailHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailHistoryTable,
+			Columns: []string{user.EmailHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailhistory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if uuo.mutation.AccountBlockedUntilCleared() {
-		_spec.ClearField(user.FieldAccountBlockedUntil, field.TypeTime)
+	if nodes := uuo.mutation.RemovedEmailHistoryIDs(); len(nodes) > 0 && !uuo.mutation.EmailHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailHistoryTable,
+			Columns: []string{user.EmailHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailhistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.AccountBlockReason(); ok {
-		_spec.SetField(user.FieldAccountBlockReason, field.TypeString, value)
+	if nodes := uuo.mutation.EmailHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailHistoryTable,
+			Columns: []string{user.EmailHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailhistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.AccountBlockReasonCleared() {
-		_spec.ClearField(user.FieldAccountBlockReason, field.TypeString)
+	if uuo.mutation.UsernameHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsernameHistoryTable,
+			Columns: []string{user.UsernameHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usernamehistory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.AccountBlockedLevel(); ok {
-		_spec.SetField(user.FieldAccountBlockedLevel, field.TypeInt, value)
+	if nodes := uuo.mutation.RemovedUsernameHistoryIDs(); len(nodes) > 0 && !uuo.mutation.UsernameHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsernameHistoryTable,
+			Columns: []string{user.UsernameHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usernamehistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.AddedAccountBlockedLevel(); ok {
-		_spec.AddField(user.FieldAccountBlockedLevel, field.TypeInt, value)
+	if nodes := uuo.mutation.UsernameHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsernameHistoryTable,
+			Columns: []string{user.UsernameHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usernamehistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.StatisticsCleared() {
+	if uuo.mutation.PasswordHistoryCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.StatisticsTable,
-			Columns: []string{user.StatisticsColumn},
+			Table:   user.PasswordHistoryTable,
+			Columns: []string{user.PasswordHistoryColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(statistic.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(passwordhistory.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedStatisticsIDs(); len(nodes) > 0 && !uuo.mutation.StatisticsCleared() {
+	if nodes := uuo.mutation.RemovedPasswordHistoryIDs(); len(nodes) > 0 && !uuo.mutation.PasswordHistoryCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.StatisticsTable,
-			Columns: []string{user.StatisticsColumn},
+			Table:   user.PasswordHistoryTable,
+			Columns: []string{user.PasswordHistoryColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(statistic.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(passwordhistory.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1925,15 +4360,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.StatisticsIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.PasswordHistoryIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.StatisticsTable,
-			Columns: []string{user.StatisticsColumn},
+			Table:   user.PasswordHistoryTable,
+			Columns: []string{user.PasswordHistoryColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(statistic.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(passwordhistory.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1941,28 +4376,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.FriendsCleared() {
+	if uuo.mutation.LoginHistoryCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.FriendsTable,
-			Columns: user.FriendsPrimaryKey,
-			Bidi:    true,
+			Table:   user.LoginHistoryTable,
+			Columns: []string{user.LoginHistoryColumn},
+			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(loginhistory.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedFriendsIDs(); len(nodes) > 0 && !uuo.mutation.FriendsCleared() {
+	if nodes := uuo.mutation.RemovedLoginHistoryIDs(); len(nodes) > 0 && !uuo.mutation.LoginHistoryCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.FriendsTable,
-			Columns: user.FriendsPrimaryKey,
-			Bidi:    true,
+			Table:   user.LoginHistoryTable,
+			Columns: []string{user.LoginHistoryColumn},
+			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(loginhistory.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1970,15 +4405,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.FriendsIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.LoginHistoryIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.FriendsTable,
-			Columns: user.FriendsPrimaryKey,
-			Bidi:    true,
+			Table:   user.LoginHistoryTable,
+			Columns: []string{user.LoginHistoryColumn},
+			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(loginhistory.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1986,28 +4421,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.SentFriendRequestsCleared() {
+	if uuo.mutation.ExternalIdentitiesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SentFriendRequestsTable,
-			Columns: []string{user.SentFriendRequestsColumn},
+			Table:   user.ExternalIdentitiesTable,
+			Columns: []string{user.ExternalIdentitiesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(friendrequest.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(externalidentity.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedSentFriendRequestsIDs(); len(nodes) > 0 && !uuo.mutation.SentFriendRequestsCleared() {
+	if nodes := uuo.mutation.RemovedExternalIdentitiesIDs(); len(nodes) > 0 && !uuo.mutation.ExternalIdentitiesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SentFriendRequestsTable,
-			Columns: []string{user.SentFriendRequestsColumn},
+			Table:   user.ExternalIdentitiesTable,
+			Columns: []string{user.ExternalIdentitiesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(friendrequest.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(externalidentity.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2015,15 +4450,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.SentFriendRequestsIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.ExternalIdentitiesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SentFriendRequestsTable,
-			Columns: []string{user.SentFriendRequestsColumn},
+			Table:   user.ExternalIdentitiesTable,
+			Columns: []string{user.ExternalIdentitiesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(friendrequest.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(externalidentity.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2031,28 +4466,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.ReceivedFriendRequestsCleared() {
+	if uuo.mutation.APITokensCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ReceivedFriendRequestsTable,
-			Columns: []string{user.ReceivedFriendRequestsColumn},
+			Table:   user.APITokensTable,
+			Columns: []string{user.APITokensColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(friendrequest.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(apitoken.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedReceivedFriendRequestsIDs(); len(nodes) > 0 && !uuo.mutation.ReceivedFriendRequestsCleared() {
+	if nodes := uuo.mutation.RemovedAPITokensIDs(); len(nodes) > 0 && !uuo.mutation.APITokensCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ReceivedFriendRequestsTable,
-			Columns: []string{user.ReceivedFriendRequestsColumn},
+			Table:   user.APITokensTable,
+			Columns: []string{user.APITokensColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(friendrequest.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(apitoken.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2060,15 +4495,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.ReceivedFriendRequestsIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.APITokensIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ReceivedFriendRequestsTable,
-			Columns: []string{user.ReceivedFriendRequestsColumn},
+			Table:   user.APITokensTable,
+			Columns: []string{user.APITokensColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(friendrequest.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(apitoken.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2076,28 +4511,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.ItemsCleared() {
+	if uuo.mutation.RecoveryCodesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ItemsTable,
-			Columns: []string{user.ItemsColumn},
+			Table:   user.RecoveryCodesTable,
+			Columns: []string{user.RecoveryCodesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(inventoryitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(recoverycode.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedItemsIDs(); len(nodes) > 0 && !uuo.mutation.ItemsCleared() {
+	if nodes := uuo.mutation.RemovedRecoveryCodesIDs(); len(nodes) > 0 && !uuo.mutation.RecoveryCodesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ItemsTable,
-			Columns: []string{user.ItemsColumn},
+			Table:   user.RecoveryCodesTable,
+			Columns: []string{user.RecoveryCodesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(inventoryitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(recoverycode.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2105,15 +4540,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.ItemsIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.RecoveryCodesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ItemsTable,
-			Columns: []string{user.ItemsColumn},
+			Table:   user.RecoveryCodesTable,
+			Columns: []string{user.RecoveryCodesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(inventoryitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(recoverycode.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2121,28 +4556,44 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.CurrentItemCleared() {
+	if uuo.mutation.HardwareIDResetsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CurrentItemTable,
-			Columns: []string{user.CurrentItemColumn},
+			Table:   user.HardwareIDResetsTable,
+			Columns: []string{user.HardwareIDResetsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(inventoryitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(hardwareidreset.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.CurrentItemIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.RemovedHardwareIDResetsIDs(); len(nodes) > 0 && !uuo.mutation.HardwareIDResetsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CurrentItemTable,
-			Columns: []string{user.CurrentItemColumn},
+			Table:   user.HardwareIDResetsTable,
+			Columns: []string{user.HardwareIDResetsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(inventoryitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(hardwareidreset.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.HardwareIDResetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HardwareIDResetsTable,
+			Columns: []string{user.HardwareIDResetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hardwareidreset.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2150,28 +4601,44 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.CurrentMatchCleared() {
+	if uuo.mutation.SanctionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CurrentMatchTable,
-			Columns: []string{user.CurrentMatchColumn},
+			Table:   user.SanctionsTable,
+			Columns: []string{user.SanctionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(match.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.CurrentMatchIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.RemovedSanctionsIDs(); len(nodes) > 0 && !uuo.mutation.SanctionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CurrentMatchTable,
-			Columns: []string{user.CurrentMatchColumn},
+			Table:   user.SanctionsTable,
+			Columns: []string{user.SanctionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(match.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.SanctionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SanctionsTable,
+			Columns: []string{user.SanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2179,28 +4646,44 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.BalanceCleared() {
+	if uuo.mutation.RolesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
-			Table:   user.BalanceTable,
-			Columns: []string{user.BalanceColumn},
+			Table:   user.RolesTable,
+			Columns: user.RolesPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(userbalance.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.BalanceIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.RemovedRolesIDs(); len(nodes) > 0 && !uuo.mutation.RolesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
-			Table:   user.BalanceTable,
-			Columns: []string{user.BalanceColumn},
+			Table:   user.RolesTable,
+			Columns: user.RolesPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(userbalance.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.RolesTable,
+			Columns: user.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -0,0 +1,192 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/usernamehistory"
+)
+
+// UsernameHistory is the model entity for the UsernameHistory schema.
+type UsernameHistory struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// OldUsername holds the value of the "old_username" field.
+	OldUsername string `json:"old_username,omitempty"`
+	// NewUsername holds the value of the "new_username" field.
+	NewUsername string `json:"new_username,omitempty"`
+	// CoinsSpent holds the value of the "coins_spent" field.
+	CoinsSpent float64 `json:"coins_spent,omitempty"`
+	// ReleasedAt holds the value of the "released_at" field.
+	ReleasedAt time.Time `json:"released_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UsernameHistoryQuery when eager-loading is set.
+	Edges        UsernameHistoryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// UsernameHistoryEdges holds the relations/edges for other nodes in the graph.
+type UsernameHistoryEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UsernameHistoryEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UsernameHistory) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case usernamehistory.FieldCoinsSpent:
+			values[i] = new(sql.NullFloat64)
+		case usernamehistory.FieldID, usernamehistory.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case usernamehistory.FieldOldUsername, usernamehistory.FieldNewUsername:
+			values[i] = new(sql.NullString)
+		case usernamehistory.FieldReleasedAt, usernamehistory.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UsernameHistory fields.
+func (uh *UsernameHistory) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case usernamehistory.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			uh.ID = int(value.Int64)
+		case usernamehistory.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				uh.UserID = int(value.Int64)
+			}
+		case usernamehistory.FieldOldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field old_username", values[i])
+			} else if value.Valid {
+				uh.OldUsername = value.String
+			}
+		case usernamehistory.FieldNewUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field new_username", values[i])
+			} else if value.Valid {
+				uh.NewUsername = value.String
+			}
+		case usernamehistory.FieldCoinsSpent:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field coins_spent", values[i])
+			} else if value.Valid {
+				uh.CoinsSpent = value.Float64
+			}
+		case usernamehistory.FieldReleasedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field released_at", values[i])
+			} else if value.Valid {
+				uh.ReleasedAt = value.Time
+			}
+		case usernamehistory.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				uh.CreatedAt = value.Time
+			}
+		default:
+			uh.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UsernameHistory.
+// This includes values selected through modifiers, order, etc.
+func (uh *UsernameHistory) Value(name string) (ent.Value, error) {
+	return uh.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the UsernameHistory entity.
+func (uh *UsernameHistory) QueryUser() *UserQuery {
+	return NewUsernameHistoryClient(uh.config).QueryUser(uh)
+}
+
+// Update returns a builder for updating this UsernameHistory.
+// Note that you need to call UsernameHistory.Unwrap() before calling this method if this UsernameHistory
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (uh *UsernameHistory) Update() *UsernameHistoryUpdateOne {
+	return NewUsernameHistoryClient(uh.config).UpdateOne(uh)
+}
+
+// Unwrap unwraps the UsernameHistory entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (uh *UsernameHistory) Unwrap() *UsernameHistory {
+	_tx, ok := uh.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UsernameHistory is not a transactional entity")
+	}
+	uh.config.driver = _tx.drv
+	return uh
+}
+
+// String implements the fmt.Stringer.
+func (uh *UsernameHistory) String() string {
+	var builder strings.Builder
+	builder.WriteString("UsernameHistory(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", uh.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", uh.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("old_username=")
+	builder.WriteString(uh.OldUsername)
+	builder.WriteString(", ")
+	builder.WriteString("new_username=")
+	builder.WriteString(uh.NewUsername)
+	builder.WriteString(", ")
+	builder.WriteString("coins_spent=")
+	builder.WriteString(fmt.Sprintf("%v", uh.CoinsSpent))
+	builder.WriteString(", ")
+	builder.WriteString("released_at=")
+	builder.WriteString(uh.ReleasedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(uh.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UsernameHistories is a parsable slice of UsernameHistory.
+type UsernameHistories []*UsernameHistory
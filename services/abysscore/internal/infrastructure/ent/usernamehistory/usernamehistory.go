@@ -0,0 +1,120 @@
+// Code generated by ent, DO NOT EDIT.
+
+package usernamehistory
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the usernamehistory type in the database.
+	Label = "username_history"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldOldUsername holds the string denoting the old_username field in the database.
+	FieldOldUsername = "old_username"
+	// FieldNewUsername holds the string denoting the new_username field in the database.
+	FieldNewUsername = "new_username"
+	// FieldCoinsSpent holds the string denoting the coins_spent field in the database.
+	FieldCoinsSpent = "coins_spent"
+	// FieldReleasedAt holds the string denoting the released_at field in the database.
+	FieldReleasedAt = "released_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the usernamehistory in the database.
+	Table = "username_histories"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "username_histories"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+)
+
+// Columns holds all SQL columns for usernamehistory fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldOldUsername,
+	FieldNewUsername,
+	FieldCoinsSpent,
+	FieldReleasedAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCoinsSpent holds the default value on creation for the "coins_spent" field.
+	DefaultCoinsSpent float64
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the UsernameHistory queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByOldUsername orders the results by the old_username field.
+func ByOldUsername(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOldUsername, opts...).ToFunc()
+}
+
+// ByNewUsername orders the results by the new_username field.
+func ByNewUsername(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNewUsername, opts...).ToFunc()
+}
+
+// ByCoinsSpent orders the results by the coins_spent field.
+func ByCoinsSpent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCoinsSpent, opts...).ToFunc()
+}
+
+// ByReleasedAt orders the results by the released_at field.
+func ByReleasedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReleasedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
@@ -0,0 +1,394 @@
+// Code generated by ent, DO NOT EDIT.
+
+package usernamehistory
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldEQ(FieldUserID, v))
+}
+
+// OldUsername applies equality check predicate on the "old_username" field. It's identical to OldUsernameEQ.
+func OldUsername(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldEQ(FieldOldUsername, v))
+}
+
+// NewUsername applies equality check predicate on the "new_username" field. It's identical to NewUsernameEQ.
+func NewUsername(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldEQ(FieldNewUsername, v))
+}
+
+// CoinsSpent applies equality check predicate on the "coins_spent" field. It's identical to CoinsSpentEQ.
+func CoinsSpent(v float64) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldEQ(FieldCoinsSpent, v))
+}
+
+// ReleasedAt applies equality check predicate on the "released_at" field. It's identical to ReleasedAtEQ.
+func ReleasedAt(v time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// OldUsernameEQ applies the EQ predicate on the "old_username" field.
+func OldUsernameEQ(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldEQ(FieldOldUsername, v))
+}
+
+// OldUsernameNEQ applies the NEQ predicate on the "old_username" field.
+func OldUsernameNEQ(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldNEQ(FieldOldUsername, v))
+}
+
+// OldUsernameIn applies the In predicate on the "old_username" field.
+func OldUsernameIn(vs ...string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldIn(FieldOldUsername, vs...))
+}
+
+// OldUsernameNotIn applies the NotIn predicate on the "old_username" field.
+func OldUsernameNotIn(vs ...string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldNotIn(FieldOldUsername, vs...))
+}
+
+// OldUsernameGT applies the GT predicate on the "old_username" field.
+func OldUsernameGT(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldGT(FieldOldUsername, v))
+}
+
+// OldUsernameGTE applies the GTE predicate on the "old_username" field.
+func OldUsernameGTE(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldGTE(FieldOldUsername, v))
+}
+
+// OldUsernameLT applies the LT predicate on the "old_username" field.
+func OldUsernameLT(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldLT(FieldOldUsername, v))
+}
+
+// OldUsernameLTE applies the LTE predicate on the "old_username" field.
+func OldUsernameLTE(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldLTE(FieldOldUsername, v))
+}
+
+// OldUsernameContains applies the Contains predicate on the "old_username" field.
+func OldUsernameContains(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldContains(FieldOldUsername, v))
+}
+
+// OldUsernameHasPrefix applies the HasPrefix predicate on the "old_username" field.
+func OldUsernameHasPrefix(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldHasPrefix(FieldOldUsername, v))
+}
+
+// OldUsernameHasSuffix applies the HasSuffix predicate on the "old_username" field.
+func OldUsernameHasSuffix(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldHasSuffix(FieldOldUsername, v))
+}
+
+// OldUsernameEqualFold applies the EqualFold predicate on the "old_username" field.
+func OldUsernameEqualFold(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldEqualFold(FieldOldUsername, v))
+}
+
+// OldUsernameContainsFold applies the ContainsFold predicate on the "old_username" field.
+func OldUsernameContainsFold(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldContainsFold(FieldOldUsername, v))
+}
+
+// NewUsernameEQ applies the EQ predicate on the "new_username" field.
+func NewUsernameEQ(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldEQ(FieldNewUsername, v))
+}
+
+// NewUsernameNEQ applies the NEQ predicate on the "new_username" field.
+func NewUsernameNEQ(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldNEQ(FieldNewUsername, v))
+}
+
+// NewUsernameIn applies the In predicate on the "new_username" field.
+func NewUsernameIn(vs ...string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldIn(FieldNewUsername, vs...))
+}
+
+// NewUsernameNotIn applies the NotIn predicate on the "new_username" field.
+func NewUsernameNotIn(vs ...string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldNotIn(FieldNewUsername, vs...))
+}
+
+// NewUsernameGT applies the GT predicate on the "new_username" field.
+func NewUsernameGT(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldGT(FieldNewUsername, v))
+}
+
+// NewUsernameGTE applies the GTE predicate on the "new_username" field.
+func NewUsernameGTE(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldGTE(FieldNewUsername, v))
+}
+
+// NewUsernameLT applies the LT predicate on the "new_username" field.
+func NewUsernameLT(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldLT(FieldNewUsername, v))
+}
+
+// NewUsernameLTE applies the LTE predicate on the "new_username" field.
+func NewUsernameLTE(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldLTE(FieldNewUsername, v))
+}
+
+// NewUsernameContains applies the Contains predicate on the "new_username" field.
+func NewUsernameContains(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldContains(FieldNewUsername, v))
+}
+
+// NewUsernameHasPrefix applies the HasPrefix predicate on the "new_username" field.
+func NewUsernameHasPrefix(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldHasPrefix(FieldNewUsername, v))
+}
+
+// NewUsernameHasSuffix applies the HasSuffix predicate on the "new_username" field.
+func NewUsernameHasSuffix(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldHasSuffix(FieldNewUsername, v))
+}
+
+// NewUsernameEqualFold applies the EqualFold predicate on the "new_username" field.
+func NewUsernameEqualFold(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldEqualFold(FieldNewUsername, v))
+}
+
+// NewUsernameContainsFold applies the ContainsFold predicate on the "new_username" field.
+func NewUsernameContainsFold(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldContainsFold(FieldNewUsername, v))
+}
+
+// CoinsSpentEQ applies the EQ predicate on the "coins_spent" field.
+func CoinsSpentEQ(v float64) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldEQ(FieldCoinsSpent, v))
+}
+
+// CoinsSpentNEQ applies the NEQ predicate on the "coins_spent" field.
+func CoinsSpentNEQ(v float64) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldNEQ(FieldCoinsSpent, v))
+}
+
+// CoinsSpentIn applies the In predicate on the "coins_spent" field.
+func CoinsSpentIn(vs ...float64) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldIn(FieldCoinsSpent, vs...))
+}
+
+// CoinsSpentNotIn applies the NotIn predicate on the "coins_spent" field.
+func CoinsSpentNotIn(vs ...float64) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldNotIn(FieldCoinsSpent, vs...))
+}
+
+// CoinsSpentGT applies the GT predicate on the "coins_spent" field.
+func CoinsSpentGT(v float64) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldGT(FieldCoinsSpent, v))
+}
+
+// CoinsSpentGTE applies the GTE predicate on the "coins_spent" field.
+func CoinsSpentGTE(v float64) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldGTE(FieldCoinsSpent, v))
+}
+
+// CoinsSpentLT applies the LT predicate on the "coins_spent" field.
+func CoinsSpentLT(v float64) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldLT(FieldCoinsSpent, v))
+}
+
+// CoinsSpentLTE applies the LTE predicate on the "coins_spent" field.
+func CoinsSpentLTE(v float64) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldLTE(FieldCoinsSpent, v))
+}
+
+// ReleasedAtEQ applies the EQ predicate on the "released_at" field.
+func ReleasedAtEQ(v time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtNEQ applies the NEQ predicate on the "released_at" field.
+func ReleasedAtNEQ(v time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldNEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtIn applies the In predicate on the "released_at" field.
+func ReleasedAtIn(vs ...time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtNotIn applies the NotIn predicate on the "released_at" field.
+func ReleasedAtNotIn(vs ...time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldNotIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtGT applies the GT predicate on the "released_at" field.
+func ReleasedAtGT(v time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldGT(FieldReleasedAt, v))
+}
+
+// ReleasedAtGTE applies the GTE predicate on the "released_at" field.
+func ReleasedAtGTE(v time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldGTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtLT applies the LT predicate on the "released_at" field.
+func ReleasedAtLT(v time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldLT(FieldReleasedAt, v))
+}
+
+// ReleasedAtLTE applies the LTE predicate on the "released_at" field.
+func ReleasedAtLTE(v time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldLTE(FieldReleasedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.UsernameHistory {
+	return predicate.UsernameHistory(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.UsernameHistory {
+	return predicate.UsernameHistory(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UsernameHistory) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UsernameHistory) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UsernameHistory) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.NotPredicates(p))
+}
@@ -0,0 +1,313 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/usernamehistory"
+)
+
+// UsernameHistoryCreate is the builder for creating a UsernameHistory entity.
+type UsernameHistoryCreate struct {
+	config
+	mutation *UsernameHistoryMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (uhc *UsernameHistoryCreate) SetUserID(i int) *UsernameHistoryCreate {
+	uhc.mutation.SetUserID(i)
+	return uhc
+}
+
+// SetOldUsername sets the "old_username" field.
+func (uhc *UsernameHistoryCreate) SetOldUsername(s string) *UsernameHistoryCreate {
+	uhc.mutation.SetOldUsername(s)
+	return uhc
+}
+
+// SetNewUsername sets the "new_username" field.
+func (uhc *UsernameHistoryCreate) SetNewUsername(s string) *UsernameHistoryCreate {
+	uhc.mutation.SetNewUsername(s)
+	return uhc
+}
+
+// SetCoinsSpent sets the "coins_spent" field.
+func (uhc *UsernameHistoryCreate) SetCoinsSpent(f float64) *UsernameHistoryCreate {
+	uhc.mutation.SetCoinsSpent(f)
+	return uhc
+}
+
+// SetNillableCoinsSpent sets the "coins_spent" field if the given value is not nil.
+func (uhc *UsernameHistoryCreate) SetNillableCoinsSpent(f *float64) *UsernameHistoryCreate {
+	if f != nil {
+		uhc.SetCoinsSpent(*f)
+	}
+	return uhc
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (uhc *UsernameHistoryCreate) SetReleasedAt(t time.Time) *UsernameHistoryCreate {
+	uhc.mutation.SetReleasedAt(t)
+	return uhc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (uhc *UsernameHistoryCreate) SetCreatedAt(t time.Time) *UsernameHistoryCreate {
+	uhc.mutation.SetCreatedAt(t)
+	return uhc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (uhc *UsernameHistoryCreate) SetNillableCreatedAt(t *time.Time) *UsernameHistoryCreate {
+	if t != nil {
+		uhc.SetCreatedAt(*t)
+	}
+	return uhc
+}
+
+// SetID sets the "id" field.
+func (uhc *UsernameHistoryCreate) SetID(i int) *UsernameHistoryCreate {
+	uhc.mutation.SetID(i)
+	return uhc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (uhc *UsernameHistoryCreate) SetUser(u *User) *UsernameHistoryCreate {
+	return uhc.SetUserID(u.ID)
+}
+
+// Mutation returns the UsernameHistoryMutation object of the builder.
+func (uhc *UsernameHistoryCreate) Mutation() *UsernameHistoryMutation {
+	return uhc.mutation
+}
+
+// Save creates the UsernameHistory in the database.
+func (uhc *UsernameHistoryCreate) Save(ctx context.Context) (*UsernameHistory, error) {
+	uhc.defaults()
+	return withHooks(ctx, uhc.sqlSave, uhc.mutation, uhc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (uhc *UsernameHistoryCreate) SaveX(ctx context.Context) *UsernameHistory {
+	v, err := uhc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uhc *UsernameHistoryCreate) Exec(ctx context.Context) error {
+	_, err := uhc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uhc *UsernameHistoryCreate) ExecX(ctx context.Context) {
+	if err := uhc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uhc *UsernameHistoryCreate) defaults() {
+	if _, ok := uhc.mutation.CoinsSpent(); !ok {
+		v := usernamehistory.DefaultCoinsSpent
+		uhc.mutation.SetCoinsSpent(v)
+	}
+	if _, ok := uhc.mutation.CreatedAt(); !ok {
+		v := usernamehistory.DefaultCreatedAt()
+		uhc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uhc *UsernameHistoryCreate) check() error {
+	if _, ok := uhc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UsernameHistory.user_id"`)}
+	}
+	if _, ok := uhc.mutation.OldUsername(); !ok {
+		return &ValidationError{Name: "old_username", err: errors.New(`ent: missing required field "UsernameHistory.old_username"`)}
+	}
+	if _, ok := uhc.mutation.NewUsername(); !ok {
+		return &ValidationError{Name: "new_username", err: errors.New(`ent: missing required field "UsernameHistory.new_username"`)}
+	}
+	if _, ok := uhc.mutation.CoinsSpent(); !ok {
+		return &ValidationError{Name: "coins_spent", err: errors.New(`ent: missing required field "UsernameHistory.coins_spent"`)}
+	}
+	if _, ok := uhc.mutation.ReleasedAt(); !ok {
+		return &ValidationError{Name: "released_at", err: errors.New(`ent: missing required field "UsernameHistory.released_at"`)}
+	}
+	if _, ok := uhc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UsernameHistory.created_at"`)}
+	}
+	if len(uhc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "UsernameHistory.user"`)}
+	}
+	return nil
+}
+
+func (uhc *UsernameHistoryCreate) sqlSave(ctx context.Context) (*UsernameHistory, error) {
+	if err := uhc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := uhc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, uhc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	uhc.mutation.id = &_node.ID
+	uhc.mutation.done = true
+	return _node, nil
+}
+
+func (uhc *UsernameHistoryCreate) createSpec() (*UsernameHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UsernameHistory{config: uhc.config}
+		_spec = sqlgraph.NewCreateSpec(usernamehistory.Table, sqlgraph.NewFieldSpec(usernamehistory.FieldID, field.TypeInt))
+	)
+	if id, ok := uhc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := uhc.mutation.OldUsername(); ok {
+		_spec.SetField(usernamehistory.FieldOldUsername, field.TypeString, value)
+		_node.OldUsername = value
+	}
+	if value, ok := uhc.mutation.NewUsername(); ok {
+		_spec.SetField(usernamehistory.FieldNewUsername, field.TypeString, value)
+		_node.NewUsername = value
+	}
+	if value, ok := uhc.mutation.CoinsSpent(); ok {
+		_spec.SetField(usernamehistory.FieldCoinsSpent, field.TypeFloat64, value)
+		_node.CoinsSpent = value
+	}
+	if value, ok := uhc.mutation.ReleasedAt(); ok {
+		_spec.SetField(usernamehistory.FieldReleasedAt, field.TypeTime, value)
+		_node.ReleasedAt = value
+	}
+	if value, ok := uhc.mutation.CreatedAt(); ok {
+		_spec.SetField(usernamehistory.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := uhc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usernamehistory.UserTable,
+			Columns: []string{usernamehistory.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// UsernameHistoryCreateBulk is the builder for creating many UsernameHistory entities in bulk.
+type UsernameHistoryCreateBulk struct {
+	config
+	err      error
+	builders []*UsernameHistoryCreate
+}
+
+// Save creates the UsernameHistory entities in the database.
+func (uhcb *UsernameHistoryCreateBulk) Save(ctx context.Context) ([]*UsernameHistory, error) {
+	if uhcb.err != nil {
+		return nil, uhcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(uhcb.builders))
+	nodes := make([]*UsernameHistory, len(uhcb.builders))
+	mutators := make([]Mutator, len(uhcb.builders))
+	for i := range uhcb.builders {
+		func(i int, root context.Context) {
+			builder := uhcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UsernameHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, uhcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, uhcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, uhcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uhcb *UsernameHistoryCreateBulk) SaveX(ctx context.Context) []*UsernameHistory {
+	v, err := uhcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uhcb *UsernameHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := uhcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uhcb *UsernameHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := uhcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/usernamehistory"
+)
+
+// UsernameHistoryDelete is the builder for deleting a UsernameHistory entity.
+type UsernameHistoryDelete struct {
+	config
+	hooks    []Hook
+	mutation *UsernameHistoryMutation
+}
+
+// Where appends a list predicates to the UsernameHistoryDelete builder.
+func (uhd *UsernameHistoryDelete) Where(ps ...predicate.UsernameHistory) *UsernameHistoryDelete {
+	uhd.mutation.Where(ps...)
+	return uhd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (uhd *UsernameHistoryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, uhd.sqlExec, uhd.mutation, uhd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uhd *UsernameHistoryDelete) ExecX(ctx context.Context) int {
+	n, err := uhd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (uhd *UsernameHistoryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(usernamehistory.Table, sqlgraph.NewFieldSpec(usernamehistory.FieldID, field.TypeInt))
+	if ps := uhd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, uhd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	uhd.mutation.done = true
+	return affected, err
+}
+
+// UsernameHistoryDeleteOne is the builder for deleting a single UsernameHistory entity.
+type UsernameHistoryDeleteOne struct {
+	uhd *UsernameHistoryDelete
+}
+
+// Where appends a list predicates to the UsernameHistoryDelete builder.
+func (uhdo *UsernameHistoryDeleteOne) Where(ps ...predicate.UsernameHistory) *UsernameHistoryDeleteOne {
+	uhdo.uhd.mutation.Where(ps...)
+	return uhdo
+}
+
+// Exec executes the deletion query.
+func (uhdo *UsernameHistoryDeleteOne) Exec(ctx context.Context) error {
+	n, err := uhdo.uhd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{usernamehistory.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uhdo *UsernameHistoryDeleteOne) ExecX(ctx context.Context) {
+	if err := uhdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
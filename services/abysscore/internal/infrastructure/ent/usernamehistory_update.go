@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/usernamehistory"
+)
+
+// UsernameHistoryUpdate is the builder for updating UsernameHistory entities.
+type UsernameHistoryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UsernameHistoryMutation
+}
+
+// Where appends a list predicates to the UsernameHistoryUpdate builder.
+func (uhu *UsernameHistoryUpdate) Where(ps ...predicate.UsernameHistory) *UsernameHistoryUpdate {
+	uhu.mutation.Where(ps...)
+	return uhu
+}
+
+// Mutation returns the UsernameHistoryMutation object of the builder.
+func (uhu *UsernameHistoryUpdate) Mutation() *UsernameHistoryMutation {
+	return uhu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (uhu *UsernameHistoryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, uhu.sqlSave, uhu.mutation, uhu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uhu *UsernameHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := uhu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (uhu *UsernameHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := uhu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uhu *UsernameHistoryUpdate) ExecX(ctx context.Context) {
+	if err := uhu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uhu *UsernameHistoryUpdate) check() error {
+	if uhu.mutation.UserCleared() && len(uhu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UsernameHistory.user"`)
+	}
+	return nil
+}
+
+func (uhu *UsernameHistoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := uhu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usernamehistory.Table, usernamehistory.Columns, sqlgraph.NewFieldSpec(usernamehistory.FieldID, field.TypeInt))
+	if ps := uhu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, uhu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usernamehistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uhu.mutation.done = true
+	return n, nil
+}
+
+// UsernameHistoryUpdateOne is the builder for updating a single UsernameHistory entity.
+type UsernameHistoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UsernameHistoryMutation
+}
+
+// Mutation returns the UsernameHistoryMutation object of the builder.
+func (uhuo *UsernameHistoryUpdateOne) Mutation() *UsernameHistoryMutation {
+	return uhuo.mutation
+}
+
+// Where appends a list predicates to the UsernameHistoryUpdate builder.
+func (uhuo *UsernameHistoryUpdateOne) Where(ps ...predicate.UsernameHistory) *UsernameHistoryUpdateOne {
+	uhuo.mutation.Where(ps...)
+	return uhuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (uhuo *UsernameHistoryUpdateOne) Select(field string, fields ...string) *UsernameHistoryUpdateOne {
+	uhuo.fields = append([]string{field}, fields...)
+	return uhuo
+}
+
+// Save executes the query and returns the updated UsernameHistory entity.
+func (uhuo *UsernameHistoryUpdateOne) Save(ctx context.Context) (*UsernameHistory, error) {
+	return withHooks(ctx, uhuo.sqlSave, uhuo.mutation, uhuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uhuo *UsernameHistoryUpdateOne) SaveX(ctx context.Context) *UsernameHistory {
+	node, err := uhuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (uhuo *UsernameHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := uhuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uhuo *UsernameHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := uhuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uhuo *UsernameHistoryUpdateOne) check() error {
+	if uhuo.mutation.UserCleared() && len(uhuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UsernameHistory.user"`)
+	}
+	return nil
+}
+
+func (uhuo *UsernameHistoryUpdateOne) sqlSave(ctx context.Context) (_node *UsernameHistory, err error) {
+	if err := uhuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usernamehistory.Table, usernamehistory.Columns, sqlgraph.NewFieldSpec(usernamehistory.FieldID, field.TypeInt))
+	id, ok := uhuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UsernameHistory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := uhuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usernamehistory.FieldID)
+		for _, f := range fields {
+			if !usernamehistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != usernamehistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := uhuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &UsernameHistory{config: uhuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, uhuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usernamehistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	uhuo.mutation.done = true
+	return _node, nil
+}
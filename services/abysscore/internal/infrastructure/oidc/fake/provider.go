@@ -0,0 +1,302 @@
+// Package fake contains in-process OIDC provider for development and manual testing.
+// It approves every authorization request without user interaction.
+package fake
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/oidc"
+	"github.com/intezya/pkglib/logger"
+	jsoniter "github.com/json-iterator/go"
+)
+
+const (
+	ProviderName = "fake"
+
+	keyID          = "fake-key"
+	rsaKeyBits     = 2048
+	codeBytes      = 32
+	codeTTL        = time.Minute
+	idTokenTTL     = 5 * time.Minute
+	defaultSubject = "fake-user"
+
+	readTimeout  = 5 * time.Second
+	writeTimeout = 5 * time.Second
+	idleTimeout  = 30 * time.Second
+)
+
+type authorizationCode struct {
+	clientID      string
+	redirectURI   string
+	codeChallenge string
+	nonce         string
+	subject       string
+	expiresAt     time.Time
+}
+
+// Provider implements authorization, token and JWKS endpoints of OIDC provider.
+// Identity is taken from login_hint query parameter, so different users can be emulated:
+// login_hint=alice gives subject "alice" with email "alice@fake.local".
+type Provider struct {
+	config     *oidc.Config
+	privateKey *rsa.PrivateKey
+
+	mu    sync.Mutex
+	codes map[string]*authorizationCode
+}
+
+func NewProvider(config *oidc.Config) (*Provider, error) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Provider{
+		config:     config,
+		privateKey: privateKey,
+		codes:      make(map[string]*authorizationCode),
+	}, nil
+}
+
+// Config returns client configuration pointing to endpoints of provider listening on port.
+func Config(port int, redirectURL string) *oidc.Config {
+	issuer := fmt.Sprintf("http://localhost:%d", port)
+
+	return &oidc.Config{
+		Name:         ProviderName,
+		Issuer:       issuer,
+		ClientID:     "abysscore",
+		ClientSecret: "fake-secret",
+		AuthURL:      issuer + "/authorize",
+		TokenURL:     issuer + "/token",
+		JWKSURL:      issuer + "/jwks",
+		RedirectURL:  redirectURL,
+		Scopes:       []string{"openid", "email", "profile"},
+	}
+}
+
+// Run starts provider on separate port in background.
+func Run(config *oidc.Config, port int) {
+	provider, err := NewProvider(config)
+	if err != nil {
+		logger.Log.Warnf("Fake OIDC provider is not started: %v", err)
+
+		return
+	}
+
+	go func() {
+		server := &http.Server{ //nolint:exhaustruct
+			Addr:         fmt.Sprintf(":%d", port),
+			Handler:      provider.Handler(),
+			ReadTimeout:  readTimeout,
+			WriteTimeout: writeTimeout,
+			IdleTimeout:  idleTimeout,
+		}
+
+		logger.Log.Infof("Starting fake OIDC provider on port %d", port)
+
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Log.Warnf("Fake OIDC provider error: %v", err)
+		}
+	}()
+}
+
+func (p *Provider) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /authorize", p.authorize)
+	mux.HandleFunc("POST /token", p.token)
+	mux.HandleFunc("GET /jwks", p.jwks)
+
+	return mux
+}
+
+func (p *Provider) authorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	if query.Get("response_type") != "code" ||
+		query.Get("client_id") != p.config.ClientID ||
+		query.Get("code_challenge_method") != "S256" ||
+		query.Get("code_challenge") == "" {
+		http.Error(w, "invalid_request", http.StatusBadRequest)
+
+		return
+	}
+
+	redirectURI, err := url.Parse(query.Get("redirect_uri"))
+	if err != nil || query.Get("redirect_uri") != p.config.RedirectURL {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+
+		return
+	}
+
+	subject := query.Get("login_hint")
+	if subject == "" {
+		subject = defaultSubject
+	}
+
+	code := randomString()
+
+	p.mu.Lock()
+	p.removeExpiredCodes()
+	p.codes[code] = &authorizationCode{
+		clientID:      query.Get("client_id"),
+		redirectURI:   query.Get("redirect_uri"),
+		codeChallenge: query.Get("code_challenge"),
+		nonce:         query.Get("nonce"),
+		subject:       subject,
+		expiresAt:     time.Now().Add(codeTTL),
+	}
+	p.mu.Unlock()
+
+	values := redirectURI.Query()
+	values.Set("code", code)
+	values.Set("state", query.Get("state"))
+	redirectURI.RawQuery = values.Encode()
+
+	http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+}
+
+func (p *Provider) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeTokenError(w, "invalid_request")
+
+		return
+	}
+
+	if r.PostForm.Get("grant_type") != "authorization_code" {
+		writeTokenError(w, "unsupported_grant_type")
+
+		return
+	}
+
+	if r.PostForm.Get("client_id") != p.config.ClientID ||
+		subtle.ConstantTimeCompare(
+			[]byte(r.PostForm.Get("client_secret")),
+			[]byte(p.config.ClientSecret),
+		) != 1 {
+		writeTokenError(w, "invalid_client")
+
+		return
+	}
+
+	// Codes are single use, even failed exchange consumes the code
+	p.mu.Lock()
+	code, ok := p.codes[r.PostForm.Get("code")]
+	delete(p.codes, r.PostForm.Get("code"))
+	p.mu.Unlock()
+
+	if !ok ||
+		time.Now().After(code.expiresAt) ||
+		code.clientID != r.PostForm.Get("client_id") ||
+		code.redirectURI != r.PostForm.Get("redirect_uri") ||
+		!verifyCodeChallenge(code.codeChallenge, r.PostForm.Get("code_verifier")) {
+		writeTokenError(w, "invalid_grant")
+
+		return
+	}
+
+	idToken, err := p.signIDToken(code)
+	if err != nil {
+		http.Error(w, "server_error", http.StatusInternalServerError)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": randomString(),
+		"token_type":   "Bearer",
+		"expires_in":   int(idTokenTTL.Seconds()),
+		"id_token":     idToken,
+	})
+}
+
+func (p *Provider) jwks(w http.ResponseWriter, _ *http.Request) {
+	publicKey := p.privateKey.PublicKey
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"keys": []map[string]string{
+			{
+				"kty": "RSA",
+				"kid": keyID,
+				"use": "sig",
+				"alg": jwt.SigningMethodRS256.Alg(),
+				"n":   base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+				"e": base64.RawURLEncoding.EncodeToString(
+					big.NewInt(int64(publicKey.E)).Bytes(),
+				),
+			},
+		},
+	})
+}
+
+func (p *Provider) signIDToken(code *authorizationCode) (string, error) {
+	now := time.Now()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":                p.config.Issuer,
+		"sub":                code.subject,
+		"aud":                code.clientID,
+		"iat":                now.Unix(),
+		"exp":                now.Add(idTokenTTL).Unix(),
+		"nonce":              code.nonce,
+		"email":              code.subject + "@fake.local",
+		"email_verified":     true,
+		"preferred_username": code.subject,
+	})
+	token.Header["kid"] = keyID
+
+	return token.SignedString(p.privateKey)
+}
+
+// removeExpiredCodes must be called with mu held.
+func (p *Provider) removeExpiredCodes() {
+	now := time.Now()
+
+	for code, data := range p.codes {
+		if now.After(data.expiresAt) {
+			delete(p.codes, code)
+		}
+	}
+}
+
+func verifyCodeChallenge(challenge, verifier string) bool {
+	if verifier == "" {
+		return false
+	}
+
+	hash := sha256.Sum256([]byte(verifier))
+	expected := base64.RawURLEncoding.EncodeToString(hash[:])
+
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(challenge)) == 1
+}
+
+func randomString() string {
+	bytes := make([]byte, codeBytes)
+	_, _ = rand.Read(bytes)
+
+	return base64.RawURLEncoding.EncodeToString(bytes)
+}
+
+func writeTokenError(w http.ResponseWriter, code string) {
+	writeJSON(w, http.StatusBadRequest, map[string]string{"error": code})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+
+	_ = jsoniter.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,152 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	jsoniter "github.com/json-iterator/go"
+)
+
+const (
+	// keySetRefreshInterval limits refetching of provider keys when token is signed with unknown key.
+	keySetRefreshInterval = time.Minute
+	maxKeySetSize         = 1 << 20
+)
+
+var (
+	ErrUnknownKey        = errors.New("id token is signed with unknown key")
+	ErrKeySetFetchFailed = errors.New("key set request failed")
+)
+
+type jsonWebKey struct {
+	KeyType string `json:"kty"`
+	KeyID   string `json:"kid"`
+	Use     string `json:"use"`
+	N       string `json:"n"`
+	E       string `json:"e"`
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+// keySet caches RSA signing keys of the provider, keys are refetched on rotation.
+type keySet struct {
+	url        string
+	httpClient *http.Client
+
+	mu        sync.Mutex
+	keys      map[string]*rsa.PublicKey
+	fetchedAt time.Time
+}
+
+func newKeySet(url string, httpClient *http.Client) *keySet {
+	return &keySet{url: url, httpClient: httpClient, keys: make(map[string]*rsa.PublicKey)}
+}
+
+func (s *keySet) keyFunc(ctx context.Context) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		keyID, _ := token.Header["kid"].(string)
+
+		return s.find(ctx, keyID)
+	}
+}
+
+func (s *keySet) find(ctx context.Context, keyID string) (*rsa.PublicKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if key, ok := s.keys[keyID]; ok {
+		return key, nil
+	}
+
+	if time.Since(s.fetchedAt) < keySetRefreshInterval {
+		return nil, ErrUnknownKey
+	}
+
+	keys, err := s.fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.keys = keys
+	s.fetchedAt = time.Now()
+
+	if key, ok := s.keys[keyID]; ok {
+		return key, nil
+	}
+
+	return nil, ErrUnknownKey
+}
+
+func (s *keySet) fetch(ctx context.Context) (map[string]*rsa.PublicKey, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %d", ErrKeySetFetchFailed, response.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, maxKeySetSize))
+	if err != nil {
+		return nil, err
+	}
+
+	var set jsonWebKeySet
+
+	err = jsoniter.Unmarshal(body, &set)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+
+	for _, key := range set.Keys {
+		if key.KeyType != "RSA" || (key.Use != "" && key.Use != "sig") {
+			continue
+		}
+
+		publicKey, err := parseRSAPublicKey(key.N, key.E)
+		if err != nil {
+			continue
+		}
+
+		keys[key.KeyID] = publicKey
+	}
+
+	return keys, nil
+}
+
+func parseRSAPublicKey(encodedN, encodedE string) (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(encodedN)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := base64.RawURLEncoding.DecodeString(encodedE)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(new(big.Int).SetBytes(e).Int64()),
+	}, nil
+}
@@ -0,0 +1,194 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	drivenports "github.com/intezya/abyssleague/services/abysscore/internal/domain/ports/driven"
+	jsoniter "github.com/json-iterator/go"
+)
+
+const (
+	httpTimeout          = 10 * time.Second
+	maxTokenResponseSize = 1 << 20
+)
+
+var (
+	ErrTokenRequestFailed = errors.New("token request failed")
+	ErrNoIDToken          = errors.New("token response has no id token")
+)
+
+// Config describes OIDC client registered at the provider.
+type Config struct {
+	Name         string
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	AuthURL      string
+	TokenURL     string
+	JWKSURL      string
+	// RedirectURL is the address of game client the provider redirects user back to
+	RedirectURL string
+	Scopes      []string
+}
+
+type idTokenClaims struct {
+	Email             string `json:"email"`
+	EmailVerified     bool   `json:"email_verified"`
+	PreferredUsername string `json:"preferred_username"`
+	Name              string `json:"name"`
+	Nonce             string `json:"nonce"`
+	jwt.RegisteredClaims
+}
+
+type tokenResponse struct {
+	IDToken string `json:"id_token"`
+}
+
+// Provider is a confidential OIDC client, it authenticates with client secret and PKCE both.
+type Provider struct {
+	config     *Config
+	httpClient *http.Client
+	keys       *keySet
+}
+
+func NewProvider(config *Config) *Provider {
+	httpClient := &http.Client{Timeout: httpTimeout} //nolint:exhaustruct // defaults
+
+	return &Provider{
+		config:     config,
+		httpClient: httpClient,
+		keys:       newKeySet(config.JWKSURL, httpClient),
+	}
+}
+
+// NewProviders returns providers by name.
+func NewProviders(configs []*Config) map[string]drivenports.OIDCProvider {
+	providers := make(map[string]drivenports.OIDCProvider, len(configs))
+
+	for _, config := range configs {
+		providers[config.Name] = NewProvider(config)
+	}
+
+	return providers
+}
+
+func (p *Provider) AuthorizationURL(state, nonce, codeChallenge string) string {
+	query := url.Values{}
+	query.Set("response_type", "code")
+	query.Set("client_id", p.config.ClientID)
+	query.Set("redirect_uri", p.config.RedirectURL)
+	query.Set("scope", strings.Join(p.config.Scopes, " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", codeChallenge)
+	query.Set("code_challenge_method", "S256")
+
+	separator := "?"
+	if strings.Contains(p.config.AuthURL, "?") {
+		separator = "&"
+	}
+
+	return p.config.AuthURL + separator + query.Encode()
+}
+
+func (p *Provider) Exchange(
+	ctx context.Context,
+	code, codeVerifier string,
+) (*drivenports.OIDCIdentity, error) {
+	idToken, err := p.requestIDToken(ctx, code, codeVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := &idTokenClaims{}
+
+	_, err = jwt.ParseWithClaims(
+		idToken,
+		claims,
+		p.keys.keyFunc(ctx),
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}),
+		jwt.WithIssuer(p.config.Issuer),
+		jwt.WithAudience(p.config.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid id token: %w", err)
+	}
+
+	identity := &drivenports.OIDCIdentity{
+		Subject:           claims.Subject,
+		PreferredUsername: claims.PreferredUsername,
+		Nonce:             claims.Nonce,
+	}
+
+	if identity.PreferredUsername == "" {
+		identity.PreferredUsername = claims.Name
+	}
+
+	// Unverified email may belong to someone else
+	if claims.Email != "" && claims.EmailVerified {
+		identity.Email = &claims.Email
+	}
+
+	return identity, nil
+}
+
+func (p *Provider) requestIDToken(ctx context.Context, code, codeVerifier string) (string, error) {
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.config.RedirectURL)
+	form.Set("client_id", p.config.ClientID)
+	form.Set("client_secret", p.config.ClientSecret)
+	form.Set("code_verifier", codeVerifier)
+
+	request, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		p.config.TokenURL,
+		strings.NewReader(form.Encode()),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+
+	response, err := p.httpClient.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, maxTokenResponseSize))
+	if err != nil {
+		return "", err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%w: %d %s", ErrTokenRequestFailed, response.StatusCode, body)
+	}
+
+	var token tokenResponse
+
+	err = jsoniter.Unmarshal(body, &token)
+	if err != nil {
+		return "", err
+	}
+
+	if token.IDToken == "" {
+		return "", ErrNoIDToken
+	}
+
+	return token.IDToken, nil
+}
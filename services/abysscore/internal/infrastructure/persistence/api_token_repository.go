@@ -0,0 +1,130 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/mapper"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/apitoken"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/intezya/pkglib/itertools"
+)
+
+type APITokenRepository struct {
+	client *ent.Client
+}
+
+func NewAPITokenRepository(client *ent.Client) *APITokenRepository {
+	return &APITokenRepository{client: client}
+}
+
+func (r *APITokenRepository) Create(
+	ctx context.Context,
+	token *dto.CreateAPITokenDTO,
+	maxPerUser int,
+) (*dto.APITokenDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "APITokenRepository.Create")
+	defer span.End()
+
+	return withTxResult(ctx, r.client, func(tx *ent.Tx) (*dto.APITokenDTO, error) {
+		// Expired tokens are useless, they don't count towards the limit
+		count, err := tx.APIToken.Query().
+			Where(
+				apitoken.UserIDEQ(token.UserID),
+				apitoken.ExpiresAtGT(time.Now()),
+			).
+			Count(ctx)
+		if err != nil {
+			return nil, apperrors.WrapUnexpectedError(err)
+		}
+
+		if count >= maxPerUser {
+			return nil, apperrors.ErrAPITokenLimitReached
+		}
+
+		created, err := tx.APIToken.Create().
+			SetUserID(token.UserID).
+			SetName(token.Name).
+			SetTokenHash(token.TokenHash).
+			SetTokenPrefix(token.TokenPrefix).
+			SetScopes(token.Scopes).
+			SetExpiresAt(token.ExpiresAt).
+			Save(ctx)
+		if err != nil {
+			return nil, apperrors.WrapUnexpectedError(err)
+		}
+
+		return mapper.ToAPITokenDTOFromEnt(created), nil
+	})
+}
+
+func (r *APITokenRepository) FindByUserID(ctx context.Context, userID int) ([]*dto.APITokenDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "APITokenRepository.FindByUserID")
+	defer span.End()
+
+	tokens, err := r.client.APIToken.Query().
+		Where(apitoken.UserIDEQ(userID)).
+		Order(ent.Desc(apitoken.FieldCreatedAt), ent.Desc(apitoken.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return itertools.Map(tokens, mapper.ToAPITokenDTOFromEnt), nil
+}
+
+func (r *APITokenRepository) FindValidByHash(ctx context.Context, tokenHash string) (*dto.APITokenDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "APITokenRepository.FindValidByHash")
+	defer span.End()
+
+	token, err := r.client.APIToken.Query().
+		Where(
+			apitoken.TokenHashEQ(tokenHash),
+			apitoken.ExpiresAtGT(time.Now()),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, apperrors.ErrInvalidAPIToken
+	}
+
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return mapper.ToAPITokenDTOFromEnt(token), nil
+}
+
+func (r *APITokenRepository) TouchLastUsed(ctx context.Context, id int, usedAt time.Time) error {
+	ctx, span := tracer.StartSpan(ctx, "APITokenRepository.TouchLastUsed")
+	defer span.End()
+
+	err := r.client.APIToken.UpdateOneID(id).
+		SetLastUsedAt(usedAt).
+		Exec(ctx)
+	if err != nil && !ent.IsNotFound(err) { // token may be revoked concurrently
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	return nil
+}
+
+func (r *APITokenRepository) DeleteByIDAndUserID(ctx context.Context, id, userID int) error {
+	ctx, span := tracer.StartSpan(ctx, "APITokenRepository.DeleteByIDAndUserID")
+	defer span.End()
+
+	affected, err := r.client.APIToken.Delete().
+		Where(apitoken.IDEQ(id), apitoken.UserIDEQ(userID)).
+		Exec(ctx)
+	if err != nil {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	if affected == 0 {
+		return apperrors.WrapAPITokenNotFound(nil)
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package persistence
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/mapper"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/auditlogentity"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/auditlog"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/intezya/pkglib/itertools"
+)
+
+type AuditLogRepository struct {
+	client *ent.Client
+}
+
+func NewAuditLogRepository(client *ent.Client) *AuditLogRepository {
+	return &AuditLogRepository{client: client}
+}
+
+func (r *AuditLogRepository) Create(ctx context.Context, record *dto.CreateAuditLogDTO) error {
+	ctx, span := tracer.StartSpan(ctx, "AuditLogRepository.Create")
+	defer span.End()
+
+	create := r.client.AuditLog.
+		Create().
+		SetActorID(record.ActorID).
+		SetActorUsername(record.ActorUsername).
+		SetAction(string(record.Action)).
+		SetTargetType(string(record.Target.Type)).
+		SetNillableTargetID(record.Target.ID).
+		SetNillableRequestID(record.RequestID).
+		SetNillableIP(record.IP)
+
+	if record.Before != nil {
+		create.SetBefore(record.Before)
+	}
+
+	if record.After != nil {
+		create.SetAfter(record.After)
+	}
+
+	err := create.Exec(ctx)
+	if err != nil {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	return nil
+}
+
+func (r *AuditLogRepository) FindPaged(
+	ctx context.Context,
+	filter *auditlogentity.Filter,
+	page, size int,
+) (*dto.PaginatedResult[*dto.AuditLogDTO], error) {
+	ctx, span := tracer.StartSpan(ctx, "AuditLogRepository.FindPaged")
+	defer span.End()
+
+	page = getValidPage(page)
+	size = getValidSize(size)
+	offset := countOffset(page, size)
+
+	query := r.client.AuditLog.
+		Query().
+		Where(filter.ToPredicates()...)
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	records, err := query.
+		Limit(size).
+		Offset(offset).
+		Order(auditlog.ByCreatedAt(sql.OrderDesc()), auditlog.ByID(sql.OrderDesc())).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return &dto.PaginatedResult[*dto.AuditLogDTO]{
+		Data:       itertools.Map(records, mapper.ToAuditLogDTOFromEnt),
+		Page:       page,
+		Size:       size,
+		TotalItems: total,
+		TotalPages: getTotalPages(total, size),
+	}, nil
+}
+
+func (r *AuditLogRepository) FindBatch(
+	ctx context.Context,
+	filter *auditlogentity.Filter,
+	afterID int,
+	limit int,
+) ([]*dto.AuditLogDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "AuditLogRepository.FindBatch")
+	defer span.End()
+
+	records, err := r.client.AuditLog.
+		Query().
+		Where(filter.ToPredicates()...).
+		Where(auditlog.IDGT(afterID)).
+		Order(auditlog.ByID()).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return itertools.Map(records, mapper.ToAuditLogDTOFromEnt), nil
+}
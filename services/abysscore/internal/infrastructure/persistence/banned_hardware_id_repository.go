@@ -3,12 +3,15 @@ package persistence
 import (
 	"context"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/mapper"
 	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/bannedhardwareid"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
 	"github.com/intezya/abyssleague/services/abysscore/pkg/optional"
+	"github.com/intezya/pkglib/itertools"
 )
 
 type BannedHardwareIDRepository struct {
@@ -23,12 +26,26 @@ func (r *BannedHardwareIDRepository) Create(
 	ctx context.Context,
 	hardwareID string,
 	reason optional.String,
+	performerID int,
 ) (*dto.BannedHardwareID, error) {
 	ctx, span := tracer.StartSpan(ctx, "BannedHardwareIDRepository.Create")
 	defer span.End()
 
-	// TODO implement me
-	panic("implement me")
+	banned, err := r.client.BannedHardwareID.
+		Create().
+		SetHardwareID(hardwareID).
+		SetNillableBanReason(reason.ValueOrNil()).
+		SetPerformerID(performerID).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, apperrors.WrapHardwareIDAlreadyBanned(err)
+		}
+
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return mapper.ToBannedHardwareIDFromEnt(banned), nil
 }
 
 func (r *BannedHardwareIDRepository) FindByHardwareID(
@@ -38,8 +55,65 @@ func (r *BannedHardwareIDRepository) FindByHardwareID(
 	ctx, span := tracer.StartSpan(ctx, "BannedHardwareIDRepository.FindByHardwareID")
 	defer span.End()
 
-	// TODO implement me
-	panic("implement me")
+	found, err := r.client.BannedHardwareID.
+		Query().
+		Where(bannedhardwareid.HardwareIDEQ(hardwareID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrors.WrapBannedHardwareIDNotFound(err)
+		}
+
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return mapper.ToBannedHardwareIDFromEnt(found), nil
+}
+
+func (r *BannedHardwareIDRepository) FindPaged(
+	ctx context.Context,
+	search string,
+	page, size int,
+) (*dto.PaginatedResult[*dto.BannedHardwareID], error) {
+	ctx, span := tracer.StartSpan(ctx, "BannedHardwareIDRepository.FindPaged")
+	defer span.End()
+
+	page = getValidPage(page)
+	size = getValidSize(size)
+	offset := countOffset(page, size)
+
+	query := r.client.BannedHardwareID.Query()
+
+	if search != "" {
+		query.Where(
+			bannedhardwareid.Or(
+				bannedhardwareid.HardwareIDContainsFold(search),
+				bannedhardwareid.BanReasonContainsFold(search),
+			),
+		)
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	bans, err := query.
+		Limit(size).
+		Offset(offset).
+		Order(bannedhardwareid.ByCreatedAt(sql.OrderDesc()), bannedhardwareid.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return &dto.PaginatedResult[*dto.BannedHardwareID]{
+		Data:       itertools.Map(bans, mapper.ToBannedHardwareIDFromEnt),
+		Page:       page,
+		Size:       size,
+		TotalItems: total,
+		TotalPages: getTotalPages(total, size),
+	}, nil
 }
 
 func (r *BannedHardwareIDRepository) DeleteByHardwareID(
@@ -49,8 +123,19 @@ func (r *BannedHardwareIDRepository) DeleteByHardwareID(
 	ctx, span := tracer.StartSpan(ctx, "BannedHardwareIDRepository.DeleteByHardwareID")
 	defer span.End()
 
-	// TODO implement me
-	panic("implement me")
+	affected, err := r.client.BannedHardwareID.
+		Delete().
+		Where(bannedhardwareid.HardwareIDEQ(hardwareID)).
+		Exec(ctx)
+	if err != nil {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	if affected == 0 {
+		return apperrors.WrapBannedHardwareIDNotFound(nil)
+	}
+
+	return nil
 }
 
 func (r *BannedHardwareIDRepository) TxFindByHardwareID(
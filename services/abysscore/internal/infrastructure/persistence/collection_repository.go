@@ -0,0 +1,279 @@
+package persistence
+
+import (
+	"context"
+	"sort"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/mapper"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/collectioncompletion"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/collectionreward"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/gameitem"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/inventoryitem"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/intezya/pkglib/itertools"
+)
+
+type CollectionRepository struct {
+	client *ent.Client
+}
+
+func NewCollectionRepository(client *ent.Client) *CollectionRepository {
+	return &CollectionRepository{client: client}
+}
+
+func (r *CollectionRepository) FindProgressByUserID(
+	ctx context.Context,
+	userID int,
+) ([]*dto.CollectionProgressDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "CollectionRepository.FindProgressByUserID")
+	defer span.End()
+
+	return r.findProgress(ctx, userID)
+}
+
+func (r *CollectionRepository) FindProgressByUserIDAndCollection(
+	ctx context.Context,
+	userID int,
+	collection string,
+) (*dto.CollectionProgressDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "CollectionRepository.FindProgressByUserIDAndCollection")
+	defer span.End()
+
+	progress, err := r.findProgress(ctx, userID, gameitem.CollectionEQ(collection))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(progress) == 0 {
+		return nil, apperrors.WrapCollectionNotFound(nil)
+	}
+
+	return progress[0], nil
+}
+
+func (r *CollectionRepository) FindAllRewards(ctx context.Context) ([]*dto.CollectionRewardDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "CollectionRepository.FindAllRewards")
+	defer span.End()
+
+	rewards, err := r.client.CollectionReward.
+		Query().
+		Order(collectionreward.ByCollection()).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return itertools.Map(rewards, mapper.ToCollectionRewardDTOFromEnt), nil
+}
+
+func (r *CollectionRepository) UpsertReward(
+	ctx context.Context,
+	reward *dto.CollectionRewardDTO,
+) (*dto.CollectionRewardDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "CollectionRepository.UpsertReward")
+	defer span.End()
+
+	result, err := withTxResult(ctx, r.client, func(tx *ent.Tx) (*ent.CollectionReward, error) {
+		existing, err := tx.CollectionReward.
+			Query().
+			Where(collectionreward.CollectionEQ(reward.Collection)).
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, err
+		}
+
+		if existing == nil {
+			return tx.CollectionReward.
+				Create().
+				SetCollection(reward.Collection).
+				SetCoins(reward.Coins).
+				SetNillableTitle(reward.Title).
+				SetNillableBadgeItemID(reward.BadgeItemID).
+				Save(ctx)
+		}
+
+		update := tx.CollectionReward.
+			UpdateOne(existing).
+			SetCoins(reward.Coins)
+
+		if reward.Title != nil {
+			update.SetTitle(*reward.Title)
+		} else {
+			update.ClearTitle()
+		}
+
+		if reward.BadgeItemID != nil {
+			update.SetBadgeItemID(*reward.BadgeItemID)
+		} else {
+			update.ClearBadgeItemID()
+		}
+
+		return update.Save(ctx)
+	})
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return mapper.ToCollectionRewardDTOFromEnt(result), nil
+}
+
+func (r *CollectionRepository) CompleteAndReward(
+	ctx context.Context,
+	userID int,
+	collection string,
+) (*dto.CollectionCompletionDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "CollectionRepository.CompleteAndReward")
+	defer span.End()
+
+	result, err := withTxResult(ctx, r.client, func(tx *ent.Tx) (*dto.CollectionCompletionDTO, error) {
+		alreadyCompleted, err := tx.CollectionCompletion.
+			Query().
+			Where(
+				collectioncompletion.UserIDEQ(userID),
+				collectioncompletion.CollectionEQ(collection),
+			).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if alreadyCompleted {
+			return nil, nil //nolint:nilnil // reward has already been granted
+		}
+
+		// Unique (user_id, collection) index guarantees the reward is granted exactly once
+		// even if two grants complete the collection concurrently.
+		completion, err := tx.CollectionCompletion.
+			Create().
+			SetUserID(userID).
+			SetCollection(collection).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		result := &dto.CollectionCompletionDTO{
+			UserID:      userID,
+			Collection:  collection,
+			CompletedAt: completion.CompletedAt,
+		}
+
+		reward, err := tx.CollectionReward.
+			Query().
+			Where(collectionreward.CollectionEQ(collection)).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return result, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		result.Reward = mapper.ToCollectionRewardDTOFromEnt(reward)
+
+		if reward.Coins > 0 {
+			err = txAddCoins(ctx, tx, userID, reward.Coins)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if reward.Title != nil {
+			err = tx.User.
+				UpdateOneID(userID).
+				SetTitle(*reward.Title).
+				Exec(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if reward.BadgeItemID != nil {
+			result.BadgeItem, err = txGrantSystemItem(ctx, tx, userID, *reward.BadgeItemID)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return result, nil
+	})
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, nil //nolint:nilnil // completed concurrently
+		}
+
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return result, nil
+}
+
+func (r *CollectionRepository) findProgress(
+	ctx context.Context,
+	userID int,
+	predicates ...predicate.GameItem,
+) ([]*dto.CollectionProgressDTO, error) {
+	// Archived items can't be obtained anymore, so they don't count towards completion.
+	predicates = append(predicates, gameitem.ArchivedAtIsNil())
+
+	var totals []struct {
+		Collection string `json:"collection"`
+		Count      int    `json:"count"`
+	}
+
+	err := r.client.GameItem.
+		Query().
+		Where(predicates...).
+		GroupBy(gameitem.FieldCollection).
+		Aggregate(ent.Count()).
+		Scan(ctx, &totals)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	missingItems, err := r.client.GameItem.
+		Query().
+		Where(predicates...).
+		Where(gameitem.Not(gameitem.HasInventoryItemsWith(inventoryitem.UserIDEQ(userID)))).
+		Order(gameitem.ByRarity(), gameitem.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	missingByCollection := make(map[string][]*dto.GameItemDTO)
+	for _, item := range missingItems {
+		missingByCollection[item.Collection] = append(
+			missingByCollection[item.Collection],
+			mapper.ToGameItemDTOFromEnt(item),
+		)
+	}
+
+	result := make([]*dto.CollectionProgressDTO, 0, len(totals))
+
+	for _, total := range totals {
+		missing := missingByCollection[total.Collection]
+		if missing == nil {
+			missing = make([]*dto.GameItemDTO, 0)
+		}
+
+		result = append(result, &dto.CollectionProgressDTO{
+			Collection:   total.Collection,
+			Owned:        total.Count - len(missing),
+			Total:        total.Count,
+			Completed:    len(missing) == 0,
+			MissingItems: missing,
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Collection < result[j].Collection
+	})
+
+	return result, nil
+}
@@ -6,6 +6,10 @@ import (
 
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/migrate"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/role"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/access_level"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/schema/permission"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
 )
 
 const (
@@ -86,9 +90,104 @@ func SetupEnt(config *EntConfig, logger Logger) *ent.Client {
 		logger.Fatalf("failed to create schema (all attempts are over)")
 	}
 
+	migrated, err := migrateAccessLevelsToRoles(context.Background(), entClient)
+	if err != nil {
+		logger.Fatalf("failed to migrate access levels to roles: %v", err)
+	}
+
+	if migrated > 0 {
+		logger.Infof("Access levels of %d users migrated to roles", migrated)
+	}
+
+	err = syncTopLevelRole(context.Background(), entClient)
+	if err != nil {
+		logger.Fatalf("failed to sync %s role permissions: %v", access_level.Dev, err)
+	}
+
 	return entClient
 }
 
+// migrateAccessLevelsToRoles assigns each user with non-default access level a role
+// named after the level and resets the level, so running it again does nothing.
+func migrateAccessLevelsToRoles(ctx context.Context, client *ent.Client) (int, error) {
+	var migrated int
+
+	err := withTx(ctx, client, func(tx *ent.Tx) error {
+		users, err := tx.User.
+			Query().
+			Where(user.AccessLevelNEQ(access_level.User)).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+
+		roleIDs := make(map[access_level.AccessLevel]int)
+
+		for _, u := range users {
+			roleID, ok := roleIDs[u.AccessLevel]
+			if !ok {
+				roleID, err = txEnsureLegacyRole(ctx, tx, u.AccessLevel)
+				if err != nil {
+					return err
+				}
+
+				roleIDs[u.AccessLevel] = roleID
+			}
+
+			err = tx.User.
+				UpdateOneID(u.ID).
+				AddRoleIDs(roleID).
+				SetAccessLevel(access_level.User).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		migrated = len(users)
+
+		return nil
+	})
+
+	return migrated, err
+}
+
+// syncTopLevelRole grants all permissions to the system role migrated from the top access level.
+// Permissions can't be granted by those who don't have them, so new ones become grantable through it.
+// The role is found by flag, not by name, a role with the same name could be created through API.
+func syncTopLevelRole(ctx context.Context, client *ent.Client) error {
+	_, err := client.Role.
+		Update().
+		Where(role.SystemEQ(true)).
+		SetPermissions(permission.All()).
+		Save(ctx)
+
+	return err
+}
+
+func txEnsureLegacyRole(ctx context.Context, tx *ent.Tx, level access_level.AccessLevel) (int, error) {
+	existing, err := tx.Role.Query().Where(role.NameEQ(level.String())).Only(ctx)
+	if err == nil {
+		return existing.ID, nil
+	}
+
+	if !ent.IsNotFound(err) {
+		return 0, err
+	}
+
+	created, err := tx.Role.
+		Create().
+		SetName(level.String()).
+		SetPermissions(permission.FromAccessLevel(level)).
+		SetSystem(level == access_level.Dev).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return created.ID, nil
+}
+
 func gt0[T int | time.Duration](value T, fallback T) T {
 	if value <= 0 {
 		return fallback
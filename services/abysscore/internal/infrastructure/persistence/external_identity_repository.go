@@ -0,0 +1,169 @@
+package persistence
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/mapper"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/externalidentity"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/intezya/pkglib/itertools"
+)
+
+type ExternalIdentityRepository struct {
+	client *ent.Client
+}
+
+func NewExternalIdentityRepository(client *ent.Client) *ExternalIdentityRepository {
+	return &ExternalIdentityRepository{client: client}
+}
+
+func (r *ExternalIdentityRepository) Create(
+	ctx context.Context,
+	identity *dto.CreateExternalIdentityDTO,
+) (*dto.ExternalIdentityDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "ExternalIdentityRepository.Create")
+	defer span.End()
+
+	return r.create(ctx, r.client.ExternalIdentity, identity)
+}
+
+func (r *ExternalIdentityRepository) FindByUserID(
+	ctx context.Context,
+	userID int,
+) ([]*dto.ExternalIdentityDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "ExternalIdentityRepository.FindByUserID")
+	defer span.End()
+
+	identities, err := r.client.ExternalIdentity.Query().
+		Where(externalidentity.UserIDEQ(userID)).
+		Order(ent.Asc(externalidentity.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return itertools.Map(identities, mapper.ToExternalIdentityDTOFromEnt), nil
+}
+
+func (r *ExternalIdentityRepository) DeleteByIDAndUserID(ctx context.Context, id, userID int) error {
+	ctx, span := tracer.StartSpan(ctx, "ExternalIdentityRepository.DeleteByIDAndUserID")
+	defer span.End()
+
+	affected, err := r.client.ExternalIdentity.Delete().
+		Where(externalidentity.IDEQ(id), externalidentity.UserIDEQ(userID)).
+		Exec(ctx)
+	if err != nil {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	if affected == 0 {
+		return apperrors.WrapExternalIdentityNotFound(nil)
+	}
+
+	return nil
+}
+
+func (r *ExternalIdentityRepository) TxCreate(
+	ctx context.Context,
+	tx *ent.Tx,
+	identity *dto.CreateExternalIdentityDTO,
+) (*dto.ExternalIdentityDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "ExternalIdentityRepository.TxCreate")
+	defer span.End()
+
+	return r.create(ctx, tx.ExternalIdentity, identity)
+}
+
+func (r *ExternalIdentityRepository) create(
+	ctx context.Context,
+	client *ent.ExternalIdentityClient,
+	identity *dto.CreateExternalIdentityDTO,
+) (*dto.ExternalIdentityDTO, error) {
+	now := time.Now()
+
+	created, err := client.Create().
+		SetUserID(identity.UserID).
+		SetProvider(identity.Provider).
+		SetSubject(identity.Subject).
+		SetNillableEmail(identity.Email).
+		SetCreatedAt(now).
+		SetLastLoginAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, r.handleConstraintError(err)
+	}
+
+	return mapper.ToExternalIdentityDTOFromEnt(created), nil
+}
+
+func (r *ExternalIdentityRepository) TxFindUserIDByProviderAndSubject(
+	ctx context.Context,
+	tx *ent.Tx,
+	provider, subject string,
+) (int, error) {
+	ctx, span := tracer.StartSpan(ctx, "ExternalIdentityRepository.TxFindUserIDByProviderAndSubject")
+	defer span.End()
+
+	identity, err := tx.ExternalIdentity.Query().
+		Where(
+			externalidentity.ProviderEQ(provider),
+			externalidentity.SubjectEQ(subject),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return 0, apperrors.WrapExternalIdentityNotFound(err)
+	}
+
+	if err != nil {
+		return 0, apperrors.WrapUnexpectedError(err)
+	}
+
+	return identity.UserID, nil
+}
+
+func (r *ExternalIdentityRepository) TxTouch(
+	ctx context.Context,
+	tx *ent.Tx,
+	provider, subject string,
+	email *string,
+) error {
+	ctx, span := tracer.StartSpan(ctx, "ExternalIdentityRepository.TxTouch")
+	defer span.End()
+
+	update := tx.ExternalIdentity.Update().
+		Where(
+			externalidentity.ProviderEQ(provider),
+			externalidentity.SubjectEQ(subject),
+		).
+		SetLastLoginAt(time.Now())
+
+	if email != nil {
+		update.SetEmail(*email)
+	}
+
+	err := update.Exec(ctx)
+	if err != nil {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	return nil
+}
+
+// handleConstraintError maps violations of both unique indexes to conflict,
+// identity is linked either to another account or the account already has identity of the provider.
+func (r *ExternalIdentityRepository) handleConstraintError(err error) error {
+	if !ent.IsConstraintError(err) {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	if strings.Contains(err.Error(), externalidentity.FieldUserID) {
+		return apperrors.ErrProviderAlreadyLinked
+	}
+
+	return apperrors.WrapExternalIdentityAlreadyLinked(err)
+}
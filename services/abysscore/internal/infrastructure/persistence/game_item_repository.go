@@ -2,12 +2,17 @@ package persistence
 
 import (
 	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
 
 	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/mapper"
 	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
 	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/gameitementity"
 	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/types"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/gameitem"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/inventoryitem"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
 	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
 	"github.com/intezya/pkglib/itertools"
@@ -64,12 +69,31 @@ func (r *GameItemRepository) UpdateByID(
 	return nil
 }
 
-func (r *GameItemRepository) DeleteByID(ctx context.Context, id int) error {
-	ctx, span := tracer.StartSpan(ctx, "GameItemRepository.DeleteByID")
+func (r *GameItemRepository) ArchiveByID(ctx context.Context, id int) error {
+	ctx, span := tracer.StartSpan(ctx, "GameItemRepository.ArchiveByID")
+	defer span.End()
+
+	// Inventory items reference game items through required edge,
+	// so catalog items are never hard-deleted.
+	err := r.client.GameItem.
+		Update().
+		Where(gameitem.IDEQ(id), gameitem.ArchivedAtIsNil()).
+		SetArchivedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	return r.ensureExists(ctx, id)
+}
+
+func (r *GameItemRepository) RestoreByID(ctx context.Context, id int) error {
+	ctx, span := tracer.StartSpan(ctx, "GameItemRepository.RestoreByID")
 	defer span.End()
 
 	err := r.client.GameItem.
-		DeleteOneID(id).
+		UpdateOneID(id).
+		ClearArchivedAt().
 		Exec(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -82,11 +106,68 @@ func (r *GameItemRepository) DeleteByID(ctx context.Context, id int) error {
 	return nil
 }
 
+func (r *GameItemRepository) FindArchivedPaged(
+	ctx context.Context,
+	page, size int,
+) (*dto.PaginatedResult[*dto.ArchivedGameItemDTO], error) {
+	ctx, span := tracer.StartSpan(ctx, "GameItemRepository.FindArchivedPaged")
+	defer span.End()
+
+	page = getValidPage(page)
+	size = getValidSize(size)
+	offset := countOffset(page, size)
+
+	total, err := r.client.GameItem.
+		Query().
+		Where(gameitem.ArchivedAtNotNil()).
+		Count(ctx)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	totalPages := getTotalPages(total, size)
+
+	gameItems, err := r.client.GameItem.
+		Query().
+		Where(gameitem.ArchivedAtNotNil()).
+		Limit(size).
+		Offset(offset).
+		Order(gameitem.ByArchivedAt(sql.OrderDesc()), gameitem.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	ownersCount, err := r.countOwners(ctx, gameItems)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	mappedItems := itertools.Map(
+		gameItems,
+		func(gameItem *ent.GameItem) *dto.ArchivedGameItemDTO {
+			return &dto.ArchivedGameItemDTO{
+				GameItemDTO: mapper.ToGameItemDTOFromEnt(gameItem),
+				OwnersCount: ownersCount[gameItem.ID],
+			}
+		},
+	)
+
+	return &dto.PaginatedResult[*dto.ArchivedGameItemDTO]{
+		Data:       mappedItems,
+		Page:       page,
+		Size:       size,
+		TotalItems: total,
+		TotalPages: totalPages,
+	}, nil
+}
+
 func (r *GameItemRepository) FindAllPaged(
 	ctx context.Context,
 	page, size int,
 	orderBy gameitementity.OrderBy,
 	orderType types.OrderType,
+	filter *gameitementity.Filter,
 ) (*dto.PaginatedResult[*dto.GameItemDTO], error) {
 	ctx, span := tracer.StartSpan(ctx, "GameItemRepository.FindAllPaged")
 	defer span.End()
@@ -94,9 +175,11 @@ func (r *GameItemRepository) FindAllPaged(
 	page = getValidPage(page)
 	size = getValidSize(size)
 	offset := countOffset(page, size)
+	predicates := filter.ToPredicates()
 
 	total, err := r.client.GameItem.
 		Query().
+		Where(predicates...).
 		Count(ctx)
 	if err != nil {
 		return nil, apperrors.WrapUnexpectedError(err)
@@ -106,9 +189,10 @@ func (r *GameItemRepository) FindAllPaged(
 
 	gameItems, err := r.client.GameItem.
 		Query().
+		Where(predicates...).
 		Limit(size).
 		Offset(offset).
-		Order(orderBy.ToOrderOption(orderType)).
+		Order(orderBy.ToOrderOption(orderType), gameitem.ByID()).
 		All(ctx)
 	if err != nil {
 		return nil, apperrors.WrapUnexpectedError(err)
@@ -141,3 +225,56 @@ func (r *GameItemRepository) FindByID(ctx context.Context, id int) (*dto.GameIte
 
 	return mapper.ToGameItemDTOFromEnt(result), nil
 }
+
+// countOwners counts distinct users owning each of the given items.
+func (r *GameItemRepository) countOwners(
+	ctx context.Context,
+	gameItems []*ent.GameItem,
+) (map[int]int, error) {
+	result := make(map[int]int, len(gameItems))
+
+	if len(gameItems) == 0 {
+		return result, nil
+	}
+
+	itemIDs := make([]int, 0, len(gameItems))
+	for _, gameItem := range gameItems {
+		itemIDs = append(itemIDs, gameItem.ID)
+	}
+
+	var owners []struct {
+		ItemID int `json:"item_id"`
+		UserID int `json:"user_id"`
+	}
+
+	err := r.client.InventoryItem.
+		Query().
+		Where(inventoryitem.ItemIDIn(itemIDs...)).
+		GroupBy(inventoryitem.FieldItemID, inventoryitem.FieldUserID).
+		Scan(ctx, &owners)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, owner := range owners {
+		result[owner.ItemID]++
+	}
+
+	return result, nil
+}
+
+func (r *GameItemRepository) ensureExists(ctx context.Context, id int) error {
+	exists, err := r.client.GameItem.
+		Query().
+		Where(gameitem.IDEQ(id)).
+		Exist(ctx)
+	if err != nil {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	if !exists {
+		return apperrors.WrapGameItemNotFound(nil)
+	}
+
+	return nil
+}
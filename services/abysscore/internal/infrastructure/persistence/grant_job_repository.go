@@ -0,0 +1,173 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/mapper"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/grantjob"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/intezya/abyssleague/services/abysscore/pkg/optional"
+)
+
+type GrantJobRepository struct {
+	client *ent.Client
+}
+
+func NewGrantJobRepository(client *ent.Client) *GrantJobRepository {
+	return &GrantJobRepository{client: client}
+}
+
+func (r *GrantJobRepository) Create(
+	ctx context.Context,
+	job *dto.CreateGrantJobDTO,
+) (*dto.GrantJobDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "GrantJobRepository.Create")
+	defer span.End()
+
+	result, err := r.client.GrantJob.
+		Create().
+		SetCampaign(job.Campaign).
+		SetItemID(job.ItemID).
+		SetCreatedByID(job.CreatedByID).
+		SetTarget(grantjob.Target(job.Target)).
+		SetTotal(job.Total).
+		SetOwner(job.Owner).
+		SetHeartbeatAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, r.handleQueryError(err)
+	}
+
+	return mapper.ToGrantJobDTOFromEnt(result), nil
+}
+
+func (r *GrantJobRepository) FindByID(ctx context.Context, id int) (*dto.GrantJobDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "GrantJobRepository.FindByID")
+	defer span.End()
+
+	result, err := r.client.GrantJob.Get(ctx, id)
+	if err != nil {
+		return nil, r.handleQueryError(err)
+	}
+
+	return mapper.ToGrantJobDTOFromEnt(result), nil
+}
+
+func (r *GrantJobRepository) SetRunning(ctx context.Context, id int) error {
+	ctx, span := tracer.StartSpan(ctx, "GrantJobRepository.SetRunning")
+	defer span.End()
+
+	err := r.client.GrantJob.
+		UpdateOneID(id).
+		SetStatus(grantjob.StatusRunning).
+		Exec(ctx)
+	if err != nil {
+		return r.handleQueryError(err)
+	}
+
+	return nil
+}
+
+func (r *GrantJobRepository) AddProgress(
+	ctx context.Context,
+	id int,
+	progress *dto.GrantJobProgressDTO,
+) error {
+	ctx, span := tracer.StartSpan(ctx, "GrantJobRepository.AddProgress")
+	defer span.End()
+
+	err := r.client.GrantJob.
+		UpdateOneID(id).
+		AddProcessed(progress.Processed).
+		AddGranted(progress.Granted).
+		AddSkipped(progress.Skipped).
+		AddFailed(progress.Failed).
+		Exec(ctx)
+	if err != nil {
+		return r.handleQueryError(err)
+	}
+
+	return nil
+}
+
+func (r *GrantJobRepository) Finish(
+	ctx context.Context,
+	id int,
+	status dto.GrantJobStatus,
+	reason optional.String,
+) error {
+	ctx, span := tracer.StartSpan(ctx, "GrantJobRepository.Finish")
+	defer span.End()
+
+	err := r.client.GrantJob.
+		UpdateOneID(id).
+		SetStatus(grantjob.Status(status)).
+		SetNillableError(reason.ValueOrNil()).
+		SetFinishedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return r.handleQueryError(err)
+	}
+
+	return nil
+}
+
+func (r *GrantJobRepository) Heartbeat(ctx context.Context, id int) error {
+	ctx, span := tracer.StartSpan(ctx, "GrantJobRepository.Heartbeat")
+	defer span.End()
+
+	err := r.client.GrantJob.
+		UpdateOneID(id).
+		SetHeartbeatAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return r.handleQueryError(err)
+	}
+
+	return nil
+}
+
+func (r *GrantJobRepository) FailStale(
+	ctx context.Context,
+	owner string,
+	heartbeatBefore time.Time,
+	reason string,
+) (int, error) {
+	ctx, span := tracer.StartSpan(ctx, "GrantJobRepository.FailStale")
+	defer span.End()
+
+	// jobs created before owners were recorded have neither owner nor heartbeat
+	affected, err := r.client.GrantJob.
+		Update().
+		Where(
+			grantjob.StatusIn(grantjob.StatusPending, grantjob.StatusRunning),
+			grantjob.Or(grantjob.OwnerIsNil(), grantjob.OwnerNEQ(owner)),
+			grantjob.Or(grantjob.HeartbeatAtIsNil(), grantjob.HeartbeatAtLT(heartbeatBefore)),
+		).
+		SetStatus(grantjob.StatusFailed).
+		SetError(reason).
+		SetFinishedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return 0, r.handleQueryError(err)
+	}
+
+	return affected, nil
+}
+
+// handleQueryError transforms Ent query errors into domain-specific errors.
+func (r *GrantJobRepository) handleQueryError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if ent.IsNotFound(err) {
+		return apperrors.WrapGrantJobNotFound(err)
+	}
+
+	return apperrors.WrapUnexpectedError(err)
+}
@@ -0,0 +1,266 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/mapper"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/hardwareidreset"
+	entUser "github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/intezya/pkglib/itertools"
+)
+
+type HardwareIDResetRepository struct {
+	client *ent.Client
+}
+
+func NewHardwareIDResetRepository(client *ent.Client) *HardwareIDResetRepository {
+	return &HardwareIDResetRepository{client: client}
+}
+
+func (r *HardwareIDResetRepository) CreateRequest(
+	ctx context.Context,
+	userID int,
+	reason *string,
+) (*dto.HardwareIDResetDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "HardwareIDResetRepository.CreateRequest")
+	defer span.End()
+
+	return withTxResult(ctx, r.client, func(tx *ent.Tx) (*dto.HardwareIDResetDTO, error) {
+		pending, err := tx.HardwareIDReset.Query().
+			Where(
+				hardwareidreset.UserIDEQ(userID),
+				hardwareidreset.StatusEQ(hardwareidreset.StatusPending),
+			).
+			Exist(ctx)
+		if err != nil {
+			return nil, apperrors.WrapUnexpectedError(err)
+		}
+
+		if pending {
+			return nil, apperrors.ErrHardwareIDResetAlreadyRequested
+		}
+
+		request, err := tx.HardwareIDReset.Create().
+			SetUserID(userID).
+			SetNillableReason(reason).
+			Save(ctx)
+		if err != nil {
+			return nil, apperrors.WrapUnexpectedError(err)
+		}
+
+		return mapper.ToHardwareIDResetDTOFromEnt(request), nil
+	})
+}
+
+func (r *HardwareIDResetRepository) Reset(
+	ctx context.Context,
+	userID int,
+	operatorID int,
+	reason *string,
+) (*dto.HardwareIDResetResultDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "HardwareIDResetRepository.Reset")
+	defer span.End()
+
+	return withTxResult(ctx, r.client, func(tx *ent.Tx) (*dto.HardwareIDResetResultDTO, error) {
+		user, err := r.txClearHardwareID(ctx, tx, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		if user.HardwareID == nil {
+			return nil, apperrors.ErrHardwareIDNotBound
+		}
+
+		now := time.Now()
+
+		err = tx.HardwareIDReset.Update().
+			Where(
+				hardwareidreset.UserIDEQ(userID),
+				hardwareidreset.StatusEQ(hardwareidreset.StatusPending),
+			).
+			SetStatus(hardwareidreset.StatusApproved).
+			SetOperatorID(operatorID).
+			SetNillableOperatorComment(reason).
+			SetResolvedAt(now).
+			Exec(ctx)
+		if err != nil {
+			return nil, apperrors.WrapUnexpectedError(err)
+		}
+
+		reset, err := tx.HardwareIDReset.Create().
+			SetUserID(userID).
+			SetStatus(hardwareidreset.StatusApproved).
+			SetNillableReason(reason).
+			SetOperatorID(operatorID).
+			SetResolvedAt(now).
+			Save(ctx)
+		if err != nil {
+			return nil, apperrors.WrapUnexpectedError(err)
+		}
+
+		return &dto.HardwareIDResetResultDTO{
+			HardwareIDResetDTO: mapper.ToHardwareIDResetDTOFromEnt(reset),
+			User:               user,
+		}, nil
+	})
+}
+
+func (r *HardwareIDResetRepository) Approve(
+	ctx context.Context,
+	requestID int,
+	operatorID int,
+	comment *string,
+) (*dto.HardwareIDResetResultDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "HardwareIDResetRepository.Approve")
+	defer span.End()
+
+	return withTxResult(ctx, r.client, func(tx *ent.Tx) (*dto.HardwareIDResetResultDTO, error) {
+		request, err := r.txResolve(ctx, tx, requestID, hardwareidreset.StatusApproved, operatorID, comment)
+		if err != nil {
+			return nil, err
+		}
+
+		// Hardware id may be already reset by admin directly, the request is approved anyway
+		user, err := r.txClearHardwareID(ctx, tx, request.UserID)
+		if err != nil {
+			return nil, err
+		}
+
+		return &dto.HardwareIDResetResultDTO{
+			HardwareIDResetDTO: mapper.ToHardwareIDResetDTOFromEnt(request),
+			User:               user,
+		}, nil
+	})
+}
+
+func (r *HardwareIDResetRepository) Reject(
+	ctx context.Context,
+	requestID int,
+	operatorID int,
+	comment *string,
+) (*dto.HardwareIDResetDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "HardwareIDResetRepository.Reject")
+	defer span.End()
+
+	return withTxResult(ctx, r.client, func(tx *ent.Tx) (*dto.HardwareIDResetDTO, error) {
+		request, err := r.txResolve(ctx, tx, requestID, hardwareidreset.StatusRejected, operatorID, comment)
+		if err != nil {
+			return nil, err
+		}
+
+		return mapper.ToHardwareIDResetDTOFromEnt(request), nil
+	})
+}
+
+func (r *HardwareIDResetRepository) FindPaged(
+	ctx context.Context,
+	status *dto.HardwareIDResetStatus,
+	page, size int,
+) (*dto.PaginatedResult[*dto.HardwareIDResetDTO], error) {
+	ctx, span := tracer.StartSpan(ctx, "HardwareIDResetRepository.FindPaged")
+	defer span.End()
+
+	page = getValidPage(page)
+	size = getValidSize(size)
+	offset := countOffset(page, size)
+
+	query := r.client.HardwareIDReset.Query()
+
+	if status != nil {
+		query.Where(hardwareidreset.StatusEQ(hardwareidreset.Status(*status)))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	resets, err := query.
+		Limit(size).
+		Offset(offset).
+		Order(hardwareidreset.ByCreatedAt(sql.OrderDesc()), hardwareidreset.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return &dto.PaginatedResult[*dto.HardwareIDResetDTO]{
+		Data:       itertools.Map(resets, mapper.ToHardwareIDResetDTOFromEnt),
+		Page:       page,
+		Size:       size,
+		TotalItems: total,
+		TotalPages: getTotalPages(total, size),
+	}, nil
+}
+
+// txResolve resolves pending request, conditional update prevents resolving the request twice.
+func (r *HardwareIDResetRepository) txResolve(
+	ctx context.Context,
+	tx *ent.Tx,
+	requestID int,
+	status hardwareidreset.Status,
+	operatorID int,
+	comment *string,
+) (*ent.HardwareIDReset, error) {
+	affected, err := tx.HardwareIDReset.Update().
+		Where(
+			hardwareidreset.IDEQ(requestID),
+			hardwareidreset.StatusEQ(hardwareidreset.StatusPending),
+		).
+		SetStatus(status).
+		SetOperatorID(operatorID).
+		SetNillableOperatorComment(comment).
+		SetResolvedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	request, err := tx.HardwareIDReset.Get(ctx, requestID)
+	if ent.IsNotFound(err) {
+		return nil, apperrors.WrapHardwareIDResetRequestNotFound(err)
+	}
+
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	if affected == 0 {
+		return nil, apperrors.ErrHardwareIDResetRequestResolved
+	}
+
+	return request, nil
+}
+
+// txClearHardwareID clears hardware id and returns user as it was before clearing.
+func (r *HardwareIDResetRepository) txClearHardwareID(
+	ctx context.Context,
+	tx *ent.Tx,
+	userID int,
+) (*dto.UserDTO, error) {
+	user, err := tx.User.Get(ctx, userID)
+	if ent.IsNotFound(err) {
+		return nil, apperrors.WrapUserNotFound(err)
+	}
+
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	err = tx.User.Update().
+		Where(entUser.IDEQ(userID)).
+		ClearHardwareID().
+		ClearHardwareIDFingerprint().
+		Exec(ctx)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return mapper.ToUserDTOFromEnt(user), nil
+}
@@ -2,16 +2,26 @@ package persistence
 
 import (
 	"context"
+	"errors"
+	"slices"
 
 	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/mapper"
 	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/inventoryitementity"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/types"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/gameitem"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/inventoryitem"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/userbalance"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
 	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
 	"github.com/intezya/pkglib/itertools"
 )
 
+var errItemsNotInInventory = errors.New("some items are not in user's inventory")
+
 type InventoryItemRepository struct {
 	client *ent.Client
 }
@@ -56,17 +66,134 @@ func (r *InventoryItemRepository) Create(
 	return mapper.ToInventoryItemDTOFromEnt(result), nil
 }
 
-func (r *InventoryItemRepository) FindByUserID(
+func (r *InventoryItemRepository) CreateForCampaign(
+	ctx context.Context,
+	userIDs []int,
+	itemID int,
+	receivedFromID int,
+	campaign string,
+) (*dto.BulkGrantResultDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "InventoryItemRepository.CreateForCampaign")
+	defer span.End()
+
+	result, err := withTxResult(ctx, r.client, func(tx *ent.Tx) (*dto.BulkGrantResultDTO, error) {
+		alreadyGranted, err := tx.InventoryItem.
+			Query().
+			Where(
+				inventoryitem.UserIDIn(userIDs...),
+				inventoryitem.ItemIDEQ(itemID),
+				inventoryitem.CampaignEQ(campaign),
+			).
+			Select(inventoryitem.FieldUserID).
+			Ints(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		skip := make(map[int]struct{}, len(alreadyGranted))
+		for _, userID := range alreadyGranted {
+			skip[userID] = struct{}{}
+		}
+
+		builders := make([]*ent.InventoryItemCreate, 0, len(userIDs))
+
+		for _, userID := range userIDs {
+			if _, ok := skip[userID]; ok {
+				continue
+			}
+
+			// Duplicated IDs in one batch must not break the unique index.
+			skip[userID] = struct{}{}
+
+			builders = append(
+				builders,
+				tx.InventoryItem.
+					Create().
+					SetUserID(userID).
+					SetItemID(itemID).
+					SetReceivedFromID(receivedFromID).
+					SetCampaign(campaign),
+			)
+		}
+
+		result := &dto.BulkGrantResultDTO{
+			Granted: make([]*dto.InventoryItemDTO, 0, len(builders)),
+			Skipped: len(userIDs) - len(builders),
+		}
+
+		if len(builders) == 0 {
+			return result, nil
+		}
+
+		created, err := tx.InventoryItem.
+			CreateBulk(builders...).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		createdIDs := make([]int, 0, len(created))
+		for _, item := range created {
+			createdIDs = append(createdIDs, item.ID)
+		}
+
+		items, err := tx.InventoryItem.
+			Query().
+			Where(inventoryitem.IDIn(createdIDs...)).
+			WithItem().
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Granted = itertools.Map(items, mapper.ToInventoryItemDTOFromEnt)
+
+		return result, nil
+	})
+	if err != nil {
+		return nil, r.handleQueryError(err)
+	}
+
+	return result, nil
+}
+
+func (r *InventoryItemRepository) FindPagedByUserID(
 	ctx context.Context,
 	userID int,
-) ([]*dto.InventoryItemDTO, error) {
-	ctx, span := tracer.StartSpan(ctx, "InventoryItemRepository.FindByUserID")
+	page, size int,
+	orderBy inventoryitementity.OrderBy,
+	orderType types.OrderType,
+	filter *inventoryitementity.Filter,
+) (*dto.PaginatedResult[*dto.InventoryItemDTO], error) {
+	ctx, span := tracer.StartSpan(ctx, "InventoryItemRepository.FindPagedByUserID")
 	defer span.End()
 
+	page = getValidPage(page)
+	size = getValidSize(size)
+	offset := countOffset(page, size)
+
+	predicates := append(
+		[]predicate.InventoryItem{inventoryitem.UserIDEQ(userID)},
+		filter.ToPredicates()...,
+	)
+
+	total, err := r.client.InventoryItem.
+		Query().
+		Where(predicates...).
+		Count(ctx)
+	if err != nil {
+		return nil, r.handleQueryError(err)
+	}
+
+	totalPages := getTotalPages(total, size)
+
 	inventoryItems, err := r.client.InventoryItem.
 		Query().
-		Where(inventoryitem.UserIDEQ(userID)).
+		Where(predicates...).
 		WithItem().
+		Limit(size).
+		Offset(offset).
+		Order(orderBy.ToOrderOption(orderType), inventoryitem.ByID()).
 		All(ctx)
 	if err != nil {
 		return nil, r.handleQueryError(err)
@@ -74,7 +201,84 @@ func (r *InventoryItemRepository) FindByUserID(
 
 	mapped := itertools.Map(inventoryItems, mapper.ToInventoryItemDTOFromEnt)
 
-	return mapped, nil
+	return &dto.PaginatedResult[*dto.InventoryItemDTO]{
+		Data:       mapped,
+		Page:       page,
+		Size:       size,
+		TotalItems: total,
+		TotalPages: totalPages,
+	}, nil
+}
+
+func (r *InventoryItemRepository) SummaryByUserID(
+	ctx context.Context,
+	userID int,
+	filter *inventoryitementity.Filter,
+) (*dto.InventorySummaryDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "InventoryItemRepository.SummaryByUserID")
+	defer span.End()
+
+	predicates := append(
+		[]predicate.InventoryItem{inventoryitem.UserIDEQ(userID)},
+		filter.ToPredicates()...,
+	)
+
+	var counts []struct {
+		ItemID int `json:"item_id"`
+		Count  int `json:"count"`
+	}
+
+	// Counting per game item keeps the aggregation in the database,
+	// the number of distinct items is bounded by the catalog size.
+	err := r.client.InventoryItem.
+		Query().
+		Where(predicates...).
+		GroupBy(inventoryitem.FieldItemID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, r.handleQueryError(err)
+	}
+
+	summary := &dto.InventorySummaryDTO{
+		ByRarity:     make(map[int]int),
+		ByCollection: make(map[string]int),
+	}
+
+	if len(counts) == 0 {
+		return summary, nil
+	}
+
+	itemIDs := make([]int, 0, len(counts))
+	for _, count := range counts {
+		itemIDs = append(itemIDs, count.ItemID)
+	}
+
+	gameItems, err := r.client.GameItem.
+		Query().
+		Where(gameitem.IDIn(itemIDs...)).
+		Select(gameitem.FieldID, gameitem.FieldCollection, gameitem.FieldRarity).
+		All(ctx)
+	if err != nil {
+		return nil, r.handleQueryError(err)
+	}
+
+	itemsByID := make(map[int]*ent.GameItem, len(gameItems))
+	for _, gameItem := range gameItems {
+		itemsByID[gameItem.ID] = gameItem
+	}
+
+	for _, count := range counts {
+		gameItem, ok := itemsByID[count.ItemID]
+		if !ok {
+			continue
+		}
+
+		summary.ByRarity[gameItem.Rarity] += count.Count
+		summary.ByCollection[gameItem.Collection] += count.Count
+	}
+
+	return summary, nil
 }
 
 func (r *InventoryItemRepository) FindByUserIDAndID(
@@ -130,6 +334,102 @@ func (r *InventoryItemRepository) DeleteByUserIDAndID(
 	return mapper.ToInventoryItemDTOFromEnt(result), nil
 }
 
+func (r *InventoryItemRepository) DismantleByUserIDAndIDs(
+	ctx context.Context,
+	userID int,
+	ids []int,
+) (*dto.DismantleResultDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "InventoryItemRepository.DismantleByUserIDAndIDs")
+	defer span.End()
+
+	// duplicates would never match the count of found and deleted rows
+	ids = slices.Clone(ids)
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+
+	result, err := withTxResult(ctx, r.client, func(tx *ent.Tx) (*dto.DismantleResultDTO, error) {
+		owner, err := tx.User.Get(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		if owner.CurrentItemInProfileID != nil && slices.Contains(ids, *owner.CurrentItemInProfileID) {
+			return nil, apperrors.ErrDismantleCurrentItem
+		}
+
+		items, err := tx.InventoryItem.
+			Query().
+			Where(
+				inventoryitem.IDIn(ids...),
+				inventoryitem.UserIDEQ(userID),
+			).
+			WithItem().
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(items) != len(ids) {
+			return nil, errItemsNotInInventory
+		}
+
+		// owner isn't locked, so the item may be set as current after the check above
+		deleted, err := tx.InventoryItem.
+			Delete().
+			Where(
+				inventoryitem.IDIn(ids...),
+				inventoryitem.UserIDEQ(userID),
+				inventoryitem.Not(inventoryitem.HasUserWith(user.CurrentItemInProfileIDIn(ids...))),
+			).
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if deleted != len(ids) {
+			return nil, errItemsNotInInventory // dismantled or set as current concurrently
+		}
+
+		result := &dto.DismantleResultDTO{
+			Items: itertools.Map(items, mapper.ToInventoryItemDTOFromEnt),
+		}
+
+		for _, item := range items {
+			result.Coins += inventoryitementity.DismantleCoins(item.Edges.Item.Rarity)
+		}
+
+		err = txAddCoins(ctx, tx, userID, result.Coins)
+		if err != nil {
+			return nil, err
+		}
+
+		balance, err := tx.UserBalance.
+			Query().
+			Where(userbalance.UserIDEQ(userID)).
+			Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Balance = balance.Coins
+
+		return result, nil
+	})
+	if err != nil {
+		if errors.Is(err, apperrors.ErrDismantleCurrentItem) {
+			return nil, err
+		}
+
+		if errors.Is(err, errItemsNotInInventory) {
+			return nil, apperrors.WrapItemNotFoundInInventory(err)
+		}
+
+		return nil, r.handleQueryError(err)
+	}
+
+	return result, nil
+}
+
 // handleQueryError transforms Ent query errors into domain-specific errors.
 func (r *InventoryItemRepository) handleQueryError(err error) error {
 	if err == nil {
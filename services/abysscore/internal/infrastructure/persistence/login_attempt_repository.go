@@ -0,0 +1,129 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	rediswrapper "github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/cache/redis"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/redis/go-redis/v9"
+)
+
+const loginLockoutValue = "1"
+
+type LoginAttemptRepository struct {
+	redisClient *rediswrapper.ClientWrapper
+}
+
+func NewLoginAttemptRepository(redisClient *rediswrapper.ClientWrapper) *LoginAttemptRepository {
+	return &LoginAttemptRepository{redisClient: redisClient}
+}
+
+func (r *LoginAttemptRepository) IncrementFailures(
+	ctx context.Context,
+	subject string,
+	window time.Duration,
+) (int, error) {
+	ctx, span := tracer.StartSpan(ctx, "LoginAttemptRepository.IncrementFailures")
+	defer span.End()
+
+	client := r.redisClient.Client
+	if client == nil {
+		return 0, apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	key := r.failuresKey(subject)
+
+	var incr *redis.IntCmd
+
+	_, err := client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.Incr(ctx, key)
+		pipe.ExpireNX(ctx, key, window)
+
+		return nil
+	})
+	if err != nil {
+		return 0, apperrors.WrapUnexpectedError(err)
+	}
+
+	return int(incr.Val()), nil
+}
+
+func (r *LoginAttemptRepository) ResetFailures(ctx context.Context, subject string) error {
+	ctx, span := tracer.StartSpan(ctx, "LoginAttemptRepository.ResetFailures")
+	defer span.End()
+
+	client := r.redisClient.Client
+	if client == nil {
+		return apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	err := client.Del(ctx, r.failuresKey(subject), r.lockoutKey(subject)).Err()
+	if err != nil {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	return nil
+}
+
+func (r *LoginAttemptRepository) Lockout(ctx context.Context, subject string, duration time.Duration) error {
+	ctx, span := tracer.StartSpan(ctx, "LoginAttemptRepository.Lockout")
+	defer span.End()
+
+	client := r.redisClient.Client
+	if client == nil {
+		return apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	err := client.Set(ctx, r.lockoutKey(subject), loginLockoutValue, duration).Err()
+	if err != nil {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	return nil
+}
+
+func (r *LoginAttemptRepository) FindLockout(ctx context.Context, subjects ...string) (time.Duration, error) {
+	ctx, span := tracer.StartSpan(ctx, "LoginAttemptRepository.FindLockout")
+	defer span.End()
+
+	client := r.redisClient.Client
+	if client == nil {
+		return 0, apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	cmds := make([]*redis.DurationCmd, 0, len(subjects))
+
+	_, err := client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, subject := range subjects {
+			cmds = append(cmds, pipe.PTTL(ctx, r.lockoutKey(subject)))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, apperrors.WrapUnexpectedError(err)
+	}
+
+	var lockout time.Duration
+
+	// PTTL returns negative values for missing keys and keys without expiration
+	for _, cmd := range cmds {
+		lockout = max(lockout, cmd.Val())
+	}
+
+	return lockout, nil
+}
+
+func (r *LoginAttemptRepository) failuresKey(subject string) string {
+	const key = "LoginFailures:"
+
+	return key + subject
+}
+
+func (r *LoginAttemptRepository) lockoutKey(subject string) string {
+	const key = "LoginLockout:"
+
+	return key + subject
+}
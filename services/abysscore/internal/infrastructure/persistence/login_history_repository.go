@@ -0,0 +1,125 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/mapper"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/loginhistory"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/intezya/pkglib/itertools"
+)
+
+type LoginHistoryRepository struct {
+	client *ent.Client
+}
+
+func NewLoginHistoryRepository(client *ent.Client) *LoginHistoryRepository {
+	return &LoginHistoryRepository{client: client}
+}
+
+func (r *LoginHistoryRepository) Create(ctx context.Context, record *dto.CreateLoginHistoryDTO) error {
+	ctx, span := tracer.StartSpan(ctx, "LoginHistoryRepository.Create")
+	defer span.End()
+
+	err := r.client.LoginHistory.Create().
+		SetUserID(record.UserID).
+		SetIP(record.IP).
+		SetUserAgent(record.UserAgent).
+		SetHardwareFingerprint(record.HardwareFingerprint).
+		SetHardwareIDMatch(record.HardwareIDMatch).
+		SetSuccess(record.Success).
+		Exec(ctx)
+	if err != nil {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	return nil
+}
+
+// FindPagedByUserID returns login history of the user, most recent first.
+func (r *LoginHistoryRepository) FindPagedByUserID(
+	ctx context.Context,
+	userID int,
+	page, size int,
+) (*dto.PaginatedResult[*dto.LoginHistoryDTO], error) {
+	ctx, span := tracer.StartSpan(ctx, "LoginHistoryRepository.FindPagedByUserID")
+	defer span.End()
+
+	page = getValidPage(page)
+	size = getValidSize(size)
+	offset := countOffset(page, size)
+
+	query := r.client.LoginHistory.Query().
+		Where(loginhistory.UserIDEQ(userID))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	records, err := query.
+		Limit(size).
+		Offset(offset).
+		Order(ent.Desc(loginhistory.FieldCreatedAt), ent.Desc(loginhistory.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return &dto.PaginatedResult[*dto.LoginHistoryDTO]{
+		Data:       itertools.Map(records, mapper.ToLoginHistoryDTOFromEnt),
+		Page:       page,
+		Size:       size,
+		TotalItems: total,
+		TotalPages: getTotalPages(total, size),
+	}, nil
+}
+
+func (r *LoginHistoryRepository) ExistsSuccessfulByUserID(ctx context.Context, userID int) (bool, error) {
+	ctx, span := tracer.StartSpan(ctx, "LoginHistoryRepository.ExistsSuccessfulByUserID")
+	defer span.End()
+
+	return r.existsSuccessful(ctx, userID)
+}
+
+func (r *LoginHistoryRepository) ExistsSuccessfulByUserIDAndIP(
+	ctx context.Context,
+	userID int,
+	ip string,
+) (bool, error) {
+	ctx, span := tracer.StartSpan(ctx, "LoginHistoryRepository.ExistsSuccessfulByUserIDAndIP")
+	defer span.End()
+
+	return r.existsSuccessful(ctx, userID, loginhistory.IPEQ(ip))
+}
+
+func (r *LoginHistoryRepository) ExistsSuccessfulByUserIDAndHardwareFingerprint(
+	ctx context.Context,
+	userID int,
+	hardwareFingerprint string,
+) (bool, error) {
+	ctx, span := tracer.StartSpan(ctx, "LoginHistoryRepository.ExistsSuccessfulByUserIDAndHardwareFingerprint")
+	defer span.End()
+
+	return r.existsSuccessful(ctx, userID, loginhistory.HardwareFingerprintEQ(hardwareFingerprint))
+}
+
+func (r *LoginHistoryRepository) existsSuccessful(
+	ctx context.Context,
+	userID int,
+	predicates ...predicate.LoginHistory,
+) (bool, error) {
+	exists, err := r.client.LoginHistory.Query().
+		Where(loginhistory.UserIDEQ(userID), loginhistory.SuccessEQ(true)).
+		Where(predicates...).
+		Exist(ctx)
+	if err != nil {
+		return false, apperrors.WrapUnexpectedError(err)
+	}
+
+	return exists, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
 	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
 	"github.com/intezya/pkglib/logger"
+	"github.com/redis/go-redis/v9"
 )
 
 type entry struct {
@@ -83,6 +84,187 @@ func (s *MailMessageRepository) GetLinkMailCodeData(
 	return nil, ErrClientNotReady
 }
 
+func (s *MailMessageRepository) SavePasswordResetCodeData(
+	ctx context.Context,
+	message *mailmessage.PasswordResetCodeData,
+	expireMinutes int,
+) {
+	ctx, span := tracer.StartSpan(ctx, "MailMessageRepository.SavePasswordResetCodeData")
+	defer span.End()
+
+	data := entry{
+		key:           s.passwordResetCodeKey(message.UserID),
+		expireMinutes: expireMinutes,
+		data:          message,
+	}
+
+	s.queue <- data
+}
+
+func (s *MailMessageRepository) GetPasswordResetCodeData(
+	ctx context.Context,
+	receiverID int,
+) (*mailmessage.PasswordResetCodeData, error) {
+	ctx, span := tracer.StartSpan(ctx, "MailMessageRepository.GetPasswordResetCodeData")
+	defer span.End()
+
+	client := s.redisClient.Client
+	if client == nil {
+		return nil, apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	result := &mailmessage.PasswordResetCodeData{}
+
+	err := client.Get(ctx, s.passwordResetCodeKey(receiverID)).Scan(result)
+	if err != nil {
+		return nil, s.handleNotFoundOrUnexpected(err)
+	}
+
+	return result, nil
+}
+
+// ConsumePasswordResetCodeData atomically reads and deletes code data,
+// so only one of concurrent requests is able to use the code.
+func (s *MailMessageRepository) ConsumePasswordResetCodeData(
+	ctx context.Context,
+	receiverID int,
+) (*mailmessage.PasswordResetCodeData, error) {
+	ctx, span := tracer.StartSpan(ctx, "MailMessageRepository.ConsumePasswordResetCodeData")
+	defer span.End()
+
+	client := s.redisClient.Client
+	if client == nil {
+		return nil, apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	result := &mailmessage.PasswordResetCodeData{}
+
+	err := client.GetDel(ctx, s.passwordResetCodeKey(receiverID)).Scan(result)
+	if err != nil {
+		return nil, s.handleNotFoundOrUnexpected(err)
+	}
+
+	client.Del(ctx, s.passwordResetAttemptsKey(receiverID))
+
+	return result, nil
+}
+
+// IncrementPasswordResetAttempts counts wrong codes entered for the current code
+// and returns the updated count.
+func (s *MailMessageRepository) IncrementPasswordResetAttempts(
+	ctx context.Context,
+	receiverID int,
+	expireMinutes int,
+) (int, error) {
+	ctx, span := tracer.StartSpan(ctx, "MailMessageRepository.IncrementPasswordResetAttempts")
+	defer span.End()
+
+	client := s.redisClient.Client
+	if client == nil {
+		return 0, apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	key := s.passwordResetAttemptsKey(receiverID)
+
+	var incr *redis.IntCmd
+
+	_, err := client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.Incr(ctx, key)
+		pipe.ExpireNX(ctx, key, time.Duration(expireMinutes)*time.Minute)
+
+		return nil
+	})
+	if err != nil {
+		return 0, apperrors.WrapUnexpectedError(err)
+	}
+
+	return int(incr.Val()), nil
+}
+
+// DeletePasswordResetCodeData invalidates the code and its wrong attempts counter.
+func (s *MailMessageRepository) DeletePasswordResetCodeData(ctx context.Context, receiverID int) error {
+	ctx, span := tracer.StartSpan(ctx, "MailMessageRepository.DeletePasswordResetCodeData")
+	defer span.End()
+
+	client := s.redisClient.Client
+	if client == nil {
+		return apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	err := client.Del(
+		ctx,
+		s.passwordResetCodeKey(receiverID),
+		s.passwordResetAttemptsKey(receiverID),
+	).Err()
+	if err != nil {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	return nil
+}
+
+func (s *MailMessageRepository) SaveChangeEmailCodeData(
+	ctx context.Context,
+	message *mailmessage.ChangeEmailCodeData,
+	expireMinutes int,
+) {
+	ctx, span := tracer.StartSpan(ctx, "MailMessageRepository.SaveChangeEmailCodeData")
+	defer span.End()
+
+	data := entry{
+		key:           s.changeEmailCodeKey(message.UserID),
+		expireMinutes: expireMinutes,
+		data:          message,
+	}
+
+	s.queue <- data
+}
+
+func (s *MailMessageRepository) GetChangeEmailCodeData(
+	ctx context.Context,
+	receiverID int,
+) (*mailmessage.ChangeEmailCodeData, error) {
+	ctx, span := tracer.StartSpan(ctx, "MailMessageRepository.GetChangeEmailCodeData")
+	defer span.End()
+
+	client := s.redisClient.Client
+	if client == nil {
+		return nil, apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	result := &mailmessage.ChangeEmailCodeData{}
+
+	err := client.Get(ctx, s.changeEmailCodeKey(receiverID)).Scan(result)
+	if err != nil {
+		return nil, s.handleNotFoundOrUnexpected(err)
+	}
+
+	return result, nil
+}
+
+// ConsumeChangeEmailCodeData atomically reads and deletes code data.
+func (s *MailMessageRepository) ConsumeChangeEmailCodeData(
+	ctx context.Context,
+	receiverID int,
+) (*mailmessage.ChangeEmailCodeData, error) {
+	ctx, span := tracer.StartSpan(ctx, "MailMessageRepository.ConsumeChangeEmailCodeData")
+	defer span.End()
+
+	client := s.redisClient.Client
+	if client == nil {
+		return nil, apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	result := &mailmessage.ChangeEmailCodeData{}
+
+	err := client.GetDel(ctx, s.changeEmailCodeKey(receiverID)).Scan(result)
+	if err != nil {
+		return nil, s.handleNotFoundOrUnexpected(err)
+	}
+
+	return result, nil
+}
+
 func (s *MailMessageRepository) handleNotFoundOrUnexpected(err error) error {
 	if strings.Contains(err.Error(), "redis: nil") {
 		return apperrors.WrapMailDataNotFound(err)
@@ -114,3 +296,21 @@ func (s *MailMessageRepository) linkMailCodeKey(userID int) string {
 
 	return fmt.Sprintf("%s:%d", key, userID)
 }
+
+func (s *MailMessageRepository) passwordResetCodeKey(userID int) string {
+	const key = "PasswordResetCodeData"
+
+	return fmt.Sprintf("%s:%d", key, userID)
+}
+
+func (s *MailMessageRepository) passwordResetAttemptsKey(userID int) string {
+	const key = "PasswordResetAttempts"
+
+	return fmt.Sprintf("%s:%d", key, userID)
+}
+
+func (s *MailMessageRepository) changeEmailCodeKey(userID int) string {
+	const key = "ChangeEmailCodeData"
+
+	return fmt.Sprintf("%s:%d", key, userID)
+}
@@ -0,0 +1,146 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity"
+	rediswrapper "github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/cache/redis"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/redis/go-redis/v9"
+)
+
+type OIDCStateRepository struct {
+	redisClient *rediswrapper.ClientWrapper
+}
+
+func NewOIDCStateRepository(redisClient *rediswrapper.ClientWrapper) *OIDCStateRepository {
+	return &OIDCStateRepository{redisClient: redisClient}
+}
+
+func (r *OIDCStateRepository) SaveAuthorization(
+	ctx context.Context,
+	state string,
+	data *entity.OIDCAuthorizationData,
+	ttl time.Duration,
+) error {
+	ctx, span := tracer.StartSpan(ctx, "OIDCStateRepository.SaveAuthorization")
+	defer span.End()
+
+	client := r.redisClient.Client
+	if client == nil {
+		return apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	err := client.Set(ctx, r.authorizationKey(state), data, ttl).Err()
+	if err != nil {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	return nil
+}
+
+func (r *OIDCStateRepository) ConsumeAuthorization(
+	ctx context.Context,
+	state string,
+) (*entity.OIDCAuthorizationData, error) {
+	ctx, span := tracer.StartSpan(ctx, "OIDCStateRepository.ConsumeAuthorization")
+	defer span.End()
+
+	client := r.redisClient.Client
+	if client == nil {
+		return nil, apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	result := &entity.OIDCAuthorizationData{}
+
+	err := client.GetDel(ctx, r.authorizationKey(state)).Scan(result)
+	if errors.Is(err, redis.Nil) {
+		return nil, apperrors.ErrInvalidOIDCState
+	}
+
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return result, nil
+}
+
+func (r *OIDCStateRepository) SaveRegistration(
+	ctx context.Context,
+	tokenHash string,
+	data *entity.OIDCRegistrationData,
+	ttl time.Duration,
+) error {
+	ctx, span := tracer.StartSpan(ctx, "OIDCStateRepository.SaveRegistration")
+	defer span.End()
+
+	client := r.redisClient.Client
+	if client == nil {
+		return apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	err := client.Set(ctx, r.registrationKey(tokenHash), data, ttl).Err()
+	if err != nil {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	return nil
+}
+
+func (r *OIDCStateRepository) FindRegistration(
+	ctx context.Context,
+	tokenHash string,
+) (*entity.OIDCRegistrationData, error) {
+	ctx, span := tracer.StartSpan(ctx, "OIDCStateRepository.FindRegistration")
+	defer span.End()
+
+	client := r.redisClient.Client
+	if client == nil {
+		return nil, apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	result := &entity.OIDCRegistrationData{}
+
+	err := client.Get(ctx, r.registrationKey(tokenHash)).Scan(result)
+	if errors.Is(err, redis.Nil) {
+		return nil, apperrors.ErrInvalidRegistrationToken
+	}
+
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return result, nil
+}
+
+func (r *OIDCStateRepository) DeleteRegistration(ctx context.Context, tokenHash string) error {
+	ctx, span := tracer.StartSpan(ctx, "OIDCStateRepository.DeleteRegistration")
+	defer span.End()
+
+	client := r.redisClient.Client
+	if client == nil {
+		return apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	err := client.Del(ctx, r.registrationKey(tokenHash)).Err()
+	if err != nil {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	return nil
+}
+
+func (r *OIDCStateRepository) authorizationKey(state string) string {
+	const key = "OIDCAuthorization:"
+
+	return key + state
+}
+
+func (r *OIDCStateRepository) registrationKey(tokenHash string) string {
+	const key = "OIDCRegistration:"
+
+	return key + tokenHash
+}
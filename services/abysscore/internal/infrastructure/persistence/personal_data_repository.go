@@ -0,0 +1,238 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/mapper"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/apitoken"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/collectioncompletion"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/emailhistory"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/externalidentity"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/friendrequest"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/hardwareidreset"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/inventoryitem"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/loginhistory"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/match"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/passwordhistory"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/recoverycode"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasonpass"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasonrewardclaim"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/statistic"
+	entUser "github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/userbalance"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/usernamehistory"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+)
+
+type PersonalDataRepository struct {
+	client *ent.Client
+}
+
+func NewPersonalDataRepository(client *ent.Client) *PersonalDataRepository {
+	return &PersonalDataRepository{client: client}
+}
+
+func (r *PersonalDataRepository) FindByUserID(ctx context.Context, userID int) (*dto.PersonalDataDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "PersonalDataRepository.FindByUserID")
+	defer span.End()
+
+	return withTxResult(ctx, r.client, func(tx *ent.Tx) (*dto.PersonalDataDTO, error) {
+		user, err := tx.User.Query().
+			Where(entUser.IDEQ(userID)).
+			WithRoles().
+			WithBalance().
+			WithFriends().
+			WithExternalIdentities().
+			WithStatistics(func(q *ent.StatisticQuery) {
+				q.Order(ent.Asc(statistic.FieldType), ent.Asc(statistic.FieldPeriod))
+			}).
+			WithItems(func(q *ent.InventoryItemQuery) {
+				q.WithItem().Order(ent.Asc(inventoryitem.FieldObtainedAt))
+			}).
+			WithUsernameHistory(func(q *ent.UsernameHistoryQuery) {
+				q.Order(ent.Desc(usernamehistory.FieldCreatedAt))
+			}).
+			WithEmailHistory(func(q *ent.EmailHistoryQuery) {
+				q.Order(ent.Desc(emailhistory.FieldCreatedAt))
+			}).
+			WithLoginHistory(func(q *ent.LoginHistoryQuery) {
+				q.Order(ent.Desc(loginhistory.FieldCreatedAt), ent.Desc(loginhistory.FieldID))
+			}).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return nil, apperrors.WrapUserNotFound(err)
+		}
+
+		if err != nil {
+			return nil, apperrors.WrapUnexpectedError(err)
+		}
+
+		matches, err := tx.Match.Query().
+			Where(match.Or(match.Player1IDEQ(userID), match.Player2IDEQ(userID))).
+			WithPlayer1().
+			WithPlayer2().
+			WithResults(func(q *ent.PlayerMatchResultQuery) {
+				q.WithUser()
+			}).
+			Order(ent.Desc(match.FieldCreatedAt)).
+			All(ctx)
+		if err != nil {
+			return nil, apperrors.WrapUnexpectedError(err)
+		}
+
+		return mapper.ToPersonalDataDTOFromEnt(user, matches), nil
+	})
+}
+
+func (r *PersonalDataRepository) ScheduleDeletion(ctx context.Context, userID int, at time.Time) error {
+	ctx, span := tracer.StartSpan(ctx, "PersonalDataRepository.ScheduleDeletion")
+	defer span.End()
+
+	affected, err := r.client.User.Update().
+		Where(
+			entUser.IDEQ(userID),
+			entUser.DeletionScheduledAtIsNil(),
+			entUser.DeletedAtIsNil(),
+		).
+		SetDeletionScheduledAt(at).
+		Save(ctx)
+	if err != nil {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	if affected == 0 {
+		return apperrors.ErrAccountDeletionAlreadyScheduled
+	}
+
+	return nil
+}
+
+func (r *PersonalDataRepository) CancelDeletion(ctx context.Context, userID int) error {
+	ctx, span := tracer.StartSpan(ctx, "PersonalDataRepository.CancelDeletion")
+	defer span.End()
+
+	affected, err := r.client.User.Update().
+		Where(
+			entUser.IDEQ(userID),
+			entUser.DeletionScheduledAtNotNil(),
+			entUser.DeletedAtIsNil(),
+		).
+		ClearDeletionScheduledAt().
+		Save(ctx)
+	if err != nil {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	if affected == 0 {
+		return apperrors.ErrAccountDeletionNotScheduled
+	}
+
+	return nil
+}
+
+func (r *PersonalDataRepository) FindIDsDueForDeletion(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+) ([]int, error) {
+	ctx, span := tracer.StartSpan(ctx, "PersonalDataRepository.FindIDsDueForDeletion")
+	defer span.End()
+
+	ids, err := r.client.User.Query().
+		Where(
+			entUser.DeletionScheduledAtLTE(now),
+			entUser.DeletedAtIsNil(),
+		).
+		Order(entUser.ByDeletionScheduledAt()).
+		Limit(limit).
+		IDs(ctx)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return ids, nil
+}
+
+// Erase keeps audit log records and sanctions, they reference the anonymized user by id and are
+// retained for moderation.
+func (r *PersonalDataRepository) Erase(ctx context.Context, erasure *dto.AccountErasureDTO) error {
+	ctx, span := tracer.StartSpan(ctx, "PersonalDataRepository.Erase")
+	defer span.End()
+
+	return withTx(ctx, r.client, func(tx *ent.Tx) error {
+		// current item is cleared before items are deleted, friends and roles are join table rows
+		affected, err := tx.User.Update().
+			Where(
+				entUser.IDEQ(erasure.UserID),
+				entUser.DeletionScheduledAtLTE(erasure.ErasedAt),
+				entUser.DeletedAtIsNil(),
+			).
+			SetUsername(erasure.Username).
+			SetPassword(erasure.Password).
+			ClearEmail().
+			ClearHardwareID().
+			ClearHardwareIDFingerprint().
+			ClearTotpSecret().
+			ClearTotpEnabledAt().
+			ClearGenshinUID().
+			ClearHoyolabLogin().
+			ClearCurrentMatch().
+			ClearCurrentItem().
+			ClearAvatarURL().
+			ClearTitle().
+			SetInvitesEnabled(false).
+			ClearSearchBlockReason().
+			ClearAccountBlockReason().
+			ClearLoginLockedUntil().
+			ClearDeletionScheduledAt().
+			SetDeletedAt(erasure.ErasedAt).
+			ClearFriends().
+			ClearRoles().
+			Save(ctx)
+		if err != nil {
+			return apperrors.WrapUnexpectedError(err)
+		}
+
+		if affected == 0 {
+			return apperrors.ErrAccountDeletionNotScheduled
+		}
+
+		return r.txDeleteBoundRecords(ctx, tx, erasure.UserID)
+	})
+}
+
+// txDeleteBoundRecords deletes records of all user edges except matches, their results and sanctions.
+func (r *PersonalDataRepository) txDeleteBoundRecords(ctx context.Context, tx *ent.Tx, userID int) error {
+	deletes := []func(context.Context) (int, error){
+		tx.FriendRequest.Delete().
+			Where(friendrequest.Or(friendrequest.FromUserIDEQ(userID), friendrequest.ToUserIDEQ(userID))).
+			Exec,
+		tx.Statistic.Delete().Where(statistic.UserIDEQ(userID)).Exec,
+		tx.InventoryItem.Delete().Where(inventoryitem.UserIDEQ(userID)).Exec,
+		tx.UserBalance.Delete().Where(userbalance.UserIDEQ(userID)).Exec,
+		tx.CollectionCompletion.Delete().Where(collectioncompletion.UserIDEQ(userID)).Exec,
+		tx.SeasonPass.Delete().Where(seasonpass.UserIDEQ(userID)).Exec,
+		tx.SeasonRewardClaim.Delete().Where(seasonrewardclaim.UserIDEQ(userID)).Exec,
+		tx.EmailHistory.Delete().Where(emailhistory.UserIDEQ(userID)).Exec,
+		tx.UsernameHistory.Delete().Where(usernamehistory.UserIDEQ(userID)).Exec,
+		tx.PasswordHistory.Delete().Where(passwordhistory.UserIDEQ(userID)).Exec,
+		tx.LoginHistory.Delete().Where(loginhistory.UserIDEQ(userID)).Exec,
+		tx.ExternalIdentity.Delete().Where(externalidentity.UserIDEQ(userID)).Exec,
+		tx.APIToken.Delete().Where(apitoken.UserIDEQ(userID)).Exec,
+		tx.RecoveryCode.Delete().Where(recoverycode.UserIDEQ(userID)).Exec,
+		tx.HardwareIDReset.Delete().Where(hardwareidreset.UserIDEQ(userID)).Exec,
+	}
+
+	for _, deleteRecords := range deletes {
+		_, err := deleteRecords(ctx)
+		if err != nil {
+			return apperrors.WrapUnexpectedError(err)
+		}
+	}
+
+	return nil
+}
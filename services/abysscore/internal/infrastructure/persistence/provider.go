@@ -16,6 +16,23 @@ type DependencyProvider struct {
 	InventoryItemRepository    repositoryports.InventoryItemRepository
 	MailMessageRepository      repositoryports.MailMessageRepository
 	BannedHardwareIDRepository repositoryports.BannedHardwareIDRepository
+	CollectionRepository       repositoryports.CollectionRepository
+	GrantJobRepository         repositoryports.GrantJobRepository
+	SeasonRepository           repositoryports.SeasonRepository
+	RefreshTokenRepository     repositoryports.RefreshTokenRepository
+	SessionRepository          repositoryports.SessionRepository
+	TwoFactorRepository        repositoryports.TwoFactorRepository
+	TwoFactorCacheRepository   repositoryports.TwoFactorCacheRepository
+	HardwareIDResetRepository  repositoryports.HardwareIDResetRepository
+	SanctionRepository         repositoryports.SanctionRepository
+	RoleRepository             repositoryports.RoleRepository
+	AuditLogRepository         repositoryports.AuditLogRepository
+	LoginAttemptRepository     repositoryports.LoginAttemptRepository
+	LoginHistoryRepository     repositoryports.LoginHistoryRepository
+	ExternalIdentityRepository repositoryports.ExternalIdentityRepository
+	OIDCStateRepository        repositoryports.OIDCStateRepository
+	APITokenRepository         repositoryports.APITokenRepository
+	PersonalDataRepository     repositoryports.PersonalDataRepository
 }
 
 func NewDependencyProvider(
@@ -32,5 +49,22 @@ func NewDependencyProvider(
 		InventoryItemRepository:    NewInventoryItemRepository(client),
 		MailMessageRepository:      NewMailMessageRepository(redisClient),
 		BannedHardwareIDRepository: NewBannedHardwareIDRepository(client),
+		CollectionRepository:       NewCollectionRepository(client),
+		GrantJobRepository:         NewGrantJobRepository(client),
+		SeasonRepository:           NewSeasonRepository(client),
+		RefreshTokenRepository:     NewRefreshTokenRepository(redisClient),
+		SessionRepository:          NewSessionRepository(redisClient),
+		TwoFactorRepository:        NewTwoFactorRepository(client),
+		TwoFactorCacheRepository:   NewTwoFactorCacheRepository(redisClient),
+		HardwareIDResetRepository:  NewHardwareIDResetRepository(client),
+		SanctionRepository:         NewSanctionRepository(client),
+		RoleRepository:             NewRoleRepository(client),
+		AuditLogRepository:         NewAuditLogRepository(client),
+		LoginAttemptRepository:     NewLoginAttemptRepository(redisClient),
+		LoginHistoryRepository:     NewLoginHistoryRepository(client),
+		ExternalIdentityRepository: NewExternalIdentityRepository(client),
+		OIDCStateRepository:        NewOIDCStateRepository(redisClient),
+		APITokenRepository:         NewAPITokenRepository(client),
+		PersonalDataRepository:     NewPersonalDataRepository(client),
 	}
 }
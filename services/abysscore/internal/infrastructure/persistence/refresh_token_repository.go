@@ -0,0 +1,256 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity"
+	rediswrapper "github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/cache/redis"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/redis/go-redis/v9"
+)
+
+// RevokedTokenFamilyKeyFormat is shared with websocket-messaging, which checks the same revocation list.
+const RevokedTokenFamilyKeyFormat = "RevokedTokenFamily:%s"
+
+// StaleUserTokensKeyFormat is shared with websocket-messaging, access tokens of the user issued
+// before the stored unix time carry outdated user data and are rejected.
+const StaleUserTokensKeyFormat = "StaleUserTokens:%d"
+
+const revokedTokenFamilyValue = "1"
+
+type RefreshTokenRepository struct {
+	redisClient *rediswrapper.ClientWrapper
+}
+
+func NewRefreshTokenRepository(redisClient *rediswrapper.ClientWrapper) *RefreshTokenRepository {
+	return &RefreshTokenRepository{redisClient: redisClient}
+}
+
+func (r *RefreshTokenRepository) Save(
+	ctx context.Context,
+	tokenHash string,
+	token *entity.RefreshTokenData,
+) error {
+	ctx, span := tracer.StartSpan(ctx, "RefreshTokenRepository.Save")
+	defer span.End()
+
+	client := r.redisClient.Client
+	if client == nil {
+		return apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	_, err := client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		r.pipeSet(ctx, pipe, tokenHash, token)
+
+		return nil
+	})
+	if err != nil {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	return nil
+}
+
+func (r *RefreshTokenRepository) FindByHash(
+	ctx context.Context,
+	tokenHash string,
+) (*entity.RefreshTokenData, error) {
+	ctx, span := tracer.StartSpan(ctx, "RefreshTokenRepository.FindByHash")
+	defer span.End()
+
+	client := r.redisClient.Client
+	if client == nil {
+		return nil, apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	result := &entity.RefreshTokenData{}
+
+	err := client.Get(ctx, r.tokenKey(tokenHash)).Scan(result)
+	if errors.Is(err, redis.Nil) {
+		return nil, apperrors.ErrInvalidRefreshToken
+	}
+
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return result, nil
+}
+
+func (r *RefreshTokenRepository) Rotate(
+	ctx context.Context,
+	oldTokenHash, newTokenHash string,
+	token *entity.RefreshTokenData,
+) error {
+	ctx, span := tracer.StartSpan(ctx, "RefreshTokenRepository.Rotate")
+	defer span.End()
+
+	client := r.redisClient.Client
+	if client == nil {
+		return apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	familyKey := r.familyKey(token.FamilyID)
+
+	// Old token record is kept until it expires, so its reuse can be detected.
+	err := client.Watch(ctx, func(tx *redis.Tx) error {
+		currentHash, err := tx.Get(ctx, familyKey).Result()
+		if errors.Is(err, redis.Nil) {
+			return apperrors.ErrInvalidRefreshToken // family is revoked or expired
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if currentHash != oldTokenHash {
+			return apperrors.ErrRefreshTokenReused
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			r.pipeSet(ctx, pipe, newTokenHash, token)
+
+			return nil
+		})
+
+		return err
+	}, familyKey)
+
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, apperrors.ErrInvalidRefreshToken), errors.Is(err, apperrors.ErrRefreshTokenReused):
+		return err
+	case errors.Is(err, redis.TxFailedErr):
+		return apperrors.ErrRefreshTokenReused // the same token has been rotated concurrently
+	default:
+		return apperrors.WrapUnexpectedError(err)
+	}
+}
+
+func (r *RefreshTokenRepository) RevokeFamily(
+	ctx context.Context,
+	familyID string,
+	accessTokenLifetime time.Duration,
+) error {
+	ctx, span := tracer.StartSpan(ctx, "RefreshTokenRepository.RevokeFamily")
+	defer span.End()
+
+	client := r.redisClient.Client
+	if client == nil {
+		return apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	_, err := client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, r.familyKey(familyID))
+		pipe.Set(ctx, r.revokedFamilyKey(familyID), revokedTokenFamilyValue, accessTokenLifetime)
+
+		return nil
+	})
+	if err != nil {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	return nil
+}
+
+func (r *RefreshTokenRepository) IsFamilyRevoked(ctx context.Context, familyID string) (bool, error) {
+	ctx, span := tracer.StartSpan(ctx, "RefreshTokenRepository.IsFamilyRevoked")
+	defer span.End()
+
+	client := r.redisClient.Client
+	if client == nil {
+		return false, apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	count, err := client.Exists(ctx, r.revokedFamilyKey(familyID)).Result()
+	if err != nil {
+		return false, apperrors.WrapUnexpectedError(err)
+	}
+
+	return count > 0, nil
+}
+
+func (r *RefreshTokenRepository) MarkUserTokensStale(
+	ctx context.Context,
+	userID int,
+	issuedBefore time.Time,
+	accessTokenLifetime time.Duration,
+) error {
+	ctx, span := tracer.StartSpan(ctx, "RefreshTokenRepository.MarkUserTokensStale")
+	defer span.End()
+
+	client := r.redisClient.Client
+	if client == nil {
+		return apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	err := client.Set(ctx, r.staleUserTokensKey(userID), issuedBefore.Unix(), accessTokenLifetime).Err()
+	if err != nil {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	return nil
+}
+
+func (r *RefreshTokenRepository) IsUserTokenStale(
+	ctx context.Context,
+	userID int,
+	issuedAt time.Time,
+) (bool, error) {
+	ctx, span := tracer.StartSpan(ctx, "RefreshTokenRepository.IsUserTokenStale")
+	defer span.End()
+
+	client := r.redisClient.Client
+	if client == nil {
+		return false, apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	issuedBefore, err := client.Get(ctx, r.staleUserTokensKey(userID)).Int64()
+	if errors.Is(err, redis.Nil) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, apperrors.WrapUnexpectedError(err)
+	}
+
+	return issuedAt.Unix() < issuedBefore, nil
+}
+
+// pipeSet stores token and makes it the current token of its family.
+func (r *RefreshTokenRepository) pipeSet(
+	ctx context.Context,
+	pipe redis.Pipeliner,
+	tokenHash string,
+	token *entity.RefreshTokenData,
+) {
+	ttl := time.Until(token.ExpiresAt)
+
+	pipe.Set(ctx, r.tokenKey(tokenHash), token, ttl)
+	pipe.Set(ctx, r.familyKey(token.FamilyID), tokenHash, ttl)
+}
+
+func (r *RefreshTokenRepository) tokenKey(tokenHash string) string {
+	const key = "RefreshToken"
+
+	return fmt.Sprintf("%s:%s", key, tokenHash)
+}
+
+func (r *RefreshTokenRepository) familyKey(familyID string) string {
+	const key = "RefreshTokenFamily"
+
+	return fmt.Sprintf("%s:%s", key, familyID)
+}
+
+func (r *RefreshTokenRepository) revokedFamilyKey(familyID string) string {
+	return fmt.Sprintf(RevokedTokenFamilyKeyFormat, familyID)
+}
+
+func (r *RefreshTokenRepository) staleUserTokensKey(userID int) string {
+	return fmt.Sprintf(StaleUserTokensKeyFormat, userID)
+}
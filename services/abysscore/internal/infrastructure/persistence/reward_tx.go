@@ -0,0 +1,72 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/mapper"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/inventoryitem"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/userbalance"
+)
+
+// systemIssuerID is used as received_from_id for items granted by the system.
+const systemIssuerID = 0
+
+// txAddCoins adds coins to user balance, creating the balance if user has none.
+func txAddCoins(
+	ctx context.Context,
+	tx *ent.Tx,
+	userID int,
+	coins float64,
+) error {
+	balance, err := tx.UserBalance.
+		Query().
+		Where(userbalance.UserIDEQ(userID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return tx.UserBalance.
+			Create().
+			SetUserID(userID).
+			SetCoins(coins).
+			Exec(ctx)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return tx.UserBalance.
+		UpdateOne(balance).
+		AddCoins(coins).
+		Exec(ctx)
+}
+
+// txGrantSystemItem grants item to user on behalf of the system.
+func txGrantSystemItem(
+	ctx context.Context,
+	tx *ent.Tx,
+	userID int,
+	itemID int,
+) (*dto.InventoryItemDTO, error) {
+	created, err := tx.InventoryItem.
+		Create().
+		SetUserID(userID).
+		SetItemID(itemID).
+		SetReceivedFromID(systemIssuerID).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := tx.InventoryItem.
+		Query().
+		Where(inventoryitem.IDEQ(created.ID)).
+		WithItem().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToInventoryItemDTOFromEnt(item), nil
+}
@@ -0,0 +1,197 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/mapper"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/role"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/intezya/pkglib/itertools"
+)
+
+type RoleRepository struct {
+	client *ent.Client
+}
+
+func NewRoleRepository(client *ent.Client) *RoleRepository {
+	return &RoleRepository{client: client}
+}
+
+func (r *RoleRepository) FindAll(ctx context.Context) ([]*dto.RoleDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "RoleRepository.FindAll")
+	defer span.End()
+
+	roles, err := r.client.Role.
+		Query().
+		Order(role.ByName()).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return itertools.Map(roles, mapper.ToRoleDTOFromEnt), nil
+}
+
+func (r *RoleRepository) FindByID(ctx context.Context, roleID int) (*dto.RoleDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "RoleRepository.FindByID")
+	defer span.End()
+
+	found, err := r.client.Role.Get(ctx, roleID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrors.WrapRoleNotFound(err)
+		}
+
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return mapper.ToRoleDTOFromEnt(found), nil
+}
+
+func (r *RoleRepository) FindByUserID(ctx context.Context, userID int) ([]*dto.RoleDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "RoleRepository.FindByUserID")
+	defer span.End()
+
+	exists, err := r.client.User.Query().Where(user.IDEQ(userID)).Exist(ctx)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	if !exists {
+		return nil, apperrors.WrapUserNotFound(nil)
+	}
+
+	roles, err := r.client.Role.
+		Query().
+		Where(role.HasUsersWith(user.IDEQ(userID))).
+		Order(role.ByName()).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return itertools.Map(roles, mapper.ToRoleDTOFromEnt), nil
+}
+
+func (r *RoleRepository) Create(ctx context.Context, saveRole *dto.SaveRoleDTO) (*dto.RoleDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "RoleRepository.Create")
+	defer span.End()
+
+	created, err := r.client.Role.
+		Create().
+		SetName(saveRole.Name).
+		SetNillableDescription(saveRole.Description).
+		SetPermissions(saveRole.Permissions).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, apperrors.WrapRoleAlreadyExists(err)
+		}
+
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return mapper.ToRoleDTOFromEnt(created), nil
+}
+
+func (r *RoleRepository) Update(
+	ctx context.Context,
+	roleID int,
+	saveRole *dto.SaveRoleDTO,
+) (*dto.RoleDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "RoleRepository.Update")
+	defer span.End()
+
+	update := r.client.Role.
+		UpdateOneID(roleID).
+		SetName(saveRole.Name).
+		SetPermissions(saveRole.Permissions)
+
+	if saveRole.Description != nil {
+		update.SetDescription(*saveRole.Description)
+	} else {
+		update.ClearDescription()
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			return nil, apperrors.WrapRoleNotFound(err)
+		case ent.IsConstraintError(err):
+			return nil, apperrors.WrapRoleAlreadyExists(err)
+		default:
+			return nil, apperrors.WrapUnexpectedError(err)
+		}
+	}
+
+	return mapper.ToRoleDTOFromEnt(updated), nil
+}
+
+func (r *RoleRepository) Delete(ctx context.Context, roleID int) error {
+	ctx, span := tracer.StartSpan(ctx, "RoleRepository.Delete")
+	defer span.End()
+
+	err := r.client.Role.DeleteOneID(roleID).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperrors.WrapRoleNotFound(err)
+		}
+
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	return nil
+}
+
+func (r *RoleRepository) SetUserRoles(
+	ctx context.Context,
+	userID int,
+	roleIDs []int,
+) ([]*dto.RoleDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "RoleRepository.SetUserRoles")
+	defer span.End()
+
+	type result struct {
+		roles []*ent.Role
+	}
+
+	res, err := withTxResult(ctx, r.client, func(tx *ent.Tx) (*result, error) {
+		roles, err := tx.Role.
+			Query().
+			Where(role.IDIn(roleIDs...)).
+			Order(role.ByName()).
+			All(ctx)
+		if err != nil {
+			return nil, apperrors.WrapUnexpectedError(err)
+		}
+
+		if len(roles) != len(roleIDs) {
+			return nil, apperrors.WrapRoleNotFound(nil)
+		}
+
+		err = tx.User.
+			UpdateOneID(userID).
+			ClearRoles().
+			AddRoleIDs(roleIDs...).
+			Exec(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, apperrors.WrapUserNotFound(err)
+			}
+
+			return nil, apperrors.WrapUnexpectedError(err)
+		}
+
+		return &result{roles: roles}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return itertools.Map(res.roles, mapper.ToRoleDTOFromEnt), nil
+}
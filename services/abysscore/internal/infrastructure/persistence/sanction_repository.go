@@ -0,0 +1,430 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/mapper"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/sanction"
+	entUser "github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/intezya/pkglib/itertools"
+)
+
+type SanctionRepository struct {
+	client *ent.Client
+}
+
+func NewSanctionRepository(client *ent.Client) *SanctionRepository {
+	return &SanctionRepository{client: client}
+}
+
+func (r *SanctionRepository) Issue(
+	ctx context.Context,
+	issue *dto.IssueSanctionDTO,
+) (*dto.SanctionResultDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "SanctionRepository.Issue")
+	defer span.End()
+
+	return withTxResult(ctx, r.client, func(tx *ent.Tx) (*dto.SanctionResultDTO, error) {
+		// Block state is compared with the state the level was computed from,
+		// so concurrent sanctions don't skip the ladder steps
+		update := tx.User.Update().Where(
+			entUser.IDEQ(issue.UserID),
+			r.blockStateEQ(issue.Type, issue.PreviousLevel, issue.PreviousBlockedUntil),
+		)
+
+		switch issue.Type {
+		case dto.SanctionSearch:
+			update.
+				SetSearchBlockedLevel(issue.Level).
+				SetSearchBlockedUntil(issue.BlockedUntil).
+				SetSearchBlockReason(issue.Reason)
+		default:
+			update.
+				SetAccountBlockedLevel(issue.Level).
+				SetAccountBlockedUntil(issue.BlockedUntil).
+				SetAccountBlockReason(issue.Reason)
+		}
+
+		affected, err := update.Save(ctx)
+		if err != nil {
+			return nil, apperrors.WrapUnexpectedError(err)
+		}
+
+		if affected == 0 {
+			return nil, apperrors.ErrUserSanctionsChangedConcurrently
+		}
+
+		created, err := tx.Sanction.Create().
+			SetUserID(issue.UserID).
+			SetType(sanction.Type(issue.Type)).
+			SetLevel(issue.Level).
+			SetPreviousLevel(issue.PreviousLevel).
+			SetReason(issue.Reason).
+			SetBlockedUntil(issue.BlockedUntil).
+			SetPerformerID(issue.PerformerID).
+			SetCreatedAt(issue.IssuedAt).
+			Save(ctx)
+		if err != nil {
+			return nil, apperrors.WrapUnexpectedError(err)
+		}
+
+		return r.txResult(ctx, tx, created)
+	})
+}
+
+func (r *SanctionRepository) Lift(
+	ctx context.Context,
+	sanctionID int,
+	resolverID int,
+	comment *string,
+) (*dto.SanctionResultDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "SanctionRepository.Lift")
+	defer span.End()
+
+	return withTxResult(ctx, r.client, func(tx *ent.Tx) (*dto.SanctionResultDTO, error) {
+		found, err := r.txFindLatestActive(ctx, tx, sanctionID)
+		if err != nil {
+			return nil, err
+		}
+
+		now := time.Now()
+
+		affected, err := tx.Sanction.Update().
+			Where(sanction.IDEQ(found.ID), sanction.StatusEQ(sanction.StatusActive)).
+			SetStatus(sanction.StatusLifted).
+			SetResolverID(resolverID).
+			SetNillableResolutionComment(comment).
+			SetResolvedAt(now).
+			Save(ctx)
+		if err != nil {
+			return nil, apperrors.WrapUnexpectedError(err)
+		}
+
+		if affected == 0 {
+			return nil, apperrors.ErrSanctionNotActive
+		}
+
+		// Level and blocked_until are kept as a mark of the active sanction, so the next one is escalated
+		if found.BlockedUntil.After(now) {
+			update := tx.User.UpdateOneID(found.UserID)
+
+			switch found.Type {
+			case sanction.TypeSearch:
+				update.SetSearchBlockedUntil(now)
+			default:
+				update.SetAccountBlockedUntil(now)
+			}
+
+			err = update.Exec(ctx)
+			if err != nil {
+				return nil, apperrors.WrapUnexpectedError(err)
+			}
+		}
+
+		found, err = tx.Sanction.Get(ctx, found.ID)
+		if err != nil {
+			return nil, apperrors.WrapUnexpectedError(err)
+		}
+
+		return r.txResult(ctx, tx, found)
+	})
+}
+
+func (r *SanctionRepository) Appeal(
+	ctx context.Context,
+	userID int,
+	sanctionID int,
+	message string,
+) (*dto.SanctionDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "SanctionRepository.Appeal")
+	defer span.End()
+
+	return withTxResult(ctx, r.client, func(tx *ent.Tx) (*dto.SanctionDTO, error) {
+		found, err := tx.Sanction.Query().
+			Where(sanction.IDEQ(sanctionID), sanction.UserIDEQ(userID)).
+			Only(ctx)
+		if err != nil {
+			return nil, r.handleQueryError(err)
+		}
+
+		// Lifted sanction can be appealed too, the level is still counted
+		if found.Status == sanction.StatusRevoked {
+			return nil, apperrors.ErrSanctionRevoked
+		}
+
+		affected, err := tx.Sanction.Update().
+			Where(
+				sanction.IDEQ(found.ID),
+				sanction.StatusNEQ(sanction.StatusRevoked),
+				sanction.AppealStatusIsNil(),
+			).
+			SetAppealStatus(sanction.AppealStatusPending).
+			SetAppealMessage(message).
+			SetAppealedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return nil, apperrors.WrapUnexpectedError(err)
+		}
+
+		if affected == 0 {
+			return nil, apperrors.ErrSanctionAlreadyAppealed
+		}
+
+		found, err = tx.Sanction.Get(ctx, found.ID)
+		if err != nil {
+			return nil, apperrors.WrapUnexpectedError(err)
+		}
+
+		return mapper.ToSanctionDTOFromEnt(found), nil
+	})
+}
+
+func (r *SanctionRepository) ResolveAppeal(
+	ctx context.Context,
+	sanctionID int,
+	resolverID int,
+	accepted bool,
+	comment *string,
+) (*dto.SanctionResultDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "SanctionRepository.ResolveAppeal")
+	defer span.End()
+
+	return withTxResult(ctx, r.client, func(tx *ent.Tx) (*dto.SanctionResultDTO, error) {
+		found, err := tx.Sanction.Get(ctx, sanctionID)
+		if err != nil {
+			return nil, r.handleQueryError(err)
+		}
+
+		update := tx.Sanction.Update().
+			Where(
+				sanction.IDEQ(found.ID),
+				sanction.AppealStatusEQ(sanction.AppealStatusPending),
+			).
+			SetResolverID(resolverID).
+			SetNillableResolutionComment(comment).
+			SetResolvedAt(time.Now())
+
+		if accepted {
+			update.
+				SetAppealStatus(sanction.AppealStatusAccepted).
+				SetStatus(sanction.StatusRevoked)
+		} else {
+			update.SetAppealStatus(sanction.AppealStatusRejected)
+		}
+
+		affected, err := update.Save(ctx)
+		if err != nil {
+			return nil, apperrors.WrapUnexpectedError(err)
+		}
+
+		if affected == 0 {
+			return nil, apperrors.ErrSanctionAppealNotPending
+		}
+
+		if accepted {
+			err = r.txRestorePreviousLevel(ctx, tx, found)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		found, err = tx.Sanction.Get(ctx, found.ID)
+		if err != nil {
+			return nil, apperrors.WrapUnexpectedError(err)
+		}
+
+		return r.txResult(ctx, tx, found)
+	})
+}
+
+func (r *SanctionRepository) FindByID(ctx context.Context, sanctionID int) (*dto.SanctionDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "SanctionRepository.FindByID")
+	defer span.End()
+
+	found, err := r.client.Sanction.Get(ctx, sanctionID)
+	if err != nil {
+		return nil, r.handleQueryError(err)
+	}
+
+	return mapper.ToSanctionDTOFromEnt(found), nil
+}
+
+func (r *SanctionRepository) FindByUserID(ctx context.Context, userID int) ([]*dto.SanctionDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "SanctionRepository.FindByUserID")
+	defer span.End()
+
+	sanctions, err := r.client.Sanction.Query().
+		Where(sanction.UserIDEQ(userID)).
+		Order(sanction.ByCreatedAt(sql.OrderDesc()), sanction.ByID(sql.OrderDesc())).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return itertools.Map(sanctions, mapper.ToSanctionDTOFromEnt), nil
+}
+
+func (r *SanctionRepository) FindPendingAppealsPaged(
+	ctx context.Context,
+	page, size int,
+) (*dto.PaginatedResult[*dto.SanctionDTO], error) {
+	ctx, span := tracer.StartSpan(ctx, "SanctionRepository.FindPendingAppealsPaged")
+	defer span.End()
+
+	page = getValidPage(page)
+	size = getValidSize(size)
+	offset := countOffset(page, size)
+
+	query := r.client.Sanction.Query().
+		Where(sanction.AppealStatusEQ(sanction.AppealStatusPending))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	sanctions, err := query.
+		Limit(size).
+		Offset(offset).
+		Order(sanction.ByAppealedAt(), sanction.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return &dto.PaginatedResult[*dto.SanctionDTO]{
+		Data:       itertools.Map(sanctions, mapper.ToSanctionDTOFromEnt),
+		Page:       page,
+		Size:       size,
+		TotalItems: total,
+		TotalPages: getTotalPages(total, size),
+	}, nil
+}
+
+// txFindLatestActive finds active sanction, only the latest sanction of its type defines user block state.
+func (r *SanctionRepository) txFindLatestActive(
+	ctx context.Context,
+	tx *ent.Tx,
+	sanctionID int,
+) (*ent.Sanction, error) {
+	found, err := tx.Sanction.Get(ctx, sanctionID)
+	if err != nil {
+		return nil, r.handleQueryError(err)
+	}
+
+	if found.Status != sanction.StatusActive {
+		return nil, apperrors.ErrSanctionNotActive
+	}
+
+	latest, err := r.txIsLatest(ctx, tx, found)
+	if err != nil {
+		return nil, err
+	}
+
+	if !latest {
+		return nil, apperrors.ErrSanctionNotLatest
+	}
+
+	return found, nil
+}
+
+func (r *SanctionRepository) txIsLatest(ctx context.Context, tx *ent.Tx, found *ent.Sanction) (bool, error) {
+	newer, err := tx.Sanction.Query().
+		Where(
+			sanction.UserIDEQ(found.UserID),
+			sanction.TypeEQ(found.Type),
+			sanction.IDGT(found.ID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, apperrors.WrapUnexpectedError(err)
+	}
+
+	return !newer, nil
+}
+
+// txRestorePreviousLevel releases user if revoked sanction is the latest of its type.
+// Revoking an older sanction doesn't change the current block state.
+func (r *SanctionRepository) txRestorePreviousLevel(ctx context.Context, tx *ent.Tx, found *ent.Sanction) error {
+	latest, err := r.txIsLatest(ctx, tx, found)
+	if err != nil {
+		return err
+	}
+
+	if !latest {
+		return nil
+	}
+
+	update := tx.User.UpdateOneID(found.UserID)
+
+	switch found.Type {
+	case sanction.TypeSearch:
+		update.
+			SetSearchBlockedLevel(found.PreviousLevel).
+			ClearSearchBlockedUntil().
+			ClearSearchBlockReason()
+	default:
+		update.
+			SetAccountBlockedLevel(found.PreviousLevel).
+			ClearAccountBlockedUntil().
+			ClearAccountBlockReason()
+	}
+
+	err = update.Exec(ctx)
+	if err != nil {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	return nil
+}
+
+func (r *SanctionRepository) blockStateEQ(
+	sanctionType dto.SanctionType,
+	level int,
+	blockedUntil *time.Time,
+) predicate.User {
+	if sanctionType == dto.SanctionSearch {
+		if blockedUntil == nil {
+			return entUser.And(entUser.SearchBlockedLevelEQ(level), entUser.SearchBlockedUntilIsNil())
+		}
+
+		return entUser.And(entUser.SearchBlockedLevelEQ(level), entUser.SearchBlockedUntilEQ(*blockedUntil))
+	}
+
+	if blockedUntil == nil {
+		return entUser.And(entUser.AccountBlockedLevelEQ(level), entUser.AccountBlockedUntilIsNil())
+	}
+
+	return entUser.And(entUser.AccountBlockedLevelEQ(level), entUser.AccountBlockedUntilEQ(*blockedUntil))
+}
+
+func (r *SanctionRepository) txResult(
+	ctx context.Context,
+	tx *ent.Tx,
+	found *ent.Sanction,
+) (*dto.SanctionResultDTO, error) {
+	user, err := tx.User.Get(ctx, found.UserID)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return &dto.SanctionResultDTO{
+		SanctionDTO: mapper.ToSanctionDTOFromEnt(found),
+		User:        mapper.ToUserDTOFromEnt(user),
+	}, nil
+}
+
+func (r *SanctionRepository) handleQueryError(err error) error {
+	if ent.IsNotFound(err) {
+		return apperrors.WrapSanctionNotFound(err)
+	}
+
+	return apperrors.WrapUnexpectedError(err)
+}
@@ -0,0 +1,561 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/mapper"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/seasonentity"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/inventoryitem"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/season"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasonpass"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasonrewardclaim"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/seasontier"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/statistic"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/userbalance"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/intezya/pkglib/itertools"
+)
+
+type SeasonRepository struct {
+	client *ent.Client
+}
+
+func NewSeasonRepository(client *ent.Client) *SeasonRepository {
+	return &SeasonRepository{client: client}
+}
+
+func (r *SeasonRepository) Create(
+	ctx context.Context,
+	season *dto.CreateSeasonDTO,
+) (*dto.SeasonDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "SeasonRepository.Create")
+	defer span.End()
+
+	created, err := withTxResult(ctx, r.client, func(tx *ent.Tx) (*ent.Season, error) {
+		created, err := tx.Season.
+			Create().
+			SetNumber(season.Number).
+			SetName(season.Name).
+			SetStartsAt(season.StartsAt).
+			SetEndsAt(season.EndsAt).
+			SetPremiumPrice(season.PremiumPrice).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		builders := make([]*ent.SeasonTierCreate, 0, len(season.Tiers))
+		for _, tier := range season.Tiers {
+			builders = append(builders, tx.SeasonTier.
+				Create().
+				SetSeasonID(created.ID).
+				SetLevel(tier.Level).
+				SetXpRequired(tier.XPRequired).
+				SetNillableFreeItemID(tier.FreeItemID).
+				SetFreeCoins(tier.FreeCoins).
+				SetNillablePremiumItemID(tier.PremiumItemID).
+				SetPremiumCoins(tier.PremiumCoins),
+			)
+		}
+
+		created.Edges.Tiers, err = tx.SeasonTier.CreateBulk(builders...).Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return created, nil
+	})
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, apperrors.WrapSeasonAlreadyExists(err)
+		}
+
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return mapper.ToSeasonDTOFromEnt(created), nil
+}
+
+func (r *SeasonRepository) ExistsOverlapping(
+	ctx context.Context,
+	startsAt, endsAt time.Time,
+) (bool, error) {
+	ctx, span := tracer.StartSpan(ctx, "SeasonRepository.ExistsOverlapping")
+	defer span.End()
+
+	exists, err := r.client.Season.
+		Query().
+		Where(
+			season.StartsAtLT(endsAt),
+			season.EndsAtGT(startsAt),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, apperrors.WrapUnexpectedError(err)
+	}
+
+	return exists, nil
+}
+
+func (r *SeasonRepository) FindActive(ctx context.Context, now time.Time) (*dto.SeasonDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "SeasonRepository.FindActive")
+	defer span.End()
+
+	result, err := r.client.Season.
+		Query().
+		Where(
+			season.StartsAtLTE(now),
+			season.EndsAtGT(now),
+		).
+		WithTiers(withOrderedTiers).
+		First(ctx)
+	if err != nil {
+		return nil, r.handleQueryError(err)
+	}
+
+	return mapper.ToSeasonDTOFromEnt(result), nil
+}
+
+func (r *SeasonRepository) FindEndedNotRolledOver(
+	ctx context.Context,
+	now time.Time,
+) ([]*dto.SeasonDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "SeasonRepository.FindEndedNotRolledOver")
+	defer span.End()
+
+	result, err := r.client.Season.
+		Query().
+		Where(
+			season.EndsAtLTE(now),
+			season.RolledOverAtIsNil(),
+		).
+		WithTiers(withOrderedTiers).
+		Order(season.ByEndsAt()).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return itertools.Map(result, mapper.ToSeasonDTOFromEnt), nil
+}
+
+func (r *SeasonRepository) MarkRolledOver(ctx context.Context, seasonID int) error {
+	ctx, span := tracer.StartSpan(ctx, "SeasonRepository.MarkRolledOver")
+	defer span.End()
+
+	err := r.client.Season.
+		UpdateOneID(seasonID).
+		SetRolledOverAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return r.handleQueryError(err)
+	}
+
+	return nil
+}
+
+func (r *SeasonRepository) AddXP(
+	ctx context.Context,
+	userID int,
+	seasonNumber int,
+	xp int,
+) (int, error) {
+	ctx, span := tracer.StartSpan(ctx, "SeasonRepository.AddXP")
+	defer span.End()
+
+	stat, err := r.findSeasonStatistic(ctx, userID, seasonNumber)
+	if ent.IsNotFound(err) {
+		stat, err = r.client.Statistic.
+			Create().
+			SetUserID(userID).
+			SetType(statistic.TypeSeason).
+			SetPeriod(seasonNumber).
+			SetXp(xp).
+			Save(ctx)
+		if err == nil {
+			return stat.Xp, nil
+		}
+
+		if !ent.IsConstraintError(err) {
+			return 0, apperrors.WrapUnexpectedError(err)
+		}
+
+		// statistic has been created concurrently
+		stat, err = r.findSeasonStatistic(ctx, userID, seasonNumber)
+	}
+
+	if err != nil {
+		return 0, apperrors.WrapUnexpectedError(err)
+	}
+
+	stat, err = r.client.Statistic.
+		UpdateOne(stat).
+		AddXp(xp).
+		Save(ctx)
+	if err != nil {
+		return 0, apperrors.WrapUnexpectedError(err)
+	}
+
+	return stat.Xp, nil
+}
+
+func (r *SeasonRepository) FindUserIDsWithXP(ctx context.Context, seasonNumber int) ([]int, error) {
+	ctx, span := tracer.StartSpan(ctx, "SeasonRepository.FindUserIDsWithXP")
+	defer span.End()
+
+	userIDs, err := r.client.Statistic.
+		Query().
+		Where(
+			statistic.TypeEQ(statistic.TypeSeason),
+			statistic.PeriodEQ(seasonNumber),
+			statistic.XpGT(0),
+		).
+		Order(statistic.ByUserID()).
+		Select(statistic.FieldUserID).
+		Ints(ctx)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return userIDs, nil
+}
+
+func (r *SeasonRepository) FindPass(
+	ctx context.Context,
+	userID int,
+	season *dto.SeasonDTO,
+) (*dto.SeasonPassDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "SeasonRepository.FindPass")
+	defer span.End()
+
+	result := &dto.SeasonPassDTO{Season: season}
+
+	stat, err := r.findSeasonStatistic(ctx, userID, season.Number)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	if stat != nil {
+		result.XP = stat.Xp
+	}
+
+	result.Level = season.LevelForXP(result.XP)
+
+	pass, err := r.client.SeasonPass.
+		Query().
+		Where(
+			seasonpass.UserIDEQ(userID),
+			seasonpass.SeasonIDEQ(season.ID),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	if pass != nil {
+		result.Premium = pass.Premium
+		result.PremiumPurchasedAt = pass.PremiumPurchasedAt
+	}
+
+	claims, err := r.client.SeasonRewardClaim.
+		Query().
+		Where(
+			seasonrewardclaim.UserIDEQ(userID),
+			seasonrewardclaim.SeasonIDEQ(season.ID),
+		).
+		Order(seasonrewardclaim.ByLevel(), seasonrewardclaim.ByTrack()).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	result.Claims, err = r.mapClaims(ctx, claims)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return result, nil
+}
+
+func (r *SeasonRepository) PurchasePremium(
+	ctx context.Context,
+	userID int,
+	season *dto.SeasonDTO,
+) error {
+	ctx, span := tracer.StartSpan(ctx, "SeasonRepository.PurchasePremium")
+	defer span.End()
+
+	_, err := withTxResult(ctx, r.client, func(tx *ent.Tx) (*ent.SeasonPass, error) {
+		now := time.Now()
+
+		// Conditional updates guard against concurrent purchases without row locks.
+		updated, err := tx.SeasonPass.
+			Update().
+			Where(
+				seasonpass.UserIDEQ(userID),
+				seasonpass.SeasonIDEQ(season.ID),
+				seasonpass.PremiumEQ(false),
+			).
+			SetPremium(true).
+			SetPremiumPurchasedAt(now).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if updated == 0 {
+			exists, err := tx.SeasonPass.
+				Query().
+				Where(
+					seasonpass.UserIDEQ(userID),
+					seasonpass.SeasonIDEQ(season.ID),
+				).
+				Exist(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			if exists {
+				return nil, apperrors.ErrSeasonPassAlreadyPremium
+			}
+
+			_, err = tx.SeasonPass.
+				Create().
+				SetUserID(userID).
+				SetSeasonID(season.ID).
+				SetPremium(true).
+				SetPremiumPurchasedAt(now).
+				Save(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if season.PremiumPrice > 0 {
+			charged, err := tx.UserBalance.
+				Update().
+				Where(
+					userbalance.UserIDEQ(userID),
+					userbalance.CoinsGTE(season.PremiumPrice),
+				).
+				AddCoins(-season.PremiumPrice).
+				Save(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			if charged == 0 {
+				return nil, apperrors.ErrInsufficientCoins
+			}
+		}
+
+		return nil, nil //nolint:nilnil // only transaction result matters
+	})
+	if err != nil {
+		if errors.Is(err, apperrors.ErrSeasonPassAlreadyPremium) ||
+			errors.Is(err, apperrors.ErrInsufficientCoins) {
+			return err
+		}
+
+		if ent.IsConstraintError(err) {
+			return apperrors.ErrSeasonPassAlreadyPremium // purchased concurrently
+		}
+
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	return nil
+}
+
+func (r *SeasonRepository) Claim(
+	ctx context.Context,
+	userID int,
+	seasonID int,
+	tier *dto.SeasonTierDTO,
+	track seasonentity.Track,
+	automatic bool,
+) (*dto.SeasonRewardClaimDTO, bool, error) {
+	ctx, span := tracer.StartSpan(ctx, "SeasonRepository.Claim")
+	defer span.End()
+
+	existing, err := r.findClaim(ctx, userID, seasonID, tier.Level, track)
+	if err == nil {
+		return existing, false, nil
+	}
+
+	if !ent.IsNotFound(err) {
+		return nil, false, apperrors.WrapUnexpectedError(err)
+	}
+
+	result, err := withTxResult(ctx, r.client, func(tx *ent.Tx) (*dto.SeasonRewardClaimDTO, error) {
+		itemID, coins := tier.Reward(track)
+
+		var item *dto.InventoryItemDTO
+
+		if itemID != nil {
+			item, err = txGrantSystemItem(ctx, tx, userID, *itemID)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if coins > 0 {
+			err = txAddCoins(ctx, tx, userID, coins)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		create := tx.SeasonRewardClaim.
+			Create().
+			SetUserID(userID).
+			SetSeasonID(seasonID).
+			SetLevel(tier.Level).
+			SetTrack(seasonrewardclaim.Track(track)).
+			SetCoins(coins).
+			SetAutomatic(automatic)
+
+		if item != nil {
+			create.SetInventoryItemID(item.ID)
+		}
+
+		// Unique (user_id, season_id, level, track) index rolls back concurrent duplicate grant.
+		claim, err := create.Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return &dto.SeasonRewardClaimDTO{
+			SeasonID:  claim.SeasonID,
+			Level:     claim.Level,
+			Track:     track,
+			Coins:     claim.Coins,
+			Item:      item,
+			Automatic: claim.Automatic,
+			ClaimedAt: claim.ClaimedAt,
+		}, nil
+	})
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			existing, err = r.findClaim(ctx, userID, seasonID, tier.Level, track)
+			if err != nil {
+				return nil, false, apperrors.WrapUnexpectedError(err)
+			}
+
+			return existing, false, nil
+		}
+
+		return nil, false, apperrors.WrapUnexpectedError(err)
+	}
+
+	return result, true, nil
+}
+
+func (r *SeasonRepository) findSeasonStatistic(
+	ctx context.Context,
+	userID int,
+	seasonNumber int,
+) (*ent.Statistic, error) {
+	return r.client.Statistic.
+		Query().
+		Where(
+			statistic.UserIDEQ(userID),
+			statistic.TypeEQ(statistic.TypeSeason),
+			statistic.PeriodEQ(seasonNumber),
+		).
+		Only(ctx)
+}
+
+func (r *SeasonRepository) findClaim(
+	ctx context.Context,
+	userID int,
+	seasonID int,
+	level int,
+	track seasonentity.Track,
+) (*dto.SeasonRewardClaimDTO, error) {
+	claim, err := r.client.SeasonRewardClaim.
+		Query().
+		Where(
+			seasonrewardclaim.UserIDEQ(userID),
+			seasonrewardclaim.SeasonIDEQ(seasonID),
+			seasonrewardclaim.LevelEQ(level),
+			seasonrewardclaim.TrackEQ(seasonrewardclaim.Track(track)),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := r.mapClaims(ctx, []*ent.SeasonRewardClaim{claim})
+	if err != nil {
+		return nil, err
+	}
+
+	return claims[0], nil
+}
+
+// mapClaims loads granted inventory items of claims and maps them.
+func (r *SeasonRepository) mapClaims(
+	ctx context.Context,
+	claims []*ent.SeasonRewardClaim,
+) ([]*dto.SeasonRewardClaimDTO, error) {
+	itemIDs := make([]int, 0, len(claims))
+
+	for _, claim := range claims {
+		if claim.InventoryItemID != nil {
+			itemIDs = append(itemIDs, *claim.InventoryItemID)
+		}
+	}
+
+	itemsByID := make(map[int]*ent.InventoryItem, len(itemIDs))
+
+	if len(itemIDs) > 0 {
+		items, err := r.client.InventoryItem.
+			Query().
+			Where(inventoryitem.IDIn(itemIDs...)).
+			WithItem().
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			itemsByID[item.ID] = item
+		}
+	}
+
+	result := make([]*dto.SeasonRewardClaimDTO, 0, len(claims))
+
+	for _, claim := range claims {
+		var item *ent.InventoryItem
+
+		if claim.InventoryItemID != nil {
+			item = itemsByID[*claim.InventoryItemID]
+		}
+
+		result = append(result, mapper.ToSeasonRewardClaimDTOFromEnt(claim, item))
+	}
+
+	return result, nil
+}
+
+func (r *SeasonRepository) handleQueryError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if ent.IsNotFound(err) {
+		return apperrors.WrapSeasonNotFound(err)
+	}
+
+	return apperrors.WrapUnexpectedError(err)
+}
+
+func withOrderedTiers(query *ent.SeasonTierQuery) {
+	query.Order(seasontier.ByLevel())
+}
@@ -0,0 +1,211 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity"
+	rediswrapper "github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/cache/redis"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/redis/go-redis/v9"
+)
+
+type SessionRepository struct {
+	redisClient *rediswrapper.ClientWrapper
+}
+
+func NewSessionRepository(redisClient *rediswrapper.ClientWrapper) *SessionRepository {
+	return &SessionRepository{redisClient: redisClient}
+}
+
+func (r *SessionRepository) Save(
+	ctx context.Context,
+	session *entity.SessionData,
+	ttl time.Duration,
+) error {
+	ctx, span := tracer.StartSpan(ctx, "SessionRepository.Save")
+	defer span.End()
+
+	client := r.redisClient.Client
+	if client == nil {
+		return apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	userSessionsKey := r.userSessionsKey(session.UserID)
+
+	_, err := client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, r.sessionKey(session.ID), session, ttl)
+		pipe.SAdd(ctx, userSessionsKey, session.ID)
+		// Index lives as long as the latest session, stale members are pruned on read.
+		pipe.Expire(ctx, userSessionsKey, ttl)
+
+		return nil
+	})
+	if err != nil {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	return nil
+}
+
+func (r *SessionRepository) FindByID(ctx context.Context, sessionID string) (*entity.SessionData, error) {
+	ctx, span := tracer.StartSpan(ctx, "SessionRepository.FindByID")
+	defer span.End()
+
+	client := r.redisClient.Client
+	if client == nil {
+		return nil, apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	result := &entity.SessionData{}
+
+	err := client.Get(ctx, r.sessionKey(sessionID)).Scan(result)
+	if errors.Is(err, redis.Nil) {
+		return nil, apperrors.WrapSessionNotFound(err)
+	}
+
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return result, nil
+}
+
+func (r *SessionRepository) FindByUserID(ctx context.Context, userID int) ([]*entity.SessionData, error) {
+	ctx, span := tracer.StartSpan(ctx, "SessionRepository.FindByUserID")
+	defer span.End()
+
+	client := r.redisClient.Client
+	if client == nil {
+		return nil, apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	userSessionsKey := r.userSessionsKey(userID)
+
+	sessionIDs, err := client.SMembers(ctx, userSessionsKey).Result()
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	result := make([]*entity.SessionData, 0, len(sessionIDs))
+
+	if len(sessionIDs) == 0 {
+		return result, nil
+	}
+
+	keys := make([]string, len(sessionIDs))
+	for i, sessionID := range sessionIDs {
+		keys[i] = r.sessionKey(sessionID)
+	}
+
+	values, err := client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	expired := make([]interface{}, 0)
+
+	for i, value := range values {
+		raw, ok := value.(string)
+		if !ok { // session expired
+			expired = append(expired, sessionIDs[i])
+
+			continue
+		}
+
+		session := &entity.SessionData{}
+
+		err = session.UnmarshalBinary([]byte(raw))
+		if err != nil {
+			return nil, apperrors.WrapUnexpectedError(err)
+		}
+
+		result = append(result, session)
+	}
+
+	if len(expired) > 0 {
+		client.SRem(ctx, userSessionsKey, expired...)
+	}
+
+	return result, nil
+}
+
+func (r *SessionRepository) Touch(ctx context.Context, sessionID string, lastActivityAt time.Time) error {
+	ctx, span := tracer.StartSpan(ctx, "SessionRepository.Touch")
+	defer span.End()
+
+	client := r.redisClient.Client
+	if client == nil {
+		return apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	session := &entity.SessionData{}
+
+	err := client.Get(ctx, r.sessionKey(sessionID)).Scan(session)
+	if errors.Is(err, redis.Nil) {
+		return nil
+	}
+
+	if err != nil {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	session.LastActivityAt = lastActivityAt
+
+	// XX keeps session deleted by concurrent logout from being recreated.
+	err = client.SetArgs(ctx, r.sessionKey(sessionID), session, redis.SetArgs{Mode: "XX", KeepTTL: true}).Err()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	return nil
+}
+
+func (r *SessionRepository) Delete(ctx context.Context, userID int, sessionIDs ...string) error {
+	ctx, span := tracer.StartSpan(ctx, "SessionRepository.Delete")
+	defer span.End()
+
+	client := r.redisClient.Client
+	if client == nil {
+		return apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	if len(sessionIDs) == 0 {
+		return nil
+	}
+
+	keys := make([]string, len(sessionIDs))
+	members := make([]interface{}, len(sessionIDs))
+
+	for i, sessionID := range sessionIDs {
+		keys[i] = r.sessionKey(sessionID)
+		members[i] = sessionID
+	}
+
+	_, err := client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, keys...)
+		pipe.SRem(ctx, r.userSessionsKey(userID), members...)
+
+		return nil
+	})
+	if err != nil {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	return nil
+}
+
+func (r *SessionRepository) sessionKey(sessionID string) string {
+	const key = "Session"
+
+	return fmt.Sprintf("%s:%s", key, sessionID)
+}
+
+func (r *SessionRepository) userSessionsKey(userID int) string {
+	const key = "UserSessions"
+
+	return fmt.Sprintf("%s:%d", key, userID)
+}
@@ -0,0 +1,191 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	rediswrapper "github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/cache/redis"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/redis/go-redis/v9"
+)
+
+const usedTOTPStepValue = "1"
+
+type TwoFactorCacheRepository struct {
+	redisClient *rediswrapper.ClientWrapper
+}
+
+func NewTwoFactorCacheRepository(redisClient *rediswrapper.ClientWrapper) *TwoFactorCacheRepository {
+	return &TwoFactorCacheRepository{redisClient: redisClient}
+}
+
+func (r *TwoFactorCacheRepository) SavePendingSecret(
+	ctx context.Context,
+	userID int,
+	encryptedSecret string,
+	ttl time.Duration,
+) error {
+	ctx, span := tracer.StartSpan(ctx, "TwoFactorCacheRepository.SavePendingSecret")
+	defer span.End()
+
+	client := r.redisClient.Client
+	if client == nil {
+		return apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	err := client.Set(ctx, r.pendingSecretKey(userID), encryptedSecret, ttl).Err()
+	if err != nil {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	return nil
+}
+
+func (r *TwoFactorCacheRepository) GetPendingSecret(ctx context.Context, userID int) (string, error) {
+	ctx, span := tracer.StartSpan(ctx, "TwoFactorCacheRepository.GetPendingSecret")
+	defer span.End()
+
+	client := r.redisClient.Client
+	if client == nil {
+		return "", apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	encryptedSecret, err := client.Get(ctx, r.pendingSecretKey(userID)).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", apperrors.ErrTwoFactorEnrollmentNotStarted
+	}
+
+	if err != nil {
+		return "", apperrors.WrapUnexpectedError(err)
+	}
+
+	return encryptedSecret, nil
+}
+
+func (r *TwoFactorCacheRepository) DeletePendingSecret(ctx context.Context, userID int) error {
+	ctx, span := tracer.StartSpan(ctx, "TwoFactorCacheRepository.DeletePendingSecret")
+	defer span.End()
+
+	client := r.redisClient.Client
+	if client == nil {
+		return apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	err := client.Del(ctx, r.pendingSecretKey(userID)).Err()
+	if err != nil {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	return nil
+}
+
+func (r *TwoFactorCacheRepository) MarkStepUsed(
+	ctx context.Context,
+	userID int,
+	step int64,
+	ttl time.Duration,
+) (bool, error) {
+	ctx, span := tracer.StartSpan(ctx, "TwoFactorCacheRepository.MarkStepUsed")
+	defer span.End()
+
+	client := r.redisClient.Client
+	if client == nil {
+		return false, apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	firstUse, err := client.SetNX(ctx, r.usedStepKey(userID, step), usedTOTPStepValue, ttl).Result()
+	if err != nil {
+		return false, apperrors.WrapUnexpectedError(err)
+	}
+
+	return firstUse, nil
+}
+
+func (r *TwoFactorCacheRepository) GetFailures(ctx context.Context, userID int) (int, error) {
+	ctx, span := tracer.StartSpan(ctx, "TwoFactorCacheRepository.GetFailures")
+	defer span.End()
+
+	client := r.redisClient.Client
+	if client == nil {
+		return 0, apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	failures, err := client.Get(ctx, r.failuresKey(userID)).Int()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+
+	if err != nil {
+		return 0, apperrors.WrapUnexpectedError(err)
+	}
+
+	return failures, nil
+}
+
+func (r *TwoFactorCacheRepository) IncrementFailures(
+	ctx context.Context,
+	userID int,
+	ttl time.Duration,
+) (int, error) {
+	ctx, span := tracer.StartSpan(ctx, "TwoFactorCacheRepository.IncrementFailures")
+	defer span.End()
+
+	client := r.redisClient.Client
+	if client == nil {
+		return 0, apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	key := r.failuresKey(userID)
+
+	var incr *redis.IntCmd
+
+	_, err := client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.Incr(ctx, key)
+		pipe.ExpireNX(ctx, key, ttl)
+
+		return nil
+	})
+	if err != nil {
+		return 0, apperrors.WrapUnexpectedError(err)
+	}
+
+	return int(incr.Val()), nil
+}
+
+func (r *TwoFactorCacheRepository) ResetFailures(ctx context.Context, userID int) error {
+	ctx, span := tracer.StartSpan(ctx, "TwoFactorCacheRepository.ResetFailures")
+	defer span.End()
+
+	client := r.redisClient.Client
+	if client == nil {
+		return apperrors.WrapServiceUnavailable(ErrClientNotReady)
+	}
+
+	err := client.Del(ctx, r.failuresKey(userID)).Err()
+	if err != nil {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	return nil
+}
+
+func (r *TwoFactorCacheRepository) pendingSecretKey(userID int) string {
+	const key = "TOTPPendingSecret"
+
+	return fmt.Sprintf("%s:%d", key, userID)
+}
+
+func (r *TwoFactorCacheRepository) usedStepKey(userID int, step int64) string {
+	const key = "TOTPUsedStep"
+
+	return fmt.Sprintf("%s:%d:%d", key, userID, step)
+}
+
+func (r *TwoFactorCacheRepository) failuresKey(userID int) string {
+	const key = "TOTPFailures"
+
+	return fmt.Sprintf("%s:%d", key, userID)
+}
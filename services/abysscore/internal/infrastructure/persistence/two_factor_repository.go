@@ -0,0 +1,133 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/recoverycode"
+	entUser "github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
+	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+)
+
+type TwoFactorRepository struct {
+	client *ent.Client
+}
+
+func NewTwoFactorRepository(client *ent.Client) *TwoFactorRepository {
+	return &TwoFactorRepository{client: client}
+}
+
+func (r *TwoFactorRepository) Enable(
+	ctx context.Context,
+	userID int,
+	encryptedSecret string,
+	recoveryCodeHashes []string,
+) error {
+	ctx, span := tracer.StartSpan(ctx, "TwoFactorRepository.Enable")
+	defer span.End()
+
+	return withTx(ctx, r.client, func(tx *ent.Tx) error {
+		affected, err := tx.User.Update().
+			Where(entUser.IDEQ(userID), entUser.TotpSecretIsNil()).
+			SetTotpSecret(encryptedSecret).
+			SetTotpEnabledAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return apperrors.WrapUnexpectedError(err)
+		}
+
+		if affected == 0 {
+			return apperrors.ErrTwoFactorAlreadyEnabled
+		}
+
+		return r.txReplaceRecoveryCodes(ctx, tx, userID, recoveryCodeHashes)
+	})
+}
+
+func (r *TwoFactorRepository) Disable(ctx context.Context, userID int) error {
+	ctx, span := tracer.StartSpan(ctx, "TwoFactorRepository.Disable")
+	defer span.End()
+
+	return withTx(ctx, r.client, func(tx *ent.Tx) error {
+		affected, err := tx.User.Update().
+			Where(entUser.IDEQ(userID), entUser.TotpSecretNotNil()).
+			ClearTotpSecret().
+			ClearTotpEnabledAt().
+			Save(ctx)
+		if err != nil {
+			return apperrors.WrapUnexpectedError(err)
+		}
+
+		if affected == 0 {
+			return apperrors.ErrTwoFactorNotEnabled
+		}
+
+		return r.txReplaceRecoveryCodes(ctx, tx, userID, nil)
+	})
+}
+
+func (r *TwoFactorRepository) ReplaceRecoveryCodes(
+	ctx context.Context,
+	userID int,
+	recoveryCodeHashes []string,
+) error {
+	ctx, span := tracer.StartSpan(ctx, "TwoFactorRepository.ReplaceRecoveryCodes")
+	defer span.End()
+
+	return withTx(ctx, r.client, func(tx *ent.Tx) error {
+		return r.txReplaceRecoveryCodes(ctx, tx, userID, recoveryCodeHashes)
+	})
+}
+
+func (r *TwoFactorRepository) UseRecoveryCode(ctx context.Context, userID int, codeHash string) (bool, error) {
+	ctx, span := tracer.StartSpan(ctx, "TwoFactorRepository.UseRecoveryCode")
+	defer span.End()
+
+	// Conditional update, so concurrent requests can't use the same code twice
+	affected, err := r.client.RecoveryCode.Update().
+		Where(
+			recoverycode.UserIDEQ(userID),
+			recoverycode.CodeHashEQ(codeHash),
+			recoverycode.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return false, apperrors.WrapUnexpectedError(err)
+	}
+
+	return affected > 0, nil
+}
+
+func (r *TwoFactorRepository) txReplaceRecoveryCodes(
+	ctx context.Context,
+	tx *ent.Tx,
+	userID int,
+	recoveryCodeHashes []string,
+) error {
+	_, err := tx.RecoveryCode.Delete().
+		Where(recoverycode.UserIDEQ(userID)).
+		Exec(ctx)
+	if err != nil {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	if len(recoveryCodeHashes) == 0 {
+		return nil
+	}
+
+	builders := make([]*ent.RecoveryCodeCreate, 0, len(recoveryCodeHashes))
+
+	for _, codeHash := range recoveryCodeHashes {
+		builders = append(builders, tx.RecoveryCode.Create().SetUserID(userID).SetCodeHash(codeHash))
+	}
+
+	err = tx.RecoveryCode.CreateBulk(builders...).Exec(ctx)
+	if err != nil {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	return nil
+}
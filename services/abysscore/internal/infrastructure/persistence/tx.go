@@ -49,6 +49,19 @@ func withTxResult[T any](
 	return res, err
 }
 
+// withTx is withTxResult for functions without result.
+func withTx(
+	ctx context.Context,
+	client *ent.Client,
+	fn func(tx *ent.Tx) error,
+) error {
+	_, err := withTxResult(ctx, client, func(tx *ent.Tx) (*struct{}, error) {
+		return nil, fn(tx)
+	})
+
+	return err
+}
+
 func WithTxResultTx[T any](
 	ctx context.Context,
 	tx *ent.Tx,
@@ -8,9 +8,16 @@ import (
 	"github.com/intezya/abyssleague/services/abysscore/internal/adapters/mapper"
 	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/emailhistory"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/passwordhistory"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/predicate"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/statistic"
 	entUser "github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/user"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/userbalance"
+	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/ent/usernamehistory"
 	"github.com/intezya/abyssleague/services/abysscore/internal/infrastructure/metrics/tracer"
 	"github.com/intezya/abyssleague/services/abysscore/internal/pkg/apperrors"
+	"github.com/intezya/pkglib/itertools"
 )
 
 // UserRepository provides access to user data in the database.
@@ -67,6 +74,40 @@ func (r *UserRepository) FindFullDTOById(ctx context.Context, id int) (*dto.User
 	return mapper.ToUserFullDTOFromEnt(user), nil
 }
 
+// FindDTOByEmail retrieves basic user data by linked email, case-insensitively.
+func (r *UserRepository) FindDTOByEmail(ctx context.Context, email string) (*dto.UserDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "UserRepository.FindDTOByEmail")
+	defer span.End()
+
+	user, err := r.client.User.
+		Query().
+		Where(entUser.EmailEqualFold(email)).
+		Only(ctx)
+	if err != nil {
+		return nil, r.handleQueryError(err)
+	}
+
+	return mapper.ToUserDTOFromEnt(user), nil
+}
+
+func (r *UserRepository) FindDTOByLowerUsername(
+	ctx context.Context,
+	username string,
+) (*dto.UserDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "UserRepository.FindDTOByLowerUsername")
+	defer span.End()
+
+	user, err := r.client.User.
+		Query().
+		Where(resolvesUsername(username)).
+		Only(ctx)
+	if err != nil {
+		return nil, r.handleQueryError(err)
+	}
+
+	return mapper.ToUserDTOFromEnt(user), nil
+}
+
 func (r *UserRepository) ExistsByEmail(ctx context.Context, email string) bool {
 	ctx, span := tracer.StartSpan(ctx, "UserRepository.ExistsByEmail")
 	defer span.End()
@@ -78,12 +119,164 @@ func (r *UserRepository) ExistsByEmail(ctx context.Context, email string) bool {
 	return exists
 }
 
+// FindIDsByFilter retrieves IDs of users matching the filter.
+func (r *UserRepository) FindIDsByFilter(
+	ctx context.Context,
+	filter *dto.UserFilterDTO,
+) ([]int, error) {
+	ctx, span := tracer.StartSpan(ctx, "UserRepository.FindIDsByFilter")
+	defer span.End()
+
+	query := r.client.User.Query()
+
+	if filter.RegisteredBefore != nil {
+		query.Where(entUser.CreatedAtLT(*filter.RegisteredBefore))
+	}
+
+	if filter.MinSearchScore != nil {
+		query.Where(
+			entUser.HasStatisticsWith(
+				statistic.TypeEQ(statistic.TypeGlobal),
+				statistic.SearchScoreGT(*filter.MinSearchScore),
+			),
+		)
+	}
+
+	ids, err := query.
+		Order(entUser.ByID()).
+		IDs(ctx)
+	if err != nil {
+		return nil, r.handleQueryError(err)
+	}
+
+	return ids, nil
+}
+
+// FindExistingIDs filters out IDs of users that don't exist.
+func (r *UserRepository) FindExistingIDs(ctx context.Context, ids []int) ([]int, error) {
+	ctx, span := tracer.StartSpan(ctx, "UserRepository.FindExistingIDs")
+	defer span.End()
+
+	existing, err := r.client.User.
+		Query().
+		Where(entUser.IDIn(ids...)).
+		Order(entUser.ByID()).
+		IDs(ctx)
+	if err != nil {
+		return nil, r.handleQueryError(err)
+	}
+
+	return existing, nil
+}
+
+func (r *UserRepository) FindEncodedHardwareIDsByFingerprint(
+	ctx context.Context,
+	fingerprint string,
+) (map[int]string, error) {
+	ctx, span := tracer.StartSpan(ctx, "UserRepository.FindEncodedHardwareIDsByFingerprint")
+	defer span.End()
+
+	var rows []struct {
+		ID         int    `json:"id"`
+		HardwareID string `json:"hardware_id"`
+	}
+
+	err := r.client.User.
+		Query().
+		Where(entUser.HardwareIDFingerprintEQ(fingerprint), entUser.HardwareIDNotNil()).
+		Select(entUser.FieldID, entUser.FieldHardwareID).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, r.handleQueryError(err)
+	}
+
+	result := make(map[int]string, len(rows))
+	for _, row := range rows {
+		result[row.ID] = row.HardwareID
+	}
+
+	return result, nil
+}
+
+func (r *UserRepository) FindSecretsNotEncodedWithKey(
+	ctx context.Context,
+	keyID string,
+	afterID int,
+	limit int,
+) ([]*dto.EncodedSecretsDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "UserRepository.FindSecretsNotEncodedWithKey")
+	defer span.End()
+
+	prefix := keyID + ":"
+
+	users, err := r.client.User.Query().
+		Where(
+			entUser.IDGT(afterID),
+			entUser.Or(
+				entUser.And(entUser.HardwareIDNotNil(), entUser.Not(entUser.HardwareIDHasPrefix(prefix))),
+				entUser.And(entUser.HardwareIDNotNil(), entUser.HardwareIDFingerprintIsNil()),
+				entUser.And(entUser.TotpSecretNotNil(), entUser.Not(entUser.TotpSecretHasPrefix(prefix))),
+			),
+		).
+		Select(
+			entUser.FieldID,
+			entUser.FieldHardwareID,
+			entUser.FieldHardwareIDFingerprint,
+			entUser.FieldTotpSecret,
+		).
+		Order(entUser.ByID()).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, r.handleQueryError(err)
+	}
+
+	return itertools.Map(users, func(user *ent.User) *dto.EncodedSecretsDTO {
+		return &dto.EncodedSecretsDTO{
+			UserID:              user.ID,
+			HardwareID:          user.HardwareID,
+			HardwareFingerprint: user.HardwareIDFingerprint,
+			TOTPSecret:          user.TotpSecret,
+		}
+	}), nil
+}
+
+func (r *UserRepository) ReplaceEncodedSecrets(
+	ctx context.Context,
+	current, replacement *dto.EncodedSecretsDTO,
+) (bool, error) {
+	ctx, span := tracer.StartSpan(ctx, "UserRepository.ReplaceEncodedSecrets")
+	defer span.End()
+
+	hardwareIDUnchanged := entUser.HardwareIDIsNil()
+	if current.HardwareID != nil {
+		hardwareIDUnchanged = entUser.HardwareIDEQ(*current.HardwareID)
+	}
+
+	totpSecretUnchanged := entUser.TotpSecretIsNil()
+	if current.TOTPSecret != nil {
+		totpSecretUnchanged = entUser.TotpSecretEQ(*current.TOTPSecret)
+	}
+
+	affected, err := r.client.User.Update().
+		Where(entUser.IDEQ(current.UserID), hardwareIDUnchanged, totpSecretUnchanged).
+		SetNillableHardwareID(replacement.HardwareID).
+		SetNillableHardwareIDFingerprint(replacement.HardwareFingerprint).
+		SetNillableTotpSecret(replacement.TOTPSecret).
+		Save(ctx)
+	if err != nil {
+		return false, r.handleUpdateError(err)
+	}
+
+	return affected > 0, nil
+}
+
 // TxUpdateHardwareIDByID updates a user's hardware ID.
 func (r *UserRepository) TxUpdateHardwareIDByID(
 	ctx context.Context,
 	tx *ent.Tx,
 	id int,
-	hardwareID string,
+	hardwareID, hardwareFingerprint string,
 ) error {
 	ctx, span := tracer.StartSpan(ctx, "UserRepository.TxUpdateHardwareIDByID")
 	defer span.End()
@@ -91,6 +284,7 @@ func (r *UserRepository) TxUpdateHardwareIDByID(
 	_, err := tx.User.
 		UpdateOneID(id).
 		SetHardwareID(hardwareID).
+		SetHardwareIDFingerprint(hardwareFingerprint).
 		Save(ctx)
 	if err != nil {
 		if ent.IsConstraintError(err) && strings.Contains(err.Error(), "hardwareID") {
@@ -132,15 +326,25 @@ func (r *UserRepository) TxSetBlockUntilAndLevelAndReasonFromUser(
 	ctx, span := tracer.StartSpan(ctx, "UserRepository.TxSetBlockUntilAndLevelAndReasonFromUser")
 	defer span.End()
 
-	_, err := tx.User.
+	update := tx.User.
 		UpdateOneID(user.ID).
-		SetNillableAccountBlockedUntil(user.AccountBlockedUntil).
 		SetAccountBlockedLevel(user.AccountBlockedLevel).
-		SetNillableAccountBlockReason(user.AccountBlockReason).
-		SetNillableSearchBlockedUntil(user.SearchBlockedUntil).
-		SetSearchBlockedLevel(user.SearchBlockedLevel).
-		SetNillableSearchBlockReason(user.SearchBlockReason).
-		Save(ctx)
+		SetSearchBlockedLevel(user.SearchBlockedLevel)
+
+	// SetNillable* skips nil values, so expired blocks have to be cleared explicitly
+	if user.AccountBlockedUntil == nil {
+		update.ClearAccountBlockedUntil().ClearAccountBlockReason()
+	} else {
+		update.SetAccountBlockedUntil(*user.AccountBlockedUntil).SetNillableAccountBlockReason(user.AccountBlockReason)
+	}
+
+	if user.SearchBlockedUntil == nil {
+		update.ClearSearchBlockedUntil().ClearSearchBlockReason()
+	} else {
+		update.SetSearchBlockedUntil(*user.SearchBlockedUntil).SetNillableSearchBlockReason(user.SearchBlockReason)
+	}
+
+	_, err := update.Save(ctx)
 
 	return r.handleUpdateError(err)
 }
@@ -185,11 +389,294 @@ func (r *UserRepository) SetEmailIfNil(
 				return nil, r.handleConstraintError(err) // unexpected
 			}
 
+			err = r.txCreateEmailHistory(ctx, tx, &dto.EmailChangeDTO{
+				UserID:   userID,
+				Action:   dto.EmailLinked,
+				NewEmail: &email,
+			})
+			if err != nil {
+				return nil, err
+			}
+
 			return mapper.ToUserDTOFromEnt(savedUser), nil
 		},
 	)
 }
 
+// ReplaceEmail replaces the email if it is still equal to change.OldEmail and records it in email history.
+func (r *UserRepository) ReplaceEmail(
+	ctx context.Context,
+	change *dto.EmailChangeDTO,
+) (*dto.UserDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "UserRepository.ReplaceEmail")
+	defer span.End()
+
+	return withTxResult(
+		ctx, r.client, func(tx *ent.Tx) (*dto.UserDTO, error) {
+			update := tx.User.Update().Where(entUser.IDEQ(change.UserID))
+
+			if change.OldEmail == nil {
+				update.Where(entUser.EmailIsNil())
+			} else {
+				update.Where(entUser.EmailEQ(*change.OldEmail))
+			}
+
+			if change.NewEmail == nil {
+				update.ClearEmail()
+			} else {
+				update.SetEmail(*change.NewEmail)
+			}
+
+			affected, err := update.Save(ctx)
+			if ent.IsConstraintError(err) {
+				return nil, apperrors.ErrEmailConflict
+			}
+
+			if err != nil {
+				return nil, apperrors.WrapUnexpectedError(err)
+			}
+
+			if affected == 0 {
+				return nil, apperrors.ErrEmailChangedConcurrently
+			}
+
+			err = r.txCreateEmailHistory(ctx, tx, change)
+			if err != nil {
+				return nil, err
+			}
+
+			user, err := tx.User.Get(ctx, change.UserID)
+			if err != nil {
+				return nil, r.handleQueryError(err)
+			}
+
+			return mapper.ToUserDTOFromEnt(user), nil
+		},
+	)
+}
+
+// FindEmailHistoryByUserID returns email history of the user, most recent first.
+func (r *UserRepository) FindEmailHistoryByUserID(
+	ctx context.Context,
+	userID int,
+) ([]*dto.EmailHistoryDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "UserRepository.FindEmailHistoryByUserID")
+	defer span.End()
+
+	records, err := r.client.EmailHistory.
+		Query().
+		Where(emailhistory.UserIDEQ(userID)).
+		Order(ent.Desc(emailhistory.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return itertools.Map(records, mapper.ToEmailHistoryDTOFromEnt), nil
+}
+
+// FindLastEmailChangeAt returns time of the latest email history record, nil if email has never been set.
+func (r *UserRepository) FindLastEmailChangeAt(ctx context.Context, userID int) (*time.Time, error) {
+	ctx, span := tracer.StartSpan(ctx, "UserRepository.FindLastEmailChangeAt")
+	defer span.End()
+
+	record, err := r.client.EmailHistory.
+		Query().
+		Where(emailhistory.UserIDEQ(userID)).
+		Order(ent.Desc(emailhistory.FieldCreatedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil //nolint:nilnil // no changes yet
+	}
+
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return &record.CreatedAt, nil
+}
+
+func (r *UserRepository) txCreateEmailHistory(
+	ctx context.Context,
+	tx *ent.Tx,
+	change *dto.EmailChangeDTO,
+) error {
+	err := tx.EmailHistory.Create().
+		SetUserID(change.UserID).
+		SetAction(emailhistory.Action(change.Action)).
+		SetNillableOldEmail(change.OldEmail).
+		SetNillableNewEmail(change.NewEmail).
+		SetNillablePerformerID(change.PerformerID).
+		SetNillableReason(change.Reason).
+		Exec(ctx)
+	if err != nil {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	return nil
+}
+
+// ReplaceUsername changes username if it is still equal to OldUsername, charges CoinsSpent
+// and keeps the old username bound to the user until ReleasedAt.
+func (r *UserRepository) ReplaceUsername(
+	ctx context.Context,
+	change *dto.UsernameChangeDTO,
+) (*dto.UserDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "UserRepository.ReplaceUsername")
+	defer span.End()
+
+	return withTxResult(
+		ctx, r.client, func(tx *ent.Tx) (*dto.UserDTO, error) {
+			err := r.txCheckUsernameAvailable(ctx, tx, change.NewUsername, change.UserID)
+			if err != nil {
+				return nil, err
+			}
+
+			affected, err := tx.User.Update().
+				Where(entUser.IDEQ(change.UserID), entUser.UsernameEQ(change.OldUsername)).
+				SetUsername(change.NewUsername).
+				Save(ctx)
+			if ent.IsConstraintError(err) {
+				return nil, apperrors.ErrUsernameTaken
+			}
+
+			if err != nil {
+				return nil, apperrors.WrapUnexpectedError(err)
+			}
+
+			if affected == 0 {
+				return nil, apperrors.ErrUsernameChangedConcurrently
+			}
+
+			if change.CoinsSpent > 0 {
+				charged, err := tx.UserBalance.Update().
+					Where(
+						userbalance.UserIDEQ(change.UserID),
+						userbalance.CoinsGTE(change.CoinsSpent),
+					).
+					AddCoins(-change.CoinsSpent).
+					Save(ctx)
+				if err != nil {
+					return nil, apperrors.WrapUnexpectedError(err)
+				}
+
+				if charged == 0 {
+					return nil, apperrors.ErrInsufficientCoins
+				}
+			}
+
+			err = tx.UsernameHistory.Create().
+				SetUserID(change.UserID).
+				SetOldUsername(change.OldUsername).
+				SetNewUsername(change.NewUsername).
+				SetCoinsSpent(change.CoinsSpent).
+				SetReleasedAt(change.ReleasedAt).
+				Exec(ctx)
+			if err != nil {
+				return nil, apperrors.WrapUnexpectedError(err)
+			}
+
+			user, err := tx.User.Query().
+				Where(entUser.IDEQ(change.UserID)).
+				WithRoles().
+				Only(ctx)
+			if err != nil {
+				return nil, r.handleQueryError(err)
+			}
+
+			return mapper.ToUserDTOFromEnt(user), nil
+		},
+	)
+}
+
+func (r *UserRepository) FindUsernameHistoryByUserID(
+	ctx context.Context,
+	userID int,
+) ([]*dto.UsernameHistoryDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "UserRepository.FindUsernameHistoryByUserID")
+	defer span.End()
+
+	records, err := r.client.UsernameHistory.
+		Query().
+		Where(usernamehistory.UserIDEQ(userID)).
+		Order(ent.Desc(usernamehistory.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return itertools.Map(records, mapper.ToUsernameHistoryDTOFromEnt), nil
+}
+
+// FindLastUsernameChangeAt returns time of the latest username change, nil if username has never been changed.
+func (r *UserRepository) FindLastUsernameChangeAt(ctx context.Context, userID int) (*time.Time, error) {
+	ctx, span := tracer.StartSpan(ctx, "UserRepository.FindLastUsernameChangeAt")
+	defer span.End()
+
+	record, err := r.client.UsernameHistory.
+		Query().
+		Where(usernamehistory.UserIDEQ(userID)).
+		Order(ent.Desc(usernamehistory.FieldCreatedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil //nolint:nilnil // no changes yet
+	}
+
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return &record.CreatedAt, nil
+}
+
+// txCheckUsernameAvailable compares usernames case-insensitively, unlike the unique index,
+// and rejects usernames released by other users within the grace period.
+func (r *UserRepository) txCheckUsernameAvailable(
+	ctx context.Context,
+	tx *ent.Tx,
+	username string,
+	exceptUserID int,
+) error {
+	taken, err := tx.User.Query().
+		Where(entUser.UsernameEqualFold(username), entUser.IDNEQ(exceptUserID)).
+		Exist(ctx)
+	if err != nil {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	if taken {
+		return apperrors.ErrUsernameTaken
+	}
+
+	held, err := tx.UsernameHistory.Query().
+		Where(
+			usernamehistory.OldUsernameEqualFold(username),
+			usernamehistory.ReleasedAtGT(time.Now()),
+			usernamehistory.UserIDNEQ(exceptUserID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return apperrors.WrapUnexpectedError(err)
+	}
+
+	if held {
+		return apperrors.ErrUsernameTaken
+	}
+
+	return nil
+}
+
+// resolvesUsername matches the user by current username or by the old one until it is released.
+func resolvesUsername(username string) predicate.User {
+	return entUser.Or(
+		entUser.UsernameEqualFold(username),
+		entUser.HasUsernameHistoryWith(
+			usernamehistory.OldUsernameEqualFold(username),
+			usernamehistory.ReleasedAtGT(time.Now()),
+		),
+	)
+}
+
 func (r *UserRepository) WithTx(ctx context.Context) (*ent.Tx, error) {
 	ctx, span := tracer.StartSpan(ctx, "UserRepository.WithTx")
 	defer span.End()
@@ -230,11 +717,17 @@ func (r *UserRepository) TxCreate(
 	ctx, span := tracer.StartSpan(ctx, "UserRepository.TxCreate")
 	defer span.End()
 
+	err := r.txCheckUsernameAvailable(ctx, tx, credentials.Username, 0)
+	if err != nil {
+		return nil, err
+	}
+
 	user, err := tx.User.
 		Create().
 		SetUsername(credentials.Username).
 		SetPassword(credentials.Password).
 		SetHardwareID(credentials.HardwareID).
+		SetHardwareIDFingerprint(credentials.HardwareFingerprint).
 		Save(ctx)
 	if err != nil {
 		return nil, r.handleConstraintError(err)
@@ -243,27 +736,130 @@ func (r *UserRepository) TxCreate(
 	return mapper.ToUserDTOFromEnt(user), nil
 }
 
-// TxUpdatePasswordByID updates a user's password and returns the updated user data.
-func (r *UserRepository) TxUpdatePasswordByID(
+// ReplacePassword replaces the password if it is still equal to oldPassword and moves the old one to
+// password history, keeping only historySize-1 latest records since the current password is checked separately.
+func (r *UserRepository) ReplacePassword(
 	ctx context.Context,
-	tx *ent.Tx,
-	id int,
-	password string,
+	userID int,
+	oldPassword, newPassword string,
+	historySize int,
 ) error {
-	ctx, span := tracer.StartSpan(ctx, "UserRepository.TxUpdatePasswordByID")
+	ctx, span := tracer.StartSpan(ctx, "UserRepository.ReplacePassword")
 	defer span.End()
 
-	_, err := tx.User.
-		UpdateOneID(id).
-		SetPassword(password).
-		Save(ctx)
+	return withTx(
+		ctx, r.client, func(tx *ent.Tx) error {
+			affected, err := tx.User.Update().
+				Where(entUser.IDEQ(userID), entUser.PasswordEQ(oldPassword)).
+				SetPassword(newPassword).
+				// Login locked after guessing the old password is no longer at risk
+				ClearLoginLockedUntil().
+				Save(ctx)
+			if err != nil {
+				return apperrors.WrapUnexpectedError(err)
+			}
+
+			if affected == 0 {
+				return apperrors.ErrPasswordChangedConcurrently
+			}
+
+			err = tx.PasswordHistory.Create().
+				SetUserID(userID).
+				SetPassword(oldPassword).
+				Exec(ctx)
+			if err != nil {
+				return apperrors.WrapUnexpectedError(err)
+			}
+
+			outdatedIDs, err := tx.PasswordHistory.Query().
+				Where(passwordhistory.UserIDEQ(userID)).
+				Order(ent.Desc(passwordhistory.FieldCreatedAt), ent.Desc(passwordhistory.FieldID)).
+				Offset(max(historySize-1, 0)).
+				IDs(ctx)
+			if err != nil {
+				return apperrors.WrapUnexpectedError(err)
+			}
+
+			if len(outdatedIDs) == 0 {
+				return nil
+			}
+
+			_, err = tx.PasswordHistory.Delete().
+				Where(passwordhistory.IDIn(outdatedIDs...)).
+				Exec(ctx)
+			if err != nil {
+				return apperrors.WrapUnexpectedError(err)
+			}
+
+			return nil
+		},
+	)
+}
+
+// LockLoginUntil locks login to the account until the given time.
+func (r *UserRepository) LockLoginUntil(ctx context.Context, userID int, until time.Time) error {
+	ctx, span := tracer.StartSpan(ctx, "UserRepository.LockLoginUntil")
+	defer span.End()
+
+	err := r.client.User.
+		UpdateOneID(userID).
+		SetLoginLockedUntil(until).
+		Exec(ctx)
 	if err != nil {
-		return r.handleQueryError(err)
+		return r.handleUpdateError(err)
 	}
 
 	return nil
 }
 
+// FindRecentPasswords returns encoded previous passwords of the user, most recent first.
+func (r *UserRepository) FindRecentPasswords(ctx context.Context, userID int, limit int) ([]string, error) {
+	ctx, span := tracer.StartSpan(ctx, "UserRepository.FindRecentPasswords")
+	defer span.End()
+
+	passwords, err := r.client.PasswordHistory.
+		Query().
+		Where(passwordhistory.UserIDEQ(userID)).
+		Order(ent.Desc(passwordhistory.FieldCreatedAt), ent.Desc(passwordhistory.FieldID)).
+		Limit(limit).
+		Select(passwordhistory.FieldPassword).
+		Strings(ctx)
+	if err != nil {
+		return nil, apperrors.WrapUnexpectedError(err)
+	}
+
+	return passwords, nil
+}
+
+func (r *UserRepository) TxFindFullDTOById(
+	ctx context.Context,
+	tx *ent.Tx,
+	id int,
+) (*dto.UserFullDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "UserRepository.TxFindFullDTOById")
+	defer span.End()
+
+	user, err := tx.User.
+		Query().
+		Where(entUser.IDEQ(id)).
+		WithCurrentMatch().
+		WithFriends().
+		WithCurrentItem(func(q *ent.InventoryItemQuery) {
+			q.WithItem()
+		}).
+		WithItems(func(q *ent.InventoryItemQuery) {
+			q.WithItem()
+		}).
+		WithReceivedFriendRequests().
+		WithStatistics().
+		Only(ctx)
+	if err != nil {
+		return nil, r.handleQueryError(err)
+	}
+
+	return mapper.ToUserFullDTOFromEnt(user), nil
+}
+
 func (r *UserRepository) TxFindFullDTOByLowerUsername(
 	ctx context.Context,
 	tx *ent.Tx,
@@ -274,7 +870,7 @@ func (r *UserRepository) TxFindFullDTOByLowerUsername(
 
 	user, err := tx.User.
 		Query().
-		Where(entUser.UsernameEqualFold(username)).
+		Where(resolvesUsername(username)).
 		WithCurrentMatch().
 		WithFriends().
 		WithCurrentItem(func(q *ent.InventoryItemQuery) {
@@ -303,7 +899,8 @@ func (r *UserRepository) TxFindDTOByLowerUsername(
 
 	user, err := tx.User.
 		Query().
-		Where(entUser.UsernameEqualFold(username)).
+		Where(resolvesUsername(username)).
+		WithRoles().
 		Only(ctx)
 	if err != nil {
 		return nil, r.handleQueryError(err)
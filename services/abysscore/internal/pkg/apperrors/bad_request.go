@@ -2,11 +2,26 @@ package apperrors
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/intezya/abyssleague/services/abysscore/pkg/errorz"
 )
 
-var errWrongVerificationCode = errors.New("wrong verification code")
+var (
+	errWrongVerificationCode        = errors.New("wrong verification code")
+	errInvalidHardwareIDResetStatus = errors.New("invalid hardware id reset status")
+	errInvalidSanctionType          = errors.New("invalid sanction type")
+	errUnknownPermission            = errors.New("unknown permission")
+	errPasswordRecentlyUsed         = errors.New("password has been used recently")
+	errPasswordSameAsCurrent        = errors.New("new password must differ from the current one")
+	errUnknownIdentityProvider      = errors.New("unknown identity provider")
+	errInvalidOIDCState             = errors.New("authorization is expired or started from another device")
+	errInvalidRegistrationToken     = errors.New("registration token is invalid or expired")
+	errUnknownAPITokenScope         = errors.New("unknown api token scope")
+	errAPITokenScopesEmpty          = errors.New("api token must have at least one scope")
+	errUsernameSameAsCurrent        = errors.New("new username must differ from the current one")
+	errUnknownExportFormat          = errors.New("export format must be json or zip")
+)
 
 var (
 	ErrWrongVerificationCodeForEmailLink  = errorz.BadRequest(errWrongVerificationCode)
@@ -14,6 +29,32 @@ var (
 		return errorz.ServiceUnavailable(err)
 	}
 
+	ErrWrongVerificationCodeForPasswordReset = errorz.BadRequest(errWrongVerificationCode)
+
+	ErrWrongVerificationCodeForEmailChange = errorz.BadRequest(errWrongVerificationCode)
+
+	ErrInvalidHardwareIDResetStatus = errorz.BadRequest(errInvalidHardwareIDResetStatus)
+
+	ErrInvalidSanctionType = errorz.BadRequest(errInvalidSanctionType)
+
+	ErrUnknownPermission = errorz.BadRequest(errUnknownPermission)
+
+	ErrPasswordRecentlyUsed  = errorz.BadRequest(errPasswordRecentlyUsed)
+	ErrPasswordSameAsCurrent = errorz.BadRequest(errPasswordSameAsCurrent)
+
+	ErrUnknownIdentityProvider  = errorz.BadRequest(errUnknownIdentityProvider)
+	ErrInvalidOIDCState         = errorz.BadRequest(errInvalidOIDCState)
+	ErrInvalidRegistrationToken = errorz.BadRequest(errInvalidRegistrationToken)
+
+	ErrUnknownAPITokenScope    = errorz.BadRequest(errUnknownAPITokenScope)
+	ErrAPITokenScopesEmpty     = errorz.BadRequest(errAPITokenScopesEmpty)
+	ErrUsernameSameAsCurrent   = errorz.BadRequest(errUsernameSameAsCurrent)
+	ErrUnknownExportFormat     = errorz.BadRequest(errUnknownExportFormat)
+	ErrInvalidAPITokenLifetime = func(maxDays int) error {
+		//nolint:err113 // required dynamic error
+		return errorz.BadRequest(fmt.Errorf("api token lifetime must be from 1 to %d days", maxDays))
+	}
+
 	WrapBadRequest = func(err error) error {
 		return errorz.BadRequest(err)
 	}
@@ -13,7 +13,83 @@ var (
 		return errorz.Conflict("user already exists", err)
 	}
 
+	WrapRoleAlreadyExists = func(err error) error {
+		return errorz.Conflict("role with this name already exists", err)
+	}
+
+	// ErrRoleNameReserved is returned for names of legacy access levels, roles with them are created by migration.
+	ErrRoleNameReserved = errorz.Conflict("role name is reserved", nil)
+
 	ErrAccountAlreadyHasEmail = errorz.Conflict("account already has linked email", nil)
 
 	ErrEmailConflict = errorz.Conflict("someone account already has this email", nil)
+
+	ErrAccountHasNoEmail = errorz.Conflict("account has no linked email", nil)
+
+	ErrSameEmail = errorz.Conflict("account already has this email", nil)
+
+	ErrEmailChangedConcurrently = errorz.Conflict("account email has been changed", nil)
+
+	// ErrUsernameTaken is also returned for usernames released by other users within the grace period.
+	ErrUsernameTaken = errorz.Conflict("username is taken", nil)
+
+	ErrUsernameChangedConcurrently = errorz.Conflict("account username has been changed", nil)
+
+	ErrPasswordChangedConcurrently = errorz.Conflict("account password has been changed", nil)
+
+	ErrAccountDeletionAlreadyScheduled = errorz.Conflict("account deletion is already scheduled", nil)
+
+	ErrAccountDeletionNotScheduled = errorz.Conflict("account deletion is not scheduled", nil)
+
+	ErrTwoFactorAlreadyEnabled = errorz.Conflict("two-factor authentication is already enabled", nil)
+
+	ErrTwoFactorNotEnabled = errorz.Conflict("two-factor authentication is not enabled", nil)
+
+	ErrTwoFactorEnrollmentNotStarted = errorz.Conflict("two-factor enrollment is not started or expired", nil)
+
+	ErrHardwareIDNotBound = errorz.Conflict("account has no bound hardware id", nil)
+
+	ErrHardwareIDResetAlreadyRequested = errorz.Conflict("hardware id reset is already requested", nil)
+
+	ErrHardwareIDResetRequestResolved = errorz.Conflict("hardware id reset request is already resolved", nil)
+
+	WrapHardwareIDAlreadyBanned = func(err error) error {
+		return errorz.Conflict("hardware id is already banned", err)
+	}
+
+	ErrUserSanctionsChangedConcurrently = errorz.Conflict("user sanctions have been changed", nil)
+
+	ErrSanctionNotActive = errorz.Conflict("sanction is not active", nil)
+
+	ErrSanctionRevoked = errorz.Conflict("sanction is already revoked", nil)
+
+	ErrSanctionNotLatest = errorz.Conflict("sanction is not the latest of its type", nil)
+
+	ErrSanctionAlreadyAppealed = errorz.Conflict("sanction is already appealed", nil)
+
+	ErrSanctionAppealNotPending = errorz.Conflict("sanction has no pending appeal", nil)
+
+	WrapExternalIdentityAlreadyLinked = func(err error) error {
+		return errorz.Conflict("identity is already linked to an account", err)
+	}
+
+	ErrProviderAlreadyLinked = errorz.Conflict("account already has linked identity of this provider", nil)
+
+	ErrAPITokenLimitReached = errorz.Conflict("api token limit is reached, revoke unused tokens", nil)
+
+	ErrNoOtherRecoveryMethod = errorz.Conflict("account has no recovery method except email", nil)
+
+	ErrGameItemArchived = errorz.Conflict("game item is archived", nil)
+
+	WrapSeasonAlreadyExists = func(err error) error {
+		return errorz.Conflict("season already exists", err)
+	}
+
+	ErrSeasonOverlaps = errorz.Conflict("season overlaps with another season", nil)
+
+	ErrSeasonPassAlreadyPremium = errorz.Conflict("season pass is already premium", nil)
+
+	ErrInsufficientCoins = errorz.Conflict("insufficient coins", nil)
+
+	ErrDismantleCurrentItem = errorz.Conflict("item showcased in profile can't be dismantled", nil)
 )
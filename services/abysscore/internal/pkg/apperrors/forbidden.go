@@ -2,6 +2,8 @@ package apperrors
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/intezya/abyssleague/services/abysscore/pkg/errorz"
 )
@@ -27,9 +29,29 @@ var (
 		return errorz.Forbidden("hardware id is banned", reasonAsError)
 	}
 
-	ForbiddenByInsufficientAccessLevel = errorz.Forbidden("insufficient access level", nil)
+	ErrLoginLocked = func(until time.Time) error {
+		//nolint:err113 // required dynamic error
+		return errorz.Forbidden(
+			"account is temporarily locked after too many failed logins",
+			fmt.Errorf("try again after %s", until.UTC().Format(time.RFC3339)),
+		)
+	}
+
+	ForbiddenByInsufficientPermissions = errorz.Forbidden("insufficient permissions", nil)
+
+	ErrGrantingMissingPermissions = errorz.Forbidden("permissions you don't have can't be granted", nil)
+
+	ErrSystemRoleImmutable = errorz.Forbidden("system role can't be changed", nil)
 
 	WrapUserMatchStateError = func(err error) error {
 		return errorz.Forbidden("account is locked", err)
 	}
+
+	ErrAPITokenNotAllowed = errorz.Forbidden("api token can't be used for this request", nil)
+
+	ErrAPITokenScopeMissing = errorz.Forbidden("api token has no required scope", nil)
+
+	ErrSeasonTierNotReached = errorz.Forbidden("season tier is not reached", nil)
+
+	ErrSeasonPremiumTrackLocked = errorz.Forbidden("premium track is locked", nil)
 )
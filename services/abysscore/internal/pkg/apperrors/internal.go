@@ -1,9 +1,32 @@
 package apperrors
 
 import (
+	"errors"
+	"net/http"
+
 	"github.com/intezya/abyssleague/services/abysscore/pkg/errorz"
 )
 
 var WrapUnexpectedError = func(err error) error {
 	return errorz.InternalError(err)
 }
+
+// IsUnexpected reports whether err is caused by the server side, not by the request itself.
+func IsUnexpected(err error) bool {
+	var typed *errorz.Error
+	if !errors.As(err, &typed) {
+		return true
+	}
+
+	return typed.StatusCode >= http.StatusInternalServerError
+}
+
+// IsNotFound reports whether err means that the requested resource does not exist.
+func IsNotFound(err error) bool {
+	var typed *errorz.Error
+	if !errors.As(err, &typed) {
+		return false
+	}
+
+	return typed.StatusCode == http.StatusNotFound
+}
@@ -20,4 +20,48 @@ var (
 	WrapMailDataNotFound = func(err error) error {
 		return errorz.NotFound("mail data", err)
 	}
+
+	WrapSessionNotFound = func(err error) error {
+		return errorz.NotFound("session", err)
+	}
+
+	WrapHardwareIDResetRequestNotFound = func(err error) error {
+		return errorz.NotFound("hardware id reset request", err)
+	}
+
+	WrapBannedHardwareIDNotFound = func(err error) error {
+		return errorz.NotFound("banned hardware id", err)
+	}
+
+	WrapSanctionNotFound = func(err error) error {
+		return errorz.NotFound("sanction", err)
+	}
+
+	WrapRoleNotFound = func(err error) error {
+		return errorz.NotFound("role", err)
+	}
+
+	WrapCollectionNotFound = func(err error) error {
+		return errorz.NotFound("collection", err)
+	}
+
+	WrapGrantJobNotFound = func(err error) error {
+		return errorz.NotFound("grant job", err)
+	}
+
+	WrapSeasonNotFound = func(err error) error {
+		return errorz.NotFound("season", err)
+	}
+
+	WrapSeasonTierNotFound = func(err error) error {
+		return errorz.NotFound("season tier", err)
+	}
+
+	WrapAPITokenNotFound = func(err error) error {
+		return errorz.NotFound("api token", err)
+	}
+
+	WrapExternalIdentityNotFound = func(err error) error {
+		return errorz.NotFound("external identity", err)
+	}
 )
@@ -2,14 +2,40 @@ package apperrors
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/intezya/abyssleague/services/abysscore/pkg/errorz"
 )
 
-var errTooManyEmailLinkRequests = errors.New("too many email link requests")
+var (
+	errTooManyEmailLinkRequests   = errors.New("too many email link requests")
+	errTooManyEmailChangeRequests = errors.New("too many email change requests")
+	errEmailChangeCooldown        = errors.New("email has been changed recently")
+	errTooManyTwoFactorAttempts   = errors.New("too many wrong two-factor codes, try again later")
+)
 
 var (
-	TooManyEmailLinkRequests = errorz.TooManyRequests(errTooManyEmailLinkRequests)
+	TooManyEmailLinkRequests   = errorz.TooManyRequests(errTooManyEmailLinkRequests)
+	TooManyEmailChangeRequests = errorz.TooManyRequests(errTooManyEmailChangeRequests)
+	ErrEmailChangeCooldown     = errorz.TooManyRequests(errEmailChangeCooldown)
+	TooManyTwoFactorAttempts   = errorz.TooManyRequests(errTooManyTwoFactorAttempts)
 
 	TooManyRequests = errorz.TooManyRequests(nil)
+
+	ErrUsernameChangeCooldown = func(availableAt time.Time) error {
+		//nolint:err113 // required dynamic error
+		return errorz.TooManyRequests(fmt.Errorf(
+			"username has been changed recently, next change is available after %s",
+			availableAt.UTC().Format(time.RFC3339),
+		))
+	}
+
+	TooManyLoginFailures = func(retryAfter time.Duration) error {
+		//nolint:err113 // required dynamic error
+		return errorz.TooManyRequests(fmt.Errorf(
+			"too many failed logins, try again in %s",
+			retryAfter.Round(time.Second),
+		))
+	}
 )
@@ -11,6 +11,13 @@ var (
 	errUserWrongHardwareID      = errors.New("wrong hardware id")
 	errTokenHardwareIDIsInvalid = errors.New("wrong token hardware id")
 	errHardwareIDIsInvalid      = errors.New("hardware id is invalid. contact the support")
+	errInvalidRefreshToken      = errors.New("invalid refresh token")
+	errRefreshTokenReused       = errors.New("refresh token reuse detected, all sessions of the token are revoked")
+	errTokenRevoked             = errors.New("token has been revoked")
+	errTwoFactorRequired        = errors.New("two-factor authentication code required")
+	errWrongTwoFactorCode       = errors.New("wrong two-factor authentication code")
+	errOIDCAuthorizationFailed  = errors.New("identity provider authorization failed")
+	errInvalidAPIToken          = errors.New("api token is invalid, expired or revoked")
 )
 
 var (
@@ -18,6 +25,13 @@ var (
 	ErrUserWrongHardwareID      = errorz.Unauthorized(errUserWrongHardwareID)
 	ErrTokenHardwareIDIsInvalid = errorz.Unauthorized(errTokenHardwareIDIsInvalid)
 	ErrHardwareIDIsInvalid      = errorz.Unauthorized(errHardwareIDIsInvalid)
+	ErrInvalidRefreshToken      = errorz.Unauthorized(errInvalidRefreshToken)
+	ErrRefreshTokenReused       = errorz.Unauthorized(errRefreshTokenReused)
+	ErrTokenRevoked             = errorz.Unauthorized(errTokenRevoked)
+	ErrTwoFactorRequired        = errorz.Unauthorized(errTwoFactorRequired)
+	ErrWrongTwoFactorCode       = errorz.Unauthorized(errWrongTwoFactorCode)
+	ErrOIDCAuthorizationFailed  = errorz.Unauthorized(errOIDCAuthorizationFailed)
+	ErrInvalidAPIToken          = errorz.Unauthorized(errInvalidAPIToken)
 
 	WrapUnauthorized = func(err error) *errorz.Error {
 		var typed *errorz.Error
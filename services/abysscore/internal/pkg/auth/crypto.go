@@ -14,22 +14,44 @@ import (
 	"github.com/intezya/pkglib/generate"
 )
 
-var errInvalidEncodeFormat = errors.New("invalid encoded format")
+var (
+	errInvalidEncodeFormat = errors.New("invalid encoded format")
+	errDecryptionFailed    = errors.New("value can't be decrypted with any known key")
+)
+
+// encodedPartsLegacy is "nonce:ciphertext" format used before key ids were added,
+// such values are decrypted by trying every key.
+const (
+	encodedParts       = 3
+	encodedPartsLegacy = 2
+)
 
 type HashHelper struct {
-	block cipher.Block
+	currentKeyID string
+	blocks       map[string]cipher.Block
+	// keyIDs is the order keys are tried in for legacy values, the current key goes first
+	keyIDs []string
 }
 
-func NewHashHelper(hardwareIDEncryptionKey string) *HashHelper {
-	key := sha256.Sum256([]byte(hardwareIDEncryptionKey))
+func NewHashHelper(encryptionKeys *Keyring) *HashHelper {
+	blocks := make(map[string]cipher.Block, len(encryptionKeys.IDs()))
 
-	block, err := aes.NewCipher(key[:])
-	if err != nil {
-		panic(err) // unreachable
+	for _, id := range encryptionKeys.IDs() {
+		secret, _ := encryptionKeys.Secret(id)
+		key := sha256.Sum256([]byte(secret))
+
+		block, err := aes.NewCipher(key[:])
+		if err != nil {
+			panic(err) // unreachable
+		}
+
+		blocks[id] = block
 	}
 
 	return &HashHelper{
-		block: block,
+		currentKeyID: encryptionKeys.CurrentID(),
+		blocks:       blocks,
+		keyIDs:       encryptionKeys.IDs(),
 	}
 }
 
@@ -49,9 +71,52 @@ func (h *HashHelper) VerifyPassword(raw, hash string) bool {
 }
 
 func (h *HashHelper) EncodeHardwareID(raw string) string {
+	return h.encrypt(raw)
+}
+
+func (h *HashHelper) DecodeHardwareID(encoded string) (string, error) {
+	return h.decrypt(encoded)
+}
+
+func (h *HashHelper) VerifyHardwareID(raw, encoded string) bool {
+	decoded, err := h.DecodeHardwareID(encoded)
+	if err != nil {
+		return false
+	}
+
+	return decoded == raw
+}
+
+func (h *HashHelper) EncodeTOTPSecret(raw string) string {
+	return h.encrypt(raw)
+}
+
+func (h *HashHelper) DecodeTOTPSecret(encoded string) (string, error) {
+	return h.decrypt(encoded)
+}
+
+// HashToken hashes high-entropy random tokens, so unlike passwords they don't need a slow hash.
+func (h *HashHelper) HashToken(raw string) string {
+	return h.preHash(raw)
+}
+
+func (h *HashHelper) preHash(raw string) string {
+	shaSum := sha256.Sum256([]byte(raw))
+
+	return hex.EncodeToString(shaSum[:])
+}
+
+// CurrentEncryptionKeyID returns id of the key new values are encrypted with,
+// encoded values start with the key id followed by ":".
+func (h *HashHelper) CurrentEncryptionKeyID() string {
+	return h.currentKeyID
+}
+
+// encrypt seals raw with AES-GCM and returns it in "kid:nonce:ciphertext" format.
+func (h *HashHelper) encrypt(raw string) string {
 	salt := generate.RandomBytes(12) //nolint:mnd
 
-	aesgcm, err := cipher.NewGCM(h.block)
+	aesgcm, err := cipher.NewGCM(h.blocks[h.currentKeyID])
 	if err != nil {
 		panic(err)
 	}
@@ -59,33 +124,58 @@ func (h *HashHelper) EncodeHardwareID(raw string) string {
 	ciphertext := aesgcm.Seal(nil, salt, []byte(raw), nil)
 
 	return fmt.Sprintf(
-		"%s:%s",
+		"%s:%s:%s",
+		h.currentKeyID,
 		base64.StdEncoding.EncodeToString(salt),
 		base64.StdEncoding.EncodeToString(ciphertext),
 	)
 }
 
-func (h *HashHelper) DecodeHardwareID(encoded string) (string, error) {
+func (h *HashHelper) decrypt(encoded string) (string, error) {
 	parts := strings.Split(encoded, ":")
-	if len(parts) != 2 { //nolint:mnd
+
+	switch len(parts) {
+	case encodedParts:
+		block, ok := h.blocks[parts[0]]
+		if !ok {
+			return "", fmt.Errorf("%w: %q", errUnknownKeyID, parts[0])
+		}
+
+		return h.open(block, parts[1], parts[2])
+	case encodedPartsLegacy:
+		for _, id := range h.keyIDs {
+			plaintext, err := h.open(h.blocks[id], parts[0], parts[1])
+			if err == nil {
+				return plaintext, nil
+			}
+		}
+
+		return "", errDecryptionFailed
+	default:
 		return "", errInvalidEncodeFormat
 	}
+}
 
-	nonce, err := base64.StdEncoding.DecodeString(parts[0])
+func (h *HashHelper) open(block cipher.Block, encodedNonce, encodedCiphertext string) (string, error) {
+	nonce, err := base64.StdEncoding.DecodeString(encodedNonce)
 	if err != nil {
 		return "", err
 	}
 
-	ciphertext, err := base64.StdEncoding.DecodeString(parts[1])
+	ciphertext, err := base64.StdEncoding.DecodeString(encodedCiphertext)
 	if err != nil {
 		return "", err
 	}
 
-	aesgcm, err := cipher.NewGCM(h.block)
+	aesgcm, err := cipher.NewGCM(block)
 	if err != nil {
 		return "", err
 	}
 
+	if len(nonce) != aesgcm.NonceSize() {
+		return "", errInvalidEncodeFormat
+	}
+
 	plaintext, err := aesgcm.Open(nil, nonce, ciphertext, nil)
 	if err != nil {
 		return "", err
@@ -93,18 +183,3 @@ func (h *HashHelper) DecodeHardwareID(encoded string) (string, error) {
 
 	return string(plaintext), nil
 }
-
-func (h *HashHelper) VerifyHardwareID(raw, encoded string) bool {
-	decoded, err := h.DecodeHardwareID(encoded)
-	if err != nil {
-		return false
-	}
-
-	return decoded == raw
-}
-
-func (h *HashHelper) preHash(raw string) string {
-	shaSum := sha256.Sum256([]byte(raw))
-
-	return hex.EncodeToString(shaSum[:])
-}
@@ -1,31 +1,48 @@
 package auth
 
 import (
+	"encoding/base64"
 	"errors"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
-	"github.com/google/uuid"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
 	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity"
+	"github.com/intezya/pkglib/generate"
 )
 
-var ErrInvalidToken = errors.New("invalid token")
+var (
+	ErrInvalidToken = errors.New("invalid token")
+
+	errKeyIDRequired         = errors.New("token has no key id")
+	errSigningMethodMismatch = errors.New("token signing method does not match the key")
+)
+
+const (
+	refreshTokenLength = 32
+	keyIDHeader        = "kid"
+)
 
 type JWTConfiguration struct {
-	secretKey      []byte
-	issuer         string
-	expirationTime time.Duration
+	signingKeys           *SigningKeys
+	issuer                string
+	expirationTime        time.Duration
+	refreshExpirationTime time.Duration
 }
 
+// NewJWTConfiguration takes private signing keys, tokens are signed with the current key
+// and its id is put to "kid" header. Tokens signed with other keys stay valid until they expire.
 func NewJWTConfiguration(
-	secretKey string,
+	signingKeys *SigningKeys,
 	issuer string,
 	expirationTime time.Duration,
+	refreshExpirationTime time.Duration,
 ) *JWTConfiguration {
 	return &JWTConfiguration{
-		secretKey:      []byte(secretKey),
-		issuer:         issuer,
-		expirationTime: expirationTime,
+		signingKeys:           signingKeys,
+		issuer:                issuer,
+		expirationTime:        expirationTime,
+		refreshExpirationTime: refreshExpirationTime,
 	}
 }
 
@@ -52,26 +69,46 @@ func (j *JWTHelper) TokenGenerator(tokenData *entity.TokenData) string {
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(j.expirationTime)),
 			NotBefore: jwt.NewNumericDate(time.Now()),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			ID:        uuid.New().String(),
+			ID:        tokenData.FamilyID, // session id
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	keyID, key := j.signingKeys.current()
 
-	tokenString, _ := token.SignedString(j.secretKey)
+	token := jwt.NewWithClaims(key.method, claims)
+	token.Header[keyIDHeader] = keyID
+
+	tokenString, _ := token.SignedString(key.private)
 
 	return tokenString
 }
 
+// RefreshTokenGenerator creates an opaque refresh token, only its hash should be stored.
+func (j *JWTHelper) RefreshTokenGenerator() string {
+	return base64.RawURLEncoding.EncodeToString(generate.RandomBytes(refreshTokenLength))
+}
+
+func (j *JWTHelper) AccessTokenLifetime() time.Duration {
+	return j.expirationTime
+}
+
+func (j *JWTHelper) RefreshTokenLifetime() time.Duration {
+	return j.refreshExpirationTime
+}
+
+// PublicKeys returns the key set other services verify tokens with.
+func (j *JWTHelper) PublicKeys() *dto.JSONWebKeySetDTO {
+	return j.signingKeys.JWKS()
+}
+
 func (j *JWTHelper) ValidateToken(tokenString string) (*entity.TokenData, error) {
 	claims := &Claim{} //nolint:exhaustruct
 
 	token, err := jwt.ParseWithClaims(
 		tokenString,
 		claims,
-		func(token *jwt.Token) (interface{}, error) {
-			return j.secretKey, nil
-		},
+		j.verificationKey,
+		jwt.WithValidMethods([]string{jwt.SigningMethodEdDSA.Alg(), jwt.SigningMethodRS256.Alg()}),
 		jwt.WithIssuer(j.issuer),
 		jwt.WithStrictDecoding(),
 	)
@@ -80,9 +117,32 @@ func (j *JWTHelper) ValidateToken(tokenString string) (*entity.TokenData, error)
 	}
 
 	claims, ok := token.Claims.(*Claim)
-	if !ok || !token.Valid {
+	if !ok || !token.Valid || claims.AuthenticationData == nil {
 		return nil, ErrInvalidToken
 	}
 
+	if claims.IssuedAt != nil {
+		claims.AuthenticationData.IssuedAt = claims.IssuedAt.Time
+	}
+
 	return claims.AuthenticationData, nil
 }
+
+// verificationKey picks the public key by "kid" header, the key must match the token algorithm.
+func (j *JWTHelper) verificationKey(token *jwt.Token) (interface{}, error) {
+	keyID, ok := token.Header[keyIDHeader].(string)
+	if !ok {
+		return nil, errKeyIDRequired
+	}
+
+	key, public, err := j.signingKeys.publicKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, errSigningMethodMismatch
+	}
+
+	return public, nil
+}
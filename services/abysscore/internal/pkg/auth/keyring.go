@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	errKeyringEmpty    = errors.New("keyring must have at least one key")
+	errInvalidKeyID    = errors.New("key id must consist of latin letters, digits, '-' and '_'")
+	errInvalidKeyEntry = errors.New("key must be in \"id=secret\" format")
+	errDuplicateKeyID  = errors.New("duplicate key id")
+	errUnknownKeyID    = errors.New("unknown key id")
+
+	errKeySecretRequired = errors.New("key secret must not be empty")
+)
+
+// Keyring holds versioned secrets. The current key is used for new data, the other keys are kept
+// to read data created before rotation until it is migrated or expires.
+type Keyring struct {
+	currentID string
+	ids       []string
+	secrets   map[string]string
+}
+
+// NewKeyring creates keyring with a single key, it is used for configuration without key ids.
+func NewKeyring(id, secret string) *Keyring {
+	return &Keyring{
+		currentID: id,
+		ids:       []string{id},
+		secrets:   map[string]string{id: secret},
+	}
+}
+
+// ParseKeyring parses comma-separated "id=secret" pairs, the first key is current.
+func ParseKeyring(spec string) (*Keyring, error) {
+	keyring := &Keyring{secrets: make(map[string]string)}
+
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		id, secret, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, errInvalidKeyEntry
+		}
+
+		if !isValidKeyID(id) {
+			return nil, fmt.Errorf("%w: %q", errInvalidKeyID, id)
+		}
+
+		if secret == "" {
+			return nil, fmt.Errorf("%w: %q", errKeySecretRequired, id)
+		}
+
+		if _, exists := keyring.secrets[id]; exists {
+			return nil, fmt.Errorf("%w: %q", errDuplicateKeyID, id)
+		}
+
+		keyring.ids = append(keyring.ids, id)
+		keyring.secrets[id] = secret
+	}
+
+	if len(keyring.ids) == 0 {
+		return nil, errKeyringEmpty
+	}
+
+	keyring.currentID = keyring.ids[0]
+
+	return keyring, nil
+}
+
+func (k *Keyring) CurrentID() string {
+	return k.currentID
+}
+
+// IDs returns key ids, the current one goes first.
+func (k *Keyring) IDs() []string {
+	return k.ids
+}
+
+func (k *Keyring) Secret(id string) (string, error) {
+	secret, ok := k.secrets[id]
+	if !ok {
+		return "", fmt.Errorf("%w: %q", errUnknownKeyID, id)
+	}
+
+	return secret, nil
+}
+
+// isValidKeyID keeps ids free of separators used in encoded values.
+func isValidKeyID(id string) bool {
+	if id == "" {
+		return false
+	}
+
+	for _, r := range id {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_') {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,141 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/dto"
+)
+
+const minRSAKeyBits = 2048
+
+var (
+	errUnsupportedSigningKey = errors.New("signing key must be Ed25519 or RSA private key in PKCS #8")
+	errWeakRSAKey            = errors.New("RSA signing key must be at least 2048 bits")
+)
+
+type signingKey struct {
+	method  jwt.SigningMethod
+	private crypto.Signer
+}
+
+// SigningKeys holds private keys for JWT signing, the current key signs new tokens and the others
+// are kept until tokens signed with them expire. Only public parts leave the service via JWKS.
+type SigningKeys struct {
+	currentID string
+	ids       []string
+	keys      map[string]*signingKey
+}
+
+// NewSigningKeys parses keyring secrets as base64 encoded PKCS #8 private keys, Ed25519 keys
+// sign with EdDSA and RSA keys with RS256.
+func NewSigningKeys(keyring *Keyring) (*SigningKeys, error) {
+	signingKeys := &SigningKeys{
+		currentID: keyring.CurrentID(),
+		ids:       keyring.IDs(),
+		keys:      make(map[string]*signingKey, len(keyring.IDs())),
+	}
+
+	for _, id := range keyring.IDs() {
+		secret, _ := keyring.Secret(id)
+
+		key, err := parseSigningKey(secret)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", id, err)
+		}
+
+		signingKeys.keys[id] = key
+	}
+
+	return signingKeys, nil
+}
+
+// GenerateSigningKeys creates a single Ed25519 key, tokens signed with it are invalidated on restart.
+func GenerateSigningKeys(id string) (*SigningKeys, error) {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SigningKeys{
+		currentID: id,
+		ids:       []string{id},
+		keys:      map[string]*signingKey{id: {method: jwt.SigningMethodEdDSA, private: private}},
+	}, nil
+}
+
+func (k *SigningKeys) current() (string, *signingKey) {
+	return k.currentID, k.keys[k.currentID]
+}
+
+func (k *SigningKeys) publicKey(id string) (*signingKey, crypto.PublicKey, error) {
+	key, ok := k.keys[id]
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: %q", errUnknownKeyID, id)
+	}
+
+	return key, key.private.Public(), nil
+}
+
+// JWKS returns public keys in JSON Web Key Set format, the current key goes first.
+func (k *SigningKeys) JWKS() *dto.JSONWebKeySetDTO {
+	keys := make([]*dto.JSONWebKeyDTO, 0, len(k.ids))
+
+	for _, id := range k.ids {
+		key := k.keys[id]
+
+		jwk := &dto.JSONWebKeyDTO{
+			KeyID:     id,
+			Algorithm: key.method.Alg(),
+			Use:       "sig",
+		}
+
+		switch public := key.private.Public().(type) {
+		case ed25519.PublicKey:
+			jwk.KeyType = "OKP"
+			jwk.Curve = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(public)
+		case *rsa.PublicKey:
+			jwk.KeyType = "RSA"
+			jwk.Modulus = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+			jwk.Exponent = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+		}
+
+		keys = append(keys, jwk)
+	}
+
+	return &dto.JSONWebKeySetDTO{Keys: keys}
+}
+
+func parseSigningKey(encoded string) (*signingKey, error) {
+	der, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	private, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, err
+	}
+
+	switch private := private.(type) {
+	case ed25519.PrivateKey:
+		return &signingKey{method: jwt.SigningMethodEdDSA, private: private}, nil
+	case *rsa.PrivateKey:
+		if private.N.BitLen() < minRSAKeyBits {
+			return nil, errWeakRSAKey
+		}
+
+		return &signingKey{method: jwt.SigningMethodRS256, private: private}, nil
+	default:
+		return nil, errUnsupportedSigningKey
+	}
+}
@@ -0,0 +1,38 @@
+package queryparser
+
+import (
+	"fmt"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/auditlogentity"
+)
+
+func ParseAuditLogFilter(query QueryGetter) (*auditlogentity.Filter, error) {
+	actorID, err := ParseOptionalInt(query("actor_id"))
+	if err != nil {
+		return nil, fmt.Errorf("actor_id: %w", err)
+	}
+
+	createdAfter, err := ParseOptionalTime(query("created_after"))
+	if err != nil {
+		return nil, fmt.Errorf("created_after: %w", err)
+	}
+
+	createdBefore, err := ParseOptionalTime(query("created_before"))
+	if err != nil {
+		return nil, fmt.Errorf("created_before: %w", err)
+	}
+
+	if createdAfter != nil && createdBefore != nil && createdAfter.After(*createdBefore) {
+		return nil, fmt.Errorf("created_after, created_before: %w", errInvalidRange)
+	}
+
+	return &auditlogentity.Filter{
+		ActorID:       actorID,
+		Action:        ParseOptionalString(query("action")),
+		TargetType:    ParseOptionalString(query("target_type")),
+		TargetID:      ParseOptionalString(query("target_id")),
+		RequestID:     ParseOptionalString(query("request_id")),
+		CreatedAfter:  createdAfter,
+		CreatedBefore: createdBefore,
+	}, nil
+}
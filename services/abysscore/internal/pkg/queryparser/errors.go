@@ -7,4 +7,7 @@ import (
 var (
 	errOrderByParseError   = errors.New("invalid value for OrderBy")
 	errOrderTypeParseError = errors.New("invalid value for OrderType")
+	errIntParseError       = errors.New("invalid integer value")
+	errTimeParseError      = errors.New("invalid time value, expected RFC 3339")
+	errInvalidRange        = errors.New("range start is greater than range end")
 )
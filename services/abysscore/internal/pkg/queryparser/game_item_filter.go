@@ -0,0 +1,57 @@
+package queryparser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/gameitementity"
+)
+
+// QueryGetter returns raw query value by key, empty string if absent.
+type QueryGetter func(key string) string
+
+func ParseGameItemFilter(query QueryGetter) (*gameitementity.Filter, error) {
+	itemType, err := ParseOptionalInt(query("type"))
+	if err != nil {
+		return nil, fmt.Errorf("type: %w", err)
+	}
+
+	rarityMin, err := ParseOptionalInt(query("rarity_min"))
+	if err != nil {
+		return nil, fmt.Errorf("rarity_min: %w", err)
+	}
+
+	rarityMax, err := ParseOptionalInt(query("rarity_max"))
+	if err != nil {
+		return nil, fmt.Errorf("rarity_max: %w", err)
+	}
+
+	if rarityMin != nil && rarityMax != nil && *rarityMin > *rarityMax {
+		return nil, fmt.Errorf("rarity_min, rarity_max: %w", errInvalidRange)
+	}
+
+	createdAfter, err := ParseOptionalTime(query("created_after"))
+	if err != nil {
+		return nil, fmt.Errorf("created_after: %w", err)
+	}
+
+	createdBefore, err := ParseOptionalTime(query("created_before"))
+	if err != nil {
+		return nil, fmt.Errorf("created_before: %w", err)
+	}
+
+	if createdAfter != nil && createdBefore != nil && createdAfter.After(*createdBefore) {
+		return nil, fmt.Errorf("created_after, created_before: %w", errInvalidRange)
+	}
+
+	return &gameitementity.Filter{
+		NameContains:  ParseOptionalString(strings.TrimSpace(query("name"))),
+		Collections:   ParseStringList(query("collection")),
+		Type:          itemType,
+		RarityMin:     rarityMin,
+		RarityMax:     rarityMax,
+		CreatedAfter:  createdAfter,
+		CreatedBefore: createdBefore,
+		Search:        ParseOptionalString(strings.TrimSpace(query("q"))),
+	}, nil
+}
@@ -0,0 +1,18 @@
+package queryparser
+
+import (
+	"github.com/intezya/abyssleague/services/abysscore/internal/domain/entity/inventoryitementity"
+)
+
+func ParseInventoryItemOrderBy(s string) (inventoryitementity.OrderBy, error) {
+	switch s {
+	case "", "obtained_at":
+		return inventoryitementity.OrderByObtainedAt, nil
+	case "rarity":
+		return inventoryitementity.OrderByRarity, nil
+	case "name":
+		return inventoryitementity.OrderByName, nil
+	default:
+		return "", errOrderByParseError
+	}
+}
@@ -0,0 +1,62 @@
+package queryparser
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseOptionalString returns nil for an empty query value.
+func ParseOptionalString(input string) *string {
+	if input == "" {
+		return nil
+	}
+
+	return &input
+}
+
+// ParseStringList splits comma separated query value, skipping empty elements.
+func ParseStringList(input string) []string {
+	if input == "" {
+		return nil
+	}
+
+	var result []string
+
+	for _, element := range strings.Split(input, ",") {
+		element = strings.TrimSpace(element)
+		if element != "" {
+			result = append(result, element)
+		}
+	}
+
+	return result
+}
+
+// ParseOptionalInt returns nil for an empty query value.
+func ParseOptionalInt(input string) (*int, error) {
+	if input == "" {
+		return nil, nil //nolint:nilnil // absent value is not an error
+	}
+
+	value, err := strconv.Atoi(input)
+	if err != nil {
+		return nil, errIntParseError
+	}
+
+	return &value, nil
+}
+
+// ParseOptionalTime parses an RFC 3339 timestamp and returns nil for an empty query value.
+func ParseOptionalTime(input string) (*time.Time, error) {
+	if input == "" {
+		return nil, nil //nolint:nilnil // absent value is not an error
+	}
+
+	value, err := time.Parse(time.RFC3339, input)
+	if err != nil {
+		return nil, errTimeParseError
+	}
+
+	return &value, nil
+}
@@ -0,0 +1,27 @@
+package requestmeta
+
+import (
+	"context"
+)
+
+type ctxKey struct{}
+
+// Metadata describes the HTTP request which caused the action.
+type Metadata struct {
+	RequestID string
+	IP        string
+}
+
+func WithMetadata(ctx context.Context, metadata *Metadata) context.Context {
+	return context.WithValue(ctx, ctxKey{}, metadata)
+}
+
+// FromContext returns request metadata, empty one if the action isn't caused by HTTP request.
+func FromContext(ctx context.Context) *Metadata {
+	metadata, ok := ctx.Value(ctxKey{}).(*Metadata)
+	if !ok {
+		return &Metadata{}
+	}
+
+	return metadata
+}
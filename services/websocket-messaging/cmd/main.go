@@ -14,9 +14,11 @@ import (
 	"github.com/intezya/abyssleague/services/websocket-messaging/internal/adapters/controller/grpcapi"
 	"github.com/intezya/abyssleague/services/websocket-messaging/internal/adapters/controller/websocket"
 	"github.com/intezya/abyssleague/services/websocket-messaging/internal/infrastructure/hub"
+	"github.com/intezya/abyssleague/services/websocket-messaging/internal/infrastructure/revocation"
 	"github.com/intezya/abyssleague/services/websocket-messaging/internal/infrastructure/service"
 	"github.com/intezya/abyssleague/services/websocket-messaging/internal/pkg/auth"
 	"github.com/intezya/pkglib/logger"
+	"github.com/redis/go-redis/v9"
 )
 
 const gracefulShutdownTimeout = 10 * time.Second
@@ -32,9 +34,16 @@ func main() {
 	appConfig := config.Setup()
 	jwtService := auth.NewJWTHelper(appConfig.JwtConfiguration())
 
+	go jwtService.RunRefreshLoop(ctx)
+
+	redisClient := redis.NewClient(appConfig.RedisOptions())
+	defer closeRedisClient(redisClient)
+
+	revocationChecker := revocation.NewChecker(redisClient)
+
 	httpApp := app.NewHttpApp(appConfig)
 
-	gRPCApps, hubs := setupHubs(ctx, httpApp, jwtService, appConfig)
+	gRPCApps, hubs := setupHubs(ctx, httpApp, jwtService, revocationChecker, appConfig)
 
 	httpErrCh := startHTTPServer(ctx, httpApp)
 
@@ -48,6 +57,7 @@ func setupHubs(
 	ctx context.Context,
 	httpApp *app.HttpApp,
 	jwtService *auth.JWTHelper,
+	revocationChecker *revocation.Checker,
 	appConfig *config.Config,
 ) ([]*app.GRPCApp, []*hub.Hub) {
 	gRPCApps := make([]*app.GRPCApp, 0, len(appConfig.Hubs))
@@ -63,7 +73,7 @@ func setupHubs(
 
 		go newHub.Run()
 
-		websocket.SetupRoute(httpApp.Mux, newHub, hubName, jwtService)
+		websocket.SetupRoute(httpApp.Mux, newHub, hubName, jwtService, revocationChecker)
 
 		websocketService := service.NewWebsocketService(newHub)
 		gRPCApp := app.NewGRPCApp(appConfig.GRPCPorts[idx])
@@ -171,6 +181,12 @@ func shutdownGRPCServers(ctx context.Context, gRPCApps []*app.GRPCApp) {
 	}
 }
 
+func closeRedisClient(client *redis.Client) {
+	if err := client.Close(); err != nil {
+		logger.Log.Errorf("Error closing redis client: %v", err)
+	}
+}
+
 func shutdownHubs(hubs []*hub.Hub) {
 	for _, h := range hubs {
 		logger.Log.Infof("Hub %s stopped", h.GetName())
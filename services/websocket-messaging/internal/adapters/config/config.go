@@ -12,6 +12,7 @@ import (
 	"github.com/intezya/pkglib/configloader"
 	"github.com/intezya/pkglib/itertools"
 	"github.com/intezya/pkglib/logger"
+	"github.com/redis/go-redis/v9"
 )
 
 /*
@@ -19,8 +20,11 @@ import (
 | Variable               | Description                                | Default                                  |
 |------------------------|--------------------------------------------|------------------------------------------|
 | `HTTP_PORT`            | HTTP server port                           | 8090                                     |
-| `JWT_SECRET`           | Secret key for JWT authentication          | Required                                 |
+| `JWKS_URL`             | abysscore public keys for JWT verification | "http://localhost:8080/.well-known/jwks.json" |
 | `JWT_ISSUER`           | Issuer for JWT tokens                      | "issuer"                                 |
+| `REDIS_ADDR`           | Redis address with revoked tokens          | "localhost:6379"                         |
+| `REDIS_PASSWORD`       | Redis password                             | ""                                       |
+| `REDIS_DB`             | Redis database                             | 0                                        |
 | `ENV_TYPE`             | Environment type (dev, prod)               | "dev"                                    |
 | `WEBSOCKET_HUBS`       | Comma-separated list of available hubs     | "main"                                   |
 | `GRPC_SERVER_PORTS`    | Comma-separated list of ports for each hub | 50051                                    |
@@ -30,12 +34,15 @@ import (
 */
 
 const (
-	DefaultGRPCPort      = 50051
-	DefaultHTTPPort      = 8090
-	DefaultJWTIssuer     = "issuer"
-	DefaultJWTExpiration = 24 * time.Hour
-	DefaultEnvType       = "dev"
-	DefaultHub           = "main"
+	DefaultGRPCPort    = 50051
+	DefaultHTTPPort    = 8090
+	DefaultJWTIssuer   = "issuer"
+	DefaultJWKSURL     = "http://localhost:8080/.well-known/jwks.json"
+	DefaultJWKSRefresh = 5 * time.Minute
+	DefaultEnvType     = "dev"
+	DefaultHub         = "main"
+	DefaultRedisAddr   = "localhost:6379"
+	DefaultRedisDB     = 0
 
 	DefaultLokiURL = "http://localhost:3100/loki/api/v1/push"
 )
@@ -44,9 +51,13 @@ type Config struct {
 	GRPCPorts []int
 	HTTPPort  int
 
-	jwtSecret         string
-	jwtIssuer         string
-	jwtExpirationTime time.Duration
+	jwksURL         string
+	jwtIssuer       string
+	jwksRefreshTime time.Duration
+
+	redisAddr     string
+	redisPassword string
+	redisDB       int
 
 	Hubs []string
 
@@ -54,7 +65,15 @@ type Config struct {
 }
 
 func (c Config) JwtConfiguration() *auth.JWTConfiguration {
-	return auth.NewJWTConfiguration(c.jwtSecret, c.jwtIssuer, c.jwtExpirationTime)
+	return auth.NewJWTConfiguration(c.jwksURL, c.jwtIssuer, c.jwksRefreshTime)
+}
+
+func (c Config) RedisOptions() *redis.Options {
+	return &redis.Options{
+		Addr:     c.redisAddr,
+		Password: c.redisPassword,
+		DB:       c.redisDB,
+	}
 }
 
 func (c Config) IsDevMode() bool {
@@ -109,17 +128,20 @@ func Configure() *Config {
 		panic("GRPC_SERVER_PORTS and WEBSOCKET_HUBS must have the same number of elements")
 	}
 
-	jwtSecret := configloader.GetEnvOrPanic("JWT_SECRET")
+	jwksURL := configloader.GetEnvOrFallback("JWKS_URL", DefaultJWKSURL)
 	jwtIssuer := configloader.GetEnvOrFallback("JWT_ISSUER", DefaultJWTIssuer)
-	jwtExpirationTime := DefaultJWTExpiration
 
 	config := &Config{
 		GRPCPorts: grpcPorts,
 		HTTPPort:  configloader.GetEnvIntOrFallback("HTTP_PORT", DefaultHTTPPort),
 
-		jwtSecret:         jwtSecret,
-		jwtIssuer:         jwtIssuer,
-		jwtExpirationTime: jwtExpirationTime,
+		jwksURL:         jwksURL,
+		jwtIssuer:       jwtIssuer,
+		jwksRefreshTime: DefaultJWKSRefresh,
+
+		redisAddr:     configloader.GetEnvOrFallback("REDIS_ADDR", DefaultRedisAddr),
+		redisPassword: configloader.GetEnvOrFallback("REDIS_PASSWORD", ""),
+		redisDB:       configloader.GetEnvIntOrFallback("REDIS_DB", DefaultRedisDB),
 
 		Hubs: websocketHubs,
 
@@ -17,6 +17,7 @@ type WebsocketService interface {
 	GetOnlineUsers(ctx context.Context) ([]*service.OnlineUser, error)
 	SendToUser(ctx context.Context, userID int, jsonPayload []byte) error
 	Broadcast(ctx context.Context, jsonPayload []byte) error
+	DisconnectSessions(ctx context.Context, userID int, sessionIDs []string) error
 }
 
 type WebsocketHandler struct {
@@ -118,6 +119,33 @@ func (h *WebsocketHandler) Broadcast(
 	return nil, nil //nolint:nilnil // nil, nil - noreturn, without error
 }
 
+func (h *WebsocketHandler) DisconnectSessions(
+	ctx context.Context,
+	request *websocketpb.DisconnectSessionsRequest,
+) (
+	*emptypb.Empty,
+	error,
+) {
+	if request.GetUserId() == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "UserId is required")
+	}
+
+	if len(request.GetSessionIds()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "SessionIds are required")
+	}
+
+	err := h.websocketService.DisconnectSessions(
+		ctx,
+		int(request.GetUserId()),
+		request.GetSessionIds(),
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Session not connected")
+	}
+
+	return nil, nil //nolint:nilnil // nil, nil - noreturn, without error
+}
+
 func (h *WebsocketHandler) Setup(gRPCServer *grpc.Server) {
 	websocketpb.RegisterWebsocketServiceServer(gRPCServer, h)
 }
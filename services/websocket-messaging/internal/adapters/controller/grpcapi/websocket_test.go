@@ -24,6 +24,8 @@ type MockWebsocketService struct {
 	GetOnlineUsersFunc func(ctx context.Context) ([]*service.OnlineUser, error)
 	SendToUserFunc     func(ctx context.Context, userID int, jsonPayload []byte) error
 	BroadcastFunc      func(ctx context.Context, jsonPayload []byte) error
+
+	DisconnectSessionsFunc func(ctx context.Context, userID int, sessionIDs []string) error
 }
 
 func (m *MockWebsocketService) GetOnline(ctx context.Context) (int, error) {
@@ -46,6 +48,14 @@ func (m *MockWebsocketService) Broadcast(ctx context.Context, jsonPayload []byte
 	return m.BroadcastFunc(ctx, jsonPayload)
 }
 
+func (m *MockWebsocketService) DisconnectSessions(
+	ctx context.Context,
+	userID int,
+	sessionIDs []string,
+) error {
+	return m.DisconnectSessionsFunc(ctx, userID, sessionIDs)
+}
+
 func TestGetOnline(t *testing.T) {
 	t.Parallel()
 
@@ -448,3 +458,77 @@ func TestBroadcast(t *testing.T) {
 		)
 	}
 }
+
+func TestDisconnectSessions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                   string
+		request                *websocketpb.DisconnectSessionsRequest
+		mockDisconnectSessions func(ctx context.Context, userID int, sessionIDs []string) error
+		expectedCode           codes.Code
+	}{
+		{
+			name: "Success",
+			request: &websocketpb.DisconnectSessionsRequest{
+				UserId:     123,
+				SessionIds: []string{"session"},
+			},
+			mockDisconnectSessions: func(ctx context.Context, userID int, sessionIDs []string) error {
+				return nil
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name: "Missing UserId",
+			request: &websocketpb.DisconnectSessionsRequest{
+				UserId:     0,
+				SessionIds: []string{"session"},
+			},
+			mockDisconnectSessions: func(ctx context.Context, userID int, sessionIDs []string) error {
+				return nil
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "Missing SessionIds",
+			request: &websocketpb.DisconnectSessionsRequest{
+				UserId:     123,
+				SessionIds: nil,
+			},
+			mockDisconnectSessions: func(ctx context.Context, userID int, sessionIDs []string) error {
+				return nil
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "Session Not Connected",
+			request: &websocketpb.DisconnectSessionsRequest{
+				UserId:     123,
+				SessionIds: []string{"session"},
+			},
+			mockDisconnectSessions: func(ctx context.Context, userID int, sessionIDs []string) error {
+				return errUserNotConnected
+			},
+			expectedCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				mockService := &MockWebsocketService{
+					DisconnectSessionsFunc: tt.mockDisconnectSessions,
+				}
+				handler := NewWebsocketHandler(mockService)
+
+				_, err := handler.DisconnectSessions(t.Context(), tt.request)
+				if status.Code(err) != tt.expectedCode {
+					t.Errorf("Expected error code %v, got %v", tt.expectedCode, status.Code(err))
+				}
+			},
+		)
+	}
+}
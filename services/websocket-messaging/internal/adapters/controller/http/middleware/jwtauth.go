@@ -1,20 +1,30 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/intezya/abyssleague/services/websocket-messaging/internal/domain/entity"
 	"github.com/intezya/abyssleague/services/websocket-messaging/internal/pkg/auth"
 	"github.com/intezya/pkglib/logger"
 )
 
+// RevocationChecker looks up refresh token families revoked by abysscore
+// and users whose tokens have been reissued.
+type RevocationChecker interface {
+	IsRevoked(ctx context.Context, familyID string) (bool, error)
+	IsStale(ctx context.Context, userID int, issuedAt time.Time) (bool, error)
+}
+
 type SecurityMiddleware struct {
-	jwtService *auth.JWTHelper
+	jwtService        *auth.JWTHelper
+	revocationChecker RevocationChecker
 }
 
-func NewMiddleware(jwtService *auth.JWTHelper) *SecurityMiddleware {
-	return &SecurityMiddleware{jwtService: jwtService}
+func NewMiddleware(jwtService *auth.JWTHelper, revocationChecker RevocationChecker) *SecurityMiddleware {
+	return &SecurityMiddleware{jwtService: jwtService, revocationChecker: revocationChecker}
 }
 
 func (m *SecurityMiddleware) JwtAuth(
@@ -45,6 +55,17 @@ func (m *SecurityMiddleware) JwtAuth(
 		return authenticationData
 	}
 
+	if tokenData == nil {
+		logger.Log.Debug("malformed token data")
+		http.Error(w, "malformed token data", http.StatusUnauthorized)
+
+		return nil
+	}
+
+	if !m.checkNotRevoked(w, r, tokenData) {
+		return nil
+	}
+
 	authenticationData = entity.DecodeToAuthenticationData(tokenData)
 
 	if authenticationData == nil {
@@ -57,6 +78,52 @@ func (m *SecurityMiddleware) JwtAuth(
 	return authenticationData
 }
 
+// checkNotRevoked fails closed: token is rejected if revocation list is unavailable.
+func (m *SecurityMiddleware) checkNotRevoked(
+	w http.ResponseWriter,
+	r *http.Request,
+	tokenData *auth.TokenData,
+) bool {
+	if tokenData.FamilyID == "" {
+		logger.Log.Debug("token has no family id")
+		http.Error(w, "token is revoked", http.StatusUnauthorized)
+
+		return false
+	}
+
+	revoked, err := m.revocationChecker.IsRevoked(r.Context(), tokenData.FamilyID)
+	if err != nil {
+		logger.Log.Warn("checking token revocation error: ", err)
+		http.Error(w, "token revocation check failed", http.StatusServiceUnavailable)
+
+		return false
+	}
+
+	if revoked {
+		logger.Log.Debug("token is revoked, family id: ", tokenData.FamilyID)
+		http.Error(w, "token is revoked", http.StatusUnauthorized)
+
+		return false
+	}
+
+	stale, err := m.revocationChecker.IsStale(r.Context(), tokenData.ID, tokenData.IssuedAt)
+	if err != nil {
+		logger.Log.Warn("checking token staleness error: ", err)
+		http.Error(w, "token revocation check failed", http.StatusServiceUnavailable)
+
+		return false
+	}
+
+	if stale {
+		logger.Log.Debug("token is issued before user data change, user id: ", tokenData.ID)
+		http.Error(w, "token is outdated", http.StatusUnauthorized)
+
+		return false
+	}
+
+	return true
+}
+
 func softExtractTokenFromHeader(tokenString string, availablePrefixes ...string) string {
 	for _, prefix := range availablePrefixes {
 		if token, found := strings.CutPrefix(tokenString, prefix); found {
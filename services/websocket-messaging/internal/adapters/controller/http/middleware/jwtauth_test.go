@@ -1,6 +1,8 @@
 package middleware
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -8,18 +10,53 @@ import (
 
 	"github.com/intezya/abyssleague/services/websocket-messaging/internal/domain/entity"
 	"github.com/intezya/abyssleague/services/websocket-messaging/internal/pkg/auth"
+	"github.com/intezya/abyssleague/services/websocket-messaging/internal/pkg/auth/authtest"
 	"github.com/intezya/pkglib/logger"
 )
 
+var errRedisUnavailable = errors.New("redis unavailable")
+
+// fakeRevocationChecker treats listed families as revoked and all tokens of stale users as stale.
+type fakeRevocationChecker struct {
+	revoked    map[string]bool
+	staleUsers map[int]bool
+	err        error
+}
+
+func (f *fakeRevocationChecker) IsRevoked(_ context.Context, familyID string) (bool, error) {
+	if f.err != nil {
+		return false, f.err
+	}
+
+	return f.revoked[familyID], nil
+}
+
+func (f *fakeRevocationChecker) IsStale(_ context.Context, userID int, _ time.Time) (bool, error) {
+	if f.err != nil {
+		return false, f.err
+	}
+
+	return f.staleUsers[userID], nil
+}
+
+func newFakeRevocationChecker(revokedFamilies ...string) *fakeRevocationChecker {
+	revoked := make(map[string]bool, len(revokedFamilies))
+	for _, familyID := range revokedFamilies {
+		revoked[familyID] = true
+	}
+
+	return &fakeRevocationChecker{revoked: revoked, staleUsers: nil, err: nil}
+}
+
 func TestNewMiddleware(t *testing.T) {
 	t.Parallel()
 
 	// Create a real JWT helper with test configuration
-	jwtConfig := auth.NewJWTConfiguration("test-secret", "test-issuer", 24*time.Hour)
-	jwtHelper := auth.NewJWTHelper(jwtConfig)
+	issuer := authtest.NewIssuer(t, "test-issuer")
+	jwtHelper := auth.NewJWTHelper(auth.NewJWTConfiguration(issuer.JWKSURL(), "test-issuer", time.Minute))
 
 	// Create the middleware
-	middleware := NewMiddleware(jwtHelper)
+	middleware := NewMiddleware(jwtHelper, newFakeRevocationChecker())
 
 	// Verify that the middleware is created correctly
 	if middleware == nil {
@@ -30,6 +67,10 @@ func TestNewMiddleware(t *testing.T) {
 	if middleware.jwtService == nil {
 		t.Error("Expected non-nil jwtService")
 	}
+
+	if middleware.revocationChecker == nil {
+		t.Error("Expected non-nil revocationChecker")
+	}
 }
 
 func TestJwtAuth(t *testing.T) {
@@ -42,47 +83,101 @@ func TestJwtAuth(t *testing.T) {
 	}
 
 	// Create a real JWT helper with test configuration
-	jwtConfig := auth.NewJWTConfiguration("test-secret", "test-issuer", 24*time.Hour)
-	jwtHelper := auth.NewJWTHelper(jwtConfig)
+	issuer := authtest.NewIssuer(t, "test-issuer")
+	jwtHelper := auth.NewJWTHelper(auth.NewJWTConfiguration(issuer.JWKSURL(), "test-issuer", time.Minute))
 
 	// Create a valid token
 	validTokenData := &auth.TokenData{
 		ID:       123,
 		Username: "testuser",
 		Hwid:     "testhwid",
+		FamilyID: "active-family",
 	}
-	validToken := jwtHelper.TokenGenerator(validTokenData)
+	validToken := issuer.Token(t, validTokenData)
+
+	revokedToken := issuer.Token(t, &auth.TokenData{
+		ID:       123,
+		Username: "testuser",
+		Hwid:     "testhwid",
+		FamilyID: "revoked-family",
+	})
+
+	tokenWithoutFamily := issuer.Token(t, &auth.TokenData{
+		ID:       123,
+		Username: "testuser",
+		Hwid:     "testhwid",
+		FamilyID: "",
+	})
+
+	revocationChecker := newFakeRevocationChecker("revoked-family")
 
 	tests := []struct {
 		name               string
 		authHeader         string
+		revocationChecker  RevocationChecker
 		expectedStatusCode int
 		expectedAuthData   *entity.AuthenticationData
 	}{
 		{
 			name:               "Valid token",
 			authHeader:         "Bearer " + validToken,
+			revocationChecker:  revocationChecker,
 			expectedStatusCode: http.StatusOK,
 			expectedAuthData:   entity.NewAuthenticationData(123, "testuser", "testhwid"),
 		},
 		{
 			name:               "Missing authorization header",
 			authHeader:         "",
+			revocationChecker:  revocationChecker,
 			expectedStatusCode: http.StatusUnauthorized,
 			expectedAuthData:   nil,
 		},
 		{
 			name:               "Invalid token",
 			authHeader:         "Bearer invalid-token",
+			revocationChecker:  revocationChecker,
 			expectedStatusCode: http.StatusUnauthorized,
 			expectedAuthData:   nil,
 		},
 		{
 			name:               "Token with different prefix",
 			authHeader:         "Token " + validToken,
+			revocationChecker:  revocationChecker,
 			expectedStatusCode: http.StatusOK,
 			expectedAuthData:   entity.NewAuthenticationData(123, "testuser", "testhwid"),
 		},
+		{
+			name:               "Revoked token family",
+			authHeader:         "Bearer " + revokedToken,
+			revocationChecker:  revocationChecker,
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedAuthData:   nil,
+		},
+		{
+			name:       "Token issued before username change",
+			authHeader: "Bearer " + validToken,
+			revocationChecker: &fakeRevocationChecker{
+				revoked:    nil,
+				staleUsers: map[int]bool{123: true},
+				err:        nil,
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedAuthData:   nil,
+		},
+		{
+			name:               "Token without family",
+			authHeader:         "Bearer " + tokenWithoutFamily,
+			revocationChecker:  revocationChecker,
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedAuthData:   nil,
+		},
+		{
+			name:               "Revocation list unavailable",
+			authHeader:         "Bearer " + validToken,
+			revocationChecker:  &fakeRevocationChecker{revoked: nil, staleUsers: nil, err: errRedisUnavailable},
+			expectedStatusCode: http.StatusServiceUnavailable,
+			expectedAuthData:   nil,
+		},
 	}
 
 	for _, tt := range tests {
@@ -91,7 +186,7 @@ func TestJwtAuth(t *testing.T) {
 			t.Parallel()
 
 			// Create the middleware
-			middleware := NewMiddleware(jwtHelper)
+			middleware := NewMiddleware(jwtHelper, tt.revocationChecker)
 
 			// Create a test request with context
 			ctx := t.Context()
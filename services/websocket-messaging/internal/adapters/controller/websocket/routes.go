@@ -20,8 +20,9 @@ func SetupRoute(
 	hub *hub.Hub,
 	hubName string,
 	jwtService *auth.JWTHelper,
+	revocationChecker middleware.RevocationChecker,
 ) {
-	authMiddleware := middleware.NewMiddleware(jwtService)
+	authMiddleware := middleware.NewMiddleware(jwtService, revocationChecker)
 
 	upgrader := websocket.Upgrader{
 		ReadBufferSize:    readBufferSize,
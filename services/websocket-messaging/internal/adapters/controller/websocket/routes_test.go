@@ -7,9 +7,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/alicebob/miniredis/v2"
 	"github.com/intezya/abyssleague/services/websocket-messaging/internal/adapters/controller/http/routes"
 	"github.com/intezya/abyssleague/services/websocket-messaging/internal/infrastructure/hub"
+	"github.com/intezya/abyssleague/services/websocket-messaging/internal/infrastructure/revocation"
 	"github.com/intezya/abyssleague/services/websocket-messaging/internal/pkg/auth"
+	"github.com/intezya/abyssleague/services/websocket-messaging/internal/pkg/auth/authtest"
+	"github.com/redis/go-redis/v9"
 )
 
 func TestSetupRoute(t *testing.T) {
@@ -21,11 +25,17 @@ func TestSetupRoute(t *testing.T) {
 	testHub := hub.NewHub("test-hub")
 
 	// Create a test JWT helper
-	jwtConfig := auth.NewJWTConfiguration("test-secret", "test-issuer", 24*time.Hour)
-	jwtHelper := auth.NewJWTHelper(jwtConfig)
+	issuer := authtest.NewIssuer(t, "test-issuer")
+	jwtHelper := auth.NewJWTHelper(auth.NewJWTConfiguration(issuer.JWKSURL(), "test-issuer", time.Minute))
+
+	// Create a test revocation checker backed by in-memory redis
+	redisClient := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+	defer func() {
+		_ = redisClient.Close()
+	}()
 
 	// Call the function being tested
-	SetupRoute(mux, testHub, "test-hub", jwtHelper)
+	SetupRoute(mux, testHub, "test-hub", jwtHelper, revocation.NewChecker(redisClient))
 
 	// Create a test server using the mux
 	server := httptest.NewServer(mux)
@@ -10,6 +10,7 @@ type AuthenticationData struct {
 	id         int
 	username   string
 	hardwareID string
+	sessionID  string
 }
 
 func NewAuthenticationData(id int, username string, hardwareID string) *AuthenticationData {
@@ -32,6 +33,17 @@ func (a *AuthenticationData) HardwareID() string {
 	return a.hardwareID
 }
 
+// SessionID is the abysscore session the connection was authenticated with.
+func (a *AuthenticationData) SessionID() string {
+	return a.sessionID
+}
+
+func (a *AuthenticationData) WithSessionID(sessionID string) *AuthenticationData {
+	a.sessionID = sessionID
+
+	return a
+}
+
 func (a *AuthenticationData) Encode() map[string]string {
 	return map[string]string{
 		"id":         strconv.Itoa(a.id),
@@ -45,5 +57,6 @@ func DecodeToAuthenticationData(data *auth.TokenData) *AuthenticationData {
 		id:         data.ID,
 		username:   data.Username,
 		hardwareID: data.Hwid,
+		sessionID:  data.FamilyID,
 	}
 }
@@ -60,6 +60,7 @@ func TestDecodeToAuthenticationData(t *testing.T) {
 		ID:       123,
 		Username: "testuser",
 		Hwid:     "testhwid",
+		FamilyID: "session-id",
 	}
 
 	authData := DecodeToAuthenticationData(tokenData)
@@ -79,6 +80,20 @@ func TestDecodeToAuthenticationData(t *testing.T) {
 	if authData.HardwareID() != tokenData.Hwid {
 		t.Errorf("Expected HardwareID %s, got %s", tokenData.Hwid, authData.HardwareID())
 	}
+
+	if authData.SessionID() != tokenData.FamilyID {
+		t.Errorf("Expected SessionID %s, got %s", tokenData.FamilyID, authData.SessionID())
+	}
+}
+
+func TestAuthenticationData_WithSessionID(t *testing.T) {
+	t.Parallel()
+
+	authData := NewAuthenticationData(123, "testuser", "testhwid").WithSessionID("session-id")
+
+	if authData.SessionID() != "session-id" {
+		t.Errorf("Expected SessionID %s, got %s", "session-id", authData.SessionID())
+	}
 }
 
 func TestAuthenticationData_Getters(t *testing.T) {
@@ -8,7 +8,10 @@ type message struct {
 	Message string `json:"message"`
 }
 
-var DisconnectByOtherClient []byte
+var (
+	DisconnectByOtherClient []byte
+	DisconnectBySessionEnd  []byte
+)
 
 func init() {
 	var err error
@@ -23,4 +26,15 @@ func init() {
 	if err != nil {
 		panic("failed to marshal disconnect message: " + err.Error())
 	}
+
+	DisconnectBySessionEnd, err = json.Marshal(
+		message{
+			Type:    "disconnect",
+			Subtype: "session_ended",
+			Message: "Your session has been ended",
+		},
+	)
+	if err != nil {
+		panic("failed to marshal disconnect message: " + err.Error())
+	}
 }
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/intezya/abyssleague/services/websocket-messaging/internal/domain/entity"
+	"github.com/intezya/abyssleague/services/websocket-messaging/internal/domain/message"
 	logger2 "github.com/intezya/pkglib/logger"
 )
 
@@ -213,6 +214,53 @@ func TestSendToUser(t *testing.T) {
 	}
 }
 
+func TestDisconnectSessions(t *testing.T) {
+	t.Parallel()
+	// Create a hub
+	hub := NewHub("test-hub")
+
+	// Start the hub
+	go hub.Run()
+	defer hub.Stop()
+
+	// Create a test client authenticated with a session
+	authData := entity.NewAuthenticationData(1, "testuser", "testhwid").WithSessionID("session-1")
+	client := &Client{
+		Hub:            hub,
+		authentication: authData,
+		Send:           make(chan []byte, 256),
+		connectTime:    time.Now(),
+	}
+
+	// Register the client
+	hub.RegisterClient(client)
+
+	// Give the hub time to process the registration
+	time.Sleep(100 * time.Millisecond)
+
+	// Other sessions of the user don't affect the connection
+	if hub.DisconnectSessions(t.Context(), authData.ID(), []string{"session-2"}) {
+		t.Error("Expected DisconnectSessions to return false for another session")
+	}
+
+	if !hub.DisconnectSessions(t.Context(), authData.ID(), []string{"session-2", "session-1"}) {
+		t.Fatal("Expected DisconnectSessions to return true")
+	}
+
+	// Verify that the client received disconnect message and its channel is closed
+	if receivedMessage := <-client.Send; string(receivedMessage) != string(message.DisconnectBySessionEnd) {
+		t.Errorf("Expected disconnect message, got %s", string(receivedMessage))
+	}
+
+	if _, ok := <-client.Send; ok {
+		t.Error("Expected client send channel to be closed")
+	}
+
+	if clients := hub.GetClients(t.Context()); len(clients) != 0 {
+		t.Errorf("Expected 0 clients, got %d", len(clients))
+	}
+}
+
 func TestBroadcast(t *testing.T) {
 	t.Parallel()
 	// Create a hub
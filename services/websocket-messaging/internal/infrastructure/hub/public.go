@@ -2,8 +2,11 @@ package hub
 
 import (
 	"context"
+	"slices"
+	"time"
 
 	"github.com/intezya/abyssleague/services/websocket-messaging/internal/domain/entity"
+	"github.com/intezya/abyssleague/services/websocket-messaging/internal/domain/message"
 	"github.com/intezya/abyssleague/services/websocket-messaging/internal/infrastructure/metrics"
 )
 
@@ -55,6 +58,31 @@ func (hub *Hub) SendToUser(ctx context.Context, userId int, jsonPayload []byte)
 	}
 }
 
+// DisconnectSessions closes the user connection if it was authenticated with one of sessionIDs.
+func (hub *Hub) DisconnectSessions(ctx context.Context, userID int, sessionIDs []string) bool {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	client, exists := hub.clientsByID[userID]
+	if !exists || !slices.Contains(sessionIDs, client.authentication.SessionID()) {
+		return false
+	}
+
+	select {
+	case client.Send <- message.DisconnectBySessionEnd:
+	default:
+	}
+
+	metrics.ConnectionDuration.Observe(time.Since(client.connectTime).Seconds())
+	metrics.ActiveConnections.Dec()
+
+	close(client.Send)
+	delete(hub.clients, client)
+	delete(hub.clientsByID, userID)
+
+	return true
+}
+
 func (hub *Hub) Broadcast(ctx context.Context, message []byte) {
 	hub.broadcast <- message
 }
@@ -0,0 +1,50 @@
+package revocation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// revokedTokenFamilyKeyFormat must match the key abysscore writes revoked refresh token families to.
+	revokedTokenFamilyKeyFormat = "RevokedTokenFamily:%s"
+	// staleUserTokensKeyFormat must match the key abysscore writes the time user data has changed at.
+	staleUserTokensKeyFormat = "StaleUserTokens:%d"
+)
+
+// Checker looks up the revocation list shared with abysscore.
+type Checker struct {
+	client *redis.Client
+}
+
+func NewChecker(client *redis.Client) *Checker {
+	return &Checker{client: client}
+}
+
+// IsRevoked reports whether tokens of the refresh token family are revoked.
+func (c *Checker) IsRevoked(ctx context.Context, familyID string) (bool, error) {
+	count, err := c.client.Exists(ctx, fmt.Sprintf(revokedTokenFamilyKeyFormat, familyID)).Result()
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// IsStale reports whether the token issued at issuedAt carries outdated user data, e.g. old username.
+func (c *Checker) IsStale(ctx context.Context, userID int, issuedAt time.Time) (bool, error) {
+	issuedBefore, err := c.client.Get(ctx, fmt.Sprintf(staleUserTokensKeyFormat, userID)).Int64()
+	if errors.Is(err, redis.Nil) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return issuedAt.Unix() < issuedBefore, nil
+}
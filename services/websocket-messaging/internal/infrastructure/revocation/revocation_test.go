@@ -0,0 +1,136 @@
+package revocation
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func TestIsRevoked(t *testing.T) {
+	t.Parallel()
+
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	checker := NewChecker(client)
+
+	if err := server.Set("RevokedTokenFamily:revoked-family", "1"); err != nil {
+		t.Fatalf("Failed to set key: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		familyID string
+		expected bool
+	}{
+		{
+			name:     "Revoked family",
+			familyID: "revoked-family",
+			expected: true,
+		},
+		{
+			name:     "Active family",
+			familyID: "active-family",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			revoked, err := checker.IsRevoked(t.Context(), tt.familyID)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if revoked != tt.expected {
+				t.Errorf("Expected revoked to be %v, got %v", tt.expected, revoked)
+			}
+		})
+	}
+}
+
+func TestIsRevokedUnavailable(t *testing.T) {
+	t.Parallel()
+
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr(), MaxRetries: -1})
+
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	server.Close()
+
+	_, err := NewChecker(client).IsRevoked(t.Context(), "family")
+	if err == nil {
+		t.Error("Expected error when redis is unavailable")
+	}
+}
+
+func TestIsStale(t *testing.T) {
+	t.Parallel()
+
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	checker := NewChecker(client)
+	changedAt := time.Now().Truncate(time.Second)
+
+	if err := server.Set("StaleUserTokens:1", strconv.FormatInt(changedAt.Unix(), 10)); err != nil {
+		t.Fatalf("Failed to set key: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		userID   int
+		issuedAt time.Time
+		expected bool
+	}{
+		{
+			name:     "Issued before change",
+			userID:   1,
+			issuedAt: changedAt.Add(-time.Second),
+			expected: true,
+		},
+		{
+			name:     "Issued at change",
+			userID:   1,
+			issuedAt: changedAt,
+			expected: false,
+		},
+		{
+			name:     "User without changes",
+			userID:   2,
+			issuedAt: changedAt.Add(-time.Second),
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			stale, err := checker.IsStale(t.Context(), tt.userID, tt.issuedAt)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if stale != tt.expected {
+				t.Errorf("Expected stale to be %v, got %v", tt.expected, stale)
+			}
+		})
+	}
+}
@@ -7,8 +7,12 @@ import (
 	"github.com/intezya/abyssleague/services/websocket-messaging/internal/domain/entity"
 )
 
-// ErrFailedToSendMessage is returned when a message cannot be sent to a user.
-var ErrFailedToSendMessage = errors.New("failed to send message to user")
+var (
+	// ErrFailedToSendMessage is returned when a message cannot be sent to a user.
+	ErrFailedToSendMessage = errors.New("failed to send message to user")
+	// ErrSessionNotConnected is returned when none of the sessions has a connection.
+	ErrSessionNotConnected = errors.New("session is not connected")
+)
 
 type OnlineUser struct {
 	Id         int64
@@ -20,6 +24,7 @@ type Hub interface {
 	GetClients(ctx context.Context) []*entity.AuthenticationData
 	SendToUser(ctx context.Context, userId int, jsonPayload []byte) bool
 	Broadcast(ctx context.Context, jsonPayload []byte)
+	DisconnectSessions(ctx context.Context, userID int, sessionIDs []string) bool
 }
 
 type WebsocketService struct {
@@ -62,3 +67,11 @@ func (s *WebsocketService) Broadcast(ctx context.Context, jsonPayload []byte) er
 
 	return nil
 }
+
+func (s *WebsocketService) DisconnectSessions(ctx context.Context, userID int, sessionIDs []string) error {
+	if !s.hub.DisconnectSessions(ctx, userID, sessionIDs) {
+		return ErrSessionNotConnected
+	}
+
+	return nil
+}
@@ -15,6 +15,8 @@ type MockHub struct {
 	GetClientsFunc func(ctx context.Context) []*entity.AuthenticationData
 	SendToUserFunc func(ctx context.Context, userId int, jsonPayload []byte) bool
 	BroadcastFunc  func(ctx context.Context, jsonPayload []byte)
+
+	DisconnectSessionsFunc func(ctx context.Context, userID int, sessionIDs []string) bool
 }
 
 func (m *MockHub) GetClients(ctx context.Context) []*entity.AuthenticationData {
@@ -29,6 +31,10 @@ func (m *MockHub) Broadcast(ctx context.Context, jsonPayload []byte) {
 	m.BroadcastFunc(ctx, jsonPayload)
 }
 
+func (m *MockHub) DisconnectSessions(ctx context.Context, userID int, sessionIDs []string) bool {
+	return m.DisconnectSessionsFunc(ctx, userID, sessionIDs)
+}
+
 // Create a test-specific version of NewWebsocketService that accepts our MockHub.
 func newTestWebsocketService(mockHub *MockHub) *WebsocketService {
 	return &WebsocketService{hub: mockHub}
@@ -222,3 +228,42 @@ func TestBroadcast(t *testing.T) {
 		t.Errorf("Expected Broadcast to be called")
 	}
 }
+
+func TestDisconnectSessions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		disconnected  bool
+		expectedError error
+	}{
+		{
+			name:          "Session connected",
+			disconnected:  true,
+			expectedError: nil,
+		},
+		{
+			name:          "Session not connected",
+			disconnected:  false,
+			expectedError: ErrSessionNotConnected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockHub := &MockHub{
+				DisconnectSessionsFunc: func(ctx context.Context, userID int, sessionIDs []string) bool {
+					return tt.disconnected
+				},
+			}
+			service := newTestWebsocketService(mockHub)
+
+			err := service.DisconnectSessions(t.Context(), 1, []string{"session"})
+			if !errors.Is(err, tt.expectedError) {
+				t.Errorf("Expected error %v, got %v", tt.expectedError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+// Package authtest provides a token issuer for tests of packages which verify tokens.
+package authtest
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/intezya/abyssleague/services/websocket-messaging/internal/pkg/auth"
+)
+
+const tokenLifetime = time.Hour
+
+// Issuer signs tokens with Ed25519 keys like abysscore does and publishes public keys as JWKS.
+type Issuer struct {
+	issuer string
+	server *httptest.Server
+
+	mu        sync.RWMutex
+	currentID string
+	keys      map[string]ed25519.PrivateKey
+	ids       []string
+
+	requests atomic.Int32
+}
+
+func NewIssuer(t testing.TB, issuer string) *Issuer {
+	t.Helper()
+
+	i := &Issuer{issuer: issuer, keys: make(map[string]ed25519.PrivateKey)} //nolint:exhaustruct
+	i.Rotate(t, "1")
+
+	i.server = httptest.NewServer(http.HandlerFunc(i.serveJWKS))
+	t.Cleanup(i.server.Close)
+
+	return i
+}
+
+// JWKSURL is the address of published public keys.
+func (i *Issuer) JWKSURL() string {
+	return i.server.URL
+}
+
+// Requests returns the number of JWKS requests served.
+func (i *Issuer) Requests() int {
+	return int(i.requests.Load())
+}
+
+// Rotate makes a new key current, previous keys stay published.
+func (i *Issuer) Rotate(t testing.TB, keyID string) {
+	t.Helper()
+
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.currentID = keyID
+	i.keys[keyID] = private
+	i.ids = append(i.ids, keyID)
+}
+
+// Token signs token data with the current key.
+func (i *Issuer) Token(t testing.TB, data *auth.TokenData) string {
+	t.Helper()
+
+	now := time.Now()
+
+	return i.Sign(t, &auth.Claim{
+		AuthenticationData: data,
+		RegisteredClaims: jwt.RegisteredClaims{ //nolint:exhaustruct
+			Issuer:    i.issuer,
+			ExpiresAt: jwt.NewNumericDate(now.Add(tokenLifetime)),
+			NotBefore: jwt.NewNumericDate(now),
+			IssuedAt:  jwt.NewNumericDate(now),
+		},
+	})
+}
+
+// Sign signs arbitrary claims with the current key.
+func (i *Issuer) Sign(t testing.TB, claims jwt.Claims) string {
+	t.Helper()
+
+	i.mu.RLock()
+	keyID, private := i.currentID, i.keys[i.currentID]
+	i.mu.RUnlock()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+	token.Header["kid"] = keyID
+
+	tokenString, err := token.SignedString(private)
+	if err != nil {
+		t.Fatalf("Failed to sign token: %v", err)
+	}
+
+	return tokenString
+}
+
+func (i *Issuer) serveJWKS(w http.ResponseWriter, _ *http.Request) {
+	i.requests.Add(1)
+
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	keys := make([]map[string]string, 0, len(i.ids))
+
+	for _, id := range i.ids {
+		keys = append(keys, map[string]string{
+			"kty": "OKP",
+			"crv": "Ed25519",
+			"kid": id,
+			"alg": jwt.SigningMethodEdDSA.Alg(),
+			"use": "sig",
+			"x":   base64.RawURLEncoding.EncodeToString(i.keys[id].Public().(ed25519.PublicKey)),
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	_ = json.NewEncoder(w).Encode(map[string]any{"keys": keys})
+}
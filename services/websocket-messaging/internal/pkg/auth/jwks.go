@@ -0,0 +1,217 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/intezya/pkglib/logger"
+)
+
+const (
+	jwksFetchTimeout = 5 * time.Second
+	// minRefreshInterval limits refreshes caused by tokens with unknown key ids.
+	minRefreshInterval = 10 * time.Second
+)
+
+var (
+	errUnknownKeyID       = errors.New("unknown key id")
+	errUnexpectedStatus   = errors.New("unexpected JWKS response status")
+	errUnsupportedKeyType = errors.New("unsupported key type")
+	errNoUsableKeys       = errors.New("JWKS has no usable keys")
+)
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+type jsonWebKey struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Algorithm string `json:"alg"`
+	Use       string `json:"use"`
+	Curve     string `json:"crv"`
+	X         string `json:"x"`
+	Modulus   string `json:"n"`
+	Exponent  string `json:"e"`
+}
+
+type publicKey struct {
+	algorithm string
+	key       crypto.PublicKey
+}
+
+// keySet caches public keys published by abysscore. Keys are refreshed periodically and when
+// a token refers to a key id that is not cached yet, e.g. right after rotation.
+type keySet struct {
+	url    string
+	client *http.Client
+
+	mu          sync.RWMutex
+	keys        map[string]*publicKey
+	refreshedAt time.Time
+
+	refreshMu sync.Mutex
+}
+
+func newKeySet(url string) *keySet {
+	return &keySet{
+		url:         url,
+		client:      &http.Client{Timeout: jwksFetchTimeout}, //nolint:exhaustruct
+		mu:          sync.RWMutex{},
+		keys:        make(map[string]*publicKey),
+		refreshedAt: time.Time{},
+		refreshMu:   sync.Mutex{},
+	}
+}
+
+func (s *keySet) key(ctx context.Context, keyID string) (*publicKey, error) {
+	if key, ok := s.cached(keyID); ok {
+		return key, nil
+	}
+
+	err := s.refreshIfOutdated(ctx, minRefreshInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	if key, ok := s.cached(keyID); ok {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("%w: %q", errUnknownKeyID, keyID)
+}
+
+func (s *keySet) cached(keyID string) (*publicKey, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	key, ok := s.keys[keyID]
+
+	return key, ok
+}
+
+// refreshIfOutdated fetches keys unless they were fetched within maxAge, concurrent callers wait
+// for a single request.
+func (s *keySet) refreshIfOutdated(ctx context.Context, maxAge time.Duration) error {
+	s.refreshMu.Lock()
+	defer s.refreshMu.Unlock()
+
+	s.mu.RLock()
+	refreshedAt := s.refreshedAt
+	s.mu.RUnlock()
+
+	if time.Since(refreshedAt) < maxAge {
+		return nil
+	}
+
+	return s.refresh(ctx)
+}
+
+func (s *keySet) refresh(ctx context.Context) error {
+	keys, err := s.fetch(ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// failed attempts are counted too, so unknown key ids do not flood abysscore while it is down
+	s.refreshedAt = time.Now()
+
+	if err != nil {
+		return err
+	}
+
+	s.keys = keys
+
+	return nil
+}
+
+func (s *keySet) fetch(ctx context.Context) (map[string]*publicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %d", errUnexpectedStatus, resp.StatusCode)
+	}
+
+	var set jsonWebKeySet
+
+	err = json.NewDecoder(resp.Body).Decode(&set)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]*publicKey, len(set.Keys))
+
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+
+		// one unsupported key must not invalidate tokens signed with the others
+		key, err := parsePublicKey(&jwk)
+		if err != nil {
+			logger.Log.Warnf("skipping JWKS key %q: %v", jwk.KeyID, err)
+
+			continue
+		}
+
+		keys[jwk.KeyID] = &publicKey{algorithm: jwk.Algorithm, key: key}
+	}
+
+	if len(keys) == 0 {
+		return nil, errNoUsableKeys
+	}
+
+	return keys, nil
+}
+
+func parsePublicKey(jwk *jsonWebKey) (crypto.PublicKey, error) {
+	switch {
+	case jwk.KeyType == "OKP" && jwk.Curve == "Ed25519":
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errUnsupportedKeyType
+		}
+
+		return ed25519.PublicKey(x), nil
+	case jwk.KeyType == "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(jwk.Modulus)
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(jwk.Exponent)
+		if err != nil {
+			return nil, err
+		}
+
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnsupportedKeyType, jwk.KeyType)
+	}
+}
@@ -0,0 +1,118 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/intezya/pkglib/logger"
+)
+
+func TestParsePublicKeyRSA(t *testing.T) {
+	t.Parallel()
+
+	private, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+
+	key, err := parsePublicKey(&jsonWebKey{ //nolint:exhaustruct
+		KeyType:  "RSA",
+		Modulus:  base64.RawURLEncoding.EncodeToString(private.N.Bytes()),
+		Exponent: base64.RawURLEncoding.EncodeToString(big.NewInt(int64(private.E)).Bytes()),
+	})
+	if err != nil {
+		t.Fatalf("Failed to parse key: %v", err)
+	}
+
+	if !private.PublicKey.Equal(key) {
+		t.Error("Expected parsed key to match the generated one")
+	}
+}
+
+func TestParsePublicKeyUnsupported(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		jwk  *jsonWebKey
+	}{
+		{name: "Symmetric key", jwk: &jsonWebKey{KeyType: "oct"}},                             //nolint:exhaustruct
+		{name: "Other curve", jwk: &jsonWebKey{KeyType: "OKP", Curve: "X25519"}},              //nolint:exhaustruct
+		{name: "Wrong key size", jwk: &jsonWebKey{KeyType: "OKP", Curve: "Ed25519", X: "AA"}}, //nolint:exhaustruct
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := parsePublicKey(tc.jwk)
+			if !errors.Is(err, errUnsupportedKeyType) {
+				t.Errorf("Expected errUnsupportedKeyType, got %v", err)
+			}
+		})
+	}
+}
+
+func newTestJWKSServer(t *testing.T, keys ...*jsonWebKey) string {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": keys})
+	}))
+	t.Cleanup(server.Close)
+
+	return server.URL
+}
+
+func TestFetchSkipsUnparsableKeys(t *testing.T) {
+	t.Parallel()
+
+	_, err := logger.New(logger.WithDebug(true))
+	if err != nil {
+		t.Fatalf("Failed to initialize logger: %v", err)
+	}
+
+	public, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+
+	unsupported := &jsonWebKey{KeyType: "oct", KeyID: "bad"} //nolint:exhaustruct
+
+	t.Run("Usable key is kept", func(t *testing.T) {
+		t.Parallel()
+
+		url := newTestJWKSServer(t, unsupported, &jsonWebKey{ //nolint:exhaustruct
+			KeyType: "OKP",
+			Curve:   "Ed25519",
+			KeyID:   "good",
+			X:       base64.RawURLEncoding.EncodeToString(public),
+		})
+
+		keys, err := newKeySet(url).fetch(t.Context())
+		if err != nil {
+			t.Fatalf("Expected unparsable key to be skipped, got %v", err)
+		}
+
+		if _, ok := keys["good"]; !ok || len(keys) != 1 {
+			t.Errorf("Expected only the usable key, got %v", keys)
+		}
+	})
+
+	t.Run("No usable keys", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newKeySet(newTestJWKSServer(t, unsupported)).fetch(t.Context())
+		if !errors.Is(err, errNoUsableKeys) {
+			t.Errorf("Expected errNoUsableKeys, got %v", err)
+		}
+	})
+}
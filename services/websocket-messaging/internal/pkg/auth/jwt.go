@@ -1,36 +1,49 @@
 package auth
 
 import (
+	"context"
 	"errors"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/intezya/pkglib/logger"
 )
 
 // ErrInvalidToken is returned when a token is invalid or cannot be validated.
 var ErrInvalidToken = errors.New("invalid token")
 
+var (
+	errKeyIDRequired         = errors.New("token has no key id")
+	errSigningMethodMismatch = errors.New("token signing method does not match the key")
+)
+
+const keyIDHeader = "kid"
+
 type TokenData struct {
 	ID       int    `json:"id"`
 	Username string `json:"username"`
 	Hwid     string `json:"hardware_id"`
+	FamilyID string `json:"family_id"`
+	// IssuedAt is taken from the registered claims on validation.
+	IssuedAt time.Time `json:"-"`
 }
 
+// JWTConfiguration holds only the location of public keys, tokens are signed by abysscore.
 type JWTConfiguration struct {
-	secretKey      []byte
-	issuer         string
-	expirationTime time.Duration
+	jwksURL         string
+	issuer          string
+	refreshInterval time.Duration
 }
 
 func NewJWTConfiguration(
-	secretKey string,
+	jwksURL string,
 	issuer string,
-	expirationTime time.Duration,
+	refreshInterval time.Duration,
 ) *JWTConfiguration {
 	return &JWTConfiguration{
-		secretKey:      []byte(secretKey),
-		issuer:         issuer,
-		expirationTime: expirationTime,
+		jwksURL:         jwksURL,
+		issuer:          issuer,
+		refreshInterval: refreshInterval,
 	}
 }
 
@@ -41,31 +54,34 @@ type Claim struct {
 
 type JWTHelper struct {
 	*JWTConfiguration
+	keys *keySet
 }
 
 func NewJWTHelper(configuration *JWTConfiguration) *JWTHelper {
-	return &JWTHelper{JWTConfiguration: configuration}
+	return &JWTHelper{
+		JWTConfiguration: configuration,
+		keys:             newKeySet(configuration.jwksURL),
+	}
 }
 
-func (j *JWTHelper) TokenGenerator(tokenData *TokenData) string {
-	claims := &Claim{
-		AuthenticationData: tokenData,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(j.expirationTime)),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			NotBefore: jwt.NewNumericDate(time.Now()),
-			Issuer:    j.issuer,
-			Subject:   "",
-			Audience:  nil,
-			ID:        "",
-		},
+// RunRefreshLoop fetches public keys on start and then every refresh interval.
+// Keys of tokens with unknown key id are fetched on demand regardless of the loop.
+func (j *JWTHelper) RunRefreshLoop(ctx context.Context) {
+	ticker := time.NewTicker(j.refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		err := j.keys.refresh(ctx)
+		if err != nil {
+			logger.Log.Warnf("Failed to refresh JWKS from %s: %v", j.jwksURL, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
 	}
-
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-
-	tokenString, _ := token.SignedString(j.secretKey)
-
-	return tokenString
 }
 
 func (j *JWTHelper) ValidateToken(tokenString string) (*TokenData, error) {
@@ -77,9 +93,8 @@ func (j *JWTHelper) ValidateToken(tokenString string) (*TokenData, error) {
 	token, err := jwt.ParseWithClaims(
 		tokenString,
 		claims,
-		func(token *jwt.Token) (interface{}, error) {
-			return j.secretKey, nil
-		},
+		j.verificationKey,
+		jwt.WithValidMethods([]string{jwt.SigningMethodEdDSA.Alg(), jwt.SigningMethodRS256.Alg()}),
 		jwt.WithIssuer(j.issuer),
 		jwt.WithStrictDecoding(),
 	)
@@ -92,5 +107,28 @@ func (j *JWTHelper) ValidateToken(tokenString string) (*TokenData, error) {
 		return nil, ErrInvalidToken
 	}
 
+	if claims.AuthenticationData != nil && claims.IssuedAt != nil {
+		claims.AuthenticationData.IssuedAt = claims.IssuedAt.Time
+	}
+
 	return claims.AuthenticationData, nil
 }
+
+// verificationKey picks the public key by "kid" header, the key must match the token algorithm.
+func (j *JWTHelper) verificationKey(token *jwt.Token) (interface{}, error) {
+	keyID, ok := token.Header[keyIDHeader].(string)
+	if !ok {
+		return nil, errKeyIDRequired
+	}
+
+	key, err := j.keys.key(context.Background(), keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.algorithm != "" && key.algorithm != token.Method.Alg() {
+		return nil, errSigningMethodMismatch
+	}
+
+	return key.key, nil
+}
@@ -1,18 +1,38 @@
-package auth
+package auth_test
 
 import (
+	"crypto/ed25519"
+	"crypto/rand"
 	"testing"
 	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/intezya/abyssleague/services/websocket-messaging/internal/pkg/auth"
+	"github.com/intezya/abyssleague/services/websocket-messaging/internal/pkg/auth/authtest"
 )
 
+func newTestHelper(t *testing.T, issuerName string) (*auth.JWTHelper, *authtest.Issuer) {
+	t.Helper()
+
+	issuer := authtest.NewIssuer(t, issuerName)
+	helper := auth.NewJWTHelper(auth.NewJWTConfiguration(issuer.JWKSURL(), issuerName, time.Minute))
+
+	return helper, issuer
+}
+
+func newTestTokenData() *auth.TokenData {
+	return &auth.TokenData{
+		ID:       123,
+		Username: "testuser",
+		Hwid:     "testhwid",
+		FamilyID: "family",
+	}
+}
+
 func TestJWTConfiguration(t *testing.T) {
 	t.Parallel()
 	// Test creating a new JWT configuration
-	secretKey := "test-secret"
-	issuer := "test-issuer"
-	expirationTime := 24 * time.Hour
-
-	config := NewJWTConfiguration(secretKey, issuer, expirationTime)
+	config := auth.NewJWTConfiguration("http://localhost/.well-known/jwks.json", "test-issuer", time.Minute)
 
 	if config == nil {
 		t.Fatal("Expected non-nil JWTConfiguration")
@@ -22,8 +42,8 @@ func TestJWTConfiguration(t *testing.T) {
 func TestNewJWTHelper(t *testing.T) {
 	t.Parallel()
 	// Test creating a new JWT helper
-	config := NewJWTConfiguration("test-secret", "test-issuer", 24*time.Hour)
-	helper := NewJWTHelper(config)
+	config := auth.NewJWTConfiguration("http://localhost/.well-known/jwks.json", "test-issuer", time.Minute)
+	helper := auth.NewJWTHelper(config)
 
 	if helper == nil {
 		t.Fatal("Expected non-nil JWTHelper")
@@ -34,28 +54,14 @@ func TestNewJWTHelper(t *testing.T) {
 	}
 }
 
-func TestTokenGeneratorAndValidation(t *testing.T) {
+func TestValidateToken(t *testing.T) {
 	t.Parallel()
-	// Create a JWT helper with a short expiration time for testing
-	config := NewJWTConfiguration("test-secret", "test-issuer", 2*time.Second)
-	helper := NewJWTHelper(config)
-
-	// Create test token data
-	tokenData := &TokenData{
-		ID:       123,
-		Username: "testuser",
-		Hwid:     "testhwid",
-	}
 
-	// Generate a token
-	token := helper.TokenGenerator(tokenData)
+	helper, issuer := newTestHelper(t, "test-issuer")
+	tokenData := newTestTokenData()
 
-	if token == "" {
-		t.Fatal("Expected non-empty token")
-	}
-
-	// Validate the token
-	validatedData, err := helper.ValidateToken(token)
+	// Validate the token, public keys are fetched on first use
+	validatedData, err := helper.ValidateToken(issuer.Token(t, tokenData))
 	if err != nil {
 		t.Fatalf("Token validation failed: %v", err)
 	}
@@ -73,25 +79,64 @@ func TestTokenGeneratorAndValidation(t *testing.T) {
 		t.Errorf("Expected Hwid %s, got %s", tokenData.Hwid, validatedData.Hwid)
 	}
 
-	// Test token expiration
-	t.Run("Token expiration", func(t *testing.T) {
-		// Wait for the token to expire
-		t.Parallel()
-		time.Sleep(3 * time.Second)
+	if validatedData.FamilyID != tokenData.FamilyID {
+		t.Errorf("Expected FamilyID %s, got %s", tokenData.FamilyID, validatedData.FamilyID)
+	}
+
+	if time.Since(validatedData.IssuedAt) > time.Minute {
+		t.Errorf("Expected IssuedAt to be set from claims, got %v", validatedData.IssuedAt)
+	}
 
-		// Try to validate the expired token
-		_, err := helper.ValidateToken(token)
-		if err == nil {
-			t.Error("Expected error for expired token, got nil")
-		}
+	// Cached keys are reused
+	_, err = helper.ValidateToken(issuer.Token(t, tokenData))
+	if err != nil {
+		t.Fatalf("Token validation failed: %v", err)
+	}
+
+	if issuer.Requests() != 1 {
+		t.Errorf("Expected keys to be fetched once, got %d requests", issuer.Requests())
+	}
+}
+
+func TestValidateExpiredToken(t *testing.T) {
+	t.Parallel()
+
+	helper, issuer := newTestHelper(t, "test-issuer")
+
+	token := issuer.Sign(t, &auth.Claim{
+		AuthenticationData: newTestTokenData(),
+		RegisteredClaims: jwt.RegisteredClaims{ //nolint:exhaustruct
+			Issuer:    "test-issuer",
+			IssuedAt:  jwt.NewNumericDate(time.Now().Add(-2 * time.Hour)),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Hour)),
+		},
 	})
+
+	_, err := helper.ValidateToken(token)
+	if err == nil {
+		t.Error("Expected error for expired token, got nil")
+	}
 }
 
 func TestValidateTokenWithInvalidToken(t *testing.T) {
 	t.Parallel()
-	// Create a JWT helper
-	config := NewJWTConfiguration("test-secret", "test-issuer", 24*time.Hour)
-	helper := NewJWTHelper(config)
+
+	helper, _ := newTestHelper(t, "test-issuer")
+
+	// HMAC token signed with a shared secret must not be accepted
+	hmacToken := jwt.NewWithClaims(jwt.SigningMethodHS256, &auth.Claim{
+		AuthenticationData: newTestTokenData(),
+		RegisteredClaims: jwt.RegisteredClaims{ //nolint:exhaustruct
+			Issuer:    "test-issuer",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	})
+	hmacToken.Header["kid"] = "1"
+
+	hmacTokenString, err := hmacToken.SignedString([]byte("test-secret"))
+	if err != nil {
+		t.Fatalf("Failed to sign token: %v", err)
+	}
 
 	// Test cases for invalid tokens
 	testCases := []struct {
@@ -112,6 +157,10 @@ func TestValidateTokenWithInvalidToken(t *testing.T) {
 				"eyJzdWIiOiIxMjM0NTY3ODkwIiwibmFtZSI6IkpvaG4gRG9lIiwiaWF0IjoxNTE2MjM5MDIyfQ." +
 				"SflKxwRJSMeKKF2QT4fwpMeJf36POk6yJV_adQssw5c",
 		},
+		{
+			name:  "HMAC signed",
+			token: hmacTokenString,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -128,27 +177,84 @@ func TestValidateTokenWithInvalidToken(t *testing.T) {
 
 func TestValidateTokenWithWrongIssuer(t *testing.T) {
 	t.Parallel()
-	// Create a JWT helper with a specific issuer
-	config1 := NewJWTConfiguration("test-secret", "issuer1", 24*time.Hour)
-	helper1 := NewJWTHelper(config1)
 
-	// Create another JWT helper with a different issuer
-	config2 := NewJWTConfiguration("test-secret", "issuer2", 24*time.Hour)
-	helper2 := NewJWTHelper(config2)
+	issuer := authtest.NewIssuer(t, "issuer1")
+	helper := auth.NewJWTHelper(auth.NewJWTConfiguration(issuer.JWKSURL(), "issuer2", time.Minute))
 
-	// Create test token data
-	tokenData := &TokenData{
-		ID:       123,
-		Username: "testuser",
-		Hwid:     "testhwid",
+	// Try to validate the token issued by another issuer
+	_, err := helper.ValidateToken(issuer.Token(t, newTestTokenData()))
+	if err == nil {
+		t.Error("Expected error for token with wrong issuer, got nil")
+	}
+}
+
+func TestValidateTokenAfterKeyRotation(t *testing.T) {
+	t.Parallel()
+
+	helper, issuer := newTestHelper(t, "test-issuer")
+	oldToken := issuer.Token(t, newTestTokenData())
+
+	_, err := helper.ValidateToken(oldToken)
+	if err != nil {
+		t.Fatalf("Token validation failed: %v", err)
 	}
 
-	// Generate a token with the first helper
-	token := helper1.TokenGenerator(tokenData)
+	issuer.Rotate(t, "2")
 
-	// Try to validate the token with the second helper (different issuer)
-	_, err := helper2.ValidateToken(token)
+	// Key id is unknown, but keys were fetched too recently to refresh them again
+	_, err = helper.ValidateToken(issuer.Token(t, newTestTokenData()))
 	if err == nil {
-		t.Error("Expected error for token with wrong issuer, got nil")
+		t.Error("Expected error for token signed with not fetched key, got nil")
+	}
+
+	if issuer.Requests() != 1 {
+		t.Errorf("Expected keys to be fetched once, got %d requests", issuer.Requests())
+	}
+
+	// Token signed before rotation is still accepted
+	_, err = helper.ValidateToken(oldToken)
+	if err != nil {
+		t.Errorf("Expected token signed with previous key to be valid, got %v", err)
+	}
+}
+
+func TestValidateTokenFetchesRotatedKey(t *testing.T) {
+	t.Parallel()
+
+	helper, issuer := newTestHelper(t, "test-issuer")
+
+	// Key is rotated before the first validation, so the first fetch already has it
+	issuer.Rotate(t, "2")
+
+	_, err := helper.ValidateToken(issuer.Token(t, newTestTokenData()))
+	if err != nil {
+		t.Fatalf("Expected token signed with rotated key to be valid, got %v", err)
+	}
+}
+
+func TestValidateTokenWithoutKeyID(t *testing.T) {
+	t.Parallel()
+
+	helper, _ := newTestHelper(t, "test-issuer")
+
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodEdDSA, &auth.Claim{
+		AuthenticationData: newTestTokenData(),
+		RegisteredClaims: jwt.RegisteredClaims{ //nolint:exhaustruct
+			Issuer:    "test-issuer",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}).SignedString(private)
+	if err != nil {
+		t.Fatalf("Failed to sign token: %v", err)
+	}
+
+	_, err = helper.ValidateToken(token)
+	if err == nil {
+		t.Error("Expected error for token without key id, got nil")
 	}
 }